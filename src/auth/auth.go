@@ -69,6 +69,10 @@ const (
 	// RepoReaderRole is a role which grants ability to both read from a repo
 	RepoReaderRole = "repoReader"
 
+	// RepoApproverRole is a role which grants the ability to read from a repo
+	// and approve its commits.
+	RepoApproverRole = "repoApprover"
+
 	// IDPAdminRole is a role which grants the ability to configure OIDC apps.
 	OIDCAppAdminRole = "oidcAppAdmin"
 
@@ -302,6 +306,18 @@ func HashToken(token string) string {
 	return fmt.Sprintf("%x", sum)
 }
 
+// TokenIDLength is the length of token IDs.
+const TokenIDLength = 16
+
+// TokenID returns the ID of the token with the given hash, which identifies
+// the token when listing or revoking it without revealing the token.
+func TokenID(hashedToken string) string {
+	if len(hashedToken) < TokenIDLength {
+		return hashedToken
+	}
+	return hashedToken[:TokenIDLength]
+}
+
 // GetAuthToken extracts the auth token embedded in 'ctx', if there is one
 func GetAuthToken(ctx context.Context) (string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
@@ -71,11 +71,16 @@ const (
 	Permission_CLUSTER_LICENSE_DELETE_CLUSTER             Permission = 136
 	Permission_CLUSTER_LICENSE_LIST_CLUSTERS              Permission = 137
 	// TODO(actgardner): Make k8s secrets into nouns and add an Update RPC
-	Permission_CLUSTER_CREATE_SECRET       Permission = 143
-	Permission_CLUSTER_LIST_SECRETS        Permission = 144
-	Permission_SECRET_DELETE               Permission = 145
-	Permission_SECRET_INSPECT              Permission = 146
-	Permission_CLUSTER_DELETE_ALL          Permission = 138
+	Permission_CLUSTER_CREATE_SECRET Permission = 143
+	Permission_CLUSTER_LIST_SECRETS  Permission = 144
+	Permission_SECRET_DELETE         Permission = 145
+	Permission_SECRET_INSPECT        Permission = 146
+	Permission_CLUSTER_DELETE_ALL    Permission = 138
+	// CLUSTER_MANAGE_REPLICATIONS and CLUSTER_RECEIVE_REPLICATION are part of PFS.
+	Permission_CLUSTER_MANAGE_REPLICATIONS Permission = 151
+	Permission_CLUSTER_RECEIVE_REPLICATION Permission = 152
+	// CLUSTER_SET_PROJECT_QUOTAS is part of PFS.
+	Permission_CLUSTER_SET_PROJECT_QUOTAS  Permission = 153
 	Permission_REPO_READ                   Permission = 200
 	Permission_REPO_WRITE                  Permission = 201
 	Permission_REPO_MODIFY_BINDINGS        Permission = 202
@@ -91,7 +96,11 @@ const (
 	Permission_REPO_ADD_PIPELINE_READER    Permission = 212
 	Permission_REPO_REMOVE_PIPELINE_READER Permission = 213
 	Permission_REPO_ADD_PIPELINE_WRITER    Permission = 214
-	Permission_PIPELINE_LIST_JOB           Permission = 301
+	// REPO_APPROVE_COMMIT allows approving commits for the branch triggers
+	// which require approval.  It isn't part of repoWriter, so that the
+	// writers of a commit can't approve it themselves.
+	Permission_REPO_APPROVE_COMMIT Permission = 215
+	Permission_PIPELINE_LIST_JOB   Permission = 301
 	// CLUSTER_SET_DEFAULTS is part of PPS.
 	Permission_CLUSTER_SET_DEFAULTS    Permission = 302
 	Permission_PROJECT_CREATE          Permission = 400
@@ -152,6 +161,9 @@ var (
 		145: "SECRET_DELETE",
 		146: "SECRET_INSPECT",
 		138: "CLUSTER_DELETE_ALL",
+		151: "CLUSTER_MANAGE_REPLICATIONS",
+		152: "CLUSTER_RECEIVE_REPLICATION",
+		153: "CLUSTER_SET_PROJECT_QUOTAS",
 		200: "REPO_READ",
 		201: "REPO_WRITE",
 		202: "REPO_MODIFY_BINDINGS",
@@ -167,6 +179,7 @@ var (
 		212: "REPO_ADD_PIPELINE_READER",
 		213: "REPO_REMOVE_PIPELINE_READER",
 		214: "REPO_ADD_PIPELINE_WRITER",
+		215: "REPO_APPROVE_COMMIT",
 		301: "PIPELINE_LIST_JOB",
 		302: "CLUSTER_SET_DEFAULTS",
 		400: "PROJECT_CREATE",
@@ -224,6 +237,9 @@ var (
 		"SECRET_DELETE":                              145,
 		"SECRET_INSPECT":                             146,
 		"CLUSTER_DELETE_ALL":                         138,
+		"CLUSTER_MANAGE_REPLICATIONS":                151,
+		"CLUSTER_RECEIVE_REPLICATION":                152,
+		"CLUSTER_SET_PROJECT_QUOTAS":                 153,
 		"REPO_READ":                                  200,
 		"REPO_WRITE":                                 201,
 		"REPO_MODIFY_BINDINGS":                       202,
@@ -239,6 +255,7 @@ var (
 		"REPO_ADD_PIPELINE_READER":                   212,
 		"REPO_REMOVE_PIPELINE_READER":                213,
 		"REPO_ADD_PIPELINE_WRITER":                   214,
+		"REPO_APPROVE_COMMIT":                        215,
 		"PIPELINE_LIST_JOB":                          301,
 		"CLUSTER_SET_DEFAULTS":                       302,
 		"PROJECT_CREATE":                             400,
@@ -625,6 +642,13 @@ type OIDCConfig struct {
 	// accessible outside the cluster. This requires a fully formed URL with scheme of either http or https.
 	// This is necessary to support some configurations like Minikube.
 	UserAccessibleIssuerHost string `protobuf:"bytes,8,opt,name=user_accessible_issuer_host,json=userAccessibleIssuerHost,proto3" json:"user_accessible_issuer_host,omitempty"`
+	// workload_identity_providers let workloads, such as pods running in
+	// Kubernetes, authenticate with tokens that an OIDC issuer gave them instead
+	// of with robot tokens.
+	WorkloadIdentityProviders []*WorkloadIdentityProvider `protobuf:"bytes,9,rep,name=workload_identity_providers,json=workloadIdentityProviders,proto3" json:"workload_identity_providers,omitempty"`
+	// group_sync configures how users' group memberships are synced from their
+	// ID tokens when they authenticate.
+	GroupSync *OIDCGroupSync `protobuf:"bytes,10,opt,name=group_sync,json=groupSync,proto3" json:"group_sync,omitempty"`
 }
 
 func (x *OIDCConfig) Reset() {
@@ -715,6 +739,261 @@ func (x *OIDCConfig) GetUserAccessibleIssuerHost() string {
 	return ""
 }
 
+func (x *OIDCConfig) GetWorkloadIdentityProviders() []*WorkloadIdentityProvider {
+	if x != nil {
+		return x.WorkloadIdentityProviders
+	}
+	return nil
+}
+
+func (x *OIDCConfig) GetGroupSync() *OIDCGroupSync {
+	if x != nil {
+		return x.GroupSync
+	}
+	return nil
+}
+
+// OIDCGroupSync maps an ID token claim to the groups of the user that
+// authenticates with the token, so that role bindings on group: principals
+// follow the ID provider.
+type OIDCGroupSync struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// claim is the ID token claim that lists the user's groups.  It may be a
+	// string or a list of strings, and defaults to "groups".  A claim that
+	// isn't at the top level of the token may be named by joining the names
+	// of its parents and itself with '/'.
+	Claim string `protobuf:"bytes,1,opt,name=claim,proto3" json:"claim,omitempty"`
+	// prefix is prepended to the names in the claim, so that "eng" becomes
+	// "group:<prefix>eng".  If it's set, only the user's groups that start with
+	// the prefix are synced, and the groups they were added to with
+	// SetGroupsForUser or ModifyMembers are kept.  Otherwise the synced groups
+	// replace all of the user's groups.
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// allowed_groups, if set, are the only names in the claim that are synced.
+	// A name ending in '*' allows every group that starts with the rest of it.
+	AllowedGroups []string `protobuf:"bytes,3,rep,name=allowed_groups,json=allowedGroups,proto3" json:"allowed_groups,omitempty"`
+	// disabled turns group sync off, leaving group memberships to
+	// SetGroupsForUser and ModifyMembers.
+	Disabled bool `protobuf:"varint,4,opt,name=disabled,proto3" json:"disabled,omitempty"`
+}
+
+func (x *OIDCGroupSync) Reset() {
+	*x = OIDCGroupSync{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_auth_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCGroupSync) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCGroupSync) ProtoMessage() {}
+
+func (x *OIDCGroupSync) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCGroupSync.ProtoReflect.Descriptor instead.
+func (*OIDCGroupSync) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OIDCGroupSync) GetClaim() string {
+	if x != nil {
+		return x.Claim
+	}
+	return ""
+}
+
+func (x *OIDCGroupSync) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *OIDCGroupSync) GetAllowedGroups() []string {
+	if x != nil {
+		return x.AllowedGroups
+	}
+	return nil
+}
+
+func (x *OIDCGroupSync) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+// WorkloadIdentityProvider is an OIDC issuer of workload tokens, such as the
+// projected service account tokens of a Kubernetes cluster.
+type WorkloadIdentityProvider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// issuer is the iss claim of the provider's tokens.
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// audiences are the aud claims that tokens may be issued for.
+	Audiences []string `protobuf:"bytes,2,rep,name=audiences,proto3" json:"audiences,omitempty"`
+	// jwks_url is the URL of the provider's signing keys.  If it's not set, it
+	// is discovered from the issuer's OIDC configuration.
+	JwksUrl string `protobuf:"bytes,3,opt,name=jwks_url,json=jwksUrl,proto3" json:"jwks_url,omitempty"`
+	// kubernetes_api fetches the signing keys from the API server of the
+	// Kubernetes cluster that pachd runs in, with pachd's service account.  The
+	// service account tokens of that cluster can then be verified even if its
+	// issuer isn't reachable without credentials.
+	KubernetesApi bool `protobuf:"varint,4,opt,name=kubernetes_api,json=kubernetesApi,proto3" json:"kubernetes_api,omitempty"`
+	// rules map the claims of verified tokens to principals.  The first rule
+	// that matches a token determines its principal, and tokens that match no
+	// rule are rejected.
+	Rules []*WorkloadIdentityRule `protobuf:"bytes,5,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *WorkloadIdentityProvider) Reset() {
+	*x = WorkloadIdentityProvider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_auth_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkloadIdentityProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadIdentityProvider) ProtoMessage() {}
+
+func (x *WorkloadIdentityProvider) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadIdentityProvider.ProtoReflect.Descriptor instead.
+func (*WorkloadIdentityProvider) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WorkloadIdentityProvider) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *WorkloadIdentityProvider) GetAudiences() []string {
+	if x != nil {
+		return x.Audiences
+	}
+	return nil
+}
+
+func (x *WorkloadIdentityProvider) GetJwksUrl() string {
+	if x != nil {
+		return x.JwksUrl
+	}
+	return ""
+}
+
+func (x *WorkloadIdentityProvider) GetKubernetesApi() bool {
+	if x != nil {
+		return x.KubernetesApi
+	}
+	return false
+}
+
+func (x *WorkloadIdentityProvider) GetRules() []*WorkloadIdentityRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type WorkloadIdentityRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// claims must all match the claims of a token for the rule to match it.
+	// Nested claims are named by joining their names with '/', such as
+	// 'kubernetes.io/namespace'.  A value ending in '*' matches any claim with
+	// that prefix, and a claim with a list of values matches if any of them
+	// match.
+	Claims map[string]string `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// principal is the robot: or user: principal that tokens matching the rule
+	// authenticate as.
+	Principal string `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
+}
+
+func (x *WorkloadIdentityRule) Reset() {
+	*x = WorkloadIdentityRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_auth_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkloadIdentityRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadIdentityRule) ProtoMessage() {}
+
+func (x *WorkloadIdentityRule) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadIdentityRule.ProtoReflect.Descriptor instead.
+func (*WorkloadIdentityRule) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WorkloadIdentityRule) GetClaims() map[string]string {
+	if x != nil {
+		return x.Claims
+	}
+	return nil
+}
+
+func (x *WorkloadIdentityRule) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
 type GetConfigurationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -724,7 +1003,7 @@ type GetConfigurationRequest struct {
 func (x *GetConfigurationRequest) Reset() {
 	*x = GetConfigurationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[7]
+		mi := &file_auth_auth_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -737,7 +1016,7 @@ func (x *GetConfigurationRequest) String() string {
 func (*GetConfigurationRequest) ProtoMessage() {}
 
 func (x *GetConfigurationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[7]
+	mi := &file_auth_auth_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -750,7 +1029,7 @@ func (x *GetConfigurationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConfigurationRequest.ProtoReflect.Descriptor instead.
 func (*GetConfigurationRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{7}
+	return file_auth_auth_proto_rawDescGZIP(), []int{10}
 }
 
 type GetConfigurationResponse struct {
@@ -764,7 +1043,7 @@ type GetConfigurationResponse struct {
 func (x *GetConfigurationResponse) Reset() {
 	*x = GetConfigurationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[8]
+		mi := &file_auth_auth_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -777,7 +1056,7 @@ func (x *GetConfigurationResponse) String() string {
 func (*GetConfigurationResponse) ProtoMessage() {}
 
 func (x *GetConfigurationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[8]
+	mi := &file_auth_auth_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -790,7 +1069,7 @@ func (x *GetConfigurationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConfigurationResponse.ProtoReflect.Descriptor instead.
 func (*GetConfigurationResponse) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{8}
+	return file_auth_auth_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetConfigurationResponse) GetConfiguration() *OIDCConfig {
@@ -811,7 +1090,7 @@ type SetConfigurationRequest struct {
 func (x *SetConfigurationRequest) Reset() {
 	*x = SetConfigurationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[9]
+		mi := &file_auth_auth_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -824,7 +1103,7 @@ func (x *SetConfigurationRequest) String() string {
 func (*SetConfigurationRequest) ProtoMessage() {}
 
 func (x *SetConfigurationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[9]
+	mi := &file_auth_auth_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -837,7 +1116,7 @@ func (x *SetConfigurationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetConfigurationRequest.ProtoReflect.Descriptor instead.
 func (*SetConfigurationRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{9}
+	return file_auth_auth_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SetConfigurationRequest) GetConfiguration() *OIDCConfig {
@@ -856,7 +1135,7 @@ type SetConfigurationResponse struct {
 func (x *SetConfigurationResponse) Reset() {
 	*x = SetConfigurationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[10]
+		mi := &file_auth_auth_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -869,7 +1148,7 @@ func (x *SetConfigurationResponse) String() string {
 func (*SetConfigurationResponse) ProtoMessage() {}
 
 func (x *SetConfigurationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[10]
+	mi := &file_auth_auth_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -882,7 +1161,7 @@ func (x *SetConfigurationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetConfigurationResponse.ProtoReflect.Descriptor instead.
 func (*SetConfigurationResponse) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{10}
+	return file_auth_auth_proto_rawDescGZIP(), []int{13}
 }
 
 // TokenInfo is the 'value' of an auth token 'key' in the 'tokens' collection
@@ -896,12 +1175,19 @@ type TokenInfo struct {
 	Subject     string                 `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
 	Expiration  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expiration,proto3" json:"expiration,omitempty"`
 	HashedToken string                 `protobuf:"bytes,3,opt,name=hashed_token,json=hashedToken,proto3" json:"hashed_token,omitempty"`
+	// scopes restrict what the token may be used for.  A token without scopes
+	// has all of its subject's permissions.
+	Scopes    []*TokenScope          `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// id identifies the token without revealing it, for listing and revoking
+	// tokens.
+	Id string `protobuf:"bytes,6,opt,name=id,proto3" json:"id,omitempty"`
 }
 
 func (x *TokenInfo) Reset() {
 	*x = TokenInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[11]
+		mi := &file_auth_auth_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -914,7 +1200,7 @@ func (x *TokenInfo) String() string {
 func (*TokenInfo) ProtoMessage() {}
 
 func (x *TokenInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[11]
+	mi := &file_auth_auth_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -927,7 +1213,7 @@ func (x *TokenInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenInfo.ProtoReflect.Descriptor instead.
 func (*TokenInfo) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{11}
+	return file_auth_auth_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *TokenInfo) GetSubject() string {
@@ -951,6 +1237,99 @@ func (x *TokenInfo) GetHashedToken() string {
 	return ""
 }
 
+func (x *TokenInfo) GetScopes() []*TokenScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *TokenInfo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TokenInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// TokenScope grants a token permissions on a resource.  A scoped token is only
+// granted the permissions that both its subject's role bindings and one of its
+// scopes grant.
+type TokenScope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// resource is the cluster, project or repo that the scope applies to.  A
+	// scope on the cluster applies to every resource, and a scope on a project
+	// applies to the project's repos.
+	Resource    *Resource    `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Permissions []Permission `protobuf:"varint,2,rep,packed,name=permissions,proto3,enum=auth_v2.Permission" json:"permissions,omitempty"`
+	// branches restricts a scope on a repo to operations on these branches,
+	// such as starting commits on them or creating them.  Operations on a repo
+	// that aren't about a branch aren't granted by a scope with branches.
+	Branches []string `protobuf:"bytes,3,rep,name=branches,proto3" json:"branches,omitempty"`
+}
+
+func (x *TokenScope) Reset() {
+	*x = TokenScope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_auth_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenScope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenScope) ProtoMessage() {}
+
+func (x *TokenScope) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenScope.ProtoReflect.Descriptor instead.
+func (*TokenScope) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TokenScope) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *TokenScope) GetPermissions() []Permission {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *TokenScope) GetBranches() []string {
+	if x != nil {
+		return x.Branches
+	}
+	return nil
+}
+
 type AuthenticateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -961,12 +1340,15 @@ type AuthenticateRequest struct {
 	OidcState string `protobuf:"bytes,1,opt,name=oidc_state,json=oidcState,proto3" json:"oidc_state,omitempty"`
 	// This is an ID Token issued by the OIDC provider.
 	IdToken string `protobuf:"bytes,2,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	// This is a token issued to a workload by one of the configured workload
+	// identity providers, such as a Kubernetes service account token.
+	WorkloadToken string `protobuf:"bytes,3,opt,name=workload_token,json=workloadToken,proto3" json:"workload_token,omitempty"`
 }
 
 func (x *AuthenticateRequest) Reset() {
 	*x = AuthenticateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[12]
+		mi := &file_auth_auth_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -979,7 +1361,7 @@ func (x *AuthenticateRequest) String() string {
 func (*AuthenticateRequest) ProtoMessage() {}
 
 func (x *AuthenticateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[12]
+	mi := &file_auth_auth_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -992,7 +1374,7 @@ func (x *AuthenticateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthenticateRequest.ProtoReflect.Descriptor instead.
 func (*AuthenticateRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{12}
+	return file_auth_auth_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *AuthenticateRequest) GetOidcState() string {
@@ -1009,6 +1391,13 @@ func (x *AuthenticateRequest) GetIdToken() string {
 	return ""
 }
 
+func (x *AuthenticateRequest) GetWorkloadToken() string {
+	if x != nil {
+		return x.WorkloadToken
+	}
+	return ""
+}
+
 type AuthenticateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1023,7 +1412,7 @@ type AuthenticateResponse struct {
 func (x *AuthenticateResponse) Reset() {
 	*x = AuthenticateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[13]
+		mi := &file_auth_auth_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1036,7 +1425,7 @@ func (x *AuthenticateResponse) String() string {
 func (*AuthenticateResponse) ProtoMessage() {}
 
 func (x *AuthenticateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[13]
+	mi := &file_auth_auth_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1049,7 +1438,7 @@ func (x *AuthenticateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthenticateResponse.ProtoReflect.Descriptor instead.
 func (*AuthenticateResponse) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{13}
+	return file_auth_auth_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *AuthenticateResponse) GetPachToken() string {
@@ -1068,7 +1457,7 @@ type WhoAmIRequest struct {
 func (x *WhoAmIRequest) Reset() {
 	*x = WhoAmIRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[14]
+		mi := &file_auth_auth_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1081,7 +1470,7 @@ func (x *WhoAmIRequest) String() string {
 func (*WhoAmIRequest) ProtoMessage() {}
 
 func (x *WhoAmIRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[14]
+	mi := &file_auth_auth_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1094,7 +1483,7 @@ func (x *WhoAmIRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WhoAmIRequest.ProtoReflect.Descriptor instead.
 func (*WhoAmIRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{14}
+	return file_auth_auth_proto_rawDescGZIP(), []int{18}
 }
 
 type WhoAmIResponse struct {
@@ -1104,12 +1493,14 @@ type WhoAmIResponse struct {
 
 	Username   string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	Expiration *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// scopes are the scopes of the caller's token, if it's a scoped token.
+	Scopes []*TokenScope `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
 }
 
 func (x *WhoAmIResponse) Reset() {
 	*x = WhoAmIResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[15]
+		mi := &file_auth_auth_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1122,7 +1513,7 @@ func (x *WhoAmIResponse) String() string {
 func (*WhoAmIResponse) ProtoMessage() {}
 
 func (x *WhoAmIResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[15]
+	mi := &file_auth_auth_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1135,7 +1526,7 @@ func (x *WhoAmIResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WhoAmIResponse.ProtoReflect.Descriptor instead.
 func (*WhoAmIResponse) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{15}
+	return file_auth_auth_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *WhoAmIResponse) GetUsername() string {
@@ -1152,6 +1543,13 @@ func (x *WhoAmIResponse) GetExpiration() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *WhoAmIResponse) GetScopes() []*TokenScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
 type GetRolesForPermissionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1163,7 +1561,7 @@ type GetRolesForPermissionRequest struct {
 func (x *GetRolesForPermissionRequest) Reset() {
 	*x = GetRolesForPermissionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[16]
+		mi := &file_auth_auth_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1176,7 +1574,7 @@ func (x *GetRolesForPermissionRequest) String() string {
 func (*GetRolesForPermissionRequest) ProtoMessage() {}
 
 func (x *GetRolesForPermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[16]
+	mi := &file_auth_auth_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1189,7 +1587,7 @@ func (x *GetRolesForPermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRolesForPermissionRequest.ProtoReflect.Descriptor instead.
 func (*GetRolesForPermissionRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{16}
+	return file_auth_auth_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetRolesForPermissionRequest) GetPermission() Permission {
@@ -1210,7 +1608,7 @@ type GetRolesForPermissionResponse struct {
 func (x *GetRolesForPermissionResponse) Reset() {
 	*x = GetRolesForPermissionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[17]
+		mi := &file_auth_auth_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1223,7 +1621,7 @@ func (x *GetRolesForPermissionResponse) String() string {
 func (*GetRolesForPermissionResponse) ProtoMessage() {}
 
 func (x *GetRolesForPermissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[17]
+	mi := &file_auth_auth_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1236,7 +1634,7 @@ func (x *GetRolesForPermissionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRolesForPermissionResponse.ProtoReflect.Descriptor instead.
 func (*GetRolesForPermissionResponse) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{17}
+	return file_auth_auth_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetRolesForPermissionResponse) GetRoles() []*Role {
@@ -1253,12 +1651,16 @@ type Roles struct {
 	unknownFields protoimpl.UnknownFields
 
 	Roles map[string]bool `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// expiration is when the roles stop granting any permissions, if set.
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// justification records why the roles were granted.
+	Justification string `protobuf:"bytes,3,opt,name=justification,proto3" json:"justification,omitempty"`
 }
 
 func (x *Roles) Reset() {
 	*x = Roles{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[18]
+		mi := &file_auth_auth_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1271,7 +1673,7 @@ func (x *Roles) String() string {
 func (*Roles) ProtoMessage() {}
 
 func (x *Roles) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[18]
+	mi := &file_auth_auth_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1284,7 +1686,7 @@ func (x *Roles) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Roles.ProtoReflect.Descriptor instead.
 func (*Roles) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{18}
+	return file_auth_auth_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *Roles) GetRoles() map[string]bool {
@@ -1294,6 +1696,20 @@ func (x *Roles) GetRoles() map[string]bool {
 	return nil
 }
 
+func (x *Roles) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+func (x *Roles) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
 // RoleBinding represents the set of roles principals have on a given Resource
 type RoleBinding struct {
 	state         protoimpl.MessageState
@@ -1307,7 +1723,7 @@ type RoleBinding struct {
 func (x *RoleBinding) Reset() {
 	*x = RoleBinding{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[19]
+		mi := &file_auth_auth_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1320,7 +1736,7 @@ func (x *RoleBinding) String() string {
 func (*RoleBinding) ProtoMessage() {}
 
 func (x *RoleBinding) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[19]
+	mi := &file_auth_auth_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1333,7 +1749,7 @@ func (x *RoleBinding) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoleBinding.ProtoReflect.Descriptor instead.
 func (*RoleBinding) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{19}
+	return file_auth_auth_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *RoleBinding) GetEntries() map[string]*Roles {
@@ -1356,7 +1772,7 @@ type Resource struct {
 func (x *Resource) Reset() {
 	*x = Resource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[20]
+		mi := &file_auth_auth_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1369,7 +1785,7 @@ func (x *Resource) String() string {
 func (*Resource) ProtoMessage() {}
 
 func (x *Resource) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[20]
+	mi := &file_auth_auth_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1382,7 +1798,7 @@ func (x *Resource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Resource.ProtoReflect.Descriptor instead.
 func (*Resource) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{20}
+	return file_auth_auth_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *Resource) GetType() ResourceType {
@@ -1410,7 +1826,7 @@ type Users struct {
 func (x *Users) Reset() {
 	*x = Users{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[21]
+		mi := &file_auth_auth_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1423,7 +1839,7 @@ func (x *Users) String() string {
 func (*Users) ProtoMessage() {}
 
 func (x *Users) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[21]
+	mi := &file_auth_auth_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1436,7 +1852,7 @@ func (x *Users) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Users.ProtoReflect.Descriptor instead.
 func (*Users) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{21}
+	return file_auth_auth_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *Users) GetUsernames() map[string]bool {
@@ -1457,7 +1873,7 @@ type Groups struct {
 func (x *Groups) Reset() {
 	*x = Groups{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[22]
+		mi := &file_auth_auth_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1470,7 +1886,7 @@ func (x *Groups) String() string {
 func (*Groups) ProtoMessage() {}
 
 func (x *Groups) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[22]
+	mi := &file_auth_auth_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1483,7 +1899,7 @@ func (x *Groups) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Groups.ProtoReflect.Descriptor instead.
 func (*Groups) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{22}
+	return file_auth_auth_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *Groups) GetGroups() map[string]bool {
@@ -1512,7 +1928,7 @@ type Role struct {
 func (x *Role) Reset() {
 	*x = Role{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[23]
+		mi := &file_auth_auth_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1525,7 +1941,7 @@ func (x *Role) String() string {
 func (*Role) ProtoMessage() {}
 
 func (x *Role) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[23]
+	mi := &file_auth_auth_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1538,7 +1954,7 @@ func (x *Role) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Role.ProtoReflect.Descriptor instead.
 func (*Role) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{23}
+	return file_auth_auth_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *Role) GetName() string {
@@ -1582,7 +1998,7 @@ type AuthorizeRequest struct {
 func (x *AuthorizeRequest) Reset() {
 	*x = AuthorizeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[24]
+		mi := &file_auth_auth_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1595,7 +2011,7 @@ func (x *AuthorizeRequest) String() string {
 func (*AuthorizeRequest) ProtoMessage() {}
 
 func (x *AuthorizeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[24]
+	mi := &file_auth_auth_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1608,7 +2024,7 @@ func (x *AuthorizeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthorizeRequest.ProtoReflect.Descriptor instead.
 func (*AuthorizeRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{24}
+	return file_auth_auth_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *AuthorizeRequest) GetResource() *Resource {
@@ -1643,7 +2059,7 @@ type AuthorizeResponse struct {
 func (x *AuthorizeResponse) Reset() {
 	*x = AuthorizeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[25]
+		mi := &file_auth_auth_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1656,7 +2072,7 @@ func (x *AuthorizeResponse) String() string {
 func (*AuthorizeResponse) ProtoMessage() {}
 
 func (x *AuthorizeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[25]
+	mi := &file_auth_auth_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1669,7 +2085,7 @@ func (x *AuthorizeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthorizeResponse.ProtoReflect.Descriptor instead.
 func (*AuthorizeResponse) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{25}
+	return file_auth_auth_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *AuthorizeResponse) GetAuthorized() bool {
@@ -1712,7 +2128,7 @@ type GetPermissionsRequest struct {
 func (x *GetPermissionsRequest) Reset() {
 	*x = GetPermissionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[26]
+		mi := &file_auth_auth_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1725,7 +2141,7 @@ func (x *GetPermissionsRequest) String() string {
 func (*GetPermissionsRequest) ProtoMessage() {}
 
 func (x *GetPermissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[26]
+	mi := &file_auth_auth_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1738,7 +2154,7 @@ func (x *GetPermissionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPermissionsRequest.ProtoReflect.Descriptor instead.
 func (*GetPermissionsRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{26}
+	return file_auth_auth_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetPermissionsRequest) GetResource() *Resource {
@@ -1762,7 +2178,7 @@ type GetPermissionsForPrincipalRequest struct {
 func (x *GetPermissionsForPrincipalRequest) Reset() {
 	*x = GetPermissionsForPrincipalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[27]
+		mi := &file_auth_auth_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1775,7 +2191,7 @@ func (x *GetPermissionsForPrincipalRequest) String() string {
 func (*GetPermissionsForPrincipalRequest) ProtoMessage() {}
 
 func (x *GetPermissionsForPrincipalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[27]
+	mi := &file_auth_auth_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1788,7 +2204,7 @@ func (x *GetPermissionsForPrincipalRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetPermissionsForPrincipalRequest.ProtoReflect.Descriptor instead.
 func (*GetPermissionsForPrincipalRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{27}
+	return file_auth_auth_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetPermissionsForPrincipalRequest) GetResource() *Resource {
@@ -1819,7 +2235,7 @@ type GetPermissionsResponse struct {
 func (x *GetPermissionsResponse) Reset() {
 	*x = GetPermissionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[28]
+		mi := &file_auth_auth_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1832,7 +2248,7 @@ func (x *GetPermissionsResponse) String() string {
 func (*GetPermissionsResponse) ProtoMessage() {}
 
 func (x *GetPermissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[28]
+	mi := &file_auth_auth_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1845,7 +2261,7 @@ func (x *GetPermissionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPermissionsResponse.ProtoReflect.Descriptor instead.
 func (*GetPermissionsResponse) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{28}
+	return file_auth_auth_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *GetPermissionsResponse) GetPermissions() []Permission {
@@ -1874,12 +2290,17 @@ type ModifyRoleBindingRequest struct {
 	// roles is the set of roles for principal - an empty list
 	// removes all role bindings
 	Roles []string `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	// expiration, if set, is when the roles stop granting any permissions.
+	// Expired role bindings are eventually removed.
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// justification records why the roles are granted, for access reviews.
+	Justification string `protobuf:"bytes,5,opt,name=justification,proto3" json:"justification,omitempty"`
 }
 
 func (x *ModifyRoleBindingRequest) Reset() {
 	*x = ModifyRoleBindingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[29]
+		mi := &file_auth_auth_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1892,7 +2313,7 @@ func (x *ModifyRoleBindingRequest) String() string {
 func (*ModifyRoleBindingRequest) ProtoMessage() {}
 
 func (x *ModifyRoleBindingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[29]
+	mi := &file_auth_auth_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1905,7 +2326,7 @@ func (x *ModifyRoleBindingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModifyRoleBindingRequest.ProtoReflect.Descriptor instead.
 func (*ModifyRoleBindingRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{29}
+	return file_auth_auth_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ModifyRoleBindingRequest) GetResource() *Resource {
@@ -1924,9 +2345,23 @@ func (x *ModifyRoleBindingRequest) GetPrincipal() string {
 
 func (x *ModifyRoleBindingRequest) GetRoles() []string {
 	if x != nil {
-		return x.Roles
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *ModifyRoleBindingRequest) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+func (x *ModifyRoleBindingRequest) GetJustification() string {
+	if x != nil {
+		return x.Justification
 	}
-	return nil
+	return ""
 }
 
 type ModifyRoleBindingResponse struct {
@@ -1938,7 +2373,7 @@ type ModifyRoleBindingResponse struct {
 func (x *ModifyRoleBindingResponse) Reset() {
 	*x = ModifyRoleBindingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[30]
+		mi := &file_auth_auth_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1951,7 +2386,7 @@ func (x *ModifyRoleBindingResponse) String() string {
 func (*ModifyRoleBindingResponse) ProtoMessage() {}
 
 func (x *ModifyRoleBindingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[30]
+	mi := &file_auth_auth_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1964,7 +2399,7 @@ func (x *ModifyRoleBindingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModifyRoleBindingResponse.ProtoReflect.Descriptor instead.
 func (*ModifyRoleBindingResponse) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{30}
+	return file_auth_auth_proto_rawDescGZIP(), []int{34}
 }
 
 type GetRoleBindingRequest struct {
@@ -1978,7 +2413,7 @@ type GetRoleBindingRequest struct {
 func (x *GetRoleBindingRequest) Reset() {
 	*x = GetRoleBindingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[31]
+		mi := &file_auth_auth_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1991,7 +2426,7 @@ func (x *GetRoleBindingRequest) String() string {
 func (*GetRoleBindingRequest) ProtoMessage() {}
 
 func (x *GetRoleBindingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[31]
+	mi := &file_auth_auth_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2004,7 +2439,7 @@ func (x *GetRoleBindingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRoleBindingRequest.ProtoReflect.Descriptor instead.
 func (*GetRoleBindingRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{31}
+	return file_auth_auth_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *GetRoleBindingRequest) GetResource() *Resource {
@@ -2025,7 +2460,7 @@ type GetRoleBindingResponse struct {
 func (x *GetRoleBindingResponse) Reset() {
 	*x = GetRoleBindingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[32]
+		mi := &file_auth_auth_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2038,7 +2473,7 @@ func (x *GetRoleBindingResponse) String() string {
 func (*GetRoleBindingResponse) ProtoMessage() {}
 
 func (x *GetRoleBindingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[32]
+	mi := &file_auth_auth_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2051,7 +2486,7 @@ func (x *GetRoleBindingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRoleBindingResponse.ProtoReflect.Descriptor instead.
 func (*GetRoleBindingResponse) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{32}
+	return file_auth_auth_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetRoleBindingResponse) GetBinding() *RoleBinding {
@@ -2100,7 +2535,7 @@ type SessionInfo struct {
 func (x *SessionInfo) Reset() {
 	*x = SessionInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[33]
+		mi := &file_auth_auth_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2113,7 +2548,7 @@ func (x *SessionInfo) String() string {
 func (*SessionInfo) ProtoMessage() {}
 
 func (x *SessionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[33]
+	mi := &file_auth_auth_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2126,7 +2561,7 @@ func (x *SessionInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SessionInfo.ProtoReflect.Descriptor instead.
 func (*SessionInfo) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{33}
+	return file_auth_auth_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *SessionInfo) GetNonce() string {
@@ -2159,7 +2594,7 @@ type GetOIDCLoginRequest struct {
 func (x *GetOIDCLoginRequest) Reset() {
 	*x = GetOIDCLoginRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[34]
+		mi := &file_auth_auth_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2172,7 +2607,7 @@ func (x *GetOIDCLoginRequest) String() string {
 func (*GetOIDCLoginRequest) ProtoMessage() {}
 
 func (x *GetOIDCLoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[34]
+	mi := &file_auth_auth_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2185,7 +2620,7 @@ func (x *GetOIDCLoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOIDCLoginRequest.ProtoReflect.Descriptor instead.
 func (*GetOIDCLoginRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{34}
+	return file_auth_auth_proto_rawDescGZIP(), []int{38}
 }
 
 type GetOIDCLoginResponse struct {
@@ -2201,7 +2636,7 @@ type GetOIDCLoginResponse struct {
 func (x *GetOIDCLoginResponse) Reset() {
 	*x = GetOIDCLoginResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[35]
+		mi := &file_auth_auth_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2214,7 +2649,7 @@ func (x *GetOIDCLoginResponse) String() string {
 func (*GetOIDCLoginResponse) ProtoMessage() {}
 
 func (x *GetOIDCLoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[35]
+	mi := &file_auth_auth_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2227,7 +2662,7 @@ func (x *GetOIDCLoginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOIDCLoginResponse.ProtoReflect.Descriptor instead.
 func (*GetOIDCLoginResponse) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{35}
+	return file_auth_auth_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetOIDCLoginResponse) GetLoginUrl() string {
@@ -2255,12 +2690,14 @@ type GetRobotTokenRequest struct {
 	// ttl indicates the requested (approximate) remaining lifetime of this token,
 	// in seconds
 	Ttl int64 `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// scopes, if set, restrict the token to these scopes.
+	Scopes []*TokenScope `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
 }
 
 func (x *GetRobotTokenRequest) Reset() {
 	*x = GetRobotTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[36]
+		mi := &file_auth_auth_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2273,7 +2710,7 @@ func (x *GetRobotTokenRequest) String() string {
 func (*GetRobotTokenRequest) ProtoMessage() {}
 
 func (x *GetRobotTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[36]
+	mi := &file_auth_auth_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2286,7 +2723,7 @@ func (x *GetRobotTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRobotTokenRequest.ProtoReflect.Descriptor instead.
 func (*GetRobotTokenRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{36}
+	return file_auth_auth_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetRobotTokenRequest) GetRobot() string {
@@ -2303,6 +2740,13 @@ func (x *GetRobotTokenRequest) GetTtl() int64 {
 	return 0
 }
 
+func (x *GetRobotTokenRequest) GetScopes() []*TokenScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
 type GetRobotTokenResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2315,7 +2759,7 @@ type GetRobotTokenResponse struct {
 func (x *GetRobotTokenResponse) Reset() {
 	*x = GetRobotTokenResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[37]
+		mi := &file_auth_auth_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2328,7 +2772,7 @@ func (x *GetRobotTokenResponse) String() string {
 func (*GetRobotTokenResponse) ProtoMessage() {}
 
 func (x *GetRobotTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[37]
+	mi := &file_auth_auth_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2341,7 +2785,7 @@ func (x *GetRobotTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRobotTokenResponse.ProtoReflect.Descriptor instead.
 func (*GetRobotTokenResponse) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{37}
+	return file_auth_auth_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GetRobotTokenResponse) GetToken() string {
@@ -2351,18 +2795,234 @@ func (x *GetRobotTokenResponse) GetToken() string {
 	return ""
 }
 
+// GetScopedTokenRequest requests a token for the caller that is restricted to
+// a set of scopes.
+type GetScopedTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scopes []*TokenScope `protobuf:"bytes,1,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// ttl indicates the requested (approximate) remaining lifetime of this token,
+	// in seconds.  If it's not set, the token does not expire.
+	Ttl int64 `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *GetScopedTokenRequest) Reset() {
+	*x = GetScopedTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_auth_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScopedTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScopedTokenRequest) ProtoMessage() {}
+
+func (x *GetScopedTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScopedTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetScopedTokenRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetScopedTokenRequest) GetScopes() []*TokenScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *GetScopedTokenRequest) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type GetScopedTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Id    string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetScopedTokenResponse) Reset() {
+	*x = GetScopedTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_auth_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScopedTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScopedTokenResponse) ProtoMessage() {}
+
+func (x *GetScopedTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScopedTokenResponse.ProtoReflect.Descriptor instead.
+func (*GetScopedTokenResponse) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetScopedTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetScopedTokenResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ListAuthTokensRequest lists the tokens of a principal, without the tokens
+// themselves.
+type ListAuthTokensRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// principal defaults to the caller.  Listing the tokens of another
+	// principal requires CLUSTER_AUTH_EXTRACT_TOKENS.
+	Principal string `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
+}
+
+func (x *ListAuthTokensRequest) Reset() {
+	*x = ListAuthTokensRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_auth_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuthTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthTokensRequest) ProtoMessage() {}
+
+func (x *ListAuthTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthTokensRequest.ProtoReflect.Descriptor instead.
+func (*ListAuthTokensRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListAuthTokensRequest) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+type ListAuthTokensResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tokens []*TokenInfo `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+}
+
+func (x *ListAuthTokensResponse) Reset() {
+	*x = ListAuthTokensResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_auth_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuthTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthTokensResponse) ProtoMessage() {}
+
+func (x *ListAuthTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthTokensResponse.ProtoReflect.Descriptor instead.
+func (*ListAuthTokensResponse) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListAuthTokensResponse) GetTokens() []*TokenInfo {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+// RevokeAuthTokenRequest revokes a token, given either the token or its ID.
+// Revoking another principal's token by its ID requires
+// CLUSTER_AUTH_REVOKE_USER_TOKENS.
 type RevokeAuthTokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Id    string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
 func (x *RevokeAuthTokenRequest) Reset() {
 	*x = RevokeAuthTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[38]
+		mi := &file_auth_auth_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2375,7 +3035,7 @@ func (x *RevokeAuthTokenRequest) String() string {
 func (*RevokeAuthTokenRequest) ProtoMessage() {}
 
 func (x *RevokeAuthTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[38]
+	mi := &file_auth_auth_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2388,7 +3048,7 @@ func (x *RevokeAuthTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeAuthTokenRequest.ProtoReflect.Descriptor instead.
 func (*RevokeAuthTokenRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{38}
+	return file_auth_auth_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *RevokeAuthTokenRequest) GetToken() string {
@@ -2398,6 +3058,13 @@ func (x *RevokeAuthTokenRequest) GetToken() string {
 	return ""
 }
 
+func (x *RevokeAuthTokenRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
 type RevokeAuthTokenResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2409,7 +3076,7 @@ type RevokeAuthTokenResponse struct {
 func (x *RevokeAuthTokenResponse) Reset() {
 	*x = RevokeAuthTokenResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[39]
+		mi := &file_auth_auth_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2422,7 +3089,7 @@ func (x *RevokeAuthTokenResponse) String() string {
 func (*RevokeAuthTokenResponse) ProtoMessage() {}
 
 func (x *RevokeAuthTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[39]
+	mi := &file_auth_auth_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2435,7 +3102,7 @@ func (x *RevokeAuthTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeAuthTokenResponse.ProtoReflect.Descriptor instead.
 func (*RevokeAuthTokenResponse) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{39}
+	return file_auth_auth_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *RevokeAuthTokenResponse) GetNumber() int64 {
@@ -2457,7 +3124,7 @@ type SetGroupsForUserRequest struct {
 func (x *SetGroupsForUserRequest) Reset() {
 	*x = SetGroupsForUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[40]
+		mi := &file_auth_auth_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2470,7 +3137,7 @@ func (x *SetGroupsForUserRequest) String() string {
 func (*SetGroupsForUserRequest) ProtoMessage() {}
 
 func (x *SetGroupsForUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[40]
+	mi := &file_auth_auth_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2483,7 +3150,7 @@ func (x *SetGroupsForUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetGroupsForUserRequest.ProtoReflect.Descriptor instead.
 func (*SetGroupsForUserRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{40}
+	return file_auth_auth_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *SetGroupsForUserRequest) GetUsername() string {
@@ -2509,7 +3176,7 @@ type SetGroupsForUserResponse struct {
 func (x *SetGroupsForUserResponse) Reset() {
 	*x = SetGroupsForUserResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[41]
+		mi := &file_auth_auth_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2522,7 +3189,7 @@ func (x *SetGroupsForUserResponse) String() string {
 func (*SetGroupsForUserResponse) ProtoMessage() {}
 
 func (x *SetGroupsForUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[41]
+	mi := &file_auth_auth_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2535,7 +3202,7 @@ func (x *SetGroupsForUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetGroupsForUserResponse.ProtoReflect.Descriptor instead.
 func (*SetGroupsForUserResponse) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{41}
+	return file_auth_auth_proto_rawDescGZIP(), []int{49}
 }
 
 type ModifyMembersRequest struct {
@@ -2551,7 +3218,7 @@ type ModifyMembersRequest struct {
 func (x *ModifyMembersRequest) Reset() {
 	*x = ModifyMembersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[42]
+		mi := &file_auth_auth_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2564,7 +3231,7 @@ func (x *ModifyMembersRequest) String() string {
 func (*ModifyMembersRequest) ProtoMessage() {}
 
 func (x *ModifyMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[42]
+	mi := &file_auth_auth_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2577,7 +3244,7 @@ func (x *ModifyMembersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModifyMembersRequest.ProtoReflect.Descriptor instead.
 func (*ModifyMembersRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{42}
+	return file_auth_auth_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *ModifyMembersRequest) GetGroup() string {
@@ -2610,7 +3277,7 @@ type ModifyMembersResponse struct {
 func (x *ModifyMembersResponse) Reset() {
 	*x = ModifyMembersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[43]
+		mi := &file_auth_auth_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2623,7 +3290,7 @@ func (x *ModifyMembersResponse) String() string {
 func (*ModifyMembersResponse) ProtoMessage() {}
 
 func (x *ModifyMembersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[43]
+	mi := &file_auth_auth_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2636,7 +3303,7 @@ func (x *ModifyMembersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModifyMembersResponse.ProtoReflect.Descriptor instead.
 func (*ModifyMembersResponse) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{43}
+	return file_auth_auth_proto_rawDescGZIP(), []int{51}
 }
 
 type GetGroupsRequest struct {
@@ -2648,7 +3315,7 @@ type GetGroupsRequest struct {
 func (x *GetGroupsRequest) Reset() {
 	*x = GetGroupsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[44]
+		mi := &file_auth_auth_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2661,7 +3328,7 @@ func (x *GetGroupsRequest) String() string {
 func (*GetGroupsRequest) ProtoMessage() {}
 
 func (x *GetGroupsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[44]
+	mi := &file_auth_auth_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2674,7 +3341,7 @@ func (x *GetGroupsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupsRequest.ProtoReflect.Descriptor instead.
 func (*GetGroupsRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{44}
+	return file_auth_auth_proto_rawDescGZIP(), []int{52}
 }
 
 type GetGroupsForPrincipalRequest struct {
@@ -2688,7 +3355,7 @@ type GetGroupsForPrincipalRequest struct {
 func (x *GetGroupsForPrincipalRequest) Reset() {
 	*x = GetGroupsForPrincipalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[45]
+		mi := &file_auth_auth_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2701,7 +3368,7 @@ func (x *GetGroupsForPrincipalRequest) String() string {
 func (*GetGroupsForPrincipalRequest) ProtoMessage() {}
 
 func (x *GetGroupsForPrincipalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[45]
+	mi := &file_auth_auth_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2714,7 +3381,7 @@ func (x *GetGroupsForPrincipalRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupsForPrincipalRequest.ProtoReflect.Descriptor instead.
 func (*GetGroupsForPrincipalRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{45}
+	return file_auth_auth_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *GetGroupsForPrincipalRequest) GetPrincipal() string {
@@ -2735,7 +3402,7 @@ type GetGroupsResponse struct {
 func (x *GetGroupsResponse) Reset() {
 	*x = GetGroupsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[46]
+		mi := &file_auth_auth_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2748,7 +3415,7 @@ func (x *GetGroupsResponse) String() string {
 func (*GetGroupsResponse) ProtoMessage() {}
 
 func (x *GetGroupsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[46]
+	mi := &file_auth_auth_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2761,7 +3428,7 @@ func (x *GetGroupsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupsResponse.ProtoReflect.Descriptor instead.
 func (*GetGroupsResponse) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{46}
+	return file_auth_auth_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *GetGroupsResponse) GetGroups() []string {
@@ -2782,7 +3449,7 @@ type GetUsersRequest struct {
 func (x *GetUsersRequest) Reset() {
 	*x = GetUsersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[47]
+		mi := &file_auth_auth_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2795,7 +3462,7 @@ func (x *GetUsersRequest) String() string {
 func (*GetUsersRequest) ProtoMessage() {}
 
 func (x *GetUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[47]
+	mi := &file_auth_auth_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2808,7 +3475,7 @@ func (x *GetUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUsersRequest.ProtoReflect.Descriptor instead.
 func (*GetUsersRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{47}
+	return file_auth_auth_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *GetUsersRequest) GetGroup() string {
@@ -2829,7 +3496,7 @@ type GetUsersResponse struct {
 func (x *GetUsersResponse) Reset() {
 	*x = GetUsersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[48]
+		mi := &file_auth_auth_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2842,7 +3509,7 @@ func (x *GetUsersResponse) String() string {
 func (*GetUsersResponse) ProtoMessage() {}
 
 func (x *GetUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[48]
+	mi := &file_auth_auth_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2855,7 +3522,7 @@ func (x *GetUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUsersResponse.ProtoReflect.Descriptor instead.
 func (*GetUsersResponse) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{48}
+	return file_auth_auth_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *GetUsersResponse) GetUsernames() []string {
@@ -2876,7 +3543,7 @@ type ExtractAuthTokensRequest struct {
 func (x *ExtractAuthTokensRequest) Reset() {
 	*x = ExtractAuthTokensRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[49]
+		mi := &file_auth_auth_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2889,7 +3556,7 @@ func (x *ExtractAuthTokensRequest) String() string {
 func (*ExtractAuthTokensRequest) ProtoMessage() {}
 
 func (x *ExtractAuthTokensRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[49]
+	mi := &file_auth_auth_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2902,7 +3569,7 @@ func (x *ExtractAuthTokensRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtractAuthTokensRequest.ProtoReflect.Descriptor instead.
 func (*ExtractAuthTokensRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{49}
+	return file_auth_auth_proto_rawDescGZIP(), []int{57}
 }
 
 type ExtractAuthTokensResponse struct {
@@ -2916,7 +3583,7 @@ type ExtractAuthTokensResponse struct {
 func (x *ExtractAuthTokensResponse) Reset() {
 	*x = ExtractAuthTokensResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[50]
+		mi := &file_auth_auth_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2929,7 +3596,7 @@ func (x *ExtractAuthTokensResponse) String() string {
 func (*ExtractAuthTokensResponse) ProtoMessage() {}
 
 func (x *ExtractAuthTokensResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[50]
+	mi := &file_auth_auth_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2942,7 +3609,7 @@ func (x *ExtractAuthTokensResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtractAuthTokensResponse.ProtoReflect.Descriptor instead.
 func (*ExtractAuthTokensResponse) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{50}
+	return file_auth_auth_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *ExtractAuthTokensResponse) GetTokens() []*TokenInfo {
@@ -2964,7 +3631,7 @@ type RestoreAuthTokenRequest struct {
 func (x *RestoreAuthTokenRequest) Reset() {
 	*x = RestoreAuthTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[51]
+		mi := &file_auth_auth_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2977,7 +3644,7 @@ func (x *RestoreAuthTokenRequest) String() string {
 func (*RestoreAuthTokenRequest) ProtoMessage() {}
 
 func (x *RestoreAuthTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[51]
+	mi := &file_auth_auth_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2990,7 +3657,7 @@ func (x *RestoreAuthTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestoreAuthTokenRequest.ProtoReflect.Descriptor instead.
 func (*RestoreAuthTokenRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{51}
+	return file_auth_auth_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *RestoreAuthTokenRequest) GetToken() *TokenInfo {
@@ -3009,7 +3676,7 @@ type RestoreAuthTokenResponse struct {
 func (x *RestoreAuthTokenResponse) Reset() {
 	*x = RestoreAuthTokenResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[52]
+		mi := &file_auth_auth_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3022,7 +3689,7 @@ func (x *RestoreAuthTokenResponse) String() string {
 func (*RestoreAuthTokenResponse) ProtoMessage() {}
 
 func (x *RestoreAuthTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[52]
+	mi := &file_auth_auth_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3035,7 +3702,7 @@ func (x *RestoreAuthTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestoreAuthTokenResponse.ProtoReflect.Descriptor instead.
 func (*RestoreAuthTokenResponse) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{52}
+	return file_auth_auth_proto_rawDescGZIP(), []int{60}
 }
 
 type RevokeAuthTokensForUserRequest struct {
@@ -3049,7 +3716,7 @@ type RevokeAuthTokensForUserRequest struct {
 func (x *RevokeAuthTokensForUserRequest) Reset() {
 	*x = RevokeAuthTokensForUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[53]
+		mi := &file_auth_auth_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3062,7 +3729,7 @@ func (x *RevokeAuthTokensForUserRequest) String() string {
 func (*RevokeAuthTokensForUserRequest) ProtoMessage() {}
 
 func (x *RevokeAuthTokensForUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[53]
+	mi := &file_auth_auth_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3075,7 +3742,7 @@ func (x *RevokeAuthTokensForUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeAuthTokensForUserRequest.ProtoReflect.Descriptor instead.
 func (*RevokeAuthTokensForUserRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{53}
+	return file_auth_auth_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *RevokeAuthTokensForUserRequest) GetUsername() string {
@@ -3096,7 +3763,7 @@ type RevokeAuthTokensForUserResponse struct {
 func (x *RevokeAuthTokensForUserResponse) Reset() {
 	*x = RevokeAuthTokensForUserResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[54]
+		mi := &file_auth_auth_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3109,7 +3776,7 @@ func (x *RevokeAuthTokensForUserResponse) String() string {
 func (*RevokeAuthTokensForUserResponse) ProtoMessage() {}
 
 func (x *RevokeAuthTokensForUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[54]
+	mi := &file_auth_auth_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3122,7 +3789,7 @@ func (x *RevokeAuthTokensForUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeAuthTokensForUserResponse.ProtoReflect.Descriptor instead.
 func (*RevokeAuthTokensForUserResponse) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{54}
+	return file_auth_auth_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *RevokeAuthTokensForUserResponse) GetNumber() int64 {
@@ -3141,7 +3808,7 @@ type DeleteExpiredAuthTokensRequest struct {
 func (x *DeleteExpiredAuthTokensRequest) Reset() {
 	*x = DeleteExpiredAuthTokensRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[55]
+		mi := &file_auth_auth_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3154,7 +3821,7 @@ func (x *DeleteExpiredAuthTokensRequest) String() string {
 func (*DeleteExpiredAuthTokensRequest) ProtoMessage() {}
 
 func (x *DeleteExpiredAuthTokensRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[55]
+	mi := &file_auth_auth_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3167,7 +3834,7 @@ func (x *DeleteExpiredAuthTokensRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteExpiredAuthTokensRequest.ProtoReflect.Descriptor instead.
 func (*DeleteExpiredAuthTokensRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{55}
+	return file_auth_auth_proto_rawDescGZIP(), []int{63}
 }
 
 type DeleteExpiredAuthTokensResponse struct {
@@ -3179,7 +3846,7 @@ type DeleteExpiredAuthTokensResponse struct {
 func (x *DeleteExpiredAuthTokensResponse) Reset() {
 	*x = DeleteExpiredAuthTokensResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_auth_proto_msgTypes[56]
+		mi := &file_auth_auth_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3192,7 +3859,7 @@ func (x *DeleteExpiredAuthTokensResponse) String() string {
 func (*DeleteExpiredAuthTokensResponse) ProtoMessage() {}
 
 func (x *DeleteExpiredAuthTokensResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[56]
+	mi := &file_auth_auth_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3205,7 +3872,7 @@ func (x *DeleteExpiredAuthTokensResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteExpiredAuthTokensResponse.ProtoReflect.Descriptor instead.
 func (*DeleteExpiredAuthTokensResponse) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{56}
+	return file_auth_auth_proto_rawDescGZIP(), []int{64}
 }
 
 var File_auth_auth_proto protoreflect.FileDescriptor
@@ -3236,7 +3903,7 @@ var file_auth_auth_proto_rawDesc = []byte{
 	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0a,
 	0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x22, 0xc7, 0x02, 0x0a, 0x0a, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x6e, 0x22, 0xe1, 0x03, 0x0a, 0x0a, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
 	0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65,
 	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69,
@@ -3256,523 +3923,639 @@ var file_auth_auth_proto_rawDesc = []byte{
 	0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f,
 	0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x18, 0x75, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c,
-	0x65, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x55, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x5f, 0x76, 0x32, 0x2e, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x54, 0x0a,
-	0x17, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x84, 0x01, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x68, 0x65,
-	0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5b, 0x0a, 0x13, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
-	0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
-	0x0a, 0x6f, 0x69, 0x64, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x04, 0x90, 0xb5, 0x18, 0x01, 0x52, 0x09, 0x6f, 0x69, 0x64, 0x63, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x90, 0xb5, 0x18, 0x01, 0x52, 0x07, 0x69, 0x64, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x22, 0x3b, 0x0a, 0x14, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0a, 0x70,
-	0x61, 0x63, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x09, 0x70, 0x61, 0x63, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x22, 0x0f, 0x0a, 0x0d, 0x57, 0x68, 0x6f, 0x41, 0x6d, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x68, 0x0a, 0x0e, 0x57, 0x68, 0x6f, 0x41, 0x6d, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x3a, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x65, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x61, 0x0a, 0x1b, 0x77,
+	0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6c,
+	0x6f, 0x61, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x52, 0x19, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x35,
+	0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x4f, 0x49, 0x44,
+	0x43, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x53, 0x79, 0x6e, 0x63, 0x22, 0x80, 0x01, 0x0a, 0x0d, 0x4f, 0x49, 0x44, 0x43, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0xc7, 0x01, 0x0a, 0x18, 0x57, 0x6f, 0x72,
+	0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6a,
+	0x77, 0x6b, 0x73, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a,
+	0x77, 0x6b, 0x73, 0x55, 0x72, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e,
+	0x65, 0x74, 0x65, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x41, 0x70, 0x69, 0x12, 0x33, 0x0a,
+	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x14, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x1a, 0x39, 0x0a, 0x0b,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x55, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e,
+	0x4f, 0x49, 0x44, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x17, 0x53, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x1a, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xfc, 0x01, 0x0a, 0x09,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x21, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x68, 0x65, 0x64, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x53, 0x0a, 0x1c, 0x47,
-	0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x0a, 0x70,
-	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x22, 0x44, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x50,
-	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x23, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52,
-	0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x72, 0x0a, 0x05, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12,
-	0x2f, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x52,
-	0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73,
-	0x1a, 0x38, 0x0a, 0x0a, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x96, 0x01, 0x0a, 0x0b, 0x52,
-	0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x3b, 0x0a, 0x07, 0x65, 0x6e,
-	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
-	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x4a, 0x0a, 0x0c, 0x45, 0x6e, 0x74, 0x72, 0x69,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f,
-	0x76, 0x32, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x49, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
-	0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
-	0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x82,
-	0x01, 0x0a, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x78, 0x0a, 0x06, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x33, 0x0a,
-	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc9, 0x01,
-	0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x70, 0x65,
-	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32,
-	0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x3c, 0x0a, 0x0f, 0x63, 0x61, 0x6e, 0x5f, 0x62, 0x65, 0x5f, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x5f, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x0c, 0x63, 0x61, 0x6e, 0x42, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x6f, 0x12,
-	0x38, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x72, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x22, 0x82, 0x01, 0x0a, 0x10, 0x41, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37,
-	0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61,
-	0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb3,
-	0x01, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
-	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x7a, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x09, 0x73, 0x61, 0x74, 0x69, 0x73, 0x66, 0x69, 0x65,
-	0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76,
-	0x32, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x73, 0x61,
-	0x74, 0x69, 0x73, 0x66, 0x69, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6e, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f,
-	0x76, 0x32, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69,
-	0x70, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63,
-	0x69, 0x70, 0x61, 0x6c, 0x22, 0x50, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a,
-	0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x7a, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x6e, 0x63,
-	0x69, 0x70, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70,
-	0x61, 0x6c, 0x22, 0x65, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0b,
-	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0e, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x18, 0x4d, 0x6f,
-	0x64, 0x69, 0x66, 0x79, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f,
-	0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05,
-	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x14, 0x0a,
-	0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f,
-	0x6c, 0x65, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x6f, 0x6c,
-	0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x50, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x0a, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x72, 0x65, 0x73,
 	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x08,
-	0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x22, 0x48, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07,
-	0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x66, 0x0a, 0x0b,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x05, 0x6e,
-	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x90, 0xb5, 0x18, 0x01,
-	0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x25, 0x0a,
-	0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x45, 0x72, 0x72, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4f, 0x49, 0x44, 0x43, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x55, 0x0a, 0x14, 0x47,
-	0x65, 0x74, 0x4f, 0x49, 0x44, 0x43, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x75, 0x72, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x90, 0xb5, 0x18, 0x01, 0x52, 0x08, 0x6c, 0x6f,
-	0x67, 0x69, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x90, 0xb5, 0x18, 0x01, 0x52, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x22, 0x3e, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x62, 0x6f, 0x74, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f,
-	0x62, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x62, 0x6f, 0x74,
+	0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x13,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a, 0x6f, 0x69, 0x64, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x90, 0xb5, 0x18, 0x01, 0x52, 0x09, 0x6f,
+	0x69, 0x64, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x69, 0x64, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x90, 0xb5, 0x18, 0x01,
+	0x52, 0x07, 0x69, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2b, 0x0a, 0x0e, 0x77, 0x6f, 0x72,
+	0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x04, 0x90, 0xb5, 0x18, 0x01, 0x52, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61,
+	0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3b, 0x0a, 0x14, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23,
+	0x0a, 0x0a, 0x70, 0x61, 0x63, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x09, 0x70, 0x61, 0x63, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x0f, 0x0a, 0x0d, 0x57, 0x68, 0x6f, 0x41, 0x6d, 0x49, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x0e, 0x57, 0x68, 0x6f, 0x41, 0x6d, 0x49, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2b, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0x53, 0x0a, 0x1c,
+	0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x0a,
+	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x44, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0xd4, 0x01, 0x0a, 0x05, 0x52, 0x6f, 0x6c, 0x65,
+	0x73, 0x12, 0x2f, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x73,
+	0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x6f, 0x6c,
+	0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24,
+	0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x38, 0x0a, 0x0a, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x96,
+	0x01, 0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x3b,
+	0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x4a, 0x0a, 0x0c, 0x45,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x49, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x55, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x78, 0x0a, 0x06, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x12, 0x33, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xc9, 0x01, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x35,
+	0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3c, 0x0a, 0x0f, 0x63, 0x61, 0x6e, 0x5f, 0x62, 0x65, 0x5f,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x63, 0x61, 0x6e, 0x42, 0x65, 0x42, 0x6f, 0x75, 0x6e,
+	0x64, 0x54, 0x6f, 0x12, 0x38, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f,
+	0x66, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0b, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x22, 0x82, 0x01,
+	0x0a, 0x10, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10,
+	0x01, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0xb3, 0x01, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x09, 0x73, 0x61, 0x74, 0x69,
+	0x73, 0x66, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x73, 0x61, 0x74, 0x69, 0x73, 0x66, 0x69, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x07, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72,
+	0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x22, 0x50, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x7a, 0x0a, 0x21, 0x47, 0x65,
+	0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x50,
+	0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x37, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e,
+	0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x69,
+	0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x22, 0x65, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x35, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0xe9, 0x01,
+	0x0a, 0x18, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61,
+	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x79, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x50, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c,
+	0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x37, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x48, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52,
+	0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x6f,
+	0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x22, 0x66, 0x0a, 0x0b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1a, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x04, 0x90, 0xb5, 0x18, 0x01, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x65, 0x72, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x4f, 0x49, 0x44, 0x43, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x55, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4f, 0x49, 0x44, 0x43, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x09, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x90, 0xb5,
+	0x18, 0x01, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x90, 0xb5, 0x18,
+	0x01, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x6b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52,
+	0x6f, 0x62, 0x6f, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x62, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x72, 0x6f, 0x62, 0x6f, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f,
+	0x76, 0x32, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0x33, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x62, 0x6f,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88,
+	0xb5, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x56, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73,
 	0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x74,
-	0x74, 0x6c, 0x22, 0x33, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x62, 0x6f, 0x74, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01,
-	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x34, 0x0a, 0x16, 0x52, 0x65, 0x76, 0x6f, 0x6b,
-	0x65, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1a, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x04, 0x90, 0xb5, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x31, 0x0a,
-	0x17, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x22, 0x4d, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x46, 0x6f, 0x72,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22,
-	0x1a, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x46, 0x6f, 0x72, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56, 0x0a, 0x14, 0x4d,
-	0x6f, 0x64, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x64, 0x64,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x61, 0x64, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x3c, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x46, 0x6f, 0x72,
-	0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x22, 0x2b,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x27, 0x0a, 0x0f, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x22, 0x30, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x47, 0x0a, 0x19, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x75, 0x74,
-	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2a, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x4d, 0x0a, 0x17, 0x52,
-	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01,
-	0x02, 0x10, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x0a, 0x1e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
-	0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x39, 0x0a, 0x1f, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75,
-	0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22,
-	0x20, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
+	0x74, 0x6c, 0x22, 0x44, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x64, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18,
+	0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x35, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74,
 	0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x21, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72,
-	0x65, 0x64, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2a, 0xc4, 0x10, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f,
-	0x4e, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x43,
-	0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x59, 0x5f, 0x42, 0x49,
-	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x53, 0x10, 0x64, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x4c, 0x55, 0x53,
-	0x54, 0x45, 0x52, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x42, 0x49, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x53,
-	0x10, 0x65, 0x12, 0x1b, 0x0a, 0x16, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x47, 0x45,
-	0x54, 0x5f, 0x50, 0x41, 0x43, 0x48, 0x44, 0x5f, 0x4c, 0x4f, 0x47, 0x53, 0x10, 0x94, 0x01, 0x12,
-	0x1a, 0x0a, 0x15, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x4c,
-	0x4f, 0x4b, 0x49, 0x5f, 0x4c, 0x4f, 0x47, 0x53, 0x10, 0x96, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x43,
-	0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x41, 0x43, 0x54, 0x49,
-	0x56, 0x41, 0x54, 0x45, 0x10, 0x66, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45,
-	0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x44, 0x45, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54,
-	0x45, 0x10, 0x67, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x41,
-	0x55, 0x54, 0x48, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x10, 0x68,
-	0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48,
-	0x5f, 0x53, 0x45, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x10, 0x69, 0x12, 0x21, 0x0a,
-	0x1c, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x47, 0x45,
-	0x54, 0x5f, 0x52, 0x4f, 0x42, 0x4f, 0x54, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x8b, 0x01,
-	0x12, 0x25, 0x0a, 0x21, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48,
-	0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x59, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x4d, 0x45,
-	0x4d, 0x42, 0x45, 0x52, 0x53, 0x10, 0x6d, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4c, 0x55, 0x53, 0x54,
-	0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x47, 0x52, 0x4f, 0x55,
-	0x50, 0x53, 0x10, 0x6e, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f,
-	0x41, 0x55, 0x54, 0x48, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x55,
-	0x53, 0x45, 0x52, 0x53, 0x10, 0x6f, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45,
-	0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x45, 0x58, 0x54, 0x52, 0x41, 0x43, 0x54, 0x5f, 0x54,
-	0x4f, 0x4b, 0x45, 0x4e, 0x53, 0x10, 0x70, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4c, 0x55, 0x53, 0x54,
-	0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f,
-	0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x71, 0x12, 0x2f, 0x0a, 0x2a, 0x43, 0x4c, 0x55, 0x53, 0x54,
-	0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x4d,
-	0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x50, 0x52, 0x49, 0x4e,
-	0x43, 0x49, 0x50, 0x41, 0x4c, 0x10, 0x8d, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x43, 0x4c, 0x55, 0x53,
-	0x54, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f,
-	0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x53, 0x10, 0x8c,
-	0x01, 0x12, 0x24, 0x0a, 0x1f, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54,
-	0x48, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x54, 0x4f,
-	0x4b, 0x45, 0x4e, 0x53, 0x10, 0x8e, 0x01, 0x12, 0x23, 0x0a, 0x1e, 0x43, 0x4c, 0x55, 0x53, 0x54,
-	0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x52, 0x4f, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52,
-	0x4f, 0x4f, 0x54, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x93, 0x01, 0x12, 0x1f, 0x0a, 0x1b,
-	0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x4e, 0x54, 0x45, 0x52, 0x50, 0x52, 0x49,
-	0x53, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x72, 0x12, 0x20, 0x0a,
-	0x1c, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x4e, 0x54, 0x45, 0x52, 0x50, 0x52,
-	0x49, 0x53, 0x45, 0x5f, 0x48, 0x45, 0x41, 0x52, 0x54, 0x42, 0x45, 0x41, 0x54, 0x10, 0x73, 0x12,
-	0x1f, 0x0a, 0x1b, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x4e, 0x54, 0x45, 0x52,
-	0x50, 0x52, 0x49, 0x53, 0x45, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x74,
-	0x12, 0x21, 0x0a, 0x1d, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x4e, 0x54, 0x45,
-	0x52, 0x50, 0x52, 0x49, 0x53, 0x45, 0x5f, 0x44, 0x45, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54,
-	0x45, 0x10, 0x75, 0x12, 0x1d, 0x0a, 0x18, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x45,
-	0x4e, 0x54, 0x45, 0x52, 0x50, 0x52, 0x49, 0x53, 0x45, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10,
-	0x95, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x49, 0x44,
-	0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49,
-	0x47, 0x10, 0x76, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x49,
-	0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46,
-	0x49, 0x47, 0x10, 0x77, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f,
-	0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f,
-	0x49, 0x44, 0x50, 0x10, 0x78, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52,
-	0x5f, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45,
-	0x5f, 0x49, 0x44, 0x50, 0x10, 0x79, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45,
-	0x52, 0x5f, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f,
-	0x49, 0x44, 0x50, 0x53, 0x10, 0x7a, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45,
-	0x52, 0x5f, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x49,
-	0x44, 0x50, 0x10, 0x7b, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x22,
+	0x44, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x5f, 0x76, 0x32, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x44, 0x0a, 0x16, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41,
+	0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
+	0x90, 0xb5, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x31, 0x0a, 0x17, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x4d,
+	0x0a, 0x17, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x1a, 0x0a,
+	0x18, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56, 0x0a, 0x14, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x64, 0x64, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x61, 0x64, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x22, 0x17, 0x0a, 0x15, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3c,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x72,
+	0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x22, 0x2b, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x27, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x22, 0x30, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41,
+	0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x47, 0x0a, 0x19, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x75, 0x74, 0x68, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a,
+	0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x4d, 0x0a, 0x17, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10,
+	0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x0a, 0x1e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75,
+	0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x39, 0x0a, 0x1f, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75, 0x74, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x20, 0x0a,
+	0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x75,
+	0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x21, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
+	0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2a, 0xc3, 0x11, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4c, 0x55,
+	0x53, 0x54, 0x45, 0x52, 0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x59, 0x5f, 0x42, 0x49, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x53, 0x10, 0x64, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45,
+	0x52, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x42, 0x49, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x53, 0x10, 0x65,
+	0x12, 0x1b, 0x0a, 0x16, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x47, 0x45, 0x54, 0x5f,
+	0x50, 0x41, 0x43, 0x48, 0x44, 0x5f, 0x4c, 0x4f, 0x47, 0x53, 0x10, 0x94, 0x01, 0x12, 0x1a, 0x0a,
+	0x15, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x4c, 0x4f, 0x4b,
+	0x49, 0x5f, 0x4c, 0x4f, 0x47, 0x53, 0x10, 0x96, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4c, 0x55,
+	0x53, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41,
+	0x54, 0x45, 0x10, 0x66, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f,
+	0x41, 0x55, 0x54, 0x48, 0x5f, 0x44, 0x45, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10,
+	0x67, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54,
+	0x48, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x10, 0x68, 0x12, 0x1b,
+	0x0a, 0x17, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x53,
+	0x45, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x10, 0x69, 0x12, 0x21, 0x0a, 0x1c, 0x43,
+	0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x47, 0x45, 0x54, 0x5f,
+	0x52, 0x4f, 0x42, 0x4f, 0x54, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x8b, 0x01, 0x12, 0x25,
+	0x0a, 0x21, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x4d,
+	0x4f, 0x44, 0x49, 0x46, 0x59, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x4d, 0x45, 0x4d, 0x42,
+	0x45, 0x52, 0x53, 0x10, 0x6d, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52,
+	0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x53,
+	0x10, 0x6e, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x55,
+	0x54, 0x48, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x55, 0x53, 0x45,
+	0x52, 0x53, 0x10, 0x6f, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f,
+	0x41, 0x55, 0x54, 0x48, 0x5f, 0x45, 0x58, 0x54, 0x52, 0x41, 0x43, 0x54, 0x5f, 0x54, 0x4f, 0x4b,
+	0x45, 0x4e, 0x53, 0x10, 0x70, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52,
+	0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x54, 0x4f,
+	0x4b, 0x45, 0x4e, 0x10, 0x71, 0x12, 0x2f, 0x0a, 0x2a, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52,
+	0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53,
+	0x53, 0x49, 0x4f, 0x4e, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x50, 0x52, 0x49, 0x4e, 0x43, 0x49,
+	0x50, 0x41, 0x4c, 0x10, 0x8d, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45,
+	0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x45, 0x58,
+	0x50, 0x49, 0x52, 0x45, 0x44, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x53, 0x10, 0x8c, 0x01, 0x12,
+	0x24, 0x0a, 0x1f, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f,
+	0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x54, 0x4f, 0x4b, 0x45,
+	0x4e, 0x53, 0x10, 0x8e, 0x01, 0x12, 0x23, 0x0a, 0x1e, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52,
+	0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x52, 0x4f, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4f,
+	0x54, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x93, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4c,
+	0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x4e, 0x54, 0x45, 0x52, 0x50, 0x52, 0x49, 0x53, 0x45,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x72, 0x12, 0x20, 0x0a, 0x1c, 0x43,
+	0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x4e, 0x54, 0x45, 0x52, 0x50, 0x52, 0x49, 0x53,
+	0x45, 0x5f, 0x48, 0x45, 0x41, 0x52, 0x54, 0x42, 0x45, 0x41, 0x54, 0x10, 0x73, 0x12, 0x1f, 0x0a,
+	0x1b, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x4e, 0x54, 0x45, 0x52, 0x50, 0x52,
+	0x49, 0x53, 0x45, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x74, 0x12, 0x21,
+	0x0a, 0x1d, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x4e, 0x54, 0x45, 0x52, 0x50,
+	0x52, 0x49, 0x53, 0x45, 0x5f, 0x44, 0x45, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10,
+	0x75, 0x12, 0x1d, 0x0a, 0x18, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x4e, 0x54,
+	0x45, 0x52, 0x50, 0x52, 0x49, 0x53, 0x45, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0x95, 0x01,
+	0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x45, 0x4e,
+	0x54, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x10,
+	0x76, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x45,
+	0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
+	0x10, 0x77, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x49, 0x44,
+	0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x44,
+	0x50, 0x10, 0x78, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x49,
+	0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x49,
+	0x44, 0x50, 0x10, 0x79, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f,
+	0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x49, 0x44,
+	0x50, 0x53, 0x10, 0x7a, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f,
+	0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x49, 0x44, 0x50,
+	0x10, 0x7b, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x49, 0x44,
+	0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x49, 0x44,
+	0x50, 0x10, 0x7c, 0x12, 0x27, 0x0a, 0x23, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x49,
+	0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x4f,
+	0x49, 0x44, 0x43, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x7d, 0x12, 0x27, 0x0a, 0x23,
+	0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59,
+	0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x49, 0x44, 0x43, 0x5f, 0x43, 0x4c, 0x49,
+	0x45, 0x4e, 0x54, 0x10, 0x7e, 0x12, 0x26, 0x0a, 0x22, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52,
+	0x5f, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4f,
+	0x49, 0x44, 0x43, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x7f, 0x12, 0x25, 0x0a,
+	0x20, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54,
+	0x59, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x4f, 0x49, 0x44, 0x43, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e,
+	0x54, 0x10, 0x80, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f,
 	0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f,
-	0x49, 0x44, 0x50, 0x10, 0x7c, 0x12, 0x27, 0x0a, 0x23, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52,
-	0x5f, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45,
-	0x5f, 0x4f, 0x49, 0x44, 0x43, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x7d, 0x12, 0x27,
-	0x0a, 0x23, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49,
-	0x54, 0x59, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x49, 0x44, 0x43, 0x5f, 0x43,
-	0x4c, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x7e, 0x12, 0x26, 0x0a, 0x22, 0x43, 0x4c, 0x55, 0x53, 0x54,
-	0x45, 0x52, 0x5f, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x49, 0x53, 0x54,
-	0x5f, 0x4f, 0x49, 0x44, 0x43, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x7f, 0x12,
-	0x25, 0x0a, 0x20, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x45, 0x4e, 0x54,
-	0x49, 0x54, 0x59, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x4f, 0x49, 0x44, 0x43, 0x5f, 0x43, 0x4c, 0x49,
-	0x45, 0x4e, 0x54, 0x10, 0x80, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45,
-	0x52, 0x5f, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54,
-	0x45, 0x5f, 0x4f, 0x49, 0x44, 0x43, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x81, 0x01,
-	0x12, 0x17, 0x0a, 0x12, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x42, 0x55,
-	0x47, 0x5f, 0x44, 0x55, 0x4d, 0x50, 0x10, 0x83, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x43, 0x4c, 0x55,
-	0x53, 0x54, 0x45, 0x52, 0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x41, 0x43, 0x54,
-	0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x84, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x43, 0x4c, 0x55, 0x53,
-	0x54, 0x45, 0x52, 0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x47, 0x45, 0x54, 0x5f,
-	0x43, 0x4f, 0x44, 0x45, 0x10, 0x85, 0x01, 0x12, 0x20, 0x0a, 0x1b, 0x43, 0x4c, 0x55, 0x53, 0x54,
-	0x45, 0x52, 0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x5f, 0x43,
-	0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x10, 0x86, 0x01, 0x12, 0x23, 0x0a, 0x1e, 0x43, 0x4c, 0x55,
-	0x53, 0x54, 0x45, 0x52, 0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x55, 0x50, 0x44,
-	0x41, 0x54, 0x45, 0x5f, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x10, 0x87, 0x01, 0x12, 0x23,
-	0x0a, 0x1e, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53,
-	0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52,
-	0x10, 0x88, 0x01, 0x12, 0x22, 0x0a, 0x1d, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x4c,
-	0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x43, 0x4c, 0x55, 0x53,
-	0x54, 0x45, 0x52, 0x53, 0x10, 0x89, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x43, 0x4c, 0x55, 0x53, 0x54,
-	0x45, 0x52, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54,
-	0x10, 0x8f, 0x01, 0x12, 0x19, 0x0a, 0x14, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x4c,
-	0x49, 0x53, 0x54, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x53, 0x10, 0x90, 0x01, 0x12, 0x12,
-	0x0a, 0x0d, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10,
-	0x91, 0x01, 0x12, 0x13, 0x0a, 0x0e, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x5f, 0x49, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x54, 0x10, 0x92, 0x01, 0x12, 0x17, 0x0a, 0x12, 0x43, 0x4c, 0x55, 0x53, 0x54,
-	0x45, 0x52, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x8a, 0x01,
-	0x12, 0x0e, 0x0a, 0x09, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xc8, 0x01,
-	0x12, 0x0f, 0x0a, 0x0a, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xc9,
-	0x01, 0x12, 0x19, 0x0a, 0x14, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x59,
-	0x5f, 0x42, 0x49, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x53, 0x10, 0xca, 0x01, 0x12, 0x10, 0x0a, 0x0b,
-	0x52, 0x45, 0x50, 0x4f, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0xcb, 0x01, 0x12, 0x18,
-	0x0a, 0x13, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x5f, 0x43,
-	0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0xcc, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x52, 0x45, 0x50, 0x4f,
-	0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0xcd, 0x01, 0x12,
-	0x17, 0x0a, 0x12, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x43,
-	0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0xce, 0x01, 0x12, 0x17, 0x0a, 0x12, 0x52, 0x45, 0x50, 0x4f,
-	0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x10, 0xcf,
-	0x01, 0x12, 0x15, 0x0a, 0x10, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x42,
-	0x52, 0x41, 0x4e, 0x43, 0x48, 0x10, 0xd0, 0x01, 0x12, 0x17, 0x0a, 0x12, 0x52, 0x45, 0x50, 0x4f,
-	0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x42, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x10, 0xd1,
-	0x01, 0x12, 0x16, 0x0a, 0x11, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x54, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0xd2, 0x01, 0x12, 0x13, 0x0a, 0x0e, 0x52, 0x45, 0x50,
-	0x4f, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0xd3, 0x01, 0x12, 0x1d,
-	0x0a, 0x18, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x41, 0x44, 0x44, 0x5f, 0x50, 0x49, 0x50, 0x45, 0x4c,
-	0x49, 0x4e, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x45, 0x52, 0x10, 0xd4, 0x01, 0x12, 0x20, 0x0a,
-	0x1b, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x50, 0x49, 0x50,
-	0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x45, 0x52, 0x10, 0xd5, 0x01, 0x12,
-	0x1d, 0x0a, 0x18, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x41, 0x44, 0x44, 0x5f, 0x50, 0x49, 0x50, 0x45,
-	0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x52, 0x10, 0xd6, 0x01, 0x12, 0x16,
-	0x0a, 0x11, 0x50, 0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f,
-	0x4a, 0x4f, 0x42, 0x10, 0xad, 0x02, 0x12, 0x19, 0x0a, 0x14, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45,
-	0x52, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x53, 0x10, 0xae,
-	0x02, 0x12, 0x13, 0x0a, 0x0e, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x43, 0x52, 0x45,
-	0x41, 0x54, 0x45, 0x10, 0x90, 0x03, 0x12, 0x13, 0x0a, 0x0e, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43,
-	0x54, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x91, 0x03, 0x12, 0x16, 0x0a, 0x11, 0x50,
-	0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4f,
-	0x10, 0x92, 0x03, 0x12, 0x18, 0x0a, 0x13, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x43,
-	0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x10, 0x93, 0x03, 0x12, 0x1c, 0x0a,
-	0x17, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x59, 0x5f,
-	0x42, 0x49, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x53, 0x10, 0x94, 0x03, 0x2a, 0x5c, 0x0a, 0x0c, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x52,
-	0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b,
-	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45,
-	0x52, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x45, 0x50, 0x4f, 0x10, 0x02, 0x12, 0x0d, 0x0a,
-	0x09, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07,
-	0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x04, 0x32, 0xf6, 0x10, 0x0a, 0x03, 0x41, 0x50,
-	0x49, 0x12, 0x41, 0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e,
-	0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76,
-	0x32, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x12, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a,
-	0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x61,
-	0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x75, 0x74, 0x68,
-	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12,
-	0x19, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50,
-	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a,
-	0x1a, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46,
-	0x6f, 0x72, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x2a, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c,
+	0x4f, 0x49, 0x44, 0x43, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x81, 0x01, 0x12, 0x17,
+	0x0a, 0x12, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x42, 0x55, 0x47, 0x5f,
+	0x44, 0x55, 0x4d, 0x50, 0x10, 0x83, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x43, 0x4c, 0x55, 0x53, 0x54,
+	0x45, 0x52, 0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56,
+	0x41, 0x54, 0x45, 0x10, 0x84, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45,
+	0x52, 0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x43, 0x4f,
+	0x44, 0x45, 0x10, 0x85, 0x01, 0x12, 0x20, 0x0a, 0x1b, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52,
+	0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x5f, 0x43, 0x4c, 0x55,
+	0x53, 0x54, 0x45, 0x52, 0x10, 0x86, 0x01, 0x12, 0x23, 0x0a, 0x1e, 0x43, 0x4c, 0x55, 0x53, 0x54,
+	0x45, 0x52, 0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54,
+	0x45, 0x5f, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x10, 0x87, 0x01, 0x12, 0x23, 0x0a, 0x1e,
+	0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f,
+	0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x10, 0x88,
+	0x01, 0x12, 0x22, 0x0a, 0x1d, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x4c, 0x49, 0x43,
+	0x45, 0x4e, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45,
+	0x52, 0x53, 0x10, 0x89, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52,
+	0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x10, 0x8f,
+	0x01, 0x12, 0x19, 0x0a, 0x14, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x4c, 0x49, 0x53,
+	0x54, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x53, 0x10, 0x90, 0x01, 0x12, 0x12, 0x0a, 0x0d,
+	0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x91, 0x01,
+	0x12, 0x13, 0x0a, 0x0e, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x5f, 0x49, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x54, 0x10, 0x92, 0x01, 0x12, 0x17, 0x0a, 0x12, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52,
+	0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x8a, 0x01, 0x12, 0x20,
+	0x0a, 0x1b, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x4e, 0x41, 0x47, 0x45,
+	0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x97, 0x01,
+	0x12, 0x20, 0x0a, 0x1b, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x43, 0x45,
+	0x49, 0x56, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x98, 0x01, 0x12, 0x1f, 0x0a, 0x1a, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x53, 0x45,
+	0x54, 0x5f, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x51, 0x55, 0x4f, 0x54, 0x41, 0x53,
+	0x10, 0x99, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x44,
+	0x10, 0xc8, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x57, 0x52, 0x49, 0x54,
+	0x45, 0x10, 0xc9, 0x01, 0x12, 0x19, 0x0a, 0x14, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x4d, 0x4f, 0x44,
+	0x49, 0x46, 0x59, 0x5f, 0x42, 0x49, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x53, 0x10, 0xca, 0x01, 0x12,
+	0x10, 0x0a, 0x0b, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0xcb,
+	0x01, 0x12, 0x18, 0x0a, 0x13, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0xcc, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x52,
+	0x45, 0x50, 0x4f, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10,
+	0xcd, 0x01, 0x12, 0x17, 0x0a, 0x12, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54,
+	0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0xce, 0x01, 0x12, 0x17, 0x0a, 0x12, 0x52,
+	0x45, 0x50, 0x4f, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x52, 0x41, 0x4e, 0x43,
+	0x48, 0x10, 0xcf, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x4c, 0x49, 0x53,
+	0x54, 0x5f, 0x42, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x10, 0xd0, 0x01, 0x12, 0x17, 0x0a, 0x12, 0x52,
+	0x45, 0x50, 0x4f, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x42, 0x52, 0x41, 0x4e, 0x43,
+	0x48, 0x10, 0xd1, 0x01, 0x12, 0x16, 0x0a, 0x11, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x49, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x54, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0xd2, 0x01, 0x12, 0x13, 0x0a, 0x0e,
+	0x52, 0x45, 0x50, 0x4f, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0xd3,
+	0x01, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x41, 0x44, 0x44, 0x5f, 0x50, 0x49,
+	0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x45, 0x52, 0x10, 0xd4, 0x01,
+	0x12, 0x20, 0x0a, 0x1b, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f,
+	0x50, 0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x45, 0x52, 0x10,
+	0xd5, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x41, 0x44, 0x44, 0x5f, 0x50,
+	0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x52, 0x10, 0xd6,
+	0x01, 0x12, 0x18, 0x0a, 0x13, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56,
+	0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0xd7, 0x01, 0x12, 0x16, 0x0a, 0x11, 0x50,
+	0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4a, 0x4f, 0x42,
+	0x10, 0xad, 0x02, 0x12, 0x19, 0x0a, 0x14, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x53,
+	0x45, 0x54, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x53, 0x10, 0xae, 0x02, 0x12, 0x13,
+	0x0a, 0x0e, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45,
+	0x10, 0x90, 0x03, 0x12, 0x13, 0x0a, 0x0e, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x44,
+	0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x91, 0x03, 0x12, 0x16, 0x0a, 0x11, 0x50, 0x52, 0x4f, 0x4a,
+	0x45, 0x43, 0x54, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x10, 0x92, 0x03,
+	0x12, 0x18, 0x0a, 0x13, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41,
+	0x54, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x10, 0x93, 0x03, 0x12, 0x1c, 0x0a, 0x17, 0x50, 0x52,
+	0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x59, 0x5f, 0x42, 0x49, 0x4e,
+	0x44, 0x49, 0x4e, 0x47, 0x53, 0x10, 0x94, 0x03, 0x2a, 0x5c, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x10, 0x01,
+	0x12, 0x08, 0x0a, 0x04, 0x52, 0x45, 0x50, 0x4f, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x50,
+	0x45, 0x43, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x4f,
+	0x4a, 0x45, 0x43, 0x54, 0x10, 0x04, 0x32, 0xa0, 0x12, 0x0a, 0x03, 0x41, 0x50, 0x49, 0x12, 0x41,
+	0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x47, 0x0a, 0x0a, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12,
+	0x1a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x5f, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4d, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x12, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x44, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76,
+	0x32, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76,
+	0x32, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76,
 	0x32, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x06, 0x57, 0x68,
-	0x6f, 0x41, 0x6d, 0x49, 0x12, 0x16, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x57,
-	0x68, 0x6f, 0x41, 0x6d, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61,
-	0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x57, 0x68, 0x6f, 0x41, 0x6d, 0x49, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x6f,
-	0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x25, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f,
-	0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76,
-	0x32, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x65, 0x72,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x5c, 0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x6f, 0x6c, 0x65, 0x42,
-	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x50,
+	0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x2a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f,
+	0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x46, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x06, 0x57, 0x68, 0x6f, 0x41, 0x6d,
+	0x49, 0x12, 0x16, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x57, 0x68, 0x6f, 0x41,
+	0x6d, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x5f, 0x76, 0x32, 0x2e, 0x57, 0x68, 0x6f, 0x41, 0x6d, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73,
+	0x46, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73,
+	0x46, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c,
+	0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x4d, 0x6f,
+	0x64, 0x69, 0x66, 0x79, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32,
 	0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x5f, 0x76, 0x32, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x53, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x12, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x52,
-	0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x52,
-	0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4f, 0x49, 0x44, 0x43, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47,
-	0x65, 0x74, 0x4f, 0x49, 0x44, 0x43, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74,
-	0x4f, 0x49, 0x44, 0x43, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x62, 0x6f, 0x74, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47,
-	0x65, 0x74, 0x52, 0x6f, 0x62, 0x6f, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65,
-	0x74, 0x52, 0x6f, 0x62, 0x6f, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0f, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41,
-	0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f,
-	0x76, 0x32, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a,
-	0x17, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1e,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65,
+	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65,
+	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4f, 0x49, 0x44, 0x43, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x12, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x4f,
+	0x49, 0x44, 0x43, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x49, 0x44,
+	0x43, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x50, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x62, 0x6f, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x6f, 0x62, 0x6f, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f,
+	0x62, 0x6f, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x64, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0f,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x17, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75,
+	0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x27, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f,
 	0x76, 0x32, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x76, 0x6f,
-	0x6b, 0x65, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a,
-	0x10, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x74, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x65,
-	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x4d, 0x6f, 0x64, 0x69,
-	0x66, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x5f, 0x76, 0x32, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f,
-	0x76, 0x32, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x47, 0x65,
-	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x19, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76,
-	0x32, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x5c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x46, 0x6f, 0x72,
-	0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x25, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x46, 0x6f, 0x72,
-	0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41,
-	0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x18, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x5c, 0x0a, 0x11, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x75, 0x74, 0x68,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32,
-	0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x5f, 0x76, 0x32, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x75, 0x74, 0x68, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x59, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e,
-	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x17, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x75, 0x74, 0x68, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e,
+	0x65, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f,
+	0x76, 0x32, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x46, 0x6f, 0x72, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x46, 0x6f,
+	0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x50, 0x0a, 0x0d, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x12, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x44, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x19,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c,
+	0x12, 0x25, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76,
+	0x32, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x12, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x11, 0x45, 0x78, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x21, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41,
+	0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x75, 0x74, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x75,
+	0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x6e, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x64, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x64, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e,
 	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x75, 0x74,
-	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45,
-	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0f, 0x52, 0x6f,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x2e,
-	0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f,
-	0x6f, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x52,
-	0x6f, 0x6f, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64, 0x65, 0x72, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79,
-	0x64, 0x65, 0x72, 0x6d, 0x2f, 0x76, 0x32, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x61, 0x75, 0x74, 0x68,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x56, 0x0a, 0x0f, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x52,
+	0x6f, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e,
+	0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64, 0x65, 0x72,
+	0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64, 0x65, 0x72, 0x6d, 0x2f, 0x76, 0x32, 0x2f, 0x73,
+	0x72, 0x63, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3788,7 +4571,7 @@ func file_auth_auth_proto_rawDescGZIP() []byte {
 }
 
 var file_auth_auth_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_auth_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 61)
+var file_auth_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 70)
 var file_auth_auth_proto_goTypes = []interface{}{
 	(Permission)(0),                           // 0: auth_v2.Permission
 	(ResourceType)(0),                         // 1: auth_v2.ResourceType
@@ -3799,145 +4582,172 @@ var file_auth_auth_proto_goTypes = []interface{}{
 	(*RotateRootTokenRequest)(nil),            // 6: auth_v2.RotateRootTokenRequest
 	(*RotateRootTokenResponse)(nil),           // 7: auth_v2.RotateRootTokenResponse
 	(*OIDCConfig)(nil),                        // 8: auth_v2.OIDCConfig
-	(*GetConfigurationRequest)(nil),           // 9: auth_v2.GetConfigurationRequest
-	(*GetConfigurationResponse)(nil),          // 10: auth_v2.GetConfigurationResponse
-	(*SetConfigurationRequest)(nil),           // 11: auth_v2.SetConfigurationRequest
-	(*SetConfigurationResponse)(nil),          // 12: auth_v2.SetConfigurationResponse
-	(*TokenInfo)(nil),                         // 13: auth_v2.TokenInfo
-	(*AuthenticateRequest)(nil),               // 14: auth_v2.AuthenticateRequest
-	(*AuthenticateResponse)(nil),              // 15: auth_v2.AuthenticateResponse
-	(*WhoAmIRequest)(nil),                     // 16: auth_v2.WhoAmIRequest
-	(*WhoAmIResponse)(nil),                    // 17: auth_v2.WhoAmIResponse
-	(*GetRolesForPermissionRequest)(nil),      // 18: auth_v2.GetRolesForPermissionRequest
-	(*GetRolesForPermissionResponse)(nil),     // 19: auth_v2.GetRolesForPermissionResponse
-	(*Roles)(nil),                             // 20: auth_v2.Roles
-	(*RoleBinding)(nil),                       // 21: auth_v2.RoleBinding
-	(*Resource)(nil),                          // 22: auth_v2.Resource
-	(*Users)(nil),                             // 23: auth_v2.Users
-	(*Groups)(nil),                            // 24: auth_v2.Groups
-	(*Role)(nil),                              // 25: auth_v2.Role
-	(*AuthorizeRequest)(nil),                  // 26: auth_v2.AuthorizeRequest
-	(*AuthorizeResponse)(nil),                 // 27: auth_v2.AuthorizeResponse
-	(*GetPermissionsRequest)(nil),             // 28: auth_v2.GetPermissionsRequest
-	(*GetPermissionsForPrincipalRequest)(nil), // 29: auth_v2.GetPermissionsForPrincipalRequest
-	(*GetPermissionsResponse)(nil),            // 30: auth_v2.GetPermissionsResponse
-	(*ModifyRoleBindingRequest)(nil),          // 31: auth_v2.ModifyRoleBindingRequest
-	(*ModifyRoleBindingResponse)(nil),         // 32: auth_v2.ModifyRoleBindingResponse
-	(*GetRoleBindingRequest)(nil),             // 33: auth_v2.GetRoleBindingRequest
-	(*GetRoleBindingResponse)(nil),            // 34: auth_v2.GetRoleBindingResponse
-	(*SessionInfo)(nil),                       // 35: auth_v2.SessionInfo
-	(*GetOIDCLoginRequest)(nil),               // 36: auth_v2.GetOIDCLoginRequest
-	(*GetOIDCLoginResponse)(nil),              // 37: auth_v2.GetOIDCLoginResponse
-	(*GetRobotTokenRequest)(nil),              // 38: auth_v2.GetRobotTokenRequest
-	(*GetRobotTokenResponse)(nil),             // 39: auth_v2.GetRobotTokenResponse
-	(*RevokeAuthTokenRequest)(nil),            // 40: auth_v2.RevokeAuthTokenRequest
-	(*RevokeAuthTokenResponse)(nil),           // 41: auth_v2.RevokeAuthTokenResponse
-	(*SetGroupsForUserRequest)(nil),           // 42: auth_v2.SetGroupsForUserRequest
-	(*SetGroupsForUserResponse)(nil),          // 43: auth_v2.SetGroupsForUserResponse
-	(*ModifyMembersRequest)(nil),              // 44: auth_v2.ModifyMembersRequest
-	(*ModifyMembersResponse)(nil),             // 45: auth_v2.ModifyMembersResponse
-	(*GetGroupsRequest)(nil),                  // 46: auth_v2.GetGroupsRequest
-	(*GetGroupsForPrincipalRequest)(nil),      // 47: auth_v2.GetGroupsForPrincipalRequest
-	(*GetGroupsResponse)(nil),                 // 48: auth_v2.GetGroupsResponse
-	(*GetUsersRequest)(nil),                   // 49: auth_v2.GetUsersRequest
-	(*GetUsersResponse)(nil),                  // 50: auth_v2.GetUsersResponse
-	(*ExtractAuthTokensRequest)(nil),          // 51: auth_v2.ExtractAuthTokensRequest
-	(*ExtractAuthTokensResponse)(nil),         // 52: auth_v2.ExtractAuthTokensResponse
-	(*RestoreAuthTokenRequest)(nil),           // 53: auth_v2.RestoreAuthTokenRequest
-	(*RestoreAuthTokenResponse)(nil),          // 54: auth_v2.RestoreAuthTokenResponse
-	(*RevokeAuthTokensForUserRequest)(nil),    // 55: auth_v2.RevokeAuthTokensForUserRequest
-	(*RevokeAuthTokensForUserResponse)(nil),   // 56: auth_v2.RevokeAuthTokensForUserResponse
-	(*DeleteExpiredAuthTokensRequest)(nil),    // 57: auth_v2.DeleteExpiredAuthTokensRequest
-	(*DeleteExpiredAuthTokensResponse)(nil),   // 58: auth_v2.DeleteExpiredAuthTokensResponse
-	nil,                                       // 59: auth_v2.Roles.RolesEntry
-	nil,                                       // 60: auth_v2.RoleBinding.EntriesEntry
-	nil,                                       // 61: auth_v2.Users.UsernamesEntry
-	nil,                                       // 62: auth_v2.Groups.GroupsEntry
-	(*timestamppb.Timestamp)(nil),             // 63: google.protobuf.Timestamp
+	(*OIDCGroupSync)(nil),                     // 9: auth_v2.OIDCGroupSync
+	(*WorkloadIdentityProvider)(nil),          // 10: auth_v2.WorkloadIdentityProvider
+	(*WorkloadIdentityRule)(nil),              // 11: auth_v2.WorkloadIdentityRule
+	(*GetConfigurationRequest)(nil),           // 12: auth_v2.GetConfigurationRequest
+	(*GetConfigurationResponse)(nil),          // 13: auth_v2.GetConfigurationResponse
+	(*SetConfigurationRequest)(nil),           // 14: auth_v2.SetConfigurationRequest
+	(*SetConfigurationResponse)(nil),          // 15: auth_v2.SetConfigurationResponse
+	(*TokenInfo)(nil),                         // 16: auth_v2.TokenInfo
+	(*TokenScope)(nil),                        // 17: auth_v2.TokenScope
+	(*AuthenticateRequest)(nil),               // 18: auth_v2.AuthenticateRequest
+	(*AuthenticateResponse)(nil),              // 19: auth_v2.AuthenticateResponse
+	(*WhoAmIRequest)(nil),                     // 20: auth_v2.WhoAmIRequest
+	(*WhoAmIResponse)(nil),                    // 21: auth_v2.WhoAmIResponse
+	(*GetRolesForPermissionRequest)(nil),      // 22: auth_v2.GetRolesForPermissionRequest
+	(*GetRolesForPermissionResponse)(nil),     // 23: auth_v2.GetRolesForPermissionResponse
+	(*Roles)(nil),                             // 24: auth_v2.Roles
+	(*RoleBinding)(nil),                       // 25: auth_v2.RoleBinding
+	(*Resource)(nil),                          // 26: auth_v2.Resource
+	(*Users)(nil),                             // 27: auth_v2.Users
+	(*Groups)(nil),                            // 28: auth_v2.Groups
+	(*Role)(nil),                              // 29: auth_v2.Role
+	(*AuthorizeRequest)(nil),                  // 30: auth_v2.AuthorizeRequest
+	(*AuthorizeResponse)(nil),                 // 31: auth_v2.AuthorizeResponse
+	(*GetPermissionsRequest)(nil),             // 32: auth_v2.GetPermissionsRequest
+	(*GetPermissionsForPrincipalRequest)(nil), // 33: auth_v2.GetPermissionsForPrincipalRequest
+	(*GetPermissionsResponse)(nil),            // 34: auth_v2.GetPermissionsResponse
+	(*ModifyRoleBindingRequest)(nil),          // 35: auth_v2.ModifyRoleBindingRequest
+	(*ModifyRoleBindingResponse)(nil),         // 36: auth_v2.ModifyRoleBindingResponse
+	(*GetRoleBindingRequest)(nil),             // 37: auth_v2.GetRoleBindingRequest
+	(*GetRoleBindingResponse)(nil),            // 38: auth_v2.GetRoleBindingResponse
+	(*SessionInfo)(nil),                       // 39: auth_v2.SessionInfo
+	(*GetOIDCLoginRequest)(nil),               // 40: auth_v2.GetOIDCLoginRequest
+	(*GetOIDCLoginResponse)(nil),              // 41: auth_v2.GetOIDCLoginResponse
+	(*GetRobotTokenRequest)(nil),              // 42: auth_v2.GetRobotTokenRequest
+	(*GetRobotTokenResponse)(nil),             // 43: auth_v2.GetRobotTokenResponse
+	(*GetScopedTokenRequest)(nil),             // 44: auth_v2.GetScopedTokenRequest
+	(*GetScopedTokenResponse)(nil),            // 45: auth_v2.GetScopedTokenResponse
+	(*ListAuthTokensRequest)(nil),             // 46: auth_v2.ListAuthTokensRequest
+	(*ListAuthTokensResponse)(nil),            // 47: auth_v2.ListAuthTokensResponse
+	(*RevokeAuthTokenRequest)(nil),            // 48: auth_v2.RevokeAuthTokenRequest
+	(*RevokeAuthTokenResponse)(nil),           // 49: auth_v2.RevokeAuthTokenResponse
+	(*SetGroupsForUserRequest)(nil),           // 50: auth_v2.SetGroupsForUserRequest
+	(*SetGroupsForUserResponse)(nil),          // 51: auth_v2.SetGroupsForUserResponse
+	(*ModifyMembersRequest)(nil),              // 52: auth_v2.ModifyMembersRequest
+	(*ModifyMembersResponse)(nil),             // 53: auth_v2.ModifyMembersResponse
+	(*GetGroupsRequest)(nil),                  // 54: auth_v2.GetGroupsRequest
+	(*GetGroupsForPrincipalRequest)(nil),      // 55: auth_v2.GetGroupsForPrincipalRequest
+	(*GetGroupsResponse)(nil),                 // 56: auth_v2.GetGroupsResponse
+	(*GetUsersRequest)(nil),                   // 57: auth_v2.GetUsersRequest
+	(*GetUsersResponse)(nil),                  // 58: auth_v2.GetUsersResponse
+	(*ExtractAuthTokensRequest)(nil),          // 59: auth_v2.ExtractAuthTokensRequest
+	(*ExtractAuthTokensResponse)(nil),         // 60: auth_v2.ExtractAuthTokensResponse
+	(*RestoreAuthTokenRequest)(nil),           // 61: auth_v2.RestoreAuthTokenRequest
+	(*RestoreAuthTokenResponse)(nil),          // 62: auth_v2.RestoreAuthTokenResponse
+	(*RevokeAuthTokensForUserRequest)(nil),    // 63: auth_v2.RevokeAuthTokensForUserRequest
+	(*RevokeAuthTokensForUserResponse)(nil),   // 64: auth_v2.RevokeAuthTokensForUserResponse
+	(*DeleteExpiredAuthTokensRequest)(nil),    // 65: auth_v2.DeleteExpiredAuthTokensRequest
+	(*DeleteExpiredAuthTokensResponse)(nil),   // 66: auth_v2.DeleteExpiredAuthTokensResponse
+	nil,                                       // 67: auth_v2.WorkloadIdentityRule.ClaimsEntry
+	nil,                                       // 68: auth_v2.Roles.RolesEntry
+	nil,                                       // 69: auth_v2.RoleBinding.EntriesEntry
+	nil,                                       // 70: auth_v2.Users.UsernamesEntry
+	nil,                                       // 71: auth_v2.Groups.GroupsEntry
+	(*timestamppb.Timestamp)(nil),             // 72: google.protobuf.Timestamp
 }
 var file_auth_auth_proto_depIdxs = []int32{
-	8,  // 0: auth_v2.GetConfigurationResponse.configuration:type_name -> auth_v2.OIDCConfig
-	8,  // 1: auth_v2.SetConfigurationRequest.configuration:type_name -> auth_v2.OIDCConfig
-	63, // 2: auth_v2.TokenInfo.expiration:type_name -> google.protobuf.Timestamp
-	63, // 3: auth_v2.WhoAmIResponse.expiration:type_name -> google.protobuf.Timestamp
-	0,  // 4: auth_v2.GetRolesForPermissionRequest.permission:type_name -> auth_v2.Permission
-	25, // 5: auth_v2.GetRolesForPermissionResponse.roles:type_name -> auth_v2.Role
-	59, // 6: auth_v2.Roles.roles:type_name -> auth_v2.Roles.RolesEntry
-	60, // 7: auth_v2.RoleBinding.entries:type_name -> auth_v2.RoleBinding.EntriesEntry
-	1,  // 8: auth_v2.Resource.type:type_name -> auth_v2.ResourceType
-	61, // 9: auth_v2.Users.usernames:type_name -> auth_v2.Users.UsernamesEntry
-	62, // 10: auth_v2.Groups.groups:type_name -> auth_v2.Groups.GroupsEntry
-	0,  // 11: auth_v2.Role.permissions:type_name -> auth_v2.Permission
-	1,  // 12: auth_v2.Role.can_be_bound_to:type_name -> auth_v2.ResourceType
-	1,  // 13: auth_v2.Role.returned_for:type_name -> auth_v2.ResourceType
-	22, // 14: auth_v2.AuthorizeRequest.resource:type_name -> auth_v2.Resource
-	0,  // 15: auth_v2.AuthorizeRequest.permissions:type_name -> auth_v2.Permission
-	0,  // 16: auth_v2.AuthorizeResponse.satisfied:type_name -> auth_v2.Permission
-	0,  // 17: auth_v2.AuthorizeResponse.missing:type_name -> auth_v2.Permission
-	22, // 18: auth_v2.GetPermissionsRequest.resource:type_name -> auth_v2.Resource
-	22, // 19: auth_v2.GetPermissionsForPrincipalRequest.resource:type_name -> auth_v2.Resource
-	0,  // 20: auth_v2.GetPermissionsResponse.permissions:type_name -> auth_v2.Permission
-	22, // 21: auth_v2.ModifyRoleBindingRequest.resource:type_name -> auth_v2.Resource
-	22, // 22: auth_v2.GetRoleBindingRequest.resource:type_name -> auth_v2.Resource
-	21, // 23: auth_v2.GetRoleBindingResponse.binding:type_name -> auth_v2.RoleBinding
-	13, // 24: auth_v2.ExtractAuthTokensResponse.tokens:type_name -> auth_v2.TokenInfo
-	13, // 25: auth_v2.RestoreAuthTokenRequest.token:type_name -> auth_v2.TokenInfo
-	20, // 26: auth_v2.RoleBinding.EntriesEntry.value:type_name -> auth_v2.Roles
-	2,  // 27: auth_v2.API.Activate:input_type -> auth_v2.ActivateRequest
-	4,  // 28: auth_v2.API.Deactivate:input_type -> auth_v2.DeactivateRequest
-	9,  // 29: auth_v2.API.GetConfiguration:input_type -> auth_v2.GetConfigurationRequest
-	11, // 30: auth_v2.API.SetConfiguration:input_type -> auth_v2.SetConfigurationRequest
-	14, // 31: auth_v2.API.Authenticate:input_type -> auth_v2.AuthenticateRequest
-	26, // 32: auth_v2.API.Authorize:input_type -> auth_v2.AuthorizeRequest
-	28, // 33: auth_v2.API.GetPermissions:input_type -> auth_v2.GetPermissionsRequest
-	29, // 34: auth_v2.API.GetPermissionsForPrincipal:input_type -> auth_v2.GetPermissionsForPrincipalRequest
-	16, // 35: auth_v2.API.WhoAmI:input_type -> auth_v2.WhoAmIRequest
-	18, // 36: auth_v2.API.GetRolesForPermission:input_type -> auth_v2.GetRolesForPermissionRequest
-	31, // 37: auth_v2.API.ModifyRoleBinding:input_type -> auth_v2.ModifyRoleBindingRequest
-	33, // 38: auth_v2.API.GetRoleBinding:input_type -> auth_v2.GetRoleBindingRequest
-	36, // 39: auth_v2.API.GetOIDCLogin:input_type -> auth_v2.GetOIDCLoginRequest
-	38, // 40: auth_v2.API.GetRobotToken:input_type -> auth_v2.GetRobotTokenRequest
-	40, // 41: auth_v2.API.RevokeAuthToken:input_type -> auth_v2.RevokeAuthTokenRequest
-	55, // 42: auth_v2.API.RevokeAuthTokensForUser:input_type -> auth_v2.RevokeAuthTokensForUserRequest
-	42, // 43: auth_v2.API.SetGroupsForUser:input_type -> auth_v2.SetGroupsForUserRequest
-	44, // 44: auth_v2.API.ModifyMembers:input_type -> auth_v2.ModifyMembersRequest
-	46, // 45: auth_v2.API.GetGroups:input_type -> auth_v2.GetGroupsRequest
-	47, // 46: auth_v2.API.GetGroupsForPrincipal:input_type -> auth_v2.GetGroupsForPrincipalRequest
-	49, // 47: auth_v2.API.GetUsers:input_type -> auth_v2.GetUsersRequest
-	51, // 48: auth_v2.API.ExtractAuthTokens:input_type -> auth_v2.ExtractAuthTokensRequest
-	53, // 49: auth_v2.API.RestoreAuthToken:input_type -> auth_v2.RestoreAuthTokenRequest
-	57, // 50: auth_v2.API.DeleteExpiredAuthTokens:input_type -> auth_v2.DeleteExpiredAuthTokensRequest
-	6,  // 51: auth_v2.API.RotateRootToken:input_type -> auth_v2.RotateRootTokenRequest
-	3,  // 52: auth_v2.API.Activate:output_type -> auth_v2.ActivateResponse
-	5,  // 53: auth_v2.API.Deactivate:output_type -> auth_v2.DeactivateResponse
-	10, // 54: auth_v2.API.GetConfiguration:output_type -> auth_v2.GetConfigurationResponse
-	12, // 55: auth_v2.API.SetConfiguration:output_type -> auth_v2.SetConfigurationResponse
-	15, // 56: auth_v2.API.Authenticate:output_type -> auth_v2.AuthenticateResponse
-	27, // 57: auth_v2.API.Authorize:output_type -> auth_v2.AuthorizeResponse
-	30, // 58: auth_v2.API.GetPermissions:output_type -> auth_v2.GetPermissionsResponse
-	30, // 59: auth_v2.API.GetPermissionsForPrincipal:output_type -> auth_v2.GetPermissionsResponse
-	17, // 60: auth_v2.API.WhoAmI:output_type -> auth_v2.WhoAmIResponse
-	19, // 61: auth_v2.API.GetRolesForPermission:output_type -> auth_v2.GetRolesForPermissionResponse
-	32, // 62: auth_v2.API.ModifyRoleBinding:output_type -> auth_v2.ModifyRoleBindingResponse
-	34, // 63: auth_v2.API.GetRoleBinding:output_type -> auth_v2.GetRoleBindingResponse
-	37, // 64: auth_v2.API.GetOIDCLogin:output_type -> auth_v2.GetOIDCLoginResponse
-	39, // 65: auth_v2.API.GetRobotToken:output_type -> auth_v2.GetRobotTokenResponse
-	41, // 66: auth_v2.API.RevokeAuthToken:output_type -> auth_v2.RevokeAuthTokenResponse
-	56, // 67: auth_v2.API.RevokeAuthTokensForUser:output_type -> auth_v2.RevokeAuthTokensForUserResponse
-	43, // 68: auth_v2.API.SetGroupsForUser:output_type -> auth_v2.SetGroupsForUserResponse
-	45, // 69: auth_v2.API.ModifyMembers:output_type -> auth_v2.ModifyMembersResponse
-	48, // 70: auth_v2.API.GetGroups:output_type -> auth_v2.GetGroupsResponse
-	48, // 71: auth_v2.API.GetGroupsForPrincipal:output_type -> auth_v2.GetGroupsResponse
-	50, // 72: auth_v2.API.GetUsers:output_type -> auth_v2.GetUsersResponse
-	52, // 73: auth_v2.API.ExtractAuthTokens:output_type -> auth_v2.ExtractAuthTokensResponse
-	54, // 74: auth_v2.API.RestoreAuthToken:output_type -> auth_v2.RestoreAuthTokenResponse
-	58, // 75: auth_v2.API.DeleteExpiredAuthTokens:output_type -> auth_v2.DeleteExpiredAuthTokensResponse
-	7,  // 76: auth_v2.API.RotateRootToken:output_type -> auth_v2.RotateRootTokenResponse
-	52, // [52:77] is the sub-list for method output_type
-	27, // [27:52] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	10, // 0: auth_v2.OIDCConfig.workload_identity_providers:type_name -> auth_v2.WorkloadIdentityProvider
+	9,  // 1: auth_v2.OIDCConfig.group_sync:type_name -> auth_v2.OIDCGroupSync
+	11, // 2: auth_v2.WorkloadIdentityProvider.rules:type_name -> auth_v2.WorkloadIdentityRule
+	67, // 3: auth_v2.WorkloadIdentityRule.claims:type_name -> auth_v2.WorkloadIdentityRule.ClaimsEntry
+	8,  // 4: auth_v2.GetConfigurationResponse.configuration:type_name -> auth_v2.OIDCConfig
+	8,  // 5: auth_v2.SetConfigurationRequest.configuration:type_name -> auth_v2.OIDCConfig
+	72, // 6: auth_v2.TokenInfo.expiration:type_name -> google.protobuf.Timestamp
+	17, // 7: auth_v2.TokenInfo.scopes:type_name -> auth_v2.TokenScope
+	72, // 8: auth_v2.TokenInfo.created_at:type_name -> google.protobuf.Timestamp
+	26, // 9: auth_v2.TokenScope.resource:type_name -> auth_v2.Resource
+	0,  // 10: auth_v2.TokenScope.permissions:type_name -> auth_v2.Permission
+	72, // 11: auth_v2.WhoAmIResponse.expiration:type_name -> google.protobuf.Timestamp
+	17, // 12: auth_v2.WhoAmIResponse.scopes:type_name -> auth_v2.TokenScope
+	0,  // 13: auth_v2.GetRolesForPermissionRequest.permission:type_name -> auth_v2.Permission
+	29, // 14: auth_v2.GetRolesForPermissionResponse.roles:type_name -> auth_v2.Role
+	68, // 15: auth_v2.Roles.roles:type_name -> auth_v2.Roles.RolesEntry
+	72, // 16: auth_v2.Roles.expiration:type_name -> google.protobuf.Timestamp
+	69, // 17: auth_v2.RoleBinding.entries:type_name -> auth_v2.RoleBinding.EntriesEntry
+	1,  // 18: auth_v2.Resource.type:type_name -> auth_v2.ResourceType
+	70, // 19: auth_v2.Users.usernames:type_name -> auth_v2.Users.UsernamesEntry
+	71, // 20: auth_v2.Groups.groups:type_name -> auth_v2.Groups.GroupsEntry
+	0,  // 21: auth_v2.Role.permissions:type_name -> auth_v2.Permission
+	1,  // 22: auth_v2.Role.can_be_bound_to:type_name -> auth_v2.ResourceType
+	1,  // 23: auth_v2.Role.returned_for:type_name -> auth_v2.ResourceType
+	26, // 24: auth_v2.AuthorizeRequest.resource:type_name -> auth_v2.Resource
+	0,  // 25: auth_v2.AuthorizeRequest.permissions:type_name -> auth_v2.Permission
+	0,  // 26: auth_v2.AuthorizeResponse.satisfied:type_name -> auth_v2.Permission
+	0,  // 27: auth_v2.AuthorizeResponse.missing:type_name -> auth_v2.Permission
+	26, // 28: auth_v2.GetPermissionsRequest.resource:type_name -> auth_v2.Resource
+	26, // 29: auth_v2.GetPermissionsForPrincipalRequest.resource:type_name -> auth_v2.Resource
+	0,  // 30: auth_v2.GetPermissionsResponse.permissions:type_name -> auth_v2.Permission
+	26, // 31: auth_v2.ModifyRoleBindingRequest.resource:type_name -> auth_v2.Resource
+	72, // 32: auth_v2.ModifyRoleBindingRequest.expiration:type_name -> google.protobuf.Timestamp
+	26, // 33: auth_v2.GetRoleBindingRequest.resource:type_name -> auth_v2.Resource
+	25, // 34: auth_v2.GetRoleBindingResponse.binding:type_name -> auth_v2.RoleBinding
+	17, // 35: auth_v2.GetRobotTokenRequest.scopes:type_name -> auth_v2.TokenScope
+	17, // 36: auth_v2.GetScopedTokenRequest.scopes:type_name -> auth_v2.TokenScope
+	16, // 37: auth_v2.ListAuthTokensResponse.tokens:type_name -> auth_v2.TokenInfo
+	16, // 38: auth_v2.ExtractAuthTokensResponse.tokens:type_name -> auth_v2.TokenInfo
+	16, // 39: auth_v2.RestoreAuthTokenRequest.token:type_name -> auth_v2.TokenInfo
+	24, // 40: auth_v2.RoleBinding.EntriesEntry.value:type_name -> auth_v2.Roles
+	2,  // 41: auth_v2.API.Activate:input_type -> auth_v2.ActivateRequest
+	4,  // 42: auth_v2.API.Deactivate:input_type -> auth_v2.DeactivateRequest
+	12, // 43: auth_v2.API.GetConfiguration:input_type -> auth_v2.GetConfigurationRequest
+	14, // 44: auth_v2.API.SetConfiguration:input_type -> auth_v2.SetConfigurationRequest
+	18, // 45: auth_v2.API.Authenticate:input_type -> auth_v2.AuthenticateRequest
+	30, // 46: auth_v2.API.Authorize:input_type -> auth_v2.AuthorizeRequest
+	32, // 47: auth_v2.API.GetPermissions:input_type -> auth_v2.GetPermissionsRequest
+	33, // 48: auth_v2.API.GetPermissionsForPrincipal:input_type -> auth_v2.GetPermissionsForPrincipalRequest
+	20, // 49: auth_v2.API.WhoAmI:input_type -> auth_v2.WhoAmIRequest
+	22, // 50: auth_v2.API.GetRolesForPermission:input_type -> auth_v2.GetRolesForPermissionRequest
+	35, // 51: auth_v2.API.ModifyRoleBinding:input_type -> auth_v2.ModifyRoleBindingRequest
+	37, // 52: auth_v2.API.GetRoleBinding:input_type -> auth_v2.GetRoleBindingRequest
+	40, // 53: auth_v2.API.GetOIDCLogin:input_type -> auth_v2.GetOIDCLoginRequest
+	42, // 54: auth_v2.API.GetRobotToken:input_type -> auth_v2.GetRobotTokenRequest
+	44, // 55: auth_v2.API.GetScopedToken:input_type -> auth_v2.GetScopedTokenRequest
+	46, // 56: auth_v2.API.ListAuthTokens:input_type -> auth_v2.ListAuthTokensRequest
+	48, // 57: auth_v2.API.RevokeAuthToken:input_type -> auth_v2.RevokeAuthTokenRequest
+	63, // 58: auth_v2.API.RevokeAuthTokensForUser:input_type -> auth_v2.RevokeAuthTokensForUserRequest
+	50, // 59: auth_v2.API.SetGroupsForUser:input_type -> auth_v2.SetGroupsForUserRequest
+	52, // 60: auth_v2.API.ModifyMembers:input_type -> auth_v2.ModifyMembersRequest
+	54, // 61: auth_v2.API.GetGroups:input_type -> auth_v2.GetGroupsRequest
+	55, // 62: auth_v2.API.GetGroupsForPrincipal:input_type -> auth_v2.GetGroupsForPrincipalRequest
+	57, // 63: auth_v2.API.GetUsers:input_type -> auth_v2.GetUsersRequest
+	59, // 64: auth_v2.API.ExtractAuthTokens:input_type -> auth_v2.ExtractAuthTokensRequest
+	61, // 65: auth_v2.API.RestoreAuthToken:input_type -> auth_v2.RestoreAuthTokenRequest
+	65, // 66: auth_v2.API.DeleteExpiredAuthTokens:input_type -> auth_v2.DeleteExpiredAuthTokensRequest
+	6,  // 67: auth_v2.API.RotateRootToken:input_type -> auth_v2.RotateRootTokenRequest
+	3,  // 68: auth_v2.API.Activate:output_type -> auth_v2.ActivateResponse
+	5,  // 69: auth_v2.API.Deactivate:output_type -> auth_v2.DeactivateResponse
+	13, // 70: auth_v2.API.GetConfiguration:output_type -> auth_v2.GetConfigurationResponse
+	15, // 71: auth_v2.API.SetConfiguration:output_type -> auth_v2.SetConfigurationResponse
+	19, // 72: auth_v2.API.Authenticate:output_type -> auth_v2.AuthenticateResponse
+	31, // 73: auth_v2.API.Authorize:output_type -> auth_v2.AuthorizeResponse
+	34, // 74: auth_v2.API.GetPermissions:output_type -> auth_v2.GetPermissionsResponse
+	34, // 75: auth_v2.API.GetPermissionsForPrincipal:output_type -> auth_v2.GetPermissionsResponse
+	21, // 76: auth_v2.API.WhoAmI:output_type -> auth_v2.WhoAmIResponse
+	23, // 77: auth_v2.API.GetRolesForPermission:output_type -> auth_v2.GetRolesForPermissionResponse
+	36, // 78: auth_v2.API.ModifyRoleBinding:output_type -> auth_v2.ModifyRoleBindingResponse
+	38, // 79: auth_v2.API.GetRoleBinding:output_type -> auth_v2.GetRoleBindingResponse
+	41, // 80: auth_v2.API.GetOIDCLogin:output_type -> auth_v2.GetOIDCLoginResponse
+	43, // 81: auth_v2.API.GetRobotToken:output_type -> auth_v2.GetRobotTokenResponse
+	45, // 82: auth_v2.API.GetScopedToken:output_type -> auth_v2.GetScopedTokenResponse
+	47, // 83: auth_v2.API.ListAuthTokens:output_type -> auth_v2.ListAuthTokensResponse
+	49, // 84: auth_v2.API.RevokeAuthToken:output_type -> auth_v2.RevokeAuthTokenResponse
+	64, // 85: auth_v2.API.RevokeAuthTokensForUser:output_type -> auth_v2.RevokeAuthTokensForUserResponse
+	51, // 86: auth_v2.API.SetGroupsForUser:output_type -> auth_v2.SetGroupsForUserResponse
+	53, // 87: auth_v2.API.ModifyMembers:output_type -> auth_v2.ModifyMembersResponse
+	56, // 88: auth_v2.API.GetGroups:output_type -> auth_v2.GetGroupsResponse
+	56, // 89: auth_v2.API.GetGroupsForPrincipal:output_type -> auth_v2.GetGroupsResponse
+	58, // 90: auth_v2.API.GetUsers:output_type -> auth_v2.GetUsersResponse
+	60, // 91: auth_v2.API.ExtractAuthTokens:output_type -> auth_v2.ExtractAuthTokensResponse
+	62, // 92: auth_v2.API.RestoreAuthToken:output_type -> auth_v2.RestoreAuthTokenResponse
+	66, // 93: auth_v2.API.DeleteExpiredAuthTokens:output_type -> auth_v2.DeleteExpiredAuthTokensResponse
+	7,  // 94: auth_v2.API.RotateRootToken:output_type -> auth_v2.RotateRootTokenResponse
+	68, // [68:95] is the sub-list for method output_type
+	41, // [41:68] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_auth_auth_proto_init() }
@@ -4031,7 +4841,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigurationRequest); i {
+			switch v := v.(*OIDCGroupSync); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4043,7 +4853,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigurationResponse); i {
+			switch v := v.(*WorkloadIdentityProvider); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4055,7 +4865,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetConfigurationRequest); i {
+			switch v := v.(*WorkloadIdentityRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4067,7 +4877,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetConfigurationResponse); i {
+			switch v := v.(*GetConfigurationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4079,7 +4889,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenInfo); i {
+			switch v := v.(*GetConfigurationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4091,7 +4901,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthenticateRequest); i {
+			switch v := v.(*SetConfigurationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4103,7 +4913,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthenticateResponse); i {
+			switch v := v.(*SetConfigurationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4115,7 +4925,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WhoAmIRequest); i {
+			switch v := v.(*TokenInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4127,7 +4937,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WhoAmIResponse); i {
+			switch v := v.(*TokenScope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4139,7 +4949,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRolesForPermissionRequest); i {
+			switch v := v.(*AuthenticateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4151,7 +4961,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRolesForPermissionResponse); i {
+			switch v := v.(*AuthenticateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4163,7 +4973,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Roles); i {
+			switch v := v.(*WhoAmIRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4175,7 +4985,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoleBinding); i {
+			switch v := v.(*WhoAmIResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4187,7 +4997,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Resource); i {
+			switch v := v.(*GetRolesForPermissionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4199,7 +5009,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Users); i {
+			switch v := v.(*GetRolesForPermissionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4211,7 +5021,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Groups); i {
+			switch v := v.(*Roles); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4223,7 +5033,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Role); i {
+			switch v := v.(*RoleBinding); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4235,7 +5045,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthorizeRequest); i {
+			switch v := v.(*Resource); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4247,7 +5057,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthorizeResponse); i {
+			switch v := v.(*Users); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4259,7 +5069,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPermissionsRequest); i {
+			switch v := v.(*Groups); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4271,7 +5081,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPermissionsForPrincipalRequest); i {
+			switch v := v.(*Role); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4283,7 +5093,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPermissionsResponse); i {
+			switch v := v.(*AuthorizeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4295,7 +5105,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModifyRoleBindingRequest); i {
+			switch v := v.(*AuthorizeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4307,7 +5117,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModifyRoleBindingResponse); i {
+			switch v := v.(*GetPermissionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4319,7 +5129,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRoleBindingRequest); i {
+			switch v := v.(*GetPermissionsForPrincipalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4331,7 +5141,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRoleBindingResponse); i {
+			switch v := v.(*GetPermissionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4343,7 +5153,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SessionInfo); i {
+			switch v := v.(*ModifyRoleBindingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4355,7 +5165,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOIDCLoginRequest); i {
+			switch v := v.(*ModifyRoleBindingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4367,7 +5177,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOIDCLoginResponse); i {
+			switch v := v.(*GetRoleBindingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4379,7 +5189,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRobotTokenRequest); i {
+			switch v := v.(*GetRoleBindingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4391,7 +5201,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRobotTokenResponse); i {
+			switch v := v.(*SessionInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4403,7 +5213,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RevokeAuthTokenRequest); i {
+			switch v := v.(*GetOIDCLoginRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4415,7 +5225,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RevokeAuthTokenResponse); i {
+			switch v := v.(*GetOIDCLoginResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4427,7 +5237,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetGroupsForUserRequest); i {
+			switch v := v.(*GetRobotTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4439,7 +5249,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetGroupsForUserResponse); i {
+			switch v := v.(*GetRobotTokenResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4451,7 +5261,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModifyMembersRequest); i {
+			switch v := v.(*GetScopedTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4463,7 +5273,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModifyMembersResponse); i {
+			switch v := v.(*GetScopedTokenResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4475,7 +5285,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGroupsRequest); i {
+			switch v := v.(*ListAuthTokensRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4487,7 +5297,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGroupsForPrincipalRequest); i {
+			switch v := v.(*ListAuthTokensResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4499,7 +5309,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGroupsResponse); i {
+			switch v := v.(*RevokeAuthTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4511,7 +5321,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUsersRequest); i {
+			switch v := v.(*RevokeAuthTokenResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4523,7 +5333,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUsersResponse); i {
+			switch v := v.(*SetGroupsForUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4535,7 +5345,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExtractAuthTokensRequest); i {
+			switch v := v.(*SetGroupsForUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4547,7 +5357,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExtractAuthTokensResponse); i {
+			switch v := v.(*ModifyMembersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4559,7 +5369,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestoreAuthTokenRequest); i {
+			switch v := v.(*ModifyMembersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4571,7 +5381,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestoreAuthTokenResponse); i {
+			switch v := v.(*GetGroupsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4583,7 +5393,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RevokeAuthTokensForUserRequest); i {
+			switch v := v.(*GetGroupsForPrincipalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4595,7 +5405,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RevokeAuthTokensForUserResponse); i {
+			switch v := v.(*GetGroupsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4607,7 +5417,7 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteExpiredAuthTokensRequest); i {
+			switch v := v.(*GetUsersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4619,6 +5429,102 @@ func file_auth_auth_proto_init() {
 			}
 		}
 		file_auth_auth_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_auth_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtractAuthTokensRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_auth_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtractAuthTokensResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_auth_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreAuthTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_auth_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreAuthTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_auth_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeAuthTokensForUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_auth_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeAuthTokensForUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_auth_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteExpiredAuthTokensRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_auth_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteExpiredAuthTokensResponse); i {
 			case 0:
 				return &v.state
@@ -4637,7 +5543,7 @@ func file_auth_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_auth_auth_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   61,
+			NumMessages:   70,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
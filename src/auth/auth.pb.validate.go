@@ -687,6 +687,69 @@ func (m *OIDCConfig) validate(all bool) error {
 
 	// no validation rules for UserAccessibleIssuerHost
 
+	for idx, item := range m.GetWorkloadIdentityProviders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OIDCConfigValidationError{
+						field:  fmt.Sprintf("WorkloadIdentityProviders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OIDCConfigValidationError{
+						field:  fmt.Sprintf("WorkloadIdentityProviders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OIDCConfigValidationError{
+					field:  fmt.Sprintf("WorkloadIdentityProviders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetGroupSync()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OIDCConfigValidationError{
+					field:  "GroupSync",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OIDCConfigValidationError{
+					field:  "GroupSync",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGroupSync()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OIDCConfigValidationError{
+				field:  "GroupSync",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return OIDCConfigMultiError(errors)
 	}
@@ -764,42 +827,48 @@ var _ interface {
 	ErrorName() string
 } = OIDCConfigValidationError{}
 
-// Validate checks the field values on GetConfigurationRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetConfigurationRequest) Validate() error {
+// Validate checks the field values on OIDCGroupSync with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OIDCGroupSync) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetConfigurationRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetConfigurationRequestMultiError, or nil if none found.
-func (m *GetConfigurationRequest) ValidateAll() error {
+// ValidateAll checks the field values on OIDCGroupSync with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OIDCGroupSyncMultiError, or
+// nil if none found.
+func (m *OIDCGroupSync) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConfigurationRequest) validate(all bool) error {
+func (m *OIDCGroupSync) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Claim
+
+	// no validation rules for Prefix
+
+	// no validation rules for Disabled
+
 	if len(errors) > 0 {
-		return GetConfigurationRequestMultiError(errors)
+		return OIDCGroupSyncMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConfigurationRequestMultiError is an error wrapping multiple validation
-// errors returned by GetConfigurationRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetConfigurationRequestMultiError []error
+// OIDCGroupSyncMultiError is an error wrapping multiple validation errors
+// returned by OIDCGroupSync.ValidateAll() if the designated constraints
+// aren't met.
+type OIDCGroupSyncMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConfigurationRequestMultiError) Error() string {
+func (m OIDCGroupSyncMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -808,11 +877,11 @@ func (m GetConfigurationRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConfigurationRequestMultiError) AllErrors() []error { return m }
+func (m OIDCGroupSyncMultiError) AllErrors() []error { return m }
 
-// GetConfigurationRequestValidationError is the validation error returned by
-// GetConfigurationRequest.Validate if the designated constraints aren't met.
-type GetConfigurationRequestValidationError struct {
+// OIDCGroupSyncValidationError is the validation error returned by
+// OIDCGroupSync.Validate if the designated constraints aren't met.
+type OIDCGroupSyncValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -820,24 +889,22 @@ type GetConfigurationRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConfigurationRequestValidationError) Field() string { return e.field }
+func (e OIDCGroupSyncValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConfigurationRequestValidationError) Reason() string { return e.reason }
+func (e OIDCGroupSyncValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConfigurationRequestValidationError) Cause() error { return e.cause }
+func (e OIDCGroupSyncValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConfigurationRequestValidationError) Key() bool { return e.key }
+func (e OIDCGroupSyncValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConfigurationRequestValidationError) ErrorName() string {
-	return "GetConfigurationRequestValidationError"
-}
+func (e OIDCGroupSyncValidationError) ErrorName() string { return "OIDCGroupSyncValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetConfigurationRequestValidationError) Error() string {
+func (e OIDCGroupSyncValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -849,14 +916,14 @@ func (e GetConfigurationRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConfigurationRequest.%s: %s%s",
+		"invalid %sOIDCGroupSync.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConfigurationRequestValidationError{}
+var _ error = OIDCGroupSyncValidationError{}
 
 var _ interface {
 	Field() string
@@ -864,73 +931,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConfigurationRequestValidationError{}
+} = OIDCGroupSyncValidationError{}
 
-// Validate checks the field values on GetConfigurationResponse with the rules
+// Validate checks the field values on WorkloadIdentityProvider with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetConfigurationResponse) Validate() error {
+func (m *WorkloadIdentityProvider) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetConfigurationResponse with the
+// ValidateAll checks the field values on WorkloadIdentityProvider with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetConfigurationResponseMultiError, or nil if none found.
-func (m *GetConfigurationResponse) ValidateAll() error {
+// WorkloadIdentityProviderMultiError, or nil if none found.
+func (m *WorkloadIdentityProvider) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConfigurationResponse) validate(all bool) error {
+func (m *WorkloadIdentityProvider) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetConfiguration()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetConfigurationResponseValidationError{
-					field:  "Configuration",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for Issuer
+
+	// no validation rules for JwksUrl
+
+	// no validation rules for KubernetesApi
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WorkloadIdentityProviderValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WorkloadIdentityProviderValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetConfigurationResponseValidationError{
-					field:  "Configuration",
+				return WorkloadIdentityProviderValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetConfiguration()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetConfigurationResponseValidationError{
-				field:  "Configuration",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return GetConfigurationResponseMultiError(errors)
+		return WorkloadIdentityProviderMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConfigurationResponseMultiError is an error wrapping multiple validation
-// errors returned by GetConfigurationResponse.ValidateAll() if the designated
+// WorkloadIdentityProviderMultiError is an error wrapping multiple validation
+// errors returned by WorkloadIdentityProvider.ValidateAll() if the designated
 // constraints aren't met.
-type GetConfigurationResponseMultiError []error
+type WorkloadIdentityProviderMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConfigurationResponseMultiError) Error() string {
+func (m WorkloadIdentityProviderMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -939,11 +1017,11 @@ func (m GetConfigurationResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConfigurationResponseMultiError) AllErrors() []error { return m }
+func (m WorkloadIdentityProviderMultiError) AllErrors() []error { return m }
 
-// GetConfigurationResponseValidationError is the validation error returned by
-// GetConfigurationResponse.Validate if the designated constraints aren't met.
-type GetConfigurationResponseValidationError struct {
+// WorkloadIdentityProviderValidationError is the validation error returned by
+// WorkloadIdentityProvider.Validate if the designated constraints aren't met.
+type WorkloadIdentityProviderValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -951,24 +1029,24 @@ type GetConfigurationResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConfigurationResponseValidationError) Field() string { return e.field }
+func (e WorkloadIdentityProviderValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConfigurationResponseValidationError) Reason() string { return e.reason }
+func (e WorkloadIdentityProviderValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConfigurationResponseValidationError) Cause() error { return e.cause }
+func (e WorkloadIdentityProviderValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConfigurationResponseValidationError) Key() bool { return e.key }
+func (e WorkloadIdentityProviderValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConfigurationResponseValidationError) ErrorName() string {
-	return "GetConfigurationResponseValidationError"
+func (e WorkloadIdentityProviderValidationError) ErrorName() string {
+	return "WorkloadIdentityProviderValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetConfigurationResponseValidationError) Error() string {
+func (e WorkloadIdentityProviderValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -980,14 +1058,14 @@ func (e GetConfigurationResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConfigurationResponse.%s: %s%s",
+		"invalid %sWorkloadIdentityProvider.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConfigurationResponseValidationError{}
+var _ error = WorkloadIdentityProviderValidationError{}
 
 var _ interface {
 	Field() string
@@ -995,73 +1073,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConfigurationResponseValidationError{}
+} = WorkloadIdentityProviderValidationError{}
 
-// Validate checks the field values on SetConfigurationRequest with the rules
+// Validate checks the field values on WorkloadIdentityRule with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetConfigurationRequest) Validate() error {
+func (m *WorkloadIdentityRule) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetConfigurationRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on WorkloadIdentityRule with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetConfigurationRequestMultiError, or nil if none found.
-func (m *SetConfigurationRequest) ValidateAll() error {
+// WorkloadIdentityRuleMultiError, or nil if none found.
+func (m *WorkloadIdentityRule) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetConfigurationRequest) validate(all bool) error {
+func (m *WorkloadIdentityRule) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetConfiguration()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SetConfigurationRequestValidationError{
-					field:  "Configuration",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SetConfigurationRequestValidationError{
-					field:  "Configuration",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetConfiguration()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SetConfigurationRequestValidationError{
-				field:  "Configuration",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Claims
+
+	// no validation rules for Principal
 
 	if len(errors) > 0 {
-		return SetConfigurationRequestMultiError(errors)
+		return WorkloadIdentityRuleMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetConfigurationRequestMultiError is an error wrapping multiple validation
-// errors returned by SetConfigurationRequest.ValidateAll() if the designated
+// WorkloadIdentityRuleMultiError is an error wrapping multiple validation
+// errors returned by WorkloadIdentityRule.ValidateAll() if the designated
 // constraints aren't met.
-type SetConfigurationRequestMultiError []error
+type WorkloadIdentityRuleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetConfigurationRequestMultiError) Error() string {
+func (m WorkloadIdentityRuleMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1070,11 +1123,11 @@ func (m SetConfigurationRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetConfigurationRequestMultiError) AllErrors() []error { return m }
+func (m WorkloadIdentityRuleMultiError) AllErrors() []error { return m }
 
-// SetConfigurationRequestValidationError is the validation error returned by
-// SetConfigurationRequest.Validate if the designated constraints aren't met.
-type SetConfigurationRequestValidationError struct {
+// WorkloadIdentityRuleValidationError is the validation error returned by
+// WorkloadIdentityRule.Validate if the designated constraints aren't met.
+type WorkloadIdentityRuleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1082,24 +1135,24 @@ type SetConfigurationRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetConfigurationRequestValidationError) Field() string { return e.field }
+func (e WorkloadIdentityRuleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetConfigurationRequestValidationError) Reason() string { return e.reason }
+func (e WorkloadIdentityRuleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetConfigurationRequestValidationError) Cause() error { return e.cause }
+func (e WorkloadIdentityRuleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetConfigurationRequestValidationError) Key() bool { return e.key }
+func (e WorkloadIdentityRuleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetConfigurationRequestValidationError) ErrorName() string {
-	return "SetConfigurationRequestValidationError"
+func (e WorkloadIdentityRuleValidationError) ErrorName() string {
+	return "WorkloadIdentityRuleValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetConfigurationRequestValidationError) Error() string {
+func (e WorkloadIdentityRuleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1111,14 +1164,14 @@ func (e SetConfigurationRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetConfigurationRequest.%s: %s%s",
+		"invalid %sWorkloadIdentityRule.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetConfigurationRequestValidationError{}
+var _ error = WorkloadIdentityRuleValidationError{}
 
 var _ interface {
 	Field() string
@@ -1126,24 +1179,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetConfigurationRequestValidationError{}
+} = WorkloadIdentityRuleValidationError{}
 
-// Validate checks the field values on SetConfigurationResponse with the rules
+// Validate checks the field values on GetConfigurationRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetConfigurationResponse) Validate() error {
+func (m *GetConfigurationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetConfigurationResponse with the
+// ValidateAll checks the field values on GetConfigurationRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetConfigurationResponseMultiError, or nil if none found.
-func (m *SetConfigurationResponse) ValidateAll() error {
+// GetConfigurationRequestMultiError, or nil if none found.
+func (m *GetConfigurationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetConfigurationResponse) validate(all bool) error {
+func (m *GetConfigurationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1151,19 +1204,19 @@ func (m *SetConfigurationResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return SetConfigurationResponseMultiError(errors)
+		return GetConfigurationRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetConfigurationResponseMultiError is an error wrapping multiple validation
-// errors returned by SetConfigurationResponse.ValidateAll() if the designated
+// GetConfigurationRequestMultiError is an error wrapping multiple validation
+// errors returned by GetConfigurationRequest.ValidateAll() if the designated
 // constraints aren't met.
-type SetConfigurationResponseMultiError []error
+type GetConfigurationRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetConfigurationResponseMultiError) Error() string {
+func (m GetConfigurationRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1172,11 +1225,11 @@ func (m SetConfigurationResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetConfigurationResponseMultiError) AllErrors() []error { return m }
+func (m GetConfigurationRequestMultiError) AllErrors() []error { return m }
 
-// SetConfigurationResponseValidationError is the validation error returned by
-// SetConfigurationResponse.Validate if the designated constraints aren't met.
-type SetConfigurationResponseValidationError struct {
+// GetConfigurationRequestValidationError is the validation error returned by
+// GetConfigurationRequest.Validate if the designated constraints aren't met.
+type GetConfigurationRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1184,24 +1237,24 @@ type SetConfigurationResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetConfigurationResponseValidationError) Field() string { return e.field }
+func (e GetConfigurationRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetConfigurationResponseValidationError) Reason() string { return e.reason }
+func (e GetConfigurationRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetConfigurationResponseValidationError) Cause() error { return e.cause }
+func (e GetConfigurationRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetConfigurationResponseValidationError) Key() bool { return e.key }
+func (e GetConfigurationRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetConfigurationResponseValidationError) ErrorName() string {
-	return "SetConfigurationResponseValidationError"
+func (e GetConfigurationRequestValidationError) ErrorName() string {
+	return "GetConfigurationRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetConfigurationResponseValidationError) Error() string {
+func (e GetConfigurationRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1213,14 +1266,14 @@ func (e SetConfigurationResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetConfigurationResponse.%s: %s%s",
+		"invalid %sGetConfigurationRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetConfigurationResponseValidationError{}
+var _ error = GetConfigurationRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1228,76 +1281,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetConfigurationResponseValidationError{}
+} = GetConfigurationRequestValidationError{}
 
-// Validate checks the field values on TokenInfo with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *TokenInfo) Validate() error {
+// Validate checks the field values on GetConfigurationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetConfigurationResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TokenInfo with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TokenInfoMultiError, or nil
-// if none found.
-func (m *TokenInfo) ValidateAll() error {
+// ValidateAll checks the field values on GetConfigurationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetConfigurationResponseMultiError, or nil if none found.
+func (m *GetConfigurationResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TokenInfo) validate(all bool) error {
+func (m *GetConfigurationResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Subject
-
 	if all {
-		switch v := interface{}(m.GetExpiration()).(type) {
+		switch v := interface{}(m.GetConfiguration()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TokenInfoValidationError{
-					field:  "Expiration",
+				errors = append(errors, GetConfigurationResponseValidationError{
+					field:  "Configuration",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TokenInfoValidationError{
-					field:  "Expiration",
+				errors = append(errors, GetConfigurationResponseValidationError{
+					field:  "Configuration",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetExpiration()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetConfiguration()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return TokenInfoValidationError{
-				field:  "Expiration",
+			return GetConfigurationResponseValidationError{
+				field:  "Configuration",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for HashedToken
-
 	if len(errors) > 0 {
-		return TokenInfoMultiError(errors)
+		return GetConfigurationResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TokenInfoMultiError is an error wrapping multiple validation errors returned
-// by TokenInfo.ValidateAll() if the designated constraints aren't met.
-type TokenInfoMultiError []error
+// GetConfigurationResponseMultiError is an error wrapping multiple validation
+// errors returned by GetConfigurationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetConfigurationResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TokenInfoMultiError) Error() string {
+func (m GetConfigurationResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1306,11 +1356,11 @@ func (m TokenInfoMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TokenInfoMultiError) AllErrors() []error { return m }
+func (m GetConfigurationResponseMultiError) AllErrors() []error { return m }
 
-// TokenInfoValidationError is the validation error returned by
-// TokenInfo.Validate if the designated constraints aren't met.
-type TokenInfoValidationError struct {
+// GetConfigurationResponseValidationError is the validation error returned by
+// GetConfigurationResponse.Validate if the designated constraints aren't met.
+type GetConfigurationResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1318,22 +1368,24 @@ type TokenInfoValidationError struct {
 }
 
 // Field function returns field value.
-func (e TokenInfoValidationError) Field() string { return e.field }
+func (e GetConfigurationResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TokenInfoValidationError) Reason() string { return e.reason }
+func (e GetConfigurationResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TokenInfoValidationError) Cause() error { return e.cause }
+func (e GetConfigurationResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TokenInfoValidationError) Key() bool { return e.key }
+func (e GetConfigurationResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TokenInfoValidationError) ErrorName() string { return "TokenInfoValidationError" }
+func (e GetConfigurationResponseValidationError) ErrorName() string {
+	return "GetConfigurationResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TokenInfoValidationError) Error() string {
+func (e GetConfigurationResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1345,14 +1397,14 @@ func (e TokenInfoValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTokenInfo.%s: %s%s",
+		"invalid %sGetConfigurationResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TokenInfoValidationError{}
+var _ error = GetConfigurationResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1360,48 +1412,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TokenInfoValidationError{}
+} = GetConfigurationResponseValidationError{}
 
-// Validate checks the field values on AuthenticateRequest with the rules
+// Validate checks the field values on SetConfigurationRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuthenticateRequest) Validate() error {
+func (m *SetConfigurationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthenticateRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SetConfigurationRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuthenticateRequestMultiError, or nil if none found.
-func (m *AuthenticateRequest) ValidateAll() error {
+// SetConfigurationRequestMultiError, or nil if none found.
+func (m *SetConfigurationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthenticateRequest) validate(all bool) error {
+func (m *SetConfigurationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OidcState
-
-	// no validation rules for IdToken
+	if all {
+		switch v := interface{}(m.GetConfiguration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetConfigurationRequestValidationError{
+					field:  "Configuration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetConfigurationRequestValidationError{
+					field:  "Configuration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfiguration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetConfigurationRequestValidationError{
+				field:  "Configuration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return AuthenticateRequestMultiError(errors)
+		return SetConfigurationRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthenticateRequestMultiError is an error wrapping multiple validation
-// errors returned by AuthenticateRequest.ValidateAll() if the designated
+// SetConfigurationRequestMultiError is an error wrapping multiple validation
+// errors returned by SetConfigurationRequest.ValidateAll() if the designated
 // constraints aren't met.
-type AuthenticateRequestMultiError []error
+type SetConfigurationRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthenticateRequestMultiError) Error() string {
+func (m SetConfigurationRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1410,11 +1487,11 @@ func (m AuthenticateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthenticateRequestMultiError) AllErrors() []error { return m }
+func (m SetConfigurationRequestMultiError) AllErrors() []error { return m }
 
-// AuthenticateRequestValidationError is the validation error returned by
-// AuthenticateRequest.Validate if the designated constraints aren't met.
-type AuthenticateRequestValidationError struct {
+// SetConfigurationRequestValidationError is the validation error returned by
+// SetConfigurationRequest.Validate if the designated constraints aren't met.
+type SetConfigurationRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1422,24 +1499,24 @@ type AuthenticateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthenticateRequestValidationError) Field() string { return e.field }
+func (e SetConfigurationRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthenticateRequestValidationError) Reason() string { return e.reason }
+func (e SetConfigurationRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthenticateRequestValidationError) Cause() error { return e.cause }
+func (e SetConfigurationRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthenticateRequestValidationError) Key() bool { return e.key }
+func (e SetConfigurationRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthenticateRequestValidationError) ErrorName() string {
-	return "AuthenticateRequestValidationError"
+func (e SetConfigurationRequestValidationError) ErrorName() string {
+	return "SetConfigurationRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuthenticateRequestValidationError) Error() string {
+func (e SetConfigurationRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1451,14 +1528,14 @@ func (e AuthenticateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthenticateRequest.%s: %s%s",
+		"invalid %sSetConfigurationRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthenticateRequestValidationError{}
+var _ error = SetConfigurationRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1466,46 +1543,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthenticateRequestValidationError{}
+} = SetConfigurationRequestValidationError{}
 
-// Validate checks the field values on AuthenticateResponse with the rules
+// Validate checks the field values on SetConfigurationResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuthenticateResponse) Validate() error {
+func (m *SetConfigurationResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthenticateResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SetConfigurationResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuthenticateResponseMultiError, or nil if none found.
-func (m *AuthenticateResponse) ValidateAll() error {
+// SetConfigurationResponseMultiError, or nil if none found.
+func (m *SetConfigurationResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthenticateResponse) validate(all bool) error {
+func (m *SetConfigurationResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PachToken
-
 	if len(errors) > 0 {
-		return AuthenticateResponseMultiError(errors)
+		return SetConfigurationResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthenticateResponseMultiError is an error wrapping multiple validation
-// errors returned by AuthenticateResponse.ValidateAll() if the designated
+// SetConfigurationResponseMultiError is an error wrapping multiple validation
+// errors returned by SetConfigurationResponse.ValidateAll() if the designated
 // constraints aren't met.
-type AuthenticateResponseMultiError []error
+type SetConfigurationResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthenticateResponseMultiError) Error() string {
+func (m SetConfigurationResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1514,11 +1589,11 @@ func (m AuthenticateResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthenticateResponseMultiError) AllErrors() []error { return m }
+func (m SetConfigurationResponseMultiError) AllErrors() []error { return m }
 
-// AuthenticateResponseValidationError is the validation error returned by
-// AuthenticateResponse.Validate if the designated constraints aren't met.
-type AuthenticateResponseValidationError struct {
+// SetConfigurationResponseValidationError is the validation error returned by
+// SetConfigurationResponse.Validate if the designated constraints aren't met.
+type SetConfigurationResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1526,24 +1601,24 @@ type AuthenticateResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthenticateResponseValidationError) Field() string { return e.field }
+func (e SetConfigurationResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthenticateResponseValidationError) Reason() string { return e.reason }
+func (e SetConfigurationResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthenticateResponseValidationError) Cause() error { return e.cause }
+func (e SetConfigurationResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthenticateResponseValidationError) Key() bool { return e.key }
+func (e SetConfigurationResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthenticateResponseValidationError) ErrorName() string {
-	return "AuthenticateResponseValidationError"
+func (e SetConfigurationResponseValidationError) ErrorName() string {
+	return "SetConfigurationResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuthenticateResponseValidationError) Error() string {
+func (e SetConfigurationResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1555,14 +1630,14 @@ func (e AuthenticateResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthenticateResponse.%s: %s%s",
+		"invalid %sSetConfigurationResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthenticateResponseValidationError{}
+var _ error = SetConfigurationResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1570,45 +1645,142 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthenticateResponseValidationError{}
+} = SetConfigurationResponseValidationError{}
 
-// Validate checks the field values on WhoAmIRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on TokenInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *WhoAmIRequest) Validate() error {
+func (m *TokenInfo) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WhoAmIRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in WhoAmIRequestMultiError, or
-// nil if none found.
-func (m *WhoAmIRequest) ValidateAll() error {
+// ValidateAll checks the field values on TokenInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TokenInfoMultiError, or nil
+// if none found.
+func (m *TokenInfo) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WhoAmIRequest) validate(all bool) error {
+func (m *TokenInfo) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(errors) > 0 {
-		return WhoAmIRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-// WhoAmIRequestMultiError is an error wrapping multiple validation errors
-// returned by WhoAmIRequest.ValidateAll() if the designated constraints
-// aren't met.
-type WhoAmIRequestMultiError []error
+	// no validation rules for Subject
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m WhoAmIRequestMultiError) Error() string {
-	var msgs []string
+	if all {
+		switch v := interface{}(m.GetExpiration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TokenInfoValidationError{
+					field:  "Expiration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TokenInfoValidationError{
+					field:  "Expiration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TokenInfoValidationError{
+				field:  "Expiration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for HashedToken
+
+	for idx, item := range m.GetScopes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TokenInfoValidationError{
+						field:  fmt.Sprintf("Scopes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TokenInfoValidationError{
+						field:  fmt.Sprintf("Scopes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TokenInfoValidationError{
+					field:  fmt.Sprintf("Scopes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TokenInfoValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TokenInfoValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TokenInfoValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return TokenInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// TokenInfoMultiError is an error wrapping multiple validation errors returned
+// by TokenInfo.ValidateAll() if the designated constraints aren't met.
+type TokenInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TokenInfoMultiError) Error() string {
+	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
@@ -1616,11 +1788,11 @@ func (m WhoAmIRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WhoAmIRequestMultiError) AllErrors() []error { return m }
+func (m TokenInfoMultiError) AllErrors() []error { return m }
 
-// WhoAmIRequestValidationError is the validation error returned by
-// WhoAmIRequest.Validate if the designated constraints aren't met.
-type WhoAmIRequestValidationError struct {
+// TokenInfoValidationError is the validation error returned by
+// TokenInfo.Validate if the designated constraints aren't met.
+type TokenInfoValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1628,22 +1800,22 @@ type WhoAmIRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e WhoAmIRequestValidationError) Field() string { return e.field }
+func (e TokenInfoValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WhoAmIRequestValidationError) Reason() string { return e.reason }
+func (e TokenInfoValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WhoAmIRequestValidationError) Cause() error { return e.cause }
+func (e TokenInfoValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WhoAmIRequestValidationError) Key() bool { return e.key }
+func (e TokenInfoValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WhoAmIRequestValidationError) ErrorName() string { return "WhoAmIRequestValidationError" }
+func (e TokenInfoValidationError) ErrorName() string { return "TokenInfoValidationError" }
 
 // Error satisfies the builtin error interface
-func (e WhoAmIRequestValidationError) Error() string {
+func (e TokenInfoValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1655,14 +1827,14 @@ func (e WhoAmIRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWhoAmIRequest.%s: %s%s",
+		"invalid %sTokenInfo.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WhoAmIRequestValidationError{}
+var _ error = TokenInfoValidationError{}
 
 var _ interface {
 	Field() string
@@ -1670,55 +1842,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WhoAmIRequestValidationError{}
+} = TokenInfoValidationError{}
 
-// Validate checks the field values on WhoAmIResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on TokenScope with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *WhoAmIResponse) Validate() error {
+func (m *TokenScope) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WhoAmIResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in WhoAmIResponseMultiError,
-// or nil if none found.
-func (m *WhoAmIResponse) ValidateAll() error {
+// ValidateAll checks the field values on TokenScope with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TokenScopeMultiError, or
+// nil if none found.
+func (m *TokenScope) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WhoAmIResponse) validate(all bool) error {
+func (m *TokenScope) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Username
-
 	if all {
-		switch v := interface{}(m.GetExpiration()).(type) {
+		switch v := interface{}(m.GetResource()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, WhoAmIResponseValidationError{
-					field:  "Expiration",
+				errors = append(errors, TokenScopeValidationError{
+					field:  "Resource",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, WhoAmIResponseValidationError{
-					field:  "Expiration",
+				errors = append(errors, TokenScopeValidationError{
+					field:  "Resource",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetExpiration()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return WhoAmIResponseValidationError{
-				field:  "Expiration",
+			return TokenScopeValidationError{
+				field:  "Resource",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1726,19 +1896,18 @@ func (m *WhoAmIResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return WhoAmIResponseMultiError(errors)
+		return TokenScopeMultiError(errors)
 	}
 
 	return nil
 }
 
-// WhoAmIResponseMultiError is an error wrapping multiple validation errors
-// returned by WhoAmIResponse.ValidateAll() if the designated constraints
-// aren't met.
-type WhoAmIResponseMultiError []error
+// TokenScopeMultiError is an error wrapping multiple validation errors
+// returned by TokenScope.ValidateAll() if the designated constraints aren't met.
+type TokenScopeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WhoAmIResponseMultiError) Error() string {
+func (m TokenScopeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1747,11 +1916,11 @@ func (m WhoAmIResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WhoAmIResponseMultiError) AllErrors() []error { return m }
+func (m TokenScopeMultiError) AllErrors() []error { return m }
 
-// WhoAmIResponseValidationError is the validation error returned by
-// WhoAmIResponse.Validate if the designated constraints aren't met.
-type WhoAmIResponseValidationError struct {
+// TokenScopeValidationError is the validation error returned by
+// TokenScope.Validate if the designated constraints aren't met.
+type TokenScopeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1759,22 +1928,22 @@ type WhoAmIResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e WhoAmIResponseValidationError) Field() string { return e.field }
+func (e TokenScopeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WhoAmIResponseValidationError) Reason() string { return e.reason }
+func (e TokenScopeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WhoAmIResponseValidationError) Cause() error { return e.cause }
+func (e TokenScopeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WhoAmIResponseValidationError) Key() bool { return e.key }
+func (e TokenScopeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WhoAmIResponseValidationError) ErrorName() string { return "WhoAmIResponseValidationError" }
+func (e TokenScopeValidationError) ErrorName() string { return "TokenScopeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e WhoAmIResponseValidationError) Error() string {
+func (e TokenScopeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1786,14 +1955,14 @@ func (e WhoAmIResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWhoAmIResponse.%s: %s%s",
+		"invalid %sTokenScope.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WhoAmIResponseValidationError{}
+var _ error = TokenScopeValidationError{}
 
 var _ interface {
 	Field() string
@@ -1801,46 +1970,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WhoAmIResponseValidationError{}
+} = TokenScopeValidationError{}
 
-// Validate checks the field values on GetRolesForPermissionRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AuthenticateRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetRolesForPermissionRequest) Validate() error {
+func (m *AuthenticateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRolesForPermissionRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AuthenticateRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetRolesForPermissionRequestMultiError, or nil if none found.
-func (m *GetRolesForPermissionRequest) ValidateAll() error {
+// AuthenticateRequestMultiError, or nil if none found.
+func (m *AuthenticateRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRolesForPermissionRequest) validate(all bool) error {
+func (m *AuthenticateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Permission
+	// no validation rules for OidcState
+
+	// no validation rules for IdToken
+
+	// no validation rules for WorkloadToken
 
 	if len(errors) > 0 {
-		return GetRolesForPermissionRequestMultiError(errors)
+		return AuthenticateRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRolesForPermissionRequestMultiError is an error wrapping multiple
-// validation errors returned by GetRolesForPermissionRequest.ValidateAll() if
-// the designated constraints aren't met.
-type GetRolesForPermissionRequestMultiError []error
+// AuthenticateRequestMultiError is an error wrapping multiple validation
+// errors returned by AuthenticateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AuthenticateRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRolesForPermissionRequestMultiError) Error() string {
+func (m AuthenticateRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1849,12 +2022,11 @@ func (m GetRolesForPermissionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRolesForPermissionRequestMultiError) AllErrors() []error { return m }
+func (m AuthenticateRequestMultiError) AllErrors() []error { return m }
 
-// GetRolesForPermissionRequestValidationError is the validation error returned
-// by GetRolesForPermissionRequest.Validate if the designated constraints
-// aren't met.
-type GetRolesForPermissionRequestValidationError struct {
+// AuthenticateRequestValidationError is the validation error returned by
+// AuthenticateRequest.Validate if the designated constraints aren't met.
+type AuthenticateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1862,24 +2034,24 @@ type GetRolesForPermissionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRolesForPermissionRequestValidationError) Field() string { return e.field }
+func (e AuthenticateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRolesForPermissionRequestValidationError) Reason() string { return e.reason }
+func (e AuthenticateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRolesForPermissionRequestValidationError) Cause() error { return e.cause }
+func (e AuthenticateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRolesForPermissionRequestValidationError) Key() bool { return e.key }
+func (e AuthenticateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRolesForPermissionRequestValidationError) ErrorName() string {
-	return "GetRolesForPermissionRequestValidationError"
+func (e AuthenticateRequestValidationError) ErrorName() string {
+	return "AuthenticateRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetRolesForPermissionRequestValidationError) Error() string {
+func (e AuthenticateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1891,14 +2063,14 @@ func (e GetRolesForPermissionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRolesForPermissionRequest.%s: %s%s",
+		"invalid %sAuthenticateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRolesForPermissionRequestValidationError{}
+var _ error = AuthenticateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1906,78 +2078,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRolesForPermissionRequestValidationError{}
+} = AuthenticateRequestValidationError{}
 
-// Validate checks the field values on GetRolesForPermissionResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AuthenticateResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetRolesForPermissionResponse) Validate() error {
+func (m *AuthenticateResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRolesForPermissionResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetRolesForPermissionResponseMultiError, or nil if none found.
-func (m *GetRolesForPermissionResponse) ValidateAll() error {
+// ValidateAll checks the field values on AuthenticateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthenticateResponseMultiError, or nil if none found.
+func (m *AuthenticateResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRolesForPermissionResponse) validate(all bool) error {
+func (m *AuthenticateResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRoles() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetRolesForPermissionResponseValidationError{
-						field:  fmt.Sprintf("Roles[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetRolesForPermissionResponseValidationError{
-						field:  fmt.Sprintf("Roles[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetRolesForPermissionResponseValidationError{
-					field:  fmt.Sprintf("Roles[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for PachToken
 
 	if len(errors) > 0 {
-		return GetRolesForPermissionResponseMultiError(errors)
+		return AuthenticateResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRolesForPermissionResponseMultiError is an error wrapping multiple
-// validation errors returned by GetRolesForPermissionResponse.ValidateAll()
-// if the designated constraints aren't met.
-type GetRolesForPermissionResponseMultiError []error
+// AuthenticateResponseMultiError is an error wrapping multiple validation
+// errors returned by AuthenticateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AuthenticateResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRolesForPermissionResponseMultiError) Error() string {
+func (m AuthenticateResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1986,12 +2126,11 @@ func (m GetRolesForPermissionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRolesForPermissionResponseMultiError) AllErrors() []error { return m }
+func (m AuthenticateResponseMultiError) AllErrors() []error { return m }
 
-// GetRolesForPermissionResponseValidationError is the validation error
-// returned by GetRolesForPermissionResponse.Validate if the designated
-// constraints aren't met.
-type GetRolesForPermissionResponseValidationError struct {
+// AuthenticateResponseValidationError is the validation error returned by
+// AuthenticateResponse.Validate if the designated constraints aren't met.
+type AuthenticateResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1999,24 +2138,24 @@ type GetRolesForPermissionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRolesForPermissionResponseValidationError) Field() string { return e.field }
+func (e AuthenticateResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRolesForPermissionResponseValidationError) Reason() string { return e.reason }
+func (e AuthenticateResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRolesForPermissionResponseValidationError) Cause() error { return e.cause }
+func (e AuthenticateResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRolesForPermissionResponseValidationError) Key() bool { return e.key }
+func (e AuthenticateResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRolesForPermissionResponseValidationError) ErrorName() string {
-	return "GetRolesForPermissionResponseValidationError"
+func (e AuthenticateResponseValidationError) ErrorName() string {
+	return "AuthenticateResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetRolesForPermissionResponseValidationError) Error() string {
+func (e AuthenticateResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2028,14 +2167,14 @@ func (e GetRolesForPermissionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRolesForPermissionResponse.%s: %s%s",
+		"invalid %sAuthenticateResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRolesForPermissionResponseValidationError{}
+var _ error = AuthenticateResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2043,44 +2182,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRolesForPermissionResponseValidationError{}
+} = AuthenticateResponseValidationError{}
 
-// Validate checks the field values on Roles with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on WhoAmIRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Roles) Validate() error {
+func (m *WhoAmIRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Roles with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in RolesMultiError, or nil if none found.
-func (m *Roles) ValidateAll() error {
+// ValidateAll checks the field values on WhoAmIRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WhoAmIRequestMultiError, or
+// nil if none found.
+func (m *WhoAmIRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Roles) validate(all bool) error {
+func (m *WhoAmIRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Roles
-
 	if len(errors) > 0 {
-		return RolesMultiError(errors)
+		return WhoAmIRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RolesMultiError is an error wrapping multiple validation errors returned by
-// Roles.ValidateAll() if the designated constraints aren't met.
-type RolesMultiError []error
+// WhoAmIRequestMultiError is an error wrapping multiple validation errors
+// returned by WhoAmIRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WhoAmIRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RolesMultiError) Error() string {
+func (m WhoAmIRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2089,11 +2228,11 @@ func (m RolesMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RolesMultiError) AllErrors() []error { return m }
+func (m WhoAmIRequestMultiError) AllErrors() []error { return m }
 
-// RolesValidationError is the validation error returned by Roles.Validate if
-// the designated constraints aren't met.
-type RolesValidationError struct {
+// WhoAmIRequestValidationError is the validation error returned by
+// WhoAmIRequest.Validate if the designated constraints aren't met.
+type WhoAmIRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2101,22 +2240,22 @@ type RolesValidationError struct {
 }
 
 // Field function returns field value.
-func (e RolesValidationError) Field() string { return e.field }
+func (e WhoAmIRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RolesValidationError) Reason() string { return e.reason }
+func (e WhoAmIRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RolesValidationError) Cause() error { return e.cause }
+func (e WhoAmIRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RolesValidationError) Key() bool { return e.key }
+func (e WhoAmIRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RolesValidationError) ErrorName() string { return "RolesValidationError" }
+func (e WhoAmIRequestValidationError) ErrorName() string { return "WhoAmIRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RolesValidationError) Error() string {
+func (e WhoAmIRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2128,14 +2267,14 @@ func (e RolesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRoles.%s: %s%s",
+		"invalid %sWhoAmIRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RolesValidationError{}
+var _ error = WhoAmIRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2143,89 +2282,109 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RolesValidationError{}
+} = WhoAmIRequestValidationError{}
 
-// Validate checks the field values on RoleBinding with the rules defined in
+// Validate checks the field values on WhoAmIResponse with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *RoleBinding) Validate() error {
+func (m *WhoAmIResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RoleBinding with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in RoleBindingMultiError, or
-// nil if none found.
-func (m *RoleBinding) ValidateAll() error {
+// ValidateAll checks the field values on WhoAmIResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WhoAmIResponseMultiError,
+// or nil if none found.
+func (m *WhoAmIResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RoleBinding) validate(all bool) error {
+func (m *WhoAmIResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	{
-		sorted_keys := make([]string, len(m.GetEntries()))
-		i := 0
-		for key := range m.GetEntries() {
-			sorted_keys[i] = key
-			i++
+	// no validation rules for Username
+
+	if all {
+		switch v := interface{}(m.GetExpiration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WhoAmIResponseValidationError{
+					field:  "Expiration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WhoAmIResponseValidationError{
+					field:  "Expiration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetEntries()[key]
-			_ = val
+	} else if v, ok := interface{}(m.GetExpiration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WhoAmIResponseValidationError{
+				field:  "Expiration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-			// no validation rules for Entries[key]
+	for idx, item := range m.GetScopes() {
+		_, _ = idx, item
 
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, RoleBindingValidationError{
-							field:  fmt.Sprintf("Entries[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, RoleBindingValidationError{
-							field:  fmt.Sprintf("Entries[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WhoAmIResponseValidationError{
+						field:  fmt.Sprintf("Scopes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
 				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					return RoleBindingValidationError{
-						field:  fmt.Sprintf("Entries[%v]", key),
+					errors = append(errors, WhoAmIResponseValidationError{
+						field:  fmt.Sprintf("Scopes[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
-					}
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WhoAmIResponseValidationError{
+					field:  fmt.Sprintf("Scopes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
 				}
 			}
-
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return RoleBindingMultiError(errors)
+		return WhoAmIResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RoleBindingMultiError is an error wrapping multiple validation errors
-// returned by RoleBinding.ValidateAll() if the designated constraints aren't met.
-type RoleBindingMultiError []error
+// WhoAmIResponseMultiError is an error wrapping multiple validation errors
+// returned by WhoAmIResponse.ValidateAll() if the designated constraints
+// aren't met.
+type WhoAmIResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RoleBindingMultiError) Error() string {
+func (m WhoAmIResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2234,11 +2393,11 @@ func (m RoleBindingMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RoleBindingMultiError) AllErrors() []error { return m }
+func (m WhoAmIResponseMultiError) AllErrors() []error { return m }
 
-// RoleBindingValidationError is the validation error returned by
-// RoleBinding.Validate if the designated constraints aren't met.
-type RoleBindingValidationError struct {
+// WhoAmIResponseValidationError is the validation error returned by
+// WhoAmIResponse.Validate if the designated constraints aren't met.
+type WhoAmIResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2246,22 +2405,22 @@ type RoleBindingValidationError struct {
 }
 
 // Field function returns field value.
-func (e RoleBindingValidationError) Field() string { return e.field }
+func (e WhoAmIResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RoleBindingValidationError) Reason() string { return e.reason }
+func (e WhoAmIResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RoleBindingValidationError) Cause() error { return e.cause }
+func (e WhoAmIResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RoleBindingValidationError) Key() bool { return e.key }
+func (e WhoAmIResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RoleBindingValidationError) ErrorName() string { return "RoleBindingValidationError" }
+func (e WhoAmIResponseValidationError) ErrorName() string { return "WhoAmIResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RoleBindingValidationError) Error() string {
+func (e WhoAmIResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2273,14 +2432,14 @@ func (e RoleBindingValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRoleBinding.%s: %s%s",
+		"invalid %sWhoAmIResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RoleBindingValidationError{}
+var _ error = WhoAmIResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2288,47 +2447,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RoleBindingValidationError{}
+} = WhoAmIResponseValidationError{}
 
-// Validate checks the field values on Resource with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Resource) Validate() error {
+// Validate checks the field values on GetRolesForPermissionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRolesForPermissionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Resource with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ResourceMultiError, or nil
-// if none found.
-func (m *Resource) ValidateAll() error {
+// ValidateAll checks the field values on GetRolesForPermissionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRolesForPermissionRequestMultiError, or nil if none found.
+func (m *GetRolesForPermissionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Resource) validate(all bool) error {
+func (m *GetRolesForPermissionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Type
-
-	// no validation rules for Name
+	// no validation rules for Permission
 
 	if len(errors) > 0 {
-		return ResourceMultiError(errors)
+		return GetRolesForPermissionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ResourceMultiError is an error wrapping multiple validation errors returned
-// by Resource.ValidateAll() if the designated constraints aren't met.
-type ResourceMultiError []error
+// GetRolesForPermissionRequestMultiError is an error wrapping multiple
+// validation errors returned by GetRolesForPermissionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetRolesForPermissionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ResourceMultiError) Error() string {
+func (m GetRolesForPermissionRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2337,11 +2495,12 @@ func (m ResourceMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ResourceMultiError) AllErrors() []error { return m }
+func (m GetRolesForPermissionRequestMultiError) AllErrors() []error { return m }
 
-// ResourceValidationError is the validation error returned by
-// Resource.Validate if the designated constraints aren't met.
-type ResourceValidationError struct {
+// GetRolesForPermissionRequestValidationError is the validation error returned
+// by GetRolesForPermissionRequest.Validate if the designated constraints
+// aren't met.
+type GetRolesForPermissionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2349,22 +2508,24 @@ type ResourceValidationError struct {
 }
 
 // Field function returns field value.
-func (e ResourceValidationError) Field() string { return e.field }
+func (e GetRolesForPermissionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ResourceValidationError) Reason() string { return e.reason }
+func (e GetRolesForPermissionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ResourceValidationError) Cause() error { return e.cause }
+func (e GetRolesForPermissionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ResourceValidationError) Key() bool { return e.key }
+func (e GetRolesForPermissionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ResourceValidationError) ErrorName() string { return "ResourceValidationError" }
+func (e GetRolesForPermissionRequestValidationError) ErrorName() string {
+	return "GetRolesForPermissionRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ResourceValidationError) Error() string {
+func (e GetRolesForPermissionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2376,14 +2537,14 @@ func (e ResourceValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sResource.%s: %s%s",
+		"invalid %sGetRolesForPermissionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ResourceValidationError{}
+var _ error = GetRolesForPermissionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2391,44 +2552,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ResourceValidationError{}
+} = GetRolesForPermissionRequestValidationError{}
 
-// Validate checks the field values on Users with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Users) Validate() error {
+// Validate checks the field values on GetRolesForPermissionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRolesForPermissionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Users with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in UsersMultiError, or nil if none found.
-func (m *Users) ValidateAll() error {
+// ValidateAll checks the field values on GetRolesForPermissionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetRolesForPermissionResponseMultiError, or nil if none found.
+func (m *GetRolesForPermissionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Users) validate(all bool) error {
+func (m *GetRolesForPermissionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Usernames
+	for idx, item := range m.GetRoles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRolesForPermissionResponseValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRolesForPermissionResponseValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRolesForPermissionResponseValidationError{
+					field:  fmt.Sprintf("Roles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return UsersMultiError(errors)
+		return GetRolesForPermissionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UsersMultiError is an error wrapping multiple validation errors returned by
-// Users.ValidateAll() if the designated constraints aren't met.
-type UsersMultiError []error
+// GetRolesForPermissionResponseMultiError is an error wrapping multiple
+// validation errors returned by GetRolesForPermissionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetRolesForPermissionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UsersMultiError) Error() string {
+func (m GetRolesForPermissionResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2437,11 +2632,12 @@ func (m UsersMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UsersMultiError) AllErrors() []error { return m }
+func (m GetRolesForPermissionResponseMultiError) AllErrors() []error { return m }
 
-// UsersValidationError is the validation error returned by Users.Validate if
-// the designated constraints aren't met.
-type UsersValidationError struct {
+// GetRolesForPermissionResponseValidationError is the validation error
+// returned by GetRolesForPermissionResponse.Validate if the designated
+// constraints aren't met.
+type GetRolesForPermissionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2449,22 +2645,24 @@ type UsersValidationError struct {
 }
 
 // Field function returns field value.
-func (e UsersValidationError) Field() string { return e.field }
+func (e GetRolesForPermissionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UsersValidationError) Reason() string { return e.reason }
+func (e GetRolesForPermissionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UsersValidationError) Cause() error { return e.cause }
+func (e GetRolesForPermissionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UsersValidationError) Key() bool { return e.key }
+func (e GetRolesForPermissionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UsersValidationError) ErrorName() string { return "UsersValidationError" }
+func (e GetRolesForPermissionResponseValidationError) ErrorName() string {
+	return "GetRolesForPermissionResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UsersValidationError) Error() string {
+func (e GetRolesForPermissionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2476,14 +2674,14 @@ func (e UsersValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUsers.%s: %s%s",
+		"invalid %sGetRolesForPermissionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UsersValidationError{}
+var _ error = GetRolesForPermissionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2491,44 +2689,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UsersValidationError{}
+} = GetRolesForPermissionResponseValidationError{}
 
-// Validate checks the field values on Groups with the rules defined in the
+// Validate checks the field values on Roles with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Groups) Validate() error {
+func (m *Roles) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Groups with the rules defined in the
+// ValidateAll checks the field values on Roles with the rules defined in the
 // proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in GroupsMultiError, or nil if none found.
-func (m *Groups) ValidateAll() error {
+// a list of violation errors wrapped in RolesMultiError, or nil if none found.
+func (m *Roles) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Groups) validate(all bool) error {
+func (m *Roles) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Groups
+	// no validation rules for Roles
+
+	if all {
+		switch v := interface{}(m.GetExpiration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RolesValidationError{
+					field:  "Expiration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RolesValidationError{
+					field:  "Expiration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RolesValidationError{
+				field:  "Expiration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Justification
 
 	if len(errors) > 0 {
-		return GroupsMultiError(errors)
+		return RolesMultiError(errors)
 	}
 
 	return nil
 }
 
-// GroupsMultiError is an error wrapping multiple validation errors returned by
-// Groups.ValidateAll() if the designated constraints aren't met.
-type GroupsMultiError []error
+// RolesMultiError is an error wrapping multiple validation errors returned by
+// Roles.ValidateAll() if the designated constraints aren't met.
+type RolesMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GroupsMultiError) Error() string {
+func (m RolesMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2537,11 +2766,11 @@ func (m GroupsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GroupsMultiError) AllErrors() []error { return m }
+func (m RolesMultiError) AllErrors() []error { return m }
 
-// GroupsValidationError is the validation error returned by Groups.Validate if
+// RolesValidationError is the validation error returned by Roles.Validate if
 // the designated constraints aren't met.
-type GroupsValidationError struct {
+type RolesValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2549,22 +2778,22 @@ type GroupsValidationError struct {
 }
 
 // Field function returns field value.
-func (e GroupsValidationError) Field() string { return e.field }
+func (e RolesValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GroupsValidationError) Reason() string { return e.reason }
+func (e RolesValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GroupsValidationError) Cause() error { return e.cause }
+func (e RolesValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GroupsValidationError) Key() bool { return e.key }
+func (e RolesValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GroupsValidationError) ErrorName() string { return "GroupsValidationError" }
+func (e RolesValidationError) ErrorName() string { return "RolesValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GroupsValidationError) Error() string {
+func (e RolesValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2576,14 +2805,14 @@ func (e GroupsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGroups.%s: %s%s",
+		"invalid %sRoles.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GroupsValidationError{}
+var _ error = RolesValidationError{}
 
 var _ interface {
 	Field() string
@@ -2591,44 +2820,89 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GroupsValidationError{}
+} = RolesValidationError{}
 
-// Validate checks the field values on Role with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *Role) Validate() error {
+// Validate checks the field values on RoleBinding with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RoleBinding) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Role with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in RoleMultiError, or nil if none found.
-func (m *Role) ValidateAll() error {
+// ValidateAll checks the field values on RoleBinding with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RoleBindingMultiError, or
+// nil if none found.
+func (m *RoleBinding) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Role) validate(all bool) error {
+func (m *RoleBinding) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
-
-	if len(errors) > 0 {
-		return RoleMultiError(errors)
+	{
+		sorted_keys := make([]string, len(m.GetEntries()))
+		i := 0
+		for key := range m.GetEntries() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetEntries()[key]
+			_ = val
+
+			// no validation rules for Entries[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, RoleBindingValidationError{
+							field:  fmt.Sprintf("Entries[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, RoleBindingValidationError{
+							field:  fmt.Sprintf("Entries[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return RoleBindingValidationError{
+						field:  fmt.Sprintf("Entries[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return RoleBindingMultiError(errors)
 	}
 
 	return nil
 }
 
-// RoleMultiError is an error wrapping multiple validation errors returned by
-// Role.ValidateAll() if the designated constraints aren't met.
-type RoleMultiError []error
+// RoleBindingMultiError is an error wrapping multiple validation errors
+// returned by RoleBinding.ValidateAll() if the designated constraints aren't met.
+type RoleBindingMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RoleMultiError) Error() string {
+func (m RoleBindingMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2637,11 +2911,11 @@ func (m RoleMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RoleMultiError) AllErrors() []error { return m }
+func (m RoleBindingMultiError) AllErrors() []error { return m }
 
-// RoleValidationError is the validation error returned by Role.Validate if the
-// designated constraints aren't met.
-type RoleValidationError struct {
+// RoleBindingValidationError is the validation error returned by
+// RoleBinding.Validate if the designated constraints aren't met.
+type RoleBindingValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2649,22 +2923,22 @@ type RoleValidationError struct {
 }
 
 // Field function returns field value.
-func (e RoleValidationError) Field() string { return e.field }
+func (e RoleBindingValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RoleValidationError) Reason() string { return e.reason }
+func (e RoleBindingValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RoleValidationError) Cause() error { return e.cause }
+func (e RoleBindingValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RoleValidationError) Key() bool { return e.key }
+func (e RoleBindingValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RoleValidationError) ErrorName() string { return "RoleValidationError" }
+func (e RoleBindingValidationError) ErrorName() string { return "RoleBindingValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RoleValidationError) Error() string {
+func (e RoleBindingValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2676,14 +2950,14 @@ func (e RoleValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRole.%s: %s%s",
+		"invalid %sRoleBinding.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RoleValidationError{}
+var _ error = RoleBindingValidationError{}
 
 var _ interface {
 	Field() string
@@ -2691,84 +2965,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RoleValidationError{}
+} = RoleBindingValidationError{}
 
-// Validate checks the field values on AuthorizeRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AuthorizeRequest) Validate() error {
+// Validate checks the field values on Resource with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Resource) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthorizeRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AuthorizeRequestMultiError, or nil if none found.
-func (m *AuthorizeRequest) ValidateAll() error {
+// ValidateAll checks the field values on Resource with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ResourceMultiError, or nil
+// if none found.
+func (m *Resource) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthorizeRequest) validate(all bool) error {
+func (m *Resource) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetResource() == nil {
-		err := AuthorizeRequestValidationError{
-			field:  "Resource",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Type
 
-	if all {
-		switch v := interface{}(m.GetResource()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AuthorizeRequestValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AuthorizeRequestValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AuthorizeRequestValidationError{
-				field:  "Resource",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Name
 
 	if len(errors) > 0 {
-		return AuthorizeRequestMultiError(errors)
+		return ResourceMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthorizeRequestMultiError is an error wrapping multiple validation errors
-// returned by AuthorizeRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AuthorizeRequestMultiError []error
+// ResourceMultiError is an error wrapping multiple validation errors returned
+// by Resource.ValidateAll() if the designated constraints aren't met.
+type ResourceMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthorizeRequestMultiError) Error() string {
+func (m ResourceMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2777,11 +3014,11 @@ func (m AuthorizeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthorizeRequestMultiError) AllErrors() []error { return m }
+func (m ResourceMultiError) AllErrors() []error { return m }
 
-// AuthorizeRequestValidationError is the validation error returned by
-// AuthorizeRequest.Validate if the designated constraints aren't met.
-type AuthorizeRequestValidationError struct {
+// ResourceValidationError is the validation error returned by
+// Resource.Validate if the designated constraints aren't met.
+type ResourceValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2789,22 +3026,22 @@ type AuthorizeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthorizeRequestValidationError) Field() string { return e.field }
+func (e ResourceValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthorizeRequestValidationError) Reason() string { return e.reason }
+func (e ResourceValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthorizeRequestValidationError) Cause() error { return e.cause }
+func (e ResourceValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthorizeRequestValidationError) Key() bool { return e.key }
+func (e ResourceValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthorizeRequestValidationError) ErrorName() string { return "AuthorizeRequestValidationError" }
+func (e ResourceValidationError) ErrorName() string { return "ResourceValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AuthorizeRequestValidationError) Error() string {
+func (e ResourceValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2816,14 +3053,14 @@ func (e AuthorizeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthorizeRequest.%s: %s%s",
+		"invalid %sResource.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthorizeRequestValidationError{}
+var _ error = ResourceValidationError{}
 
 var _ interface {
 	Field() string
@@ -2831,48 +3068,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthorizeRequestValidationError{}
+} = ResourceValidationError{}
 
-// Validate checks the field values on AuthorizeResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AuthorizeResponse) Validate() error {
+// Validate checks the field values on Users with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Users) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthorizeResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AuthorizeResponseMultiError, or nil if none found.
-func (m *AuthorizeResponse) ValidateAll() error {
+// ValidateAll checks the field values on Users with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in UsersMultiError, or nil if none found.
+func (m *Users) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthorizeResponse) validate(all bool) error {
+func (m *Users) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Authorized
-
-	// no validation rules for Principal
+	// no validation rules for Usernames
 
 	if len(errors) > 0 {
-		return AuthorizeResponseMultiError(errors)
+		return UsersMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthorizeResponseMultiError is an error wrapping multiple validation errors
-// returned by AuthorizeResponse.ValidateAll() if the designated constraints
-// aren't met.
-type AuthorizeResponseMultiError []error
+// UsersMultiError is an error wrapping multiple validation errors returned by
+// Users.ValidateAll() if the designated constraints aren't met.
+type UsersMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthorizeResponseMultiError) Error() string {
+func (m UsersMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2881,11 +3114,11 @@ func (m AuthorizeResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthorizeResponseMultiError) AllErrors() []error { return m }
+func (m UsersMultiError) AllErrors() []error { return m }
 
-// AuthorizeResponseValidationError is the validation error returned by
-// AuthorizeResponse.Validate if the designated constraints aren't met.
-type AuthorizeResponseValidationError struct {
+// UsersValidationError is the validation error returned by Users.Validate if
+// the designated constraints aren't met.
+type UsersValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2893,24 +3126,22 @@ type AuthorizeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthorizeResponseValidationError) Field() string { return e.field }
+func (e UsersValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthorizeResponseValidationError) Reason() string { return e.reason }
+func (e UsersValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthorizeResponseValidationError) Cause() error { return e.cause }
+func (e UsersValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthorizeResponseValidationError) Key() bool { return e.key }
+func (e UsersValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthorizeResponseValidationError) ErrorName() string {
-	return "AuthorizeResponseValidationError"
-}
+func (e UsersValidationError) ErrorName() string { return "UsersValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AuthorizeResponseValidationError) Error() string {
+func (e UsersValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2922,14 +3153,14 @@ func (e AuthorizeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthorizeResponse.%s: %s%s",
+		"invalid %sUsers.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthorizeResponseValidationError{}
+var _ error = UsersValidationError{}
 
 var _ interface {
 	Field() string
@@ -2937,24 +3168,861 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthorizeResponseValidationError{}
+} = UsersValidationError{}
 
-// Validate checks the field values on GetPermissionsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetPermissionsRequest) Validate() error {
+// Validate checks the field values on Groups with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Groups) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetPermissionsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetPermissionsRequestMultiError, or nil if none found.
-func (m *GetPermissionsRequest) ValidateAll() error {
-	return m.validate(true)
-}
+// ValidateAll checks the field values on Groups with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in GroupsMultiError, or nil if none found.
+func (m *Groups) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Groups) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Groups
+
+	if len(errors) > 0 {
+		return GroupsMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupsMultiError is an error wrapping multiple validation errors returned by
+// Groups.ValidateAll() if the designated constraints aren't met.
+type GroupsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupsMultiError) AllErrors() []error { return m }
+
+// GroupsValidationError is the validation error returned by Groups.Validate if
+// the designated constraints aren't met.
+type GroupsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupsValidationError) ErrorName() string { return "GroupsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GroupsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroups.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupsValidationError{}
+
+// Validate checks the field values on Role with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Role) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Role with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in RoleMultiError, or nil if none found.
+func (m *Role) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Role) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return RoleMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoleMultiError is an error wrapping multiple validation errors returned by
+// Role.ValidateAll() if the designated constraints aren't met.
+type RoleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoleMultiError) AllErrors() []error { return m }
+
+// RoleValidationError is the validation error returned by Role.Validate if the
+// designated constraints aren't met.
+type RoleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoleValidationError) ErrorName() string { return "RoleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRole.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoleValidationError{}
+
+// Validate checks the field values on AuthorizeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AuthorizeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorizeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthorizeRequestMultiError, or nil if none found.
+func (m *AuthorizeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorizeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetResource() == nil {
+		err := AuthorizeRequestValidationError{
+			field:  "Resource",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthorizeRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthorizeRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthorizeRequestValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AuthorizeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorizeRequestMultiError is an error wrapping multiple validation errors
+// returned by AuthorizeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AuthorizeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorizeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorizeRequestMultiError) AllErrors() []error { return m }
+
+// AuthorizeRequestValidationError is the validation error returned by
+// AuthorizeRequest.Validate if the designated constraints aren't met.
+type AuthorizeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorizeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorizeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorizeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorizeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorizeRequestValidationError) ErrorName() string { return "AuthorizeRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthorizeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorizeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorizeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorizeRequestValidationError{}
+
+// Validate checks the field values on AuthorizeResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AuthorizeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorizeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthorizeResponseMultiError, or nil if none found.
+func (m *AuthorizeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorizeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Authorized
+
+	// no validation rules for Principal
+
+	if len(errors) > 0 {
+		return AuthorizeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorizeResponseMultiError is an error wrapping multiple validation errors
+// returned by AuthorizeResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AuthorizeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorizeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorizeResponseMultiError) AllErrors() []error { return m }
+
+// AuthorizeResponseValidationError is the validation error returned by
+// AuthorizeResponse.Validate if the designated constraints aren't met.
+type AuthorizeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorizeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorizeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorizeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorizeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorizeResponseValidationError) ErrorName() string {
+	return "AuthorizeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthorizeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorizeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorizeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorizeResponseValidationError{}
+
+// Validate checks the field values on GetPermissionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPermissionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPermissionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPermissionsRequestMultiError, or nil if none found.
+func (m *GetPermissionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPermissionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetResource() == nil {
+		err := GetPermissionsRequestValidationError{
+			field:  "Resource",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPermissionsRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPermissionsRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPermissionsRequestValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetPermissionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPermissionsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPermissionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPermissionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPermissionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPermissionsRequestMultiError) AllErrors() []error { return m }
+
+// GetPermissionsRequestValidationError is the validation error returned by
+// GetPermissionsRequest.Validate if the designated constraints aren't met.
+type GetPermissionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPermissionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPermissionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPermissionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPermissionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPermissionsRequestValidationError) ErrorName() string {
+	return "GetPermissionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPermissionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPermissionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPermissionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPermissionsRequestValidationError{}
+
+// Validate checks the field values on GetPermissionsForPrincipalRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetPermissionsForPrincipalRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPermissionsForPrincipalRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetPermissionsForPrincipalRequestMultiError, or nil if none found.
+func (m *GetPermissionsForPrincipalRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPermissionsForPrincipalRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetResource() == nil {
+		err := GetPermissionsForPrincipalRequestValidationError{
+			field:  "Resource",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPermissionsForPrincipalRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPermissionsForPrincipalRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPermissionsForPrincipalRequestValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Principal
+
+	if len(errors) > 0 {
+		return GetPermissionsForPrincipalRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPermissionsForPrincipalRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetPermissionsForPrincipalRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPermissionsForPrincipalRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPermissionsForPrincipalRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPermissionsForPrincipalRequestMultiError) AllErrors() []error { return m }
+
+// GetPermissionsForPrincipalRequestValidationError is the validation error
+// returned by GetPermissionsForPrincipalRequest.Validate if the designated
+// constraints aren't met.
+type GetPermissionsForPrincipalRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPermissionsForPrincipalRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPermissionsForPrincipalRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPermissionsForPrincipalRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPermissionsForPrincipalRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPermissionsForPrincipalRequestValidationError) ErrorName() string {
+	return "GetPermissionsForPrincipalRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPermissionsForPrincipalRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPermissionsForPrincipalRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPermissionsForPrincipalRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPermissionsForPrincipalRequestValidationError{}
 
-func (m *GetPermissionsRequest) validate(all bool) error {
+// Validate checks the field values on GetPermissionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPermissionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPermissionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPermissionsResponseMultiError, or nil if none found.
+func (m *GetPermissionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPermissionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetPermissionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPermissionsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetPermissionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetPermissionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPermissionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPermissionsResponseMultiError) AllErrors() []error { return m }
+
+// GetPermissionsResponseValidationError is the validation error returned by
+// GetPermissionsResponse.Validate if the designated constraints aren't met.
+type GetPermissionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPermissionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPermissionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPermissionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPermissionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPermissionsResponseValidationError) ErrorName() string {
+	return "GetPermissionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPermissionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPermissionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPermissionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPermissionsResponseValidationError{}
+
+// Validate checks the field values on ModifyRoleBindingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ModifyRoleBindingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModifyRoleBindingRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ModifyRoleBindingRequestMultiError, or nil if none found.
+func (m *ModifyRoleBindingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModifyRoleBindingRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2962,7 +4030,7 @@ func (m *GetPermissionsRequest) validate(all bool) error {
 	var errors []error
 
 	if m.GetResource() == nil {
-		err := GetPermissionsRequestValidationError{
+		err := ModifyRoleBindingRequestValidationError{
 			field:  "Resource",
 			reason: "value is required",
 		}
@@ -2976,7 +4044,7 @@ func (m *GetPermissionsRequest) validate(all bool) error {
 		switch v := interface{}(m.GetResource()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetPermissionsRequestValidationError{
+				errors = append(errors, ModifyRoleBindingRequestValidationError{
 					field:  "Resource",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2984,7 +4052,7 @@ func (m *GetPermissionsRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetPermissionsRequestValidationError{
+				errors = append(errors, ModifyRoleBindingRequestValidationError{
 					field:  "Resource",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2993,7 +4061,7 @@ func (m *GetPermissionsRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetPermissionsRequestValidationError{
+			return ModifyRoleBindingRequestValidationError{
 				field:  "Resource",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -3001,20 +4069,155 @@ func (m *GetPermissionsRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Principal
+
+	if all {
+		switch v := interface{}(m.GetExpiration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModifyRoleBindingRequestValidationError{
+					field:  "Expiration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModifyRoleBindingRequestValidationError{
+					field:  "Expiration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModifyRoleBindingRequestValidationError{
+				field:  "Expiration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Justification
+
+	if len(errors) > 0 {
+		return ModifyRoleBindingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModifyRoleBindingRequestMultiError is an error wrapping multiple validation
+// errors returned by ModifyRoleBindingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ModifyRoleBindingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModifyRoleBindingRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModifyRoleBindingRequestMultiError) AllErrors() []error { return m }
+
+// ModifyRoleBindingRequestValidationError is the validation error returned by
+// ModifyRoleBindingRequest.Validate if the designated constraints aren't met.
+type ModifyRoleBindingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModifyRoleBindingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModifyRoleBindingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModifyRoleBindingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModifyRoleBindingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModifyRoleBindingRequestValidationError) ErrorName() string {
+	return "ModifyRoleBindingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ModifyRoleBindingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModifyRoleBindingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModifyRoleBindingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModifyRoleBindingRequestValidationError{}
+
+// Validate checks the field values on ModifyRoleBindingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ModifyRoleBindingResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModifyRoleBindingResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ModifyRoleBindingResponseMultiError, or nil if none found.
+func (m *ModifyRoleBindingResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModifyRoleBindingResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
 	if len(errors) > 0 {
-		return GetPermissionsRequestMultiError(errors)
+		return ModifyRoleBindingResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetPermissionsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetPermissionsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetPermissionsRequestMultiError []error
+// ModifyRoleBindingResponseMultiError is an error wrapping multiple validation
+// errors returned by ModifyRoleBindingResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ModifyRoleBindingResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetPermissionsRequestMultiError) Error() string {
+func (m ModifyRoleBindingResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3023,11 +4226,11 @@ func (m GetPermissionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetPermissionsRequestMultiError) AllErrors() []error { return m }
+func (m ModifyRoleBindingResponseMultiError) AllErrors() []error { return m }
 
-// GetPermissionsRequestValidationError is the validation error returned by
-// GetPermissionsRequest.Validate if the designated constraints aren't met.
-type GetPermissionsRequestValidationError struct {
+// ModifyRoleBindingResponseValidationError is the validation error returned by
+// ModifyRoleBindingResponse.Validate if the designated constraints aren't met.
+type ModifyRoleBindingResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3035,24 +4238,24 @@ type GetPermissionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetPermissionsRequestValidationError) Field() string { return e.field }
+func (e ModifyRoleBindingResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetPermissionsRequestValidationError) Reason() string { return e.reason }
+func (e ModifyRoleBindingResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetPermissionsRequestValidationError) Cause() error { return e.cause }
+func (e ModifyRoleBindingResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetPermissionsRequestValidationError) Key() bool { return e.key }
+func (e ModifyRoleBindingResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetPermissionsRequestValidationError) ErrorName() string {
-	return "GetPermissionsRequestValidationError"
+func (e ModifyRoleBindingResponseValidationError) ErrorName() string {
+	return "ModifyRoleBindingResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetPermissionsRequestValidationError) Error() string {
+func (e ModifyRoleBindingResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3064,14 +4267,14 @@ func (e GetPermissionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetPermissionsRequest.%s: %s%s",
+		"invalid %sModifyRoleBindingResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetPermissionsRequestValidationError{}
+var _ error = ModifyRoleBindingResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3079,25 +4282,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetPermissionsRequestValidationError{}
+} = ModifyRoleBindingResponseValidationError{}
 
-// Validate checks the field values on GetPermissionsForPrincipalRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *GetPermissionsForPrincipalRequest) Validate() error {
+// Validate checks the field values on GetRoleBindingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRoleBindingRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetPermissionsForPrincipalRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// GetPermissionsForPrincipalRequestMultiError, or nil if none found.
-func (m *GetPermissionsForPrincipalRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetRoleBindingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRoleBindingRequestMultiError, or nil if none found.
+func (m *GetRoleBindingRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetPermissionsForPrincipalRequest) validate(all bool) error {
+func (m *GetRoleBindingRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3105,7 +4307,7 @@ func (m *GetPermissionsForPrincipalRequest) validate(all bool) error {
 	var errors []error
 
 	if m.GetResource() == nil {
-		err := GetPermissionsForPrincipalRequestValidationError{
+		err := GetRoleBindingRequestValidationError{
 			field:  "Resource",
 			reason: "value is required",
 		}
@@ -3119,7 +4321,7 @@ func (m *GetPermissionsForPrincipalRequest) validate(all bool) error {
 		switch v := interface{}(m.GetResource()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetPermissionsForPrincipalRequestValidationError{
+				errors = append(errors, GetRoleBindingRequestValidationError{
 					field:  "Resource",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3127,7 +4329,7 @@ func (m *GetPermissionsForPrincipalRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetPermissionsForPrincipalRequestValidationError{
+				errors = append(errors, GetRoleBindingRequestValidationError{
 					field:  "Resource",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3136,7 +4338,7 @@ func (m *GetPermissionsForPrincipalRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetPermissionsForPrincipalRequestValidationError{
+			return GetRoleBindingRequestValidationError{
 				field:  "Resource",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -3144,23 +4346,20 @@ func (m *GetPermissionsForPrincipalRequest) validate(all bool) error {
 		}
 	}
 
-	// no validation rules for Principal
-
 	if len(errors) > 0 {
-		return GetPermissionsForPrincipalRequestMultiError(errors)
+		return GetRoleBindingRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetPermissionsForPrincipalRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// GetPermissionsForPrincipalRequest.ValidateAll() if the designated
+// GetRoleBindingRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRoleBindingRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetPermissionsForPrincipalRequestMultiError []error
+type GetRoleBindingRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetPermissionsForPrincipalRequestMultiError) Error() string {
+func (m GetRoleBindingRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3169,12 +4368,11 @@ func (m GetPermissionsForPrincipalRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetPermissionsForPrincipalRequestMultiError) AllErrors() []error { return m }
+func (m GetRoleBindingRequestMultiError) AllErrors() []error { return m }
 
-// GetPermissionsForPrincipalRequestValidationError is the validation error
-// returned by GetPermissionsForPrincipalRequest.Validate if the designated
-// constraints aren't met.
-type GetPermissionsForPrincipalRequestValidationError struct {
+// GetRoleBindingRequestValidationError is the validation error returned by
+// GetRoleBindingRequest.Validate if the designated constraints aren't met.
+type GetRoleBindingRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3182,24 +4380,24 @@ type GetPermissionsForPrincipalRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetPermissionsForPrincipalRequestValidationError) Field() string { return e.field }
+func (e GetRoleBindingRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetPermissionsForPrincipalRequestValidationError) Reason() string { return e.reason }
+func (e GetRoleBindingRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetPermissionsForPrincipalRequestValidationError) Cause() error { return e.cause }
+func (e GetRoleBindingRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetPermissionsForPrincipalRequestValidationError) Key() bool { return e.key }
+func (e GetRoleBindingRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetPermissionsForPrincipalRequestValidationError) ErrorName() string {
-	return "GetPermissionsForPrincipalRequestValidationError"
+func (e GetRoleBindingRequestValidationError) ErrorName() string {
+	return "GetRoleBindingRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetPermissionsForPrincipalRequestValidationError) Error() string {
+func (e GetRoleBindingRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3211,14 +4409,14 @@ func (e GetPermissionsForPrincipalRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetPermissionsForPrincipalRequest.%s: %s%s",
+		"invalid %sGetRoleBindingRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetPermissionsForPrincipalRequestValidationError{}
+var _ error = GetRoleBindingRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3226,44 +4424,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetPermissionsForPrincipalRequestValidationError{}
+} = GetRoleBindingRequestValidationError{}
 
-// Validate checks the field values on GetPermissionsResponse with the rules
+// Validate checks the field values on GetRoleBindingResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetPermissionsResponse) Validate() error {
+func (m *GetRoleBindingResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetPermissionsResponse with the rules
+// ValidateAll checks the field values on GetRoleBindingResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetPermissionsResponseMultiError, or nil if none found.
-func (m *GetPermissionsResponse) ValidateAll() error {
+// GetRoleBindingResponseMultiError, or nil if none found.
+func (m *GetRoleBindingResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetPermissionsResponse) validate(all bool) error {
+func (m *GetRoleBindingResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetBinding()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRoleBindingResponseValidationError{
+					field:  "Binding",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRoleBindingResponseValidationError{
+					field:  "Binding",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBinding()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRoleBindingResponseValidationError{
+				field:  "Binding",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return GetPermissionsResponseMultiError(errors)
+		return GetRoleBindingResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetPermissionsResponseMultiError is an error wrapping multiple validation
-// errors returned by GetPermissionsResponse.ValidateAll() if the designated
+// GetRoleBindingResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRoleBindingResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetPermissionsResponseMultiError []error
+type GetRoleBindingResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetPermissionsResponseMultiError) Error() string {
+func (m GetRoleBindingResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3272,11 +4499,11 @@ func (m GetPermissionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetPermissionsResponseMultiError) AllErrors() []error { return m }
+func (m GetRoleBindingResponseMultiError) AllErrors() []error { return m }
 
-// GetPermissionsResponseValidationError is the validation error returned by
-// GetPermissionsResponse.Validate if the designated constraints aren't met.
-type GetPermissionsResponseValidationError struct {
+// GetRoleBindingResponseValidationError is the validation error returned by
+// GetRoleBindingResponse.Validate if the designated constraints aren't met.
+type GetRoleBindingResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3284,24 +4511,24 @@ type GetPermissionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetPermissionsResponseValidationError) Field() string { return e.field }
+func (e GetRoleBindingResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetPermissionsResponseValidationError) Reason() string { return e.reason }
+func (e GetRoleBindingResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetPermissionsResponseValidationError) Cause() error { return e.cause }
+func (e GetRoleBindingResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetPermissionsResponseValidationError) Key() bool { return e.key }
+func (e GetRoleBindingResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetPermissionsResponseValidationError) ErrorName() string {
-	return "GetPermissionsResponseValidationError"
+func (e GetRoleBindingResponseValidationError) ErrorName() string {
+	return "GetRoleBindingResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetPermissionsResponseValidationError) Error() string {
+func (e GetRoleBindingResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3313,14 +4540,14 @@ func (e GetPermissionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetPermissionsResponse.%s: %s%s",
+		"invalid %sGetRoleBindingResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetPermissionsResponseValidationError{}
+var _ error = GetRoleBindingResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3328,86 +4555,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetPermissionsResponseValidationError{}
+} = GetRoleBindingResponseValidationError{}
 
-// Validate checks the field values on ModifyRoleBindingRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ModifyRoleBindingRequest) Validate() error {
+// Validate checks the field values on SessionInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SessionInfo) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ModifyRoleBindingRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ModifyRoleBindingRequestMultiError, or nil if none found.
-func (m *ModifyRoleBindingRequest) ValidateAll() error {
+// ValidateAll checks the field values on SessionInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SessionInfoMultiError, or
+// nil if none found.
+func (m *SessionInfo) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ModifyRoleBindingRequest) validate(all bool) error {
+func (m *SessionInfo) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetResource() == nil {
-		err := ModifyRoleBindingRequestValidationError{
-			field:  "Resource",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Nonce
 
-	if all {
-		switch v := interface{}(m.GetResource()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ModifyRoleBindingRequestValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ModifyRoleBindingRequestValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ModifyRoleBindingRequestValidationError{
-				field:  "Resource",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Email
 
-	// no validation rules for Principal
+	// no validation rules for ConversionErr
 
 	if len(errors) > 0 {
-		return ModifyRoleBindingRequestMultiError(errors)
+		return SessionInfoMultiError(errors)
 	}
 
 	return nil
 }
 
-// ModifyRoleBindingRequestMultiError is an error wrapping multiple validation
-// errors returned by ModifyRoleBindingRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ModifyRoleBindingRequestMultiError []error
+// SessionInfoMultiError is an error wrapping multiple validation errors
+// returned by SessionInfo.ValidateAll() if the designated constraints aren't met.
+type SessionInfoMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ModifyRoleBindingRequestMultiError) Error() string {
+func (m SessionInfoMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3416,11 +4606,11 @@ func (m ModifyRoleBindingRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ModifyRoleBindingRequestMultiError) AllErrors() []error { return m }
+func (m SessionInfoMultiError) AllErrors() []error { return m }
 
-// ModifyRoleBindingRequestValidationError is the validation error returned by
-// ModifyRoleBindingRequest.Validate if the designated constraints aren't met.
-type ModifyRoleBindingRequestValidationError struct {
+// SessionInfoValidationError is the validation error returned by
+// SessionInfo.Validate if the designated constraints aren't met.
+type SessionInfoValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3428,24 +4618,22 @@ type ModifyRoleBindingRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ModifyRoleBindingRequestValidationError) Field() string { return e.field }
+func (e SessionInfoValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ModifyRoleBindingRequestValidationError) Reason() string { return e.reason }
+func (e SessionInfoValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ModifyRoleBindingRequestValidationError) Cause() error { return e.cause }
+func (e SessionInfoValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ModifyRoleBindingRequestValidationError) Key() bool { return e.key }
+func (e SessionInfoValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ModifyRoleBindingRequestValidationError) ErrorName() string {
-	return "ModifyRoleBindingRequestValidationError"
-}
+func (e SessionInfoValidationError) ErrorName() string { return "SessionInfoValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ModifyRoleBindingRequestValidationError) Error() string {
+func (e SessionInfoValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3457,14 +4645,14 @@ func (e ModifyRoleBindingRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sModifyRoleBindingRequest.%s: %s%s",
+		"invalid %sSessionInfo.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ModifyRoleBindingRequestValidationError{}
+var _ error = SessionInfoValidationError{}
 
 var _ interface {
 	Field() string
@@ -3472,24 +4660,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ModifyRoleBindingRequestValidationError{}
+} = SessionInfoValidationError{}
 
-// Validate checks the field values on ModifyRoleBindingResponse with the rules
+// Validate checks the field values on GetOIDCLoginRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ModifyRoleBindingResponse) Validate() error {
+func (m *GetOIDCLoginRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ModifyRoleBindingResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetOIDCLoginRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ModifyRoleBindingResponseMultiError, or nil if none found.
-func (m *ModifyRoleBindingResponse) ValidateAll() error {
+// GetOIDCLoginRequestMultiError, or nil if none found.
+func (m *GetOIDCLoginRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ModifyRoleBindingResponse) validate(all bool) error {
+func (m *GetOIDCLoginRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3497,19 +4685,19 @@ func (m *ModifyRoleBindingResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return ModifyRoleBindingResponseMultiError(errors)
+		return GetOIDCLoginRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ModifyRoleBindingResponseMultiError is an error wrapping multiple validation
-// errors returned by ModifyRoleBindingResponse.ValidateAll() if the
-// designated constraints aren't met.
-type ModifyRoleBindingResponseMultiError []error
+// GetOIDCLoginRequestMultiError is an error wrapping multiple validation
+// errors returned by GetOIDCLoginRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetOIDCLoginRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ModifyRoleBindingResponseMultiError) Error() string {
+func (m GetOIDCLoginRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3518,11 +4706,11 @@ func (m ModifyRoleBindingResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ModifyRoleBindingResponseMultiError) AllErrors() []error { return m }
+func (m GetOIDCLoginRequestMultiError) AllErrors() []error { return m }
 
-// ModifyRoleBindingResponseValidationError is the validation error returned by
-// ModifyRoleBindingResponse.Validate if the designated constraints aren't met.
-type ModifyRoleBindingResponseValidationError struct {
+// GetOIDCLoginRequestValidationError is the validation error returned by
+// GetOIDCLoginRequest.Validate if the designated constraints aren't met.
+type GetOIDCLoginRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3530,24 +4718,24 @@ type ModifyRoleBindingResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ModifyRoleBindingResponseValidationError) Field() string { return e.field }
+func (e GetOIDCLoginRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ModifyRoleBindingResponseValidationError) Reason() string { return e.reason }
+func (e GetOIDCLoginRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ModifyRoleBindingResponseValidationError) Cause() error { return e.cause }
+func (e GetOIDCLoginRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ModifyRoleBindingResponseValidationError) Key() bool { return e.key }
+func (e GetOIDCLoginRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ModifyRoleBindingResponseValidationError) ErrorName() string {
-	return "ModifyRoleBindingResponseValidationError"
+func (e GetOIDCLoginRequestValidationError) ErrorName() string {
+	return "GetOIDCLoginRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ModifyRoleBindingResponseValidationError) Error() string {
+func (e GetOIDCLoginRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3559,14 +4747,14 @@ func (e ModifyRoleBindingResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sModifyRoleBindingResponse.%s: %s%s",
+		"invalid %sGetOIDCLoginRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ModifyRoleBindingResponseValidationError{}
+var _ error = GetOIDCLoginRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3574,84 +4762,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ModifyRoleBindingResponseValidationError{}
+} = GetOIDCLoginRequestValidationError{}
 
-// Validate checks the field values on GetRoleBindingRequest with the rules
+// Validate checks the field values on GetOIDCLoginResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetRoleBindingRequest) Validate() error {
+func (m *GetOIDCLoginResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRoleBindingRequest with the rules
+// ValidateAll checks the field values on GetOIDCLoginResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetRoleBindingRequestMultiError, or nil if none found.
-func (m *GetRoleBindingRequest) ValidateAll() error {
+// GetOIDCLoginResponseMultiError, or nil if none found.
+func (m *GetOIDCLoginResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRoleBindingRequest) validate(all bool) error {
+func (m *GetOIDCLoginResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetResource() == nil {
-		err := GetRoleBindingRequestValidationError{
-			field:  "Resource",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for LoginUrl
 
-	if all {
-		switch v := interface{}(m.GetResource()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetRoleBindingRequestValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetRoleBindingRequestValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetRoleBindingRequestValidationError{
-				field:  "Resource",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for State
 
 	if len(errors) > 0 {
-		return GetRoleBindingRequestMultiError(errors)
+		return GetOIDCLoginResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRoleBindingRequestMultiError is an error wrapping multiple validation
-// errors returned by GetRoleBindingRequest.ValidateAll() if the designated
+// GetOIDCLoginResponseMultiError is an error wrapping multiple validation
+// errors returned by GetOIDCLoginResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetRoleBindingRequestMultiError []error
+type GetOIDCLoginResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRoleBindingRequestMultiError) Error() string {
+func (m GetOIDCLoginResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3660,11 +4812,11 @@ func (m GetRoleBindingRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRoleBindingRequestMultiError) AllErrors() []error { return m }
+func (m GetOIDCLoginResponseMultiError) AllErrors() []error { return m }
 
-// GetRoleBindingRequestValidationError is the validation error returned by
-// GetRoleBindingRequest.Validate if the designated constraints aren't met.
-type GetRoleBindingRequestValidationError struct {
+// GetOIDCLoginResponseValidationError is the validation error returned by
+// GetOIDCLoginResponse.Validate if the designated constraints aren't met.
+type GetOIDCLoginResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3672,24 +4824,24 @@ type GetRoleBindingRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRoleBindingRequestValidationError) Field() string { return e.field }
+func (e GetOIDCLoginResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRoleBindingRequestValidationError) Reason() string { return e.reason }
+func (e GetOIDCLoginResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRoleBindingRequestValidationError) Cause() error { return e.cause }
+func (e GetOIDCLoginResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRoleBindingRequestValidationError) Key() bool { return e.key }
+func (e GetOIDCLoginResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRoleBindingRequestValidationError) ErrorName() string {
-	return "GetRoleBindingRequestValidationError"
+func (e GetOIDCLoginResponseValidationError) ErrorName() string {
+	return "GetOIDCLoginResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetRoleBindingRequestValidationError) Error() string {
+func (e GetOIDCLoginResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3701,14 +4853,14 @@ func (e GetRoleBindingRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRoleBindingRequest.%s: %s%s",
+		"invalid %sGetOIDCLoginResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRoleBindingRequestValidationError{}
+var _ error = GetOIDCLoginResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3716,73 +4868,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRoleBindingRequestValidationError{}
+} = GetOIDCLoginResponseValidationError{}
 
-// Validate checks the field values on GetRoleBindingResponse with the rules
+// Validate checks the field values on GetRobotTokenRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetRoleBindingResponse) Validate() error {
+func (m *GetRobotTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRoleBindingResponse with the rules
+// ValidateAll checks the field values on GetRobotTokenRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetRoleBindingResponseMultiError, or nil if none found.
-func (m *GetRoleBindingResponse) ValidateAll() error {
+// GetRobotTokenRequestMultiError, or nil if none found.
+func (m *GetRobotTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRoleBindingResponse) validate(all bool) error {
+func (m *GetRobotTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetBinding()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetRoleBindingResponseValidationError{
-					field:  "Binding",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for Robot
+
+	// no validation rules for Ttl
+
+	for idx, item := range m.GetScopes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRobotTokenRequestValidationError{
+						field:  fmt.Sprintf("Scopes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRobotTokenRequestValidationError{
+						field:  fmt.Sprintf("Scopes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetRoleBindingResponseValidationError{
-					field:  "Binding",
+				return GetRobotTokenRequestValidationError{
+					field:  fmt.Sprintf("Scopes[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetBinding()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetRoleBindingResponseValidationError{
-				field:  "Binding",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return GetRoleBindingResponseMultiError(errors)
+		return GetRobotTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRoleBindingResponseMultiError is an error wrapping multiple validation
-// errors returned by GetRoleBindingResponse.ValidateAll() if the designated
+// GetRobotTokenRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRobotTokenRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetRoleBindingResponseMultiError []error
+type GetRobotTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRoleBindingResponseMultiError) Error() string {
+func (m GetRobotTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3791,11 +4952,11 @@ func (m GetRoleBindingResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRoleBindingResponseMultiError) AllErrors() []error { return m }
+func (m GetRobotTokenRequestMultiError) AllErrors() []error { return m }
 
-// GetRoleBindingResponseValidationError is the validation error returned by
-// GetRoleBindingResponse.Validate if the designated constraints aren't met.
-type GetRoleBindingResponseValidationError struct {
+// GetRobotTokenRequestValidationError is the validation error returned by
+// GetRobotTokenRequest.Validate if the designated constraints aren't met.
+type GetRobotTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3803,24 +4964,24 @@ type GetRoleBindingResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRoleBindingResponseValidationError) Field() string { return e.field }
+func (e GetRobotTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRoleBindingResponseValidationError) Reason() string { return e.reason }
+func (e GetRobotTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRoleBindingResponseValidationError) Cause() error { return e.cause }
+func (e GetRobotTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRoleBindingResponseValidationError) Key() bool { return e.key }
+func (e GetRobotTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRoleBindingResponseValidationError) ErrorName() string {
-	return "GetRoleBindingResponseValidationError"
+func (e GetRobotTokenRequestValidationError) ErrorName() string {
+	return "GetRobotTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetRoleBindingResponseValidationError) Error() string {
+func (e GetRobotTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3832,14 +4993,14 @@ func (e GetRoleBindingResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRoleBindingResponse.%s: %s%s",
+		"invalid %sGetRobotTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRoleBindingResponseValidationError{}
+var _ error = GetRobotTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3847,49 +5008,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRoleBindingResponseValidationError{}
+} = GetRobotTokenRequestValidationError{}
 
-// Validate checks the field values on SessionInfo with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SessionInfo) Validate() error {
+// Validate checks the field values on GetRobotTokenResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRobotTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SessionInfo with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SessionInfoMultiError, or
-// nil if none found.
-func (m *SessionInfo) ValidateAll() error {
+// ValidateAll checks the field values on GetRobotTokenResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRobotTokenResponseMultiError, or nil if none found.
+func (m *GetRobotTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SessionInfo) validate(all bool) error {
+func (m *GetRobotTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Nonce
-
-	// no validation rules for Email
-
-	// no validation rules for ConversionErr
+	// no validation rules for Token
 
 	if len(errors) > 0 {
-		return SessionInfoMultiError(errors)
+		return GetRobotTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SessionInfoMultiError is an error wrapping multiple validation errors
-// returned by SessionInfo.ValidateAll() if the designated constraints aren't met.
-type SessionInfoMultiError []error
+// GetRobotTokenResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRobotTokenResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetRobotTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SessionInfoMultiError) Error() string {
+func (m GetRobotTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3898,11 +5056,11 @@ func (m SessionInfoMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SessionInfoMultiError) AllErrors() []error { return m }
+func (m GetRobotTokenResponseMultiError) AllErrors() []error { return m }
 
-// SessionInfoValidationError is the validation error returned by
-// SessionInfo.Validate if the designated constraints aren't met.
-type SessionInfoValidationError struct {
+// GetRobotTokenResponseValidationError is the validation error returned by
+// GetRobotTokenResponse.Validate if the designated constraints aren't met.
+type GetRobotTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3910,22 +5068,24 @@ type SessionInfoValidationError struct {
 }
 
 // Field function returns field value.
-func (e SessionInfoValidationError) Field() string { return e.field }
+func (e GetRobotTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SessionInfoValidationError) Reason() string { return e.reason }
+func (e GetRobotTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SessionInfoValidationError) Cause() error { return e.cause }
+func (e GetRobotTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SessionInfoValidationError) Key() bool { return e.key }
+func (e GetRobotTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SessionInfoValidationError) ErrorName() string { return "SessionInfoValidationError" }
+func (e GetRobotTokenResponseValidationError) ErrorName() string {
+	return "GetRobotTokenResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SessionInfoValidationError) Error() string {
+func (e GetRobotTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3937,14 +5097,14 @@ func (e SessionInfoValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSessionInfo.%s: %s%s",
+		"invalid %sGetRobotTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SessionInfoValidationError{}
+var _ error = GetRobotTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3952,44 +5112,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SessionInfoValidationError{}
+} = GetRobotTokenResponseValidationError{}
 
-// Validate checks the field values on GetOIDCLoginRequest with the rules
+// Validate checks the field values on GetScopedTokenRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetOIDCLoginRequest) Validate() error {
+func (m *GetScopedTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetOIDCLoginRequest with the rules
+// ValidateAll checks the field values on GetScopedTokenRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetOIDCLoginRequestMultiError, or nil if none found.
-func (m *GetOIDCLoginRequest) ValidateAll() error {
+// GetScopedTokenRequestMultiError, or nil if none found.
+func (m *GetScopedTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetOIDCLoginRequest) validate(all bool) error {
+func (m *GetScopedTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetScopes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetScopedTokenRequestValidationError{
+						field:  fmt.Sprintf("Scopes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetScopedTokenRequestValidationError{
+						field:  fmt.Sprintf("Scopes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetScopedTokenRequestValidationError{
+					field:  fmt.Sprintf("Scopes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Ttl
+
 	if len(errors) > 0 {
-		return GetOIDCLoginRequestMultiError(errors)
+		return GetScopedTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetOIDCLoginRequestMultiError is an error wrapping multiple validation
-// errors returned by GetOIDCLoginRequest.ValidateAll() if the designated
+// GetScopedTokenRequestMultiError is an error wrapping multiple validation
+// errors returned by GetScopedTokenRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetOIDCLoginRequestMultiError []error
+type GetScopedTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetOIDCLoginRequestMultiError) Error() string {
+func (m GetScopedTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3998,11 +5194,11 @@ func (m GetOIDCLoginRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetOIDCLoginRequestMultiError) AllErrors() []error { return m }
+func (m GetScopedTokenRequestMultiError) AllErrors() []error { return m }
 
-// GetOIDCLoginRequestValidationError is the validation error returned by
-// GetOIDCLoginRequest.Validate if the designated constraints aren't met.
-type GetOIDCLoginRequestValidationError struct {
+// GetScopedTokenRequestValidationError is the validation error returned by
+// GetScopedTokenRequest.Validate if the designated constraints aren't met.
+type GetScopedTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4010,24 +5206,24 @@ type GetOIDCLoginRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetOIDCLoginRequestValidationError) Field() string { return e.field }
+func (e GetScopedTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetOIDCLoginRequestValidationError) Reason() string { return e.reason }
+func (e GetScopedTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetOIDCLoginRequestValidationError) Cause() error { return e.cause }
+func (e GetScopedTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetOIDCLoginRequestValidationError) Key() bool { return e.key }
+func (e GetScopedTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetOIDCLoginRequestValidationError) ErrorName() string {
-	return "GetOIDCLoginRequestValidationError"
+func (e GetScopedTokenRequestValidationError) ErrorName() string {
+	return "GetScopedTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetOIDCLoginRequestValidationError) Error() string {
+func (e GetScopedTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4039,14 +5235,14 @@ func (e GetOIDCLoginRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetOIDCLoginRequest.%s: %s%s",
+		"invalid %sGetScopedTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetOIDCLoginRequestValidationError{}
+var _ error = GetScopedTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4054,48 +5250,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetOIDCLoginRequestValidationError{}
+} = GetScopedTokenRequestValidationError{}
 
-// Validate checks the field values on GetOIDCLoginResponse with the rules
+// Validate checks the field values on GetScopedTokenResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetOIDCLoginResponse) Validate() error {
+func (m *GetScopedTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetOIDCLoginResponse with the rules
+// ValidateAll checks the field values on GetScopedTokenResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetOIDCLoginResponseMultiError, or nil if none found.
-func (m *GetOIDCLoginResponse) ValidateAll() error {
+// GetScopedTokenResponseMultiError, or nil if none found.
+func (m *GetScopedTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetOIDCLoginResponse) validate(all bool) error {
+func (m *GetScopedTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for LoginUrl
+	// no validation rules for Token
 
-	// no validation rules for State
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return GetOIDCLoginResponseMultiError(errors)
+		return GetScopedTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetOIDCLoginResponseMultiError is an error wrapping multiple validation
-// errors returned by GetOIDCLoginResponse.ValidateAll() if the designated
+// GetScopedTokenResponseMultiError is an error wrapping multiple validation
+// errors returned by GetScopedTokenResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetOIDCLoginResponseMultiError []error
+type GetScopedTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetOIDCLoginResponseMultiError) Error() string {
+func (m GetScopedTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4104,11 +5300,11 @@ func (m GetOIDCLoginResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetOIDCLoginResponseMultiError) AllErrors() []error { return m }
+func (m GetScopedTokenResponseMultiError) AllErrors() []error { return m }
 
-// GetOIDCLoginResponseValidationError is the validation error returned by
-// GetOIDCLoginResponse.Validate if the designated constraints aren't met.
-type GetOIDCLoginResponseValidationError struct {
+// GetScopedTokenResponseValidationError is the validation error returned by
+// GetScopedTokenResponse.Validate if the designated constraints aren't met.
+type GetScopedTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4116,24 +5312,24 @@ type GetOIDCLoginResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetOIDCLoginResponseValidationError) Field() string { return e.field }
+func (e GetScopedTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetOIDCLoginResponseValidationError) Reason() string { return e.reason }
+func (e GetScopedTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetOIDCLoginResponseValidationError) Cause() error { return e.cause }
+func (e GetScopedTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetOIDCLoginResponseValidationError) Key() bool { return e.key }
+func (e GetScopedTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetOIDCLoginResponseValidationError) ErrorName() string {
-	return "GetOIDCLoginResponseValidationError"
+func (e GetScopedTokenResponseValidationError) ErrorName() string {
+	return "GetScopedTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetOIDCLoginResponseValidationError) Error() string {
+func (e GetScopedTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4145,14 +5341,14 @@ func (e GetOIDCLoginResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetOIDCLoginResponse.%s: %s%s",
+		"invalid %sGetScopedTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetOIDCLoginResponseValidationError{}
+var _ error = GetScopedTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4160,48 +5356,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetOIDCLoginResponseValidationError{}
+} = GetScopedTokenResponseValidationError{}
 
-// Validate checks the field values on GetRobotTokenRequest with the rules
+// Validate checks the field values on ListAuthTokensRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetRobotTokenRequest) Validate() error {
+func (m *ListAuthTokensRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRobotTokenRequest with the rules
+// ValidateAll checks the field values on ListAuthTokensRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetRobotTokenRequestMultiError, or nil if none found.
-func (m *GetRobotTokenRequest) ValidateAll() error {
+// ListAuthTokensRequestMultiError, or nil if none found.
+func (m *ListAuthTokensRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRobotTokenRequest) validate(all bool) error {
+func (m *ListAuthTokensRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Robot
-
-	// no validation rules for Ttl
+	// no validation rules for Principal
 
 	if len(errors) > 0 {
-		return GetRobotTokenRequestMultiError(errors)
+		return ListAuthTokensRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRobotTokenRequestMultiError is an error wrapping multiple validation
-// errors returned by GetRobotTokenRequest.ValidateAll() if the designated
+// ListAuthTokensRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAuthTokensRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetRobotTokenRequestMultiError []error
+type ListAuthTokensRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRobotTokenRequestMultiError) Error() string {
+func (m ListAuthTokensRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4210,11 +5404,11 @@ func (m GetRobotTokenRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRobotTokenRequestMultiError) AllErrors() []error { return m }
+func (m ListAuthTokensRequestMultiError) AllErrors() []error { return m }
 
-// GetRobotTokenRequestValidationError is the validation error returned by
-// GetRobotTokenRequest.Validate if the designated constraints aren't met.
-type GetRobotTokenRequestValidationError struct {
+// ListAuthTokensRequestValidationError is the validation error returned by
+// ListAuthTokensRequest.Validate if the designated constraints aren't met.
+type ListAuthTokensRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4222,24 +5416,24 @@ type GetRobotTokenRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRobotTokenRequestValidationError) Field() string { return e.field }
+func (e ListAuthTokensRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRobotTokenRequestValidationError) Reason() string { return e.reason }
+func (e ListAuthTokensRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRobotTokenRequestValidationError) Cause() error { return e.cause }
+func (e ListAuthTokensRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRobotTokenRequestValidationError) Key() bool { return e.key }
+func (e ListAuthTokensRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRobotTokenRequestValidationError) ErrorName() string {
-	return "GetRobotTokenRequestValidationError"
+func (e ListAuthTokensRequestValidationError) ErrorName() string {
+	return "ListAuthTokensRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetRobotTokenRequestValidationError) Error() string {
+func (e ListAuthTokensRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4251,14 +5445,14 @@ func (e GetRobotTokenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRobotTokenRequest.%s: %s%s",
+		"invalid %sListAuthTokensRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRobotTokenRequestValidationError{}
+var _ error = ListAuthTokensRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4266,46 +5460,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRobotTokenRequestValidationError{}
+} = ListAuthTokensRequestValidationError{}
 
-// Validate checks the field values on GetRobotTokenResponse with the rules
+// Validate checks the field values on ListAuthTokensResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetRobotTokenResponse) Validate() error {
+func (m *ListAuthTokensResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRobotTokenResponse with the rules
+// ValidateAll checks the field values on ListAuthTokensResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetRobotTokenResponseMultiError, or nil if none found.
-func (m *GetRobotTokenResponse) ValidateAll() error {
+// ListAuthTokensResponseMultiError, or nil if none found.
+func (m *ListAuthTokensResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRobotTokenResponse) validate(all bool) error {
+func (m *ListAuthTokensResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Token
+	for idx, item := range m.GetTokens() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAuthTokensResponseValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAuthTokensResponseValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuthTokensResponseValidationError{
+					field:  fmt.Sprintf("Tokens[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return GetRobotTokenResponseMultiError(errors)
+		return ListAuthTokensResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRobotTokenResponseMultiError is an error wrapping multiple validation
-// errors returned by GetRobotTokenResponse.ValidateAll() if the designated
+// ListAuthTokensResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAuthTokensResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetRobotTokenResponseMultiError []error
+type ListAuthTokensResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRobotTokenResponseMultiError) Error() string {
+func (m ListAuthTokensResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4314,11 +5540,11 @@ func (m GetRobotTokenResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRobotTokenResponseMultiError) AllErrors() []error { return m }
+func (m ListAuthTokensResponseMultiError) AllErrors() []error { return m }
 
-// GetRobotTokenResponseValidationError is the validation error returned by
-// GetRobotTokenResponse.Validate if the designated constraints aren't met.
-type GetRobotTokenResponseValidationError struct {
+// ListAuthTokensResponseValidationError is the validation error returned by
+// ListAuthTokensResponse.Validate if the designated constraints aren't met.
+type ListAuthTokensResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4326,24 +5552,24 @@ type GetRobotTokenResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRobotTokenResponseValidationError) Field() string { return e.field }
+func (e ListAuthTokensResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRobotTokenResponseValidationError) Reason() string { return e.reason }
+func (e ListAuthTokensResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRobotTokenResponseValidationError) Cause() error { return e.cause }
+func (e ListAuthTokensResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRobotTokenResponseValidationError) Key() bool { return e.key }
+func (e ListAuthTokensResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRobotTokenResponseValidationError) ErrorName() string {
-	return "GetRobotTokenResponseValidationError"
+func (e ListAuthTokensResponseValidationError) ErrorName() string {
+	return "ListAuthTokensResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetRobotTokenResponseValidationError) Error() string {
+func (e ListAuthTokensResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4355,14 +5581,14 @@ func (e GetRobotTokenResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRobotTokenResponse.%s: %s%s",
+		"invalid %sListAuthTokensResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRobotTokenResponseValidationError{}
+var _ error = ListAuthTokensResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4370,7 +5596,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRobotTokenResponseValidationError{}
+} = ListAuthTokensResponseValidationError{}
 
 // Validate checks the field values on RevokeAuthTokenRequest with the rules
 // defined in the proto definition for this message. If any rules are
@@ -4396,6 +5622,8 @@ func (m *RevokeAuthTokenRequest) validate(all bool) error {
 
 	// no validation rules for Token
 
+	// no validation rules for Id
+
 	if len(errors) > 0 {
 		return RevokeAuthTokenRequestMultiError(errors)
 	}
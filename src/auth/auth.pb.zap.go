@@ -74,6 +74,69 @@ func (x *OIDCConfig) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddBool("require_email_verified", x.RequireEmailVerified)
 	enc.AddBool("localhost_issuer", x.LocalhostIssuer)
 	enc.AddString("user_accessible_issuer_host", x.UserAccessibleIssuerHost)
+	workload_identity_providersArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.WorkloadIdentityProviders {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("workload_identity_providers", zapcore.ArrayMarshalerFunc(workload_identity_providersArrMarshaller))
+	enc.AddObject("group_sync", x.GroupSync)
+	return nil
+}
+
+func (x *OIDCGroupSync) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("claim", x.Claim)
+	enc.AddString("prefix", x.Prefix)
+	allowed_groupsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.AllowedGroups {
+			enc.AppendString(v)
+		}
+		return nil
+	}
+	enc.AddArray("allowed_groups", zapcore.ArrayMarshalerFunc(allowed_groupsArrMarshaller))
+	enc.AddBool("disabled", x.Disabled)
+	return nil
+}
+
+func (x *WorkloadIdentityProvider) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("issuer", x.Issuer)
+	audiencesArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Audiences {
+			enc.AppendString(v)
+		}
+		return nil
+	}
+	enc.AddArray("audiences", zapcore.ArrayMarshalerFunc(audiencesArrMarshaller))
+	enc.AddString("jwks_url", x.JwksUrl)
+	enc.AddBool("kubernetes_api", x.KubernetesApi)
+	rulesArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Rules {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("rules", zapcore.ArrayMarshalerFunc(rulesArrMarshaller))
+	return nil
+}
+
+func (x *WorkloadIdentityRule) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("claims", zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+		for k, v := range x.Claims {
+			enc.AddString(fmt.Sprintf("%v", k), v)
+		}
+		return nil
+	}))
+	enc.AddString("principal", x.Principal)
 	return nil
 }
 
@@ -114,6 +177,37 @@ func (x *TokenInfo) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("subject", x.Subject)
 	protoextensions.AddTimestamp(enc, "expiration", x.Expiration)
 	enc.AddString("hashed_token", x.HashedToken)
+	scopesArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Scopes {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("scopes", zapcore.ArrayMarshalerFunc(scopesArrMarshaller))
+	protoextensions.AddTimestamp(enc, "created_at", x.CreatedAt)
+	enc.AddString("id", x.Id)
+	return nil
+}
+
+func (x *TokenScope) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("resource", x.Resource)
+	permissionsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Permissions {
+			enc.AppendString(v.String())
+		}
+		return nil
+	}
+	enc.AddArray("permissions", zapcore.ArrayMarshalerFunc(permissionsArrMarshaller))
+	branchesArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Branches {
+			enc.AppendString(v)
+		}
+		return nil
+	}
+	enc.AddArray("branches", zapcore.ArrayMarshalerFunc(branchesArrMarshaller))
 	return nil
 }
 
@@ -123,6 +217,7 @@ func (x *AuthenticateRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error
 	}
 	protoextensions.AddHalfString(enc, "oidc_state", x.OidcState)
 	protoextensions.AddHalfString(enc, "id_token", x.IdToken)
+	protoextensions.AddHalfString(enc, "workload_token", x.WorkloadToken)
 	return nil
 }
 
@@ -147,6 +242,13 @@ func (x *WhoAmIResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	}
 	enc.AddString("username", x.Username)
 	protoextensions.AddTimestamp(enc, "expiration", x.Expiration)
+	scopesArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Scopes {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("scopes", zapcore.ArrayMarshalerFunc(scopesArrMarshaller))
 	return nil
 }
 
@@ -182,6 +284,8 @@ func (x *Roles) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 		}
 		return nil
 	}))
+	protoextensions.AddTimestamp(enc, "expiration", x.Expiration)
+	enc.AddString("justification", x.Justification)
 	return nil
 }
 
@@ -351,6 +455,8 @@ func (x *ModifyRoleBindingRequest) MarshalLogObject(enc zapcore.ObjectEncoder) e
 		return nil
 	}
 	enc.AddArray("roles", zapcore.ArrayMarshalerFunc(rolesArrMarshaller))
+	protoextensions.AddTimestamp(enc, "expiration", x.Expiration)
+	enc.AddString("justification", x.Justification)
 	return nil
 }
 
@@ -409,6 +515,13 @@ func (x *GetRobotTokenRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error
 	}
 	enc.AddString("robot", x.Robot)
 	enc.AddInt64("ttl", x.Ttl)
+	scopesArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Scopes {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("scopes", zapcore.ArrayMarshalerFunc(scopesArrMarshaller))
 	return nil
 }
 
@@ -420,11 +533,58 @@ func (x *GetRobotTokenResponse) MarshalLogObject(enc zapcore.ObjectEncoder) erro
 	return nil
 }
 
+func (x *GetScopedTokenRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	scopesArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Scopes {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("scopes", zapcore.ArrayMarshalerFunc(scopesArrMarshaller))
+	enc.AddInt64("ttl", x.Ttl)
+	return nil
+}
+
+func (x *GetScopedTokenResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("token", "[MASKED]")
+	enc.AddString("id", x.Id)
+	return nil
+}
+
+func (x *ListAuthTokensRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("principal", x.Principal)
+	return nil
+}
+
+func (x *ListAuthTokensResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	tokensArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Tokens {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("tokens", zapcore.ArrayMarshalerFunc(tokensArrMarshaller))
+	return nil
+}
+
 func (x *RevokeAuthTokenRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	if x == nil {
 		return nil
 	}
 	protoextensions.AddHalfString(enc, "token", x.Token)
+	enc.AddString("id", x.Id)
 	return nil
 }
 
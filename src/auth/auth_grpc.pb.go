@@ -33,6 +33,8 @@ const (
 	API_GetRoleBinding_FullMethodName             = "/auth_v2.API/GetRoleBinding"
 	API_GetOIDCLogin_FullMethodName               = "/auth_v2.API/GetOIDCLogin"
 	API_GetRobotToken_FullMethodName              = "/auth_v2.API/GetRobotToken"
+	API_GetScopedToken_FullMethodName             = "/auth_v2.API/GetScopedToken"
+	API_ListAuthTokens_FullMethodName             = "/auth_v2.API/ListAuthTokens"
 	API_RevokeAuthToken_FullMethodName            = "/auth_v2.API/RevokeAuthToken"
 	API_RevokeAuthTokensForUser_FullMethodName    = "/auth_v2.API/RevokeAuthTokensForUser"
 	API_SetGroupsForUser_FullMethodName           = "/auth_v2.API/SetGroupsForUser"
@@ -67,6 +69,8 @@ type APIClient interface {
 	GetRoleBinding(ctx context.Context, in *GetRoleBindingRequest, opts ...grpc.CallOption) (*GetRoleBindingResponse, error)
 	GetOIDCLogin(ctx context.Context, in *GetOIDCLoginRequest, opts ...grpc.CallOption) (*GetOIDCLoginResponse, error)
 	GetRobotToken(ctx context.Context, in *GetRobotTokenRequest, opts ...grpc.CallOption) (*GetRobotTokenResponse, error)
+	GetScopedToken(ctx context.Context, in *GetScopedTokenRequest, opts ...grpc.CallOption) (*GetScopedTokenResponse, error)
+	ListAuthTokens(ctx context.Context, in *ListAuthTokensRequest, opts ...grpc.CallOption) (*ListAuthTokensResponse, error)
 	RevokeAuthToken(ctx context.Context, in *RevokeAuthTokenRequest, opts ...grpc.CallOption) (*RevokeAuthTokenResponse, error)
 	RevokeAuthTokensForUser(ctx context.Context, in *RevokeAuthTokensForUserRequest, opts ...grpc.CallOption) (*RevokeAuthTokensForUserResponse, error)
 	SetGroupsForUser(ctx context.Context, in *SetGroupsForUserRequest, opts ...grpc.CallOption) (*SetGroupsForUserResponse, error)
@@ -214,6 +218,24 @@ func (c *aPIClient) GetRobotToken(ctx context.Context, in *GetRobotTokenRequest,
 	return out, nil
 }
 
+func (c *aPIClient) GetScopedToken(ctx context.Context, in *GetScopedTokenRequest, opts ...grpc.CallOption) (*GetScopedTokenResponse, error) {
+	out := new(GetScopedTokenResponse)
+	err := c.cc.Invoke(ctx, API_GetScopedToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListAuthTokens(ctx context.Context, in *ListAuthTokensRequest, opts ...grpc.CallOption) (*ListAuthTokensResponse, error) {
+	out := new(ListAuthTokensResponse)
+	err := c.cc.Invoke(ctx, API_ListAuthTokens_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) RevokeAuthToken(ctx context.Context, in *RevokeAuthTokenRequest, opts ...grpc.CallOption) (*RevokeAuthTokenResponse, error) {
 	out := new(RevokeAuthTokenResponse)
 	err := c.cc.Invoke(ctx, API_RevokeAuthToken_FullMethodName, in, out, opts...)
@@ -334,6 +356,8 @@ type APIServer interface {
 	GetRoleBinding(context.Context, *GetRoleBindingRequest) (*GetRoleBindingResponse, error)
 	GetOIDCLogin(context.Context, *GetOIDCLoginRequest) (*GetOIDCLoginResponse, error)
 	GetRobotToken(context.Context, *GetRobotTokenRequest) (*GetRobotTokenResponse, error)
+	GetScopedToken(context.Context, *GetScopedTokenRequest) (*GetScopedTokenResponse, error)
+	ListAuthTokens(context.Context, *ListAuthTokensRequest) (*ListAuthTokensResponse, error)
 	RevokeAuthToken(context.Context, *RevokeAuthTokenRequest) (*RevokeAuthTokenResponse, error)
 	RevokeAuthTokensForUser(context.Context, *RevokeAuthTokensForUserRequest) (*RevokeAuthTokensForUserResponse, error)
 	SetGroupsForUser(context.Context, *SetGroupsForUserRequest) (*SetGroupsForUserResponse, error)
@@ -394,6 +418,12 @@ func (UnimplementedAPIServer) GetOIDCLogin(context.Context, *GetOIDCLoginRequest
 func (UnimplementedAPIServer) GetRobotToken(context.Context, *GetRobotTokenRequest) (*GetRobotTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRobotToken not implemented")
 }
+func (UnimplementedAPIServer) GetScopedToken(context.Context, *GetScopedTokenRequest) (*GetScopedTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScopedToken not implemented")
+}
+func (UnimplementedAPIServer) ListAuthTokens(context.Context, *ListAuthTokensRequest) (*ListAuthTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuthTokens not implemented")
+}
 func (UnimplementedAPIServer) RevokeAuthToken(context.Context, *RevokeAuthTokenRequest) (*RevokeAuthTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeAuthToken not implemented")
 }
@@ -692,6 +722,42 @@ func _API_GetRobotToken_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_GetScopedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScopedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetScopedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetScopedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetScopedToken(ctx, req.(*GetScopedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListAuthTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuthTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListAuthTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_ListAuthTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListAuthTokens(ctx, req.(*ListAuthTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_RevokeAuthToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RevokeAuthTokenRequest)
 	if err := dec(in); err != nil {
@@ -953,6 +1019,14 @@ var API_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRobotToken",
 			Handler:    _API_GetRobotToken_Handler,
 		},
+		{
+			MethodName: "GetScopedToken",
+			Handler:    _API_GetScopedToken_Handler,
+		},
+		{
+			MethodName: "ListAuthTokens",
+			Handler:    _API_ListAuthTokens_Handler,
+		},
 		{
 			MethodName: "RevokeAuthToken",
 			Handler:    _API_RevokeAuthToken_Handler,
@@ -69,6 +69,10 @@ const (
 	// DatumIDEnv is an env var that is added to the environment of user
 	// pipelined code and indicates the id of the datum.
 	DatumIDEnv = "PACH_DATUM_ID"
+	// SpoutCheckpointEnv is an env var that is added to the environment of
+	// the user code of spouts with exactly_once set, and holds the checkpoint
+	// of the latest finished commit on the spout's output branch.
+	SpoutCheckpointEnv = "PACH_SPOUT_CHECKPOINT"
 	// PeerPortEnv is the env var that sets a custom peer port
 	PeerPortEnv = "PEER_PORT"
 
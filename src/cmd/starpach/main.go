@@ -23,6 +23,7 @@ import (
 
 	// Starlark modules.
 	_ "github.com/pachyderm/pachyderm/v2/src/server/debug/server/debugstar"
+	_ "github.com/pachyderm/pachyderm/v2/src/server/worker/transformstar"
 )
 
 var (
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
@@ -948,3 +949,109 @@ func WalkFile(ctx context.Context, c pfs.APIClient, commit *pfs.Commit, path str
 		}
 	}
 }
+
+// CreateUploadSession creates a resumable upload session for file. If appendFile
+// is false, committing the session replaces the file rather than appending to
+// it.
+func (c APIClient) CreateUploadSession(file *pfs.File, appendFile bool, ttl time.Duration) (_ *pfs.UploadSessionInfo, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	req := &pfs.CreateUploadSessionRequest{
+		File:   file,
+		Append: appendFile,
+	}
+	if ttl > 0 {
+		req.Ttl = durationpb.New(ttl)
+	}
+	return c.PfsAPIClient.CreateUploadSession(c.Ctx(), req)
+}
+
+// InspectUploadSession returns info about an upload session, including the
+// parts that have been uploaded so far.
+func (c APIClient) InspectUploadSession(id string) (_ *pfs.UploadSessionInfo, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	return c.PfsAPIClient.InspectUploadSession(c.Ctx(), &pfs.InspectUploadSessionRequest{Id: id})
+}
+
+// ListUploadSession lists the upload sessions in a repo.
+func (c APIClient) ListUploadSession(repo *pfs.Repo) (_ []*pfs.UploadSessionInfo, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	client, err := c.PfsAPIClient.ListUploadSession(c.Ctx(), &pfs.ListUploadSessionRequest{Repo: repo})
+	if err != nil {
+		return nil, err
+	}
+	var infos []*pfs.UploadSessionInfo
+	for {
+		info, err := client.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return infos, nil
+			}
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+}
+
+// UploadPart uploads the content of r as part number of an upload session.
+// Uploading a part with the same number again replaces it, so a failed part
+// can be retried independently of the others.
+func (c APIClient) UploadPart(id string, number int64, r io.Reader) (_ *pfs.UploadPartInfo, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	client, err := c.PfsAPIClient.UploadPart(c.Ctx())
+	if err != nil {
+		return nil, err
+	}
+	req := &pfs.UploadPartRequest{
+		SessionId: id,
+		Number:    number,
+	}
+	if _, err := grpcutil.ChunkReader(r, func(data []byte) error {
+		req.Data = data
+		if err := client.Send(req); err != nil {
+			return errors.EnsureStack(err)
+		}
+		req = &pfs.UploadPartRequest{}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	// The first message identifies the part, so it must be sent even if the
+	// part is empty.
+	if req.SessionId != "" {
+		if err := client.Send(req); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+	}
+	part, err := client.CloseAndRecv()
+	return part, errors.EnsureStack(err)
+}
+
+// CommitUploadSession commits the given parts of an upload session, in
+// order, to the session's file and deletes the session.
+func (c APIClient) CommitUploadSession(id string, parts []int64) (retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	_, err := c.PfsAPIClient.CommitUploadSession(c.Ctx(), &pfs.CommitUploadSessionRequest{
+		Id:    id,
+		Parts: parts,
+	})
+	return err
+}
+
+// DeleteUploadSession deletes an upload session and its uploaded parts.
+func (c APIClient) DeleteUploadSession(id string) (retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	_, err := c.PfsAPIClient.DeleteUploadSession(c.Ctx(), &pfs.DeleteUploadSessionRequest{Id: id})
+	return err
+}
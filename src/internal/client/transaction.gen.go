@@ -96,10 +96,18 @@ func (c *unsupportedAuthBuilderClient) GetRolesForPermission(_ context.Context,
 	return nil, unsupportedError("GetRolesForPermission")
 }
 
+func (c *unsupportedAuthBuilderClient) GetScopedToken(_ context.Context, _ *auth_v2.GetScopedTokenRequest, opts ...grpc.CallOption) (*auth_v2.GetScopedTokenResponse, error) {
+	return nil, unsupportedError("GetScopedToken")
+}
+
 func (c *unsupportedAuthBuilderClient) GetUsers(_ context.Context, _ *auth_v2.GetUsersRequest, opts ...grpc.CallOption) (*auth_v2.GetUsersResponse, error) {
 	return nil, unsupportedError("GetUsers")
 }
 
+func (c *unsupportedAuthBuilderClient) ListAuthTokens(_ context.Context, _ *auth_v2.ListAuthTokensRequest, opts ...grpc.CallOption) (*auth_v2.ListAuthTokensResponse, error) {
+	return nil, unsupportedError("ListAuthTokens")
+}
+
 func (c *unsupportedAuthBuilderClient) ModifyMembers(_ context.Context, _ *auth_v2.ModifyMembersRequest, opts ...grpc.CallOption) (*auth_v2.ModifyMembersResponse, error) {
 	return nil, unsupportedError("ModifyMembers")
 }
@@ -306,6 +314,10 @@ func (c *unsupportedPfsBuilderClient) AddFileSet(_ context.Context, _ *pfs_v2.Ad
 	return nil, unsupportedError("AddFileSet")
 }
 
+func (c *unsupportedPfsBuilderClient) ApproveCommit(_ context.Context, _ *pfs_v2.ApproveCommitRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	return nil, unsupportedError("ApproveCommit")
+}
+
 func (c *unsupportedPfsBuilderClient) CheckStorage(_ context.Context, _ *pfs_v2.CheckStorageRequest, opts ...grpc.CallOption) (*pfs_v2.CheckStorageResponse, error) {
 	return nil, unsupportedError("CheckStorage")
 }
@@ -318,6 +330,10 @@ func (c *unsupportedPfsBuilderClient) ClearCommit(_ context.Context, _ *pfs_v2.C
 	return nil, unsupportedError("ClearCommit")
 }
 
+func (c *unsupportedPfsBuilderClient) CommitUploadSession(_ context.Context, _ *pfs_v2.CommitUploadSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	return nil, unsupportedError("CommitUploadSession")
+}
+
 func (c *unsupportedPfsBuilderClient) ComposeFileSet(_ context.Context, _ *pfs_v2.ComposeFileSetRequest, opts ...grpc.CallOption) (*pfs_v2.CreateFileSetResponse, error) {
 	return nil, unsupportedError("ComposeFileSet")
 }
@@ -334,10 +350,18 @@ func (c *unsupportedPfsBuilderClient) CreateProject(_ context.Context, _ *pfs_v2
 	return nil, unsupportedError("CreateProject")
 }
 
+func (c *unsupportedPfsBuilderClient) CreateReplication(_ context.Context, _ *pfs_v2.CreateReplicationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	return nil, unsupportedError("CreateReplication")
+}
+
 func (c *unsupportedPfsBuilderClient) CreateRepo(_ context.Context, _ *pfs_v2.CreateRepoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	return nil, unsupportedError("CreateRepo")
 }
 
+func (c *unsupportedPfsBuilderClient) CreateUploadSession(_ context.Context, _ *pfs_v2.CreateUploadSessionRequest, opts ...grpc.CallOption) (*pfs_v2.UploadSessionInfo, error) {
+	return nil, unsupportedError("CreateUploadSession")
+}
+
 func (c *unsupportedPfsBuilderClient) DeleteAll(_ context.Context, _ *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	return nil, unsupportedError("DeleteAll")
 }
@@ -350,6 +374,10 @@ func (c *unsupportedPfsBuilderClient) DeleteProject(_ context.Context, _ *pfs_v2
 	return nil, unsupportedError("DeleteProject")
 }
 
+func (c *unsupportedPfsBuilderClient) DeleteReplication(_ context.Context, _ *pfs_v2.DeleteReplicationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	return nil, unsupportedError("DeleteReplication")
+}
+
 func (c *unsupportedPfsBuilderClient) DeleteRepo(_ context.Context, _ *pfs_v2.DeleteRepoRequest, opts ...grpc.CallOption) (*pfs_v2.DeleteRepoResponse, error) {
 	return nil, unsupportedError("DeleteRepo")
 }
@@ -358,6 +386,10 @@ func (c *unsupportedPfsBuilderClient) DeleteRepos(_ context.Context, _ *pfs_v2.D
 	return nil, unsupportedError("DeleteRepos")
 }
 
+func (c *unsupportedPfsBuilderClient) DeleteUploadSession(_ context.Context, _ *pfs_v2.DeleteUploadSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	return nil, unsupportedError("DeleteUploadSession")
+}
+
 func (c *unsupportedPfsBuilderClient) DiffFile(_ context.Context, _ *pfs_v2.DiffFileRequest, opts ...grpc.CallOption) (pfs_v2.API_DiffFileClient, error) {
 	return nil, unsupportedError("DiffFile")
 }
@@ -370,6 +402,10 @@ func (c *unsupportedPfsBuilderClient) Egress(_ context.Context, _ *pfs_v2.Egress
 	return nil, unsupportedError("Egress")
 }
 
+func (c *unsupportedPfsBuilderClient) EnforceRetentionPolicy(_ context.Context, _ *pfs_v2.EnforceRetentionPolicyRequest, opts ...grpc.CallOption) (pfs_v2.API_EnforceRetentionPolicyClient, error) {
+	return nil, unsupportedError("EnforceRetentionPolicy")
+}
+
 func (c *unsupportedPfsBuilderClient) FindCommits(_ context.Context, _ *pfs_v2.FindCommitsRequest, opts ...grpc.CallOption) (pfs_v2.API_FindCommitsClient, error) {
 	return nil, unsupportedError("FindCommits")
 }
@@ -422,10 +458,18 @@ func (c *unsupportedPfsBuilderClient) InspectProject(_ context.Context, _ *pfs_v
 	return nil, unsupportedError("InspectProject")
 }
 
+func (c *unsupportedPfsBuilderClient) InspectReplication(_ context.Context, _ *pfs_v2.InspectReplicationRequest, opts ...grpc.CallOption) (*pfs_v2.ReplicationInfo, error) {
+	return nil, unsupportedError("InspectReplication")
+}
+
 func (c *unsupportedPfsBuilderClient) InspectRepo(_ context.Context, _ *pfs_v2.InspectRepoRequest, opts ...grpc.CallOption) (*pfs_v2.RepoInfo, error) {
 	return nil, unsupportedError("InspectRepo")
 }
 
+func (c *unsupportedPfsBuilderClient) InspectUploadSession(_ context.Context, _ *pfs_v2.InspectUploadSessionRequest, opts ...grpc.CallOption) (*pfs_v2.UploadSessionInfo, error) {
+	return nil, unsupportedError("InspectUploadSession")
+}
+
 func (c *unsupportedPfsBuilderClient) ListBranch(_ context.Context, _ *pfs_v2.ListBranchRequest, opts ...grpc.CallOption) (pfs_v2.API_ListBranchClient, error) {
 	return nil, unsupportedError("ListBranch")
 }
@@ -438,6 +482,10 @@ func (c *unsupportedPfsBuilderClient) ListCommitSet(_ context.Context, _ *pfs_v2
 	return nil, unsupportedError("ListCommitSet")
 }
 
+func (c *unsupportedPfsBuilderClient) ListCommitValidation(_ context.Context, _ *pfs_v2.ListCommitValidationRequest, opts ...grpc.CallOption) (pfs_v2.API_ListCommitValidationClient, error) {
+	return nil, unsupportedError("ListCommitValidation")
+}
+
 func (c *unsupportedPfsBuilderClient) ListFile(_ context.Context, _ *pfs_v2.ListFileRequest, opts ...grpc.CallOption) (pfs_v2.API_ListFileClient, error) {
 	return nil, unsupportedError("ListFile")
 }
@@ -446,14 +494,34 @@ func (c *unsupportedPfsBuilderClient) ListProject(_ context.Context, _ *pfs_v2.L
 	return nil, unsupportedError("ListProject")
 }
 
+func (c *unsupportedPfsBuilderClient) ListReplication(_ context.Context, _ *pfs_v2.ListReplicationRequest, opts ...grpc.CallOption) (pfs_v2.API_ListReplicationClient, error) {
+	return nil, unsupportedError("ListReplication")
+}
+
 func (c *unsupportedPfsBuilderClient) ListRepo(_ context.Context, _ *pfs_v2.ListRepoRequest, opts ...grpc.CallOption) (pfs_v2.API_ListRepoClient, error) {
 	return nil, unsupportedError("ListRepo")
 }
 
+func (c *unsupportedPfsBuilderClient) ListRetentionPolicy(_ context.Context, _ *pfs_v2.ListRetentionPolicyRequest, opts ...grpc.CallOption) (pfs_v2.API_ListRetentionPolicyClient, error) {
+	return nil, unsupportedError("ListRetentionPolicy")
+}
+
+func (c *unsupportedPfsBuilderClient) ListStoragePlacement(_ context.Context, _ *pfs_v2.ListStoragePlacementRequest, opts ...grpc.CallOption) (pfs_v2.API_ListStoragePlacementClient, error) {
+	return nil, unsupportedError("ListStoragePlacement")
+}
+
 func (c *unsupportedPfsBuilderClient) ListTask(_ context.Context, _ *taskapi.ListTaskRequest, opts ...grpc.CallOption) (pfs_v2.API_ListTaskClient, error) {
 	return nil, unsupportedError("ListTask")
 }
 
+func (c *unsupportedPfsBuilderClient) ListUploadSession(_ context.Context, _ *pfs_v2.ListUploadSessionRequest, opts ...grpc.CallOption) (pfs_v2.API_ListUploadSessionClient, error) {
+	return nil, unsupportedError("ListUploadSession")
+}
+
+func (c *unsupportedPfsBuilderClient) MissingChunks(_ context.Context, _ *pfs_v2.MissingChunksRequest, opts ...grpc.CallOption) (*pfs_v2.MissingChunksResponse, error) {
+	return nil, unsupportedError("MissingChunks")
+}
+
 func (c *unsupportedPfsBuilderClient) ModifyFile(_ context.Context, opts ...grpc.CallOption) (pfs_v2.API_ModifyFileClient, error) {
 	return nil, unsupportedError("ModifyFile")
 }
@@ -466,6 +534,22 @@ func (c *unsupportedPfsBuilderClient) RenewFileSet(_ context.Context, _ *pfs_v2.
 	return nil, unsupportedError("RenewFileSet")
 }
 
+func (c *unsupportedPfsBuilderClient) ReplicateFileSet(_ context.Context, opts ...grpc.CallOption) (pfs_v2.API_ReplicateFileSetClient, error) {
+	return nil, unsupportedError("ReplicateFileSet")
+}
+
+func (c *unsupportedPfsBuilderClient) SetCommitValidation(_ context.Context, _ *pfs_v2.SetCommitValidationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	return nil, unsupportedError("SetCommitValidation")
+}
+
+func (c *unsupportedPfsBuilderClient) SetRetentionPolicy(_ context.Context, _ *pfs_v2.SetRetentionPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	return nil, unsupportedError("SetRetentionPolicy")
+}
+
+func (c *unsupportedPfsBuilderClient) SetStoragePlacement(_ context.Context, _ *pfs_v2.SetStoragePlacementRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	return nil, unsupportedError("SetStoragePlacement")
+}
+
 func (c *unsupportedPfsBuilderClient) ShardFileSet(_ context.Context, _ *pfs_v2.ShardFileSetRequest, opts ...grpc.CallOption) (*pfs_v2.ShardFileSetResponse, error) {
 	return nil, unsupportedError("ShardFileSet")
 }
@@ -482,6 +566,10 @@ func (c *unsupportedPfsBuilderClient) SubscribeCommit(_ context.Context, _ *pfs_
 	return nil, unsupportedError("SubscribeCommit")
 }
 
+func (c *unsupportedPfsBuilderClient) UploadPart(_ context.Context, opts ...grpc.CallOption) (pfs_v2.API_UploadPartClient, error) {
+	return nil, unsupportedError("UploadPart")
+}
+
 func (c *unsupportedPfsBuilderClient) WalkFile(_ context.Context, _ *pfs_v2.WalkFileRequest, opts ...grpc.CallOption) (pfs_v2.API_WalkFileClient, error) {
 	return nil, unsupportedError("WalkFile")
 }
@@ -530,6 +618,10 @@ func (c *unsupportedPpsBuilderClient) ActivateAuth(_ context.Context, _ *pps_v2.
 	return nil, unsupportedError("ActivateAuth")
 }
 
+func (c *unsupportedPpsBuilderClient) CreateNotification(_ context.Context, _ *pps_v2.CreateNotificationRequest, opts ...grpc.CallOption) (*pps_v2.CreateNotificationResponse, error) {
+	return nil, unsupportedError("CreateNotification")
+}
+
 func (c *unsupportedPpsBuilderClient) CreatePipeline(_ context.Context, _ *pps_v2.CreatePipelineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	return nil, unsupportedError("CreatePipeline")
 }
@@ -550,6 +642,10 @@ func (c *unsupportedPpsBuilderClient) DeleteJob(_ context.Context, _ *pps_v2.Del
 	return nil, unsupportedError("DeleteJob")
 }
 
+func (c *unsupportedPpsBuilderClient) DeleteNotification(_ context.Context, _ *pps_v2.DeleteNotificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	return nil, unsupportedError("DeleteNotification")
+}
+
 func (c *unsupportedPpsBuilderClient) DeletePipeline(_ context.Context, _ *pps_v2.DeletePipelineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	return nil, unsupportedError("DeletePipeline")
 }
@@ -574,6 +670,10 @@ func (c *unsupportedPpsBuilderClient) GetLogs(_ context.Context, _ *pps_v2.GetLo
 	return nil, unsupportedError("GetLogs")
 }
 
+func (c *unsupportedPpsBuilderClient) GetProjectComputeUsage(_ context.Context, _ *pps_v2.GetProjectComputeUsageRequest, opts ...grpc.CallOption) (*pps_v2.GetProjectComputeUsageResponse, error) {
+	return nil, unsupportedError("GetProjectComputeUsage")
+}
+
 func (c *unsupportedPpsBuilderClient) InspectDatum(_ context.Context, _ *pps_v2.InspectDatumRequest, opts ...grpc.CallOption) (*pps_v2.DatumInfo, error) {
 	return nil, unsupportedError("InspectDatum")
 }
@@ -606,6 +706,14 @@ func (c *unsupportedPpsBuilderClient) ListJobSet(_ context.Context, _ *pps_v2.Li
 	return nil, unsupportedError("ListJobSet")
 }
 
+func (c *unsupportedPpsBuilderClient) ListNotification(_ context.Context, _ *pps_v2.ListNotificationRequest, opts ...grpc.CallOption) (pps_v2.API_ListNotificationClient, error) {
+	return nil, unsupportedError("ListNotification")
+}
+
+func (c *unsupportedPpsBuilderClient) ListNotificationDelivery(_ context.Context, _ *pps_v2.ListNotificationDeliveryRequest, opts ...grpc.CallOption) (pps_v2.API_ListNotificationDeliveryClient, error) {
+	return nil, unsupportedError("ListNotificationDelivery")
+}
+
 func (c *unsupportedPpsBuilderClient) ListPipeline(_ context.Context, _ *pps_v2.ListPipelineRequest, opts ...grpc.CallOption) (pps_v2.API_ListPipelineClient, error) {
 	return nil, unsupportedError("ListPipeline")
 }
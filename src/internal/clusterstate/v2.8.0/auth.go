@@ -0,0 +1,18 @@
+package v2_8_0
+
+import (
+	"context"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+)
+
+func addAuthTokenScopesColumn(ctx context.Context, tx *pachsql.Tx) error {
+	if _, err := tx.ExecContext(ctx, `
+		ALTER TABLE auth.auth_tokens
+			ADD COLUMN scopes jsonb;
+	`); err != nil {
+		return errors.Wrap(err, "adding scopes column to auth.auth_tokens")
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/migrations"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 )
 
 func Migrate(state migrations.State) migrations.State {
@@ -16,6 +17,42 @@ func Migrate(state migrations.State) migrations.State {
 		Apply("Synthesize user and effective specs from their pipeline details", synthesizeSpecs, migrations.Squash).
 		Apply("create project defaults", func(ctx context.Context, env migrations.Env) error {
 			return setupPostgresCollections(ctx, env.Tx, ppsCollections()...)
+		}).
+		Apply("create pfs replications", func(ctx context.Context, env migrations.Env) error {
+			return setupPostgresCollections(ctx, env.Tx, pfsCollections()...)
+		}).
+		Apply("create pfs retention policies", func(ctx context.Context, env migrations.Env) error {
+			return setupPostgresCollections(ctx, env.Tx, retentionPoliciesCollection())
+		}).
+		Apply("create pfs.storage_usage table", func(ctx context.Context, env migrations.Env) error {
+			return createStorageUsageTable(ctx, env.Tx)
+		}).
+		Apply("add quotas to core.projects", func(ctx context.Context, env migrations.Env) error {
+			return addProjectQuotaColumns(ctx, env.Tx)
+		}).
+		Apply("add compute quotas to core.projects", func(ctx context.Context, env migrations.Env) error {
+			return addProjectComputeQuotaColumns(ctx, env.Tx)
+		}).
+		Apply("create pfs upload sessions", func(ctx context.Context, env migrations.Env) error {
+			return setupPostgresCollections(ctx, env.Tx, uploadSessionsCollection())
+		}).
+		Apply("create pfs commit validations", func(ctx context.Context, env migrations.Env) error {
+			return setupPostgresCollections(ctx, env.Tx, commitValidationsCollection())
+		}).
+		Apply("create pps notifications", func(ctx context.Context, env migrations.Env) error {
+			if err := setupPostgresCollections(ctx, env.Tx, notificationsCollection()); err != nil {
+				return err
+			}
+			return createNotificationDeliveriesTable(ctx, env.Tx)
+		}).
+		Apply("add scopes to auth.auth_tokens", func(ctx context.Context, env migrations.Env) error {
+			return addAuthTokenScopesColumn(ctx, env.Tx)
+		}).
+		Apply("add tier to storage.chunk_objects", func(ctx context.Context, env migrations.Env) error {
+			return chunk.SetupPostgresStoreV1(ctx, env.Tx)
+		}).
+		Apply("create pfs storage placements", func(ctx context.Context, env migrations.Env) error {
+			return setupPostgresCollections(ctx, env.Tx, storagePlacementsCollection())
 		})
 }
 
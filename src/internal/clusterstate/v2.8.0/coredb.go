@@ -0,0 +1,32 @@
+package v2_8_0
+
+import (
+	"context"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+)
+
+func addProjectQuotaColumns(ctx context.Context, tx *pachsql.Tx) error {
+	if _, err := tx.ExecContext(ctx, `
+		ALTER TABLE core.projects
+			ADD COLUMN quota_max_bytes bigint NOT NULL DEFAULT 0,
+			ADD COLUMN quota_enforcement integer NOT NULL DEFAULT 0;
+	`); err != nil {
+		return errors.Wrap(err, "adding quota columns to core.projects")
+	}
+	return nil
+}
+
+func addProjectComputeQuotaColumns(ctx context.Context, tx *pachsql.Tx) error {
+	if _, err := tx.ExecContext(ctx, `
+		ALTER TABLE core.projects
+			ADD COLUMN quota_max_workers bigint NOT NULL DEFAULT 0,
+			ADD COLUMN quota_max_cpu text NOT NULL DEFAULT '',
+			ADD COLUMN quota_max_memory text NOT NULL DEFAULT '',
+			ADD COLUMN priority_class_name text NOT NULL DEFAULT '';
+	`); err != nil {
+		return errors.Wrap(err, "adding compute quota columns to core.projects")
+	}
+	return nil
+}
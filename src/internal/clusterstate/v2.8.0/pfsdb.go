@@ -307,6 +307,23 @@ func createCommitAncestryTable(ctx context.Context, tx *pachsql.Tx) error {
 	return nil
 }
 
+// storage_usage caches the storage used by each project, repo and commit, as
+// computed by the PFS master.
+func createStorageUsageTable(ctx context.Context, tx *pachsql.Tx) error {
+	query := `
+	CREATE TABLE pfs.storage_usage (
+		key text PRIMARY KEY,
+		exclusive_bytes bigint NOT NULL,
+		shared_bytes bigint NOT NULL,
+		computed_at timestamptz NOT NULL
+	);
+	`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return errors.Wrap(err, "creating storage_usage table")
+	}
+	return nil
+}
+
 func migrateCommits(ctx context.Context, env migrations.Env) error {
 	if err := alterCommitsTable(ctx, env.Tx); err != nil {
 		return err
@@ -598,3 +615,29 @@ func migrateBranches(ctx context.Context, env migrations.Env) error {
 	}
 	return nil
 }
+
+func pfsCollections() []*postgresCollection {
+	return []*postgresCollection{
+		newPostgresCollection("replications"),
+	}
+}
+
+func retentionPoliciesCollection() *postgresCollection {
+	col := newPostgresCollection("retention_policies")
+	col.indexes = []*index{{Name: "repo"}}
+	return col
+}
+
+func uploadSessionsCollection() *postgresCollection {
+	col := newPostgresCollection("upload_sessions")
+	col.indexes = []*index{{Name: "repo"}}
+	return col
+}
+
+func commitValidationsCollection() *postgresCollection {
+	return newPostgresCollection("commit_validations")
+}
+
+func storagePlacementsCollection() *postgresCollection {
+	return newPostgresCollection("storage_placements")
+}
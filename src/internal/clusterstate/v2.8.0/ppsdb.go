@@ -1,7 +1,45 @@
 package v2_8_0
 
+import (
+	"context"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+)
+
 func ppsCollections() []*postgresCollection {
 	return []*postgresCollection{
 		newPostgresCollection("project_defaults"),
 	}
 }
+
+func notificationsCollection() *postgresCollection {
+	return newPostgresCollection("notifications")
+}
+
+// notification_deliveries is the delivery log of notifications.  Its pending
+// rows are also the queue which the PPS master delivers from.
+func createNotificationDeliveriesTable(ctx context.Context, tx *pachsql.Tx) error {
+	query := `
+	CREATE SCHEMA IF NOT EXISTS pps;
+	CREATE TABLE pps.notification_deliveries (
+		id text PRIMARY KEY,
+		notification text NOT NULL,
+		event_id text NOT NULL,
+		payload bytea NOT NULL,
+		state int NOT NULL,
+		attempts int NOT NULL DEFAULT 0,
+		status_code int NOT NULL DEFAULT 0,
+		error text NOT NULL DEFAULT '',
+		created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		next_attempt_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		delivered_at timestamptz,
+		UNIQUE (notification, event_id)
+	);
+	CREATE INDEX notification_deliveries_next_attempt_at ON pps.notification_deliveries (next_attempt_at);
+	`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return errors.Wrap(err, "creating notification_deliveries table")
+	}
+	return nil
+}
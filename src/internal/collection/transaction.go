@@ -431,7 +431,7 @@ func (s *stmSerializable) commit() *v3.TxnResponse {
 		for k := range s.wset {
 			keys = append(append(keys, ','), k...)
 		}
-		span.SetTag("updated-keys", string(bytes.TrimLeft(keys, ",")))
+		tracing.TagAnySpan(span, "updated-keys", string(bytes.TrimLeft(keys, ",")))
 	}
 
 	keys, getops := s.gets()
@@ -78,6 +78,12 @@ func WithRing(ctx context.Context, client *etcd.Client, prefix string, cb func(c
 	return withRing(ctx, client, prefix, uuid.New(), cb)
 }
 
+// WithRingID is like WithRing, but the ring's node has the given id, which other members can use to address it.  The
+// id must be unique among the members of the ring.
+func WithRingID(ctx context.Context, client *etcd.Client, prefix, id string, cb func(ctx context.Context, ring *Ring) error) error {
+	return withRing(ctx, client, prefix, id, cb)
+}
+
 func withRing(rctx context.Context, client *etcd.Client, prefix, id string, cb func(ctx context.Context, ring *Ring) error) error {
 	ring := ring(client, prefix, id)
 
@@ -187,6 +193,13 @@ func (ring *Ring) rebalance() error {
 	return nil
 }
 
+// Owner returns the id of the member that key is associated with.
+func (ring *Ring) Owner(key string) string {
+	ring.stateLock.Lock()
+	defer ring.stateLock.Unlock()
+	return ring.get(key).Id
+}
+
 func (ring *Ring) get(key string) member {
 	return ring.members[ring.getIndex(key)]
 }
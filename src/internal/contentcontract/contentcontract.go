@@ -0,0 +1,211 @@
+// Package contentcontract checks a set of files, such as the output of a
+// pipeline's job or a commit to a repo, against a content contract.
+package contentcontract
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	globlib "github.com/pachyderm/ohmyglob"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/jsonschema"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// ErrViolated is returned by Check when the files violate the contract.
+// Other errors are failures to check it.
+type ErrViolated struct {
+	Reason string
+}
+
+func (e ErrViolated) Error() string {
+	return "content contract violated: " + e.Reason
+}
+
+func violated(format string, args ...interface{}) error {
+	return ErrViolated{Reason: fmt.Sprintf(format, args...)}
+}
+
+// File is one of the files being checked.
+type File struct {
+	Path      string
+	SizeBytes int64
+}
+
+// Files are the files being checked.
+type Files interface {
+	// Walk calls cb with each of the files, in path order.
+	Walk(cb func(File) error) error
+	// Get writes the content of the file at path to w.  It's only called by
+	// the callback passed to Walk, for the file being walked.
+	Get(path string, w io.Writer) error
+}
+
+// pathContract is a PathContract with its glob and schemas compiled.
+type pathContract struct {
+	*pfs.PathContract
+	match      func(string) bool
+	jsonSchema *jsonschema.Schema
+	table      *table
+
+	files int64
+}
+
+func compile(c *pfs.ContentContract) ([]*pathContract, error) {
+	if c.MinFiles < 0 || c.MaxFiles < 0 || c.MaxTotalBytes < 0 {
+		return nil, errors.New("contract bounds cannot be negative")
+	}
+	if c.MaxFiles > 0 && c.MinFiles > c.MaxFiles {
+		return nil, errors.New("contract min_files cannot exceed max_files")
+	}
+	var result []*pathContract
+	for i, p := range c.Paths {
+		pc, err := compilePath(p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "contract path %d (%q)", i, p.Glob)
+		}
+		result = append(result, pc)
+	}
+	return result, nil
+}
+
+func compilePath(p *pfs.PathContract) (*pathContract, error) {
+	if p.Glob == "" {
+		return nil, errors.New("glob must be set")
+	}
+	if p.MinFiles < 0 || p.MaxFiles < 0 || p.MinFileBytes < 0 || p.MaxFileBytes < 0 {
+		return nil, errors.New("bounds cannot be negative")
+	}
+	if p.MaxFiles > 0 && p.MinFiles > p.MaxFiles {
+		return nil, errors.New("min_files cannot exceed max_files")
+	}
+	if p.MaxFileBytes > 0 && p.MinFileBytes > p.MaxFileBytes {
+		return nil, errors.New("min_file_bytes cannot exceed max_file_bytes")
+	}
+	if p.JsonSchema != nil && p.Table != nil {
+		return nil, errors.New("only one of json_schema and table may be set")
+	}
+	g, err := globlib.Compile(cleanPath(p.Glob), '/')
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	pc := &pathContract{PathContract: p, match: g.Match}
+	if p.JsonSchema != nil {
+		schema, err := protojson.Marshal(p.JsonSchema)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		if pc.jsonSchema, err = jsonschema.Compile(schema); err != nil {
+			return nil, errors.Wrap(err, "json_schema")
+		}
+	}
+	if p.Table != nil {
+		if pc.table, err = compileTable(p.Table); err != nil {
+			return nil, errors.Wrap(err, "table")
+		}
+	}
+	return pc, nil
+}
+
+// Validate returns an error if the contract is invalid.
+func Validate(c *pfs.ContentContract) error {
+	_, err := compile(c)
+	return err
+}
+
+// Check checks files against the contract c, returning ErrViolated, with the
+// first violation found, if they don't satisfy it.
+func Check(ctx context.Context, c *pfs.ContentContract, files Files) error {
+	paths, err := compile(c)
+	if err != nil {
+		return err
+	}
+	var count, totalBytes int64
+	if err := files.Walk(func(f File) error {
+		if err := ctx.Err(); err != nil {
+			return errors.EnsureStack(context.Cause(ctx))
+		}
+		count++
+		totalBytes += f.SizeBytes
+		if c.MaxFiles > 0 && count > c.MaxFiles {
+			return violated("there are more than %d files", c.MaxFiles)
+		}
+		if c.MaxTotalBytes > 0 && totalBytes > c.MaxTotalBytes {
+			return violated("the files total more than %d bytes", c.MaxTotalBytes)
+		}
+		for _, pc := range paths {
+			if !pc.match(cleanPath(f.Path)) {
+				continue
+			}
+			if err := pc.checkFile(ctx, files, f); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if count < c.MinFiles {
+		return violated("there are %d files, fewer than %d", count, c.MinFiles)
+	}
+	for _, pc := range paths {
+		if pc.Required && pc.files == 0 {
+			return violated("no file matches required glob %q", pc.Glob)
+		}
+		if pc.files < pc.MinFiles {
+			return violated("%d files match %q, fewer than %d", pc.files, pc.Glob, pc.MinFiles)
+		}
+	}
+	return nil
+}
+
+func (pc *pathContract) checkFile(ctx context.Context, files Files, f File) error {
+	pc.files++
+	if pc.MaxFiles > 0 && pc.files > pc.MaxFiles {
+		return violated("more than %d files match %q", pc.MaxFiles, pc.Glob)
+	}
+	if f.SizeBytes < pc.MinFileBytes {
+		return violated("%s is %d bytes, smaller than the %d bytes required of files matching %q", f.Path, f.SizeBytes, pc.MinFileBytes, pc.Glob)
+	}
+	if pc.MaxFileBytes > 0 && f.SizeBytes > pc.MaxFileBytes {
+		return violated("%s is %d bytes, larger than the %d bytes allowed for files matching %q", f.Path, f.SizeBytes, pc.MaxFileBytes, pc.Glob)
+	}
+	if pc.jsonSchema == nil && pc.table == nil {
+		return nil
+	}
+	return withContent(files, f, func(r *os.File) error {
+		if pc.jsonSchema != nil {
+			return checkJSON(r, pc.jsonSchema, f.Path)
+		}
+		return pc.table.check(r, f)
+	})
+}
+
+// withContent calls cb with a temporary copy of the file's content, since
+// Parquet files can only be read from a seekable reader.
+func withContent(files Files, f File, cb func(r *os.File) error) (retErr error) {
+	tmp, err := os.CreateTemp("", "content-contract-")
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		errors.Close(&retErr, tmp, "close temporary file")
+		errors.JoinInto(&retErr, errors.EnsureStack(os.Remove(tmp.Name())))
+	}()
+	if err := files.Get(f.Path, tmp); err != nil {
+		return errors.Wrapf(err, "get %v", f.Path)
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return cb(tmp)
+}
+
+func cleanPath(p string) string {
+	return "/" + strings.Trim(p, "/")
+}
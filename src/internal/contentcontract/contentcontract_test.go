@@ -0,0 +1,127 @@
+package contentcontract
+
+import (
+	"bytes"
+	"io"
+	"sort"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/sdata"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+type memFiles map[string]string
+
+func (o memFiles) Walk(cb func(File) error) error {
+	var paths []string
+	for p := range o {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	for _, p := range paths {
+		if err := cb(File{Path: p, SizeBytes: int64(len(o[p]))}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o memFiles) Get(path string, w io.Writer) error {
+	_, err := io.WriteString(w, o[path])
+	return errors.EnsureStack(err)
+}
+
+func parseContract(t *testing.T, spec string) *pfs.ContentContract {
+	t.Helper()
+	c := &pfs.ContentContract{}
+	require.NoError(t, protojson.Unmarshal([]byte(spec), c))
+	require.NoError(t, Validate(c))
+	return c
+}
+
+// requireViolation checks files against the contract spec, and requires a
+// violation with the given reason, or none if reason is empty.
+func requireViolation(t *testing.T, spec string, files memFiles, reason string) {
+	t.Helper()
+	err := Check(pctx.TestContext(t), parseContract(t, spec), files)
+	if reason == "" {
+		require.NoError(t, err)
+		return
+	}
+	var violation ErrViolated
+	require.True(t, errors.As(err, &violation), "expected a violation, got %v", err)
+	require.Equal(t, reason, violation.Reason)
+}
+
+func TestValidate(t *testing.T) {
+	for _, spec := range []string{
+		`{"minFiles": 2, "maxFiles": 1}`,
+		`{"paths": [{}]}`,
+		`{"paths": [{"glob": "/a", "maxFileBytes": -1}]}`,
+		`{"paths": [{"glob": "/a", "table": {"format": "xml", "columns": [{"name": "a", "type": "string"}]}}]}`,
+		`{"paths": [{"glob": "/a", "table": {"format": "csv", "columns": [{"name": "a", "type": "decimal"}]}}]}`,
+		`{"paths": [{"glob": "/a", "jsonSchema": {"type": "nothing"}}]}`,
+	} {
+		c := &pfs.ContentContract{}
+		require.NoError(t, protojson.Unmarshal([]byte(spec), c))
+		require.YesError(t, Validate(c), "spec %s", spec)
+	}
+}
+
+func TestBounds(t *testing.T) {
+	files := memFiles{"/a/1.csv": "x", "/a/2.csv": "yy", "/b": ""}
+	requireViolation(t, `{"minFiles": 3, "maxFiles": 3, "maxTotalBytes": 3}`, files, "")
+	requireViolation(t, `{"maxFiles": 2}`, files, "there are more than 2 files")
+	requireViolation(t, `{"minFiles": 4}`, files, "there are 3 files, fewer than 4")
+	requireViolation(t, `{"maxTotalBytes": 2}`, files, "the files total more than 2 bytes")
+	requireViolation(t, `{"paths": [{"glob": "/c/*", "required": true}]}`, files, `no file matches required glob "/c/*"`)
+	requireViolation(t, `{"paths": [{"glob": "a/*.csv", "minFiles": 3}]}`, files, `2 files match "a/*.csv", fewer than 3`)
+	requireViolation(t, `{"paths": [{"glob": "/a/*", "maxFiles": 1}]}`, files, `more than 1 files match "/a/*"`)
+	requireViolation(t, `{"paths": [{"glob": "/a/*", "maxFileBytes": 1}]}`, files, `/a/2.csv is 2 bytes, larger than the 1 bytes allowed for files matching "/a/*"`)
+	requireViolation(t, `{"paths": [{"glob": "/**", "minFileBytes": 1}]}`, files, `/b is 0 bytes, smaller than the 1 bytes required of files matching "/**"`)
+}
+
+func TestJSONSchema(t *testing.T) {
+	spec := `{"paths": [{"glob": "/*.json", "jsonSchema": {"type": "object", "required": ["id"], "properties": {"id": {"type": "integer"}}}}]}`
+	requireViolation(t, spec, memFiles{"/a.json": "{\"id\": 1}\n{\"id\": 2}\n"}, "")
+	files := memFiles{"/a.json": "{\"id\": 1}\n{\"id\": 2.5}\n"}
+	err := Check(pctx.TestContext(t), parseContract(t, spec), files)
+	require.YesError(t, err)
+	require.Matches(t, `/a.json: record 2 does not match the JSON schema`, err.Error())
+}
+
+func TestTable(t *testing.T) {
+	columns := `"columns": [{"name": "id", "type": "int64"}, {"name": "name", "type": "string", "nullable": true}]`
+	csv := `{"paths": [{"glob": "/*.csv", "table": {"format": "csv", "header": true, ` + columns + `}}]}`
+	requireViolation(t, csv, memFiles{"/a.csv": "name,id\nx,1\n,2\n"}, "")
+	requireViolation(t, csv, memFiles{"/a.csv": "id,name\n1,x\n,y\n"}, `/a.csv: row 2: column "id" is null`)
+	requireViolation(t, csv, memFiles{"/a.csv": "id\n1\n"}, `/a.csv: missing column "name"`)
+	requireViolation(t, csv, memFiles{"/a.csv": "id,name,extra\n1,x,y\n"}, `/a.csv: unexpected column "extra"`)
+	err := Check(pctx.TestContext(t), parseContract(t, csv), memFiles{"/a.csv": "id,name\none,x\n"})
+	require.YesError(t, err)
+	require.Matches(t, `/a.csv: row 1: `, err.Error())
+
+	headerless := `{"paths": [{"glob": "/*.csv", "table": {"format": "csv", ` + columns + `}}]}`
+	requireViolation(t, headerless, memFiles{"/a.csv": "1,x\n2,y\n"}, "")
+	requireViolation(t, headerless, memFiles{"/a.csv": "1,x,z\n"}, "/a.csv: has 3 columns, more than the 2 expected")
+
+	json := `{"paths": [{"glob": "/*.json", "table": {"format": "json", ` + columns + `}}]}`
+	requireViolation(t, json, memFiles{"/a.json": "{\"id\": 1, \"name\": \"x\"}\n{\"id\": 2}\n"}, "")
+	requireViolation(t, json, memFiles{"/a.json": "{\"name\": \"x\"}\n"}, `/a.json: row 1: column "id" is null`)
+
+	var buf bytes.Buffer
+	id, name := int64(1), "x"
+	pw, err := sdata.NewParquetWriter(&buf, []string{"id", "name"}, sdata.Tuple{&id, &name})
+	require.NoError(t, err)
+	require.NoError(t, pw.WriteTuple(sdata.Tuple{&id, &name}))
+	require.NoError(t, pw.Flush())
+	parquet := `{"paths": [{"glob": "/*.parquet", "table": {"format": "parquet", ` + columns + `}}]}`
+	requireViolation(t, parquet, memFiles{"/a.parquet": buf.String()}, "")
+	wrongType := `{"paths": [{"glob": "/*.parquet", "table": {"format": "parquet", "columns": [{"name": "id", "type": "string"}, {"name": "name", "type": "string"}]}}]}`
+	requireViolation(t, wrongType, memFiles{"/a.parquet": buf.String()}, `/a.parquet: column "id" is int64, not string`)
+}
@@ -0,0 +1,204 @@
+package contentcontract
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/jsonschema"
+	"github.com/pachyderm/pachyderm/v2/src/internal/sdata"
+	"github.com/pachyderm/pachyderm/v2/src/internal/sdata/csv"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// checkJSON validates each of the JSON values in r against schema.
+func checkJSON(r io.Reader, schema *jsonschema.Schema, path string) error {
+	dec := json.NewDecoder(r)
+	for i := 1; ; i++ {
+		var record json.RawMessage
+		if err := dec.Decode(&record); errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return violated("%s: record %d is not valid JSON: %v", path, i, err)
+		}
+		if err := schema.Validate(record); err != nil {
+			return violated("%s: record %d does not match the JSON schema: %v", path, i, err)
+		}
+	}
+}
+
+// columnTypes are the types that a TableColumn can have, and the tuple
+// elements that values of them are parsed into.
+var columnTypes = map[string]func() interface{}{
+	"bool":      func() interface{} { return new(sql.NullBool) },
+	"int64":     func() interface{} { return new(sql.NullInt64) },
+	"double":    func() interface{} { return new(sql.NullFloat64) },
+	"string":    func() interface{} { return new(sql.NullString) },
+	"timestamp": func() interface{} { return new(sql.NullTime) },
+}
+
+type table struct {
+	*pfs.TableSchema
+	index map[string]int
+}
+
+func compileTable(t *pfs.TableSchema) (*table, error) {
+	switch t.Format {
+	case "csv", "json", "parquet":
+	default:
+		return nil, errors.Errorf("format must be csv, json or parquet, not %q", t.Format)
+	}
+	if len(t.Columns) == 0 {
+		return nil, errors.New("columns must be set")
+	}
+	index := make(map[string]int)
+	for i, c := range t.Columns {
+		if c.Name == "" {
+			return nil, errors.Errorf("column %d has no name", i)
+		}
+		if _, ok := index[c.Name]; ok {
+			return nil, errors.Errorf("duplicate column %q", c.Name)
+		}
+		if _, ok := columnTypes[c.Type]; !ok {
+			return nil, errors.Errorf("column %q has unknown type %q; must be bool, int64, double, string or timestamp", c.Name, c.Type)
+		}
+		index[c.Name] = i
+	}
+	return &table{TableSchema: t, index: index}, nil
+}
+
+// check checks that the rows of the file f, read from r, have the table's
+// columns.
+func (t *table) check(r *os.File, f File) error {
+	var (
+		names []string
+		tr    sdata.TupleReader
+		// parsed is the tuple that a Parquet file's rows are read into,
+		// whose types are those of its columns.
+		parsed sdata.Tuple
+	)
+	switch t.Format {
+	case "csv":
+		record, err := csv.NewReader(r).Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return violated("%s: invalid CSV: %v", f.Path, err)
+		}
+		if _, err := r.Seek(0, io.SeekStart); err != nil {
+			return errors.EnsureStack(err)
+		}
+		p := sdata.NewCSVParser(r)
+		if t.Header {
+			for _, name := range record {
+				if name == nil {
+					return violated("%s: the header has an empty column name", f.Path)
+				}
+				names = append(names, *name)
+			}
+			p = p.WithHeaderFields(names)
+		} else {
+			for i := range record {
+				if i < len(t.Columns) {
+					names = append(names, t.Columns[i].Name)
+				} else {
+					names = append(names, "")
+				}
+			}
+		}
+		tr = p
+	case "json":
+		// Each record is parsed as having exactly the table's columns, which
+		// are null when they're missing.
+		for _, c := range t.Columns {
+			names = append(names, c.Name)
+		}
+		tr = sdata.NewJSONParser(r, names)
+	case "parquet":
+		p, err := sdata.NewParquetParser(r, f.SizeBytes)
+		if err != nil {
+			return violated("%s: invalid Parquet file: %v", f.Path, err)
+		}
+		names = p.FieldNames()
+		parsed = p.NewTuple()
+		tr = p
+	}
+	row, err := t.tuple(names, parsed, f)
+	if err != nil {
+		return err
+	}
+	for i := 1; ; i++ {
+		if err := tr.Next(row); errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return violated("%s: row %d: %v", f.Path, i, err)
+		}
+		for j, name := range names {
+			k, ok := t.index[name]
+			if !ok || t.Columns[k].Nullable {
+				continue
+			}
+			if v, _ := row[j].(driver.Valuer).Value(); v == nil {
+				return violated("%s: row %d: column %q is null", f.Path, i, name)
+			}
+		}
+	}
+}
+
+// tuple returns a tuple for rows with the named columns, after checking them
+// against the table's.  If parsed is set, it's the tuple to use, and its types
+// are checked too.
+func (t *table) tuple(names []string, parsed sdata.Tuple, f File) (sdata.Tuple, error) {
+	seen := make(map[string]bool)
+	row := make(sdata.Tuple, len(names))
+	for i, name := range names {
+		k, ok := t.index[name]
+		if !ok {
+			if !t.AllowExtraColumns {
+				if name == "" {
+					return nil, violated("%s: has %d columns, more than the %d expected", f.Path, len(names), len(t.Columns))
+				}
+				return nil, violated("%s: unexpected column %q", f.Path, name)
+			}
+			// Extra columns are parsed, but not checked.
+			row[i] = new(sql.NullString)
+			continue
+		}
+		if seen[name] {
+			return nil, violated("%s: duplicate column %q", f.Path, name)
+		}
+		seen[name] = true
+		want := t.Columns[k].Type
+		if parsed == nil {
+			row[i] = columnTypes[want]()
+			continue
+		}
+		if got := typeOf(parsed[i]); got != want {
+			return nil, violated("%s: column %q is %s, not %s", f.Path, name, got, want)
+		}
+	}
+	if parsed != nil {
+		row = parsed
+	}
+	for _, c := range t.Columns {
+		if !seen[c.Name] {
+			return nil, violated("%s: missing column %q", f.Path, c.Name)
+		}
+	}
+	return row, nil
+}
+
+// typeOf returns the column type of a tuple element.
+func typeOf(x interface{}) string {
+	for typ, f := range columnTypes {
+		if reflect.TypeOf(f()) == reflect.TypeOf(x) {
+			return typ
+		}
+	}
+	return fmt.Sprintf("%T", x)
+}
@@ -9,6 +9,7 @@ import (
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
@@ -89,6 +90,13 @@ type Project struct {
 	Description string    `db:"description"`
 	CreatedAt   time.Time `db:"created_at"`
 	UpdatedAt   time.Time `db:"updated_at"`
+
+	QuotaMaxBytes     int64  `db:"quota_max_bytes"`
+	QuotaEnforcement  int32  `db:"quota_enforcement"`
+	QuotaMaxWorkers   int64  `db:"quota_max_workers"`
+	QuotaMaxCPU       string `db:"quota_max_cpu"`
+	QuotaMaxMemory    string `db:"quota_max_memory"`
+	PriorityClassName string `db:"priority_class_name"`
 }
 
 func (project *Project) Pb() *pfs.Project {
@@ -119,6 +127,7 @@ func (iter *ProjectIterator) Next(ctx context.Context, dst **pfs.ProjectInfo) er
 		Project:     &pfs.Project{Name: row.Name},
 		Description: row.Description,
 		CreatedAt:   timestamppb.New(row.CreatedAt),
+		Quota:       row.quotaPb(),
 	}
 	iter.index++
 	return nil
@@ -141,7 +150,7 @@ func ListProject(ctx context.Context, tx *pachsql.Tx) (*ProjectIterator, error)
 
 func listProject(ctx context.Context, tx *pachsql.Tx, limit, offset int) ([]Project, error) {
 	var page []Project
-	if err := tx.SelectContext(ctx, &page, "SELECT name,description,created_at FROM core.projects ORDER BY id ASC LIMIT $1 OFFSET $2", limit, offset); err != nil {
+	if err := tx.SelectContext(ctx, &page, "SELECT name,description,created_at,quota_max_bytes,quota_enforcement,quota_max_workers,quota_max_cpu,quota_max_memory,priority_class_name FROM core.projects ORDER BY id ASC LIMIT $1 OFFSET $2", limit, offset); err != nil {
 		return nil, errors.Wrap(err, "could not get project page")
 
 	}
@@ -150,7 +159,9 @@ func listProject(ctx context.Context, tx *pachsql.Tx, limit, offset int) ([]Proj
 
 // CreateProject creates an entry in the core.projects table.
 func CreateProject(ctx context.Context, tx *pachsql.Tx, project *pfs.ProjectInfo) error {
-	_, err := tx.ExecContext(ctx, "INSERT INTO core.projects (name, description) VALUES ($1, $2);", project.Project.Name, project.Description)
+	q := project.Quota
+	_, err := tx.ExecContext(ctx, "INSERT INTO core.projects (name, description, quota_max_bytes, quota_enforcement, quota_max_workers, quota_max_cpu, quota_max_memory, priority_class_name) VALUES ($1, $2, $3, $4, $5, $6, $7, $8);",
+		project.Project.Name, project.Description, q.GetMaxBytes(), int32(q.GetEnforcement()), q.GetMaxWorkers(), q.GetMaxCpu(), q.GetMaxMemory(), q.GetPriorityClassName())
 	//todo: insert project.authInfo into auth table.
 	if err != nil && IsErrProjectAlreadyExists(err) {
 		return ErrProjectAlreadyExists{Name: project.Project.Name}
@@ -187,10 +198,8 @@ func GetProjectByName(ctx context.Context, tx *pachsql.Tx, projectName string) (
 }
 
 func getProject(ctx context.Context, tx *pachsql.Tx, where string, whereVal interface{}) (*pfs.ProjectInfo, error) {
-	row := tx.QueryRowxContext(ctx, fmt.Sprintf("SELECT name, description, created_at FROM core.projects WHERE %s = $1", where), whereVal)
-	project := &pfs.ProjectInfo{Project: &pfs.Project{}}
-	var createdAt time.Time
-	err := row.Scan(&project.Project.Name, &project.Description, &createdAt)
+	row := &Project{}
+	err := tx.GetContext(ctx, row, fmt.Sprintf("SELECT name, description, created_at, quota_max_bytes, quota_enforcement, quota_max_workers, quota_max_cpu, quota_max_memory, priority_class_name FROM core.projects WHERE %s = $1", where), whereVal)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			if name, ok := whereVal.(string); ok {
@@ -200,8 +209,29 @@ func getProject(ctx context.Context, tx *pachsql.Tx, where string, whereVal inte
 		}
 		return nil, errors.Wrap(err, "scanning project row")
 	}
-	project.CreatedAt = timestamppb.New(createdAt)
-	return project, nil
+	return &pfs.ProjectInfo{
+		Project:     &pfs.Project{Name: row.Name},
+		Description: row.Description,
+		CreatedAt:   timestamppb.New(row.CreatedAt),
+		Quota:       row.quotaPb(),
+	}, nil
+}
+
+// quotaPb returns the quota stored in a project row, or nil if the project has
+// no quota.
+func (project *Project) quotaPb() *pfs.ProjectQuota {
+	q := &pfs.ProjectQuota{
+		MaxBytes:          project.QuotaMaxBytes,
+		Enforcement:       pfs.ProjectQuota_Enforcement(project.QuotaEnforcement),
+		MaxWorkers:        project.QuotaMaxWorkers,
+		MaxCpu:            project.QuotaMaxCPU,
+		MaxMemory:         project.QuotaMaxMemory,
+		PriorityClassName: project.PriorityClassName,
+	}
+	if proto.Equal(q, &pfs.ProjectQuota{}) {
+		return nil
+	}
+	return q
 }
 
 // UpsertProject updates all fields of an existing project entry in the core.projects table by name. If 'upsert' is set to true, UpsertProject()
@@ -216,8 +246,9 @@ func UpdateProject(ctx context.Context, tx *pachsql.Tx, id ProjectID, project *p
 }
 
 func updateProject(ctx context.Context, tx *pachsql.Tx, project *pfs.ProjectInfo, where string, whereVal interface{}, upsert bool) error {
-	res, err := tx.ExecContext(ctx, fmt.Sprintf("UPDATE core.projects SET name = $1, description = $2 WHERE %s = $3;", where),
-		project.Project.Name, project.Description, whereVal)
+	q := project.Quota
+	res, err := tx.ExecContext(ctx, fmt.Sprintf("UPDATE core.projects SET name = $1, description = $2, quota_max_bytes = $3, quota_enforcement = $4, quota_max_workers = $5, quota_max_cpu = $6, quota_max_memory = $7, priority_class_name = $8 WHERE %s = $9;", where),
+		project.Project.Name, project.Description, q.GetMaxBytes(), int32(q.GetEnforcement()), q.GetMaxWorkers(), q.GetMaxCpu(), q.GetMaxMemory(), q.GetPriorityClassName(), whereVal)
 	if err != nil {
 		return errors.Wrap(err, "update project")
 	}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/clusterstate"
@@ -153,3 +154,30 @@ func TestUpdateProjectByID(t *testing.T) {
 		return nil
 	}))
 }
+
+func TestProjectQuota(t *testing.T) {
+	t.Parallel()
+	ctx := pctx.TestContext(t)
+	db := dockertestenv.NewTestDB(t)
+	migrationEnv := migrations.Env{EtcdClient: testetcd.NewEnv(ctx, t).EtcdClient}
+	require.NoError(t, migrations.ApplyMigrations(ctx, db, migrationEnv, clusterstate.DesiredClusterState), "should be able to set up tables")
+	require.NoError(t, dbutil.WithTx(ctx, db, func(cbCtx context.Context, tx *pachsql.Tx) error {
+		projInfo := &pfs.ProjectInfo{Project: &pfs.Project{Name: testProj}, Description: testProjDesc, Quota: &pfs.ProjectQuota{MaxBytes: 1024, Enforcement: pfs.ProjectQuota_HARD}}
+		require.NoError(t, CreateProject(cbCtx, tx, projInfo), "should be able to create project")
+		getInfo, err := GetProjectByName(cbCtx, tx, testProj)
+		require.NoError(t, err, "should be able to get a project")
+		require.Equal(t, int64(1024), getInfo.Quota.GetMaxBytes())
+		require.Equal(t, pfs.ProjectQuota_HARD, getInfo.Quota.GetEnforcement())
+		projInfo.Quota = nil
+		require.NoError(t, UpsertProject(cbCtx, tx, projInfo), "should be able to remove the quota")
+		getInfo, err = GetProjectByName(cbCtx, tx, testProj)
+		require.NoError(t, err, "should be able to get a project")
+		require.Nil(t, getInfo.Quota)
+		projInfo.Quota = &pfs.ProjectQuota{MaxWorkers: 4, MaxCpu: "8", MaxMemory: "16Gi", PriorityClassName: "high"}
+		require.NoError(t, UpsertProject(cbCtx, tx, projInfo), "should be able to set a compute quota")
+		getInfo, err = GetProjectByName(cbCtx, tx, testProj)
+		require.NoError(t, err, "should be able to get a project")
+		require.True(t, proto.Equal(projInfo.Quota, getInfo.Quota), "compute quota should round-trip")
+		return nil
+	}))
+}
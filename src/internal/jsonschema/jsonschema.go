@@ -1,7 +1,52 @@
-// Package jsonschema bundles the generated JSON schemas.
+// Package jsonschema bundles the generated JSON schemas, and compiles
+// user-supplied ones.
 package jsonschema
 
-import "embed"
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"io"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
 
 //go:embed */*.json
 var FS embed.FS
+
+// Schema is a compiled JSON Schema.
+type Schema struct {
+	s *jsonschema.Schema
+}
+
+// Compile compiles a JSON Schema.  The schema must be self-contained:
+// references to other documents are an error, rather than being fetched.
+func Compile(schema []byte) (*Schema, error) {
+	c := jsonschema.NewCompiler()
+	c.LoadURL = func(url string) (io.ReadCloser, error) {
+		return nil, errors.Errorf("schema refers to %v, but only self-contained schemas are supported", url)
+	}
+	const url = "schema.json"
+	if err := c.AddResource(url, bytes.NewReader(schema)); err != nil {
+		return nil, errors.Wrap(err, "load schema")
+	}
+	s, err := c.Compile(url)
+	if err != nil {
+		return nil, errors.Wrap(err, "compile schema")
+	}
+	return &Schema{s: s}, nil
+}
+
+// Validate validates a JSON document against the schema.
+func (s *Schema) Validate(doc []byte) error {
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(doc))
+	// Numbers are decoded exactly, so that integer keywords see integers.
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(s.s.Validate(v))
+}
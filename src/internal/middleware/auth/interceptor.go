@@ -63,6 +63,11 @@ var authHandlers = map[string]authHandler{
 	"/auth_v2.API/RevokeAuthTokensForUser":    clusterPermissions(auth.Permission_CLUSTER_AUTH_REVOKE_USER_TOKENS),
 	"/auth_v2.API/RotateRootToken":            clusterPermissions(auth.Permission_CLUSTER_AUTH_ROTATE_ROOT_TOKEN),
 
+	// GetScopedToken and ListAuthTokens check the caller's token themselves,
+	// as they depend on its expiration and scopes
+	"/auth_v2.API/GetScopedToken": unauthenticated,
+	"/auth_v2.API/ListAuthTokens": unauthenticated,
+
 	//
 	// Debug API
 	//
@@ -143,6 +148,7 @@ var authHandlers = map[string]authHandler{
 	"/pfs_v2.API/ListCommit":       authDisabledOr(authenticated),
 	"/pfs_v2.API/SubscribeCommit":  authDisabledOr(authenticated),
 	"/pfs_v2.API/ClearCommit":      authDisabledOr(authenticated),
+	"/pfs_v2.API/ApproveCommit":    authDisabledOr(authenticated),
 	"/pfs_v2.API/InspectCommitSet": authDisabledOr(authenticated),
 	"/pfs_v2.API/ListCommitSet":    authDisabledOr(authenticated),
 	"/pfs_v2.API/SquashCommitSet":  authDisabledOr(authenticated),
@@ -182,6 +188,28 @@ var authHandlers = map[string]authHandler{
 	"/pfs_v2.API/ListTask":       authDisabledOr(authenticated),
 	"/pfs_v2.API/Egress":         authDisabledOr(authenticated),
 
+	"/pfs_v2.API/CreateReplication":  authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_MANAGE_REPLICATIONS)),
+	"/pfs_v2.API/InspectReplication": authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_MANAGE_REPLICATIONS)),
+	"/pfs_v2.API/ListReplication":    authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_MANAGE_REPLICATIONS)),
+	"/pfs_v2.API/DeleteReplication":  authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_MANAGE_REPLICATIONS)),
+	"/pfs_v2.API/MissingChunks":      authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_RECEIVE_REPLICATION)),
+	"/pfs_v2.API/ReplicateFileSet":   authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_RECEIVE_REPLICATION)),
+
+	"/pfs_v2.API/SetRetentionPolicy":     authDisabledOr(authenticated),
+	"/pfs_v2.API/ListRetentionPolicy":    authDisabledOr(authenticated),
+	"/pfs_v2.API/EnforceRetentionPolicy": authDisabledOr(authenticated),
+	"/pfs_v2.API/CreateUploadSession":    authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectUploadSession":   authDisabledOr(authenticated),
+	"/pfs_v2.API/ListUploadSession":      authDisabledOr(authenticated),
+	"/pfs_v2.API/UploadPart":             authDisabledOr(authenticated),
+	"/pfs_v2.API/CommitUploadSession":    authDisabledOr(authenticated),
+	"/pfs_v2.API/DeleteUploadSession":    authDisabledOr(authenticated),
+
+	"/pfs_v2.API/SetCommitValidation":  authDisabledOr(authenticated),
+	"/pfs_v2.API/ListCommitValidation": authDisabledOr(authenticated),
+	"/pfs_v2.API/SetStoragePlacement":  authDisabledOr(authenticated),
+	"/pfs_v2.API/ListStoragePlacement": authDisabledOr(authenticated),
+
 	//
 	// PPS API
 	//
@@ -217,18 +245,24 @@ var authHandlers = map[string]authHandler{
 	"/pps_v2.API/ActivateAuth":     clusterPermissions(auth.Permission_CLUSTER_AUTH_ACTIVATE),
 	"/pps_v2.API/DeleteAll":        authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_DELETE_ALL)),
 
-	"/pps_v2.API/CreateSecret":       authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_CREATE_SECRET)),
-	"/pps_v2.API/ListSecret":         authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_LIST_SECRETS)),
-	"/pps_v2.API/DeleteSecret":       authDisabledOr(clusterPermissions(auth.Permission_SECRET_DELETE)),
-	"/pps_v2.API/InspectSecret":      authDisabledOr(clusterPermissions(auth.Permission_SECRET_INSPECT)),
-	"/pps_v2.API/RunLoadTest":        authDisabledOr(authenticated),
-	"/pps_v2.API/RunLoadTestDefault": authDisabledOr(authenticated),
-	"/pps_v2.API/RenderTemplate":     authDisabledOr(authenticated),
-	"/pps_v2.API/ListTask":           authDisabledOr(authenticated),
-	"/pps_v2.API/GetKubeEvents":      authDisabledOr(authenticated),
-	"/pps_v2.API/QueryLoki":          authDisabledOr(authenticated),
-	"/pps_v2.API/GetClusterDefaults": authDisabledOr(authenticated),
-	"/pps_v2.API/SetClusterDefaults": authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_SET_DEFAULTS)),
+	"/pps_v2.API/CreateSecret":           authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_CREATE_SECRET)),
+	"/pps_v2.API/ListSecret":             authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_LIST_SECRETS)),
+	"/pps_v2.API/DeleteSecret":           authDisabledOr(clusterPermissions(auth.Permission_SECRET_DELETE)),
+	"/pps_v2.API/InspectSecret":          authDisabledOr(clusterPermissions(auth.Permission_SECRET_INSPECT)),
+	"/pps_v2.API/RunLoadTest":            authDisabledOr(authenticated),
+	"/pps_v2.API/RunLoadTestDefault":     authDisabledOr(authenticated),
+	"/pps_v2.API/RenderTemplate":         authDisabledOr(authenticated),
+	"/pps_v2.API/ListTask":               authDisabledOr(authenticated),
+	"/pps_v2.API/GetKubeEvents":          authDisabledOr(authenticated),
+	"/pps_v2.API/QueryLoki":              authDisabledOr(authenticated),
+	"/pps_v2.API/GetClusterDefaults":     authDisabledOr(authenticated),
+	"/pps_v2.API/SetClusterDefaults":     authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_SET_DEFAULTS)),
+	"/pps_v2.API/GetProjectComputeUsage": authDisabledOr(authenticated),
+
+	"/pps_v2.API/CreateNotification":       authDisabledOr(authenticated),
+	"/pps_v2.API/ListNotification":         authDisabledOr(authenticated),
+	"/pps_v2.API/DeleteNotification":       authDisabledOr(authenticated),
+	"/pps_v2.API/ListNotificationDelivery": authDisabledOr(authenticated),
 
 	//
 	// TransactionAPI
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -29,6 +30,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/promutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 )
 
 type amazonClient struct {
@@ -200,6 +202,20 @@ func (c *amazonClient) Walk(ctx context.Context, name string, fn func(name strin
 }
 
 func (c *amazonClient) Get(ctx context.Context, name string, w io.Writer) (retErr error) {
+	return c.get(ctx, name, nil, w)
+}
+
+func (c *amazonClient) GetRange(ctx context.Context, name string, offset, length int64, w io.Writer) error {
+	rng := fmt.Sprintf("bytes=%d-", offset)
+	if length >= 0 {
+		rng = fmt.Sprintf("bytes=%d-%d", offset, offset+length-1)
+	}
+	return c.get(ctx, name, &rng, w)
+}
+
+// get writes an object, or the range of it given by rng, an HTTP Range header,
+// if it isn't nil, to w.
+func (c *amazonClient) get(ctx context.Context, name string, rng *string, w io.Writer) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, name) }()
 	var reader io.ReadCloser
 	if c.cloudfrontDistribution != "" {
@@ -218,6 +234,9 @@ func (c *amazonClient) Get(ctx context.Context, name string, w io.Writer) (retEr
 		if err != nil {
 			return errors.EnsureStack(err)
 		}
+		if rng != nil {
+			req.Header.Set("Range", *rng)
+		}
 		backoff.RetryNotify(func() (retErr error) { //nolint:errcheck
 			span, _ := tracing.AddSpanToAnyExisting(ctx, "/Amazon.Cloudfront/Get")
 			defer func() {
@@ -244,6 +263,7 @@ func (c *amazonClient) Get(ctx context.Context, name string, w io.Writer) (retEr
 		objIn := &s3.GetObjectInput{
 			Bucket: aws.String(c.bucket),
 			Key:    aws.String(name),
+			Range:  rng,
 		}
 		getObjectOutput, err := c.s3.GetObjectWithContext(ctx, objIn)
 		if err != nil {
@@ -285,6 +305,158 @@ func (c *amazonClient) Exists(ctx context.Context, name string) (bool, error) {
 	return true, nil
 }
 
+func (c *amazonClient) Stat(ctx context.Context, name string) (_ *ObjectInfo, retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	out, err := c.s3.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(name),
+	})
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return &ObjectInfo{
+		Size:    aws.Int64Value(out.ContentLength),
+		ETag:    aws.StringValue(out.ETag),
+		ModTime: aws.TimeValue(out.LastModified),
+	}, nil
+}
+
+const (
+	// maxCopySize is the size of the largest object S3 copies in one request.
+	maxCopySize = 5 * 1024 * 1024 * 1024
+	// copyPartSize is the size of the parts of larger objects, which are
+	// copied in parts.
+	copyPartSize = 1024 * 1024 * 1024
+)
+
+func (c *amazonClient) Copy(ctx context.Context, src, dst string) (retErr error) {
+	defer func() { retErr = c.transformError(retErr, src) }()
+	info, err := c.Stat(ctx, src)
+	if err != nil {
+		return err
+	}
+	copySource := url.PathEscape(c.bucket + "/" + src)
+	if info.Size <= maxCopySize {
+		_, err := c.s3.CopyObjectWithContext(ctx, &s3.CopyObjectInput{
+			ACL:        aws.String(c.advancedConfig.UploadACL),
+			Bucket:     aws.String(c.bucket),
+			Key:        aws.String(dst),
+			CopySource: aws.String(copySource),
+		})
+		return errors.EnsureStack(err)
+	}
+	mu, err := c.newMultipartUpload(ctx, dst)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			errors.JoinInto(&retErr, mu.Abort(ctx))
+		}
+	}()
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(concurrency)
+	for offset, n := int64(0), 1; offset < info.Size; offset, n = offset+copyPartSize, n+1 {
+		offset, n := offset, n
+		end := offset + copyPartSize - 1
+		if end >= info.Size {
+			end = info.Size - 1
+		}
+		eg.Go(func() error {
+			out, err := c.s3.UploadPartCopyWithContext(egCtx, &s3.UploadPartCopyInput{
+				Bucket:          aws.String(c.bucket),
+				Key:             aws.String(dst),
+				UploadId:        mu.id,
+				PartNumber:      aws.Int64(int64(n)),
+				CopySource:      aws.String(copySource),
+				CopySourceRange: aws.String(fmt.Sprintf("bytes=%d-%d", offset, end)),
+			})
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			mu.parts.put(n, out.CopyPartResult.ETag)
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return mu.Complete(ctx)
+}
+
+func (c *amazonClient) NewMultipartUpload(ctx context.Context, name string) (MultipartUpload, error) {
+	return c.newMultipartUpload(ctx, name)
+}
+
+func (c *amazonClient) newMultipartUpload(ctx context.Context, name string) (_ *amazonMultipartUpload, retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	out, err := c.s3.CreateMultipartUploadWithContext(ctx, &s3.CreateMultipartUploadInput{
+		ACL:             aws.String(c.advancedConfig.UploadACL),
+		Bucket:          aws.String(c.bucket),
+		Key:             aws.String(name),
+		ContentEncoding: aws.String("application/octet-stream"),
+	})
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return &amazonMultipartUpload{c: c, name: name, id: out.UploadId}, nil
+}
+
+type amazonMultipartUpload struct {
+	c     *amazonClient
+	name  string
+	id    *string
+	parts partSet[*string]
+}
+
+func (u *amazonMultipartUpload) PutPart(ctx context.Context, n int, data []byte) (retErr error) {
+	defer func() { retErr = u.c.transformError(retErr, u.name) }()
+	if err := checkPartNumber(n); err != nil {
+		return err
+	}
+	out, err := u.c.s3.UploadPartWithContext(ctx, &s3.UploadPartInput{
+		Bucket:     aws.String(u.c.bucket),
+		Key:        aws.String(u.name),
+		UploadId:   u.id,
+		PartNumber: aws.Int64(int64(n)),
+		Body:       bytes.NewReader(data),
+	})
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	u.parts.put(n, out.ETag)
+	return nil
+}
+
+func (u *amazonMultipartUpload) Complete(ctx context.Context) (retErr error) {
+	defer func() { retErr = u.c.transformError(retErr, u.name) }()
+	etags, err := u.parts.list()
+	if err != nil {
+		return err
+	}
+	parts := make([]*s3.CompletedPart, len(etags))
+	for i, etag := range etags {
+		parts[i] = &s3.CompletedPart{ETag: etag, PartNumber: aws.Int64(int64(i + 1))}
+	}
+	_, err = u.c.s3.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(u.c.bucket),
+		Key:             aws.String(u.name),
+		UploadId:        u.id,
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: parts},
+	})
+	return errors.EnsureStack(err)
+}
+
+func (u *amazonMultipartUpload) Abort(ctx context.Context) (retErr error) {
+	defer func() { retErr = u.c.transformError(retErr, u.name) }()
+	_, err := u.c.s3.AbortMultipartUploadWithContext(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(u.c.bucket),
+		Key:      aws.String(u.name),
+		UploadId: u.id,
+	})
+	return errors.EnsureStack(err)
+}
+
 func (c *amazonClient) BucketURL() ObjectStoreURL {
 	return ObjectStoreURL{
 		Scheme: "s3",
@@ -3,6 +3,7 @@ package obj
 import (
 	"context"
 	"io"
+	"time"
 )
 
 // Client is an interface to object storage.
@@ -32,3 +33,66 @@ type Client interface {
 	// BucketURL returns the URL of the bucket this client uses.
 	BucketURL() ObjectStoreURL
 }
+
+// ObjectInfo is the metadata of an object.
+type ObjectInfo struct {
+	Size int64
+	// ETag identifies the content of the object.  It is empty if the client
+	// doesn't know it.
+	ETag    string
+	ModTime time.Time
+}
+
+// The following interfaces are capabilities that a Client may implement.  The
+// functions of the same names use them when a client does, and fall back to
+// the methods of Client when it doesn't.
+
+// RangeGetter is implemented by clients that can read part of an object.
+type RangeGetter interface {
+	// GetRange writes length bytes of an object, starting at offset, to w.
+	// If length is negative, it writes the rest of the object.
+	GetRange(ctx context.Context, name string, offset, length int64, w io.Writer) error
+}
+
+// Stater is implemented by clients that can read the metadata of an object
+// without reading the object.
+type Stater interface {
+	// Stat returns the metadata of an object.
+	// It should error if the object doesn't exist.
+	Stat(ctx context.Context, name string) (*ObjectInfo, error)
+}
+
+// Copier is implemented by clients that can copy an object within their bucket
+// without reading it.
+type Copier interface {
+	// Copy copies the object at src to dst, replacing any object at dst.
+	// It should error if src doesn't exist.
+	Copy(ctx context.Context, src, dst string) error
+}
+
+// MultipartPutter is implemented by clients that can write an object in
+// parts, which may be written concurrently and retried independently.
+type MultipartPutter interface {
+	NewMultipartUpload(ctx context.Context, name string) (MultipartUpload, error)
+}
+
+const (
+	// MinPartSize is the minimum size of every part of a multipart upload
+	// but the last.
+	MinPartSize = 5 * 1024 * 1024
+	// MaxParts is the maximum number of parts of a multipart upload.
+	MaxParts = 10000
+)
+
+// MultipartUpload is an object being written in parts.
+type MultipartUpload interface {
+	// PutPart writes part n of the object.  Parts are numbered from 1, and
+	// may be written concurrently and in any order.  Writing a part again
+	// replaces it.
+	PutPart(ctx context.Context, n int, data []byte) error
+	// Complete writes the object from the parts, which must be numbered
+	// 1 through the number of parts written.
+	Complete(ctx context.Context) error
+	// Abort discards the parts.
+	Abort(ctx context.Context) error
+}
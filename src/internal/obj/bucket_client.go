@@ -0,0 +1,140 @@
+package obj
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+)
+
+// NewFromBucket returns a Client for a bucket from the Go CDK packages, whose
+// URL is url.  It reads ranges, stats and copies objects natively.  Buckets
+// opened with the AWS SDK also write objects in parts natively.  The caller
+// remains responsible for closing the bucket.
+func NewFromBucket(b *blob.Bucket, url ObjectStoreURL) Client {
+	url.Object, url.Params = "", ""
+	c := &bucketClient{b: b, url: url}
+	var s3Client *s3.S3
+	if b.As(&s3Client) {
+		// minio urls name the endpoint before the bucket.
+		name := url.Bucket[strings.LastIndex(url.Bucket, "/")+1:]
+		return &s3BucketClient{
+			bucketClient: c,
+			amazon: &amazonClient{
+				bucket: name,
+				s3:     s3Client,
+				advancedConfig: &AmazonAdvancedConfiguration{
+					UploadACL: "bucket-owner-full-control",
+				},
+			},
+		}
+	}
+	return c
+}
+
+type bucketClient struct {
+	b   *blob.Bucket
+	url ObjectStoreURL
+}
+
+func (c *bucketClient) Put(ctx context.Context, name string, r io.Reader) (retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	// Canceling the context before closing the writer discards the object.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	w, err := c.b.NewWriter(ctx, name, nil)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	if _, err := io.Copy(w, r); err != nil {
+		cancel()
+		w.Close() //nolint:errcheck
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(w.Close())
+}
+
+func (c *bucketClient) Get(ctx context.Context, name string, w io.Writer) error {
+	return c.GetRange(ctx, name, 0, -1, w)
+}
+
+func (c *bucketClient) GetRange(ctx context.Context, name string, offset, length int64, w io.Writer) (retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	r, err := c.b.NewRangeReader(ctx, name, offset, length, nil)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer errors.Close(&retErr, r, "close reader for %s", name)
+	_, err = io.Copy(w, r)
+	return errors.EnsureStack(err)
+}
+
+func (c *bucketClient) Delete(ctx context.Context, name string) error {
+	return c.transformError(errors.EnsureStack(c.b.Delete(ctx, name)), name)
+}
+
+func (c *bucketClient) Walk(ctx context.Context, prefix string, fn func(name string) error) error {
+	it := c.b.List(&blob.ListOptions{Prefix: prefix})
+	for {
+		o, err := it.Next(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return errors.EnsureStack(err)
+		}
+		if err := fn(o.Key); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *bucketClient) Exists(ctx context.Context, name string) (bool, error) {
+	exists, err := c.b.Exists(ctx, name)
+	return exists, errors.EnsureStack(err)
+}
+
+func (c *bucketClient) Stat(ctx context.Context, name string) (_ *ObjectInfo, retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	attrs, err := c.b.Attributes(ctx, name)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return &ObjectInfo{
+		Size:    attrs.Size,
+		ETag:    attrs.ETag,
+		ModTime: attrs.ModTime,
+	}, nil
+}
+
+func (c *bucketClient) Copy(ctx context.Context, src, dst string) error {
+	return c.transformError(errors.EnsureStack(c.b.Copy(ctx, dst, src, nil)), src)
+}
+
+func (c *bucketClient) BucketURL() ObjectStoreURL {
+	return c.url
+}
+
+func (c *bucketClient) transformError(err error, name string) error {
+	if gcerrors.Code(err) == gcerrors.NotFound {
+		return pacherr.NewNotExist(c.url.Bucket, name)
+	}
+	return err
+}
+
+// s3BucketClient is a bucketClient for a bucket opened with the AWS SDK, whose
+// client is used to write objects in parts.
+type s3BucketClient struct {
+	*bucketClient
+	amazon *amazonClient
+}
+
+func (c *s3BucketClient) NewMultipartUpload(ctx context.Context, name string) (MultipartUpload, error) {
+	return c.amazon.NewMultipartUpload(ctx, name)
+}
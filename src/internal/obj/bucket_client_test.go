@@ -0,0 +1,18 @@
+package obj
+
+import (
+	"testing"
+
+	"gocloud.dev/blob/memblob"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestBucketClient(t *testing.T) {
+	t.Parallel()
+	TestSuite(t, func(t testing.TB) Client {
+		b := memblob.OpenBucket(nil)
+		t.Cleanup(func() { require.NoError(t, b.Close()) })
+		return NewFromBucket(b, ObjectStoreURL{Scheme: "mem", Bucket: "test"})
+	})
+}
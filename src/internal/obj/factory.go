@@ -474,6 +474,22 @@ func ParseURL(urlStr string) (*ObjectStoreURL, error) {
 			Object: key,
 			Params: u.RawQuery,
 		}
+	// remote file systems, which are read and written by the remotefs
+	// package.  The bucket is the host, with the user if there is one.
+	case "sftp", "webdav", "webdavs", "webhdfs", "swebhdfs":
+		if _, ok := u.User.Password(); ok {
+			return nil, errors.Errorf("passwords are not allowed in %s urls, use a secret instead", u.Scheme)
+		}
+		host := u.Host
+		if u.User != nil {
+			host = u.User.Username() + "@" + host
+		}
+		objStoreUrl = &ObjectStoreURL{
+			Scheme: u.Scheme,
+			Bucket: host,
+			Object: strings.Trim(u.Path, "/"),
+			Params: u.RawQuery,
+		}
 	default:
 		// return nil, errors.Errorf("unrecognized object store: %s", u.Scheme)
 		return nil, errors.Errorf("unrecognized object store: %s", u.Scheme)
@@ -1,9 +1,13 @@
 package obj
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
+	"path"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,6 +17,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
 	"github.com/pachyderm/pachyderm/v2/src/internal/promutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	"google.golang.org/api/googleapi"
 	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
@@ -107,6 +112,131 @@ func (c *googleClient) Delete(ctx context.Context, name string) (retErr error) {
 	return errors.EnsureStack(c.bucket.Object(name).Delete(ctx))
 }
 
+func (c *googleClient) GetRange(ctx context.Context, name string, offset, length int64, w io.Writer) (retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	reader, err := c.bucket.Object(name).NewRangeReader(ctx, offset, length)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := reader.Close(); retErr == nil {
+			retErr = err
+		}
+	}()
+	_, err = io.Copy(w, reader)
+	return errors.EnsureStack(err)
+}
+
+func (c *googleClient) Stat(ctx context.Context, name string) (_ *ObjectInfo, retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	attrs, err := c.bucket.Object(name).Attrs(ctx)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return &ObjectInfo{Size: attrs.Size, ETag: attrs.Etag, ModTime: attrs.Updated}, nil
+}
+
+func (c *googleClient) Copy(ctx context.Context, src, dst string) (retErr error) {
+	defer func() { retErr = c.transformError(retErr, src) }()
+	_, err := c.bucket.Object(dst).CopierFrom(c.bucket.Object(src)).Run(ctx)
+	return errors.EnsureStack(err)
+}
+
+const (
+	// googlePartPrefix is where the parts of multipart uploads are kept until
+	// they're composed into their objects.
+	googlePartPrefix = ".multipart"
+	// maxComposeSources is the largest number of objects GCS composes in one
+	// request.
+	maxComposeSources = 32
+)
+
+// NewMultipartUpload writes each part to its own object, and composes them
+// into the object when the upload is completed.
+func (c *googleClient) NewMultipartUpload(ctx context.Context, name string) (MultipartUpload, error) {
+	return &googleMultipartUpload{
+		c:      c,
+		name:   name,
+		prefix: path.Join(googlePartPrefix, uuid.NewWithoutDashes()),
+	}, nil
+}
+
+type googleMultipartUpload struct {
+	c      *googleClient
+	name   string
+	prefix string
+	parts  partSet[string]
+	// temps are the objects composed from parts, which are deleted with them.
+	temps []string
+}
+
+func (u *googleMultipartUpload) PutPart(ctx context.Context, n int, data []byte) error {
+	if err := checkPartNumber(n); err != nil {
+		return err
+	}
+	name := path.Join(u.prefix, strconv.Itoa(n))
+	if err := u.c.Put(ctx, name, bytes.NewReader(data)); err != nil {
+		return err
+	}
+	u.parts.put(n, name)
+	return nil
+}
+
+func (u *googleMultipartUpload) Complete(ctx context.Context) (retErr error) {
+	defer errors.Invoke1(&retErr, u.Abort, ctx, "delete parts")
+	defer func() { retErr = u.c.transformError(retErr, u.name) }()
+	srcs, err := u.parts.list()
+	if err != nil {
+		return err
+	}
+	// Compose the parts into intermediate objects until there are few enough
+	// to compose into the object.
+	for level := 0; len(srcs) > maxComposeSources; level++ {
+		var composed []string
+		for i := 0; i < len(srcs); i += maxComposeSources {
+			end := i + maxComposeSources
+			if end > len(srcs) {
+				end = len(srcs)
+			}
+			name := path.Join(u.prefix, fmt.Sprintf("composed-%d-%d", level, i/maxComposeSources))
+			u.temps = append(u.temps, name)
+			if err := u.compose(ctx, name, srcs[i:end]); err != nil {
+				return err
+			}
+			composed = append(composed, name)
+		}
+		srcs = composed
+	}
+	return u.compose(ctx, u.name, srcs)
+}
+
+func (u *googleMultipartUpload) compose(ctx context.Context, dst string, srcs []string) error {
+	objs := make([]*storage.ObjectHandle, len(srcs))
+	for i, src := range srcs {
+		objs[i] = u.c.bucket.Object(src)
+	}
+	_, err := u.c.bucket.Object(dst).ComposerFrom(objs...).Run(ctx)
+	return errors.EnsureStack(err)
+}
+
+func (u *googleMultipartUpload) Abort(ctx context.Context) error {
+	u.parts.mu.Lock()
+	names := append([]string{}, u.temps...)
+	for _, name := range u.parts.parts {
+		names = append(names, name)
+	}
+	u.parts.parts = nil
+	u.temps = nil
+	u.parts.mu.Unlock()
+	var errs error
+	for _, name := range names {
+		if err := u.c.Delete(ctx, name); err != nil && !pacherr.IsNotExist(err) {
+			errors.JoinInto(&errs, err)
+		}
+	}
+	return errs
+}
+
 func (c *googleClient) BucketURL() ObjectStoreURL {
 	return ObjectStoreURL{
 		Scheme: "gcs",
@@ -174,3 +174,62 @@ func (c *fsClient) removeFile(retErr *error, p string) {
 		errors.JoinInto(retErr, errors.Wrap(err, "deleting file"))
 	}
 }
+
+func (c *fsClient) GetRange(ctx context.Context, name string, offset, length int64, w io.Writer) (retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	f, err := os.Open(c.finalPathFor(name))
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer c.closeFile(&retErr, f)
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if length < 0 {
+		_, err = io.Copy(w, f)
+		return errors.EnsureStack(err)
+	}
+	if _, err := io.CopyN(w, f, length); err != nil && !errors.Is(err, io.EOF) {
+		return errors.EnsureStack(err)
+	}
+	return nil
+}
+
+func (c *fsClient) Stat(ctx context.Context, name string) (_ *ObjectInfo, retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	fi, err := os.Stat(c.finalPathFor(name))
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return &ObjectInfo{Size: fi.Size(), ModTime: fi.ModTime()}, nil
+}
+
+func (c *fsClient) Copy(ctx context.Context, src, dst string) (retErr error) {
+	log.Info(ctx, "copy", zap.String("src", src), zap.String("dst", dst))
+	defer func() { retErr = c.transformError(retErr, src) }()
+	in, err := os.Open(c.finalPathFor(src))
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer c.closeFile(&retErr, in)
+	staging := c.stagingPathFor(dst)
+	out, err := os.OpenFile(staging, os.O_EXCL|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer c.closeFile(&retErr, out)
+	defer c.removeFile(&retErr, staging)
+	if _, err := io.Copy(out, in); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if err := out.Close(); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(os.Rename(staging, c.finalPathFor(dst)))
+}
+
+// NewMultipartUpload stages the parts beside the objects, rather than in the
+// default directory for temporary files.
+func (c *fsClient) NewMultipartUpload(ctx context.Context, name string) (MultipartUpload, error) {
+	return &stagedUpload{c: c, name: name, dir: filepath.Join(c.rootDir, "staging")}, nil
+}
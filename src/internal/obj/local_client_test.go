@@ -11,6 +11,16 @@ func TestLocalClient(t *testing.T) {
 	TestSuite(t, newTestLocalClient)
 }
 
+// TestLocalClientFallbacks tests the fallbacks for clients without the optional
+// capabilities.
+func TestLocalClientFallbacks(t *testing.T) {
+	t.Parallel()
+	TestCapabilities(t, func(t testing.TB) Client {
+		// Hide the capabilities of the local client.
+		return struct{ Client }{newTestLocalClient(t)}
+	})
+}
+
 func newTestLocalClient(t testing.TB) Client {
 	dir := t.TempDir()
 	t.Log("testing local client in", dir)
@@ -23,6 +23,8 @@ const (
 	maxBlockSize = 4 * 1024 * 1024
 	// Concurrency is the maximum concurrent block writes per writer.
 	concurrency = 10
+	// microsoftCopyPollInterval is the wait between checks of a copy's status.
+	microsoftCopyPollInterval = time.Second
 )
 
 var (
@@ -112,6 +114,148 @@ func (c *microsoftClient) Exists(ctx context.Context, name string) (bool, error)
 	return exists, nil
 }
 
+func (c *microsoftClient) GetRange(ctx context.Context, name string, offset, length int64, w io.Writer) (retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	if err := checkMicrosoftContext(ctx); err != nil {
+		return err
+	}
+	// A range ending at 0 is open ended, so reads of the first byte read the
+	// whole blob, and are cut short below.
+	rng := &storage.BlobRange{Start: uint64(offset)}
+	if length > 0 {
+		rng.End = uint64(offset + length - 1)
+	}
+	r, err := c.container.GetBlobReference(name).GetRange(&storage.GetBlobRangeOptions{Range: rng})
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := r.Close(); retErr == nil {
+			retErr = err
+		}
+	}()
+	// Closing the body stops the read if ctx is cancelled.
+	stop := context.AfterFunc(ctx, func() { r.Close() }) //nolint:errcheck
+	defer stop()
+	if length < 0 {
+		_, err = io.Copy(w, r)
+		return errors.EnsureStack(err)
+	}
+	if _, err := io.CopyN(w, r, length); err != nil && !errors.Is(err, io.EOF) {
+		return errors.EnsureStack(err)
+	}
+	return nil
+}
+
+func (c *microsoftClient) Stat(ctx context.Context, name string) (_ *ObjectInfo, retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	if err := checkMicrosoftContext(ctx); err != nil {
+		return nil, err
+	}
+	blob := c.container.GetBlobReference(name)
+	if err := blob.GetProperties(nil); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return &ObjectInfo{
+		Size:    blob.Properties.ContentLength,
+		ETag:    blob.Properties.Etag,
+		ModTime: time.Time(blob.Properties.LastModified),
+	}, nil
+}
+
+// Copy starts a server-side copy, and polls it until it's done, aborting it if
+// ctx is cancelled first.
+func (c *microsoftClient) Copy(ctx context.Context, src, dst string) (retErr error) {
+	defer func() { retErr = c.transformError(retErr, src) }()
+	if err := checkMicrosoftContext(ctx); err != nil {
+		return err
+	}
+	srcURL := c.container.GetBlobReference(src).GetURL()
+	blob := c.container.GetBlobReference(dst)
+	copyID, err := blob.StartCopy(srcURL, nil)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	for {
+		if err := blob.GetProperties(nil); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if blob.Properties.CopyID != copyID {
+			return errors.Errorf("copy of %s to %s was replaced by another copy", src, dst)
+		}
+		switch blob.Properties.CopyStatus {
+		case "success":
+			return nil
+		case "pending":
+		default:
+			return errors.Errorf("copy of %s to %s %s: %s", src, dst, blob.Properties.CopyStatus, blob.Properties.CopyStatusDescription)
+		}
+		select {
+		case <-time.After(microsoftCopyPollInterval):
+		case <-ctx.Done():
+			err := errors.EnsureStack(context.Cause(ctx))
+			errors.JoinInto(&err, errors.EnsureStack(blob.AbortCopy(copyID, nil)))
+			return err
+		}
+	}
+}
+
+// NewMultipartUpload writes each part as an uncommitted block of the blob,
+// and commits them when the upload is completed.
+func (c *microsoftClient) NewMultipartUpload(_ context.Context, name string) (MultipartUpload, error) {
+	return &microsoftMultipartUpload{c: c, blob: c.container.GetBlobReference(name)}, nil
+}
+
+type microsoftMultipartUpload struct {
+	c     *microsoftClient
+	blob  *storage.Blob
+	parts partSet[string]
+}
+
+func (u *microsoftMultipartUpload) PutPart(ctx context.Context, n int, data []byte) (retErr error) {
+	defer func() { retErr = u.c.transformError(retErr, u.blob.Name) }()
+	if err := checkMicrosoftContext(ctx); err != nil {
+		return err
+	}
+	if err := checkPartNumber(n); err != nil {
+		return err
+	}
+	id := blockID(n)
+	if err := u.blob.PutBlock(id, data, nil); err != nil {
+		return errors.EnsureStack(err)
+	}
+	u.parts.put(n, id)
+	return nil
+}
+
+func (u *microsoftMultipartUpload) Complete(ctx context.Context) (retErr error) {
+	defer func() { retErr = u.c.transformError(retErr, u.blob.Name) }()
+	if err := checkMicrosoftContext(ctx); err != nil {
+		return err
+	}
+	ids, err := u.parts.list()
+	if err != nil {
+		return err
+	}
+	blocks := make([]storage.Block, len(ids))
+	for i, id := range ids {
+		blocks[i] = storage.Block{ID: id, Status: storage.BlockStatusUncommitted}
+	}
+	return errors.EnsureStack(u.blob.PutBlockList(blocks, nil))
+}
+
+// Abort does nothing, since Azure discards uncommitted blocks after a week.
+func (u *microsoftMultipartUpload) Abort(_ context.Context) error {
+	return nil
+}
+
+// checkMicrosoftContext returns an error if ctx is done.  The storage SDK's
+// requests don't take a context, so they can't be cancelled once they're sent;
+// this at least keeps new requests from being sent after ctx is done.
+func checkMicrosoftContext(ctx context.Context) error {
+	return errors.EnsureStack(context.Cause(ctx))
+}
+
 func (c *microsoftClient) BucketURL() ObjectStoreURL {
 	return ObjectStoreURL{
 		Scheme: "as",
@@ -1,11 +1,14 @@
 package obj
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
 	"net/http"
 
+	"golang.org/x/sync/errgroup"
+
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
 	"github.com/pachyderm/pachyderm/v2/src/internal/promutil"
@@ -107,6 +110,141 @@ func (c *minioClient) Exists(ctx context.Context, name string) (bool, error) {
 	return true, nil
 }
 
+func (c *minioClient) GetRange(ctx context.Context, name string, offset, length int64, w io.Writer) (retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	var opts minio.GetObjectOptions
+	switch {
+	case length > 0:
+		if err := opts.SetRange(offset, offset+length-1); err != nil {
+			return errors.EnsureStack(err)
+		}
+	case offset > 0:
+		if err := opts.SetRange(offset, 0); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	rc, err := c.GetObjectWithContext(ctx, c.bucket, name, opts)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := rc.Close(); retErr == nil {
+			retErr = err
+		}
+	}()
+	_, err = io.Copy(w, rc)
+	return errors.EnsureStack(err)
+}
+
+func (c *minioClient) Stat(ctx context.Context, name string) (_ *ObjectInfo, retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	info, err := c.StatObjectWithContext(ctx, c.bucket, name, minio.StatObjectOptions{})
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return &ObjectInfo{Size: info.Size, ETag: info.ETag, ModTime: info.LastModified}, nil
+}
+
+func (c *minioClient) Copy(ctx context.Context, src, dst string) (retErr error) {
+	defer func() { retErr = c.transformError(retErr, src) }()
+	info, err := c.StatObjectWithContext(ctx, c.bucket, src, minio.StatObjectOptions{})
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	core := minio.Core{Client: c.Client}
+	if info.Size <= maxCopySize {
+		_, err := core.CopyObjectWithContext(ctx, c.bucket, src, c.bucket, dst, nil)
+		return errors.EnsureStack(err)
+	}
+	mu, err := c.newMultipartUpload(ctx, dst)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			errors.JoinInto(&retErr, mu.Abort(ctx))
+		}
+	}()
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(concurrency)
+	for offset, n := int64(0), 1; offset < info.Size; offset, n = offset+copyPartSize, n+1 {
+		offset, n := offset, n
+		length := min(copyPartSize, info.Size-offset)
+		eg.Go(func() error {
+			part, err := core.CopyObjectPartWithContext(egCtx, c.bucket, src, c.bucket, dst, mu.id, n, offset, length, nil)
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			mu.parts.put(n, part.ETag)
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return mu.Complete(ctx)
+}
+
+func (c *minioClient) NewMultipartUpload(ctx context.Context, name string) (MultipartUpload, error) {
+	return c.newMultipartUpload(ctx, name)
+}
+
+// newMultipartUpload starts a multipart upload.  minio-go can't start one under
+// a context, so ctx is only checked before starting it; the parts are written
+// and the upload completed under their own contexts.
+func (c *minioClient) newMultipartUpload(ctx context.Context, name string) (_ *minioMultipartUpload, retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	if err := context.Cause(ctx); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	core := minio.Core{Client: c.Client}
+	id, err := core.NewMultipartUpload(c.bucket, name, minio.PutObjectOptions{ContentType: "application/octet-stream"})
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return &minioMultipartUpload{c: c, core: core, name: name, id: id}, nil
+}
+
+type minioMultipartUpload struct {
+	c     *minioClient
+	core  minio.Core
+	name  string
+	id    string
+	parts partSet[string]
+}
+
+func (u *minioMultipartUpload) PutPart(ctx context.Context, n int, data []byte) (retErr error) {
+	defer func() { retErr = u.c.transformError(retErr, u.name) }()
+	if err := checkPartNumber(n); err != nil {
+		return err
+	}
+	part, err := u.core.PutObjectPartWithContext(ctx, u.c.bucket, u.name, u.id, n, bytes.NewReader(data), int64(len(data)), "", "", nil)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	u.parts.put(n, part.ETag)
+	return nil
+}
+
+func (u *minioMultipartUpload) Complete(ctx context.Context) (retErr error) {
+	defer func() { retErr = u.c.transformError(retErr, u.name) }()
+	etags, err := u.parts.list()
+	if err != nil {
+		return err
+	}
+	parts := make([]minio.CompletePart, len(etags))
+	for i, etag := range etags {
+		parts[i] = minio.CompletePart{PartNumber: i + 1, ETag: etag}
+	}
+	_, err = u.core.CompleteMultipartUploadWithContext(ctx, u.c.bucket, u.name, u.id, parts)
+	return errors.EnsureStack(err)
+}
+
+func (u *minioMultipartUpload) Abort(ctx context.Context) (retErr error) {
+	defer func() { retErr = u.c.transformError(retErr, u.name) }()
+	return errors.EnsureStack(u.core.AbortMultipartUploadWithContext(ctx, u.c.bucket, u.name, u.id))
+}
+
 func (c *minioClient) BucketURL() ObjectStoreURL {
 	u := c.Client.EndpointURL()
 	return ObjectStoreURL{
@@ -91,3 +91,35 @@ func (c *monkeyClient) Exists(ctx context.Context, path string) (bool, error) {
 func (c *monkeyClient) BucketURL() ObjectStoreURL {
 	return c.c.BucketURL()
 }
+
+// GetRange wraps the ranged get operation.
+func (c *monkeyClient) GetRange(ctx context.Context, path string, offset, length int64, w io.Writer) error {
+	if enabled && localRand.Float64() < failProb {
+		return errMsg
+	}
+	return GetRange(ctx, c.c, path, offset, length, w)
+}
+
+// Stat wraps the stat operation.
+func (c *monkeyClient) Stat(ctx context.Context, path string) (*ObjectInfo, error) {
+	if enabled && localRand.Float64() < failProb {
+		return nil, errMsg
+	}
+	return Stat(ctx, c.c, path)
+}
+
+// Copy wraps the copy operation.
+func (c *monkeyClient) Copy(ctx context.Context, src, dst string) error {
+	if enabled && localRand.Float64() < failProb {
+		return errMsg
+	}
+	return CopyObject(ctx, c.c, src, dst)
+}
+
+// NewMultipartUpload wraps the multipart upload operation.
+func (c *monkeyClient) NewMultipartUpload(ctx context.Context, path string) (MultipartUpload, error) {
+	if enabled && localRand.Float64() < failProb {
+		return nil, errMsg
+	}
+	return NewMultipartUpload(ctx, c.c, path)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/randutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"golang.org/x/sync/errgroup"
 )
 
 // TestSuite runs tests to ensure the object returned by newClient implements Client
@@ -65,6 +66,115 @@ func TestSuite(t *testing.T, newClient func(t testing.TB) Client) {
 		actualHash := pachhash.Sum(buf.Bytes())
 		require.Equal(t, expectedHash, actualHash)
 	})
+
+	TestCapabilities(t, newClient)
+}
+
+// TestCapabilities tests the optional capabilities of the client returned by
+// newClient, through the functions which fall back to the methods of Client
+// when a client doesn't implement them.
+func TestCapabilities(t *testing.T, newClient func(t testing.TB) Client) {
+	ctx := pctx.TestContext(t)
+	t.Run("TestGetRange", func(t *testing.T) {
+		t.Parallel()
+		client := newClient(t)
+		name := randutil.UniqueString("test-get-range-")
+		data := []byte("0123456789")
+		require.NoError(t, client.Put(ctx, name, bytes.NewReader(data)))
+		defer func() {
+			require.NoError(t, client.Delete(ctx, name))
+		}()
+		for _, tc := range []struct {
+			offset, length int64
+			expected       string
+		}{
+			{0, -1, "0123456789"},
+			{0, 1, "0"},
+			{3, 4, "3456"},
+			{7, -1, "789"},
+			{7, 10, "789"},
+			{4, 0, ""},
+		} {
+			buf := &bytes.Buffer{}
+			require.NoError(t, GetRange(ctx, client, name, tc.offset, tc.length, buf))
+			require.Equal(t, tc.expected, buf.String(), "offset=%d length=%d", tc.offset, tc.length)
+		}
+		err := GetRange(ctx, client, randutil.UniqueString("test-missing-object-"), 1, 1, &bytes.Buffer{})
+		require.True(t, pacherr.IsNotExist(err))
+	})
+
+	t.Run("TestStat", func(t *testing.T) {
+		t.Parallel()
+		client := newClient(t)
+		name := randutil.UniqueString("test-stat-")
+		require.NoError(t, client.Put(ctx, name, bytes.NewReader([]byte("foo bar"))))
+		defer func() {
+			require.NoError(t, client.Delete(ctx, name))
+		}()
+		info, err := Stat(ctx, client, name)
+		require.NoError(t, err)
+		require.Equal(t, int64(7), info.Size)
+		_, err = Stat(ctx, client, randutil.UniqueString("test-missing-object-"))
+		require.True(t, pacherr.IsNotExist(err))
+	})
+
+	t.Run("TestCopy", func(t *testing.T) {
+		t.Parallel()
+		client := newClient(t)
+		src := randutil.UniqueString("test-copy-src-")
+		dst := randutil.UniqueString("test-copy-dst-")
+		require.NoError(t, client.Put(ctx, src, bytes.NewReader([]byte("foo bar"))))
+		defer func() {
+			require.NoError(t, client.Delete(ctx, src))
+			require.NoError(t, client.Delete(ctx, dst))
+		}()
+		require.NoError(t, CopyObject(ctx, client, src, dst))
+		buf := &bytes.Buffer{}
+		require.NoError(t, client.Get(ctx, dst, buf))
+		require.Equal(t, "foo bar", buf.String())
+		err := CopyObject(ctx, client, randutil.UniqueString("test-missing-object-"), dst)
+		require.True(t, pacherr.IsNotExist(err))
+	})
+
+	t.Run("TestMultipartUpload", func(t *testing.T) {
+		t.Parallel()
+		client := newClient(t)
+		name := randutil.UniqueString("test-multipart-upload-")
+		parts := make([][]byte, 3)
+		for i := range parts {
+			size := MinPartSize
+			if i == len(parts)-1 {
+				size = 1024
+			}
+			part, err := io.ReadAll(io.LimitReader(rand.Reader, int64(size)))
+			require.NoError(t, err)
+			parts[i] = part
+		}
+		u, err := NewMultipartUpload(ctx, client, name)
+		require.NoError(t, err)
+		// Write the parts concurrently, out of order, and one of them twice.
+		eg, egCtx := errgroup.WithContext(ctx)
+		for i := len(parts) - 1; i >= 0; i-- {
+			i := i
+			eg.Go(func() error { return u.PutPart(egCtx, i+1, parts[i]) })
+		}
+		require.NoError(t, eg.Wait())
+		require.NoError(t, u.PutPart(ctx, 2, parts[1]))
+		require.NoError(t, u.Complete(ctx))
+		defer func() {
+			require.NoError(t, client.Delete(ctx, name))
+		}()
+		buf := &bytes.Buffer{}
+		require.NoError(t, client.Get(ctx, name, buf))
+		require.Equal(t, pachhash.Sum(bytes.Join(parts, nil)), pachhash.Sum(buf.Bytes()))
+
+		// An upload missing a part can't be completed.
+		u, err = NewMultipartUpload(ctx, client, randutil.UniqueString("test-multipart-upload-"))
+		require.NoError(t, err)
+		require.NoError(t, u.PutPart(ctx, 2, parts[2]))
+		require.YesError(t, u.Complete(ctx))
+		require.NoError(t, u.Abort(ctx))
+	})
 }
 
 func TestEmptyWrite(t *testing.T, client Client) {
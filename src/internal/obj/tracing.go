@@ -59,7 +59,13 @@ func TracingObjClient(provider string, c Client) Client {
 	return &tracingObjClient{c, prettyProvider(provider)}
 }
 
-var _ Client = &tracingObjClient{}
+var (
+	_ Client          = &tracingObjClient{}
+	_ RangeGetter     = &tracingObjClient{}
+	_ Stater          = &tracingObjClient{}
+	_ Copier          = &tracingObjClient{}
+	_ MultipartPutter = &tracingObjClient{}
+)
 
 type tracingObjClient struct {
 	Client
@@ -132,3 +138,76 @@ func (o *tracingObjClient) Exists(ctx context.Context, name string) (retVal bool
 	res, err := o.Client.Exists(ctx, name)
 	return res, errors.EnsureStack(err)
 }
+
+// GetRange implements the corresponding method in the RangeGetter interface
+func (o *tracingObjClient) GetRange(ctx context.Context, name string, offset, length int64, w io.Writer) (retErr error) {
+	objectOperationMetric.WithLabelValues(o.provider, "get_range").Inc()
+	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/"+o.provider+"/GetRange",
+		"name", name, "offset", offset, "length", length)
+	defer func() {
+		tracing.FinishAnySpan(span, "err", retErr)
+	}()
+	err := GetRange(ctx, o.Client, name, offset, length, &promutil.CountingWriter{
+		Writer:  w,
+		Counter: objectBytesReadMetrics.WithLabelValues(o.provider),
+	})
+	return errors.EnsureStack(err)
+}
+
+// Stat implements the corresponding method in the Stater interface
+func (o *tracingObjClient) Stat(ctx context.Context, name string) (_ *ObjectInfo, retErr error) {
+	objectOperationMetric.WithLabelValues(o.provider, "stat").Inc()
+	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/"+o.provider+"/Stat",
+		"name", name)
+	defer func() {
+		tracing.FinishAnySpan(span, "err", retErr)
+	}()
+	info, err := Stat(ctx, o.Client, name)
+	return info, errors.EnsureStack(err)
+}
+
+// Copy implements the corresponding method in the Copier interface
+func (o *tracingObjClient) Copy(ctx context.Context, src, dst string) (retErr error) {
+	objectOperationMetric.WithLabelValues(o.provider, "copy").Inc()
+	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/"+o.provider+"/Copy",
+		"src", src, "dst", dst)
+	defer func() {
+		tracing.FinishAnySpan(span, "err", retErr)
+	}()
+	return errors.EnsureStack(CopyObject(ctx, o.Client, src, dst))
+}
+
+// NewMultipartUpload implements the corresponding method in the
+// MultipartPutter interface
+func (o *tracingObjClient) NewMultipartUpload(ctx context.Context, name string) (_ MultipartUpload, retErr error) {
+	objectOperationMetric.WithLabelValues(o.provider, "new_multipart_upload").Inc()
+	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/"+o.provider+"/NewMultipartUpload",
+		"name", name)
+	defer func() {
+		tracing.FinishAnySpan(span, "err", retErr)
+	}()
+	u, err := NewMultipartUpload(ctx, o.Client, name)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return &tracingMultipartUpload{MultipartUpload: u, provider: o.provider}, nil
+}
+
+type tracingMultipartUpload struct {
+	MultipartUpload
+	provider string
+}
+
+func (u *tracingMultipartUpload) PutPart(ctx context.Context, n int, data []byte) (retErr error) {
+	objectOperationMetric.WithLabelValues(u.provider, "put_part").Inc()
+	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/"+u.provider+"/PutPart",
+		"part", n, "bytes", len(data))
+	defer func() {
+		tracing.FinishAnySpan(span, "err", retErr)
+	}()
+	if err := u.MultipartUpload.PutPart(ctx, n, data); err != nil {
+		return errors.EnsureStack(err)
+	}
+	objectBytesWrittenMetrics.WithLabelValues(u.provider).Add(float64(len(data)))
+	return nil
+}
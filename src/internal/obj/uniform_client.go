@@ -11,7 +11,13 @@ import (
 	"go.uber.org/zap"
 )
 
-var _ Client = &uniformClient{}
+var (
+	_ Client          = &uniformClient{}
+	_ RangeGetter     = &uniformClient{}
+	_ Stater          = &uniformClient{}
+	_ Copier          = &uniformClient{}
+	_ MultipartPutter = &uniformClient{}
+)
 
 // uniformClient is for ensuring uniform behavior across all the object clients
 type uniformClient struct {
@@ -73,3 +79,42 @@ func (uc *uniformClient) Exists(ctx context.Context, p string) (_ bool, retErr e
 func (uc *uniformClient) BucketURL() ObjectStoreURL {
 	return uc.c.BucketURL()
 }
+
+func (uc *uniformClient) GetRange(ctx context.Context, name string, offset, length int64, w io.Writer) (retErr error) {
+	defer func() {
+		retErr = errors.EnsureStack(retErr)
+	}()
+	if offset < 0 {
+		return errors.Errorf("invalid offset %d", offset)
+	}
+	name = strings.Trim(name, "/")
+	switch {
+	case offset == 0 && length < 0:
+		// Some object stores reject ranges of empty objects.
+		return uc.c.Get(ctx, name, w)
+	case length == 0:
+		return nil
+	}
+	return GetRange(ctx, uc.c, name, offset, length, w)
+}
+
+func (uc *uniformClient) Stat(ctx context.Context, name string) (_ *ObjectInfo, retErr error) {
+	defer func() {
+		retErr = errors.EnsureStack(retErr)
+	}()
+	return Stat(ctx, uc.c, strings.Trim(name, "/"))
+}
+
+func (uc *uniformClient) Copy(ctx context.Context, src, dst string) (retErr error) {
+	defer func() {
+		retErr = errors.EnsureStack(retErr)
+	}()
+	return CopyObject(ctx, uc.c, strings.Trim(src, "/"), strings.Trim(dst, "/"))
+}
+
+func (uc *uniformClient) NewMultipartUpload(ctx context.Context, name string) (_ MultipartUpload, retErr error) {
+	defer func() {
+		retErr = errors.EnsureStack(retErr)
+	}()
+	return NewMultipartUpload(ctx, uc.c, strings.Trim(name, "/"))
+}
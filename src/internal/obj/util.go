@@ -3,13 +3,25 @@ package obj
 import (
 	"context"
 	"io"
+	"os"
+	"sort"
+	"sync"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
 )
 
-// Copy copys an object from src at srcPath to dst at dstPath
+// Copy copys an object from src at srcPath to dst at dstPath.  If src and dst
+// are the same bucket, the object is copied by the object store when it can
+// be, rather than read and rewritten.
 func Copy(ctx context.Context, src, dst Client, srcPath, dstPath string) (retErr error) {
+	if src.BucketURL() == dst.BucketURL() {
+		return CopyObject(ctx, dst, srcPath, dstPath)
+	}
+	return copyThrough(ctx, src, dst, srcPath, dstPath)
+}
+
+func copyThrough(ctx context.Context, src, dst Client, srcPath, dstPath string) error {
 	return miscutil.WithPipe(func(w io.Writer) error {
 		return errors.EnsureStack(src.Get(ctx, srcPath, w))
 	}, func(r io.Reader) error {
@@ -17,6 +29,206 @@ func Copy(ctx context.Context, src, dst Client, srcPath, dstPath string) (retErr
 	})
 }
 
+// CopyObject copies the object at src to dst within the bucket of c.
+func CopyObject(ctx context.Context, c Client, src, dst string) error {
+	if copier, ok := c.(Copier); ok {
+		return errors.EnsureStack(copier.Copy(ctx, src, dst))
+	}
+	return copyThrough(ctx, c, c, src, dst)
+}
+
+// GetRange writes length bytes of an object, starting at offset, to w.  If
+// length is negative, it writes the rest of the object.
+func GetRange(ctx context.Context, c Client, name string, offset, length int64, w io.Writer) error {
+	if rg, ok := c.(RangeGetter); ok {
+		return errors.EnsureStack(rg.GetRange(ctx, name, offset, length, w))
+	}
+	rw := &rangeWriter{w: w, skip: offset, remaining: length}
+	if err := c.Get(ctx, name, rw); err != nil && !errors.Is(err, errRangeDone) {
+		return errors.EnsureStack(err)
+	}
+	return nil
+}
+
+var errRangeDone = errors.New("range done")
+
+// rangeWriter writes the range of a stream starting skip bytes into it, and
+// remaining bytes long, to w.  It returns errRangeDone once the range has
+// been written, to stop the stream.
+type rangeWriter struct {
+	w               io.Writer
+	skip, remaining int64
+}
+
+func (rw *rangeWriter) Write(data []byte) (int, error) {
+	n := len(data)
+	if rw.skip >= int64(len(data)) {
+		rw.skip -= int64(len(data))
+		return n, nil
+	}
+	data = data[rw.skip:]
+	rw.skip = 0
+	if rw.remaining >= 0 && int64(len(data)) >= rw.remaining {
+		if _, err := rw.w.Write(data[:rw.remaining]); err != nil {
+			return 0, errors.EnsureStack(err)
+		}
+		rw.remaining = 0
+		return 0, errRangeDone
+	}
+	if _, err := rw.w.Write(data); err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	if rw.remaining > 0 {
+		rw.remaining -= int64(len(data))
+	}
+	return n, nil
+}
+
+// Stat returns the metadata of an object.  Clients that can't read metadata
+// without reading the object only return its size.
+func Stat(ctx context.Context, c Client, name string) (*ObjectInfo, error) {
+	if s, ok := c.(Stater); ok {
+		info, err := s.Stat(ctx, name)
+		return info, errors.EnsureStack(err)
+	}
+	var cw countingWriter
+	if err := c.Get(ctx, name, &cw); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return &ObjectInfo{Size: cw.n}, nil
+}
+
+type countingWriter struct {
+	n int64
+}
+
+func (cw *countingWriter) Write(data []byte) (int, error) {
+	cw.n += int64(len(data))
+	return len(data), nil
+}
+
+// NewMultipartUpload starts writing an object in parts.  Clients that can't
+// write objects in parts stage the parts on local disk, and write the object
+// when the upload is completed.
+func NewMultipartUpload(ctx context.Context, c Client, name string) (MultipartUpload, error) {
+	if mp, ok := c.(MultipartPutter); ok {
+		u, err := mp.NewMultipartUpload(ctx, name)
+		return u, errors.EnsureStack(err)
+	}
+	return &stagedUpload{c: c, name: name}, nil
+}
+
+// partSet tracks the parts of a multipart upload, and what the object store
+// returned for each of them.
+type partSet[T any] struct {
+	mu    sync.Mutex
+	parts map[int]T
+}
+
+// put records part n, and returns the part it replaced, if any.
+func (ps *partSet[T]) put(n int, v T) (T, bool) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if ps.parts == nil {
+		ps.parts = make(map[int]T)
+	}
+	old, ok := ps.parts[n]
+	ps.parts[n] = v
+	return old, ok
+}
+
+func checkPartNumber(n int) error {
+	if n < 1 || n > MaxParts {
+		return errors.Errorf("part number %d is out of range [1, %d]", n, MaxParts)
+	}
+	return nil
+}
+
+// list returns the parts in order, or an error if any are missing.
+func (ps *partSet[T]) list() ([]T, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ns := make([]int, 0, len(ps.parts))
+	for n := range ps.parts {
+		ns = append(ns, n)
+	}
+	sort.Ints(ns)
+	vs := make([]T, len(ns))
+	for i, n := range ns {
+		if n != i+1 {
+			return nil, errors.Errorf("part %d of the upload is missing", i+1)
+		}
+		vs[i] = ps.parts[n]
+	}
+	return vs, nil
+}
+
+// stagedUpload is a multipart upload that stages the parts in temporary files
+// in dir, or the default directory for temporary files if dir is empty.
+type stagedUpload struct {
+	c     Client
+	name  string
+	dir   string
+	parts partSet[string]
+}
+
+func (u *stagedUpload) PutPart(ctx context.Context, n int, data []byte) (retErr error) {
+	if err := checkPartNumber(n); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(u.dir, "multipart-")
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if retErr != nil {
+			os.Remove(f.Name()) //nolint:errcheck
+		}
+	}()
+	if _, err := f.Write(data); err != nil {
+		f.Close() //nolint:errcheck
+		return errors.EnsureStack(err)
+	}
+	if err := f.Close(); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if old, ok := u.parts.put(n, f.Name()); ok {
+		os.Remove(old) //nolint:errcheck
+	}
+	return nil
+}
+
+func (u *stagedUpload) Complete(ctx context.Context) (retErr error) {
+	defer errors.Invoke1(&retErr, u.Abort, ctx, "remove staged parts")
+	paths, err := u.parts.list()
+	if err != nil {
+		return err
+	}
+	var rs []io.Reader
+	for _, p := range paths {
+		f, err := os.Open(p)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		defer errors.Close(&retErr, f, "close staged part")
+		rs = append(rs, f)
+	}
+	return errors.EnsureStack(u.c.Put(ctx, u.name, io.MultiReader(rs...)))
+}
+
+func (u *stagedUpload) Abort(ctx context.Context) error {
+	u.parts.mu.Lock()
+	defer u.parts.mu.Unlock()
+	var errs error
+	for n, p := range u.parts.parts {
+		if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+			errors.JoinInto(&errs, errors.EnsureStack(err))
+		}
+		delete(u.parts.parts, n)
+	}
+	return errs
+}
+
 type testURL struct {
 	Client
 }
@@ -32,3 +244,19 @@ func (c testURL) BucketURL() ObjectStoreURL {
 	u.Scheme = "test-" + u.Scheme
 	return u
 }
+
+func (c testURL) GetRange(ctx context.Context, name string, offset, length int64, w io.Writer) error {
+	return GetRange(ctx, c.Client, name, offset, length, w)
+}
+
+func (c testURL) Stat(ctx context.Context, name string) (*ObjectInfo, error) {
+	return Stat(ctx, c.Client, name)
+}
+
+func (c testURL) Copy(ctx context.Context, src, dst string) error {
+	return CopyObject(ctx, c.Client, src, dst)
+}
+
+func (c testURL) NewMultipartUpload(ctx context.Context, name string) (MultipartUpload, error) {
+	return NewMultipartUpload(ctx, c.Client, name)
+}
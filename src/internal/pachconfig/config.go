@@ -155,6 +155,13 @@ type PachdSpecificConfiguration struct {
 	DeterminedPassword string `env:"DETERMINED_PASSWORD,default="`
 	DeterminedURL      string `env:"DETERMINED_API_URL,default="`
 	DeterminedTLS      bool   `env:"DETERMINED_TLS,default=false"`
+	// NotificationAllowedCIDRs and NotificationDeniedCIDRs are comma-separated
+	// lists of the address ranges that notifications may and may not be
+	// delivered to.  An address in a denied range is refused unless it's also
+	// in an allowed one.  If NotificationDeniedCIDRs is empty, the loopback,
+	// link-local and private ranges are denied.
+	NotificationAllowedCIDRs string `env:"NOTIFICATION_ALLOWED_CIDRS,default="`
+	NotificationDeniedCIDRs  string `env:"NOTIFICATION_DENIED_CIDRS,default="`
 }
 
 // EnterpriseServerConfiguration contains the full configuration for an enterprise server
@@ -198,10 +205,25 @@ type StorageConfiguration struct {
 	StoragePutFileConcurrencyLimit       int   `env:"STORAGE_PUT_FILE_CONCURRENCY_LIMIT,default=100"`
 	StorageGCPeriod                      int64 `env:"STORAGE_GC_PERIOD,default=60"`
 	StorageChunkGCPeriod                 int64 `env:"STORAGE_CHUNK_GC_PERIOD,default=60"`
+	StorageRetentionPeriod               int64 `env:"STORAGE_RETENTION_PERIOD,default=3600"`
+	StorageUsagePeriod                   int64 `env:"STORAGE_USAGE_PERIOD,default=3600"`
 	StorageCompactionMaxFanIn            int   `env:"STORAGE_COMPACTION_MAX_FANIN,default=10"`
 	StorageFileSetsMaxOpen               int   `env:"STORAGE_FILESETS_MAX_OPEN,default=50"`
 	StorageDiskCacheSize                 int   `env:"STORAGE_DISK_CACHE_SIZE,default=100"`
 	StorageMemoryCacheSize               int   `env:"STORAGE_MEMORY_CACHE_SIZE,default=100"`
+	// StorageTiers is a JSON object mapping the names of storage tiers to the
+	// URLs of the buckets that hold their chunks, e.g.
+	// {"cold": "s3://archive-bucket"}.  The tiers use the credentials of the
+	// default object storage backend.
+	StorageTiers               string `env:"STORAGE_TIERS,default="`
+	StorageTierMigrationPeriod int64  `env:"STORAGE_TIER_MIGRATION_PERIOD,default=3600"`
+	// StoragePeerCachePort, if set, enables the cluster chunk cache, which
+	// peers reach this process's member of at StoragePeerCacheHost, normally
+	// the pod's IP, on this port.  Peers sign their requests with
+	// StoragePeerCacheSecret, which all members must share.
+	StoragePeerCachePort   uint16 `env:"STORAGE_PEER_CACHE_PORT,default=0"`
+	StoragePeerCacheHost   string `env:"STORAGE_PEER_CACHE_HOST,default="`
+	StoragePeerCacheSecret string `env:"STORAGE_PEER_CACHE_SECRET,default="`
 }
 
 // WorkerFullConfiguration contains the full worker configuration.
@@ -3,8 +3,10 @@ package pachd
 import (
 	"context"
 	"math"
+	"net"
 	"path"
 	"runtime/debug"
+	"strconv"
 
 	"github.com/dustin/go-humanize"
 	"go.uber.org/zap"
@@ -32,6 +34,8 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachconfig"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/peercache"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
 	licenseclient "github.com/pachyderm/pachyderm/v2/src/license"
@@ -113,8 +117,8 @@ func (b *builder) setupProfiling(ctx context.Context) error {
 	return setupProfiling(b.name, b.config).Fn(ctx)
 }
 
-func (b *builder) initJaeger(ctx context.Context) error {
-	return initJaeger().Fn(ctx)
+func (b *builder) initTracing(ctx context.Context) error {
+	return initTracing().Fn(ctx)
 }
 
 func (b *builder) initKube(ctx context.Context) error {
@@ -256,6 +260,7 @@ func (b *builder) registerPFSServer(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	env.PeerCache = b.peerCache()
 	apiServer, err := pfs_server.NewAPIServer(*env)
 	if err != nil {
 		return err
@@ -380,6 +385,37 @@ func (b *builder) initPrometheusServer(ctx context.Context) error {
 	return nil
 }
 
+// initPeerCache creates this process's member of the cluster chunk cache, if
+// it's enabled.  It must run before the PFS servers and workers are created.
+func (b *builder) initPeerCache(ctx context.Context) error {
+	config := b.env.Config()
+	if config.StoragePeerCachePort == 0 {
+		return nil
+	}
+	if config.StoragePeerCacheHost == "" {
+		return errors.New("STORAGE_PEER_CACHE_HOST must be set to enable the peer cache")
+	}
+	if config.StoragePeerCacheSecret == "" {
+		return errors.New("STORAGE_PEER_CACHE_SECRET must be set to enable the peer cache")
+	}
+	self := net.JoinHostPort(config.StoragePeerCacheHost, strconv.Itoa(int(config.StoragePeerCachePort)))
+	b.daemon.peerCache = &peerCacheServer{
+		cache:      peercache.New(self, config.StoragePeerCacheSecret, chunk.DefaultMaxChunkSize),
+		etcdClient: b.env.GetEtcdClient(),
+		prefix:     path.Join(config.EtcdPrefix, peerCacheEtcdPrefix),
+	}
+	return nil
+}
+
+// peerCache returns this process's member of the cluster chunk cache, or nil
+// if it's disabled.
+func (b *builder) peerCache() *peercache.Cache {
+	if b.daemon.peerCache == nil {
+		return nil
+	}
+	return b.daemon.peerCache.cache
+}
+
 func (b *builder) maybeInitDexDB(ctx context.Context) error {
 	if b.env.Config().EnterpriseMember {
 		return nil
@@ -401,6 +437,7 @@ func (b *builder) startPFSWorker(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	env.PeerCache = b.peerCache()
 	config := pfs_server.WorkerConfig{
 		Storage: b.env.Config().StorageConfiguration,
 	}
@@ -422,6 +459,7 @@ func (b *builder) startPFSMaster(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	env.PeerCache = b.peerCache()
 	m, err := pfs_server.NewMaster(*env)
 	if err != nil {
 		return err
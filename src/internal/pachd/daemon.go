@@ -39,6 +39,7 @@ type daemon struct {
 	s3                 *s3Server
 	prometheus         *prometheusServer
 	pachhttp           *pachhttp.Server
+	peerCache          *peerCacheServer
 
 	// configuration
 	criticalServersOnly bool
@@ -71,6 +72,10 @@ func (d *daemon) serve(ctx context.Context) (err error) {
 	if d.pachhttp != nil {
 		eg.Go(maybeIgnoreErrorFunc(ctx, "PachHTTP Server", true, func() error { return d.pachhttp.ListenAndServe(ctx) }))
 	}
+	if d.peerCache != nil {
+		// Without the peer cache, chunks are read from object storage.
+		eg.Go(maybeIgnoreErrorFunc(ctx, "Peer Cache Server", false, func() error { return d.peerCache.run(ctx) }))
+	}
 	eg.Go(func() error {
 		<-ctx.Done() // wait for main context to complete
 		var (
@@ -60,7 +60,7 @@ func (eb *enterpriseBuilder) buildAndRun(ctx context.Context) error {
 		eb.tweakResources,
 		eb.setupProfiling,
 		eb.printVersion,
-		eb.initJaeger,
+		eb.initTracing,
 		eb.initKube,
 		eb.setupDB,
 		eb.maybeInitDexDB,
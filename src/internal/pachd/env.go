@@ -112,6 +112,9 @@ func PFSEnv(env serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv) (*pfs_serv
 		Auth:                 env.AuthServer(),
 		GetPipelineInspector: func() pfs_server.PipelineInspector { return env.PpsServer() },
 
+		GetKubeClient: env.GetKubeClient,
+		Namespace:     env.Config().Namespace,
+
 		StorageConfig: env.Config().StorageConfiguration,
 	}, nil
 }
@@ -124,9 +127,11 @@ func PFSWorkerEnv(env serviceenv.ServiceEnv) (*pfs_server.WorkerEnv, error) {
 	}
 	etcdPrefix := path.Join(env.Config().EtcdPrefix, env.Config().PFSEtcdPrefix)
 	return &pfs_server.WorkerEnv{
-		DB:          env.GetDBClient(),
-		ObjClient:   objClient,
-		TaskService: env.GetTaskService(etcdPrefix),
+		DB:            env.GetDBClient(),
+		ObjClient:     objClient,
+		TaskService:   env.GetTaskService(etcdPrefix),
+		GetKubeClient: env.GetKubeClient,
+		Namespace:     env.Config().Namespace,
 	}, nil
 }
 
@@ -92,13 +92,14 @@ func (fb *fullBuilder) buildAndRun(ctx context.Context) error {
 		fb.tweakResources,
 		fb.setupProfiling,
 		fb.printVersion,
-		fb.initJaeger,
+		fb.initTracing,
 		fb.initKube,
 		fb.setupDB,
 		fb.maybeInitDexDB,
 		fb.maybeInitReporter,
 		fb.initInternalServer,
 		fb.initExternalServer,
+		fb.initPeerCache,
 		fb.registerLicenseServer,
 		fb.registerEnterpriseServer,
 		fb.maybeRegisterIdentityServer,
@@ -192,7 +193,7 @@ func NewFull(env Env, config pachconfig.PachdFullConfiguration) *Full {
 		printVersion(),
 		setupProfiling("pachd", pachconfig.NewConfiguration(config)),
 		tweakResources(config.GlobalConfiguration),
-		initJaeger(),
+		initTracing(),
 
 		awaitDB(env.DB),
 		runMigrations(env.DB, env.EtcdClient),
@@ -31,6 +31,7 @@ func (pachwb *pachwBuilder) registerPFSServer(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	env.PeerCache = pachwb.peerCache()
 	apiServer, err := pfs_server.NewAPIServer(*env)
 	if err != nil {
 		return err
@@ -90,10 +91,11 @@ func (pachwb *pachwBuilder) buildAndRun(ctx context.Context) error {
 		pachwb.tweakResources,
 		pachwb.setupProfiling,
 		pachwb.printVersion,
-		pachwb.initJaeger,
+		pachwb.initTracing,
 		pachwb.initKube,
 		pachwb.waitForDBState,
 		pachwb.initInternalServer,
+		pachwb.initPeerCache,
 		pachwb.registerEnterpriseServer,
 		pachwb.registerAuthServer,
 		pachwb.registerPFSServer, // PFS needs a non-nil auth server.
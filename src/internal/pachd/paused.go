@@ -83,7 +83,7 @@ func (pb *pausedBuilder) buildAndRun(ctx context.Context) error {
 		pb.printVersion,
 		pb.tweakResources,
 		pb.setupProfiling,
-		pb.initJaeger,
+		pb.initTracing,
 		pb.initKube,
 		pb.waitForDBState,
 		pb.maybeInitDexDB,
@@ -0,0 +1,24 @@
+package pachd
+
+import (
+	"context"
+
+	etcd "go.etcd.io/etcd/client/v3"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/peercache"
+)
+
+// peerCacheEtcdPrefix is where the peer cache ring is kept, under the etcd
+// prefix.
+const peerCacheEtcdPrefix = "peer-cache"
+
+// peerCacheServer serves this process's member of the cluster chunk cache.
+type peerCacheServer struct {
+	cache      *peercache.Cache
+	etcdClient *etcd.Client
+	prefix     string
+}
+
+func (s *peerCacheServer) run(ctx context.Context) error {
+	return s.cache.Run(ctx, s.etcdClient, s.prefix)
+}
@@ -69,17 +69,17 @@ func setupProfiling(name string, config *pachconfig.Configuration) setupStep {
 	}
 }
 
-func initJaeger() setupStep {
+func initTracing() setupStep {
 	return setupStep{
-		Name: "initJaegar",
+		Name: "initTracing",
 		Fn: func(ctx context.Context) error {
-			// must run InstallJaegerTracer before InitWithKube (otherwise InitWithKube
-			// may create a pach client before tracing is active, not install the Jaeger
-			// gRPC interceptor in the client, and not propagate traces)
-			if endpoint := tracing.InstallJaegerTracerFromEnv(); endpoint != "" {
-				log.Info(ctx, "connecting to Jaeger", zap.String("endpoint", endpoint))
+			// must run InstallTracerFromEnv before InitWithKube (otherwise InitWithKube
+			// may create a pach client before tracing is active, not install the
+			// tracing gRPC interceptor in the client, and not propagate traces)
+			if endpoint := tracing.InstallTracerFromEnv("pachd"); endpoint != "" {
+				log.Info(ctx, "exporting traces over OTLP", zap.String("endpoint", endpoint))
 			} else {
-				log.Info(ctx, "no Jaeger collector found (JAEGER_COLLECTOR_SERVICE_HOST not set)")
+				log.Info(ctx, "no OTLP collector configured (OTEL_EXPORTER_OTLP_ENDPOINT not set)")
 			}
 			return nil
 		},
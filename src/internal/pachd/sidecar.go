@@ -44,6 +44,7 @@ func (sb *sidecarBuilder) registerPFSServer(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	env.PeerCache = sb.peerCache()
 	apiServer, err := pfs_server.NewAPIServer(*env)
 	if err != nil {
 		return err
@@ -100,9 +101,10 @@ func (sb *sidecarBuilder) buildAndRun(ctx context.Context) error {
 		sb.printVersion,
 		sb.tweakResources,
 		sb.setupProfiling,
-		sb.initJaeger,
+		sb.initTracing,
 		sb.initKube,
 		sb.initInternalServer,
+		sb.initPeerCache,
 		sb.registerAuthServer,
 		sb.registerPFSServer,
 		sb.registerPPSServer,
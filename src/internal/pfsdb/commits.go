@@ -980,3 +980,28 @@ func listCommitPage(ctx context.Context, tx *pachsql.Tx, limit, offset int, filt
 	}
 	return page, nil
 }
+
+// ListCommitStartTimes calls cb with the key and start time of every commit
+// which has started.
+func ListCommitStartTimes(ctx context.Context, db *pachsql.DB, cb func(key string, started time.Time) error) (retErr error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT commit_id, start_time
+		FROM pfs.commits
+		WHERE start_time IS NOT NULL
+	`)
+	if err != nil {
+		return errors.Wrap(err, "list commit start times")
+	}
+	defer errors.Close(&retErr, rows, "close rows")
+	for rows.Next() {
+		var key string
+		var started time.Time
+		if err := rows.Scan(&key, &started); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if err := cb(key, started); err != nil {
+			return err
+		}
+	}
+	return errors.EnsureStack(rows.Err())
+}
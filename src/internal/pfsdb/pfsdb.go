@@ -15,8 +15,13 @@ import (
 )
 
 const (
-	branchesCollectionName = "branches"
-	commitsCollectionName  = "commits"
+	branchesCollectionName          = "branches"
+	commitsCollectionName           = "commits"
+	replicationsCollectionName      = "replications"
+	retentionPoliciesCollectionName = "retention_policies"
+	uploadSessionsCollectionName    = "upload_sessions"
+	commitValidationsCollectionName = "commit_validations"
+	storagePlacementsCollectionName = "storage_placements"
 )
 
 func ProjectKey(project *pfs.Project) string {
@@ -165,3 +170,127 @@ func Branches(db *pachsql.DB, listener col.PostgresListener) col.PostgresCollect
 		}),
 	)
 }
+
+var replicationsIndexes = []*col.Index{}
+
+// Replications returns a collection of replications
+func Replications(db *pachsql.DB, listener col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(
+		replicationsCollectionName,
+		db,
+		listener,
+		&pfs.ReplicationInfo{},
+		replicationsIndexes,
+		col.WithKeyGen(func(key interface{}) (string, error) {
+			if replication, ok := key.(*pfs.Replication); !ok {
+				return "", errors.New("key must be a replication")
+			} else {
+				return replication.Name, nil
+			}
+		}),
+		col.WithNotFoundMessage(func(key interface{}) string {
+			return pfsserver.ErrReplicationNotFound{Replication: key.(*pfs.Replication)}.Error()
+		}),
+		col.WithExistsMessage(func(key interface{}) string {
+			return pfsserver.ErrReplicationExists{Replication: key.(*pfs.Replication)}.Error()
+		}),
+	)
+}
+
+// RetentionPoliciesRepoIndex indexes retention policies by repo.
+var RetentionPoliciesRepoIndex = &col.Index{
+	Name: "repo",
+	Extract: func(val proto.Message) string {
+		return RepoKey(val.(*pfs.RetentionPolicyInfo).Repo)
+	},
+}
+
+var retentionPoliciesIndexes = []*col.Index{RetentionPoliciesRepoIndex}
+
+// RetentionPolicies returns a collection of retention policies.  The key of a
+// policy is the branch it applies to, with an empty branch name for repo-wide
+// policies.
+func RetentionPolicies(db *pachsql.DB, listener col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(
+		retentionPoliciesCollectionName,
+		db,
+		listener,
+		&pfs.RetentionPolicyInfo{},
+		retentionPoliciesIndexes,
+		col.WithKeyGen(func(key interface{}) (string, error) {
+			if branch, ok := key.(*pfs.Branch); !ok {
+				return "", errors.New("key must be a branch")
+			} else {
+				return BranchKey(branch), nil
+			}
+		}),
+		col.WithNotFoundMessage(func(key interface{}) string {
+			return pfsserver.ErrRetentionPolicyNotFound{Branch: key.(*pfs.Branch)}.Error()
+		}),
+	)
+}
+
+// UploadSessionsRepoIndex indexes upload sessions by the repo they upload to.
+var UploadSessionsRepoIndex = &col.Index{
+	Name: "repo",
+	Extract: func(val proto.Message) string {
+		return RepoKey(val.(*pfs.UploadSessionInfo).File.Commit.Repo)
+	},
+}
+
+var uploadSessionsIndexes = []*col.Index{UploadSessionsRepoIndex}
+
+// UploadSessions returns a collection of upload sessions, keyed by ID.
+func UploadSessions(db *pachsql.DB, listener col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(
+		uploadSessionsCollectionName,
+		db,
+		listener,
+		&pfs.UploadSessionInfo{},
+		uploadSessionsIndexes,
+	)
+}
+
+// CommitValidations returns a collection of commit validations, keyed by the
+// repo they apply to.
+func CommitValidations(db *pachsql.DB, listener col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(
+		commitValidationsCollectionName,
+		db,
+		listener,
+		&pfs.CommitValidationInfo{},
+		nil,
+		col.WithKeyGen(func(key interface{}) (string, error) {
+			if repo, ok := key.(*pfs.Repo); !ok {
+				return "", errors.New("key must be a repo")
+			} else {
+				return RepoKey(repo), nil
+			}
+		}),
+		col.WithNotFoundMessage(func(key interface{}) string {
+			return pfsserver.ErrCommitValidationNotFound{Repo: key.(*pfs.Repo)}.Error()
+		}),
+	)
+}
+
+// StoragePlacements returns a collection of storage placements, keyed by the
+// repo they apply to.
+func StoragePlacements(db *pachsql.DB, listener col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(
+		storagePlacementsCollectionName,
+		db,
+		listener,
+		&pfs.StoragePlacementInfo{},
+		nil,
+		col.WithKeyGen(func(key interface{}) (string, error) {
+			if repo, ok := key.(*pfs.Repo); !ok {
+				return "", errors.New("key must be a repo")
+			} else {
+				return RepoKey(repo), nil
+			}
+		}),
+		col.WithNotFoundMessage(func(key interface{}) string {
+			return pfsserver.ErrStoragePlacementNotFound{Repo: key.(*pfs.Repo)}.Error()
+		}),
+	)
+}
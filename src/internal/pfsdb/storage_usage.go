@@ -0,0 +1,70 @@
+package pfsdb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// The storage usage of projects, repos and commits is keyed by ProjectKey,
+// RepoKey and CommitKey respectively.  These never collide, since project names
+// cannot contain '/' and repo keys cannot contain '@'.
+
+type storageUsageRow struct {
+	Key            string    `db:"key"`
+	ExclusiveBytes int64     `db:"exclusive_bytes"`
+	SharedBytes    int64     `db:"shared_bytes"`
+	ComputedAt     time.Time `db:"computed_at"`
+}
+
+// ReplaceStorageUsage replaces all of the cached storage usage with usage.
+func ReplaceStorageUsage(ctx context.Context, tx *pachsql.Tx, usage map[string]*pfs.StorageUsage, computedAt time.Time) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM pfs.storage_usage`); err != nil {
+		return errors.Wrap(err, "delete storage usage")
+	}
+	var keys []string
+	var exclusive, shared []int64
+	for key, u := range usage {
+		keys = append(keys, key)
+		exclusive = append(exclusive, u.ExclusiveBytes)
+		shared = append(shared, u.SharedBytes)
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO pfs.storage_usage (key, exclusive_bytes, shared_bytes, computed_at)
+		SELECT key, exclusive_bytes, shared_bytes, $4
+		FROM unnest($1::text[], $2::bigint[], $3::bigint[]) AS usage(key, exclusive_bytes, shared_bytes)
+	`, keys, exclusive, shared, computedAt); err != nil {
+		return errors.Wrap(err, "insert storage usage")
+	}
+	return nil
+}
+
+// GetStorageUsage returns the cached storage usage for key, or nil if it has
+// not been computed yet.
+func GetStorageUsage(ctx context.Context, tx *pachsql.Tx, key string) (*pfs.StorageUsage, error) {
+	row := &storageUsageRow{}
+	if err := tx.GetContext(ctx, row, `
+		SELECT key, exclusive_bytes, shared_bytes, computed_at
+		FROM pfs.storage_usage
+		WHERE key = $1
+	`, key); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "get storage usage")
+	}
+	return &pfs.StorageUsage{
+		ExclusiveBytes: row.ExclusiveBytes,
+		SharedBytes:    row.SharedBytes,
+		ComputedAt:     timestamppb.New(row.ComputedAt),
+	}, nil
+}
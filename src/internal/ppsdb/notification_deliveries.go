@@ -0,0 +1,134 @@
+package ppsdb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+// NotificationDelivery is a row of pps.notification_deliveries, the delivery
+// log of notifications.  Pending deliveries are also the queue which the PPS
+// master delivers from.
+type NotificationDelivery struct {
+	ID            string       `db:"id"`
+	Notification  string       `db:"notification"`
+	EventID       string       `db:"event_id"`
+	Payload       []byte       `db:"payload"`
+	State         int32        `db:"state"`
+	Attempts      int32        `db:"attempts"`
+	StatusCode    int32        `db:"status_code"`
+	Error         string       `db:"error"`
+	CreatedAt     time.Time    `db:"created_at"`
+	NextAttemptAt time.Time    `db:"next_attempt_at"`
+	DeliveredAt   sql.NullTime `db:"delivered_at"`
+}
+
+// PB returns the delivery as a NotificationDeliveryInfo.
+func (d *NotificationDelivery) PB() *pps.NotificationDeliveryInfo {
+	info := &pps.NotificationDeliveryInfo{
+		Id:           d.ID,
+		Notification: &pps.Notification{Name: d.Notification},
+		EventId:      d.EventID,
+		State:        pps.NotificationDeliveryState(d.State),
+		Attempts:     d.Attempts,
+		StatusCode:   d.StatusCode,
+		Error:        d.Error,
+		CreatedAt:    timestamppb.New(d.CreatedAt),
+	}
+	if d.DeliveredAt.Valid {
+		info.DeliveredAt = timestamppb.New(d.DeliveredAt.Time)
+	}
+	return info
+}
+
+const notificationDeliveryColumns = `id, notification, event_id, payload, state, attempts, status_code, error, created_at, next_attempt_at, delivered_at`
+
+// CreateNotificationDelivery queues a pending delivery of d's event to its
+// notification.  It returns false, and does nothing, if the event has already
+// been queued for the notification.
+func CreateNotificationDelivery(ctx context.Context, tx *pachsql.Tx, d *NotificationDelivery) (bool, error) {
+	res, err := tx.ExecContext(ctx, `
+		INSERT INTO pps.notification_deliveries (id, notification, event_id, payload, state)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (notification, event_id) DO NOTHING
+	`, d.ID, d.Notification, d.EventID, d.Payload, int32(pps.NotificationDeliveryState_NOTIFICATION_DELIVERY_PENDING))
+	if err != nil {
+		return false, errors.Wrap(err, "create notification delivery")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "create notification delivery")
+	}
+	return n > 0, nil
+}
+
+// ListDueNotificationDeliveries returns up to limit pending deliveries whose
+// next attempt is due at now, oldest first.
+func ListDueNotificationDeliveries(ctx context.Context, tx *pachsql.Tx, now time.Time, limit int) ([]*NotificationDelivery, error) {
+	var ds []*NotificationDelivery
+	if err := tx.SelectContext(ctx, &ds, `
+		SELECT `+notificationDeliveryColumns+`
+		FROM pps.notification_deliveries
+		WHERE state = $1 AND next_attempt_at <= $2
+		ORDER BY next_attempt_at
+		LIMIT $3
+	`, int32(pps.NotificationDeliveryState_NOTIFICATION_DELIVERY_PENDING), now, limit); err != nil {
+		return nil, errors.Wrap(err, "list due notification deliveries")
+	}
+	return ds, nil
+}
+
+// ListNotificationDeliveries returns the delivery log of notification, most
+// recent first.
+func ListNotificationDeliveries(ctx context.Context, tx *pachsql.Tx, notification string) ([]*NotificationDelivery, error) {
+	var ds []*NotificationDelivery
+	if err := tx.SelectContext(ctx, &ds, `
+		SELECT `+notificationDeliveryColumns+`
+		FROM pps.notification_deliveries
+		WHERE notification = $1
+		ORDER BY created_at DESC
+	`, notification); err != nil {
+		return nil, errors.Wrap(err, "list notification deliveries")
+	}
+	return ds, nil
+}
+
+// UpdateNotificationDelivery records the result of an attempt to deliver d.
+func UpdateNotificationDelivery(ctx context.Context, tx *pachsql.Tx, d *NotificationDelivery) error {
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE pps.notification_deliveries
+		SET state = $2, attempts = $3, status_code = $4, error = $5, next_attempt_at = $6, delivered_at = $7
+		WHERE id = $1
+	`, d.ID, d.State, d.Attempts, d.StatusCode, d.Error, d.NextAttemptAt, d.DeliveredAt); err != nil {
+		return errors.Wrap(err, "update notification delivery")
+	}
+	return nil
+}
+
+// DeleteNotificationDeliveries deletes the delivery log of notification,
+// including its pending deliveries.
+func DeleteNotificationDeliveries(ctx context.Context, tx *pachsql.Tx, notification string) error {
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM pps.notification_deliveries WHERE notification = $1
+	`, notification); err != nil {
+		return errors.Wrap(err, "delete notification deliveries")
+	}
+	return nil
+}
+
+// PruneNotificationDeliveries deletes the deliveries created before before
+// which are no longer pending.
+func PruneNotificationDeliveries(ctx context.Context, tx *pachsql.Tx, before time.Time) error {
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM pps.notification_deliveries WHERE state != $1 AND created_at < $2
+	`, int32(pps.NotificationDeliveryState_NOTIFICATION_DELIVERY_PENDING), before); err != nil {
+		return errors.Wrap(err, "prune notification deliveries")
+	}
+	return nil
+}
@@ -20,6 +20,7 @@ const (
 	jobsCollectionName            = "jobs"
 	clusterDefaultsCollectionName = "cluster_defaults"
 	projectDefaultsCollectionName = "project_defaults"
+	notificationsCollectionName   = "notifications"
 )
 
 // PipelinesVersionIndex records the version numbers of pipelines
@@ -185,6 +186,17 @@ func ProjectDefaults(db *pachsql.DB, listener col.PostgresListener) col.Postgres
 	)
 }
 
+// Notifications returns a PostgresCollection of notifications, keyed by name.
+func Notifications(db *pachsql.DB, listener col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(
+		notificationsCollectionName,
+		db,
+		listener,
+		&pps.NotificationInfo{},
+		nil,
+	)
+}
+
 // CollectionsV0 returns a list of all the PPS API collections for
 // postgres-initialization purposes. These collections are not usable for
 // querying.
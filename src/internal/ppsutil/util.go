@@ -286,6 +286,7 @@ func PipelineReqFromInfo(pipelineInfo *pps.PipelineInfo) *pps.CreatePipelineRequ
 		Autoscaling:             pipelineInfo.Details.Autoscaling,
 		Tolerations:             pipelineInfo.Details.Tolerations,
 		Determined:              det,
+		OutputContract:          pipelineInfo.Details.OutputContract,
 	}
 }
 
@@ -0,0 +1,210 @@
+package remotefs
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strings"
+
+	"gocloud.dev/blob/driver"
+	"gocloud.dev/gcerrors"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+)
+
+const defaultPageSize = 1000
+
+var errNotImplemented = errors.New("not implemented for remote file systems")
+
+var _ driver.Bucket = &bucket{}
+
+// bucket is a gocloud bucket driver for a remote file system.
+type bucket struct {
+	fs FS
+}
+
+func keyPath(key string) string {
+	return "/" + key
+}
+
+func (b *bucket) ErrorCode(err error) gcerrors.ErrorCode {
+	switch {
+	case pacherr.IsNotExist(err):
+		return gcerrors.NotFound
+	case errors.Is(err, errNotImplemented):
+		return gcerrors.Unimplemented
+	}
+	return gcerrors.Unknown
+}
+
+func (b *bucket) As(interface{}) bool { return false }
+
+func (b *bucket) ErrorAs(error, interface{}) bool { return false }
+
+func (b *bucket) Attributes(ctx context.Context, key string) (*driver.Attributes, error) {
+	fi, err := b.stat(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return &driver.Attributes{Size: fi.Size, ModTime: fi.ModTime}, nil
+}
+
+// stat describes the file at key, which must not be a directory.
+func (b *bucket) stat(ctx context.Context, key string) (FileInfo, error) {
+	fi, err := b.fs.Stat(ctx, keyPath(key))
+	if err != nil {
+		return FileInfo{}, err
+	}
+	if fi.IsDir {
+		return FileInfo{}, pacherr.NewNotExist("files", key)
+	}
+	return fi, nil
+}
+
+// ListPaged lists the files under the prefix.  File systems can't list files
+// by prefix, so every page walks the directories the prefix could match.
+func (b *bucket) ListPaged(ctx context.Context, opts *driver.ListOptions) (*driver.ListPage, error) {
+	var objs []*driver.ListObject
+	dir := opts.Prefix[:strings.LastIndex(opts.Prefix, "/")+1]
+	if err := b.walk(ctx, dir, opts.Prefix, func(key string, fi FileInfo) error {
+		objs = append(objs, &driver.ListObject{Key: key, Size: fi.Size, ModTime: fi.ModTime})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.Slice(objs, func(i, j int) bool { return objs[i].Key < objs[j].Key })
+	if opts.Delimiter != "" {
+		objs = collapse(objs, opts.Prefix, opts.Delimiter)
+	}
+	pageSize := opts.PageSize
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if len(opts.PageToken) > 0 {
+		token := string(opts.PageToken)
+		i := sort.Search(len(objs), func(i int) bool { return objs[i].Key > token })
+		objs = objs[i:]
+	}
+	page := &driver.ListPage{Objects: objs}
+	if len(objs) > pageSize {
+		page.Objects = objs[:pageSize]
+		page.NextPageToken = []byte(objs[pageSize-1].Key)
+	}
+	return page, nil
+}
+
+// walk calls cb with every file under dir, a key ending with a slash or the
+// empty string, whose key starts with prefix.
+func (b *bucket) walk(ctx context.Context, dir, prefix string, cb func(key string, fi FileInfo) error) error {
+	fis, err := b.fs.ReadDir(ctx, keyPath(dir))
+	if err != nil {
+		if pacherr.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	for _, fi := range fis {
+		key := dir + fi.Name
+		if fi.IsDir {
+			key += "/"
+			if strings.HasPrefix(key, prefix) || strings.HasPrefix(prefix, key) {
+				if err := b.walk(ctx, key, prefix, cb); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if strings.HasPrefix(key, prefix) {
+			if err := cb(key, fi); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// collapse replaces the sorted objs whose keys contain delim after prefix
+// with directories, the way object stores list objects with a delimiter.
+func collapse(objs []*driver.ListObject, prefix, delim string) []*driver.ListObject {
+	var res []*driver.ListObject
+	var lastDir string
+	for _, obj := range objs {
+		rest := strings.TrimPrefix(obj.Key, prefix)
+		i := strings.Index(rest, delim)
+		if i < 0 {
+			res = append(res, obj)
+			continue
+		}
+		// Keys sharing a directory are adjacent, since objs are sorted.
+		if dir := prefix + rest[:i+len(delim)]; dir != lastDir {
+			res = append(res, &driver.ListObject{Key: dir, IsDir: true})
+			lastDir = dir
+		}
+	}
+	return res
+}
+
+func (b *bucket) NewRangeReader(ctx context.Context, key string, offset, length int64, _ *driver.ReaderOptions) (driver.Reader, error) {
+	fi, err := b.stat(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	rc, err := b.fs.Open(ctx, keyPath(key), offset, length)
+	if err != nil {
+		return nil, err
+	}
+	return &reader{
+		ReadCloser: rc,
+		attrs:      &driver.ReaderAttributes{Size: fi.Size, ModTime: fi.ModTime},
+	}, nil
+}
+
+type reader struct {
+	io.ReadCloser
+	attrs *driver.ReaderAttributes
+}
+
+func (r *reader) Attributes() *driver.ReaderAttributes { return r.attrs }
+
+func (r *reader) As(interface{}) bool { return false }
+
+func (b *bucket) NewTypedWriter(ctx context.Context, key, _ string, _ *driver.WriterOptions) (driver.Writer, error) {
+	w, err := b.fs.Create(ctx, keyPath(key))
+	return w, err
+}
+
+// Copy copies a file through pachd, since the file systems can't copy files.
+func (b *bucket) Copy(ctx context.Context, dstKey, srcKey string, _ *driver.CopyOptions) (retErr error) {
+	ctx, cancel := pctx.WithCancel(ctx)
+	defer cancel()
+	r, err := b.fs.Open(ctx, keyPath(srcKey), 0, -1)
+	if err != nil {
+		return err
+	}
+	defer errors.Close(&retErr, r, "close %s", srcKey)
+	w, err := b.fs.Create(ctx, keyPath(dstKey))
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(w, r); err != nil {
+		// Abandon the partial file.
+		cancel()
+		w.Close() //nolint:errcheck
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(w.Close())
+}
+
+func (b *bucket) Delete(ctx context.Context, key string) error {
+	return b.fs.Remove(ctx, keyPath(key))
+}
+
+func (b *bucket) SignedURL(context.Context, string, *driver.SignedURLOptions) (string, error) {
+	return "", errNotImplemented
+}
+
+func (b *bucket) Close() error {
+	return b.fs.Close()
+}
@@ -0,0 +1,170 @@
+// Package remotefs reads and writes files on remote file systems (SFTP
+// servers, WebDAV servers and HDFS clusters, through WebHDFS) as gocloud blob
+// buckets, so that they can be used as the sources and targets of URL ingest
+// and egress alongside object stores.
+//
+// A file's key is its path, without the leading slash.
+package remotefs
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"time"
+
+	"gocloud.dev/blob"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+// Schemes are the URL schemes of the remote file systems.
+var Schemes = []string{"sftp", "webdav", "webdavs", "webhdfs", "swebhdfs"}
+
+// IsScheme returns true if scheme is the URL scheme of a remote file system.
+func IsScheme(scheme string) bool {
+	for _, s := range Schemes {
+		if s == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// FileInfo describes a file or directory.
+type FileInfo struct {
+	// Name is the name of the file within its directory.
+	Name    string
+	Size    int64
+	ModTime time.Time
+	IsDir   bool
+}
+
+// FS is a remote file system.  Paths are absolute and slash separated.
+// Operations on files that don't exist return errors for which
+// pacherr.IsNotExist is true.
+type FS interface {
+	// ReadDir returns the files and directories in dir.
+	ReadDir(ctx context.Context, dir string) ([]FileInfo, error)
+	// Stat describes the file at p.
+	Stat(ctx context.Context, p string) (FileInfo, error)
+	// Open reads length bytes of the file at p, starting at offset.  If
+	// length is negative, it reads the rest of the file.
+	Open(ctx context.Context, p string, offset, length int64) (io.ReadCloser, error)
+	// Create writes the file at p, replacing it if it exists, and creating
+	// its parent directories if they don't.  The write is abandoned if ctx
+	// is cancelled before the returned writer is closed.
+	Create(ctx context.Context, p string) (io.WriteCloser, error)
+	// Remove removes the file at p.
+	Remove(ctx context.Context, p string) error
+	// Close releases the file system's connections.
+	Close() error
+}
+
+// Credentials authenticate to a remote file system.  Which fields are used
+// depends on the file system.
+type Credentials struct {
+	Username string
+	Password string
+	// PrivateKey is a PEM encoded SSH private key.
+	PrivateKey []byte
+	// KnownHosts holds the SSH host keys that SFTP servers are trusted with,
+	// in the format of OpenSSH's known_hosts file.
+	KnownHosts []byte
+	// Token is a bearer token for WebDAV, or a delegation token for WebHDFS.
+	Token string
+}
+
+// The keys of the secrets which hold credentials.
+const (
+	UsernameKey   = "username"
+	PasswordKey   = "password"
+	PrivateKeyKey = "private_key"
+	KnownHostsKey = "known_hosts"
+	TokenKey      = "token"
+)
+
+// CredentialsFromSecret reads credentials from the data of a secret.
+func CredentialsFromSecret(data map[string][]byte) Credentials {
+	return Credentials{
+		Username:   string(data[UsernameKey]),
+		Password:   string(data[PasswordKey]),
+		PrivateKey: data[PrivateKeyKey],
+		KnownHosts: data[KnownHostsKey],
+		Token:      string(data[TokenKey]),
+	}
+}
+
+// Open connects to the file system at host, which may include a user and a
+// port, using the protocol given by scheme.  A user in host takes precedence
+// over the one in creds.
+func Open(ctx context.Context, scheme, host string, creds Credentials) (FS, error) {
+	u, err := url.Parse(scheme + "://" + host)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse host %q", host)
+	}
+	if name := u.User.Username(); name != "" {
+		creds.Username = name
+	}
+	switch scheme {
+	case "sftp":
+		return openSFTP(ctx, u.Host, creds)
+	case "webdav":
+		return newWebDAV("http", u.Host, creds), nil
+	case "webdavs":
+		return newWebDAV("https", u.Host, creds), nil
+	case "webhdfs":
+		return newWebHDFS("http", u.Host, creds), nil
+	case "swebhdfs":
+		return newWebHDFS("https", u.Host, creds), nil
+	default:
+		return nil, errors.Errorf("unrecognized remote file system: %s", scheme)
+	}
+}
+
+// OpenBucket opens the file system at host, like Open, as a bucket.
+func OpenBucket(ctx context.Context, scheme, host string, creds Credentials) (*blob.Bucket, error) {
+	fs, err := Open(ctx, scheme, host, creds)
+	if err != nil {
+		return nil, err
+	}
+	return blob.NewBucket(&bucket{fs: fs}), nil
+}
+
+// pipeWriter writes a file by streaming what's written to it to a function.
+type pipeWriter struct {
+	ctx  context.Context
+	pw   *io.PipeWriter
+	done chan error
+}
+
+// newPipeWriter returns a writer whose writes are read by write, which is
+// called in its own goroutine.  Closing the writer waits for write to return.
+// If ctx is cancelled before the writer is closed, write's reader returns the
+// cause, which abandons the file.
+func newPipeWriter(ctx context.Context, write func(r io.Reader) error) io.WriteCloser {
+	pr, pw := io.Pipe()
+	w := &pipeWriter{ctx: ctx, pw: pw, done: make(chan error, 1)}
+	go func() {
+		// Hide the reader's Close method, since HTTP clients close request
+		// bodies when requests fail, which would mask the error.
+		err := write(struct{ io.Reader }{pr})
+		pr.CloseWithError(err)
+		w.done <- err
+	}()
+	return w
+}
+
+func (w *pipeWriter) Write(data []byte) (int, error) {
+	n, err := w.pw.Write(data)
+	return n, errors.EnsureStack(err)
+}
+
+func (w *pipeWriter) Close() error {
+	if err := context.Cause(w.ctx); err != nil {
+		w.pw.CloseWithError(err)
+		<-w.done
+		return errors.EnsureStack(err)
+	}
+	w.pw.Close()
+	return <-w.done
+}
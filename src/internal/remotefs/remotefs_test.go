@@ -0,0 +1,266 @@
+package remotefs
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+	"golang.org/x/net/webdav"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+// testBucket tests a remote file system through the bucket returned by
+// newBucket.
+func testBucket(t *testing.T, newBucket func(t testing.TB) *blob.Bucket) {
+	ctx := pctx.TestContext(t)
+	write := func(t testing.TB, b *blob.Bucket, key string, data []byte) {
+		require.NoError(t, b.WriteAll(ctx, key, data, nil))
+	}
+	list := func(t testing.TB, b *blob.Bucket, opts *blob.ListOptions) []string {
+		var keys []string
+		it := b.List(opts)
+		for {
+			obj, err := it.Next(ctx)
+			if errors.Is(err, io.EOF) {
+				return keys
+			}
+			require.NoError(t, err)
+			keys = append(keys, obj.Key)
+		}
+	}
+
+	t.Run("ReadWrite", func(t *testing.T) {
+		b := newBucket(t)
+		data := make([]byte, 1<<20)
+		_, err := rand.Read(data)
+		require.NoError(t, err)
+		write(t, b, "a/b/c", data)
+		got, err := b.ReadAll(ctx, "a/b/c")
+		require.NoError(t, err)
+		require.True(t, bytes.Equal(data, got))
+		attrs, err := b.Attributes(ctx, "a/b/c")
+		require.NoError(t, err)
+		require.Equal(t, int64(len(data)), attrs.Size)
+
+		r, err := b.NewRangeReader(ctx, "a/b/c", 100, 50000, nil)
+		require.NoError(t, err)
+		got, err = io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		require.True(t, bytes.Equal(data[100:50100], got))
+
+		// Overwrite with a shorter file.
+		write(t, b, "a/b/c", []byte("foo"))
+		got, err = b.ReadAll(ctx, "a/b/c")
+		require.NoError(t, err)
+		require.Equal(t, "foo", string(got))
+	})
+
+	t.Run("List", func(t *testing.T) {
+		b := newBucket(t)
+		for _, key := range []string{"dir/a", "dir/sub/b", "dir/sub/c", "dirt", "other/d"} {
+			write(t, b, key, []byte(key))
+		}
+		require.Equal(t, []string{"dir/a", "dir/sub/b", "dir/sub/c", "dirt", "other/d"}, list(t, b, nil))
+		require.Equal(t, []string{"dir/a", "dir/sub/b", "dir/sub/c", "dirt"}, list(t, b, &blob.ListOptions{Prefix: "dir"}))
+		require.Equal(t, []string{"dir/sub/b", "dir/sub/c"}, list(t, b, &blob.ListOptions{Prefix: "dir/s"}))
+		require.Equal(t, []string{"dir/", "dirt", "other/"}, list(t, b, &blob.ListOptions{Delimiter: "/"}))
+		require.Equal(t, 0, len(list(t, b, &blob.ListOptions{Prefix: "missing/"})))
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		b := newBucket(t)
+		write(t, b, "dir/a", []byte("a"))
+		_, err := b.ReadAll(ctx, "missing")
+		require.Equal(t, gcerrors.NotFound, gcerrors.Code(err))
+		// Directories aren't objects.
+		_, err = b.ReadAll(ctx, "dir")
+		require.Equal(t, gcerrors.NotFound, gcerrors.Code(err))
+		require.NoError(t, b.Delete(ctx, "dir/a"))
+		_, err = b.ReadAll(ctx, "dir/a")
+		require.Equal(t, gcerrors.NotFound, gcerrors.Code(err))
+	})
+
+	t.Run("CancelledWrite", func(t *testing.T) {
+		b := newBucket(t)
+		ctx, cancel := context.WithCancel(ctx)
+		w, err := b.NewWriter(ctx, "a", nil)
+		require.NoError(t, err)
+		_, err = w.Write([]byte("partial"))
+		require.NoError(t, err)
+		cancel()
+		require.YesError(t, w.Close())
+	})
+}
+
+func TestWebDAV(t *testing.T) {
+	testBucket(t, func(t testing.TB) *blob.Bucket {
+		h := &webdav.Handler{
+			FileSystem: webdav.Dir(t.TempDir()),
+			LockSystem: webdav.NewMemLS(),
+		}
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "pass" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			h.ServeHTTP(w, r)
+		}))
+		t.Cleanup(s.Close)
+		b, err := OpenBucket(pctx.TestContext(t), "webdav", "user@"+strings.TrimPrefix(s.URL, "http://"), Credentials{Password: "pass"})
+		require.NoError(t, err)
+		t.Cleanup(func() { b.Close() })
+		return b
+	})
+}
+
+func TestWebHDFS(t *testing.T) {
+	testBucket(t, func(t testing.TB) *blob.Bucket {
+		s := httptest.NewServer(&fakeWebHDFS{root: t.TempDir(), token: "token"})
+		t.Cleanup(s.Close)
+		b, err := OpenBucket(pctx.TestContext(t), "webhdfs", strings.TrimPrefix(s.URL, "http://"), Credentials{Token: "token"})
+		require.NoError(t, err)
+		t.Cleanup(func() { b.Close() })
+		return b
+	})
+}
+
+// fakeWebHDFS serves the parts of the WebHDFS API that are used from a
+// directory.  Like HDFS, reads and writes are redirected to a "datanode".
+type fakeWebHDFS struct {
+	root  string
+	token string
+}
+
+func (f *fakeWebHDFS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("delegation") != f.token {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if strings.HasPrefix(r.URL.Path, "/datanode/") {
+		f.serveData(w, r, strings.TrimPrefix(r.URL.Path, "/datanode"))
+		return
+	}
+	p := strings.TrimPrefix(r.URL.Path, webHDFSPrefix)
+	fp := filepath.Join(f.root, p)
+	switch q.Get("op") {
+	case "GETFILESTATUS":
+		fi, err := os.Stat(fp)
+		if err != nil {
+			f.error(w, err)
+			return
+		}
+		f.json(w, map[string]any{"FileStatus": f.status(fi, "")})
+	case "LISTSTATUS":
+		fi, err := os.Stat(fp)
+		if err != nil {
+			f.error(w, err)
+			return
+		}
+		statuses := []fileStatus{}
+		if fi.IsDir() {
+			des, err := os.ReadDir(fp)
+			if err != nil {
+				f.error(w, err)
+				return
+			}
+			for _, de := range des {
+				fi, err := de.Info()
+				if err != nil {
+					f.error(w, err)
+					return
+				}
+				statuses = append(statuses, f.status(fi, de.Name()))
+			}
+		} else {
+			statuses = append(statuses, f.status(fi, ""))
+		}
+		f.json(w, map[string]any{"FileStatuses": map[string]any{"FileStatus": statuses}})
+	case "OPEN", "CREATE":
+		u := url.URL{Path: "/datanode" + p, RawQuery: r.URL.RawQuery}
+		http.Redirect(w, r, u.String(), http.StatusTemporaryRedirect)
+	case "DELETE":
+		err := os.Remove(fp)
+		f.json(w, map[string]bool{"boolean": err == nil})
+	default:
+		http.Error(w, "unsupported op", http.StatusBadRequest)
+	}
+}
+
+func (f *fakeWebHDFS) serveData(w http.ResponseWriter, r *http.Request, p string) {
+	fp := filepath.Join(f.root, p)
+	switch r.Method {
+	case http.MethodGet:
+		file, err := os.Open(fp)
+		if err != nil {
+			f.error(w, err)
+			return
+		}
+		defer file.Close()
+		offset, _ := strconv.ParseInt(r.URL.Query().Get("offset"), 10, 64)
+		var rd io.Reader = io.NewSectionReader(file, offset, 1<<62)
+		if length := r.URL.Query().Get("length"); length != "" {
+			n, _ := strconv.ParseInt(length, 10, 64)
+			rd = io.LimitReader(rd, n)
+		}
+		io.Copy(w, rd) //nolint:errcheck
+	case http.MethodPut:
+		if err := os.MkdirAll(filepath.Dir(fp), 0o755); err != nil {
+			f.error(w, err)
+			return
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			f.error(w, err)
+			return
+		}
+		if err := os.WriteFile(fp, data, 0o644); err != nil {
+			f.error(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}
+}
+
+func (f *fakeWebHDFS) status(fi os.FileInfo, suffix string) fileStatus {
+	s := fileStatus{PathSuffix: suffix, Type: "FILE", Length: fi.Size(), ModificationTime: fi.ModTime().UnixMilli()}
+	if fi.IsDir() {
+		s.Type, s.Length = "DIRECTORY", 0
+	}
+	return s
+}
+
+func (f *fakeWebHDFS) json(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v) //nolint:errcheck
+}
+
+func (f *fakeWebHDFS) error(w http.ResponseWriter, err error) {
+	var re remoteException
+	re.RemoteException.Message = err.Error()
+	re.RemoteException.Exception = "IOException"
+	code := http.StatusInternalServerError
+	if os.IsNotExist(err) {
+		re.RemoteException.Exception = "FileNotFoundException"
+		code = http.StatusNotFound
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(re) //nolint:errcheck
+}
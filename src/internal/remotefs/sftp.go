@@ -0,0 +1,243 @@
+package remotefs
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"net"
+	"os"
+	"path"
+	"time"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+)
+
+// sftpMaxWrites is the most write requests that are outstanding at once
+// while creating a file.
+const sftpMaxWrites = 16
+
+// sftpFS is an SFTP server, reached over SSH.  The sftp package doesn't take
+// contexts, so contexts are checked before each request.
+type sftpFS struct {
+	host   string
+	ssh    *ssh.Client
+	client *sftp.Client
+}
+
+// openSFTP connects to the SFTP server at host.  The server's host key must
+// be in creds.KnownHosts.
+func openSFTP(ctx context.Context, host string, creds Credentials) (_ *sftpFS, retErr error) {
+	if _, _, err := net.SplitHostPort(host); err != nil {
+		host = net.JoinHostPort(host, "22")
+	}
+	config, err := sshConfig(creds)
+	if err != nil {
+		return nil, err
+	}
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", host)
+	if err != nil {
+		return nil, errors.Wrapf(err, "dial %s", host)
+	}
+	// The handshakes don't take a context, so they are bounded by the
+	// context's deadline, if it has one.
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline) //nolint:errcheck
+	}
+	c, chans, reqs, err := ssh.NewClientConn(conn, host, config)
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrapf(err, "ssh handshake with %s", host)
+	}
+	client := ssh.NewClient(c, chans, reqs)
+	defer func() {
+		if retErr != nil {
+			client.Close()
+		}
+	}()
+	sc, err := sftp.NewClient(client, sftp.MaxConcurrentRequestsPerFile(sftpMaxWrites))
+	if err != nil {
+		return nil, errors.Wrapf(err, "start sftp subsystem on %s", host)
+	}
+	conn.SetDeadline(time.Time{}) //nolint:errcheck
+	return &sftpFS{host: host, ssh: client, client: sc}, nil
+}
+
+func sshConfig(creds Credentials) (*ssh.ClientConfig, error) {
+	if creds.Username == "" {
+		return nil, errors.New("sftp requires a username")
+	}
+	if len(creds.KnownHosts) == 0 {
+		return nil, errors.New("sftp requires known hosts to verify the server's host key")
+	}
+	hostKeys, err := parseKnownHosts(creds.KnownHosts)
+	if err != nil {
+		return nil, err
+	}
+	config := &ssh.ClientConfig{
+		User:            creds.Username,
+		HostKeyCallback: hostKeys,
+	}
+	if len(creds.PrivateKey) > 0 {
+		signer, err := ssh.ParsePrivateKey(creds.PrivateKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse ssh private key")
+		}
+		config.Auth = append(config.Auth, ssh.PublicKeys(signer))
+	}
+	if creds.Password != "" {
+		config.Auth = append(config.Auth, ssh.Password(creds.Password))
+	}
+	if len(config.Auth) == 0 {
+		return nil, errors.New("sftp requires a private key or a password")
+	}
+	return config, nil
+}
+
+// parseKnownHosts returns a callback which checks host keys against
+// knownHosts.  The knownhosts package only reads files, so they are written
+// to a temporary one.
+func parseKnownHosts(knownHosts []byte) (_ ssh.HostKeyCallback, retErr error) {
+	f, err := os.CreateTemp("", "known_hosts")
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	defer func() {
+		errors.Close(&retErr, f, "close known hosts")
+		if err := os.Remove(f.Name()); retErr == nil {
+			retErr = errors.EnsureStack(err)
+		}
+	}()
+	if _, err := f.Write(knownHosts); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	cb, err := knownhosts.New(f.Name())
+	return cb, errors.Wrap(err, "parse known hosts")
+}
+
+// notExist converts the server's "no such file" status to a not exist error.
+func (s *sftpFS) notExist(err error, p string) error {
+	if errors.Is(err, fs.ErrNotExist) {
+		return pacherr.NewNotExist(s.host, p)
+	}
+	return errors.Wrapf(err, "sftp %s", p)
+}
+
+func sftpFileInfo(fi os.FileInfo) FileInfo {
+	return FileInfo{Name: fi.Name(), Size: fi.Size(), ModTime: fi.ModTime(), IsDir: fi.IsDir()}
+}
+
+func (s *sftpFS) ReadDir(ctx context.Context, dir string) ([]FileInfo, error) {
+	if err := context.Cause(ctx); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	fis, err := s.client.ReadDir(dir)
+	if err != nil {
+		return nil, s.notExist(err, dir)
+	}
+	res := make([]FileInfo, len(fis))
+	for i, fi := range fis {
+		res[i] = sftpFileInfo(fi)
+	}
+	return res, nil
+}
+
+func (s *sftpFS) Stat(ctx context.Context, p string) (FileInfo, error) {
+	if err := context.Cause(ctx); err != nil {
+		return FileInfo{}, errors.EnsureStack(err)
+	}
+	fi, err := s.client.Stat(p)
+	if err != nil {
+		return FileInfo{}, s.notExist(err, p)
+	}
+	res := sftpFileInfo(fi)
+	res.Name = path.Base(p)
+	return res, nil
+}
+
+func (s *sftpFS) Open(ctx context.Context, p string, offset, length int64) (io.ReadCloser, error) {
+	if err := context.Cause(ctx); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	f, err := s.client.Open(p)
+	if err != nil {
+		return nil, s.notExist(err, p)
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		f.Close()
+		return nil, errors.Wrapf(err, "sftp seek %s", p)
+	}
+	var r io.Reader = f
+	if length >= 0 {
+		r = io.LimitReader(f, length)
+	}
+	return &sftpReadCloser{ctx: ctx, r: r, f: f}, nil
+}
+
+type sftpReadCloser struct {
+	ctx context.Context
+	r   io.Reader
+	f   *sftp.File
+}
+
+func (r *sftpReadCloser) Read(data []byte) (int, error) {
+	if err := context.Cause(r.ctx); err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	n, err := r.r.Read(data)
+	return n, errors.EnsureStack(err)
+}
+
+func (r *sftpReadCloser) Close() error {
+	return errors.EnsureStack(r.f.Close())
+}
+
+func (s *sftpFS) Create(ctx context.Context, p string) (io.WriteCloser, error) {
+	if err := s.mkdirAll(ctx, path.Dir(p)); err != nil {
+		return nil, err
+	}
+	f, err := s.client.OpenFile(p, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+	if err != nil {
+		return nil, errors.Wrapf(err, "sftp create %s", p)
+	}
+	return newPipeWriter(ctx, func(r io.Reader) (retErr error) {
+		defer func() {
+			if err := f.Close(); retErr == nil && err != nil {
+				retErr = errors.Wrapf(err, "sftp close %s", p)
+			}
+			if retErr != nil {
+				s.client.Remove(p) //nolint:errcheck
+			}
+		}()
+		_, err := f.ReadFromWithConcurrency(r, sftpMaxWrites)
+		return errors.Wrapf(err, "sftp write %s", p)
+	}), nil
+}
+
+// mkdirAll creates dir and its parents, if they don't exist.
+func (s *sftpFS) mkdirAll(ctx context.Context, dir string) error {
+	if err := context.Cause(ctx); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return errors.Wrapf(s.client.MkdirAll(dir), "sftp mkdir %s", dir)
+}
+
+func (s *sftpFS) Remove(ctx context.Context, p string) error {
+	if err := context.Cause(ctx); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if err := s.client.Remove(p); err != nil {
+		return s.notExist(err, p)
+	}
+	return nil
+}
+
+func (s *sftpFS) Close() error {
+	s.client.Close() //nolint:errcheck
+	return errors.EnsureStack(s.ssh.Close())
+}
@@ -0,0 +1,119 @@
+package remotefs
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"net"
+	"testing"
+
+	"github.com/pkg/sftp"
+	"gocloud.dev/blob"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestSFTP(t *testing.T) {
+	testBucket(t, func(t testing.TB) *blob.Bucket {
+		addr, creds := newTestSFTPServer(t)
+		b, err := OpenBucket(pctx.TestContext(t), "sftp", "user@"+addr, creds)
+		require.NoError(t, err)
+		t.Cleanup(func() { b.Close() })
+		return b
+	})
+}
+
+func TestSFTPUnknownHost(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	addr, creds := newTestSFTPServer(t)
+	_, other := newTestKey(t)
+	creds.KnownHosts = []byte(knownhosts.Line([]string{addr}, other))
+	_, err := OpenBucket(ctx, "sftp", "user@"+addr, creds)
+	require.YesError(t, err)
+	creds.KnownHosts = nil
+	_, err = OpenBucket(ctx, "sftp", "user@"+addr, creds)
+	require.YesError(t, err)
+}
+
+func newTestKey(t testing.TB) (ed25519.PrivateKey, ssh.PublicKey) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	sshPub, err := ssh.NewPublicKey(pub)
+	require.NoError(t, err)
+	return priv, sshPub
+}
+
+// newTestSFTPServer serves an in-memory file system over SFTP to "user", and
+// returns the server's address and the credentials to connect to it with.
+func newTestSFTPServer(t testing.TB) (string, Credentials) {
+	hostKey, hostPub := newTestKey(t)
+	hostSigner, err := ssh.NewSignerFromKey(hostKey)
+	require.NoError(t, err)
+	userKey, userPub := newTestKey(t)
+	block, err := ssh.MarshalPrivateKey(userKey, "")
+	require.NoError(t, err)
+
+	config := &ssh.ServerConfig{
+		PublicKeyCallback: func(conn ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if conn.User() != "user" || !bytes.Equal(key.Marshal(), userPub.Marshal()) {
+				return nil, errors.New("unauthorized")
+			}
+			return nil, nil
+		},
+	}
+	config.AddHostKey(hostSigner)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	handlers := sftp.InMemHandler()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go serveSSH(conn, config, handlers)
+		}
+	}()
+	addr := l.Addr().String()
+	return addr, Credentials{
+		PrivateKey: pem.EncodeToMemory(block),
+		KnownHosts: []byte(knownhosts.Line([]string{addr}, hostPub)),
+	}
+}
+
+func serveSSH(conn net.Conn, config *ssh.ServerConfig, handlers sftp.Handlers) {
+	_, chans, reqs, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+	for newCh := range chans {
+		if newCh.ChannelType() != "session" {
+			newCh.Reject(ssh.UnknownChannelType, "unsupported channel type") //nolint:errcheck
+			continue
+		}
+		ch, reqs, err := newCh.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			for req := range reqs {
+				if req.Type != "subsystem" || !bytes.Equal(req.Payload, ssh.Marshal(struct{ Name string }{"sftp"})) {
+					req.Reply(false, nil) //nolint:errcheck
+					continue
+				}
+				req.Reply(true, nil) //nolint:errcheck
+				go func() {
+					sftp.NewRequestServer(ch, handlers).Serve() //nolint:errcheck
+					ch.Close()
+				}()
+			}
+		}()
+	}
+}
@@ -0,0 +1,271 @@
+package remotefs
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/promutil"
+)
+
+// webDAV is a WebDAV server (RFC 4918).
+type webDAV struct {
+	base   url.URL
+	creds  Credentials
+	client *http.Client
+}
+
+func newWebDAV(scheme, host string, creds Credentials) *webDAV {
+	return &webDAV{
+		base:  url.URL{Scheme: scheme, Host: host},
+		creds: creds,
+		client: &http.Client{
+			Transport: promutil.InstrumentRoundTripper("webdav", http.DefaultTransport),
+		},
+	}
+}
+
+func (d *webDAV) url(p string) string {
+	u := d.base
+	u.Path = p
+	return u.String()
+}
+
+func (d *webDAV) do(ctx context.Context, method, p string, body io.Reader, header http.Header) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, d.url(p), body)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	for k, vs := range header {
+		req.Header[k] = vs
+	}
+	switch {
+	case d.creds.Token != "":
+		req.Header.Set("Authorization", "Bearer "+d.creds.Token)
+	case d.creds.Username != "":
+		req.SetBasicAuth(d.creds.Username, d.creds.Password)
+	}
+	resp, err := d.client.Do(req)
+	return resp, errors.EnsureStack(err)
+}
+
+// check returns an error for resp if its status isn't one of ok, and closes
+// its body if so.
+func (d *webDAV) check(resp *http.Response, p string, ok ...int) error {
+	for _, code := range ok {
+		if resp.StatusCode == code {
+			return nil
+		}
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return pacherr.NewNotExist(d.base.Host, p)
+	}
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return errors.Errorf("webdav %s %s: %s: %s", resp.Request.Method, p, resp.Status, strings.TrimSpace(string(msg)))
+}
+
+type multistatus struct {
+	Responses []struct {
+		Href     string `xml:"href"`
+		Propstat []struct {
+			Prop struct {
+				ContentLength string `xml:"getcontentlength"`
+				LastModified  string `xml:"getlastmodified"`
+				ResourceType  struct {
+					Collection *struct{} `xml:"collection"`
+				} `xml:"resourcetype"`
+			} `xml:"prop"`
+			Status string `xml:"status"`
+		} `xml:"propstat"`
+	} `xml:"response"`
+}
+
+const propfindBody = `<?xml version="1.0" encoding="utf-8"?>
+<D:propfind xmlns:D="DAV:"><D:prop><D:resourcetype/><D:getcontentlength/><D:getlastmodified/></D:prop></D:propfind>`
+
+// propfind describes p, and the members of p if depth is 1, keyed by path.
+func (d *webDAV) propfind(ctx context.Context, p string, depth int) (map[string]FileInfo, error) {
+	resp, err := d.do(ctx, "PROPFIND", p, strings.NewReader(propfindBody), http.Header{
+		"Depth":        {strconv.Itoa(depth)},
+		"Content-Type": {"application/xml"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := d.check(resp, p, http.StatusMultiStatus); err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var ms multistatus
+	if err := xml.NewDecoder(resp.Body).Decode(&ms); err != nil {
+		return nil, errors.Wrapf(err, "decode webdav response for %s", p)
+	}
+	res := make(map[string]FileInfo)
+	for _, r := range ms.Responses {
+		href, err := url.Parse(r.Href)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse webdav href %q", r.Href)
+		}
+		fi := FileInfo{Name: path.Base(href.Path)}
+		for _, ps := range r.Propstat {
+			if !strings.Contains(ps.Status, " 200 ") {
+				continue
+			}
+			fi.IsDir = ps.Prop.ResourceType.Collection != nil
+			if ps.Prop.ContentLength != "" {
+				if fi.Size, err = strconv.ParseInt(ps.Prop.ContentLength, 10, 64); err != nil {
+					return nil, errors.Wrapf(err, "parse size of %s", href.Path)
+				}
+			}
+			if ps.Prop.LastModified != "" {
+				if fi.ModTime, err = http.ParseTime(ps.Prop.LastModified); err != nil {
+					return nil, errors.Wrapf(err, "parse modification time of %s", href.Path)
+				}
+			}
+		}
+		res[path.Clean(href.Path)] = fi
+	}
+	return res, nil
+}
+
+func (d *webDAV) ReadDir(ctx context.Context, dir string) ([]FileInfo, error) {
+	dir = path.Clean(dir)
+	members, err := d.propfind(ctx, strings.TrimSuffix(dir, "/")+"/", 1)
+	if err != nil {
+		return nil, err
+	}
+	var fis []FileInfo
+	for p, fi := range members {
+		if p != dir {
+			fis = append(fis, fi)
+		}
+	}
+	return fis, nil
+}
+
+func (d *webDAV) Stat(ctx context.Context, p string) (FileInfo, error) {
+	p = path.Clean(p)
+	members, err := d.propfind(ctx, p, 0)
+	if err != nil {
+		return FileInfo{}, err
+	}
+	for _, fi := range members {
+		return fi, nil
+	}
+	return FileInfo{}, pacherr.NewNotExist(d.base.Host, p)
+}
+
+func (d *webDAV) Open(ctx context.Context, p string, offset, length int64) (io.ReadCloser, error) {
+	if length == 0 {
+		return io.NopCloser(strings.NewReader("")), nil
+	}
+	header := http.Header{}
+	if offset > 0 || length > 0 {
+		rng := fmt.Sprintf("bytes=%d-", offset)
+		if length > 0 {
+			rng += strconv.FormatInt(offset+length-1, 10)
+		}
+		header.Set("Range", rng)
+	}
+	resp, err := d.do(ctx, http.MethodGet, p, nil, header)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.check(resp, p, http.StatusOK, http.StatusPartialContent, http.StatusRequestedRangeNotSatisfiable); err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusRequestedRangeNotSatisfiable:
+		// The range starts at the end of the file.
+		resp.Body.Close()
+		return io.NopCloser(strings.NewReader("")), nil
+	case http.StatusOK:
+		// The server ignored the range.
+		return rangeReadCloser(resp.Body, offset, length)
+	}
+	return resp.Body, nil
+}
+
+// rangeReadCloser reads length bytes of rc, starting at offset, or the rest
+// of rc if length is negative.
+func rangeReadCloser(rc io.ReadCloser, offset, length int64) (io.ReadCloser, error) {
+	if _, err := io.CopyN(io.Discard, rc, offset); err != nil && !errors.Is(err, io.EOF) {
+		rc.Close()
+		return nil, errors.EnsureStack(err)
+	}
+	if length < 0 {
+		return rc, nil
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.LimitReader(rc, length), rc}, nil
+}
+
+func (d *webDAV) Create(ctx context.Context, p string) (io.WriteCloser, error) {
+	if err := d.mkdirAll(ctx, path.Dir(p)); err != nil {
+		return nil, err
+	}
+	return newPipeWriter(ctx, func(r io.Reader) error {
+		resp, err := d.do(ctx, http.MethodPut, p, r, nil)
+		if err != nil {
+			return err
+		}
+		if err := d.check(resp, p, http.StatusOK, http.StatusCreated, http.StatusNoContent); err != nil {
+			return err
+		}
+		return errors.EnsureStack(resp.Body.Close())
+	}), nil
+}
+
+// mkdirAll creates dir and its parents, if they don't exist.
+func (d *webDAV) mkdirAll(ctx context.Context, dir string) error {
+	if dir == "/" || dir == "." {
+		return nil
+	}
+	if fi, err := d.Stat(ctx, dir); err == nil {
+		if !fi.IsDir {
+			return errors.Errorf("%s is not a directory", dir)
+		}
+		return nil
+	} else if !pacherr.IsNotExist(err) {
+		return err
+	}
+	if err := d.mkdirAll(ctx, path.Dir(dir)); err != nil {
+		return err
+	}
+	resp, err := d.do(ctx, "MKCOL", dir+"/", nil, nil)
+	if err != nil {
+		return err
+	}
+	// The directory may have been created concurrently.
+	if err := d.check(resp, dir, http.StatusCreated, http.StatusMethodNotAllowed); err != nil {
+		return err
+	}
+	return errors.EnsureStack(resp.Body.Close())
+}
+
+func (d *webDAV) Remove(ctx context.Context, p string) error {
+	resp, err := d.do(ctx, http.MethodDelete, p, nil, nil)
+	if err != nil {
+		return err
+	}
+	if err := d.check(resp, p, http.StatusOK, http.StatusNoContent); err != nil {
+		return err
+	}
+	return errors.EnsureStack(resp.Body.Close())
+}
+
+func (d *webDAV) Close() error {
+	d.client.CloseIdleConnections()
+	return nil
+}
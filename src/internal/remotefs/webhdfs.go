@@ -0,0 +1,234 @@
+package remotefs
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/promutil"
+)
+
+const webHDFSPrefix = "/webhdfs/v1"
+
+// webHDFS is an HDFS cluster, through the WebHDFS REST API of its namenode.
+type webHDFS struct {
+	base   url.URL
+	creds  Credentials
+	client *http.Client
+}
+
+func newWebHDFS(scheme, host string, creds Credentials) *webHDFS {
+	return &webHDFS{
+		base:  url.URL{Scheme: scheme, Host: host},
+		creds: creds,
+		client: &http.Client{
+			Transport: promutil.InstrumentRoundTripper("webhdfs", http.DefaultTransport),
+			// Writes are redirected to a datanode, which must be done by
+			// hand, since the body can't be sent twice.
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				if req.Method == http.MethodPut {
+					return http.ErrUseLastResponse
+				}
+				return nil
+			},
+		},
+	}
+}
+
+func (h *webHDFS) url(p, op string, params url.Values) string {
+	if params == nil {
+		params = url.Values{}
+	}
+	params.Set("op", op)
+	switch {
+	case h.creds.Token != "":
+		params.Set("delegation", h.creds.Token)
+	case h.creds.Username != "":
+		params.Set("user.name", h.creds.Username)
+	}
+	u := h.base
+	u.Path = webHDFSPrefix + p
+	u.RawQuery = params.Encode()
+	return u.String()
+}
+
+func (h *webHDFS) do(ctx context.Context, method, u string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/octet-stream")
+	}
+	resp, err := h.client.Do(req)
+	return resp, errors.EnsureStack(err)
+}
+
+type remoteException struct {
+	RemoteException struct {
+		Exception string `json:"exception"`
+		Message   string `json:"message"`
+	} `json:"RemoteException"`
+}
+
+// check returns an error for resp if its status isn't one of ok, and closes
+// its body if so.
+func (h *webHDFS) check(resp *http.Response, p string, ok ...int) error {
+	for _, code := range ok {
+		if resp.StatusCode == code {
+			return nil
+		}
+	}
+	defer resp.Body.Close()
+	var re remoteException
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	if err := json.Unmarshal(body, &re); err == nil && re.RemoteException.Exception != "" {
+		if re.RemoteException.Exception == "FileNotFoundException" {
+			return pacherr.NewNotExist(h.base.Host, p)
+		}
+		return errors.Errorf("webhdfs %s: %s: %s", p, re.RemoteException.Exception, re.RemoteException.Message)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return pacherr.NewNotExist(h.base.Host, p)
+	}
+	return errors.Errorf("webhdfs %s: %s: %s", p, resp.Status, strings.TrimSpace(string(body)))
+}
+
+type fileStatus struct {
+	PathSuffix       string `json:"pathSuffix"`
+	Type             string `json:"type"`
+	Length           int64  `json:"length"`
+	ModificationTime int64  `json:"modificationTime"`
+}
+
+func (s fileStatus) info(name string) FileInfo {
+	if s.PathSuffix != "" {
+		name = s.PathSuffix
+	}
+	return FileInfo{
+		Name:    name,
+		Size:    s.Length,
+		ModTime: time.UnixMilli(s.ModificationTime),
+		IsDir:   s.Type == "DIRECTORY",
+	}
+}
+
+// get reads the JSON response to a GET of op on p into v.
+func (h *webHDFS) get(ctx context.Context, p, op string, v any) error {
+	resp, err := h.do(ctx, http.MethodGet, h.url(p, op, nil), nil)
+	if err != nil {
+		return err
+	}
+	if err := h.check(resp, p, http.StatusOK); err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return errors.Wrapf(json.NewDecoder(resp.Body).Decode(v), "decode webhdfs %s response for %s", op, p)
+}
+
+func (h *webHDFS) ReadDir(ctx context.Context, dir string) ([]FileInfo, error) {
+	var res struct {
+		FileStatuses struct {
+			FileStatus []fileStatus `json:"FileStatus"`
+		} `json:"FileStatuses"`
+	}
+	if err := h.get(ctx, dir, "LISTSTATUS", &res); err != nil {
+		return nil, err
+	}
+	var fis []FileInfo
+	for _, s := range res.FileStatuses.FileStatus {
+		fis = append(fis, s.info(""))
+	}
+	return fis, nil
+}
+
+func (h *webHDFS) Stat(ctx context.Context, p string) (FileInfo, error) {
+	var res struct {
+		FileStatus fileStatus `json:"FileStatus"`
+	}
+	if err := h.get(ctx, p, "GETFILESTATUS", &res); err != nil {
+		return FileInfo{}, err
+	}
+	return res.FileStatus.info(path.Base(p)), nil
+}
+
+func (h *webHDFS) Open(ctx context.Context, p string, offset, length int64) (io.ReadCloser, error) {
+	if length == 0 {
+		return io.NopCloser(strings.NewReader("")), nil
+	}
+	params := url.Values{"offset": {strconv.FormatInt(offset, 10)}}
+	if length > 0 {
+		params.Set("length", strconv.FormatInt(length, 10))
+	}
+	// The namenode redirects reads to a datanode, which the client follows.
+	resp, err := h.do(ctx, http.MethodGet, h.url(p, "OPEN", params), nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.check(resp, p, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// Create creates the file in two steps: the namenode redirects the request
+// to the datanode that the data is written to.  HDFS creates the parent
+// directories.
+func (h *webHDFS) Create(ctx context.Context, p string) (io.WriteCloser, error) {
+	resp, err := h.do(ctx, http.MethodPut, h.url(p, "CREATE", url.Values{"overwrite": {"true"}}), nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.check(resp, p, http.StatusTemporaryRedirect); err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	location, err := resp.Location()
+	if err != nil {
+		return nil, errors.Wrapf(err, "webhdfs %s: namenode did not redirect the write to a datanode", p)
+	}
+	return newPipeWriter(ctx, func(r io.Reader) error {
+		resp, err := h.do(ctx, http.MethodPut, location.String(), r)
+		if err != nil {
+			return err
+		}
+		if err := h.check(resp, p, http.StatusCreated); err != nil {
+			return err
+		}
+		return errors.EnsureStack(resp.Body.Close())
+	}), nil
+}
+
+func (h *webHDFS) Remove(ctx context.Context, p string) error {
+	resp, err := h.do(ctx, http.MethodDelete, h.url(p, "DELETE", nil), nil)
+	if err != nil {
+		return err
+	}
+	if err := h.check(resp, p, http.StatusOK); err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	var res struct {
+		Boolean bool `json:"boolean"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return errors.Wrapf(err, "decode webhdfs DELETE response for %s", p)
+	}
+	if !res.Boolean {
+		return pacherr.NewNotExist(h.base.Host, p)
+	}
+	return nil
+}
+
+func (h *webHDFS) Close() error {
+	h.client.CloseIdleConnections()
+	return nil
+}
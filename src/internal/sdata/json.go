@@ -131,12 +131,8 @@ func (p *JSONParser) Next(row Tuple) error {
 	}
 	for i := range row {
 		colName := p.fieldNames[i]
-		v, exists := m[colName]
-		if !exists {
-			row[i] = nil
-			continue
-		}
-		if err := convert(row[i], v); err != nil {
+		// A missing field is treated as null.
+		if err := convert(row[i], m[colName]); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,302 @@
+package sdata
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"io"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+// ParquetWriter writes tuples as the rows of a Parquet file.  The file's schema
+// is derived from the types of the tuple elements; every column is optional.
+type ParquetWriter struct {
+	w      *parquet.Writer
+	fields []string
+	row    parquet.Row
+}
+
+// NewParquetWriter returns a ParquetWriter writing to w, with a column for
+// each of fieldNames, of the type of the corresponding element of row.
+func NewParquetWriter(w io.Writer, fieldNames []string, row Tuple) (*ParquetWriter, error) {
+	if len(fieldNames) != len(row) {
+		return nil, ErrTupleFields{Fields: fieldNames, Tuple: row}
+	}
+	group := make(parquet.Group)
+	for i, name := range fieldNames {
+		if _, ok := group[name]; ok {
+			return nil, errors.Errorf("duplicate field %q", name)
+		}
+		node, err := parquetNode(row[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "field %q", name)
+		}
+		group[name] = parquet.Optional(node)
+	}
+	schema := parquet.NewSchema("sdata", newParquetColumns(group, fieldNames))
+	return &ParquetWriter{
+		w:      parquet.NewWriter(w, schema, parquet.Compression(&parquet.Snappy)),
+		fields: fieldNames,
+		row:    make(parquet.Row, len(fieldNames)),
+	}, nil
+}
+
+// parquetColumns is a group whose fields are in the order of the tuples
+// written, where parquet.Group orders its fields by name.
+type parquetColumns struct {
+	parquet.Group
+	fields []parquet.Field
+}
+
+func newParquetColumns(group parquet.Group, names []string) parquetColumns {
+	byName := make(map[string]parquet.Field)
+	for _, f := range group.Fields() {
+		byName[f.Name()] = f
+	}
+	c := parquetColumns{Group: group}
+	for _, name := range names {
+		c.fields = append(c.fields, byName[name])
+	}
+	return c
+}
+
+func (c parquetColumns) Fields() []parquet.Field { return c.fields }
+
+func parquetNode(x interface{}) (parquet.Node, error) {
+	switch x.(type) {
+	case *bool, *sql.NullBool:
+		return parquet.Leaf(parquet.BooleanType), nil
+	case *byte, *int8, *int16, *int32, *int64, *sql.NullByte, *sql.NullInt16, *sql.NullInt32, *sql.NullInt64:
+		return parquet.Int(64), nil
+	case *float64, *sql.NullFloat64:
+		return parquet.Leaf(parquet.DoubleType), nil
+	case *string, *sql.NullString:
+		return parquet.String(), nil
+	case *time.Time, *sql.NullTime:
+		return parquet.Timestamp(parquet.Microsecond), nil
+	case *[]byte, *sql.RawBytes:
+		return parquet.Leaf(parquet.ByteArrayType), nil
+	default:
+		return nil, errors.Errorf("unsupported type %T", x)
+	}
+}
+
+func parquetValue(x interface{}) parquet.Value {
+	switch x := x.(type) {
+	case *bool:
+		return parquet.BooleanValue(*x)
+	case *byte:
+		return parquet.Int64Value(int64(*x))
+	case *int8:
+		return parquet.Int64Value(int64(*x))
+	case *int16:
+		return parquet.Int64Value(int64(*x))
+	case *int32:
+		return parquet.Int64Value(int64(*x))
+	case *int64:
+		return parquet.Int64Value(*x)
+	case *float64:
+		return parquet.DoubleValue(*x)
+	case *string:
+		return parquet.ByteArrayValue([]byte(*x))
+	case *time.Time:
+		return parquet.Int64Value(x.UnixMicro())
+	case *[]byte:
+		return parquet.ByteArrayValue(*x)
+	case *sql.NullBool:
+		if x.Valid {
+			return parquet.BooleanValue(x.Bool)
+		}
+	case *sql.NullByte:
+		if x.Valid {
+			return parquet.Int64Value(int64(x.Byte))
+		}
+	case *sql.NullInt16:
+		if x.Valid {
+			return parquet.Int64Value(int64(x.Int16))
+		}
+	case *sql.NullInt32:
+		if x.Valid {
+			return parquet.Int64Value(int64(x.Int32))
+		}
+	case *sql.NullInt64:
+		if x.Valid {
+			return parquet.Int64Value(x.Int64)
+		}
+	case *sql.NullFloat64:
+		if x.Valid {
+			return parquet.DoubleValue(x.Float64)
+		}
+	case *sql.NullString:
+		if x.Valid {
+			return parquet.ByteArrayValue([]byte(x.String))
+		}
+	case *sql.NullTime:
+		if x.Valid {
+			return parquet.Int64Value(x.Time.UnixMicro())
+		}
+	case *sql.RawBytes:
+		if *x != nil {
+			return parquet.ByteArrayValue(*x)
+		}
+	}
+	return parquet.NullValue()
+}
+
+func (m *ParquetWriter) WriteTuple(row Tuple) error {
+	if len(row) != len(m.row) {
+		return ErrTupleFields{Writer: m, Fields: m.fields, Tuple: row}
+	}
+	for i, x := range row {
+		v := parquetValue(x)
+		var definitionLevel int
+		if !v.IsNull() {
+			definitionLevel = 1
+		}
+		m.row[i] = v.Level(0, definitionLevel, i)
+	}
+	_, err := m.w.WriteRows([]parquet.Row{m.row})
+	return errors.EnsureStack(err)
+}
+
+// Flush writes the file's footer.  Since a Parquet file can't be extended
+// after its footer is written, no tuples can be written after Flush.
+func (m *ParquetWriter) Flush() error {
+	return errors.EnsureStack(m.w.Close())
+}
+
+// ParquetParser reads the rows of a Parquet file into tuples.  Only files with
+// a flat schema are supported.
+type ParquetParser struct {
+	r      *parquet.Reader
+	fields []parquet.Field
+	rows   []parquet.Row
+}
+
+// NewParquetParser returns a ParquetParser reading the Parquet file of the
+// provided size from r.
+func NewParquetParser(r io.ReaderAt, size int64) (*ParquetParser, error) {
+	f, err := parquet.OpenFile(r, size)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	fields := f.Schema().Fields()
+	for _, field := range fields {
+		if !field.Leaf() || field.Repeated() {
+			return nil, errors.Errorf("column %q: only flat schemas are supported", field.Name())
+		}
+		if field.Type().Kind() == parquet.Int96 {
+			return nil, errors.Errorf("column %q: unsupported type %v", field.Name(), field.Type())
+		}
+	}
+	return &ParquetParser{
+		r:      parquet.NewReader(f),
+		fields: fields,
+		rows:   make([]parquet.Row, 1),
+	}, nil
+}
+
+// FieldNames returns the names of the file's columns, in the order of the
+// tuples returned by NewTuple.
+func (p *ParquetParser) FieldNames() []string {
+	var names []string
+	for _, f := range p.fields {
+		names = append(names, f.Name())
+	}
+	return names
+}
+
+// NewTuple returns a tuple of the shape of the file's rows.  Binary columns
+// are read as base64 encoded strings.
+func (p *ParquetParser) NewTuple() Tuple {
+	row := make(Tuple, len(p.fields))
+	for i, f := range p.fields {
+		switch {
+		case f.Type().Kind() == parquet.Boolean:
+			row[i] = new(sql.NullBool)
+		case timestampUnit(f.Type()) != 0:
+			row[i] = new(sql.NullTime)
+		case f.Type().Kind() == parquet.Int32, f.Type().Kind() == parquet.Int64:
+			row[i] = new(sql.NullInt64)
+		case f.Type().Kind() == parquet.Float, f.Type().Kind() == parquet.Double:
+			row[i] = new(sql.NullFloat64)
+		default:
+			row[i] = new(sql.NullString)
+		}
+	}
+	return row
+}
+
+// timestampUnit returns the duration of a unit of a timestamp column, or 0 if
+// t isn't a timestamp.
+func timestampUnit(t parquet.Type) time.Duration {
+	lt := t.LogicalType()
+	if lt == nil || lt.Timestamp == nil || t.Kind() != parquet.Int64 {
+		return 0
+	}
+	switch {
+	case lt.Timestamp.Unit.Millis != nil:
+		return time.Millisecond
+	case lt.Timestamp.Unit.Micros != nil:
+		return time.Microsecond
+	case lt.Timestamp.Unit.Nanos != nil:
+		return time.Nanosecond
+	}
+	return 0
+}
+
+func (p *ParquetParser) Next(row Tuple) error {
+	if len(row) != len(p.fields) {
+		return ErrTupleFields{Fields: p.FieldNames(), Tuple: row}
+	}
+	n, err := p.r.ReadRows(p.rows)
+	if n == 0 {
+		if err == nil {
+			err = io.EOF
+		}
+		return errors.EnsureStack(err)
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		return errors.EnsureStack(err)
+	}
+	for _, v := range p.rows[0] {
+		i := v.Column()
+		if i < 0 || i >= len(row) {
+			return errors.Errorf("unexpected column %d", i)
+		}
+		if err := convert(row[i], p.value(p.fields[i].Type(), v)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// value returns v as a value that convert accepts.
+func (p *ParquetParser) value(t parquet.Type, v parquet.Value) interface{} {
+	if v.IsNull() {
+		return nil
+	}
+	switch v.Kind() {
+	case parquet.Boolean:
+		return v.Boolean()
+	case parquet.Int32:
+		return int64(v.Int32())
+	case parquet.Int64:
+		if unit := timestampUnit(t); unit != 0 {
+			return time.Unix(0, v.Int64()*int64(unit)).UTC()
+		}
+		return v.Int64()
+	case parquet.Float:
+		return float64(v.Float())
+	case parquet.Double:
+		return v.Double()
+	default:
+		if lt := t.LogicalType(); lt != nil && (lt.UTF8 != nil || lt.Json != nil || lt.Enum != nil) {
+			return string(v.ByteArray())
+		}
+		return base64.StdEncoding.EncodeToString(v.ByteArray())
+	}
+}
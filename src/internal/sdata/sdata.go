@@ -96,7 +96,9 @@ func Copy(w TupleWriter, r TupleReader, row Tuple) (n int, _ error) {
 	for {
 		err := r.Next(row)
 		if errors.Is(err, io.EOF) {
-			w.Flush()
+			if err := w.Flush(); err != nil {
+				return n, errors.EnsureStack(err)
+			}
 			break
 		} else if err != nil {
 			return n, errors.EnsureStack(err)
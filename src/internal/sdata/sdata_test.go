@@ -19,6 +19,15 @@ import (
 // TestFormatParse is a round trip from a Tuple through formatting and parsing
 // back to a Tuple again.
 func TestFormatParse(t *testing.T) {
+	newTuple := func() Tuple {
+		a := int64(0)
+		b := float64(0)
+		c := ""
+		d := sql.NullInt64{}
+		e := false
+		f := sql.NullString{}
+		return Tuple{&a, &b, &c, &d, &e, &f}
+	}
 	testCases := []struct {
 		Name string
 		NewW func(w io.Writer, fieldNames []string) TupleWriter
@@ -42,15 +51,22 @@ func TestFormatParse(t *testing.T) {
 				return NewJSONParser(r, fieldNames)
 			},
 		},
-	}
-	newTuple := func() Tuple {
-		a := int64(0)
-		b := float64(0)
-		c := ""
-		d := sql.NullInt64{}
-		e := false
-		f := sql.NullString{}
-		return Tuple{&a, &b, &c, &d, &e, &f}
+		{
+			Name: "Parquet",
+			NewW: func(w io.Writer, fieldNames []string) TupleWriter {
+				pw, err := NewParquetWriter(w, fieldNames, newTuple())
+				require.NoError(t, err)
+				return pw
+			},
+			NewR: func(r io.Reader, fieldNames []string) TupleReader {
+				data, err := io.ReadAll(r)
+				require.NoError(t, err)
+				pr, err := NewParquetParser(bytes.NewReader(data), int64(len(data)))
+				require.NoError(t, err)
+				require.Equal(t, fieldNames, pr.FieldNames())
+				return pr
+			},
+		},
 	}
 	fieldNames := []string{"a", "b", "c", "d", "e", "f"}
 	for _, tc := range testCases {
@@ -41,7 +41,7 @@ type entry struct {
 
 // TODO: Add config for number of entries.
 func (s *Storage) NewBatcher(ctx context.Context, name string, threshold int, opts ...BatcherOption) *Batcher {
-	client := NewClient(s.store, s.db, s.tracker, NewRenewer(ctx, s.tracker, name, defaultChunkTTL), s.pool)
+	client := s.newClient(NewRenewer(ctx, s.tracker, name, defaultChunkTTL))
 	b := &Batcher{
 		client:    client,
 		threshold: threshold,
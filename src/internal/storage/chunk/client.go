@@ -28,6 +28,7 @@ type Client interface {
 // a tracker and an kv.Store
 type trackedClient struct {
 	store        kv.Store
+	tiers        map[string]kv.Store
 	pool         *kv.Pool
 	db           *pachsql.DB
 	tracker      track.Tracker
@@ -143,21 +144,47 @@ func (c *trackedClient) afterUpload(ctx context.Context, chunkID ID, gen uint64)
 
 // Get writes data for a chunk with ID chunkID to w.
 func (c *trackedClient) Get(ctx context.Context, chunkID ID, cb kv.ValueCallback) error {
-	var gen uint64
-	err := c.db.Get(&gen, `
-	SELECT gen
-	FROM storage.chunk_objects
-	WHERE uploaded = TRUE AND tombstone = FALSE AND chunk_id = $1
-	LIMIT 1
-	`, chunkID)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			err = errors.Errorf("no objects for chunk %v", chunkID)
+	// The object may be moved to another tier between looking up its tier
+	// and reading it, in which case the lookup is retried once.
+	for retried := false; ; retried = true {
+		var ent Entry
+		err := c.db.GetContext(ctx, &ent, `
+		SELECT chunk_id, gen, tier
+		FROM storage.chunk_objects
+		WHERE uploaded = TRUE AND tombstone = FALSE AND chunk_id = $1
+		LIMIT 1
+		`, chunkID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				err = errors.Errorf("no objects for chunk %v", chunkID)
+			}
+			return errors.EnsureStack(err)
 		}
-		return err
+		store, err := c.tierStore(ent.Tier)
+		if err != nil {
+			return err
+		}
+		err = c.pool.GetF(ctx, store, chunkKey(chunkID, ent.Gen), cb)
+		if !retried && pacherr.IsNotExist(err) {
+			continue
+		}
+		return errors.EnsureStack(err)
 	}
-	key := chunkKey(chunkID, gen)
-	return errors.EnsureStack(c.pool.GetF(ctx, c.store, key, cb))
+}
+
+// tierStore returns the store that holds the objects of tier.
+func (c *trackedClient) tierStore(tier string) (kv.Store, error) {
+	return tierStore(c.store, c.tiers, tier)
+}
+
+func tierStore(store kv.Store, tiers map[string]kv.Store, tier string) (kv.Store, error) {
+	if tier == "" {
+		return store, nil
+	}
+	if store, ok := tiers[tier]; ok {
+		return store, nil
+	}
+	return nil, errors.Errorf("unknown storage tier %q", tier)
 }
 
 // Close closes the client, stopping the background renewal of created objects
@@ -176,7 +203,7 @@ func (c *trackedClient) CheckEntries(ctx context.Context, first []byte, limit in
 	}
 	var ents []Entry
 	if err := c.db.SelectContext(ctx, &ents,
-		`SELECT chunk_id, gen, uploaded, tombstone FROM storage.chunk_objects
+		`SELECT chunk_id, gen, uploaded, tombstone, tier FROM storage.chunk_objects
 		WHERE chunk_id >= $1 AND uploaded = true AND tombstone = false
 		ORDER BY chunk_id
 		LIMIT $2
@@ -184,8 +211,12 @@ func (c *trackedClient) CheckEntries(ctx context.Context, first []byte, limit in
 		return 0, nil, errors.EnsureStack(err)
 	}
 	for _, ent := range ents {
+		store, err := c.tierStore(ent.Tier)
+		if err != nil {
+			return n, nil, err
+		}
 		if readChunks {
-			if err := c.pool.GetF(ctx, c.store, chunkKey(ent.ChunkID, ent.Gen), func(data []byte) error {
+			if err := c.pool.GetF(ctx, store, chunkKey(ent.ChunkID, ent.Gen), func(data []byte) error {
 				return verifyData(ent.ChunkID, data)
 			}); err != nil {
 				if pacherr.IsNotExist(err) {
@@ -197,7 +228,7 @@ func (c *trackedClient) CheckEntries(ctx context.Context, first []byte, limit in
 				}
 			}
 		} else {
-			exists, err := c.store.Exists(ctx, chunkKey(ent.ChunkID, ent.Gen))
+			exists, err := store.Exists(ctx, chunkKey(ent.ChunkID, ent.Gen))
 			if err != nil {
 				return n, nil, errors.EnsureStack(err)
 			}
@@ -236,6 +267,12 @@ func chunkKey(chunkID ID, gen uint64) (ret []byte) {
 	return fmt.Appendf(ret, "%s.%016x", chunkID.HexString(), gen)
 }
 
+// IsKey returns true if key is the key of a chunk object.
+func IsKey(key []byte) bool {
+	chunkID, gen, err := parseKey(key)
+	return err == nil && len(chunkID) > 0 && bytes.Equal(key, chunkKey(chunkID, gen))
+}
+
 func parseKey(key []byte) (ID, uint64, error) {
 	parts := bytes.SplitN(key, []byte("."), 2)
 	if len(parts) < 2 {
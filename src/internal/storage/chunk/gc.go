@@ -46,7 +46,7 @@ func (gc *GarbageCollector) RunOnce(ctx context.Context) (retErr error) {
 	ctx, end := log.SpanContext(ctx, "RunOnce")
 	defer end(log.Errorp(&retErr))
 	rows, err := gc.s.db.QueryxContext(ctx, `
-	SELECT chunk_id, gen, uploaded FROM storage.chunk_objects
+	SELECT chunk_id, gen, uploaded, tier FROM storage.chunk_objects
 	WHERE tombstone = true
 	`)
 	if err != nil {
@@ -71,14 +71,18 @@ func (gc *GarbageCollector) RunOnce(ctx context.Context) (retErr error) {
 }
 
 func (gc *GarbageCollector) deleteOne(ctx context.Context, ent Entry) error {
-	if err := gc.deleteObject(ctx, ent.ChunkID, ent.Gen); err != nil {
+	if err := gc.deleteObject(ctx, ent.ChunkID, ent.Gen, ent.Tier); err != nil {
 		return err
 	}
 	return gc.deleteEntry(ctx, ent.ChunkID, ent.Gen)
 }
 
-func (gc *GarbageCollector) deleteObject(ctx context.Context, chunkID ID, gen uint64) error {
-	return errors.EnsureStack(gc.s.store.Delete(ctx, chunkKey(chunkID, gen)))
+func (gc *GarbageCollector) deleteObject(ctx context.Context, chunkID ID, gen uint64, tier string) error {
+	store, err := gc.s.tierStore(tier)
+	if err != nil {
+		return err
+	}
+	return errors.EnsureStack(store.Delete(ctx, chunkKey(chunkID, gen)))
 }
 
 func (gc *GarbageCollector) deleteEntry(ctx context.Context, chunkID ID, gen uint64) error {
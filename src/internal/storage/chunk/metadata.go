@@ -59,6 +59,9 @@ type Entry struct {
 	Gen       uint64 `db:"gen"`
 	Uploaded  bool   `db:"uploaded"`
 	Tombstone bool   `db:"tombstone"`
+	// Tier is the storage tier that holds the object.  The empty tier is the
+	// default store.
+	Tier string `db:"tier"`
 }
 
 // SetupPostgresStoreV0 sets up tables in db
@@ -87,6 +90,17 @@ func SetupPostgresStoreV0(ctx context.Context, tx *pachsql.Tx) error {
 	return errors.EnsureStack(err)
 }
 
+// SetupPostgresStoreV1 adds the storage tier of each chunk object.
+// DO NOT MODIFY THIS FUNCTION
+// IT HAS BEEN USED IN A RELEASED MIGRATION
+func SetupPostgresStoreV1(ctx context.Context, tx *pachsql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+	ALTER TABLE storage.chunk_objects
+		ADD COLUMN tier TEXT NOT NULL DEFAULT ''
+	`)
+	return errors.EnsureStack(err)
+}
+
 // KeyStore is a store for named secret keys
 type KeyStore interface {
 	Create(ctx context.Context, name string, data []byte) error
@@ -3,6 +3,7 @@ package chunk
 import (
 	lru "github.com/hashicorp/golang-lru/v2"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachhash"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
 )
 
 // StorageOption configures a storage.
@@ -33,6 +34,16 @@ func WithCompression(algo CompressionAlgo) StorageOption {
 	}
 }
 
+// WithTier adds a storage tier, whose chunk objects are stored in store.
+func WithTier(name string, store kv.Store) StorageOption {
+	return func(s *Storage) {
+		if s.tiers == nil {
+			s.tiers = make(map[string]kv.Store)
+		}
+		s.tiers[name] = store
+	}
+}
+
 type BatcherOption func(b *Batcher)
 
 func WithChunkCallback(cb ChunkFunc) BatcherOption {
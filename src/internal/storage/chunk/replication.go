@@ -0,0 +1,81 @@
+package chunk
+
+import (
+	"context"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+)
+
+// The methods in this file operate on chunks in their stored form (compressed and encrypted).
+// Chunk IDs are derived from the stored form, and the data encryption key for a chunk is carried
+// in its Ref, so the stored bytes for a chunk can be copied between storage instances without
+// changing the ID of the chunk or the refs that point to it.
+
+// GetStored calls cb with the stored form of the chunk with the given ID.
+func (s *Storage) GetStored(ctx context.Context, id ID, cb kv.ValueCallback) error {
+	client := s.newClient(nil)
+	return errors.EnsureStack(client.Get(ctx, id, cb))
+}
+
+// PointsTo returns the IDs of the chunks that the chunk with the given ID immediately points to.
+func (s *Storage) PointsTo(ctx context.Context, id ID) ([]ID, error) {
+	downstream, err := s.tracker.GetDownstream(ctx, id.TrackerID())
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	var ids []ID
+	for _, tid := range downstream {
+		chunkID, err := ParseTrackerID(tid)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, chunkID)
+	}
+	return ids, nil
+}
+
+// Missing returns the subset of ids which do not have an uploaded object in this storage.
+func (s *Storage) Missing(ctx context.Context, ids []ID) ([]ID, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	var raw [][]byte
+	for _, id := range ids {
+		raw = append(raw, id)
+	}
+	var present []ID
+	if err := s.db.SelectContext(ctx, &present, `
+		SELECT DISTINCT chunk_id
+		FROM storage.chunk_objects
+		WHERE uploaded = TRUE AND tombstone = FALSE AND chunk_id = ANY($1)
+	`, raw); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	presentSet := make(map[string]struct{}, len(present))
+	for _, id := range present {
+		presentSet[string(id)] = struct{}{}
+	}
+	var missing []ID
+	for _, id := range ids {
+		if _, ok := presentSet[string(id)]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	return missing, nil
+}
+
+// CreateStored creates a chunk from its stored form.
+// The chunks that the new chunk points to must already exist.
+// The chunk will be kept alive by the renewer.
+func (s *Storage) CreateStored(ctx context.Context, renewer *Renewer, pointsTo []ID, data []byte) (ID, error) {
+	client := s.newClient(renewer)
+	id, err := client.Create(ctx, Metadata{Size: len(data), PointsTo: pointsTo}, data)
+	return id, errors.EnsureStack(err)
+}
+
+// NewRenewer returns a renewer which keeps chunks created through it alive until it is closed.
+func (s *Storage) NewRenewer(ctx context.Context, name string, ttl time.Duration) *Renewer {
+	return NewRenewer(ctx, s.tracker, name, ttl)
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachhash"
@@ -32,6 +33,7 @@ type Storage struct {
 	db            *pachsql.DB
 	tracker       track.Tracker
 	store         kv.Store
+	tiers         map[string]kv.Store
 	memCache      *memoryCache
 	deduper       *miscutil.WorkDeduper[pachhash.Output]
 	pool          *kv.Pool
@@ -62,13 +64,13 @@ func NewStorage(store kv.Store, db *pachsql.DB, tracker track.Tracker, opts ...S
 
 // NewReader creates a new Reader.
 func (s *Storage) NewReader(ctx context.Context, dataRefs []*DataRef, opts ...ReaderOption) *Reader {
-	client := NewClient(s.store, s.db, s.tracker, nil, s.pool)
+	client := s.newClient(nil)
 	defaultOpts := []ReaderOption{WithPrefetchLimit(s.prefetchLimit)}
 	return newReader(ctx, s, client, dataRefs, append(defaultOpts, opts...)...)
 }
 
 func (s *Storage) NewDataReader(ctx context.Context, dataRef *DataRef) *DataReader {
-	client := NewClient(s.store, s.db, s.tracker, nil, s.pool)
+	client := s.newClient(nil)
 	return newDataReader(ctx, s, client, dataRef, 0)
 }
 
@@ -89,6 +91,31 @@ func (s *Storage) ListStore(ctx context.Context, cb func(id ID, gen uint64) erro
 	})
 }
 
+// ListSizes lists the size of each chunk which has an uploaded object.
+func (s *Storage) ListSizes(ctx context.Context, cb func(id ID, size int64) error) (retErr error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT chunk_id, max(size)
+		FROM storage.chunk_objects
+		WHERE uploaded = TRUE AND tombstone = FALSE
+		GROUP BY chunk_id
+	`)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer errors.Close(&retErr, rows, "close rows")
+	for rows.Next() {
+		var id ID
+		var size int64
+		if err := rows.Scan(&id, &size); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if err := cb(id, size); err != nil {
+			return err
+		}
+	}
+	return errors.EnsureStack(rows.Err())
+}
+
 // NewDeleter creates a deleter for use with a tracker.GC
 func (s *Storage) NewDeleter() track.Deleter {
 	return &deleter{}
@@ -98,7 +125,7 @@ func (s *Storage) NewDeleter() track.Deleter {
 // It will check objects for chunks with IDs in the range [first, last)
 // As a special case: if len(end) == 0 then it is ignored.
 func (s *Storage) Check(ctx context.Context, begin, end []byte, readChunks bool) (int, error) {
-	c := NewClient(s.store, s.db, s.tracker, nil, s.pool).(*trackedClient)
+	c := s.newClient(nil)
 	first := append([]byte{}, begin...)
 	var count int
 	for {
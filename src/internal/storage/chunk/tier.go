@@ -0,0 +1,120 @@
+package chunk
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+)
+
+// Chunk objects are created in the default store, and can be moved to the
+// other storage tiers that the storage is configured with.  The tier of each
+// object is recorded with its chunk entry, and reads are routed to it.
+
+func (s *Storage) newClient(renewer *Renewer) *trackedClient {
+	c := NewClient(s.store, s.db, s.tracker, renewer, s.pool).(*trackedClient)
+	c.tiers = s.tiers
+	return c
+}
+
+func (s *Storage) tierStore(tier string) (kv.Store, error) {
+	return tierStore(s.store, s.tiers, tier)
+}
+
+// Tiers returns the names of the storage tiers other than the default one.
+func (s *Storage) Tiers() []string {
+	var tiers []string
+	for name := range s.tiers {
+		tiers = append(tiers, name)
+	}
+	sort.Strings(tiers)
+	return tiers
+}
+
+// HasTier returns true if tier is the default tier or one of the storage's
+// tiers.
+func (s *Storage) HasTier(tier string) bool {
+	_, err := s.tierStore(tier)
+	return err == nil
+}
+
+// ListTiers lists the tier of each chunk which has an uploaded object.
+func (s *Storage) ListTiers(ctx context.Context, cb func(id ID, tier string) error) (retErr error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT chunk_id, min(tier)
+		FROM storage.chunk_objects
+		WHERE uploaded = TRUE AND tombstone = FALSE
+		GROUP BY chunk_id
+	`)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer errors.Close(&retErr, rows, "close rows")
+	for rows.Next() {
+		var id ID
+		var tier string
+		if err := rows.Scan(&id, &tier); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if err := cb(id, tier); err != nil {
+			return err
+		}
+	}
+	return errors.EnsureStack(rows.Err())
+}
+
+// MoveChunk moves the object of a chunk to tier.  The object is copied to
+// the new tier before the chunk entry is updated, and deleted from the old
+// tier after, so readers always find it.
+func (s *Storage) MoveChunk(ctx context.Context, id ID, tier string) error {
+	dst, err := s.tierStore(tier)
+	if err != nil {
+		return err
+	}
+	var ent Entry
+	if err := s.db.GetContext(ctx, &ent, `
+		SELECT chunk_id, gen, tier
+		FROM storage.chunk_objects
+		WHERE uploaded = TRUE AND tombstone = FALSE AND chunk_id = $1
+		LIMIT 1
+	`, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// The chunk has been deleted.
+			return nil
+		}
+		return errors.EnsureStack(err)
+	}
+	if ent.Tier == tier {
+		return nil
+	}
+	src, err := s.tierStore(ent.Tier)
+	if err != nil {
+		return err
+	}
+	key := chunkKey(id, ent.Gen)
+	if err := s.pool.GetF(ctx, src, key, func(data []byte) error {
+		return errors.EnsureStack(dst.Put(ctx, key, data))
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	res, err := s.db.ExecContext(ctx, `
+		UPDATE storage.chunk_objects
+		SET tier = $3
+		WHERE chunk_id = $1 AND gen = $2 AND tier = $4 AND tombstone = FALSE
+	`, id, ent.Gen, tier, ent.Tier)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	if affected == 0 {
+		// The chunk was deleted or moved while it was being copied, so the
+		// copy isn't referenced by its entry.
+		return errors.EnsureStack(dst.Delete(ctx, key))
+	}
+	return errors.EnsureStack(src.Delete(ctx, key))
+}
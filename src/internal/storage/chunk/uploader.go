@@ -35,7 +35,7 @@ type Uploader struct {
 }
 
 func (s *Storage) NewUploader(ctx context.Context, name string, noUpload bool, cb UploadFunc) *Uploader {
-	client := NewClient(s.store, s.db, s.tracker, NewRenewer(ctx, s.tracker, name, defaultChunkTTL), s.pool)
+	client := s.newClient(NewRenewer(ctx, s.tracker, name, defaultChunkTTL))
 	return &Uploader{
 		ctx:       ctx,
 		storage:   s,
@@ -8,6 +8,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/peercache"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 )
 
@@ -42,17 +43,27 @@ func makeFilesetOptions(conf *pachconfig.StorageConfiguration) (opts []fileset.S
 
 // wrapStore adds layers around the store based on the storage configuration.
 // This includes:
+// - The chunk prefix
 // - DiskCache
+// - PeerCache, if peers is not nil
 // - Upload/Download concurrency limits
-// this is done below the chunk layer.
-func wrapStore(conf *pachconfig.StorageConfiguration, store kv.Store) kv.Store {
+// this is done below the chunk layer.  The store is registered with peers once
+// it's prefixed, so that peers can only read chunk objects from it.
+func wrapStore(conf *pachconfig.StorageConfiguration, peers *peercache.Cache, tier string, store kv.Store) kv.Store {
+	store = kv.NewPrefixed(store, []byte(chunkPrefix))
 	if conf.StorageUploadConcurrencyLimit > 0 || conf.StorageDownloadConcurrencyLimit > 0 {
 		store = kv.NewSemaphored(store, conf.StorageDownloadConcurrencyLimit, conf.StorageUploadConcurrencyLimit)
 	}
+	if peers != nil {
+		store = peers.Wrap(tier, store)
+	}
 	if conf.StorageDiskCacheSize > 0 {
 		p := filepath.Join(os.TempDir(), "pss-cache", uuid.NewWithoutDashes())
 		diskCache := kv.NewFSStore(p, maxKeySize, chunk.DefaultMaxChunkSize)
-		return kv.NewLRUCache(store, diskCache, conf.StorageDiskCacheSize)
+		store = kv.NewLRUCache(store, diskCache, conf.StorageDiskCacheSize)
+	}
+	if peers != nil {
+		peers.Register(tier, store)
 	}
 	return store
 }
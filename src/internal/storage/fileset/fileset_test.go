@@ -265,6 +265,28 @@ func TestStableHash(t *testing.T) {
 	}
 }
 
+// ComputeHash must agree with the stable hashes that the storage layer assigns.
+func TestComputeHash(t *testing.T) {
+	seed := int64(1648577872380609229)
+	for _, tc := range []struct {
+		size     int
+		expected string
+	}{
+		{size: 100 * units.KB, expected: "27e12145099615b6bf0364a4472452dfe0e8105e6d58d7fbc5d0c038c7a50736"},
+		{size: 100 * units.MB, expected: "5672e6f3e1841f3f1e284c2d4b7c12dc213ffc88878c9d3e2302be8acd0198ef"},
+	} {
+		random := rand.New(rand.NewSource(seed))
+		hash, err := ComputeHash(bytes.NewReader(oldRandomBytes(random, tc.size)))
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, pachhash.EncodeHash(hash))
+	}
+	hash, err := ComputeHash(bytes.NewReader(nil))
+	require.NoError(t, err)
+	expected, err := computeFileHash(nil)
+	require.NoError(t, err)
+	require.Equal(t, expected, hash)
+}
+
 func TestStableHashFuzz(t *testing.T) {
 	ctx := pctx.TestContext(t)
 	seed := time.Now().UTC().UnixNano()
@@ -232,6 +232,17 @@ func (s *Storage) flattenPrimitives(ctx context.Context, ids []ID) ([]*Primitive
 	return s.getPrimitives(ctx, ids)
 }
 
+// FlattenPrimitives is like FlattenAll, but returns the primitives rather than their IDs.
+func (s *Storage) FlattenPrimitives(ctx context.Context, ids []ID) ([]*Primitive, error) {
+	return s.flattenPrimitives(ctx, ids)
+}
+
+// CreatePrimitive creates a file set from a primitive that references existing chunks.
+// It is used to recreate a file set whose chunks have been copied from another storage instance.
+func (s *Storage) CreatePrimitive(ctx context.Context, prim *Primitive, ttl time.Duration) (*ID, error) {
+	return s.newPrimitive(ctx, prim, ttl)
+}
+
 func (s *Storage) getPrimitives(ctx context.Context, ids []ID) ([]*Primitive, error) {
 	var prims []*Primitive
 	for _, id := range ids {
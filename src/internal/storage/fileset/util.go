@@ -149,6 +149,27 @@ func (i *Iterator) Next() (File, error) {
 	}
 }
 
+// ComputeHash computes the hash that a file with the content read from r would
+// have if it was written to a file set, without uploading the content.  This
+// mirrors the chunking performed by the writer, so it can be compared against
+// the hash in a file's info to detect whether a local copy differs from it.
+func ComputeHash(r io.Reader) ([]byte, error) {
+	var hashes [][]byte
+	var size int
+	if err := chunk.ComputeChunks(r, func(chunkBytes []byte) error {
+		hashes = append(hashes, chunk.Hash(chunkBytes))
+		size += len(chunkBytes)
+		return nil
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	// Empty files are batched without any data references.
+	if size == 0 {
+		hashes = nil
+	}
+	return computeFileHash(hashes)
+}
+
 func computeFileHash(hashes [][]byte) ([]byte, error) {
 	h := pachhash.New()
 	for _, hash := range hashes {
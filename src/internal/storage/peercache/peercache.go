@@ -0,0 +1,361 @@
+// Package peercache implements a cluster chunk cache.  The pachd processes of a
+// cluster, including the sidecars of pipeline workers, form a consistent hash
+// ring, and each chunk object is fetched from the member that owns its key,
+// which reads it through its own disk cache, before falling back to object
+// storage.  This way a chunk read by many workers is downloaded from object
+// storage about once, rather than once per worker.
+//
+// Peers serve each other chunk objects over plain HTTP.  Chunk objects are
+// encrypted and content addressed, but requests are signed with a secret shared
+// by the members, so that only they can read objects through the cache, and
+// only objects with chunk keys are served.  Signatures expire shortly after the
+// request is made, so a captured request can't be replayed later.
+package peercache
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	etcd "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	"github.com/pachyderm/pachyderm/v2/src/internal/consistenthashing"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/stream"
+)
+
+const (
+	chunkPath    = "/chunk"
+	fetchTimeout = time.Minute
+	// signatureHeader is the header that requests carry their signature in.
+	signatureHeader = "X-Pachyderm-Peer-Signature"
+	// expiresHeader is the header that requests carry the unix time at which
+	// their signature expires in.
+	expiresHeader = "X-Pachyderm-Peer-Expires"
+	// signatureTTL is how long a request's signature is valid for.  It allows
+	// for some clock skew between members.
+	signatureTTL = time.Minute
+)
+
+var (
+	getMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "storage_peer_cache",
+		Name:      "get_count_total",
+		Help: "Number of chunk object reads, by where they were served from: " +
+			"local for keys owned by this process, peer for keys read from their owner, " +
+			"and fallback for keys read from object storage because their owner couldn't serve them",
+	}, []string{"result"})
+
+	serveMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "storage_peer_cache",
+		Name:      "serve_count_total",
+		Help:      "Number of chunk object reads served to peers, by result",
+	}, []string{"result"})
+
+	peerBytesMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "storage_peer_cache",
+		Name:      "peer_read_bytes_total",
+		Help:      "Number of bytes of chunk objects read from peers",
+	})
+)
+
+// Ring maps keys to the members of the cache that own them.
+type Ring interface {
+	Owner(key string) string
+}
+
+// Cache is a process's member of the cluster chunk cache.
+type Cache struct {
+	// self is the address that peers reach this process's cache at.
+	self string
+	// secret is shared by the members, and signs their requests.
+	secret []byte
+	client *http.Client
+	pool   *kv.Pool
+
+	mu     sync.RWMutex
+	ring   Ring
+	stores map[string]kv.Store
+}
+
+// New returns a cache member, which peers reach at self, a host:port address,
+// and which signs and verifies requests with secret, shared by all members.
+// maxValueSize is the size of the largest object it serves.
+func New(self, secret string, maxValueSize int) *Cache {
+	return &Cache{
+		self:   self,
+		secret: []byte(secret),
+		client: &http.Client{Timeout: fetchTimeout},
+		pool:   kv.NewPool(maxValueSize),
+		stores: make(map[string]kv.Store),
+	}
+}
+
+// Wrap returns a store which reads the keys owned by other members of the
+// cache from them, and the keys it owns, or can't read from their owner, from
+// store.  tier is the name of the storage tier that store holds.
+func (c *Cache) Wrap(tier string, store kv.Store) kv.Store {
+	return &peerStore{cache: c, tier: tier, inner: store}
+}
+
+// Register sets the store that serves peers the keys of tier.  It should be
+// the store returned by Wrap, behind the process's local cache, so that the
+// keys this process owns are cached locally, and it must only hold chunk
+// objects, since any of its keys that is a chunk key may be read.
+func (c *Cache) Register(tier string, store kv.Store) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stores[tier] = store
+}
+
+func (c *Cache) setRing(ring Ring) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ring = ring
+}
+
+// owner returns the address of the member that owns key, or the empty string
+// if the ring hasn't been joined yet.
+func (c *Cache) owner(key []byte) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.ring == nil {
+		return ""
+	}
+	return c.ring.Owner(string(key))
+}
+
+// Run serves peers and keeps this process in the ring, which is kept in etcd
+// under prefix, until ctx is cancelled.
+func (c *Cache) Run(ctx context.Context, client *etcd.Client, prefix string) error {
+	_, port, err := net.SplitHostPort(c.self)
+	if err != nil {
+		return errors.Wrapf(err, "parse peer cache address %q", c.self)
+	}
+	srv := &http.Server{
+		Addr:        ":" + port,
+		Handler:     c,
+		BaseContext: func(net.Listener) context.Context { return ctx },
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- errors.EnsureStack(srv.ListenAndServe())
+	}()
+	go func() {
+		// A restarted process may find its address still in the ring until
+		// its previous lease expires.
+		err := backoff.RetryUntilCancel(ctx, func() error {
+			return consistenthashing.WithRingID(ctx, client, prefix, c.self, func(ctx context.Context, ring *consistenthashing.Ring) error {
+				c.setRing(ring)
+				defer c.setRing(nil)
+				<-ctx.Done()
+				return nil
+			})
+		}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+			log.Info(ctx, "error joining peer cache ring; retrying", zap.Error(err), zap.Duration("retryAfter", d))
+			return nil
+		})
+		if err != nil {
+			errCh <- err
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		return errors.EnsureStack(srv.Shutdown(ctx))
+	case err := <-errCh:
+		return err
+	}
+}
+
+// ServeHTTP serves a chunk object to a peer.
+func (c *Cache) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != chunkPath || r.Method != http.MethodGet {
+		http.NotFound(w, r)
+		return
+	}
+	tier := r.URL.Query().Get("tier")
+	key, err := hex.DecodeString(r.URL.Query().Get("key"))
+	if err != nil || len(key) == 0 {
+		http.Error(w, "invalid key", http.StatusBadRequest)
+		return
+	}
+	signature, err := hex.DecodeString(r.Header.Get(signatureHeader))
+	if err != nil {
+		serveMetric.WithLabelValues("unauthorized").Inc()
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+	expires, err := strconv.ParseInt(r.Header.Get(expiresHeader), 10, 64)
+	if err != nil || !hmac.Equal(signature, c.sign(tier, key, expires)) {
+		serveMetric.WithLabelValues("unauthorized").Inc()
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+	if time.Now().Unix() > expires {
+		serveMetric.WithLabelValues("unauthorized").Inc()
+		http.Error(w, "signature expired", http.StatusUnauthorized)
+		return
+	}
+	if !chunk.IsKey(key) {
+		serveMetric.WithLabelValues("not_found").Inc()
+		http.NotFound(w, r)
+		return
+	}
+	c.mu.RLock()
+	store, ok := c.stores[tier]
+	c.mu.RUnlock()
+	if !ok {
+		serveMetric.WithLabelValues("not_found").Inc()
+		http.Error(w, fmt.Sprintf("unknown tier %q", tier), http.StatusNotFound)
+		return
+	}
+	ctx := withFromPeer(r.Context())
+	if err := c.pool.GetF(ctx, store, key, func(data []byte) error {
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		_, err := w.Write(data)
+		return errors.EnsureStack(err)
+	}); err != nil {
+		if pacherr.IsNotExist(err) {
+			serveMetric.WithLabelValues("not_found").Inc()
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		serveMetric.WithLabelValues("error").Inc()
+		log.Info(ctx, "error serving chunk object to peer", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	serveMetric.WithLabelValues("ok").Inc()
+}
+
+// fetch reads key of tier from the member at addr into buf.
+func (c *Cache) fetch(ctx context.Context, addr, tier string, key, buf []byte) (int, error) {
+	u := url.URL{
+		Scheme:   "http",
+		Host:     addr,
+		Path:     chunkPath,
+		RawQuery: url.Values{"tier": {tier}, "key": {hex.EncodeToString(key)}}.Encode(),
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	expires := time.Now().Add(signatureTTL).Unix()
+	req.Header.Set(signatureHeader, hex.EncodeToString(c.sign(tier, key, expires)))
+	req.Header.Set(expiresHeader, strconv.FormatInt(expires, 10))
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return 0, pacherr.NewNotExist("peer-cache", string(key))
+	case resp.StatusCode != http.StatusOK:
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return 0, errors.Errorf("peer %s: %s: %s", addr, resp.Status, msg)
+	case resp.ContentLength < 0:
+		return 0, errors.Errorf("peer %s did not send a content length", addr)
+	case resp.ContentLength > int64(len(buf)):
+		return 0, io.ErrShortBuffer
+	}
+	n, err := io.ReadFull(resp.Body, buf[:resp.ContentLength])
+	if err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	peerBytesMetric.Add(float64(n))
+	return n, nil
+}
+
+// sign returns the signature of a request for key of tier, which expires at
+// the unix time expires.
+func (c *Cache) sign(tier string, key []byte, expires int64) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write(binary.BigEndian.AppendUint64(nil, uint64(expires))) //nolint:errcheck
+	mac.Write([]byte(tier))                                        //nolint:errcheck
+	mac.Write([]byte{0})                                           //nolint:errcheck
+	mac.Write(key)                                                 //nolint:errcheck
+	return mac.Sum(nil)
+}
+
+type fromPeerKey struct{}
+
+// withFromPeer marks reads made to serve a peer, which must not be forwarded
+// to another peer, in case the members disagree on the owner of the key.
+func withFromPeer(ctx context.Context) context.Context {
+	return context.WithValue(ctx, fromPeerKey{}, true)
+}
+
+func isFromPeer(ctx context.Context) bool {
+	v, _ := ctx.Value(fromPeerKey{}).(bool)
+	return v
+}
+
+var _ kv.Store = &peerStore{}
+
+type peerStore struct {
+	cache *Cache
+	tier  string
+	inner kv.Store
+}
+
+func (s *peerStore) Get(ctx context.Context, key, buf []byte) (int, error) {
+	if isFromPeer(ctx) {
+		return s.inner.Get(ctx, key, buf)
+	}
+	owner := s.cache.owner(key)
+	if owner == "" || owner == s.cache.self {
+		getMetric.WithLabelValues("local").Inc()
+		return s.inner.Get(ctx, key, buf)
+	}
+	n, err := s.cache.fetch(ctx, owner, s.tier, key, buf)
+	if err == nil {
+		getMetric.WithLabelValues("peer").Inc()
+		return n, nil
+	}
+	if errors.Is(err, io.ErrShortBuffer) {
+		return 0, err
+	}
+	log.Debug(ctx, "could not read chunk object from peer; reading it from object storage", zap.String("peer", owner), zap.Error(err))
+	getMetric.WithLabelValues("fallback").Inc()
+	return s.inner.Get(ctx, key, buf)
+}
+
+func (s *peerStore) Put(ctx context.Context, key, value []byte) error {
+	return s.inner.Put(ctx, key, value)
+}
+
+func (s *peerStore) Delete(ctx context.Context, key []byte) error {
+	return s.inner.Delete(ctx, key)
+}
+
+func (s *peerStore) Exists(ctx context.Context, key []byte) (bool, error) {
+	return s.inner.Exists(ctx, key)
+}
+
+func (s *peerStore) NewKeyIterator(span kv.Span) stream.Iterator[[]byte] {
+	return s.inner.NewKeyIterator(span)
+}
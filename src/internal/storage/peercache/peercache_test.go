@@ -0,0 +1,128 @@
+package peercache
+
+import (
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+)
+
+// staticRing assigns every key to owner.
+type staticRing string
+
+func (r staticRing) Owner(string) string { return string(r) }
+
+// newMember returns a cache member serving the chunk/ prefix of store over
+// HTTP, and its store.
+func newMember(t *testing.T, store kv.Store) (*Cache, kv.Store) {
+	var c *Cache
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	c = New(srv.Listener.Addr().String(), "secret", 1024)
+	s := c.Wrap("", kv.NewPrefixed(store, []byte("chunk/")))
+	c.Register("", s)
+	return c, s
+}
+
+// key returns the chunk key of a chunk named name.
+func key(name string) string {
+	return hex.EncodeToString([]byte(name)) + ".0000000000000000"
+}
+
+func get(t *testing.T, s kv.Store, key string) (string, error) {
+	buf := make([]byte, 1024)
+	n, err := s.Get(pctx.TestContext(t), []byte(key), buf)
+	return string(buf[:n]), err
+}
+
+func TestPeerStore(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	aStore, bStore := kv.NewMemStore(), kv.NewMemStore()
+	a, aPeers := newMember(t, aStore)
+	b, bPeers := newMember(t, bStore)
+	require.NoError(t, aStore.Put(ctx, []byte("chunk/"+key("a")), []byte("from a")))
+	require.NoError(t, bStore.Put(ctx, []byte("chunk/"+key("b")), []byte("from b")))
+
+	// Before joining the ring, reads are local.
+	v, err := get(t, aPeers, key("a"))
+	require.NoError(t, err)
+	require.Equal(t, "from a", v)
+
+	// Keys owned by a peer are read from it.
+	a.setRing(staticRing(b.self))
+	v, err = get(t, aPeers, key("b"))
+	require.NoError(t, err)
+	require.Equal(t, "from b", v)
+
+	// Keys the owner doesn't have fall back to the local store.
+	v, err = get(t, aPeers, key("a"))
+	require.NoError(t, err)
+	require.Equal(t, "from a", v)
+	_, err = get(t, aPeers, key("c"))
+	require.True(t, pacherr.IsNotExist(err))
+
+	// Members that disagree on the owner don't forward reads between them.
+	b.setRing(staticRing(a.self))
+	v, err = get(t, aPeers, key("b"))
+	require.NoError(t, err)
+	require.Equal(t, "from b", v)
+	v, err = get(t, bPeers, key("a"))
+	require.NoError(t, err)
+	require.Equal(t, "from a", v)
+
+	// An unreachable owner falls back to the local store.
+	a.setRing(staticRing("127.0.0.1:1"))
+	v, err = get(t, aPeers, key("a"))
+	require.NoError(t, err)
+	require.Equal(t, "from a", v)
+}
+
+func TestServeHTTP(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	store := kv.NewMemStore()
+	c, _ := newMember(t, store)
+	require.NoError(t, store.Put(ctx, []byte("chunk/"+key("a")), []byte("chunk")))
+	require.NoError(t, store.Put(ctx, []byte("config/"+key("a")), []byte("not a chunk")))
+	require.NoError(t, store.Put(ctx, []byte("chunk/config"), []byte("not a chunk")))
+	expires := time.Now().Add(signatureTTL).Unix()
+	request := func(k string, expires int64, signature string) int {
+		r := httptest.NewRequest(http.MethodGet, chunkPath+"?"+url.Values{"key": {hex.EncodeToString([]byte(k))}}.Encode(), nil)
+		r.Header.Set(signatureHeader, signature)
+		r.Header.Set(expiresHeader, strconv.FormatInt(expires, 10))
+		w := httptest.NewRecorder()
+		c.ServeHTTP(w, r)
+		return w.Code
+	}
+	serve := func(secret, k string, expires int64) int {
+		signer := New(c.self, secret, 1024)
+		return request(k, expires, hex.EncodeToString(signer.sign("", []byte(k), expires)))
+	}
+	require.Equal(t, http.StatusOK, serve("secret", key("a"), expires))
+
+	// Requests must be signed with the members' secret.
+	require.Equal(t, http.StatusUnauthorized, serve("wrong", key("a"), expires))
+	require.Equal(t, http.StatusUnauthorized, request(key("a"), expires, ""))
+	require.Equal(t, http.StatusUnauthorized, request(key("a"), expires, "not hex"))
+	// A signature only covers the key and expiry that it was made for.
+	signature := hex.EncodeToString(c.sign("", []byte(key("b")), expires))
+	require.Equal(t, http.StatusUnauthorized, request(key("a"), expires, signature))
+	signature = hex.EncodeToString(c.sign("", []byte(key("a")), expires))
+	require.Equal(t, http.StatusUnauthorized, request(key("a"), expires+3600, signature))
+	// Expired signatures are rejected.
+	require.Equal(t, http.StatusUnauthorized, serve("secret", key("a"), time.Now().Add(-time.Second).Unix()))
+
+	// Only chunk keys under the chunk prefix are served.
+	require.Equal(t, http.StatusNotFound, serve("secret", "config", expires))
+	require.Equal(t, http.StatusNotFound, serve("secret", "../config/"+key("a"), expires))
+	require.Equal(t, http.StatusNotFound, serve("secret", key("b"), expires))
+}
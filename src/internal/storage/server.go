@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/rand"
 	"database/sql"
+	"encoding/json"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
@@ -13,6 +14,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/peercache"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
 	"go.uber.org/zap"
 	"gocloud.dev/blob"
@@ -28,6 +30,10 @@ type Env struct {
 	// Bucket is an object storage bucket from the Go CDK packages.
 	// If set, it takes priority over ObjectStore
 	Bucket *blob.Bucket
+
+	// PeerCache, if set, is the process's member of the cluster chunk cache,
+	// which chunk objects are read through.
+	PeerCache *peercache.Cache
 }
 
 // Server contains the storage layer servers.
@@ -56,10 +62,14 @@ func New(env Env, config pachconfig.StorageConfiguration) (*Server, error) {
 	} else {
 		store = kv.NewFromObjectClient(env.ObjectStore, maxKeySize, chunk.DefaultMaxChunkSize)
 	}
-	store = wrapStore(&config, store)
-	store = kv.NewPrefixed(store, []byte(chunkPrefix))
+	store = wrapStore(&config, env.PeerCache, "", store)
 	chunkStorageOpts := makeChunkOptions(&config)
 	chunkStorageOpts = append(chunkStorageOpts, chunk.WithSecret(secret))
+	tierOpts, err := makeTierOptions(context.TODO(), &config, env.PeerCache)
+	if err != nil {
+		return nil, err
+	}
+	chunkStorageOpts = append(chunkStorageOpts, tierOpts...)
 	chunkStorage := chunk.NewStorage(store, env.DB, tracker, chunkStorageOpts...)
 
 	// fileset
@@ -72,6 +82,35 @@ func New(env Env, config pachconfig.StorageConfiguration) (*Server, error) {
 	}, nil
 }
 
+// makeTierOptions creates a store for each of the storage tiers in config.
+func makeTierOptions(ctx context.Context, config *pachconfig.StorageConfiguration, peers *peercache.Cache) ([]chunk.StorageOption, error) {
+	if config.StorageTiers == "" {
+		return nil, nil
+	}
+	var tiers map[string]string
+	if err := json.Unmarshal([]byte(config.StorageTiers), &tiers); err != nil {
+		return nil, errors.Wrap(err, "parse storage tiers")
+	}
+	var opts []chunk.StorageOption
+	for name, urlStr := range tiers {
+		if name == "" {
+			return nil, errors.New("storage tiers must have a name")
+		}
+		url, err := obj.ParseURL(urlStr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse url of storage tier %q", name)
+		}
+		objC, err := obj.NewClientFromURLAndSecret(ctx, url)
+		if err != nil {
+			return nil, errors.Wrapf(err, "create client for storage tier %q", name)
+		}
+		store := kv.NewFromObjectClient(objC, maxKeySize, chunk.DefaultMaxChunkSize)
+		store = wrapStore(config, peers, name, store)
+		opts = append(opts, chunk.WithTier(name, store))
+	}
+	return opts, nil
+}
+
 func getOrCreateKey(ctx context.Context, keyStore chunk.KeyStore, name string) ([]byte, error) {
 	secret, err := keyStore.Get(ctx, name)
 	if !errors.Is(err, sql.ErrNoRows) {
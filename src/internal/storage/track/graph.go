@@ -0,0 +1,137 @@
+package track
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+)
+
+// Graph is an in-memory snapshot of the tracked objects and the references
+// between them.  Objects are identified by their integer ids.
+type Graph struct {
+	// IDs maps the integer id of each object to its string id.
+	IDs map[int64]string
+	// Downstream maps the integer id of each object to the integer ids of the
+	// objects that it points to.
+	Downstream map[int64][]int64
+}
+
+// LoadGraph loads a snapshot of all of the objects and references in the
+// postgres tracker.  Both tables are read in one repeatable read transaction,
+// so that every reference in the graph is between objects in the graph.
+func LoadGraph(ctx context.Context, db *pachsql.DB) (*Graph, error) {
+	var g *Graph
+	if err := dbutil.WithTx(ctx, db, func(ctx context.Context, tx *pachsql.Tx) error {
+		g = &Graph{
+			IDs:        make(map[int64]string),
+			Downstream: make(map[int64][]int64),
+		}
+		if err := forEachRow(ctx, tx, `SELECT int_id, str_id FROM storage.tracker_objects`, func(rows *sql.Rows) error {
+			var intID int64
+			var strID string
+			if err := rows.Scan(&intID, &strID); err != nil {
+				return errors.EnsureStack(err)
+			}
+			g.IDs[intID] = strID
+			return nil
+		}); err != nil {
+			return err
+		}
+		return forEachRow(ctx, tx, `SELECT from_id, to_id FROM storage.tracker_refs`, func(rows *sql.Rows) error {
+			var from, to int64
+			if err := rows.Scan(&from, &to); err != nil {
+				return errors.EnsureStack(err)
+			}
+			g.Downstream[from] = append(g.Downstream[from], to)
+			return nil
+		})
+	}, dbutil.WithIsolationLevel(sql.LevelRepeatableRead), dbutil.WithReadOnly()); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// Walk calls cb with each object reachable from the given roots, including
+// the roots themselves.  Each object is visited at most once.
+func (g *Graph) Walk(roots []int64, cb func(id int64) error) error {
+	visited := make(map[int64]struct{})
+	stack := append([]int64{}, roots...)
+	for len(stack) > 0 {
+		id := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if _, ok := visited[id]; ok {
+			continue
+		}
+		visited[id] = struct{}{}
+		if err := cb(id); err != nil {
+			return err
+		}
+		stack = append(stack, g.Downstream[id]...)
+	}
+	return nil
+}
+
+// Owners labels every object reachable from the roots of the given owners
+// with the key of its owner, or with the empty string if it is reachable from
+// the roots of more than one owner.  Objects that are not reachable are not
+// labeled.  Each object's label changes at most twice, so the graph is
+// traversed once no matter how many owners there are.
+func (g *Graph) Owners(roots map[string][]int64) map[int64]string {
+	const multiple = -1
+	var keys []string
+	labels := make(map[int64]int)
+	type item struct {
+		id    int64
+		label int
+	}
+	var stack []item
+	for key, ids := range roots {
+		for _, id := range ids {
+			stack = append(stack, item{id: id, label: len(keys)})
+		}
+		keys = append(keys, key)
+	}
+	for len(stack) > 0 {
+		it := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		label, ok := labels[it.id]
+		switch {
+		case !ok:
+			label = it.label
+		case label == it.label || label == multiple:
+			continue
+		default:
+			label = multiple
+		}
+		labels[it.id] = label
+		for _, id := range g.Downstream[it.id] {
+			stack = append(stack, item{id: id, label: label})
+		}
+	}
+	owners := make(map[int64]string, len(labels))
+	for id, label := range labels {
+		if label != multiple {
+			owners[id] = keys[label]
+		} else {
+			owners[id] = ""
+		}
+	}
+	return owners
+}
+
+func forEachRow(ctx context.Context, tx *pachsql.Tx, query string, cb func(*sql.Rows) error) (retErr error) {
+	rows, err := tx.QueryContext(ctx, query)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer errors.Close(&retErr, rows, "close rows")
+	for rows.Next() {
+		if err := cb(rows); err != nil {
+			return err
+		}
+	}
+	return errors.EnsureStack(rows.Err())
+}
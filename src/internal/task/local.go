@@ -0,0 +1,40 @@
+package task
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+type localDoer struct {
+	process ProcessFunc
+}
+
+// NewLocalDoer creates a Doer that processes tasks in the calling process,
+// one at a time, with the provided process function.  It is intended for
+// running work that is normally distributed to workers (such as datum
+// creation) without a cluster.
+func NewLocalDoer(process ProcessFunc) Doer {
+	return &localDoer{process: process}
+}
+
+func (ld *localDoer) Do(ctx context.Context, inputChan chan *anypb.Any, cb CollectFunc) error {
+	var index int64
+	for {
+		select {
+		case input, ok := <-inputChan:
+			if !ok {
+				return nil
+			}
+			output, err := ld.process(ctx, input)
+			if err := cb(index, output, err); err != nil {
+				return err
+			}
+			index++
+		case <-ctx.Done():
+			return errors.EnsureStack(context.Cause(ctx))
+		}
+	}
+}
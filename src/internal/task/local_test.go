@@ -0,0 +1,47 @@
+package task
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestLocalDoer(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	doer := NewLocalDoer(func(_ context.Context, input *anypb.Any) (*anypb.Any, error) {
+		v := &wrapperspb.Int64Value{}
+		if err := input.UnmarshalTo(v); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		if v.Value < 0 {
+			return nil, errors.New("negative")
+		}
+		return anypb.New(wrapperspb.Int64(v.Value * 2))
+	})
+	var inputs []*anypb.Any
+	for _, v := range []int64{1, 2, -1, 3} {
+		input, err := anypb.New(wrapperspb.Int64(v))
+		require.NoError(t, err)
+		inputs = append(inputs, input)
+	}
+	var outputs []int64
+	var failed []int64
+	require.NoError(t, DoBatch(ctx, doer, inputs, func(i int64, output *anypb.Any, err error) error {
+		if err != nil {
+			failed = append(failed, i)
+			return nil
+		}
+		v := &wrapperspb.Int64Value{}
+		require.NoError(t, output.UnmarshalTo(v))
+		outputs = append(outputs, v.Value)
+		return nil
+	}))
+	require.Equal(t, []int64{2, 4, 6}, outputs)
+	require.Equal(t, []int64{2}, failed)
+}
@@ -77,6 +77,8 @@ type getRolesForPermissionFunc func(context.Context, *auth.GetRolesForPermission
 type getOIDCLoginFunc func(context.Context, *auth.GetOIDCLoginRequest) (*auth.GetOIDCLoginResponse, error)
 type getRobotTokenFunc func(context.Context, *auth.GetRobotTokenRequest) (*auth.GetRobotTokenResponse, error)
 type revokeAuthTokenFunc func(context.Context, *auth.RevokeAuthTokenRequest) (*auth.RevokeAuthTokenResponse, error)
+type getScopedTokenFunc func(context.Context, *auth.GetScopedTokenRequest) (*auth.GetScopedTokenResponse, error)
+type listAuthTokensFunc func(context.Context, *auth.ListAuthTokensRequest) (*auth.ListAuthTokensResponse, error)
 type revokeAuthTokensForUserFunc func(context.Context, *auth.RevokeAuthTokensForUserRequest) (*auth.RevokeAuthTokensForUserResponse, error)
 type setGroupsForUserFunc func(context.Context, *auth.SetGroupsForUserRequest) (*auth.SetGroupsForUserResponse, error)
 type modifyMembersFunc func(context.Context, *auth.ModifyMembersRequest) (*auth.ModifyMembersResponse, error)
@@ -94,6 +96,7 @@ type checkRepoIsAuthorizedFunc func(context.Context, *pfs.Repo, ...auth.Permissi
 type checkClusterIsAuthorizedInTransactionFunc func(*txncontext.TransactionContext, ...auth.Permission) error
 type checkProjectIsAuthorizedInTransactionFunc func(*txncontext.TransactionContext, *pfs.Project, ...auth.Permission) error
 type checkRepoIsAuthorizedInTransactionFunc func(*txncontext.TransactionContext, *pfs.Repo, ...auth.Permission) error
+type checkBranchIsAuthorizedInTransactionFunc func(*txncontext.TransactionContext, *pfs.Branch, ...auth.Permission) error
 type authorizeInTransactionFunc func(*txncontext.TransactionContext, *auth.AuthorizeRequest) (*auth.AuthorizeResponse, error)
 type modifyRoleBindingInTransactionFunc func(*txncontext.TransactionContext, *auth.ModifyRoleBindingRequest) (*auth.ModifyRoleBindingResponse, error)
 type getRoleBindingInTransactionFunc func(*txncontext.TransactionContext, *auth.GetRoleBindingRequest) (*auth.GetRoleBindingResponse, error)
@@ -125,6 +128,8 @@ type mockGetRolesForPermission struct{ handler getRolesForPermissionFunc }
 type mockGetOIDCLogin struct{ handler getOIDCLoginFunc }
 type mockGetRobotToken struct{ handler getRobotTokenFunc }
 type mockRevokeAuthToken struct{ handler revokeAuthTokenFunc }
+type mockGetScopedToken struct{ handler getScopedTokenFunc }
+type mockListAuthTokens struct{ handler listAuthTokensFunc }
 type mockRevokeAuthTokensForUser struct{ handler revokeAuthTokensForUserFunc }
 type mockSetGroupsForUser struct{ handler setGroupsForUserFunc }
 type mockModifyMembers struct{ handler modifyMembersFunc }
@@ -154,6 +159,9 @@ type mockCheckProjectIsAuthorizedInTransaction struct {
 type mockCheckRepoIsAuthorizedInTransaction struct {
 	handler checkRepoIsAuthorizedInTransactionFunc
 }
+type mockCheckBranchIsAuthorizedInTransaction struct {
+	handler checkBranchIsAuthorizedInTransactionFunc
+}
 type mockAuthorizeInTransaction struct {
 	handler authorizeInTransactionFunc
 }
@@ -204,6 +212,8 @@ func (mock *mockGetRolesForPermission) Use(cb getRolesForPermissionFunc)
 func (mock *mockGetOIDCLogin) Use(cb getOIDCLoginFunc)                             { mock.handler = cb }
 func (mock *mockGetRobotToken) Use(cb getRobotTokenFunc)                           { mock.handler = cb }
 func (mock *mockRevokeAuthToken) Use(cb revokeAuthTokenFunc)                       { mock.handler = cb }
+func (mock *mockGetScopedToken) Use(cb getScopedTokenFunc)                         { mock.handler = cb }
+func (mock *mockListAuthTokens) Use(cb listAuthTokensFunc)                         { mock.handler = cb }
 func (mock *mockRevokeAuthTokensForUser) Use(cb revokeAuthTokensForUserFunc)       { mock.handler = cb }
 func (mock *mockSetGroupsForUser) Use(cb setGroupsForUserFunc)                     { mock.handler = cb }
 func (mock *mockModifyMembers) Use(cb modifyMembersFunc)                           { mock.handler = cb }
@@ -235,6 +245,9 @@ func (mock *mockCheckProjectIsAuthorizedInTransaction) Use(cb checkProjectIsAuth
 func (mock *mockCheckRepoIsAuthorizedInTransaction) Use(cb checkRepoIsAuthorizedInTransactionFunc) {
 	mock.handler = cb
 }
+func (mock *mockCheckBranchIsAuthorizedInTransaction) Use(cb checkBranchIsAuthorizedInTransactionFunc) {
+	mock.handler = cb
+}
 func (mock *mockAuthorizeInTransaction) Use(cb authorizeInTransactionFunc) {
 	mock.handler = cb
 }
@@ -294,6 +307,8 @@ type mockAuthServer struct {
 	GetOIDCLogin                               mockGetOIDCLogin
 	GetRobotToken                              mockGetRobotToken
 	RevokeAuthToken                            mockRevokeAuthToken
+	GetScopedToken                             mockGetScopedToken
+	ListAuthTokens                             mockListAuthTokens
 	RevokeAuthTokensForUser                    mockRevokeAuthTokensForUser
 	SetGroupsForUser                           mockSetGroupsForUser
 	ModifyMembers                              mockModifyMembers
@@ -310,6 +325,7 @@ type mockAuthServer struct {
 	CheckClusterIsAuthorizedInTransaction      mockCheckClusterIsAuthorizedInTransaction
 	CheckProjectIsAuthorizedInTransaction      mockCheckProjectIsAuthorizedInTransaction
 	CheckRepoIsAuthorizedInTransaction         mockCheckRepoIsAuthorizedInTransaction
+	CheckBranchIsAuthorizedInTransaction       mockCheckBranchIsAuthorizedInTransaction
 	AuthorizeInTransaction                     mockAuthorizeInTransaction
 	ModifyRoleBindingInTransaction             mockModifyRoleBindingInTransaction
 	GetRoleBindingInTransaction                mockGetRoleBindingInTransaction
@@ -414,6 +430,18 @@ func (api *authServerAPI) RevokeAuthToken(ctx context.Context, req *auth.RevokeA
 	}
 	return nil, errors.Errorf("unhandled pachd mock auth.RevokeAuthToken")
 }
+func (api *authServerAPI) GetScopedToken(ctx context.Context, req *auth.GetScopedTokenRequest) (*auth.GetScopedTokenResponse, error) {
+	if api.mock.GetScopedToken.handler != nil {
+		return api.mock.GetScopedToken.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.GetScopedToken")
+}
+func (api *authServerAPI) ListAuthTokens(ctx context.Context, req *auth.ListAuthTokensRequest) (*auth.ListAuthTokensResponse, error) {
+	if api.mock.ListAuthTokens.handler != nil {
+		return api.mock.ListAuthTokens.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.ListAuthTokens")
+}
 func (api *authServerAPI) RevokeAuthTokensForUser(ctx context.Context, req *auth.RevokeAuthTokensForUserRequest) (*auth.RevokeAuthTokensForUserResponse, error) {
 	if api.mock.RevokeAuthTokensForUser.handler != nil {
 		return api.mock.RevokeAuthTokensForUser.handler(ctx, req)
@@ -514,6 +542,13 @@ func (api *authServerAPI) CheckRepoIsAuthorizedInTransaction(transactionContext
 	return errors.Errorf("unhandled pachd mock auth.CheckRepoIsAuthorizedInTransaction")
 }
 
+func (api *authServerAPI) CheckBranchIsAuthorizedInTransaction(transactionContext *txncontext.TransactionContext, branch *pfs.Branch, permission ...auth.Permission) error {
+	if api.mock.CheckBranchIsAuthorizedInTransaction.handler != nil {
+		return api.mock.CheckBranchIsAuthorizedInTransaction.handler(transactionContext, branch, permission...)
+	}
+	return errors.Errorf("unhandled pachd mock auth.CheckBranchIsAuthorizedInTransaction")
+}
+
 func (api *authServerAPI) CheckProjectIsAuthorizedInTransaction(transactionContext *txncontext.TransactionContext, project *pfs.Project, permission ...auth.Permission) error {
 	if api.mock.CheckProjectIsAuthorizedInTransaction.handler != nil {
 		return api.mock.CheckProjectIsAuthorizedInTransaction.handler(transactionContext, project, permission...)
@@ -976,6 +1011,7 @@ type listCommitSetFunc func(*pfs.ListCommitSetRequest, pfs.API_ListCommitSetServ
 type FindCommitsFunc func(*pfs.FindCommitsRequest, pfs.API_FindCommitsServer) error
 type subscribeCommitFunc func(*pfs.SubscribeCommitRequest, pfs.API_SubscribeCommitServer) error
 type clearCommitFunc func(context.Context, *pfs.ClearCommitRequest) (*emptypb.Empty, error)
+type approveCommitFunc func(context.Context, *pfs.ApproveCommitRequest) (*emptypb.Empty, error)
 type createBranchFunc func(context.Context, *pfs.CreateBranchRequest) (*emptypb.Empty, error)
 type inspectBranchFunc func(context.Context, *pfs.InspectBranchRequest) (*pfs.BranchInfo, error)
 type listBranchFunc func(*pfs.ListBranchRequest, pfs.API_ListBranchServer) error
@@ -1006,6 +1042,25 @@ type getCacheFunc func(context.Context, *pfs.GetCacheRequest) (*pfs.GetCacheResp
 type clearCacheFunc func(context.Context, *pfs.ClearCacheRequest) (*emptypb.Empty, error)
 type listTaskPFSFunc func(*task.ListTaskRequest, pfs.API_ListTaskServer) error
 type egressFunc func(context.Context, *pfs.EgressRequest) (*pfs.EgressResponse, error)
+type createReplicationFunc func(context.Context, *pfs.CreateReplicationRequest) (*emptypb.Empty, error)
+type inspectReplicationFunc func(context.Context, *pfs.InspectReplicationRequest) (*pfs.ReplicationInfo, error)
+type listReplicationFunc func(*pfs.ListReplicationRequest, pfs.API_ListReplicationServer) error
+type deleteReplicationFunc func(context.Context, *pfs.DeleteReplicationRequest) (*emptypb.Empty, error)
+type missingChunksFunc func(context.Context, *pfs.MissingChunksRequest) (*pfs.MissingChunksResponse, error)
+type replicateFileSetFunc func(pfs.API_ReplicateFileSetServer) error
+type setRetentionPolicyFunc func(context.Context, *pfs.SetRetentionPolicyRequest) (*emptypb.Empty, error)
+type listRetentionPolicyFunc func(*pfs.ListRetentionPolicyRequest, pfs.API_ListRetentionPolicyServer) error
+type enforceRetentionPolicyFunc func(*pfs.EnforceRetentionPolicyRequest, pfs.API_EnforceRetentionPolicyServer) error
+type createUploadSessionFunc func(context.Context, *pfs.CreateUploadSessionRequest) (*pfs.UploadSessionInfo, error)
+type inspectUploadSessionFunc func(context.Context, *pfs.InspectUploadSessionRequest) (*pfs.UploadSessionInfo, error)
+type listUploadSessionFunc func(*pfs.ListUploadSessionRequest, pfs.API_ListUploadSessionServer) error
+type uploadPartFunc func(pfs.API_UploadPartServer) error
+type commitUploadSessionFunc func(context.Context, *pfs.CommitUploadSessionRequest) (*emptypb.Empty, error)
+type deleteUploadSessionFunc func(context.Context, *pfs.DeleteUploadSessionRequest) (*emptypb.Empty, error)
+type setCommitValidationFunc func(context.Context, *pfs.SetCommitValidationRequest) (*emptypb.Empty, error)
+type listCommitValidationFunc func(*pfs.ListCommitValidationRequest, pfs.API_ListCommitValidationServer) error
+type setStoragePlacementFunc func(context.Context, *pfs.SetStoragePlacementRequest) (*emptypb.Empty, error)
+type listStoragePlacementFunc func(*pfs.ListStoragePlacementRequest, pfs.API_ListStoragePlacementServer) error
 
 type mockActivateAuthPFS struct{ handler activateAuthPFSFunc }
 type mockCreateRepo struct{ handler createRepoFunc }
@@ -1024,6 +1079,7 @@ type mockListCommitSet struct{ handler listCommitSetFunc }
 type mockFindCommits struct{ handler FindCommitsFunc }
 type mockSubscribeCommit struct{ handler subscribeCommitFunc }
 type mockClearCommit struct{ handler clearCommitFunc }
+type mockApproveCommit struct{ handler approveCommitFunc }
 type mockCreateBranch struct{ handler createBranchFunc }
 type mockInspectBranch struct{ handler inspectBranchFunc }
 type mockListBranch struct{ handler listBranchFunc }
@@ -1054,54 +1110,93 @@ type mockGetCache struct{ handler getCacheFunc }
 type mockClearCache struct{ handler clearCacheFunc }
 type mockListTaskPFS struct{ handler listTaskPFSFunc }
 type mockEgress struct{ handler egressFunc }
-
-func (mock *mockActivateAuthPFS) Use(cb activateAuthPFSFunc)   { mock.handler = cb }
-func (mock *mockCreateRepo) Use(cb createRepoFunc)             { mock.handler = cb }
-func (mock *mockInspectRepo) Use(cb inspectRepoFunc)           { mock.handler = cb }
-func (mock *mockListRepo) Use(cb listRepoFunc)                 { mock.handler = cb }
-func (mock *mockDeleteRepo) Use(cb deleteRepoFunc)             { mock.handler = cb }
-func (mock *mockDeleteRepos) Use(cb deleteReposFunc)           { mock.handler = cb }
-func (mock *mockStartCommit) Use(cb startCommitFunc)           { mock.handler = cb }
-func (mock *mockFinishCommit) Use(cb finishCommitFunc)         { mock.handler = cb }
-func (mock *mockInspectCommit) Use(cb inspectCommitFunc)       { mock.handler = cb }
-func (mock *mockListCommit) Use(cb listCommitFunc)             { mock.handler = cb }
-func (mock *mockSubscribeCommit) Use(cb subscribeCommitFunc)   { mock.handler = cb }
-func (mock *mockClearCommit) Use(cb clearCommitFunc)           { mock.handler = cb }
-func (mock *mockSquashCommitSet) Use(cb squashCommitSetFunc)   { mock.handler = cb }
-func (mock *mockDropCommitSet) Use(cb dropCommitSetFunc)       { mock.handler = cb }
-func (mock *mockInspectCommitSet) Use(cb inspectCommitSetFunc) { mock.handler = cb }
-func (mock *mockListCommitSet) Use(cb listCommitSetFunc)       { mock.handler = cb }
-func (mock *mockFindCommits) Use(cb FindCommitsFunc)           { mock.handler = cb }
-func (mock *mockCreateBranch) Use(cb createBranchFunc)         { mock.handler = cb }
-func (mock *mockInspectBranch) Use(cb inspectBranchFunc)       { mock.handler = cb }
-func (mock *mockListBranch) Use(cb listBranchFunc)             { mock.handler = cb }
-func (mock *mockDeleteBranch) Use(cb deleteBranchFunc)         { mock.handler = cb }
-func (mock *mockCreateProject) Use(cb createProjectFunc)       { mock.handler = cb }
-func (mock *mockInspectProject) Use(cb inspectProjectFunc)     { mock.handler = cb }
-func (mock *mockListProject) Use(cb listProjectFunc)           { mock.handler = cb }
-func (mock *mockDeleteProject) Use(cb deleteProjectFunc)       { mock.handler = cb }
-func (mock *mockModifyFile) Use(cb modifyFileFunc)             { mock.handler = cb }
-func (mock *mockGetFile) Use(cb getFileFunc)                   { mock.handler = cb }
-func (mock *mockGetFileTAR) Use(cb getFileTARFunc)             { mock.handler = cb }
-func (mock *mockInspectFile) Use(cb inspectFileFunc)           { mock.handler = cb }
-func (mock *mockListFile) Use(cb listFileFunc)                 { mock.handler = cb }
-func (mock *mockWalkFile) Use(cb walkFileFunc)                 { mock.handler = cb }
-func (mock *mockGlobFile) Use(cb globFileFunc)                 { mock.handler = cb }
-func (mock *mockDiffFile) Use(cb diffFileFunc)                 { mock.handler = cb }
-func (mock *mockDeleteAllPFS) Use(cb deleteAllPFSFunc)         { mock.handler = cb }
-func (mock *mockFsck) Use(cb fsckFunc)                         { mock.handler = cb }
-func (mock *mockCreateFileSet) Use(cb createFileSetFunc)       { mock.handler = cb }
-func (mock *mockAddFileSet) Use(cb addFileSetFunc)             { mock.handler = cb }
-func (mock *mockGetFileSet) Use(cb getFileSetFunc)             { mock.handler = cb }
-func (mock *mockRenewFileSet) Use(cb renewFileSetFunc)         { mock.handler = cb }
-func (mock *mockComposeFileSet) Use(cb composeFileSetFunc)     { mock.handler = cb }
-func (mock *mockShardFileSet) Use(cb shardFileSetFunc)         { mock.handler = cb }
-func (mock *mockCheckStorage) Use(cb checkStorageFunc)         { mock.handler = cb }
-func (mock *mockPutCache) Use(cb putCacheFunc)                 { mock.handler = cb }
-func (mock *mockGetCache) Use(cb getCacheFunc)                 { mock.handler = cb }
-func (mock *mockClearCache) Use(cb clearCacheFunc)             { mock.handler = cb }
-func (mock *mockListTaskPFS) Use(cb listTaskPFSFunc)           { mock.handler = cb }
-func (mock *mockEgress) Use(cb egressFunc)                     { mock.handler = cb }
+type mockCreateReplication struct{ handler createReplicationFunc }
+type mockInspectReplication struct{ handler inspectReplicationFunc }
+type mockListReplication struct{ handler listReplicationFunc }
+type mockDeleteReplication struct{ handler deleteReplicationFunc }
+type mockMissingChunks struct{ handler missingChunksFunc }
+type mockReplicateFileSet struct{ handler replicateFileSetFunc }
+type mockSetRetentionPolicy struct{ handler setRetentionPolicyFunc }
+type mockListRetentionPolicy struct{ handler listRetentionPolicyFunc }
+type mockEnforceRetentionPolicy struct{ handler enforceRetentionPolicyFunc }
+type mockCreateUploadSession struct{ handler createUploadSessionFunc }
+type mockInspectUploadSession struct{ handler inspectUploadSessionFunc }
+type mockListUploadSession struct{ handler listUploadSessionFunc }
+type mockUploadPart struct{ handler uploadPartFunc }
+type mockCommitUploadSession struct{ handler commitUploadSessionFunc }
+type mockDeleteUploadSession struct{ handler deleteUploadSessionFunc }
+type mockSetCommitValidation struct{ handler setCommitValidationFunc }
+type mockListCommitValidation struct{ handler listCommitValidationFunc }
+type mockSetStoragePlacement struct{ handler setStoragePlacementFunc }
+type mockListStoragePlacement struct{ handler listStoragePlacementFunc }
+
+func (mock *mockActivateAuthPFS) Use(cb activateAuthPFSFunc)               { mock.handler = cb }
+func (mock *mockCreateRepo) Use(cb createRepoFunc)                         { mock.handler = cb }
+func (mock *mockInspectRepo) Use(cb inspectRepoFunc)                       { mock.handler = cb }
+func (mock *mockListRepo) Use(cb listRepoFunc)                             { mock.handler = cb }
+func (mock *mockDeleteRepo) Use(cb deleteRepoFunc)                         { mock.handler = cb }
+func (mock *mockDeleteRepos) Use(cb deleteReposFunc)                       { mock.handler = cb }
+func (mock *mockStartCommit) Use(cb startCommitFunc)                       { mock.handler = cb }
+func (mock *mockFinishCommit) Use(cb finishCommitFunc)                     { mock.handler = cb }
+func (mock *mockInspectCommit) Use(cb inspectCommitFunc)                   { mock.handler = cb }
+func (mock *mockListCommit) Use(cb listCommitFunc)                         { mock.handler = cb }
+func (mock *mockSubscribeCommit) Use(cb subscribeCommitFunc)               { mock.handler = cb }
+func (mock *mockClearCommit) Use(cb clearCommitFunc)                       { mock.handler = cb }
+func (mock *mockApproveCommit) Use(cb approveCommitFunc)                   { mock.handler = cb }
+func (mock *mockSquashCommitSet) Use(cb squashCommitSetFunc)               { mock.handler = cb }
+func (mock *mockDropCommitSet) Use(cb dropCommitSetFunc)                   { mock.handler = cb }
+func (mock *mockInspectCommitSet) Use(cb inspectCommitSetFunc)             { mock.handler = cb }
+func (mock *mockListCommitSet) Use(cb listCommitSetFunc)                   { mock.handler = cb }
+func (mock *mockFindCommits) Use(cb FindCommitsFunc)                       { mock.handler = cb }
+func (mock *mockCreateBranch) Use(cb createBranchFunc)                     { mock.handler = cb }
+func (mock *mockInspectBranch) Use(cb inspectBranchFunc)                   { mock.handler = cb }
+func (mock *mockListBranch) Use(cb listBranchFunc)                         { mock.handler = cb }
+func (mock *mockDeleteBranch) Use(cb deleteBranchFunc)                     { mock.handler = cb }
+func (mock *mockCreateProject) Use(cb createProjectFunc)                   { mock.handler = cb }
+func (mock *mockInspectProject) Use(cb inspectProjectFunc)                 { mock.handler = cb }
+func (mock *mockListProject) Use(cb listProjectFunc)                       { mock.handler = cb }
+func (mock *mockDeleteProject) Use(cb deleteProjectFunc)                   { mock.handler = cb }
+func (mock *mockModifyFile) Use(cb modifyFileFunc)                         { mock.handler = cb }
+func (mock *mockGetFile) Use(cb getFileFunc)                               { mock.handler = cb }
+func (mock *mockGetFileTAR) Use(cb getFileTARFunc)                         { mock.handler = cb }
+func (mock *mockInspectFile) Use(cb inspectFileFunc)                       { mock.handler = cb }
+func (mock *mockListFile) Use(cb listFileFunc)                             { mock.handler = cb }
+func (mock *mockWalkFile) Use(cb walkFileFunc)                             { mock.handler = cb }
+func (mock *mockGlobFile) Use(cb globFileFunc)                             { mock.handler = cb }
+func (mock *mockDiffFile) Use(cb diffFileFunc)                             { mock.handler = cb }
+func (mock *mockDeleteAllPFS) Use(cb deleteAllPFSFunc)                     { mock.handler = cb }
+func (mock *mockFsck) Use(cb fsckFunc)                                     { mock.handler = cb }
+func (mock *mockCreateFileSet) Use(cb createFileSetFunc)                   { mock.handler = cb }
+func (mock *mockAddFileSet) Use(cb addFileSetFunc)                         { mock.handler = cb }
+func (mock *mockGetFileSet) Use(cb getFileSetFunc)                         { mock.handler = cb }
+func (mock *mockRenewFileSet) Use(cb renewFileSetFunc)                     { mock.handler = cb }
+func (mock *mockComposeFileSet) Use(cb composeFileSetFunc)                 { mock.handler = cb }
+func (mock *mockShardFileSet) Use(cb shardFileSetFunc)                     { mock.handler = cb }
+func (mock *mockCheckStorage) Use(cb checkStorageFunc)                     { mock.handler = cb }
+func (mock *mockPutCache) Use(cb putCacheFunc)                             { mock.handler = cb }
+func (mock *mockGetCache) Use(cb getCacheFunc)                             { mock.handler = cb }
+func (mock *mockClearCache) Use(cb clearCacheFunc)                         { mock.handler = cb }
+func (mock *mockListTaskPFS) Use(cb listTaskPFSFunc)                       { mock.handler = cb }
+func (mock *mockEgress) Use(cb egressFunc)                                 { mock.handler = cb }
+func (mock *mockCreateReplication) Use(cb createReplicationFunc)           { mock.handler = cb }
+func (mock *mockInspectReplication) Use(cb inspectReplicationFunc)         { mock.handler = cb }
+func (mock *mockListReplication) Use(cb listReplicationFunc)               { mock.handler = cb }
+func (mock *mockDeleteReplication) Use(cb deleteReplicationFunc)           { mock.handler = cb }
+func (mock *mockMissingChunks) Use(cb missingChunksFunc)                   { mock.handler = cb }
+func (mock *mockReplicateFileSet) Use(cb replicateFileSetFunc)             { mock.handler = cb }
+func (mock *mockSetRetentionPolicy) Use(cb setRetentionPolicyFunc)         { mock.handler = cb }
+func (mock *mockListRetentionPolicy) Use(cb listRetentionPolicyFunc)       { mock.handler = cb }
+func (mock *mockEnforceRetentionPolicy) Use(cb enforceRetentionPolicyFunc) { mock.handler = cb }
+func (mock *mockCreateUploadSession) Use(cb createUploadSessionFunc)       { mock.handler = cb }
+func (mock *mockInspectUploadSession) Use(cb inspectUploadSessionFunc)     { mock.handler = cb }
+func (mock *mockListUploadSession) Use(cb listUploadSessionFunc)           { mock.handler = cb }
+func (mock *mockUploadPart) Use(cb uploadPartFunc)                         { mock.handler = cb }
+func (mock *mockCommitUploadSession) Use(cb commitUploadSessionFunc)       { mock.handler = cb }
+func (mock *mockDeleteUploadSession) Use(cb deleteUploadSessionFunc)       { mock.handler = cb }
+func (mock *mockSetCommitValidation) Use(cb setCommitValidationFunc)       { mock.handler = cb }
+func (mock *mockListCommitValidation) Use(cb listCommitValidationFunc)     { mock.handler = cb }
+func (mock *mockSetStoragePlacement) Use(cb setStoragePlacementFunc)       { mock.handler = cb }
+func (mock *mockListStoragePlacement) Use(cb listStoragePlacementFunc)     { mock.handler = cb }
 
 type pfsServerAPI struct {
 	pfs.UnsafeAPIServer
@@ -1109,54 +1204,74 @@ type pfsServerAPI struct {
 }
 
 type mockPFSServer struct {
-	api              pfsServerAPI
-	ActivateAuth     mockActivateAuthPFS
-	CreateRepo       mockCreateRepo
-	InspectRepo      mockInspectRepo
-	ListRepo         mockListRepo
-	DeleteRepo       mockDeleteRepo
-	DeleteRepos      mockDeleteRepos
-	StartCommit      mockStartCommit
-	FinishCommit     mockFinishCommit
-	InspectCommit    mockInspectCommit
-	ListCommit       mockListCommit
-	SubscribeCommit  mockSubscribeCommit
-	ClearCommit      mockClearCommit
-	SquashCommitSet  mockSquashCommitSet
-	DropCommitSet    mockDropCommitSet
-	InspectCommitSet mockInspectCommitSet
-	ListCommitSet    mockListCommitSet
-	FindCommits      mockFindCommits
-	CreateBranch     mockCreateBranch
-	InspectBranch    mockInspectBranch
-	ListBranch       mockListBranch
-	DeleteBranch     mockDeleteBranch
-	CreateProject    mockCreateProject
-	InspectProject   mockInspectProject
-	ListProject      mockListProject
-	DeleteProject    mockDeleteProject
-	ModifyFile       mockModifyFile
-	GetFile          mockGetFile
-	GetFileTAR       mockGetFileTAR
-	InspectFile      mockInspectFile
-	ListFile         mockListFile
-	WalkFile         mockWalkFile
-	GlobFile         mockGlobFile
-	DiffFile         mockDiffFile
-	DeleteAll        mockDeleteAllPFS
-	Fsck             mockFsck
-	CreateFileSet    mockCreateFileSet
-	AddFileSet       mockAddFileSet
-	GetFileSet       mockGetFileSet
-	RenewFileSet     mockRenewFileSet
-	ComposeFileSet   mockComposeFileSet
-	ShardFileSet     mockShardFileSet
-	CheckStorage     mockCheckStorage
-	PutCache         mockPutCache
-	GetCache         mockGetCache
-	ClearCache       mockClearCache
-	ListTask         mockListTaskPFS
-	Egress           mockEgress
+	api                    pfsServerAPI
+	ActivateAuth           mockActivateAuthPFS
+	CreateRepo             mockCreateRepo
+	InspectRepo            mockInspectRepo
+	ListRepo               mockListRepo
+	DeleteRepo             mockDeleteRepo
+	DeleteRepos            mockDeleteRepos
+	StartCommit            mockStartCommit
+	FinishCommit           mockFinishCommit
+	InspectCommit          mockInspectCommit
+	ListCommit             mockListCommit
+	SubscribeCommit        mockSubscribeCommit
+	ClearCommit            mockClearCommit
+	ApproveCommit          mockApproveCommit
+	SquashCommitSet        mockSquashCommitSet
+	DropCommitSet          mockDropCommitSet
+	InspectCommitSet       mockInspectCommitSet
+	ListCommitSet          mockListCommitSet
+	FindCommits            mockFindCommits
+	CreateBranch           mockCreateBranch
+	InspectBranch          mockInspectBranch
+	ListBranch             mockListBranch
+	DeleteBranch           mockDeleteBranch
+	CreateProject          mockCreateProject
+	InspectProject         mockInspectProject
+	ListProject            mockListProject
+	DeleteProject          mockDeleteProject
+	ModifyFile             mockModifyFile
+	GetFile                mockGetFile
+	GetFileTAR             mockGetFileTAR
+	InspectFile            mockInspectFile
+	ListFile               mockListFile
+	WalkFile               mockWalkFile
+	GlobFile               mockGlobFile
+	DiffFile               mockDiffFile
+	DeleteAll              mockDeleteAllPFS
+	Fsck                   mockFsck
+	CreateFileSet          mockCreateFileSet
+	AddFileSet             mockAddFileSet
+	GetFileSet             mockGetFileSet
+	RenewFileSet           mockRenewFileSet
+	ComposeFileSet         mockComposeFileSet
+	ShardFileSet           mockShardFileSet
+	CheckStorage           mockCheckStorage
+	PutCache               mockPutCache
+	GetCache               mockGetCache
+	ClearCache             mockClearCache
+	ListTask               mockListTaskPFS
+	Egress                 mockEgress
+	CreateReplication      mockCreateReplication
+	InspectReplication     mockInspectReplication
+	ListReplication        mockListReplication
+	DeleteReplication      mockDeleteReplication
+	MissingChunks          mockMissingChunks
+	ReplicateFileSet       mockReplicateFileSet
+	SetRetentionPolicy     mockSetRetentionPolicy
+	ListRetentionPolicy    mockListRetentionPolicy
+	EnforceRetentionPolicy mockEnforceRetentionPolicy
+	CreateUploadSession    mockCreateUploadSession
+	InspectUploadSession   mockInspectUploadSession
+	ListUploadSession      mockListUploadSession
+	UploadPart             mockUploadPart
+	CommitUploadSession    mockCommitUploadSession
+	DeleteUploadSession    mockDeleteUploadSession
+	SetCommitValidation    mockSetCommitValidation
+	ListCommitValidation   mockListCommitValidation
+	SetStoragePlacement    mockSetStoragePlacement
+	ListStoragePlacement   mockListStoragePlacement
 }
 
 func (api *pfsServerAPI) ActivateAuth(ctx context.Context, req *pfs.ActivateAuthRequest) (*pfs.ActivateAuthResponse, error) {
@@ -1257,6 +1372,12 @@ func (api *pfsServerAPI) ClearCommit(ctx context.Context, req *pfs.ClearCommitRe
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.ClearCommit")
 }
+func (api *pfsServerAPI) ApproveCommit(ctx context.Context, req *pfs.ApproveCommitRequest) (*emptypb.Empty, error) {
+	if api.mock.ApproveCommit.handler != nil {
+		return api.mock.ApproveCommit.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.ApproveCommit")
+}
 func (api *pfsServerAPI) FindCommits(req *pfs.FindCommitsRequest, srv pfs.API_FindCommitsServer) error {
 	if api.mock.FindCommits.handler != nil {
 		return api.mock.FindCommits.handler(req, srv)
@@ -1437,6 +1558,120 @@ func (api *pfsServerAPI) Egress(ctx context.Context, req *pfs.EgressRequest) (*p
 	}
 	return nil, errors.Errorf("unhandled pachd mock pps.Egress")
 }
+func (api *pfsServerAPI) CreateReplication(ctx context.Context, req *pfs.CreateReplicationRequest) (*emptypb.Empty, error) {
+	if api.mock.CreateReplication.handler != nil {
+		return api.mock.CreateReplication.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.CreateReplication")
+}
+func (api *pfsServerAPI) InspectReplication(ctx context.Context, req *pfs.InspectReplicationRequest) (*pfs.ReplicationInfo, error) {
+	if api.mock.InspectReplication.handler != nil {
+		return api.mock.InspectReplication.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.InspectReplication")
+}
+func (api *pfsServerAPI) ListReplication(req *pfs.ListReplicationRequest, server pfs.API_ListReplicationServer) error {
+	if api.mock.ListReplication.handler != nil {
+		return api.mock.ListReplication.handler(req, server)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.ListReplication")
+}
+func (api *pfsServerAPI) DeleteReplication(ctx context.Context, req *pfs.DeleteReplicationRequest) (*emptypb.Empty, error) {
+	if api.mock.DeleteReplication.handler != nil {
+		return api.mock.DeleteReplication.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteReplication")
+}
+func (api *pfsServerAPI) MissingChunks(ctx context.Context, req *pfs.MissingChunksRequest) (*pfs.MissingChunksResponse, error) {
+	if api.mock.MissingChunks.handler != nil {
+		return api.mock.MissingChunks.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.MissingChunks")
+}
+func (api *pfsServerAPI) ReplicateFileSet(server pfs.API_ReplicateFileSetServer) error {
+	if api.mock.ReplicateFileSet.handler != nil {
+		return api.mock.ReplicateFileSet.handler(server)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.ReplicateFileSet")
+}
+func (api *pfsServerAPI) SetRetentionPolicy(ctx context.Context, req *pfs.SetRetentionPolicyRequest) (*emptypb.Empty, error) {
+	if api.mock.SetRetentionPolicy.handler != nil {
+		return api.mock.SetRetentionPolicy.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.SetRetentionPolicy")
+}
+func (api *pfsServerAPI) ListRetentionPolicy(req *pfs.ListRetentionPolicyRequest, server pfs.API_ListRetentionPolicyServer) error {
+	if api.mock.ListRetentionPolicy.handler != nil {
+		return api.mock.ListRetentionPolicy.handler(req, server)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.ListRetentionPolicy")
+}
+func (api *pfsServerAPI) EnforceRetentionPolicy(req *pfs.EnforceRetentionPolicyRequest, server pfs.API_EnforceRetentionPolicyServer) error {
+	if api.mock.EnforceRetentionPolicy.handler != nil {
+		return api.mock.EnforceRetentionPolicy.handler(req, server)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.EnforceRetentionPolicy")
+}
+func (api *pfsServerAPI) CreateUploadSession(ctx context.Context, req *pfs.CreateUploadSessionRequest) (*pfs.UploadSessionInfo, error) {
+	if api.mock.CreateUploadSession.handler != nil {
+		return api.mock.CreateUploadSession.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.CreateUploadSession")
+}
+func (api *pfsServerAPI) InspectUploadSession(ctx context.Context, req *pfs.InspectUploadSessionRequest) (*pfs.UploadSessionInfo, error) {
+	if api.mock.InspectUploadSession.handler != nil {
+		return api.mock.InspectUploadSession.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.InspectUploadSession")
+}
+func (api *pfsServerAPI) ListUploadSession(req *pfs.ListUploadSessionRequest, server pfs.API_ListUploadSessionServer) error {
+	if api.mock.ListUploadSession.handler != nil {
+		return api.mock.ListUploadSession.handler(req, server)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.ListUploadSession")
+}
+func (api *pfsServerAPI) UploadPart(server pfs.API_UploadPartServer) error {
+	if api.mock.UploadPart.handler != nil {
+		return api.mock.UploadPart.handler(server)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.UploadPart")
+}
+func (api *pfsServerAPI) CommitUploadSession(ctx context.Context, req *pfs.CommitUploadSessionRequest) (*emptypb.Empty, error) {
+	if api.mock.CommitUploadSession.handler != nil {
+		return api.mock.CommitUploadSession.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.CommitUploadSession")
+}
+func (api *pfsServerAPI) DeleteUploadSession(ctx context.Context, req *pfs.DeleteUploadSessionRequest) (*emptypb.Empty, error) {
+	if api.mock.DeleteUploadSession.handler != nil {
+		return api.mock.DeleteUploadSession.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteUploadSession")
+}
+func (api *pfsServerAPI) SetCommitValidation(ctx context.Context, req *pfs.SetCommitValidationRequest) (*emptypb.Empty, error) {
+	if api.mock.SetCommitValidation.handler != nil {
+		return api.mock.SetCommitValidation.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.SetCommitValidation")
+}
+func (api *pfsServerAPI) ListCommitValidation(req *pfs.ListCommitValidationRequest, server pfs.API_ListCommitValidationServer) error {
+	if api.mock.ListCommitValidation.handler != nil {
+		return api.mock.ListCommitValidation.handler(req, server)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.ListCommitValidation")
+}
+func (api *pfsServerAPI) SetStoragePlacement(ctx context.Context, req *pfs.SetStoragePlacementRequest) (*emptypb.Empty, error) {
+	if api.mock.SetStoragePlacement.handler != nil {
+		return api.mock.SetStoragePlacement.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.SetStoragePlacement")
+}
+func (api *pfsServerAPI) ListStoragePlacement(req *pfs.ListStoragePlacementRequest, server pfs.API_ListStoragePlacementServer) error {
+	if api.mock.ListStoragePlacement.handler != nil {
+		return api.mock.ListStoragePlacement.handler(req, server)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.ListStoragePlacement")
+}
 
 func (api *pfsServerAPI) ListTask(req *task.ListTaskRequest, server pfs.API_ListTaskServer) error {
 	if api.mock.ListTask.handler != nil {
@@ -1485,6 +1720,11 @@ type queryLokiFunc func(*pps.LokiRequest, pps.API_QueryLokiServer) error
 type createDetPipelineSideEffectsFunc func(context.Context, *pps.Pipeline, []string) error
 type getClusterDefaultsFunc func(context.Context, *pps.GetClusterDefaultsRequest) (*pps.GetClusterDefaultsResponse, error)
 type setClusterDefaultsFunc func(context.Context, *pps.SetClusterDefaultsRequest) (*pps.SetClusterDefaultsResponse, error)
+type getProjectComputeUsageFunc func(context.Context, *pps.GetProjectComputeUsageRequest) (*pps.GetProjectComputeUsageResponse, error)
+type createNotificationFunc func(context.Context, *pps.CreateNotificationRequest) (*pps.CreateNotificationResponse, error)
+type listNotificationFunc func(*pps.ListNotificationRequest, pps.API_ListNotificationServer) error
+type deleteNotificationFunc func(context.Context, *pps.DeleteNotificationRequest) (*emptypb.Empty, error)
+type listNotificationDeliveryFunc func(*pps.ListNotificationDeliveryRequest, pps.API_ListNotificationDeliveryServer) error
 
 type mockInspectJob struct{ handler inspectJobFunc }
 type mockListJob struct{ handler listJobFunc }
@@ -1526,6 +1766,11 @@ type mockCreateDetPipelineSideEffects struct {
 }
 type mockGetClusterDefaults struct{ handler getClusterDefaultsFunc }
 type mockSetClusterDefaults struct{ handler setClusterDefaultsFunc }
+type mockGetProjectComputeUsage struct{ handler getProjectComputeUsageFunc }
+type mockCreateNotification struct{ handler createNotificationFunc }
+type mockListNotification struct{ handler listNotificationFunc }
+type mockDeleteNotification struct{ handler deleteNotificationFunc }
+type mockListNotificationDelivery struct{ handler listNotificationDeliveryFunc }
 
 func (mock *mockInspectJob) Use(cb inspectJobFunc)                       { mock.handler = cb }
 func (mock *mockListJob) Use(cb listJobFunc)                             { mock.handler = cb }
@@ -1565,8 +1810,13 @@ func (mock *mockQueryLoki) Use(cb queryLokiFunc)                         { mock.
 func (mock *mockCreateDetPipelineSideEffects) Use(cb createDetPipelineSideEffectsFunc) {
 	mock.handler = cb
 }
-func (mock *mockGetClusterDefaults) Use(cb getClusterDefaultsFunc) { mock.handler = cb }
-func (mock *mockSetClusterDefaults) Use(cb setClusterDefaultsFunc) { mock.handler = cb }
+func (mock *mockGetClusterDefaults) Use(cb getClusterDefaultsFunc)             { mock.handler = cb }
+func (mock *mockSetClusterDefaults) Use(cb setClusterDefaultsFunc)             { mock.handler = cb }
+func (mock *mockGetProjectComputeUsage) Use(cb getProjectComputeUsageFunc)     { mock.handler = cb }
+func (mock *mockCreateNotification) Use(cb createNotificationFunc)             { mock.handler = cb }
+func (mock *mockListNotification) Use(cb listNotificationFunc)                 { mock.handler = cb }
+func (mock *mockDeleteNotification) Use(cb deleteNotificationFunc)             { mock.handler = cb }
+func (mock *mockListNotificationDelivery) Use(cb listNotificationDeliveryFunc) { mock.handler = cb }
 
 type ppsServerAPI struct {
 	pps.UnsafeAPIServer
@@ -1613,6 +1863,11 @@ type mockPPSServer struct {
 	CreateDetPipelineSideEffects mockCreateDetPipelineSideEffects
 	GetClusterDefaults           mockGetClusterDefaults
 	SetClusterDefaults           mockSetClusterDefaults
+	GetProjectComputeUsage       mockGetProjectComputeUsage
+	CreateNotification           mockCreateNotification
+	ListNotification             mockListNotification
+	DeleteNotification           mockDeleteNotification
+	ListNotificationDelivery     mockListNotificationDelivery
 }
 
 func (api *ppsServerAPI) InspectJob(ctx context.Context, req *pps.InspectJobRequest) (*pps.JobInfo, error) {
@@ -1845,6 +2100,36 @@ func (api *ppsServerAPI) SetClusterDefaults(ctx context.Context, req *pps.SetClu
 	}
 	return nil, errors.Errorf("unhandled pachd mock pps.SetClusterDefaults")
 }
+func (api *ppsServerAPI) GetProjectComputeUsage(ctx context.Context, req *pps.GetProjectComputeUsageRequest) (*pps.GetProjectComputeUsageResponse, error) {
+	if api.mock.GetProjectComputeUsage.handler != nil {
+		return api.mock.GetProjectComputeUsage.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.GetProjectComputeUsage")
+}
+func (api *ppsServerAPI) CreateNotification(ctx context.Context, req *pps.CreateNotificationRequest) (*pps.CreateNotificationResponse, error) {
+	if api.mock.CreateNotification.handler != nil {
+		return api.mock.CreateNotification.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.CreateNotification")
+}
+func (api *ppsServerAPI) ListNotification(req *pps.ListNotificationRequest, server pps.API_ListNotificationServer) error {
+	if api.mock.ListNotification.handler != nil {
+		return api.mock.ListNotification.handler(req, server)
+	}
+	return errors.Errorf("unhandled pachd mock pps.ListNotification")
+}
+func (api *ppsServerAPI) DeleteNotification(ctx context.Context, req *pps.DeleteNotificationRequest) (*emptypb.Empty, error) {
+	if api.mock.DeleteNotification.handler != nil {
+		return api.mock.DeleteNotification.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.DeleteNotification")
+}
+func (api *ppsServerAPI) ListNotificationDelivery(req *pps.ListNotificationDeliveryRequest, server pps.API_ListNotificationDeliveryServer) error {
+	if api.mock.ListNotificationDelivery.handler != nil {
+		return api.mock.ListNotificationDelivery.handler(req, server)
+	}
+	return errors.Errorf("unhandled pachd mock pps.ListNotificationDelivery")
+}
 
 /* Transaction Server Mocks */
 
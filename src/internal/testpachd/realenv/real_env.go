@@ -220,9 +220,11 @@ func newRealEnv(ctx context.Context, t testing.TB, mockPPSTransactionServer bool
 	realEnv.PFSServer, err = pfsserver.NewAPIServer(*pfsEnv)
 	require.NoError(t, err)
 	w, err := pfsserver.NewWorker(pfsserver.WorkerEnv{
-		DB:          pfsEnv.DB,
-		ObjClient:   pfsEnv.ObjectClient,
-		TaskService: pfsEnv.TaskService,
+		DB:            pfsEnv.DB,
+		ObjClient:     pfsEnv.ObjectClient,
+		TaskService:   pfsEnv.TaskService,
+		GetKubeClient: pfsEnv.GetKubeClient,
+		Namespace:     pfsEnv.Namespace,
 	}, pfsserver.WorkerConfig{
 		Storage: pfsEnv.StorageConfig,
 	})
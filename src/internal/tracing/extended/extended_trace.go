@@ -5,8 +5,8 @@ import (
 	"os"
 	"time"
 
-	opentracing "github.com/opentracing/opentracing-go"
 	etcd "go.etcd.io/etcd/client/v3"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/metadata"
 
@@ -61,8 +61,8 @@ func PersistAny(ctx context.Context, c *etcd.Client, pipeline *pps.Pipeline) {
 	if !tracing.IsActive() {
 		return
 	}
-	span := opentracing.SpanFromContext(ctx)
-	if span == nil {
+	serializedTrace := tracing.Inject(ctx)
+	if serializedTrace == nil {
 		// No incoming trace, so nothing to propagate
 		return
 	}
@@ -90,16 +90,10 @@ func PersistAny(ctx context.Context, c *etcd.Client, pipeline *pps.Pipeline) {
 
 	// serialize extended trace & write to etcd
 	traceProto := &TraceProto{
-		SerializedTrace: map[string]string{}, // init map
+		SerializedTrace: serializedTrace,
 		Project:         pipeline.GetProject().GetName(),
 		Pipeline:        pipeline.GetName(),
 	}
-	if err := opentracing.GlobalTracer().Inject(
-		span.Context(), opentracing.TextMap,
-		opentracing.TextMapCarrier(traceProto.SerializedTrace),
-	); err != nil {
-		log.Info(ctx, "could not inject context into GlobalTracer", zap.Error(err))
-	}
 	if _, err := col.NewSTM(ctx, c, func(stm col.STM) error {
 		tracesCol := TracesCol(c).ReadWrite(stm)
 		return errors.EnsureStack(tracesCol.PutTTL(pipeline.String(), traceProto, int64(duration.Seconds())))
@@ -116,9 +110,9 @@ func (t *TraceProto) isValid() bool {
 // 'pipeline', and if any such trace exists, it creates a new span associated
 // with that trace and returns it
 func AddSpanToAnyPipelineTrace(ctx context.Context, c *etcd.Client,
-	pipeline *pps.Pipeline, operation string, kvs ...interface{}) (opentracing.Span, context.Context) {
+	pipeline *pps.Pipeline, operation string, kvs ...interface{}) (trace.Span, context.Context) {
 	if !tracing.IsActive() {
-		return nil, ctx // no Jaeger instance to send trace info to
+		return nil, ctx // no collector to send trace info to
 	}
 
 	traceProto := &TraceProto{}
@@ -133,21 +127,9 @@ func AddSpanToAnyPipelineTrace(ctx context.Context, c *etcd.Client,
 		return nil, ctx // no trace found
 	}
 
-	// Deserialize opentracing span from 'traceProto'
-	spanCtx, err := opentracing.GlobalTracer().Extract(opentracing.TextMap,
-		opentracing.TextMapCarrier(traceProto.SerializedTrace))
-	if err != nil {
-		log.Error(ctx, "could not extract span context from ExtendedTrace proto", zap.Error(err))
-		return nil, ctx
-	}
-
-	// return new span
-	span, ctx := opentracing.StartSpanFromContext(ctx,
-		operation, opentracing.FollowsFrom(spanCtx),
-		opentracing.Tag{Key: "project", Value: pipeline.Project.GetName()},
-		opentracing.Tag{Key: "pipeline", Value: pipeline.Name})
-	tracing.TagAnySpan(span, kvs...)
-	return span, ctx
+	// Deserialize the span context from 'traceProto' and return a new span
+	return tracing.AddSpanToAnyExisting(tracing.Extract(ctx, traceProto.SerializedTrace), operation,
+		append([]interface{}{"project", pipeline.Project.GetName(), "pipeline", pipeline.Name}, kvs...)...)
 }
 
 // EmbedAnyDuration augments 'ctx' (and returns a new ctx) based on whether
@@ -20,7 +20,7 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// TraceProto contains information identifying a Jaeger trace. It's used to
+// TraceProto contains information identifying an OpenTelemetry trace. It's used to
 // propagate traces that follow the lifetime of a long operation (e.g. creating
 // a pipeline or running a job), and which live longer than any single RPC.
 type TraceProto struct {
@@ -28,8 +28,8 @@ type TraceProto struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// serialized_trace contains the info identifying a trace in Jaeger (a
-	// (trace ID, span ID, sampled) tuple, basically)
+	// serialized_trace contains the W3C trace context identifying a trace (the
+	// 'traceparent' and 'tracestate' headers, basically)
 	SerializedTrace map[string]string `protobuf:"bytes,1,rep,name=serialized_trace,json=serializedTrace,proto3" json:"serialized_trace,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Project         string            `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
 	// pipeline specifies the target pipeline of this trace; this would be set for
@@ -3,227 +3,337 @@ package tracing
 import (
 	"context"
 	"fmt"
-	"io"
 	"os"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
-	otgrpc "github.com/opentracing-contrib/go-grpc"
-	opentracing "github.com/opentracing/opentracing-go"
-	"github.com/pachyderm/pachyderm/v2/src/internal/log"
-	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
-	"github.com/uber/jaeger-client-go"
-	jaegercfg "github.com/uber/jaeger-client-go/config"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
 )
 
-// JaegerServiceName is the name pachd (and the pachyderm client) uses to
-// describe itself when it reports traces to Jaeger
-const JaegerServiceName = "pachd"
+// If an OpenTelemetry collector is deployed and one of these environment
+// variables is set to the address of its OTLP endpoint, pachyderm binaries will
+// export traces to it.  The exporters read the rest of their configuration
+// (headers, TLS, timeouts) from the standard OTEL_EXPORTER_OTLP_* environment
+// variables.
+const (
+	otlpEndpointEnvVar       = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	otlpTracesEndpointEnvVar = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+)
 
-// If you have Jaeger deployed and the JAEGER_ENDPOINT environment variable set
-// to the address of your Jaeger instance's HTTP collection API, setting this
-// environment variable to "true" will cause pachyderm to attach a Jaeger trace
-// to any RPCs that it sends (this is primarily intended to be set in pachctl
-// though any binary that includes our go client library will be able to use
-// this env var)
+// otlpProtocolEnvVar and otlpTracesProtocolEnvVar select the OTLP transport:
+// "grpc" (the default) or "http/protobuf".
+const (
+	otlpProtocolEnvVar       = "OTEL_EXPORTER_OTLP_PROTOCOL"
+	otlpTracesProtocolEnvVar = "OTEL_EXPORTER_OTLP_TRACES_PROTOCOL"
+)
+
+// serviceNameEnvVar overrides the service name that a binary reports with its
+// traces.
+const serviceNameEnvVar = "OTEL_SERVICE_NAME"
+
+// ShortTraceEnvVar is what the client reads to decide whether to start a
+// trace.  If it's unset, spans are only recorded when they belong to an
+// existing, sampled trace (see InstallTracerFromEnv).
 //
 // Note that tracing calls can slow them down somewhat and make interesting
-// traces hard to find in Jaeger, so you may not want this variable set for
-// every call.
-const jaegerEndpointEnvVar = "JAEGER_ENDPOINT"
-
-// ShortTraceEnvVar is what the client reads to decide whether to send a trace.
-// Below, this is implemented by the span inclusion func
-// addTraceIfTracingEnabled() (which is itself used by the GRPC interceptor)
+// traces hard to find, so you may not want this variable set for every call.
 const ShortTraceEnvVar = "PACH_TRACE"
 
-// jaegerOnce is used to ensure that the Jaeger tracer is only initialized once
-var jaegerOnce sync.Once
+// TraceparentEnvVar and TracestateEnvVar carry the W3C trace context of a
+// datum into user code, so that it can add child spans to the job's trace.
+const (
+	TraceparentEnvVar = "TRACEPARENT"
+	TracestateEnvVar  = "TRACESTATE"
+)
+
+// tracerOnce is used to ensure that the tracer provider is only installed once
+var tracerOnce sync.Once
+
+// otlpEndpoint is set using tracerOnce on startup, and then returned by future
+// calls to InstallTracerFromEnv
+var otlpEndpoint string
 
-// jaegerEndpoint is set using jaegerOnce on startup, and then returned by
-// future calls to InstallJaegerTracerFromEnv
-var jaegerEndpoint string
+// provider is the installed tracer provider, or nil if tracing is inactive.
+var provider atomic.Pointer[sdktrace.TracerProvider]
+
+func init() {
+	// Always propagate W3C trace context, even if this process isn't
+	// exporting spans itself, so that traces pass through it intact.
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{}))
+}
+
+func tracer() trace.Tracer {
+	return otel.Tracer("github.com/pachyderm/pachyderm/v2/src/internal/tracing")
+}
 
 // TagAnySpan tags any span associated with 'spanBox' (which must be either a
 // span itself or a context.Context) with 'kvs'
-func TagAnySpan(spanBox interface{}, kvs ...interface{}) opentracing.Span {
+func TagAnySpan(spanBox interface{}, kvs ...interface{}) trace.Span {
 	if spanBox == nil {
 		return nil
 	}
 
 	// extract span from 'spanBox'
-	var span opentracing.Span
+	var span trace.Span
 	switch v := spanBox.(type) {
-	case opentracing.Span:
+	case trace.Span:
 		span = v
 	case context.Context:
-		span = opentracing.SpanFromContext(v) // may return nil
+		span = trace.SpanFromContext(v)
 	default:
 		log.Error(pctx.TODO(), "invalid type passed to TagAnySpan", zap.Any("value", spanBox))
 	}
-	if span == nil {
+	if span == nil || !span.IsRecording() {
 		return nil
 	}
 
 	// tag 'span'
 	for i := 0; i < len(kvs); i += 2 {
 		if len(kvs) == i+1 {
-			span = span.SetTag("extra", kvs[i]) // likely forgot key or value--best effort
+			setAttribute(span, "extra", kvs[i]) // likely forgot key or value--best effort
 			break
 		}
 		if key, ok := kvs[i].(string); ok {
-			span = span.SetTag(key, kvs[i+1]) // common case -- skip printf
+			setAttribute(span, key, kvs[i+1]) // common case -- skip printf
 		} else {
-			span = span.SetTag(fmt.Sprintf("%v", kvs[i]), kvs[i+1])
+			setAttribute(span, fmt.Sprintf("%v", kvs[i]), kvs[i+1])
 		}
 	}
 	return span
 }
 
-// AddSpanToAnyExisting checks 'ctx' for Jaeger tracing information, and if
-// tracing metadata is present, it generates a new span for 'operation', marks
-// it as a child of the existing span, and returns it.
-func AddSpanToAnyExisting(ctx context.Context, operation string, kvs ...interface{}) (opentracing.Span, context.Context) {
-	if parentSpan := opentracing.SpanFromContext(ctx); parentSpan != nil {
-		span := opentracing.StartSpan(operation, opentracing.ChildOf(parentSpan.Context()))
-		span = TagAnySpan(span, kvs...)
-		return span, opentracing.ContextWithSpan(ctx, span)
+// setAttribute sets the attribute 'key' of 'span' to 'value'.  Errors are also
+// recorded as span events and mark the span as failed.
+func setAttribute(span trace.Span, key string, value interface{}) {
+	switch v := value.(type) {
+	case nil:
+		return
+	case string:
+		span.SetAttributes(attribute.String(key, v))
+	case bool:
+		span.SetAttributes(attribute.Bool(key, v))
+	case int:
+		span.SetAttributes(attribute.Int(key, v))
+	case int32:
+		span.SetAttributes(attribute.Int64(key, int64(v)))
+	case int64:
+		span.SetAttributes(attribute.Int64(key, v))
+	case uint32:
+		span.SetAttributes(attribute.Int64(key, int64(v)))
+	case float64:
+		span.SetAttributes(attribute.Float64(key, v))
+	case error:
+		span.SetAttributes(attribute.String(key, v.Error()))
+		span.RecordError(v)
+		span.SetStatus(codes.Error, v.Error())
+	default:
+		span.SetAttributes(attribute.String(key, fmt.Sprint(v)))
+	}
+}
+
+// AddSpanToAnyExisting checks 'ctx' for tracing information, and if tracing
+// metadata is present, it generates a new span for 'operation', marks it as a
+// child of the existing span, and returns it.
+func AddSpanToAnyExisting(ctx context.Context, operation string, kvs ...interface{}) (trace.Span, context.Context) {
+	if parentSpan := trace.SpanFromContext(ctx); parentSpan.SpanContext().IsValid() {
+		ctx, span := tracer().Start(ctx, operation)
+		TagAnySpan(span, kvs...)
+		return span, ctx
 	}
 	return nil, ctx
 }
 
-// FinishAnySpan calls span.Finish() if span is not nil. Pairs with
+// FinishAnySpan calls span.End() if span is not nil. Pairs with
 // AddSpanToAnyExisting
-func FinishAnySpan(span opentracing.Span, kvs ...interface{}) {
-	span = TagAnySpan(span, kvs...)
+func FinishAnySpan(span trace.Span, kvs ...interface{}) {
+	TagAnySpan(span, kvs...)
 	if span != nil {
-		span.Finish()
+		span.End()
 	}
 }
 
-// InstallJaegerTracerFromEnv installs a Jaeger client as the opentracing global
-// tracer, relying on environment variables to configure the client
-func InstallJaegerTracerFromEnv() string {
-	jaegerOnce.Do(func() {
-		var onUserMachine bool
-		jaegerEndpoint, onUserMachine = os.LookupEnv(jaegerEndpointEnvVar)
-		if !onUserMachine {
-			if host, ok := os.LookupEnv("JAEGER_COLLECTOR_SERVICE_HOST"); ok {
-				port := os.Getenv("JAEGER_COLLECTOR_SERVICE_PORT_JAEGER_COLLECTOR_HTTP")
-				jaegerEndpoint = fmt.Sprintf("%s:%s", host, port)
-			}
-		}
-		if jaegerEndpoint == "" {
-			return // break early -- not using Jaeger
-		}
+// Inject returns the W3C trace context of the span in 'ctx', or nil if 'ctx'
+// has no span.  The result can be stored and passed to Extract later, to
+// continue the trace beyond the lifetime of the current RPC.
+func Inject(ctx context.Context) map[string]string {
+	if !trace.SpanFromContext(ctx).SpanContext().IsValid() {
+		return nil
+	}
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	return carrier
+}
 
-		// canonicalize jaegerEndpoint as http://<hostport>/api/traces
-		jaegerEndpoint = strings.TrimPrefix(jaegerEndpoint, "http://")
-		jaegerEndpoint = strings.TrimSuffix(jaegerEndpoint, "/api/traces")
-		jaegerEndpoint = fmt.Sprintf("http://%s/api/traces", jaegerEndpoint)
-		cfg := jaegercfg.Configuration{
-			ServiceName: JaegerServiceName,
-			// Configure Jaeger to sample every call, but use the SpanInclusionFunc
-			// addTraceIfTracingEnabled (defined below) to skip sampling every RPC
-			// unless the PACH_TRACE environment variable is set
-			Sampler: &jaegercfg.SamplerConfig{
-				Type:  "const",
-				Param: 1,
-			},
-			Reporter: &jaegercfg.ReporterConfig{
-				LogSpans:            true,
-				BufferFlushInterval: 1 * time.Second,
-				CollectorEndpoint:   jaegerEndpoint,
-			},
-		}
+// Extract returns a copy of 'ctx' carrying the trace context in 'carrier',
+// which was produced by Inject.  Spans started from the result with
+// AddSpanToAnyExisting are part of the injected trace.
+func Extract(ctx context.Context, carrier map[string]string) context.Context {
+	if len(carrier) == 0 {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(carrier))
+}
 
-		// configure jaeger logger
-		logger := jaeger.Logger(jaeger.NullLogger)
-		if !onUserMachine {
-			logger = jaeger.StdLogger
+// Environ returns environment variables carrying the W3C trace context of the
+// span in 'ctx' (in the form "KEY=value"), for passing the trace to a child
+// process.
+func Environ(ctx context.Context) []string {
+	var env []string
+	carrier := Inject(ctx)
+	if traceparent, ok := carrier["traceparent"]; ok {
+		env = append(env, TraceparentEnvVar+"="+traceparent)
+	}
+	if tracestate, ok := carrier["tracestate"]; ok && tracestate != "" {
+		env = append(env, TracestateEnvVar+"="+tracestate)
+	}
+	return env
+}
+
+// KubernetesEnvironment returns this process's OTLP exporter configuration as
+// Kubernetes environment variables, so that pods started by pachd (such as
+// workers) export their traces to the same collector.
+func KubernetesEnvironment() []v1.EnvVar {
+	var result []v1.EnvVar
+	for _, kv := range os.Environ() {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok || !strings.HasPrefix(k, "OTEL_") || k == serviceNameEnvVar {
+			continue
 		}
+		result = append(result, v1.EnvVar{Name: k, Value: v})
+	}
+	return result
+}
 
-		// Hack: ignore second argument (io.Closer) because the Jaeger
-		// implementation of opentracing.Tracer also implements io.Closer (i.e. the
-		// first and second return values from cfg.New(), here, are two interfaces
-		// that wrap the same underlying type). Instead of storing the second return
-		// value here, just cast the tracer to io.Closer in CloseAndReportTraces()
-		// (below) and call 'Close()' on it there.
-		tracer, _, err := cfg.NewTracer(jaegercfg.Logger(logger))
+// InstallTracerFromEnv installs an OTLP exporter as the OpenTelemetry global
+// tracer provider, relying on environment variables to configure the exporter,
+// and returns the endpoint that traces are exported to (or "" if tracing is
+// not configured).  'serviceName' is the name that this binary reports with
+// its traces, unless it's overridden by OTEL_SERVICE_NAME.
+func InstallTracerFromEnv(serviceName string) string {
+	tracerOnce.Do(func() {
+		ctx := pctx.TODO()
+		otlpEndpoint = os.Getenv(otlpTracesEndpointEnvVar)
+		if otlpEndpoint == "" {
+			otlpEndpoint = os.Getenv(otlpEndpointEnvVar)
+		}
+		if otlpEndpoint == "" {
+			if _, ok := os.LookupEnv(ShortTraceEnvVar); ok {
+				log.Error(ctx, "PACH_TRACE is set, indicating tracing is requested, but no OTLP endpoint is configured", zap.String("envVar", otlpEndpointEnvVar))
+			}
+			return // break early -- not tracing
+		}
+		exporter, err := newExporter(ctx)
 		if err != nil {
-			log.Error(pctx.TODO(), "jaeger-collector service is deployed, but Pachyderm could not install Jaeger tracer", zap.Error(err))
+			log.Error(ctx, "OTLP endpoint is configured, but Pachyderm could not create a trace exporter", zap.Error(err))
+			otlpEndpoint = ""
 			return
 		}
-		opentracing.SetGlobalTracer(tracer)
-		log.Info(pctx.TODO(), "jaeger setup ok")
+		res, err := resource.New(ctx,
+			resource.WithAttributes(semconv.ServiceNameKey.String(serviceName)),
+			resource.WithFromEnv(),
+		)
+		if err != nil {
+			log.Error(ctx, "could not build tracing resource", zap.Error(err))
+		}
+		install(sdktrace.NewTracerProvider(
+			sdktrace.WithBatcher(exporter, sdktrace.WithBatchTimeout(time.Second)),
+			sdktrace.WithResource(res),
+			sdktrace.WithSampler(sampler()),
+		))
+		log.Info(ctx, "OTLP tracing setup ok", zap.String("endpoint", otlpEndpoint))
 	})
-	return jaegerEndpoint
+	return otlpEndpoint
 }
 
-// addTraceIfTracingEnabled is an otgrpc span inclusion func that propagates
-// existing traces, but won't start any new ones
-func addTraceIfTracingEnabled(
-	parentSpanCtx opentracing.SpanContext,
-	method string,
-	req, resp interface{}) bool {
-	// Always trace if PACH_TRACE is on
-	if _, shortTracingOn := os.LookupEnv(ShortTraceEnvVar); shortTracingOn {
-		if !IsActive() {
-			log.Error(pctx.TODO(), "PACH_TRACE is set, indicating tracing is requested, but no connection to Jaeger has been established")
-		}
-		return true
+// newExporter creates an OTLP span exporter using the transport selected by
+// the environment.
+func newExporter(ctx context.Context) (sdktrace.SpanExporter, error) {
+	protocol := os.Getenv(otlpTracesProtocolEnvVar)
+	if protocol == "" {
+		protocol = os.Getenv(otlpProtocolEnvVar)
 	}
-
-	// Otherwise, only propagate an existing trace
-	if parentSpanCtx == nil {
-		return false
+	switch protocol {
+	case "", "grpc":
+		exporter, err := otlptracegrpc.New(ctx)
+		return exporter, errors.EnsureStack(err)
+	case "http/protobuf":
+		exporter, err := otlptracehttp.New(ctx)
+		return exporter, errors.EnsureStack(err)
+	default:
+		return nil, errors.Errorf("unsupported OTLP protocol %q (must be \"grpc\" or \"http/protobuf\")", protocol)
 	}
-	if jaegerCtx, ok := parentSpanCtx.(jaeger.SpanContext); ok {
-		return jaegerCtx.IsValid()
+}
+
+// sampler records spans that belong to an existing, sampled trace, and only
+// starts new traces if PACH_TRACE is set.
+func sampler() sdktrace.Sampler {
+	root := sdktrace.NeverSample()
+	if _, shortTracingOn := os.LookupEnv(ShortTraceEnvVar); shortTracingOn {
+		root = sdktrace.AlwaysSample()
 	}
-	// Non-Jaeger context. This shouldn't happen, unless some Pachyderm user is
-	// propagating e.g. Zipkin traces through the Pachyderm client. In that
-	// case, we wouldn't know where to report traces anyway
-	return false
+	return sdktrace.ParentBased(root)
 }
 
-// IsActive returns true if a connection to Jaeger has been established and a
-// global tracer has been installed
+// install makes 'tp' the global tracer provider.
+func install(tp *sdktrace.TracerProvider) {
+	otel.SetTracerProvider(tp)
+	provider.Store(tp)
+}
+
+// IsActive returns true if an OTLP exporter has been configured and a global
+// tracer provider has been installed
 func IsActive() bool {
-	return opentracing.IsGlobalTracerRegistered()
+	return provider.Load() != nil
 }
 
 // UnaryClientInterceptor returns a GRPC interceptor for non-streaming GRPC RPCs
 func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
-	return otgrpc.OpenTracingClientInterceptor(opentracing.GlobalTracer(),
-		otgrpc.IncludingSpans(otgrpc.SpanInclusionFunc(addTraceIfTracingEnabled)))
+	return otelgrpc.UnaryClientInterceptor()
 }
 
-// StreamClientInterceptor returns a GRPC interceptor for non-streaming GRPC RPCs
+// StreamClientInterceptor returns a GRPC interceptor for streaming GRPC RPCs
 func StreamClientInterceptor() grpc.StreamClientInterceptor {
-	return otgrpc.OpenTracingStreamClientInterceptor(opentracing.GlobalTracer(),
-		otgrpc.IncludingSpans(otgrpc.SpanInclusionFunc(addTraceIfTracingEnabled)))
+	return otelgrpc.StreamClientInterceptor()
 }
 
 // UnaryServerInterceptor returns a GRPC interceptor for non-streaming GRPC RPCs
 func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
-	return otgrpc.OpenTracingServerInterceptor(opentracing.GlobalTracer(),
-		otgrpc.IncludingSpans(otgrpc.SpanInclusionFunc(addTraceIfTracingEnabled)))
+	return otelgrpc.UnaryServerInterceptor()
 }
 
-// StreamServerInterceptor returns a GRPC interceptor for non-streaming GRPC RPCs
+// StreamServerInterceptor returns a GRPC interceptor for streaming GRPC RPCs
 func StreamServerInterceptor() grpc.StreamServerInterceptor {
-	return otgrpc.OpenTracingStreamServerInterceptor(opentracing.GlobalTracer(),
-		otgrpc.IncludingSpans(otgrpc.SpanInclusionFunc(addTraceIfTracingEnabled)))
+	return otelgrpc.StreamServerInterceptor()
 }
 
-// CloseAndReportTraces tries to close the global tracer, which, in the case of
-// the Jaeger tracer, causes it to send any unreported traces to the collector
+// CloseAndReportTraces shuts down the global tracer provider, which causes it
+// to export any unreported spans to the collector
 func CloseAndReportTraces() {
-	if c, ok := opentracing.GlobalTracer().(io.Closer); ok {
-		c.Close()
+	if tp := provider.Load(); tp != nil {
+		ctx, cancel := context.WithTimeout(pctx.TODO(), 5*time.Second)
+		defer cancel()
+		if err := tp.Shutdown(ctx); err != nil {
+			log.Error(ctx, "could not report traces", zap.Error(err))
+		}
 	}
 }
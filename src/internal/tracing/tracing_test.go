@@ -0,0 +1,61 @@
+package tracing
+
+import (
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestPropagation(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	install(sdktrace.NewTracerProvider(
+		sdktrace.WithSyncer(exporter),
+		sdktrace.WithSampler(sdktrace.AlwaysSample()),
+	))
+	require.True(t, IsActive())
+	ctx := pctx.TestContext(t)
+
+	// Without an existing trace, no span is started.
+	span, _ := AddSpanToAnyExisting(ctx, "/test/Untraced")
+	require.Nil(t, span)
+	require.Nil(t, Inject(ctx))
+	require.Len(t, Environ(ctx), 0)
+
+	ctx, root := tracer().Start(ctx, "/test/Root")
+	traceID := root.SpanContext().TraceID().String()
+
+	// The trace context survives serialization, e.g. through a job's info.
+	carrier := Inject(ctx)
+	require.True(t, strings.Contains(carrier["traceparent"], traceID))
+	jobCtx := Extract(pctx.TestContext(t), carrier)
+	span, datumCtx := AddSpanToAnyExisting(jobCtx, "/test/ProcessDatum", "datum", "abc", "count", 3)
+	require.NotNil(t, span)
+	require.Equal(t, traceID, span.SpanContext().TraceID().String())
+
+	// User code receives the datum span's context.
+	env := Environ(datumCtx)
+	require.Len(t, env, 1)
+	require.True(t, strings.HasPrefix(env[0], TraceparentEnvVar+"=00-"+traceID+"-"+span.SpanContext().SpanID().String()))
+
+	FinishAnySpan(span, "err", errors.New("datum failed"))
+	root.End()
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	require.Equal(t, "/test/ProcessDatum", spans[0].Name)
+	require.Equal(t, root.SpanContext().SpanID(), spans[0].Parent.SpanID())
+	require.Equal(t, codes.Error, spans[0].Status.Code)
+	attrs := make(map[string]string)
+	for _, kv := range spans[0].Attributes {
+		attrs[string(kv.Key)] = kv.Value.Emit()
+	}
+	require.Equal(t, "abc", attrs["datum"])
+	require.Equal(t, "3", attrs["count"])
+	require.Equal(t, "datum failed", attrs["err"])
+}
@@ -20,6 +20,7 @@ import (
 // objects, which will be threaded through to every API call:
 type TransactionContext struct {
 	username string
+	scopes   []*auth.TokenScope
 	// SqlTx is the ongoing database transaction.
 	SqlTx *pachsql.Tx
 	// CommitSetID is the ID of the CommitSet corresponding to PFS changes in this transaction.
@@ -54,12 +55,14 @@ type identifier interface {
 
 func New(ctx context.Context, sqlTx *pachsql.Tx, authServer identifier) (*TransactionContext, error) {
 	var username string
+	var scopes []*auth.TokenScope
 	// check auth once now so that we can refer to it later
 	if authServer != nil {
 		if me, err := authServer.WhoAmI(ctx, &auth.WhoAmIRequest{}); err != nil && !auth.IsErrNotActivated(err) {
 			return nil, errors.EnsureStack(err)
 		} else if err == nil {
 			username = me.Username
+			scopes = me.Scopes
 		}
 	}
 	var currTime time.Time
@@ -72,6 +75,7 @@ func New(ctx context.Context, sqlTx *pachsql.Tx, authServer identifier) (*Transa
 		CommitSetID: uuid.NewWithoutDashes(),
 		Timestamp:   timestamppb.New(currTime),
 		username:    username,
+		scopes:      scopes,
 	}, nil
 }
 
@@ -79,7 +83,7 @@ func (t *TransactionContext) WhoAmI() (*auth.WhoAmIResponse, error) {
 	if t.username == "" {
 		return nil, auth.ErrNotActivated
 	}
-	return &auth.WhoAmIResponse{Username: t.username}, nil
+	return &auth.WhoAmIResponse{Username: t.username, Scopes: t.scopes}, nil
 }
 
 // PropagateJobs notifies PPS that there are new commits in the transaction's
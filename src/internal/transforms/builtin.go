@@ -0,0 +1,229 @@
+package transforms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+// A Builtin is a transform built into Pachyderm, which a pipeline can select by
+// name in place of running a user's code.  Builtins read every file of the
+// datum's inputs and write their results to the same relative paths (or ones
+// derived from them) in the output directory.
+type Builtin struct {
+	Name        string
+	Description string
+	transform   builtinTransform
+}
+
+// builtinTransform is the implementation of a Builtin, with its args decoded.
+type builtinTransform interface {
+	validate() error
+	run(ctx context.Context, files []inputFile, outputDir string) error
+}
+
+// inputFile is a file of one of the inputs of a datum.
+type inputFile struct {
+	// Path is where the file is on disk.
+	Path string
+	// Rel is the path of the file relative to its input's directory.
+	Rel string
+}
+
+type builtinSpec struct {
+	description string
+	// new returns a pointer to the zero value of the transform's args, into
+	// which they're decoded.
+	new func() builtinTransform
+}
+
+var builtins = map[string]builtinSpec{}
+
+func registerBuiltin(name, description string, new func() builtinTransform) {
+	if _, ok := builtins[name]; ok {
+		panic("builtin transform " + name + " registered twice")
+	}
+	builtins[name] = builtinSpec{description: description, new: new}
+}
+
+// Builtins returns the names and descriptions of all the builtin transforms,
+// sorted by name.
+func Builtins() []Builtin {
+	var result []Builtin
+	for name, spec := range builtins {
+		result = append(result, Builtin{Name: name, Description: spec.description})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// ParseBuiltin returns the builtin transform called name, configured by args,
+// which are a JSON object (or empty, for the defaults).  Unknown args are an
+// error.
+func ParseBuiltin(name string, args []byte) (*Builtin, error) {
+	spec, ok := builtins[name]
+	if !ok {
+		var names []string
+		for _, b := range Builtins() {
+			names = append(names, b.Name)
+		}
+		return nil, errors.Errorf("unknown builtin transform %q (available: %s)", name, strings.Join(names, ", "))
+	}
+	t := spec.new()
+	if len(bytes.TrimSpace(args)) > 0 {
+		dec := json.NewDecoder(bytes.NewReader(args))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(t); err != nil {
+			return nil, errors.Wrapf(err, "parse args of builtin transform %q", name)
+		}
+	}
+	if err := t.validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid args for builtin transform %q", name)
+	}
+	return &Builtin{Name: name, Description: spec.description, transform: t}, nil
+}
+
+// Run runs the transform on the files under inputDirs, writing to outputDir.
+func (b *Builtin) Run(ctx context.Context, inputDirs []string, outputDir string) error {
+	var files []inputFile
+	for _, dir := range inputDirs {
+		dir, err := filepath.EvalSymlinks(dir)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		if err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.Type().IsRegular() {
+				return nil
+			}
+			rel, err := filepath.Rel(dir, p)
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			files = append(files, inputFile{Path: p, Rel: filepath.ToSlash(rel)})
+			return nil
+		}); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	sort.SliceStable(files, func(i, j int) bool { return files[i].Rel < files[j].Rel })
+	return errors.Wrapf(b.transform.run(ctx, files, outputDir), "builtin transform %q", b.Name)
+}
+
+// ParseBuiltinSpec returns the builtin transform selected by a pipeline's
+// transform.
+func ParseBuiltinSpec(spec *pps.BuiltinTransform) (*Builtin, error) {
+	var args []byte
+	if spec.Args != nil {
+		var err error
+		if args, err = protojson.Marshal(spec.Args); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+	}
+	return ParseBuiltin(spec.Name, args)
+}
+
+// RunBuiltin runs the builtin transform selected by spec on the datum in
+// pfsDir, which is laid out like a worker's /pfs.
+func RunBuiltin(ctx context.Context, spec *pps.BuiltinTransform, pfsDir string) error {
+	b, err := ParseBuiltinSpec(spec)
+	if err != nil {
+		return err
+	}
+	inputDirs, err := InputDirs(pfsDir)
+	if err != nil {
+		return err
+	}
+	return b.Run(ctx, inputDirs, filepath.Join(pfsDir, "out"))
+}
+
+// InputDirs returns the directories of a datum's inputs in pfsDir, which is
+// laid out like a worker's /pfs: every entry except the output directory,
+// "out".
+func InputDirs(pfsDir string) ([]string, error) {
+	entries, err := os.ReadDir(pfsDir)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	var dirs []string
+	for _, entry := range entries {
+		if entry.Name() == "out" {
+			continue
+		}
+		dirs = append(dirs, filepath.Join(pfsDir, entry.Name()))
+	}
+	return dirs, nil
+}
+
+// createOutput creates the file at the slash separated path rel in outputDir,
+// and its parent directories.  rel is cleaned as if it were rooted at
+// outputDir, so it can't refer to anything outside of it.
+func createOutput(outputDir, rel string) (*os.File, error) {
+	clean := pathClean(rel)
+	if clean == "" {
+		return nil, errors.Errorf("invalid output path %q", rel)
+	}
+	p := filepath.Join(outputDir, filepath.FromSlash(clean))
+	if err := os.MkdirAll(filepath.Dir(p), 0777); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	f, err := os.OpenFile(p, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0666)
+	return f, errors.EnsureStack(err)
+}
+
+// writeOutput creates the file at rel in outputDir, calls f to write its
+// content, and closes it.
+func writeOutput(outputDir, rel string, f func(w *os.File) error) (retErr error) {
+	w, err := createOutput(outputDir, rel)
+	if err != nil {
+		return err
+	}
+	defer errors.Close(&retErr, w, "close %v", rel)
+	return f(w)
+}
+
+// readInput opens an input file, calls f with it, and closes it.
+func readInput(file inputFile, f func(r *os.File) error) (retErr error) {
+	r, err := os.Open(file.Path)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer errors.Close(&retErr, r, "close %v", file.Rel)
+	return errors.Wrapf(f(r), "process %v", file.Rel)
+}
+
+// pathClean cleans the slash separated path p as if it were rooted, and
+// returns it without the leading slash.  The result never starts with "..".
+func pathClean(p string) string {
+	return strings.TrimPrefix(path.Clean("/"+p), "/")
+}
+
+// withSuffix returns rel with suffix inserted before its extension, as in
+// "a/b.csv" -> "a/b-00001.csv".
+func withSuffix(rel, suffix string) string {
+	ext := extension(rel)
+	return strings.TrimSuffix(rel, ext) + suffix + ext
+}
+
+// extension returns the extension of the last element of rel, including the
+// leading dot, or "" if it has none.
+func extension(rel string) string {
+	base := rel[strings.LastIndex(rel, "/")+1:]
+	if i := strings.LastIndex(base, "."); i > 0 {
+		return base[i:]
+	}
+	return ""
+}
@@ -0,0 +1,201 @@
+package transforms
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/stretchr/testify/require"
+)
+
+// runBuiltin runs the builtin transform called name with args on an input
+// holding files, and returns the files it outputs.
+func runBuiltin(t *testing.T, name, args string, files map[string]string) map[string]string {
+	t.Helper()
+	b, err := ParseBuiltin(name, []byte(args))
+	require.NoError(t, err)
+	inputDir, outputDir := t.TempDir(), t.TempDir()
+	for p, content := range files {
+		p = filepath.Join(inputDir, filepath.FromSlash(p))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0777))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0666))
+	}
+	require.NoError(t, b.Run(pctx.TestContext(t), []string{inputDir}, outputDir))
+	return readTree(t, outputDir)
+}
+
+func readTree(t *testing.T, dir string) map[string]string {
+	t.Helper()
+	result := make(map[string]string)
+	require.NoError(t, filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		content, err := os.ReadFile(p)
+		result[filepath.ToSlash(rel)] = string(content)
+		return err
+	}))
+	return result
+}
+
+func TestParseBuiltin(t *testing.T) {
+	var names []string
+	for _, b := range Builtins() {
+		names = append(names, b.Name)
+		require.NotEmpty(t, b.Description)
+	}
+	require.True(t, sort.StringsAreSorted(names))
+	require.Contains(t, names, "csv_to_parquet")
+
+	for _, tc := range []struct{ name, args string }{
+		{"no_such_transform", ""},
+		{"split", ""},
+		{"split", `{"lines": 1, "bytes": 1}`},
+		{"split", `{"line": 1}`},
+		{"merge", `{"output": "/"}`},
+		{"csv_to_json", `{"types": {"a": "decimal"}}`},
+		{"parquet_to_csv", `{"types": {"a": "int64"}}`},
+		{"validate_json", `{}`},
+		{"validate_json", `{"schema": {"type": "object"}, "on_invalid": "ignore"}`},
+		{"validate_json", `{"schema": {"$ref": "http://example.com/schema.json"}}`},
+	} {
+		_, err := ParseBuiltin(tc.name, []byte(tc.args))
+		require.Error(t, err, "%s %s", tc.name, tc.args)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	csv := "name,count,ok\na,1,true\nb,,false\n"
+	out := runBuiltin(t, "csv_to_json", `{"types": {"count": "int64", "ok": "bool"}}`, map[string]string{"dir/in.csv": csv, "empty.csv": ""})
+	require.Equal(t, map[string]string{
+		"dir/in.json": `{"count":1,"name":"a","ok":true}` + "\n" + `{"count":null,"name":"b","ok":false}` + "\n",
+	}, out)
+
+	// CSV -> Parquet -> CSV round trips, selecting and reordering columns on
+	// the way.
+	out = runBuiltin(t, "csv_to_parquet", `{"fields": ["ok", "name"], "types": {"ok": "bool"}}`, map[string]string{"in.csv": csv})
+	require.Len(t, out, 1)
+	out = runBuiltin(t, "parquet_to_csv", "", map[string]string{"in.parquet": out["in.parquet"]})
+	require.Equal(t, map[string]string{"in.csv": "ok,name\ntrue,a\nfalse,b\n"}, out)
+
+	out = runBuiltin(t, "json_to_csv", `{"header": false}`, map[string]string{"in.jsonl": `{"b": 1.5, "a": "x"}` + "\n" + `{"a": "y"}` + "\n"})
+	require.Equal(t, map[string]string{"in.csv": "1.5,x\n,y\n"}, out)
+
+	out = runBuiltin(t, "csv_to_json", `{"header": false}`, map[string]string{"in.csv": "x,y\n"})
+	require.Equal(t, map[string]string{"in.json": `{"column_1":"x","column_2":"y"}` + "\n"}, out)
+}
+
+func TestSplitMerge(t *testing.T) {
+	out := runBuiltin(t, "split", `{"lines": 2, "header": true}`, map[string]string{"a.csv": "h\n1\n2\n3"})
+	require.Equal(t, map[string]string{
+		"a-00000.csv": "h\n1\n2\n",
+		"a-00001.csv": "h\n3",
+	}, out)
+
+	out = runBuiltin(t, "split", `{"bytes": 4}`, map[string]string{"a": "1\n2\n333333\n4\n"})
+	require.Equal(t, map[string]string{
+		"a-00000": "1\n2\n",
+		"a-00001": "333333\n",
+		"a-00002": "4\n",
+	}, out)
+
+	files := map[string]string{"a.csv": "h\n1\n2", "b.csv": "h\n3\n"}
+	out = runBuiltin(t, "merge", `{"output": "all.csv", "header": true}`, files)
+	require.Equal(t, map[string]string{"all.csv": "h\n1\n2\n3\n"}, out)
+	out = runBuiltin(t, "merge", `{"output": "all.csv", "header": true, "lines": 2}`, files)
+	require.Equal(t, map[string]string{
+		"all-00000.csv": "h\n1\n2\n",
+		"all-00001.csv": "h\n3\n",
+	}, out)
+}
+
+func TestExtract(t *testing.T) {
+	var tarBuf bytes.Buffer
+	tw := tar.NewWriter(&tarBuf)
+	for name, content := range map[string]string{"x/a": "a", "../../escape": "e"} {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "link", Linkname: "/etc/passwd", Typeflag: tar.TypeSymlink}))
+	require.NoError(t, tw.Close())
+
+	var tgzBuf bytes.Buffer
+	gw := gzip.NewWriter(&tgzBuf)
+	_, err := gw.Write(tarBuf.Bytes())
+	require.NoError(t, err)
+	require.NoError(t, gw.Close())
+
+	var zipBuf bytes.Buffer
+	zw := zip.NewWriter(&zipBuf)
+	w, err := zw.Create("z/b")
+	require.NoError(t, err)
+	_, err = w.Write([]byte("b"))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	var gzBuf bytes.Buffer
+	gw = gzip.NewWriter(&gzBuf)
+	_, err = gw.Write([]byte("log"))
+	require.NoError(t, err)
+	require.NoError(t, gw.Close())
+
+	out := runBuiltin(t, "extract", "", map[string]string{
+		"a.tar":    tarBuf.String(),
+		"b.tgz":    tgzBuf.String(),
+		"c.zip":    zipBuf.String(),
+		"d.log.gz": gzBuf.String(),
+		"e.txt":    "e",
+	})
+	require.Equal(t, map[string]string{
+		"a/x/a":    "a",
+		"a/escape": "e",
+		"b/x/a":    "a",
+		"b/escape": "e",
+		"c/z/b":    "b",
+		"d.log":    "log",
+		"e.txt":    "e",
+	}, out)
+}
+
+func TestValidateJSON(t *testing.T) {
+	schema := `"schema": {"type": "object", "required": ["id"], "properties": {"id": {"type": "integer"}}}`
+	input := map[string]string{"in.json": "{\"id\": 1}\n{\"id\": \"x\"}\n{\n  \"id\": 2\n}\n"}
+
+	b, err := ParseBuiltin("validate_json", []byte("{"+schema+"}"))
+	require.NoError(t, err)
+	inputDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(inputDir, "in.json"), []byte(input["in.json"]), 0666))
+	err = b.Run(pctx.TestContext(t), []string{inputDir}, t.TempDir())
+	require.ErrorContains(t, err, "record 2 is invalid")
+
+	out := runBuiltin(t, "validate_json", `{`+schema+`, "on_invalid": "drop"}`, input)
+	require.Equal(t, map[string]string{"in.json": "{\"id\":1}\n{\"id\":2}\n"}, out)
+
+	out = runBuiltin(t, "validate_json", `{`+schema+`, "on_invalid": "separate", "invalid_dir": "bad"}`, input)
+	require.Equal(t, map[string]string{
+		"in.json":     "{\"id\":1}\n{\"id\":2}\n",
+		"bad/in.json": "{\"id\":\"x\"}\n",
+	}, out)
+}
+
+func TestDedup(t *testing.T) {
+	files := map[string]string{"a": "x\ny\nx\n", "b": "y\nz"}
+	out := runBuiltin(t, "dedup", "", files)
+	require.Equal(t, map[string]string{"a": "x\ny\n", "b": "y\nz\n"}, out)
+	out = runBuiltin(t, "dedup", `{"across_files": true}`, files)
+	require.Equal(t, map[string]string{"a": "x\ny\n", "b": "z\n"}, out)
+
+	out = runBuiltin(t, "dedup", `{"key": "id"}`, map[string]string{"a": `{"id": 1, "v": "a"}` + "\n" + `{"id": 1, "v": "b"}` + "\n" + `{"id": 2}`})
+	require.Equal(t, map[string]string{"a": `{"id": 1, "v": "a"}` + "\n" + `{"id": 2}` + "\n"}, out)
+}
@@ -0,0 +1,306 @@
+package transforms
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/sdata"
+	"github.com/pachyderm/pachyderm/v2/src/internal/sdata/csv"
+)
+
+// formatExtensions are the file extensions of each format that the convert
+// transforms support.  The first is used for output files.
+var formatExtensions = map[string][]string{
+	"csv":     {".csv"},
+	"json":    {".json", ".jsonl", ".ndjson"},
+	"parquet": {".parquet"},
+}
+
+// columnTypes are the values of the "types" arg of the convert transforms.
+var columnTypes = map[string]func() interface{}{
+	"bool":      func() interface{} { return new(sql.NullBool) },
+	"int64":     func() interface{} { return new(sql.NullInt64) },
+	"double":    func() interface{} { return new(sql.NullFloat64) },
+	"string":    func() interface{} { return new(sql.NullString) },
+	"timestamp": func() interface{} { return new(sql.NullTime) },
+}
+
+func init() {
+	for _, from := range []string{"csv", "json", "parquet"} {
+		for _, to := range []string{"csv", "json", "parquet"} {
+			if from == to {
+				continue
+			}
+			from, to := from, to
+			registerBuiltin(from+"_to_"+to,
+				fmt.Sprintf("Converts each %s file to %s.  Args: header (bool, default true: CSV files have a header row), fields (list of column names, to select and order columns), types (map of column name to bool, int64, double, string or timestamp).", strings.ToUpper(from), strings.ToUpper(to)),
+				func() builtinTransform { return &convertTransform{from: from, to: to} })
+		}
+	}
+}
+
+// convertTransform converts files between CSV, newline delimited JSON and
+// Parquet.  CSV columns are strings and JSON numbers are doubles, unless
+// Types says otherwise.  Empty input files produce no output.
+type convertTransform struct {
+	from, to string
+
+	// Header is whether CSV input has, and CSV output gets, a header row.
+	Header *bool `json:"header"`
+	// Fields selects and orders the columns.  By default, all the columns of
+	// the input are kept, in order; for JSON, that is the order of the fields
+	// of the first record.  Headerless CSV columns are named column_1,
+	// column_2, etc., unless Fields names every one of them.
+	Fields []string `json:"fields"`
+	// Types maps column names to types.
+	Types map[string]string `json:"types"`
+}
+
+func (t *convertTransform) validate() error {
+	seen := make(map[string]bool)
+	for _, f := range t.Fields {
+		if f == "" {
+			return errors.New("fields cannot be empty")
+		}
+		if seen[f] {
+			return errors.Errorf("duplicate field %q", f)
+		}
+		seen[f] = true
+	}
+	for field, typ := range t.Types {
+		if _, ok := columnTypes[typ]; !ok {
+			return errors.Errorf("field %q has unknown type %q", field, typ)
+		}
+		if len(t.Fields) > 0 && !seen[field] {
+			return errors.Errorf("types mentions %q, which isn't in fields", field)
+		}
+	}
+	if t.from == "parquet" && len(t.Types) > 0 {
+		return errors.New("types can't be set when converting from parquet, whose columns are already typed")
+	}
+	return nil
+}
+
+func (t *convertTransform) header() bool {
+	return t.Header == nil || *t.Header
+}
+
+func (t *convertTransform) run(ctx context.Context, files []inputFile, outputDir string) error {
+	for _, file := range files {
+		if err := readInput(file, func(r *os.File) error {
+			fields, row, tr, err := t.reader(r)
+			if errors.Is(err, io.EOF) {
+				return nil
+			} else if err != nil {
+				return err
+			}
+			return writeOutput(outputDir, t.outputPath(file.Rel), func(w *os.File) error {
+				tw, err := t.writer(w, fields, row)
+				if err != nil {
+					return err
+				}
+				_, err = sdata.Copy(tw, tr, row)
+				return errors.EnsureStack(err)
+			})
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// outputPath returns the path of the output of the input at rel: rel with
+// its extension replaced by that of the output format.
+func (t *convertTransform) outputPath(rel string) string {
+	ext := extension(rel)
+	for _, e := range formatExtensions[t.from] {
+		if strings.EqualFold(ext, e) {
+			rel = strings.TrimSuffix(rel, ext)
+			break
+		}
+	}
+	return rel + formatExtensions[t.to][0]
+}
+
+// reader returns the fields of the file, a tuple to read its rows into and a
+// reader for them, or io.EOF if the file is empty.
+func (t *convertTransform) reader(r *os.File) ([]string, sdata.Tuple, sdata.TupleReader, error) {
+	switch t.from {
+	case "csv":
+		names, err := t.csvFields(r)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if _, err := r.Seek(0, io.SeekStart); err != nil {
+			return nil, nil, nil, errors.EnsureStack(err)
+		}
+		p := sdata.NewCSVParser(r)
+		if !t.header() {
+			return names, t.newTuple(names, nil), p, nil
+		}
+		return t.project(names, t.newTuple(names, nil), p.WithHeaderFields(names))
+	case "json":
+		names, first, err := jsonFields(r)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if len(t.Fields) > 0 {
+			names = t.Fields
+		}
+		if _, err := r.Seek(0, io.SeekStart); err != nil {
+			return nil, nil, nil, errors.EnsureStack(err)
+		}
+		return names, t.newTuple(names, first), sdata.NewJSONParser(r, names), nil
+	case "parquet":
+		info, err := r.Stat()
+		if err != nil {
+			return nil, nil, nil, errors.EnsureStack(err)
+		}
+		p, err := sdata.NewParquetParser(r, info.Size())
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return t.project(p.FieldNames(), p.NewTuple(), p)
+	default:
+		return nil, nil, nil, errors.Errorf("unknown format %q", t.from)
+	}
+}
+
+// project returns the columns of Fields of the rows that tr reads into full,
+// whose fields are names.  If Fields isn't set, all of the columns are kept.
+func (t *convertTransform) project(names []string, full sdata.Tuple, tr sdata.TupleReader) ([]string, sdata.Tuple, sdata.TupleReader, error) {
+	if len(t.Fields) == 0 {
+		return names, full, tr, nil
+	}
+	// The projected tuple shares the elements of the full one, which tr
+	// fills in.
+	index := make(map[string]int)
+	for i, name := range names {
+		index[name] = i
+	}
+	row := make(sdata.Tuple, len(t.Fields))
+	for i, f := range t.Fields {
+		j, ok := index[f]
+		if !ok {
+			return nil, nil, nil, errors.Errorf("file has no column %q", f)
+		}
+		row[i] = full[j]
+	}
+	return t.Fields, row, projection{tr, full}, nil
+}
+
+// projection reads rows into a full tuple, whose elements a projected tuple
+// shares.
+type projection struct {
+	r    sdata.TupleReader
+	full sdata.Tuple
+}
+
+func (p projection) Next(sdata.Tuple) error {
+	return errors.EnsureStack(p.r.Next(p.full))
+}
+
+// csvFields returns the names of the columns of a CSV file.
+func (t *convertTransform) csvFields(r io.Reader) ([]string, error) {
+	record, err := csv.NewReader(r).Read()
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	if !t.header() {
+		if len(t.Fields) > 0 {
+			if len(t.Fields) != len(record) {
+				return nil, errors.Errorf("file has %d columns, but %d fields were given", len(record), len(t.Fields))
+			}
+			return t.Fields, nil
+		}
+		var names []string
+		for i := range record {
+			names = append(names, fmt.Sprintf("column_%d", i+1))
+		}
+		return names, nil
+	}
+	var names []string
+	for i, name := range record {
+		if name == nil || *name == "" {
+			return nil, errors.Errorf("header column %d has no name", i+1)
+		}
+		names = append(names, *name)
+	}
+	return names, nil
+}
+
+// jsonFields returns the names of the fields of the first record of a
+// newline delimited JSON file, in order, and its values.
+func jsonFields(r io.Reader) ([]string, map[string]interface{}, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, nil, errors.EnsureStack(err)
+	}
+	if tok != json.Delim('{') {
+		return nil, nil, errors.Errorf("records must be JSON objects, not %v", tok)
+	}
+	var names []string
+	values := make(map[string]interface{})
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, nil, errors.EnsureStack(err)
+		}
+		name := tok.(string)
+		var v interface{}
+		if err := dec.Decode(&v); err != nil {
+			return nil, nil, errors.EnsureStack(err)
+		}
+		if _, ok := values[name]; !ok {
+			names = append(names, name)
+		}
+		values[name] = v
+	}
+	return names, values, nil
+}
+
+// newTuple returns a tuple for the named fields, of the types in t.Types, or
+// else inferred from the values of the first record of a JSON file.
+func (t *convertTransform) newTuple(names []string, first map[string]interface{}) sdata.Tuple {
+	row := make(sdata.Tuple, len(names))
+	for i, name := range names {
+		typ, ok := t.Types[name]
+		if !ok {
+			switch first[name].(type) {
+			case bool:
+				typ = "bool"
+			case json.Number:
+				typ = "double"
+			default:
+				typ = "string"
+			}
+		}
+		row[i] = columnTypes[typ]()
+	}
+	return row
+}
+
+func (t *convertTransform) writer(w io.Writer, fields []string, row sdata.Tuple) (sdata.TupleWriter, error) {
+	switch t.to {
+	case "csv":
+		if t.header() {
+			return sdata.NewCSVWriter(w, fields), nil
+		}
+		return sdata.NewCSVWriter(w, nil), nil
+	case "json":
+		return sdata.NewJSONWriter(w, fields), nil
+	case "parquet":
+		pw, err := sdata.NewParquetWriter(w, fields, row)
+		return pw, errors.EnsureStack(err)
+	default:
+		return nil, errors.Errorf("unknown format %q", t.to)
+	}
+}
@@ -0,0 +1,78 @@
+package transforms
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"os"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+func init() {
+	registerBuiltin("dedup",
+		"Removes duplicate lines from each file, keeping the first occurrence.  Args: key (compare the lines, which must be JSON objects, by this top-level field instead of in full), across_files (bool: also remove lines that appeared in earlier files).",
+		func() builtinTransform { return &dedupTransform{} })
+}
+
+// dedupTransform removes duplicate lines.  Files are processed in the order of
+// their paths, so with AcrossFiles the copy that's kept is the one in the
+// first file.  The hashes of the lines seen are held in memory.
+type dedupTransform struct {
+	Key         string `json:"key"`
+	AcrossFiles bool   `json:"across_files"`
+}
+
+func (t *dedupTransform) validate() error { return nil }
+
+func (t *dedupTransform) run(ctx context.Context, files []inputFile, outputDir string) error {
+	seen := make(map[[sha256.Size]byte]struct{})
+	for _, file := range files {
+		if !t.AcrossFiles {
+			seen = make(map[[sha256.Size]byte]struct{})
+		}
+		if err := readInput(file, func(r *os.File) (retErr error) {
+			var w *recordWriter
+			defer func() {
+				if w != nil {
+					errors.JoinInto(&retErr, w.close())
+				}
+			}()
+			n := 0
+			return forEachLine(r, func(line []byte) error {
+				n++
+				line = withNewline(line)
+				key, err := t.key(line)
+				if err != nil {
+					return errors.Wrapf(err, "line %d", n)
+				}
+				h := sha256.Sum256(key)
+				if _, ok := seen[h]; ok {
+					return nil
+				}
+				seen[h] = struct{}{}
+				w, err = writeRecord(w, outputDir, file.Rel, line[:len(line)-1])
+				return err
+			})
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// key returns the part of line by which it's compared to others.
+func (t *dedupTransform) key(line []byte) ([]byte, error) {
+	if t.Key == "" {
+		return line, nil
+	}
+	var record map[string]json.RawMessage
+	if err := json.Unmarshal(line, &record); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	value, ok := record[t.Key]
+	if !ok {
+		return nil, errors.Errorf("record has no field %q", t.Key)
+	}
+	return value, nil
+}
@@ -0,0 +1,134 @@
+package transforms
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+func init() {
+	registerBuiltin("extract",
+		"Extracts .tar, .tar.gz, .tgz and .zip archives into a directory named after the archive, and decompresses .gz files.  Other files are copied unchanged.",
+		func() builtinTransform { return &extractTransform{} })
+}
+
+// extractTransform extracts archives.  Only regular files are extracted;
+// links and other special entries are skipped, and entries' paths are kept
+// inside the archive's directory.
+type extractTransform struct{}
+
+func (t *extractTransform) validate() error { return nil }
+
+func (t *extractTransform) run(ctx context.Context, files []inputFile, outputDir string) error {
+	for _, file := range files {
+		if err := readInput(file, func(r *os.File) error {
+			lower := strings.ToLower(file.Rel)
+			switch {
+			case strings.HasSuffix(lower, ".tar.gz"):
+				return extractTarGz(r, outputDir, file.Rel[:len(file.Rel)-len(".tar.gz")])
+			case strings.HasSuffix(lower, ".tgz"):
+				return extractTarGz(r, outputDir, file.Rel[:len(file.Rel)-len(".tgz")])
+			case strings.HasSuffix(lower, ".tar"):
+				return extractTar(r, outputDir, file.Rel[:len(file.Rel)-len(".tar")])
+			case strings.HasSuffix(lower, ".zip"):
+				return extractZip(r, outputDir, file.Rel[:len(file.Rel)-len(".zip")])
+			case strings.HasSuffix(lower, ".gz"):
+				return extractGz(r, outputDir, file.Rel[:len(file.Rel)-len(".gz")])
+			default:
+				return writeOutput(outputDir, file.Rel, func(w *os.File) error {
+					_, err := io.Copy(w, r)
+					return errors.EnsureStack(err)
+				})
+			}
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func extractGz(r io.Reader, outputDir, rel string) (retErr error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer errors.Close(&retErr, gr, "close gzip reader")
+	return writeOutput(outputDir, rel, func(w *os.File) error {
+		_, err := io.Copy(w, gr)
+		return errors.EnsureStack(err)
+	})
+}
+
+func extractTarGz(r io.Reader, outputDir, dir string) (retErr error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer errors.Close(&retErr, gr, "close gzip reader")
+	return extractTar(gr, outputDir, dir)
+}
+
+func extractTar(r io.Reader, outputDir, dir string) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return errors.EnsureStack(err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		if err := extractEntry(tr, outputDir, dir, hdr.Name); err != nil {
+			return err
+		}
+	}
+}
+
+func extractZip(r *os.File, outputDir, dir string) error {
+	info, err := r.Stat()
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	zr, err := zip.NewReader(r, info.Size())
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	for _, f := range zr.File {
+		if !f.Mode().IsRegular() {
+			continue
+		}
+		if err := func() (retErr error) {
+			fr, err := f.Open()
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			defer errors.Close(&retErr, fr, "close %v", f.Name)
+			return extractEntry(fr, outputDir, dir, f.Name)
+		}(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// extractEntry writes the archive entry called name to dir in outputDir.
+// Since name is cleaned before it's joined to dir, it can't escape dir.
+func extractEntry(r io.Reader, outputDir, dir, name string) error {
+	name = pathClean(strings.ReplaceAll(name, "\\", "/"))
+	if name == "" {
+		return nil
+	}
+	return errors.Wrapf(writeOutput(outputDir, path.Join(dir, name), func(w *os.File) error {
+		_, err := io.Copy(w, r)
+		return errors.EnsureStack(err)
+	}), "extract %v", name)
+}
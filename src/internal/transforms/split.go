@@ -0,0 +1,201 @@
+package transforms
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+func init() {
+	registerBuiltin("split",
+		"Splits each file into pieces of at most a number of lines or bytes, breaking only between lines.  Args: lines or bytes (exactly one), header (bool: repeat the first line of each file at the top of each piece).",
+		func() builtinTransform { return &splitTransform{} })
+	registerBuiltin("merge",
+		"Concatenates all the files into one.  Args: output (path of the result, required), lines or bytes (at most one: split the result into pieces), header (bool: keep the first line of only the first file).",
+		func() builtinTransform { return &mergeTransform{} })
+}
+
+// pieceLimits are the limits on the size of the pieces written by split and
+// merge.
+type pieceLimits struct {
+	Lines  int64 `json:"lines"`
+	Bytes  int64 `json:"bytes"`
+	Header bool  `json:"header"`
+}
+
+func (l pieceLimits) validate(required bool) error {
+	switch {
+	case l.Lines < 0 || l.Bytes < 0:
+		return errors.New("lines and bytes must be positive")
+	case l.Lines > 0 && l.Bytes > 0:
+		return errors.New("only one of lines and bytes may be set")
+	case required && l.Lines == 0 && l.Bytes == 0:
+		return errors.New("one of lines or bytes must be set")
+	}
+	return nil
+}
+
+// pieceWriter writes lines to pieces of at most the limits, at paths derived
+// from a base path by withSuffix.  A line longer than the byte limit is
+// written to a piece of its own.  With no limits, there's a single piece, at
+// the base path itself.
+type pieceWriter struct {
+	limits    pieceLimits
+	outputDir string
+	rel       string
+	// header is written at the top of every piece.
+	header []byte
+
+	n     int
+	w     *os.File
+	bw    *bufio.Writer
+	lines int64
+	bytes int64
+}
+
+func (pw *pieceWriter) writeLine(line []byte) error {
+	full := pw.w != nil && pw.lines > 0 &&
+		(pw.limits.Lines > 0 && pw.lines >= pw.limits.Lines ||
+			pw.limits.Bytes > 0 && pw.bytes+int64(len(line)) > pw.limits.Bytes)
+	if full {
+		if err := pw.close(); err != nil {
+			return err
+		}
+	}
+	if pw.w == nil {
+		rel := pw.rel
+		if pw.limits.Lines > 0 || pw.limits.Bytes > 0 {
+			rel = withSuffix(rel, fmt.Sprintf("-%05d", pw.n))
+		}
+		w, err := createOutput(pw.outputDir, rel)
+		if err != nil {
+			return err
+		}
+		pw.n++
+		pw.w, pw.bw = w, bufio.NewWriter(w)
+		pw.bytes = 0
+		if _, err := pw.bw.Write(pw.header); err != nil {
+			return errors.EnsureStack(err)
+		}
+		pw.bytes += int64(len(pw.header))
+	}
+	if _, err := pw.bw.Write(line); err != nil {
+		return errors.EnsureStack(err)
+	}
+	pw.lines++
+	pw.bytes += int64(len(line))
+	return nil
+}
+
+// close finishes the current piece, if there is one.
+func (pw *pieceWriter) close() (retErr error) {
+	if pw.w == nil {
+		return nil
+	}
+	w := pw.w
+	pw.w, pw.lines = nil, 0
+	defer errors.Close(&retErr, w, "close %v", w.Name())
+	return errors.EnsureStack(pw.bw.Flush())
+}
+
+// forEachLine calls f with each line of r, including its newline, if any.
+func forEachLine(r io.Reader, f func(line []byte) error) error {
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadBytes('\n')
+		if len(line) > 0 {
+			if err := f(line); err != nil {
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+}
+
+// splitTransform splits each file into numbered pieces.
+type splitTransform struct {
+	pieceLimits
+}
+
+func (t *splitTransform) validate() error {
+	return t.pieceLimits.validate(true)
+}
+
+func (t *splitTransform) run(ctx context.Context, files []inputFile, outputDir string) error {
+	for _, file := range files {
+		if err := readInput(file, func(r *os.File) error {
+			pw := &pieceWriter{limits: t.pieceLimits, outputDir: outputDir, rel: file.Rel}
+			first := true
+			if err := forEachLine(r, func(line []byte) error {
+				if first && t.Header {
+					first = false
+					pw.header = withNewline(line)
+					return nil
+				}
+				return pw.writeLine(line)
+			}); err != nil {
+				return errors.Join(err, pw.close())
+			}
+			return pw.close()
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeTransform concatenates all the files.
+type mergeTransform struct {
+	pieceLimits
+	// Output is the path of the result, relative to the output directory.
+	// If it's split, the pieces' paths are derived from it.
+	Output string `json:"output"`
+}
+
+func (t *mergeTransform) validate() error {
+	if pathClean(t.Output) == "" {
+		return errors.New("output must be set")
+	}
+	return t.pieceLimits.validate(false)
+}
+
+func (t *mergeTransform) run(ctx context.Context, files []inputFile, outputDir string) error {
+	pw := &pieceWriter{limits: t.pieceLimits, outputDir: outputDir, rel: t.Output}
+	for i, file := range files {
+		if err := readInput(file, func(r *os.File) error {
+			first := true
+			return forEachLine(r, func(line []byte) error {
+				// Files may not end in a newline, but lines from
+				// different files mustn't run together.
+				line = withNewline(line)
+				if first && t.Header {
+					first = false
+					if i == 0 {
+						pw.header = line
+					}
+					return nil
+				}
+				return pw.writeLine(line)
+			})
+		}); err != nil {
+			return errors.Join(err, pw.close())
+		}
+	}
+	return pw.close()
+}
+
+// withNewline returns line, ending in a newline.
+func withNewline(line []byte) []byte {
+	if len(line) > 0 && line[len(line)-1] != '\n' {
+		return append(line[:len(line):len(line)], '\n')
+	}
+	return line
+}
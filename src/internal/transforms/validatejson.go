@@ -0,0 +1,135 @@
+package transforms
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/jsonschema"
+)
+
+func init() {
+	registerBuiltin("validate_json",
+		"Validates each record of newline delimited JSON files against a JSON Schema, writing the valid ones to the output.  Args: schema (object, required), on_invalid (fail, drop or separate; default fail), invalid_dir (where separated records go; default invalid).",
+		func() builtinTransform { return &validateJSONTransform{} })
+}
+
+// validateJSONTransform validates JSON records against a schema.  The schema
+// must be self-contained: references to other documents aren't loaded.
+type validateJSONTransform struct {
+	Schema json.RawMessage `json:"schema"`
+	// OnInvalid is what to do with invalid records: fail the datum, drop
+	// them, or separate them into InvalidDir.
+	OnInvalid  string `json:"on_invalid"`
+	InvalidDir string `json:"invalid_dir"`
+
+	schema *jsonschema.Schema
+}
+
+func (t *validateJSONTransform) validate() error {
+	if len(bytes.TrimSpace(t.Schema)) == 0 {
+		return errors.New("schema must be set")
+	}
+	switch t.OnInvalid {
+	case "":
+		t.OnInvalid = "fail"
+	case "fail", "drop", "separate":
+	default:
+		return errors.Errorf("on_invalid must be fail, drop or separate, not %q", t.OnInvalid)
+	}
+	if t.InvalidDir == "" {
+		t.InvalidDir = "invalid"
+	}
+	if pathClean(t.InvalidDir) == "" {
+		return errors.Errorf("invalid invalid_dir %q", t.InvalidDir)
+	}
+	schema, err := jsonschema.Compile(t.Schema)
+	if err != nil {
+		return err
+	}
+	t.schema = schema
+	return nil
+}
+
+func (t *validateJSONTransform) run(ctx context.Context, files []inputFile, outputDir string) error {
+	for _, file := range files {
+		if err := readInput(file, func(r *os.File) error {
+			return t.validateFile(r, outputDir, file.Rel)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *validateJSONTransform) validateFile(r io.Reader, outputDir, rel string) (retErr error) {
+	var valid, invalid *recordWriter
+	defer func() {
+		for _, w := range []*recordWriter{valid, invalid} {
+			if w != nil {
+				errors.JoinInto(&retErr, w.close())
+			}
+		}
+	}()
+	dec := json.NewDecoder(r)
+	for i := 1; ; i++ {
+		var record json.RawMessage
+		if err := dec.Decode(&record); errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return errors.Wrapf(err, "decode record %d", i)
+		}
+		// Records are written one per line, whatever their input formatting.
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, record); err != nil {
+			return errors.EnsureStack(err)
+		}
+		record = compact.Bytes()
+		var err error
+		if verr := t.schema.Validate(record); verr == nil {
+			valid, err = writeRecord(valid, outputDir, rel, record)
+		} else {
+			switch t.OnInvalid {
+			case "fail":
+				return errors.Wrapf(verr, "record %d is invalid", i)
+			case "separate":
+				invalid, err = writeRecord(invalid, outputDir, path.Join(t.InvalidDir, rel), record)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// recordWriter writes newline delimited records to an output file.
+type recordWriter struct {
+	f  *os.File
+	bw *bufio.Writer
+}
+
+// writeRecord writes record to w, first creating w at rel in outputDir if it's
+// nil, so that files are only created for records that are written.
+func writeRecord(w *recordWriter, outputDir, rel string, record []byte) (*recordWriter, error) {
+	if w == nil {
+		f, err := createOutput(outputDir, rel)
+		if err != nil {
+			return nil, err
+		}
+		w = &recordWriter{f: f, bw: bufio.NewWriter(f)}
+	}
+	if _, err := w.bw.Write(record); err != nil {
+		return w, errors.EnsureStack(err)
+	}
+	return w, errors.EnsureStack(w.bw.WriteByte('\n'))
+}
+
+func (w *recordWriter) close() (retErr error) {
+	defer errors.Close(&retErr, w.f, "close %v", w.f.Name())
+	return errors.EnsureStack(w.bw.Flush())
+}
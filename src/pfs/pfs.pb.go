@@ -18,6 +18,7 @@ import (
 	anypb "google.golang.org/protobuf/types/known/anypb"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -243,6 +244,55 @@ func (Delimiter) EnumDescriptor() ([]byte, []int) {
 	return file_pfs_pfs_proto_rawDescGZIP(), []int{3}
 }
 
+type ProjectQuota_Enforcement int32
+
+const (
+	// SOFT quotas allow commits to finish, but with an error, which fails the
+	// jobs that process them.
+	ProjectQuota_SOFT ProjectQuota_Enforcement = 0
+	// HARD quotas cause FinishCommit to fail.
+	ProjectQuota_HARD ProjectQuota_Enforcement = 1
+)
+
+// Enum value maps for ProjectQuota_Enforcement.
+var (
+	ProjectQuota_Enforcement_name = map[int32]string{
+		0: "SOFT",
+		1: "HARD",
+	}
+	ProjectQuota_Enforcement_value = map[string]int32{
+		"SOFT": 0,
+		"HARD": 1,
+	}
+)
+
+func (x ProjectQuota_Enforcement) Enum() *ProjectQuota_Enforcement {
+	p := new(ProjectQuota_Enforcement)
+	*p = x
+	return p
+}
+
+func (x ProjectQuota_Enforcement) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProjectQuota_Enforcement) Descriptor() protoreflect.EnumDescriptor {
+	return file_pfs_pfs_proto_enumTypes[4].Descriptor()
+}
+
+func (ProjectQuota_Enforcement) Type() protoreflect.EnumType {
+	return &file_pfs_pfs_proto_enumTypes[4]
+}
+
+func (x ProjectQuota_Enforcement) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProjectQuota_Enforcement.Descriptor instead.
+func (ProjectQuota_Enforcement) EnumDescriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{17, 0}
+}
+
 type SQLDatabaseEgress_FileFormat_Type int32
 
 const (
@@ -279,11 +329,11 @@ func (x SQLDatabaseEgress_FileFormat_Type) String() string {
 }
 
 func (SQLDatabaseEgress_FileFormat_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_pfs_pfs_proto_enumTypes[4].Descriptor()
+	return file_pfs_pfs_proto_enumTypes[5].Descriptor()
 }
 
 func (SQLDatabaseEgress_FileFormat_Type) Type() protoreflect.EnumType {
-	return &file_pfs_pfs_proto_enumTypes[4]
+	return &file_pfs_pfs_proto_enumTypes[5]
 }
 
 func (x SQLDatabaseEgress_FileFormat_Type) Number() protoreflect.EnumNumber {
@@ -292,7 +342,7 @@ func (x SQLDatabaseEgress_FileFormat_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SQLDatabaseEgress_FileFormat_Type.Descriptor instead.
 func (SQLDatabaseEgress_FileFormat_Type) EnumDescriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{72, 0, 0}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{76, 0, 0}
 }
 
 type Repo struct {
@@ -575,6 +625,74 @@ func (x *RepoInfo) GetDetails() *RepoInfo_Details {
 	return nil
 }
 
+// StorageUsage is the physical storage used by a project, repo or commit.
+// Exclusive bytes are only referenced by the project, repo or commit, while
+// shared bytes are also referenced by others (for example through
+// deduplicated chunks), so deleting it would not free them.
+type StorageUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExclusiveBytes int64 `protobuf:"varint,1,opt,name=exclusive_bytes,json=exclusiveBytes,proto3" json:"exclusive_bytes,omitempty"`
+	SharedBytes    int64 `protobuf:"varint,2,opt,name=shared_bytes,json=sharedBytes,proto3" json:"shared_bytes,omitempty"`
+	// computed_at is when the usage was last computed.
+	ComputedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+}
+
+func (x *StorageUsage) Reset() {
+	*x = StorageUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageUsage) ProtoMessage() {}
+
+func (x *StorageUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageUsage.ProtoReflect.Descriptor instead.
+func (*StorageUsage) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StorageUsage) GetExclusiveBytes() int64 {
+	if x != nil {
+		return x.ExclusiveBytes
+	}
+	return 0
+}
+
+func (x *StorageUsage) GetSharedBytes() int64 {
+	if x != nil {
+		return x.SharedBytes
+	}
+	return 0
+}
+
+func (x *StorageUsage) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return nil
+}
+
 // AuthInfo includes the caller's access scope for a resource, and is returned
 // by services like ListRepo, InspectRepo, and ListProject, but is not persisted in the database.
 // It's used by the Pachyderm dashboard to render repo access appropriately.
@@ -596,7 +714,7 @@ type AuthInfo struct {
 func (x *AuthInfo) Reset() {
 	*x = AuthInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[4]
+		mi := &file_pfs_pfs_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -609,7 +727,7 @@ func (x *AuthInfo) String() string {
 func (*AuthInfo) ProtoMessage() {}
 
 func (x *AuthInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[4]
+	mi := &file_pfs_pfs_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -622,7 +740,7 @@ func (x *AuthInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthInfo.ProtoReflect.Descriptor instead.
 func (*AuthInfo) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{4}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AuthInfo) GetPermissions() []auth.Permission {
@@ -655,7 +773,7 @@ type BranchInfo struct {
 func (x *BranchInfo) Reset() {
 	*x = BranchInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[5]
+		mi := &file_pfs_pfs_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -668,7 +786,7 @@ func (x *BranchInfo) String() string {
 func (*BranchInfo) ProtoMessage() {}
 
 func (x *BranchInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[5]
+	mi := &file_pfs_pfs_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -681,7 +799,7 @@ func (x *BranchInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BranchInfo.ProtoReflect.Descriptor instead.
 func (*BranchInfo) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{5}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *BranchInfo) GetBranch() *Branch {
@@ -751,12 +869,17 @@ type Trigger struct {
 	// setting this will result with the trigger only firing based on the cron
 	// schedule.
 	CronSpec string `protobuf:"bytes,6,opt,name=cron_spec,json=cronSpec,proto3" json:"cron_spec,omitempty"`
+	// Requires the new head of the trigger branch to have been approved, with
+	// ApproveCommit, before the trigger happens.  This is required in addition
+	// to the other conditions, if any are set, and can't be combined with
+	// cron_spec.
+	RequireApproval bool `protobuf:"varint,7,opt,name=require_approval,json=requireApproval,proto3" json:"require_approval,omitempty"`
 }
 
 func (x *Trigger) Reset() {
 	*x = Trigger{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[6]
+		mi := &file_pfs_pfs_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -769,7 +892,7 @@ func (x *Trigger) String() string {
 func (*Trigger) ProtoMessage() {}
 
 func (x *Trigger) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[6]
+	mi := &file_pfs_pfs_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -782,7 +905,7 @@ func (x *Trigger) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Trigger.ProtoReflect.Descriptor instead.
 func (*Trigger) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{6}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Trigger) GetBranch() string {
@@ -827,6 +950,13 @@ func (x *Trigger) GetCronSpec() string {
 	return ""
 }
 
+func (x *Trigger) GetRequireApproval() bool {
+	if x != nil {
+		return x.RequireApproval
+	}
+	return false
+}
+
 type CommitOrigin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -838,7 +968,7 @@ type CommitOrigin struct {
 func (x *CommitOrigin) Reset() {
 	*x = CommitOrigin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[7]
+		mi := &file_pfs_pfs_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -851,7 +981,7 @@ func (x *CommitOrigin) String() string {
 func (*CommitOrigin) ProtoMessage() {}
 
 func (x *CommitOrigin) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[7]
+	mi := &file_pfs_pfs_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -864,7 +994,7 @@ func (x *CommitOrigin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommitOrigin.ProtoReflect.Descriptor instead.
 func (*CommitOrigin) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{7}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CommitOrigin) GetKind() OriginKind {
@@ -891,7 +1021,7 @@ type Commit struct {
 func (x *Commit) Reset() {
 	*x = Commit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[8]
+		mi := &file_pfs_pfs_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -904,7 +1034,7 @@ func (x *Commit) autogeneratedString() string {
 func (*Commit) ProtoMessage() {}
 
 func (x *Commit) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[8]
+	mi := &file_pfs_pfs_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -917,7 +1047,7 @@ func (x *Commit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Commit.ProtoReflect.Descriptor instead.
 func (*Commit) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{8}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Commit) GetRepo() *Repo {
@@ -959,13 +1089,18 @@ type CommitInfo struct {
 	DirectProvenance    []*Commit              `protobuf:"bytes,13,rep,name=direct_provenance,json=directProvenance,proto3" json:"direct_provenance,omitempty"`
 	Error               string                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
 	SizeBytesUpperBound int64                  `protobuf:"varint,11,opt,name=size_bytes_upper_bound,json=sizeBytesUpperBound,proto3" json:"size_bytes_upper_bound,omitempty"`
-	Details             *CommitInfo_Details    `protobuf:"bytes,12,opt,name=details,proto3" json:"details,omitempty"`
+	// approval is set once the commit has been approved.
+	Approval *CommitApproval `protobuf:"bytes,14,opt,name=approval,proto3" json:"approval,omitempty"`
+	// checkpoint is an opaque marker set when the commit was finished, such as
+	// the offset of the last message a spout wrote to it.
+	Checkpoint string              `protobuf:"bytes,15,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	Details    *CommitInfo_Details `protobuf:"bytes,12,opt,name=details,proto3" json:"details,omitempty"`
 }
 
 func (x *CommitInfo) Reset() {
 	*x = CommitInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[9]
+		mi := &file_pfs_pfs_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -978,7 +1113,7 @@ func (x *CommitInfo) String() string {
 func (*CommitInfo) ProtoMessage() {}
 
 func (x *CommitInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[9]
+	mi := &file_pfs_pfs_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -991,7 +1126,7 @@ func (x *CommitInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommitInfo.ProtoReflect.Descriptor instead.
 func (*CommitInfo) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{9}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CommitInfo) GetCommit() *Commit {
@@ -1071,6 +1206,20 @@ func (x *CommitInfo) GetSizeBytesUpperBound() int64 {
 	return 0
 }
 
+func (x *CommitInfo) GetApproval() *CommitApproval {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+func (x *CommitInfo) GetCheckpoint() string {
+	if x != nil {
+		return x.Checkpoint
+	}
+	return ""
+}
+
 func (x *CommitInfo) GetDetails() *CommitInfo_Details {
 	if x != nil {
 		return x.Details
@@ -1078,6 +1227,71 @@ func (x *CommitInfo) GetDetails() *CommitInfo_Details {
 	return nil
 }
 
+// CommitApproval records who approved a commit, allowing it to fire triggers
+// which require approval.
+type CommitApproval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Principal string                 `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
+	Comment   string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	Approved  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=approved,proto3" json:"approved,omitempty"`
+}
+
+func (x *CommitApproval) Reset() {
+	*x = CommitApproval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitApproval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitApproval) ProtoMessage() {}
+
+func (x *CommitApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitApproval.ProtoReflect.Descriptor instead.
+func (*CommitApproval) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CommitApproval) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *CommitApproval) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *CommitApproval) GetApproved() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Approved
+	}
+	return nil
+}
+
 type CommitSet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1089,7 +1303,7 @@ type CommitSet struct {
 func (x *CommitSet) Reset() {
 	*x = CommitSet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[10]
+		mi := &file_pfs_pfs_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1102,7 +1316,7 @@ func (x *CommitSet) String() string {
 func (*CommitSet) ProtoMessage() {}
 
 func (x *CommitSet) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[10]
+	mi := &file_pfs_pfs_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1115,7 +1329,7 @@ func (x *CommitSet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommitSet.ProtoReflect.Descriptor instead.
 func (*CommitSet) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{10}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CommitSet) GetId() string {
@@ -1137,7 +1351,7 @@ type CommitSetInfo struct {
 func (x *CommitSetInfo) Reset() {
 	*x = CommitSetInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[11]
+		mi := &file_pfs_pfs_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1150,7 +1364,7 @@ func (x *CommitSetInfo) String() string {
 func (*CommitSetInfo) ProtoMessage() {}
 
 func (x *CommitSetInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[11]
+	mi := &file_pfs_pfs_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1163,7 +1377,7 @@ func (x *CommitSetInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommitSetInfo.ProtoReflect.Descriptor instead.
 func (*CommitSetInfo) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{11}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CommitSetInfo) GetCommitSet() *CommitSet {
@@ -1195,7 +1409,7 @@ type FileInfo struct {
 func (x *FileInfo) Reset() {
 	*x = FileInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[12]
+		mi := &file_pfs_pfs_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1208,7 +1422,7 @@ func (x *FileInfo) String() string {
 func (*FileInfo) ProtoMessage() {}
 
 func (x *FileInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[12]
+	mi := &file_pfs_pfs_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1221,7 +1435,7 @@ func (x *FileInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileInfo.ProtoReflect.Descriptor instead.
 func (*FileInfo) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{12}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *FileInfo) GetFile() *File {
@@ -1270,7 +1484,7 @@ type Project struct {
 func (x *Project) Reset() {
 	*x = Project{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[13]
+		mi := &file_pfs_pfs_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1283,7 +1497,7 @@ func (x *Project) autogeneratedString() string {
 func (*Project) ProtoMessage() {}
 
 func (x *Project) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[13]
+	mi := &file_pfs_pfs_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1296,7 +1510,7 @@ func (x *Project) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Project.ProtoReflect.Descriptor instead.
 func (*Project) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{13}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Project) GetName() string {
@@ -1315,12 +1529,14 @@ type ProjectInfo struct {
 	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	AuthInfo    *AuthInfo              `protobuf:"bytes,3,opt,name=auth_info,json=authInfo,proto3" json:"auth_info,omitempty"`
 	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Details     *ProjectInfo_Details   `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+	Quota       *ProjectQuota          `protobuf:"bytes,6,opt,name=quota,proto3" json:"quota,omitempty"`
 }
 
 func (x *ProjectInfo) Reset() {
 	*x = ProjectInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[14]
+		mi := &file_pfs_pfs_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1333,7 +1549,7 @@ func (x *ProjectInfo) String() string {
 func (*ProjectInfo) ProtoMessage() {}
 
 func (x *ProjectInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[14]
+	mi := &file_pfs_pfs_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1346,7 +1562,7 @@ func (x *ProjectInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProjectInfo.ProtoReflect.Descriptor instead.
 func (*ProjectInfo) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{14}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ProjectInfo) GetProject() *Project {
@@ -1377,33 +1593,62 @@ func (x *ProjectInfo) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type CreateRepoRequest struct {
+func (x *ProjectInfo) GetDetails() *ProjectInfo_Details {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *ProjectInfo) GetQuota() *ProjectQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+// ProjectQuota limits the storage used by a project.
+type ProjectQuota struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Repo        *Repo  `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Update      bool   `protobuf:"varint,3,opt,name=update,proto3" json:"update,omitempty"`
-}
-
-func (x *CreateRepoRequest) Reset() {
-	*x = CreateRepoRequest{}
+	// max_bytes is the maximum storage usage (exclusive and shared bytes) of the
+	// project.  0 means the project has no quota.
+	MaxBytes    int64                    `protobuf:"varint,1,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	Enforcement ProjectQuota_Enforcement `protobuf:"varint,2,opt,name=enforcement,proto3,enum=pfs_v2.ProjectQuota_Enforcement" json:"enforcement,omitempty"`
+	// max_workers is the maximum number of workers that the project's pipelines
+	// may run at once.  0 means no limit.
+	MaxWorkers int64 `protobuf:"varint,3,opt,name=max_workers,json=maxWorkers,proto3" json:"max_workers,omitempty"`
+	// max_cpu and max_memory are the maximum total resources that the workers
+	// of the project's pipelines may request, as Kubernetes quantities (e.g.
+	// "16" or "64Gi").  Empty means no limit.
+	MaxCpu    string `protobuf:"bytes,4,opt,name=max_cpu,json=maxCpu,proto3" json:"max_cpu,omitempty"`
+	MaxMemory string `protobuf:"bytes,5,opt,name=max_memory,json=maxMemory,proto3" json:"max_memory,omitempty"`
+	// priority_class_name is the Kubernetes priority class of the workers of the
+	// project's pipelines.  It overrides the priority class in a pipeline's
+	// scheduling_spec, and applies to a pipeline's workers when they are next
+	// created.
+	PriorityClassName string `protobuf:"bytes,6,opt,name=priority_class_name,json=priorityClassName,proto3" json:"priority_class_name,omitempty"`
+}
+
+func (x *ProjectQuota) Reset() {
+	*x = ProjectQuota{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[15]
+		mi := &file_pfs_pfs_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateRepoRequest) String() string {
+func (x *ProjectQuota) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRepoRequest) ProtoMessage() {}
+func (*ProjectQuota) ProtoMessage() {}
 
-func (x *CreateRepoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[15]
+func (x *ProjectQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1414,44 +1659,128 @@ func (x *CreateRepoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRepoRequest.ProtoReflect.Descriptor instead.
-func (*CreateRepoRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ProjectQuota.ProtoReflect.Descriptor instead.
+func (*ProjectQuota) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *CreateRepoRequest) GetRepo() *Repo {
+func (x *ProjectQuota) GetMaxBytes() int64 {
 	if x != nil {
-		return x.Repo
+		return x.MaxBytes
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateRepoRequest) GetDescription() string {
+func (x *ProjectQuota) GetEnforcement() ProjectQuota_Enforcement {
 	if x != nil {
-		return x.Description
+		return x.Enforcement
 	}
-	return ""
+	return ProjectQuota_SOFT
 }
 
-func (x *CreateRepoRequest) GetUpdate() bool {
+func (x *ProjectQuota) GetMaxWorkers() int64 {
 	if x != nil {
-		return x.Update
+		return x.MaxWorkers
 	}
-	return false
+	return 0
 }
 
-type InspectRepoRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ProjectQuota) GetMaxCpu() string {
+	if x != nil {
+		return x.MaxCpu
+	}
+	return ""
+}
 
-	Repo *Repo `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+func (x *ProjectQuota) GetMaxMemory() string {
+	if x != nil {
+		return x.MaxMemory
+	}
+	return ""
 }
 
-func (x *InspectRepoRequest) Reset() {
+func (x *ProjectQuota) GetPriorityClassName() string {
+	if x != nil {
+		return x.PriorityClassName
+	}
+	return ""
+}
+
+type CreateRepoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repo        *Repo  `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Update      bool   `protobuf:"varint,3,opt,name=update,proto3" json:"update,omitempty"`
+}
+
+func (x *CreateRepoRequest) Reset() {
+	*x = CreateRepoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRepoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRepoRequest) ProtoMessage() {}
+
+func (x *CreateRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRepoRequest.ProtoReflect.Descriptor instead.
+func (*CreateRepoRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateRepoRequest) GetRepo() *Repo {
+	if x != nil {
+		return x.Repo
+	}
+	return nil
+}
+
+func (x *CreateRepoRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateRepoRequest) GetUpdate() bool {
+	if x != nil {
+		return x.Update
+	}
+	return false
+}
+
+type InspectRepoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repo *Repo `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+}
+
+func (x *InspectRepoRequest) Reset() {
 	*x = InspectRepoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[16]
+		mi := &file_pfs_pfs_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1464,7 +1793,7 @@ func (x *InspectRepoRequest) String() string {
 func (*InspectRepoRequest) ProtoMessage() {}
 
 func (x *InspectRepoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[16]
+	mi := &file_pfs_pfs_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1477,7 +1806,7 @@ func (x *InspectRepoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InspectRepoRequest.ProtoReflect.Descriptor instead.
 func (*InspectRepoRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{16}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *InspectRepoRequest) GetRepo() *Repo {
@@ -1502,7 +1831,7 @@ type ListRepoRequest struct {
 func (x *ListRepoRequest) Reset() {
 	*x = ListRepoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[17]
+		mi := &file_pfs_pfs_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1515,7 +1844,7 @@ func (x *ListRepoRequest) String() string {
 func (*ListRepoRequest) ProtoMessage() {}
 
 func (x *ListRepoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[17]
+	mi := &file_pfs_pfs_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1528,7 +1857,7 @@ func (x *ListRepoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRepoRequest.ProtoReflect.Descriptor instead.
 func (*ListRepoRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{17}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ListRepoRequest) GetType() string {
@@ -1557,7 +1886,7 @@ type DeleteRepoRequest struct {
 func (x *DeleteRepoRequest) Reset() {
 	*x = DeleteRepoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[18]
+		mi := &file_pfs_pfs_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1570,7 +1899,7 @@ func (x *DeleteRepoRequest) String() string {
 func (*DeleteRepoRequest) ProtoMessage() {}
 
 func (x *DeleteRepoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[18]
+	mi := &file_pfs_pfs_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1583,7 +1912,7 @@ func (x *DeleteRepoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRepoRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRepoRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{18}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *DeleteRepoRequest) GetRepo() *Repo {
@@ -1618,7 +1947,7 @@ type DeleteReposRequest struct {
 func (x *DeleteReposRequest) Reset() {
 	*x = DeleteReposRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[19]
+		mi := &file_pfs_pfs_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1631,7 +1960,7 @@ func (x *DeleteReposRequest) String() string {
 func (*DeleteReposRequest) ProtoMessage() {}
 
 func (x *DeleteReposRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[19]
+	mi := &file_pfs_pfs_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1644,7 +1973,7 @@ func (x *DeleteReposRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteReposRequest.ProtoReflect.Descriptor instead.
 func (*DeleteReposRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{19}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DeleteReposRequest) GetProjects() []*Project {
@@ -1681,7 +2010,7 @@ type DeleteRepoResponse struct {
 func (x *DeleteRepoResponse) Reset() {
 	*x = DeleteRepoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[20]
+		mi := &file_pfs_pfs_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1694,7 +2023,7 @@ func (x *DeleteRepoResponse) String() string {
 func (*DeleteRepoResponse) ProtoMessage() {}
 
 func (x *DeleteRepoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[20]
+	mi := &file_pfs_pfs_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1707,7 +2036,7 @@ func (x *DeleteRepoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRepoResponse.ProtoReflect.Descriptor instead.
 func (*DeleteRepoResponse) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{20}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *DeleteRepoResponse) GetDeleted() bool {
@@ -1728,7 +2057,7 @@ type DeleteReposResponse struct {
 func (x *DeleteReposResponse) Reset() {
 	*x = DeleteReposResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[21]
+		mi := &file_pfs_pfs_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1741,7 +2070,7 @@ func (x *DeleteReposResponse) String() string {
 func (*DeleteReposResponse) ProtoMessage() {}
 
 func (x *DeleteReposResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[21]
+	mi := &file_pfs_pfs_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1754,7 +2083,7 @@ func (x *DeleteReposResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteReposResponse.ProtoReflect.Descriptor instead.
 func (*DeleteReposResponse) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{21}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *DeleteReposResponse) GetRepos() []*Repo {
@@ -1780,7 +2109,7 @@ type StartCommitRequest struct {
 func (x *StartCommitRequest) Reset() {
 	*x = StartCommitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[22]
+		mi := &file_pfs_pfs_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1793,7 +2122,7 @@ func (x *StartCommitRequest) String() string {
 func (*StartCommitRequest) ProtoMessage() {}
 
 func (x *StartCommitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[22]
+	mi := &file_pfs_pfs_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1806,7 +2135,7 @@ func (x *StartCommitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartCommitRequest.ProtoReflect.Descriptor instead.
 func (*StartCommitRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{22}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *StartCommitRequest) GetParent() *Commit {
@@ -1841,12 +2170,15 @@ type FinishCommitRequest struct {
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	Error       string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	Force       bool   `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
+	// checkpoint is recorded in the commit along with its data, so that a
+	// writer can resume from the latest finished commit's checkpoint.
+	Checkpoint string `protobuf:"bytes,5,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
 }
 
 func (x *FinishCommitRequest) Reset() {
 	*x = FinishCommitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[23]
+		mi := &file_pfs_pfs_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1859,7 +2191,7 @@ func (x *FinishCommitRequest) String() string {
 func (*FinishCommitRequest) ProtoMessage() {}
 
 func (x *FinishCommitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[23]
+	mi := &file_pfs_pfs_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1872,7 +2204,7 @@ func (x *FinishCommitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinishCommitRequest.ProtoReflect.Descriptor instead.
 func (*FinishCommitRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{23}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *FinishCommitRequest) GetCommit() *Commit {
@@ -1903,6 +2235,78 @@ func (x *FinishCommitRequest) GetForce() bool {
 	return false
 }
 
+func (x *FinishCommitRequest) GetCheckpoint() string {
+	if x != nil {
+		return x.Checkpoint
+	}
+	return ""
+}
+
+type ApproveCommitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commit  *Commit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	Comment string  `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	// principal is who approved the commit.  If auth is activated it's the
+	// caller, and may be left unset, but it must be set otherwise.
+	Principal string `protobuf:"bytes,3,opt,name=principal,proto3" json:"principal,omitempty"`
+}
+
+func (x *ApproveCommitRequest) Reset() {
+	*x = ApproveCommitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveCommitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveCommitRequest) ProtoMessage() {}
+
+func (x *ApproveCommitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveCommitRequest.ProtoReflect.Descriptor instead.
+func (*ApproveCommitRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ApproveCommitRequest) GetCommit() *Commit {
+	if x != nil {
+		return x.Commit
+	}
+	return nil
+}
+
+func (x *ApproveCommitRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ApproveCommitRequest) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
 type InspectCommitRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1916,7 +2320,7 @@ type InspectCommitRequest struct {
 func (x *InspectCommitRequest) Reset() {
 	*x = InspectCommitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[24]
+		mi := &file_pfs_pfs_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1929,7 +2333,7 @@ func (x *InspectCommitRequest) String() string {
 func (*InspectCommitRequest) ProtoMessage() {}
 
 func (x *InspectCommitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[24]
+	mi := &file_pfs_pfs_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1942,7 +2346,7 @@ func (x *InspectCommitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InspectCommitRequest.ProtoReflect.Descriptor instead.
 func (*InspectCommitRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{24}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *InspectCommitRequest) GetCommit() *Commit {
@@ -1977,7 +2381,7 @@ type ListCommitRequest struct {
 func (x *ListCommitRequest) Reset() {
 	*x = ListCommitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[25]
+		mi := &file_pfs_pfs_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1990,7 +2394,7 @@ func (x *ListCommitRequest) String() string {
 func (*ListCommitRequest) ProtoMessage() {}
 
 func (x *ListCommitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[25]
+	mi := &file_pfs_pfs_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2003,7 +2407,7 @@ func (x *ListCommitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCommitRequest.ProtoReflect.Descriptor instead.
 func (*ListCommitRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{25}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ListCommitRequest) GetRepo() *Repo {
@@ -2074,7 +2478,7 @@ type InspectCommitSetRequest struct {
 func (x *InspectCommitSetRequest) Reset() {
 	*x = InspectCommitSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[26]
+		mi := &file_pfs_pfs_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2087,7 +2491,7 @@ func (x *InspectCommitSetRequest) String() string {
 func (*InspectCommitSetRequest) ProtoMessage() {}
 
 func (x *InspectCommitSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[26]
+	mi := &file_pfs_pfs_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2100,7 +2504,7 @@ func (x *InspectCommitSetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InspectCommitSetRequest.ProtoReflect.Descriptor instead.
 func (*InspectCommitSetRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{26}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *InspectCommitSetRequest) GetCommitSet() *CommitSet {
@@ -2128,7 +2532,7 @@ type ListCommitSetRequest struct {
 func (x *ListCommitSetRequest) Reset() {
 	*x = ListCommitSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[27]
+		mi := &file_pfs_pfs_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2141,7 +2545,7 @@ func (x *ListCommitSetRequest) String() string {
 func (*ListCommitSetRequest) ProtoMessage() {}
 
 func (x *ListCommitSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[27]
+	mi := &file_pfs_pfs_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2154,7 +2558,7 @@ func (x *ListCommitSetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCommitSetRequest.ProtoReflect.Descriptor instead.
 func (*ListCommitSetRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{27}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ListCommitSetRequest) GetProject() *Project {
@@ -2175,7 +2579,7 @@ type SquashCommitSetRequest struct {
 func (x *SquashCommitSetRequest) Reset() {
 	*x = SquashCommitSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[28]
+		mi := &file_pfs_pfs_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2188,7 +2592,7 @@ func (x *SquashCommitSetRequest) String() string {
 func (*SquashCommitSetRequest) ProtoMessage() {}
 
 func (x *SquashCommitSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[28]
+	mi := &file_pfs_pfs_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2201,7 +2605,7 @@ func (x *SquashCommitSetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SquashCommitSetRequest.ProtoReflect.Descriptor instead.
 func (*SquashCommitSetRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{28}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *SquashCommitSetRequest) GetCommitSet() *CommitSet {
@@ -2222,7 +2626,7 @@ type DropCommitSetRequest struct {
 func (x *DropCommitSetRequest) Reset() {
 	*x = DropCommitSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[29]
+		mi := &file_pfs_pfs_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2235,7 +2639,7 @@ func (x *DropCommitSetRequest) String() string {
 func (*DropCommitSetRequest) ProtoMessage() {}
 
 func (x *DropCommitSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[29]
+	mi := &file_pfs_pfs_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2248,7 +2652,7 @@ func (x *DropCommitSetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DropCommitSetRequest.ProtoReflect.Descriptor instead.
 func (*DropCommitSetRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{29}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *DropCommitSetRequest) GetCommitSet() *CommitSet {
@@ -2276,7 +2680,7 @@ type SubscribeCommitRequest struct {
 func (x *SubscribeCommitRequest) Reset() {
 	*x = SubscribeCommitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[30]
+		mi := &file_pfs_pfs_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2289,7 +2693,7 @@ func (x *SubscribeCommitRequest) String() string {
 func (*SubscribeCommitRequest) ProtoMessage() {}
 
 func (x *SubscribeCommitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[30]
+	mi := &file_pfs_pfs_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2302,7 +2706,7 @@ func (x *SubscribeCommitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeCommitRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeCommitRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{30}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *SubscribeCommitRequest) GetRepo() *Repo {
@@ -2358,7 +2762,7 @@ type ClearCommitRequest struct {
 func (x *ClearCommitRequest) Reset() {
 	*x = ClearCommitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[31]
+		mi := &file_pfs_pfs_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2371,7 +2775,7 @@ func (x *ClearCommitRequest) String() string {
 func (*ClearCommitRequest) ProtoMessage() {}
 
 func (x *ClearCommitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[31]
+	mi := &file_pfs_pfs_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2384,7 +2788,7 @@ func (x *ClearCommitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClearCommitRequest.ProtoReflect.Descriptor instead.
 func (*ClearCommitRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{31}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ClearCommitRequest) GetCommit() *Commit {
@@ -2409,7 +2813,7 @@ type CreateBranchRequest struct {
 func (x *CreateBranchRequest) Reset() {
 	*x = CreateBranchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[32]
+		mi := &file_pfs_pfs_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2422,7 +2826,7 @@ func (x *CreateBranchRequest) String() string {
 func (*CreateBranchRequest) ProtoMessage() {}
 
 func (x *CreateBranchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[32]
+	mi := &file_pfs_pfs_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2435,7 +2839,7 @@ func (x *CreateBranchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBranchRequest.ProtoReflect.Descriptor instead.
 func (*CreateBranchRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{32}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *CreateBranchRequest) GetHead() *Commit {
@@ -2486,7 +2890,7 @@ type FindCommitsRequest struct {
 func (x *FindCommitsRequest) Reset() {
 	*x = FindCommitsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[33]
+		mi := &file_pfs_pfs_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2499,7 +2903,7 @@ func (x *FindCommitsRequest) String() string {
 func (*FindCommitsRequest) ProtoMessage() {}
 
 func (x *FindCommitsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[33]
+	mi := &file_pfs_pfs_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2512,7 +2916,7 @@ func (x *FindCommitsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindCommitsRequest.ProtoReflect.Descriptor instead.
 func (*FindCommitsRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{33}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *FindCommitsRequest) GetStart() *Commit {
@@ -2552,7 +2956,7 @@ type FindCommitsResponse struct {
 func (x *FindCommitsResponse) Reset() {
 	*x = FindCommitsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[34]
+		mi := &file_pfs_pfs_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2565,7 +2969,7 @@ func (x *FindCommitsResponse) String() string {
 func (*FindCommitsResponse) ProtoMessage() {}
 
 func (x *FindCommitsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[34]
+	mi := &file_pfs_pfs_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2578,7 +2982,7 @@ func (x *FindCommitsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindCommitsResponse.ProtoReflect.Descriptor instead.
 func (*FindCommitsResponse) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{34}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{38}
 }
 
 func (m *FindCommitsResponse) GetResult() isFindCommitsResponse_Result {
@@ -2636,7 +3040,7 @@ type InspectBranchRequest struct {
 func (x *InspectBranchRequest) Reset() {
 	*x = InspectBranchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[35]
+		mi := &file_pfs_pfs_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2649,7 +3053,7 @@ func (x *InspectBranchRequest) String() string {
 func (*InspectBranchRequest) ProtoMessage() {}
 
 func (x *InspectBranchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[35]
+	mi := &file_pfs_pfs_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2662,7 +3066,7 @@ func (x *InspectBranchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InspectBranchRequest.ProtoReflect.Descriptor instead.
 func (*InspectBranchRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{35}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *InspectBranchRequest) GetBranch() *Branch {
@@ -2684,7 +3088,7 @@ type ListBranchRequest struct {
 func (x *ListBranchRequest) Reset() {
 	*x = ListBranchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[36]
+		mi := &file_pfs_pfs_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2697,7 +3101,7 @@ func (x *ListBranchRequest) String() string {
 func (*ListBranchRequest) ProtoMessage() {}
 
 func (x *ListBranchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[36]
+	mi := &file_pfs_pfs_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2710,7 +3114,7 @@ func (x *ListBranchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBranchRequest.ProtoReflect.Descriptor instead.
 func (*ListBranchRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{36}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *ListBranchRequest) GetRepo() *Repo {
@@ -2739,7 +3143,7 @@ type DeleteBranchRequest struct {
 func (x *DeleteBranchRequest) Reset() {
 	*x = DeleteBranchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[37]
+		mi := &file_pfs_pfs_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2752,7 +3156,7 @@ func (x *DeleteBranchRequest) String() string {
 func (*DeleteBranchRequest) ProtoMessage() {}
 
 func (x *DeleteBranchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[37]
+	mi := &file_pfs_pfs_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2765,7 +3169,7 @@ func (x *DeleteBranchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteBranchRequest.ProtoReflect.Descriptor instead.
 func (*DeleteBranchRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{37}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *DeleteBranchRequest) GetBranch() *Branch {
@@ -2790,12 +3194,15 @@ type CreateProjectRequest struct {
 	Project     *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
 	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	Update      bool     `protobuf:"varint,3,opt,name=update,proto3" json:"update,omitempty"`
+	// quota sets the storage quota of the project.  When updating a project, a
+	// nil quota leaves the existing quota unchanged.
+	Quota *ProjectQuota `protobuf:"bytes,4,opt,name=quota,proto3" json:"quota,omitempty"`
 }
 
 func (x *CreateProjectRequest) Reset() {
 	*x = CreateProjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[38]
+		mi := &file_pfs_pfs_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2808,7 +3215,7 @@ func (x *CreateProjectRequest) String() string {
 func (*CreateProjectRequest) ProtoMessage() {}
 
 func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[38]
+	mi := &file_pfs_pfs_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2821,7 +3228,7 @@ func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
 func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{38}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *CreateProjectRequest) GetProject() *Project {
@@ -2845,18 +3252,27 @@ func (x *CreateProjectRequest) GetUpdate() bool {
 	return false
 }
 
+func (x *CreateProjectRequest) GetQuota() *ProjectQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
 type InspectProjectRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Project *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// details requests the storage usage of the project.
+	Details bool `protobuf:"varint,2,opt,name=details,proto3" json:"details,omitempty"`
 }
 
 func (x *InspectProjectRequest) Reset() {
 	*x = InspectProjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[39]
+		mi := &file_pfs_pfs_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2869,7 +3285,7 @@ func (x *InspectProjectRequest) String() string {
 func (*InspectProjectRequest) ProtoMessage() {}
 
 func (x *InspectProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[39]
+	mi := &file_pfs_pfs_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2882,7 +3298,7 @@ func (x *InspectProjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InspectProjectRequest.ProtoReflect.Descriptor instead.
 func (*InspectProjectRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{39}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *InspectProjectRequest) GetProject() *Project {
@@ -2892,6 +3308,13 @@ func (x *InspectProjectRequest) GetProject() *Project {
 	return nil
 }
 
+func (x *InspectProjectRequest) GetDetails() bool {
+	if x != nil {
+		return x.Details
+	}
+	return false
+}
+
 type ListProjectRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2901,7 +3324,7 @@ type ListProjectRequest struct {
 func (x *ListProjectRequest) Reset() {
 	*x = ListProjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[40]
+		mi := &file_pfs_pfs_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2914,7 +3337,7 @@ func (x *ListProjectRequest) String() string {
 func (*ListProjectRequest) ProtoMessage() {}
 
 func (x *ListProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[40]
+	mi := &file_pfs_pfs_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2927,7 +3350,7 @@ func (x *ListProjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProjectRequest.ProtoReflect.Descriptor instead.
 func (*ListProjectRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{40}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{44}
 }
 
 type DeleteProjectRequest struct {
@@ -2942,7 +3365,7 @@ type DeleteProjectRequest struct {
 func (x *DeleteProjectRequest) Reset() {
 	*x = DeleteProjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[41]
+		mi := &file_pfs_pfs_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2955,7 +3378,7 @@ func (x *DeleteProjectRequest) String() string {
 func (*DeleteProjectRequest) ProtoMessage() {}
 
 func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[41]
+	mi := &file_pfs_pfs_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2968,7 +3391,7 @@ func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{41}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *DeleteProjectRequest) GetProject() *Project {
@@ -3002,7 +3425,7 @@ type AddFile struct {
 func (x *AddFile) Reset() {
 	*x = AddFile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[42]
+		mi := &file_pfs_pfs_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3015,7 +3438,7 @@ func (x *AddFile) String() string {
 func (*AddFile) ProtoMessage() {}
 
 func (x *AddFile) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[42]
+	mi := &file_pfs_pfs_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3028,7 +3451,7 @@ func (x *AddFile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddFile.ProtoReflect.Descriptor instead.
 func (*AddFile) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{42}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *AddFile) GetPath() string {
@@ -3094,7 +3517,7 @@ type DeleteFile struct {
 func (x *DeleteFile) Reset() {
 	*x = DeleteFile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[43]
+		mi := &file_pfs_pfs_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3107,7 +3530,7 @@ func (x *DeleteFile) String() string {
 func (*DeleteFile) ProtoMessage() {}
 
 func (x *DeleteFile) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[43]
+	mi := &file_pfs_pfs_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3120,7 +3543,7 @@ func (x *DeleteFile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFile.ProtoReflect.Descriptor instead.
 func (*DeleteFile) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{43}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *DeleteFile) GetPath() string {
@@ -3151,7 +3574,7 @@ type CopyFile struct {
 func (x *CopyFile) Reset() {
 	*x = CopyFile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[44]
+		mi := &file_pfs_pfs_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3164,7 +3587,7 @@ func (x *CopyFile) String() string {
 func (*CopyFile) ProtoMessage() {}
 
 func (x *CopyFile) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[44]
+	mi := &file_pfs_pfs_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3177,7 +3600,7 @@ func (x *CopyFile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CopyFile.ProtoReflect.Descriptor instead.
 func (*CopyFile) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{44}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *CopyFile) GetDst() string {
@@ -3225,7 +3648,7 @@ type ModifyFileRequest struct {
 func (x *ModifyFileRequest) Reset() {
 	*x = ModifyFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[45]
+		mi := &file_pfs_pfs_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3238,7 +3661,7 @@ func (x *ModifyFileRequest) String() string {
 func (*ModifyFileRequest) ProtoMessage() {}
 
 func (x *ModifyFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[45]
+	mi := &file_pfs_pfs_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3251,7 +3674,7 @@ func (x *ModifyFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModifyFileRequest.ProtoReflect.Descriptor instead.
 func (*ModifyFileRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{45}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{49}
 }
 
 func (m *ModifyFileRequest) GetBody() isModifyFileRequest_Body {
@@ -3331,7 +3754,7 @@ type GetFileRequest struct {
 func (x *GetFileRequest) Reset() {
 	*x = GetFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[46]
+		mi := &file_pfs_pfs_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3344,7 +3767,7 @@ func (x *GetFileRequest) String() string {
 func (*GetFileRequest) ProtoMessage() {}
 
 func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[46]
+	mi := &file_pfs_pfs_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3357,7 +3780,7 @@ func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFileRequest.ProtoReflect.Descriptor instead.
 func (*GetFileRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{46}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *GetFileRequest) GetFile() *File {
@@ -3399,7 +3822,7 @@ type InspectFileRequest struct {
 func (x *InspectFileRequest) Reset() {
 	*x = InspectFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[47]
+		mi := &file_pfs_pfs_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3412,7 +3835,7 @@ func (x *InspectFileRequest) String() string {
 func (*InspectFileRequest) ProtoMessage() {}
 
 func (x *InspectFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[47]
+	mi := &file_pfs_pfs_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3425,7 +3848,7 @@ func (x *InspectFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InspectFileRequest.ProtoReflect.Descriptor instead.
 func (*InspectFileRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{47}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *InspectFileRequest) GetFile() *File {
@@ -3458,7 +3881,7 @@ type ListFileRequest struct {
 func (x *ListFileRequest) Reset() {
 	*x = ListFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[48]
+		mi := &file_pfs_pfs_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3471,7 +3894,7 @@ func (x *ListFileRequest) String() string {
 func (*ListFileRequest) ProtoMessage() {}
 
 func (x *ListFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[48]
+	mi := &file_pfs_pfs_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3484,7 +3907,7 @@ func (x *ListFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFileRequest.ProtoReflect.Descriptor instead.
 func (*ListFileRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{48}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *ListFileRequest) GetFile() *File {
@@ -3534,7 +3957,7 @@ type WalkFileRequest struct {
 func (x *WalkFileRequest) Reset() {
 	*x = WalkFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[49]
+		mi := &file_pfs_pfs_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3547,7 +3970,7 @@ func (x *WalkFileRequest) String() string {
 func (*WalkFileRequest) ProtoMessage() {}
 
 func (x *WalkFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[49]
+	mi := &file_pfs_pfs_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3560,7 +3983,7 @@ func (x *WalkFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WalkFileRequest.ProtoReflect.Descriptor instead.
 func (*WalkFileRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{49}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *WalkFileRequest) GetFile() *File {
@@ -3604,7 +4027,7 @@ type GlobFileRequest struct {
 func (x *GlobFileRequest) Reset() {
 	*x = GlobFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[50]
+		mi := &file_pfs_pfs_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3617,7 +4040,7 @@ func (x *GlobFileRequest) String() string {
 func (*GlobFileRequest) ProtoMessage() {}
 
 func (x *GlobFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[50]
+	mi := &file_pfs_pfs_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3630,7 +4053,7 @@ func (x *GlobFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GlobFileRequest.ProtoReflect.Descriptor instead.
 func (*GlobFileRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{50}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *GlobFileRequest) GetCommit() *Commit {
@@ -3669,7 +4092,7 @@ type DiffFileRequest struct {
 func (x *DiffFileRequest) Reset() {
 	*x = DiffFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[51]
+		mi := &file_pfs_pfs_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3682,7 +4105,7 @@ func (x *DiffFileRequest) String() string {
 func (*DiffFileRequest) ProtoMessage() {}
 
 func (x *DiffFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[51]
+	mi := &file_pfs_pfs_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3695,7 +4118,7 @@ func (x *DiffFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiffFileRequest.ProtoReflect.Descriptor instead.
 func (*DiffFileRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{51}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *DiffFileRequest) GetNewFile() *File {
@@ -3731,7 +4154,7 @@ type DiffFileResponse struct {
 func (x *DiffFileResponse) Reset() {
 	*x = DiffFileResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[52]
+		mi := &file_pfs_pfs_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3744,7 +4167,7 @@ func (x *DiffFileResponse) String() string {
 func (*DiffFileResponse) ProtoMessage() {}
 
 func (x *DiffFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[52]
+	mi := &file_pfs_pfs_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3757,7 +4180,7 @@ func (x *DiffFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiffFileResponse.ProtoReflect.Descriptor instead.
 func (*DiffFileResponse) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{52}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *DiffFileResponse) GetNewFile() *FileInfo {
@@ -3790,7 +4213,7 @@ type FsckRequest struct {
 func (x *FsckRequest) Reset() {
 	*x = FsckRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[53]
+		mi := &file_pfs_pfs_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3803,7 +4226,7 @@ func (x *FsckRequest) String() string {
 func (*FsckRequest) ProtoMessage() {}
 
 func (x *FsckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[53]
+	mi := &file_pfs_pfs_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3816,7 +4239,7 @@ func (x *FsckRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FsckRequest.ProtoReflect.Descriptor instead.
 func (*FsckRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{53}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *FsckRequest) GetFix() bool {
@@ -3876,7 +4299,7 @@ type FsckResponse struct {
 func (x *FsckResponse) Reset() {
 	*x = FsckResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[54]
+		mi := &file_pfs_pfs_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3889,7 +4312,7 @@ func (x *FsckResponse) String() string {
 func (*FsckResponse) ProtoMessage() {}
 
 func (x *FsckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[54]
+	mi := &file_pfs_pfs_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3902,7 +4325,7 @@ func (x *FsckResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FsckResponse.ProtoReflect.Descriptor instead.
 func (*FsckResponse) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{54}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *FsckResponse) GetFix() string {
@@ -3930,7 +4353,7 @@ type CreateFileSetResponse struct {
 func (x *CreateFileSetResponse) Reset() {
 	*x = CreateFileSetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[55]
+		mi := &file_pfs_pfs_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3943,7 +4366,7 @@ func (x *CreateFileSetResponse) String() string {
 func (*CreateFileSetResponse) ProtoMessage() {}
 
 func (x *CreateFileSetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[55]
+	mi := &file_pfs_pfs_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3956,7 +4379,7 @@ func (x *CreateFileSetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateFileSetResponse.ProtoReflect.Descriptor instead.
 func (*CreateFileSetResponse) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{55}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *CreateFileSetResponse) GetFileSetId() string {
@@ -3977,7 +4400,7 @@ type GetFileSetRequest struct {
 func (x *GetFileSetRequest) Reset() {
 	*x = GetFileSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[56]
+		mi := &file_pfs_pfs_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3990,7 +4413,7 @@ func (x *GetFileSetRequest) String() string {
 func (*GetFileSetRequest) ProtoMessage() {}
 
 func (x *GetFileSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[56]
+	mi := &file_pfs_pfs_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4003,7 +4426,7 @@ func (x *GetFileSetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFileSetRequest.ProtoReflect.Descriptor instead.
 func (*GetFileSetRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{56}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *GetFileSetRequest) GetCommit() *Commit {
@@ -4025,7 +4448,7 @@ type AddFileSetRequest struct {
 func (x *AddFileSetRequest) Reset() {
 	*x = AddFileSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[57]
+		mi := &file_pfs_pfs_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4038,7 +4461,7 @@ func (x *AddFileSetRequest) String() string {
 func (*AddFileSetRequest) ProtoMessage() {}
 
 func (x *AddFileSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[57]
+	mi := &file_pfs_pfs_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4051,7 +4474,7 @@ func (x *AddFileSetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddFileSetRequest.ProtoReflect.Descriptor instead.
 func (*AddFileSetRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{57}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *AddFileSetRequest) GetCommit() *Commit {
@@ -4080,7 +4503,7 @@ type RenewFileSetRequest struct {
 func (x *RenewFileSetRequest) Reset() {
 	*x = RenewFileSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[58]
+		mi := &file_pfs_pfs_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4093,7 +4516,7 @@ func (x *RenewFileSetRequest) String() string {
 func (*RenewFileSetRequest) ProtoMessage() {}
 
 func (x *RenewFileSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[58]
+	mi := &file_pfs_pfs_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4106,7 +4529,7 @@ func (x *RenewFileSetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenewFileSetRequest.ProtoReflect.Descriptor instead.
 func (*RenewFileSetRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{58}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *RenewFileSetRequest) GetFileSetId() string {
@@ -4136,7 +4559,7 @@ type ComposeFileSetRequest struct {
 func (x *ComposeFileSetRequest) Reset() {
 	*x = ComposeFileSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[59]
+		mi := &file_pfs_pfs_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4149,7 +4572,7 @@ func (x *ComposeFileSetRequest) String() string {
 func (*ComposeFileSetRequest) ProtoMessage() {}
 
 func (x *ComposeFileSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[59]
+	mi := &file_pfs_pfs_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4162,7 +4585,7 @@ func (x *ComposeFileSetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ComposeFileSetRequest.ProtoReflect.Descriptor instead.
 func (*ComposeFileSetRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{59}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *ComposeFileSetRequest) GetFileSetIds() []string {
@@ -4197,7 +4620,7 @@ type ShardFileSetRequest struct {
 func (x *ShardFileSetRequest) Reset() {
 	*x = ShardFileSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[60]
+		mi := &file_pfs_pfs_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4210,7 +4633,7 @@ func (x *ShardFileSetRequest) String() string {
 func (*ShardFileSetRequest) ProtoMessage() {}
 
 func (x *ShardFileSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[60]
+	mi := &file_pfs_pfs_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4223,7 +4646,7 @@ func (x *ShardFileSetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShardFileSetRequest.ProtoReflect.Descriptor instead.
 func (*ShardFileSetRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{60}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *ShardFileSetRequest) GetFileSetId() string {
@@ -4245,7 +4668,7 @@ type PathRange struct {
 func (x *PathRange) Reset() {
 	*x = PathRange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[61]
+		mi := &file_pfs_pfs_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4258,7 +4681,7 @@ func (x *PathRange) String() string {
 func (*PathRange) ProtoMessage() {}
 
 func (x *PathRange) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[61]
+	mi := &file_pfs_pfs_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4271,7 +4694,7 @@ func (x *PathRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PathRange.ProtoReflect.Descriptor instead.
 func (*PathRange) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{61}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *PathRange) GetLower() string {
@@ -4299,7 +4722,7 @@ type ShardFileSetResponse struct {
 func (x *ShardFileSetResponse) Reset() {
 	*x = ShardFileSetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[62]
+		mi := &file_pfs_pfs_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4312,7 +4735,7 @@ func (x *ShardFileSetResponse) String() string {
 func (*ShardFileSetResponse) ProtoMessage() {}
 
 func (x *ShardFileSetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[62]
+	mi := &file_pfs_pfs_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4325,7 +4748,7 @@ func (x *ShardFileSetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShardFileSetResponse.ProtoReflect.Descriptor instead.
 func (*ShardFileSetResponse) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{62}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *ShardFileSetResponse) GetShards() []*PathRange {
@@ -4348,7 +4771,7 @@ type CheckStorageRequest struct {
 func (x *CheckStorageRequest) Reset() {
 	*x = CheckStorageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[63]
+		mi := &file_pfs_pfs_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4361,7 +4784,7 @@ func (x *CheckStorageRequest) String() string {
 func (*CheckStorageRequest) ProtoMessage() {}
 
 func (x *CheckStorageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[63]
+	mi := &file_pfs_pfs_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4374,7 +4797,7 @@ func (x *CheckStorageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckStorageRequest.ProtoReflect.Descriptor instead.
 func (*CheckStorageRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{63}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *CheckStorageRequest) GetReadChunkData() bool {
@@ -4409,7 +4832,7 @@ type CheckStorageResponse struct {
 func (x *CheckStorageResponse) Reset() {
 	*x = CheckStorageResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[64]
+		mi := &file_pfs_pfs_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4422,7 +4845,7 @@ func (x *CheckStorageResponse) String() string {
 func (*CheckStorageResponse) ProtoMessage() {}
 
 func (x *CheckStorageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[64]
+	mi := &file_pfs_pfs_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4435,7 +4858,7 @@ func (x *CheckStorageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckStorageResponse.ProtoReflect.Descriptor instead.
 func (*CheckStorageResponse) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{64}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *CheckStorageResponse) GetChunkObjectCount() int64 {
@@ -4459,7 +4882,7 @@ type PutCacheRequest struct {
 func (x *PutCacheRequest) Reset() {
 	*x = PutCacheRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[65]
+		mi := &file_pfs_pfs_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4472,7 +4895,7 @@ func (x *PutCacheRequest) String() string {
 func (*PutCacheRequest) ProtoMessage() {}
 
 func (x *PutCacheRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[65]
+	mi := &file_pfs_pfs_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4485,7 +4908,7 @@ func (x *PutCacheRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutCacheRequest.ProtoReflect.Descriptor instead.
 func (*PutCacheRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{65}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *PutCacheRequest) GetKey() string {
@@ -4527,7 +4950,7 @@ type GetCacheRequest struct {
 func (x *GetCacheRequest) Reset() {
 	*x = GetCacheRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[66]
+		mi := &file_pfs_pfs_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4540,7 +4963,7 @@ func (x *GetCacheRequest) String() string {
 func (*GetCacheRequest) ProtoMessage() {}
 
 func (x *GetCacheRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[66]
+	mi := &file_pfs_pfs_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4553,7 +4976,7 @@ func (x *GetCacheRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCacheRequest.ProtoReflect.Descriptor instead.
 func (*GetCacheRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{66}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *GetCacheRequest) GetKey() string {
@@ -4574,7 +4997,7 @@ type GetCacheResponse struct {
 func (x *GetCacheResponse) Reset() {
 	*x = GetCacheResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[67]
+		mi := &file_pfs_pfs_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4587,7 +5010,7 @@ func (x *GetCacheResponse) String() string {
 func (*GetCacheResponse) ProtoMessage() {}
 
 func (x *GetCacheResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[67]
+	mi := &file_pfs_pfs_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4600,7 +5023,7 @@ func (x *GetCacheResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCacheResponse.ProtoReflect.Descriptor instead.
 func (*GetCacheResponse) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{67}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *GetCacheResponse) GetValue() *anypb.Any {
@@ -4621,7 +5044,7 @@ type ClearCacheRequest struct {
 func (x *ClearCacheRequest) Reset() {
 	*x = ClearCacheRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[68]
+		mi := &file_pfs_pfs_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4634,7 +5057,7 @@ func (x *ClearCacheRequest) String() string {
 func (*ClearCacheRequest) ProtoMessage() {}
 
 func (x *ClearCacheRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[68]
+	mi := &file_pfs_pfs_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4647,7 +5070,7 @@ func (x *ClearCacheRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClearCacheRequest.ProtoReflect.Descriptor instead.
 func (*ClearCacheRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{68}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *ClearCacheRequest) GetTagPrefix() string {
@@ -4666,7 +5089,7 @@ type ActivateAuthRequest struct {
 func (x *ActivateAuthRequest) Reset() {
 	*x = ActivateAuthRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[69]
+		mi := &file_pfs_pfs_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4679,7 +5102,7 @@ func (x *ActivateAuthRequest) String() string {
 func (*ActivateAuthRequest) ProtoMessage() {}
 
 func (x *ActivateAuthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[69]
+	mi := &file_pfs_pfs_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4692,7 +5115,7 @@ func (x *ActivateAuthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActivateAuthRequest.ProtoReflect.Descriptor instead.
 func (*ActivateAuthRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{69}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{73}
 }
 
 type ActivateAuthResponse struct {
@@ -4704,7 +5127,7 @@ type ActivateAuthResponse struct {
 func (x *ActivateAuthResponse) Reset() {
 	*x = ActivateAuthResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[70]
+		mi := &file_pfs_pfs_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4717,7 +5140,7 @@ func (x *ActivateAuthResponse) String() string {
 func (*ActivateAuthResponse) ProtoMessage() {}
 
 func (x *ActivateAuthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[70]
+	mi := &file_pfs_pfs_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4730,7 +5153,7 @@ func (x *ActivateAuthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActivateAuthResponse.ProtoReflect.Descriptor instead.
 func (*ActivateAuthResponse) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{70}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{74}
 }
 
 type ObjectStorageEgress struct {
@@ -4744,7 +5167,7 @@ type ObjectStorageEgress struct {
 func (x *ObjectStorageEgress) Reset() {
 	*x = ObjectStorageEgress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[71]
+		mi := &file_pfs_pfs_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4757,7 +5180,7 @@ func (x *ObjectStorageEgress) String() string {
 func (*ObjectStorageEgress) ProtoMessage() {}
 
 func (x *ObjectStorageEgress) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[71]
+	mi := &file_pfs_pfs_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4770,7 +5193,7 @@ func (x *ObjectStorageEgress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObjectStorageEgress.ProtoReflect.Descriptor instead.
 func (*ObjectStorageEgress) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{71}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *ObjectStorageEgress) GetUrl() string {
@@ -4793,7 +5216,7 @@ type SQLDatabaseEgress struct {
 func (x *SQLDatabaseEgress) Reset() {
 	*x = SQLDatabaseEgress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[72]
+		mi := &file_pfs_pfs_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4806,7 +5229,7 @@ func (x *SQLDatabaseEgress) String() string {
 func (*SQLDatabaseEgress) ProtoMessage() {}
 
 func (x *SQLDatabaseEgress) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[72]
+	mi := &file_pfs_pfs_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4819,7 +5242,7 @@ func (x *SQLDatabaseEgress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLDatabaseEgress.ProtoReflect.Descriptor instead.
 func (*SQLDatabaseEgress) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{72}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *SQLDatabaseEgress) GetUrl() string {
@@ -4859,7 +5282,7 @@ type EgressRequest struct {
 func (x *EgressRequest) Reset() {
 	*x = EgressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[73]
+		mi := &file_pfs_pfs_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4872,7 +5295,7 @@ func (x *EgressRequest) String() string {
 func (*EgressRequest) ProtoMessage() {}
 
 func (x *EgressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[73]
+	mi := &file_pfs_pfs_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4885,7 +5308,7 @@ func (x *EgressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EgressRequest.ProtoReflect.Descriptor instead.
 func (*EgressRequest) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{73}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *EgressRequest) GetCommit() *Commit {
@@ -4947,7 +5370,7 @@ type EgressResponse struct {
 func (x *EgressResponse) Reset() {
 	*x = EgressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[74]
+		mi := &file_pfs_pfs_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4960,7 +5383,7 @@ func (x *EgressResponse) String() string {
 func (*EgressResponse) ProtoMessage() {}
 
 func (x *EgressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[74]
+	mi := &file_pfs_pfs_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4973,7 +5396,7 @@ func (x *EgressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EgressResponse.ProtoReflect.Descriptor instead.
 func (*EgressResponse) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{74}
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{78}
 }
 
 func (m *EgressResponse) GetResult() isEgressResponse_Result {
@@ -5013,32 +5436,32 @@ func (*EgressResponse_ObjectStorage) isEgressResponse_Result() {}
 
 func (*EgressResponse_SqlDatabase) isEgressResponse_Result() {}
 
-// Details are only provided when explicitly requested
-type RepoInfo_Details struct {
+// Replication continuously mirrors branches from this cluster to another pachd.
+type Replication struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SizeBytes int64 `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *RepoInfo_Details) Reset() {
-	*x = RepoInfo_Details{}
+func (x *Replication) Reset() {
+	*x = Replication{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[75]
+		mi := &file_pfs_pfs_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RepoInfo_Details) String() string {
+func (x *Replication) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepoInfo_Details) ProtoMessage() {}
+func (*Replication) ProtoMessage() {}
 
-func (x *RepoInfo_Details) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[75]
+func (x *Replication) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5049,46 +5472,48 @@ func (x *RepoInfo_Details) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepoInfo_Details.ProtoReflect.Descriptor instead.
-func (*RepoInfo_Details) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{3, 0}
+// Deprecated: Use Replication.ProtoReflect.Descriptor instead.
+func (*Replication) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *RepoInfo_Details) GetSizeBytes() int64 {
+func (x *Replication) GetName() string {
 	if x != nil {
-		return x.SizeBytes
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-// Details are only provided when explicitly requested
-type CommitInfo_Details struct {
+type ReplicationTarget struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SizeBytes      int64                `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
-	CompactingTime *durationpb.Duration `protobuf:"bytes,2,opt,name=compacting_time,json=compactingTime,proto3" json:"compacting_time,omitempty"`
-	ValidatingTime *durationpb.Duration `protobuf:"bytes,3,opt,name=validating_time,json=validatingTime,proto3" json:"validating_time,omitempty"`
+	// pachd_address is the address of the target pachd, e.g. grpcs://pachd.example.com:30650.
+	PachdAddress string `protobuf:"bytes,1,opt,name=pachd_address,json=pachdAddress,proto3" json:"pachd_address,omitempty"`
+	// auth_token_secret is the Kubernetes secret holding the auth token used
+	// to connect to the target.  It is only required if auth is enabled on the
+	// target.
+	AuthTokenSecret *ReplicationTarget_Secret `protobuf:"bytes,2,opt,name=auth_token_secret,json=authTokenSecret,proto3" json:"auth_token_secret,omitempty"`
 }
 
-func (x *CommitInfo_Details) Reset() {
-	*x = CommitInfo_Details{}
+func (x *ReplicationTarget) Reset() {
+	*x = ReplicationTarget{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[76]
+		mi := &file_pfs_pfs_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CommitInfo_Details) String() string {
+func (x *ReplicationTarget) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommitInfo_Details) ProtoMessage() {}
+func (*ReplicationTarget) ProtoMessage() {}
 
-func (x *CommitInfo_Details) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[76]
+func (x *ReplicationTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5099,59 +5524,176 @@ func (x *CommitInfo_Details) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommitInfo_Details.ProtoReflect.Descriptor instead.
-func (*CommitInfo_Details) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use ReplicationTarget.ProtoReflect.Descriptor instead.
+func (*ReplicationTarget) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *CommitInfo_Details) GetSizeBytes() int64 {
+func (x *ReplicationTarget) GetPachdAddress() string {
 	if x != nil {
-		return x.SizeBytes
+		return x.PachdAddress
+	}
+	return ""
+}
+
+func (x *ReplicationTarget) GetAuthTokenSecret() *ReplicationTarget_Secret {
+	if x != nil {
+		return x.AuthTokenSecret
+	}
+	return nil
+}
+
+// BranchReplicationStatus describes how far a replicated branch has progressed.
+type BranchReplicationStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Branch *Branch `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
+	// last_replicated_commit is the most recent commit on the source branch
+	// that exists on the target.
+	LastReplicatedCommit *Commit `protobuf:"bytes,2,opt,name=last_replicated_commit,json=lastReplicatedCommit,proto3" json:"last_replicated_commit,omitempty"`
+	// target_commit is the commit on the target that last_replicated_commit was
+	// replicated to.
+	TargetCommit     *Commit                `protobuf:"bytes,3,opt,name=target_commit,json=targetCommit,proto3" json:"target_commit,omitempty"`
+	LastReplicatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_replicated_at,json=lastReplicatedAt,proto3" json:"last_replicated_at,omitempty"`
+	// lag is the time between last_replicated_commit finishing on the source
+	// and it being finished on the target.
+	Lag              *durationpb.Duration `protobuf:"bytes,5,opt,name=lag,proto3" json:"lag,omitempty"`
+	BytesTransferred int64                `protobuf:"varint,6,opt,name=bytes_transferred,json=bytesTransferred,proto3" json:"bytes_transferred,omitempty"`
+	Error            string               `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	// pending_commit is the source commit currently being replicated, and
+	// pending_target_commit is the commit started for it on the target. They
+	// are recorded before the target commit is finished so that a retry can
+	// resume or clean up the target commit rather than starting another one.
+	PendingCommit       *Commit `protobuf:"bytes,8,opt,name=pending_commit,json=pendingCommit,proto3" json:"pending_commit,omitempty"`
+	PendingTargetCommit *Commit `protobuf:"bytes,9,opt,name=pending_target_commit,json=pendingTargetCommit,proto3" json:"pending_target_commit,omitempty"`
+}
+
+func (x *BranchReplicationStatus) Reset() {
+	*x = BranchReplicationStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BranchReplicationStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BranchReplicationStatus) ProtoMessage() {}
+
+func (x *BranchReplicationStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BranchReplicationStatus.ProtoReflect.Descriptor instead.
+func (*BranchReplicationStatus) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *BranchReplicationStatus) GetBranch() *Branch {
+	if x != nil {
+		return x.Branch
+	}
+	return nil
+}
+
+func (x *BranchReplicationStatus) GetLastReplicatedCommit() *Commit {
+	if x != nil {
+		return x.LastReplicatedCommit
+	}
+	return nil
+}
+
+func (x *BranchReplicationStatus) GetTargetCommit() *Commit {
+	if x != nil {
+		return x.TargetCommit
+	}
+	return nil
+}
+
+func (x *BranchReplicationStatus) GetLastReplicatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastReplicatedAt
+	}
+	return nil
+}
+
+func (x *BranchReplicationStatus) GetLag() *durationpb.Duration {
+	if x != nil {
+		return x.Lag
+	}
+	return nil
+}
+
+func (x *BranchReplicationStatus) GetBytesTransferred() int64 {
+	if x != nil {
+		return x.BytesTransferred
 	}
 	return 0
 }
 
-func (x *CommitInfo_Details) GetCompactingTime() *durationpb.Duration {
+func (x *BranchReplicationStatus) GetError() string {
 	if x != nil {
-		return x.CompactingTime
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BranchReplicationStatus) GetPendingCommit() *Commit {
+	if x != nil {
+		return x.PendingCommit
 	}
 	return nil
 }
 
-func (x *CommitInfo_Details) GetValidatingTime() *durationpb.Duration {
+func (x *BranchReplicationStatus) GetPendingTargetCommit() *Commit {
 	if x != nil {
-		return x.ValidatingTime
+		return x.PendingTargetCommit
 	}
 	return nil
 }
 
-type AddFile_URLSource struct {
+type ReplicationInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	URL         string `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
-	Recursive   bool   `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
-	Concurrency uint32 `protobuf:"varint,3,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	Replication *Replication               `protobuf:"bytes,1,opt,name=replication,proto3" json:"replication,omitempty"`
+	Target      *ReplicationTarget         `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Branches    []*Branch                  `protobuf:"bytes,3,rep,name=branches,proto3" json:"branches,omitempty"`
+	CreatedAt   *timestamppb.Timestamp     `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Status      []*BranchReplicationStatus `protobuf:"bytes,5,rep,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *AddFile_URLSource) Reset() {
-	*x = AddFile_URLSource{}
+func (x *ReplicationInfo) Reset() {
+	*x = ReplicationInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[77]
+		mi := &file_pfs_pfs_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddFile_URLSource) String() string {
+func (x *ReplicationInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddFile_URLSource) ProtoMessage() {}
+func (*ReplicationInfo) ProtoMessage() {}
 
-func (x *AddFile_URLSource) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[77]
+func (x *ReplicationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5162,58 +5704,74 @@ func (x *AddFile_URLSource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddFile_URLSource.ProtoReflect.Descriptor instead.
-func (*AddFile_URLSource) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{42, 0}
+// Deprecated: Use ReplicationInfo.ProtoReflect.Descriptor instead.
+func (*ReplicationInfo) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *AddFile_URLSource) GetURL() string {
+func (x *ReplicationInfo) GetReplication() *Replication {
 	if x != nil {
-		return x.URL
+		return x.Replication
 	}
-	return ""
+	return nil
 }
 
-func (x *AddFile_URLSource) GetRecursive() bool {
+func (x *ReplicationInfo) GetTarget() *ReplicationTarget {
 	if x != nil {
-		return x.Recursive
+		return x.Target
 	}
-	return false
+	return nil
 }
 
-func (x *AddFile_URLSource) GetConcurrency() uint32 {
+func (x *ReplicationInfo) GetBranches() []*Branch {
 	if x != nil {
-		return x.Concurrency
+		return x.Branches
 	}
-	return 0
+	return nil
 }
 
-type SQLDatabaseEgress_FileFormat struct {
+func (x *ReplicationInfo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ReplicationInfo) GetStatus() []*BranchReplicationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type CreateReplicationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type    SQLDatabaseEgress_FileFormat_Type `protobuf:"varint,1,opt,name=type,proto3,enum=pfs_v2.SQLDatabaseEgress_FileFormat_Type" json:"type,omitempty"`
-	Columns []string                          `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	Replication *Replication       `protobuf:"bytes,1,opt,name=replication,proto3" json:"replication,omitempty"`
+	Target      *ReplicationTarget `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Branches    []*Branch          `protobuf:"bytes,3,rep,name=branches,proto3" json:"branches,omitempty"`
+	Update      bool               `protobuf:"varint,4,opt,name=update,proto3" json:"update,omitempty"`
 }
 
-func (x *SQLDatabaseEgress_FileFormat) Reset() {
-	*x = SQLDatabaseEgress_FileFormat{}
+func (x *CreateReplicationRequest) Reset() {
+	*x = CreateReplicationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[78]
+		mi := &file_pfs_pfs_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SQLDatabaseEgress_FileFormat) String() string {
+func (x *CreateReplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SQLDatabaseEgress_FileFormat) ProtoMessage() {}
+func (*CreateReplicationRequest) ProtoMessage() {}
 
-func (x *SQLDatabaseEgress_FileFormat) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[78]
+func (x *CreateReplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5224,51 +5782,64 @@ func (x *SQLDatabaseEgress_FileFormat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SQLDatabaseEgress_FileFormat.ProtoReflect.Descriptor instead.
-func (*SQLDatabaseEgress_FileFormat) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{72, 0}
+// Deprecated: Use CreateReplicationRequest.ProtoReflect.Descriptor instead.
+func (*CreateReplicationRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *SQLDatabaseEgress_FileFormat) GetType() SQLDatabaseEgress_FileFormat_Type {
+func (x *CreateReplicationRequest) GetReplication() *Replication {
 	if x != nil {
-		return x.Type
+		return x.Replication
 	}
-	return SQLDatabaseEgress_FileFormat_UNKNOWN
+	return nil
 }
 
-func (x *SQLDatabaseEgress_FileFormat) GetColumns() []string {
+func (x *CreateReplicationRequest) GetTarget() *ReplicationTarget {
 	if x != nil {
-		return x.Columns
+		return x.Target
 	}
 	return nil
 }
 
-type SQLDatabaseEgress_Secret struct {
+func (x *CreateReplicationRequest) GetBranches() []*Branch {
+	if x != nil {
+		return x.Branches
+	}
+	return nil
+}
+
+func (x *CreateReplicationRequest) GetUpdate() bool {
+	if x != nil {
+		return x.Update
+	}
+	return false
+}
+
+type InspectReplicationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Key  string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Replication *Replication `protobuf:"bytes,1,opt,name=replication,proto3" json:"replication,omitempty"`
 }
 
-func (x *SQLDatabaseEgress_Secret) Reset() {
-	*x = SQLDatabaseEgress_Secret{}
+func (x *InspectReplicationRequest) Reset() {
+	*x = InspectReplicationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[79]
+		mi := &file_pfs_pfs_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SQLDatabaseEgress_Secret) String() string {
+func (x *InspectReplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SQLDatabaseEgress_Secret) ProtoMessage() {}
+func (*InspectReplicationRequest) ProtoMessage() {}
 
-func (x *SQLDatabaseEgress_Secret) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[79]
+func (x *InspectReplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5279,50 +5850,81 @@ func (x *SQLDatabaseEgress_Secret) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SQLDatabaseEgress_Secret.ProtoReflect.Descriptor instead.
-func (*SQLDatabaseEgress_Secret) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{72, 1}
+// Deprecated: Use InspectReplicationRequest.ProtoReflect.Descriptor instead.
+func (*InspectReplicationRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *SQLDatabaseEgress_Secret) GetName() string {
+func (x *InspectReplicationRequest) GetReplication() *Replication {
 	if x != nil {
-		return x.Name
+		return x.Replication
 	}
-	return ""
+	return nil
 }
 
-func (x *SQLDatabaseEgress_Secret) GetKey() string {
-	if x != nil {
-		return x.Key
+type ListReplicationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListReplicationRequest) Reset() {
+	*x = ListReplicationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-type EgressResponse_ObjectStorageResult struct {
+func (x *ListReplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReplicationRequest) ProtoMessage() {}
+
+func (x *ListReplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReplicationRequest.ProtoReflect.Descriptor instead.
+func (*ListReplicationRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{85}
+}
+
+type DeleteReplicationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BytesWritten int64 `protobuf:"varint,1,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+	Replication *Replication `protobuf:"bytes,1,opt,name=replication,proto3" json:"replication,omitempty"`
 }
 
-func (x *EgressResponse_ObjectStorageResult) Reset() {
-	*x = EgressResponse_ObjectStorageResult{}
+func (x *DeleteReplicationRequest) Reset() {
+	*x = DeleteReplicationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[80]
+		mi := &file_pfs_pfs_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EgressResponse_ObjectStorageResult) String() string {
+func (x *DeleteReplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EgressResponse_ObjectStorageResult) ProtoMessage() {}
+func (*DeleteReplicationRequest) ProtoMessage() {}
 
-func (x *EgressResponse_ObjectStorageResult) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[80]
+func (x *DeleteReplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5333,43 +5935,43 @@ func (x *EgressResponse_ObjectStorageResult) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EgressResponse_ObjectStorageResult.ProtoReflect.Descriptor instead.
-func (*EgressResponse_ObjectStorageResult) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{74, 0}
+// Deprecated: Use DeleteReplicationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReplicationRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *EgressResponse_ObjectStorageResult) GetBytesWritten() int64 {
+func (x *DeleteReplicationRequest) GetReplication() *Replication {
 	if x != nil {
-		return x.BytesWritten
+		return x.Replication
 	}
-	return 0
+	return nil
 }
 
-type EgressResponse_SQLDatabaseResult struct {
+type MissingChunksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RowsWritten map[string]int64 `protobuf:"bytes,1,rep,name=rows_written,json=rowsWritten,proto3" json:"rows_written,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ChunkIds [][]byte `protobuf:"bytes,1,rep,name=chunk_ids,json=chunkIds,proto3" json:"chunk_ids,omitempty"`
 }
 
-func (x *EgressResponse_SQLDatabaseResult) Reset() {
-	*x = EgressResponse_SQLDatabaseResult{}
+func (x *MissingChunksRequest) Reset() {
+	*x = MissingChunksRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfs_pfs_proto_msgTypes[81]
+		mi := &file_pfs_pfs_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EgressResponse_SQLDatabaseResult) String() string {
+func (x *MissingChunksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EgressResponse_SQLDatabaseResult) ProtoMessage() {}
+func (*MissingChunksRequest) ProtoMessage() {}
 
-func (x *EgressResponse_SQLDatabaseResult) ProtoReflect() protoreflect.Message {
-	mi := &file_pfs_pfs_proto_msgTypes[81]
+func (x *MissingChunksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5380,1194 +5982,4700 @@ func (x *EgressResponse_SQLDatabaseResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EgressResponse_SQLDatabaseResult.ProtoReflect.Descriptor instead.
-func (*EgressResponse_SQLDatabaseResult) Descriptor() ([]byte, []int) {
-	return file_pfs_pfs_proto_rawDescGZIP(), []int{74, 1}
+// Deprecated: Use MissingChunksRequest.ProtoReflect.Descriptor instead.
+func (*MissingChunksRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *EgressResponse_SQLDatabaseResult) GetRowsWritten() map[string]int64 {
+func (x *MissingChunksRequest) GetChunkIds() [][]byte {
 	if x != nil {
-		return x.RowsWritten
+		return x.ChunkIds
 	}
 	return nil
 }
 
-var File_pfs_pfs_proto protoreflect.FileDescriptor
+type MissingChunksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_pfs_pfs_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x70, 0x66, 0x73, 0x2f, 0x70, 0x66, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x06, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x0f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x0f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x1e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x59, 0x0a, 0x04, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x3e, 0x0a,
-	0x06, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52,
-	0x65, 0x70, 0x6f, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x58, 0x0a,
-	0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x22, 0xf2, 0x02, 0x0a, 0x08, 0x52, 0x65, 0x70, 0x6f,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f,
-	0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x16,
-	0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72,
-	0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x73, 0x69,
-	0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x55, 0x70, 0x70, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e,
-	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42,
-	0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12,
-	0x2d, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x75, 0x74, 0x68,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x32,
-	0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x66,
-	0x6f, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x1a, 0x28, 0x0a, 0x07, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1d, 0x0a,
-	0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x57, 0x0a, 0x08,
-	0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x35, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e,
-	0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
-	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0xa0, 0x02, 0x0a, 0x0a, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72,
-	0x61, 0x6e, 0x63, 0x68, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x22, 0x0a, 0x04,
-	0x68, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73,
-	0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x04, 0x68, 0x65, 0x61, 0x64,
-	0x12, 0x2e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72,
-	0x61, 0x6e, 0x63, 0x68, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
-	0x12, 0x2e, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72,
-	0x61, 0x6e, 0x63, 0x68, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
-	0x12, 0x3b, 0x0a, 0x11, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x65,
-	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x10, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a,
-	0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52,
-	0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x22, 0xa6, 0x01, 0x0a, 0x07, 0x54, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x10, 0x0a, 0x03,
-	0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12, 0x26,
-	0x0a, 0x0f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x70, 0x65,
-	0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x6f, 0x6e, 0x5f, 0x73, 0x70, 0x65,
-	0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x6f, 0x6e, 0x53, 0x70, 0x65,
-	0x63, 0x22, 0x36, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x12, 0x26, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4b,
-	0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x62, 0x0a, 0x06, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52,
-	0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42,
-	0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x22, 0x8d, 0x06,
-	0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a, 0x06,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70,
-	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x0c, 0x70, 0x61, 0x72,
-	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x33, 0x0a, 0x0d, 0x63, 0x68, 0x69,
-	0x6c, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x52, 0x0c, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x34,
-	0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x69, 0x6e,
-	0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x36,
-	0x0a, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x66, 0x69,
-	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x11, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x5f, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x52, 0x10, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61,
-	0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x16, 0x73, 0x69, 0x7a,
-	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6f,
-	0x75, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x73, 0x69, 0x7a, 0x65, 0x42,
-	0x79, 0x74, 0x65, 0x73, 0x55, 0x70, 0x70, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x34,
-	0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x73, 0x1a, 0xb0, 0x01, 0x0a, 0x07, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
-	0x42, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6e,
-	0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x4a, 0x04, 0x08, 0x09, 0x10, 0x0a, 0x22, 0x1b, 0x0a,
-	0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6f, 0x0a, 0x0d, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x30, 0x0a, 0x0a, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53,
-	0x65, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x12, 0x2c, 0x0a,
-	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x08,
-	0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
-	0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2d, 0x0a, 0x09, 0x66, 0x69,
-	0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
-	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74,
-	0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x1d, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
-	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41,
-	0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x6f, 0x0a, 0x11,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04, 0x72,
-	0x65, 0x70, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x36, 0x0a,
-	0x12, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52,
-	0x04, 0x72, 0x65, 0x70, 0x6f, 0x22, 0x52, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
-	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x08,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
-	0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0x4b, 0x0a, 0x11, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
-	0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
-	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f,
-	0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x69, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x08,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
-	0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72,
-	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12,
-	0x10, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c,
-	0x6c, 0x22, 0x2e, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x22, 0x39, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6f,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x22, 0x86, 0x01, 0x0a,
-	0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a,
-	0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x06, 0x62,
-	0x72, 0x61, 0x6e, 0x63, 0x68, 0x22, 0x8b, 0x01, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68,
-	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
-	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a,
-	0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f,
-	0x72, 0x63, 0x65, 0x22, 0x67, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x77, 0x61, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x13, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x04, 0x77, 0x61, 0x69, 0x74, 0x22, 0xb1, 0x02, 0x0a,
-	0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04,
-	0x72, 0x65, 0x70, 0x6f, 0x12, 0x22, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c,
-	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12, 0x33, 0x0a, 0x0b,
-	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4b, 0x69, 0x6e,
-	0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65,
-	0x22, 0x5f, 0x0a, 0x17, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53,
-	0x65, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x77, 0x61, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x77, 0x61, 0x69,
-	0x74, 0x22, 0x41, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73,
-	0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x22, 0x4a, 0x0a, 0x16, 0x53, 0x71, 0x75, 0x61, 0x73, 0x68, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30,
-	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74,
-	0x22, 0x52, 0x0a, 0x14, 0x44, 0x72, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70,
-	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x42,
-	0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x53, 0x65, 0x74, 0x22, 0xe8, 0x01, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04, 0x72, 0x65, 0x70,
-	0x6f, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x22, 0x0a, 0x04, 0x66, 0x72, 0x6f,
-	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x29, 0x0a,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70,
-	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12, 0x33, 0x0a, 0x0b, 0x6f, 0x72,
-	0x69, 0x67, 0x69, 0x6e, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4b,
-	0x69, 0x6e, 0x64, 0x52, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x22,
-	0x3c, 0x0a, 0x12, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0xe2, 0x01,
-	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x68, 0x65, 0x61, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x52, 0x04, 0x68, 0x65, 0x61, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x62, 0x72, 0x61,
-	0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f,
-	0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63,
-	0x68, 0x12, 0x2e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42,
-	0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63,
-	0x65, 0x12, 0x29, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x52, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0e,
-	0x6e, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53,
-	0x65, 0x74, 0x22, 0x77, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10,
-	0x01, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65,
-	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
-	0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xc3, 0x01, 0x0a, 0x13,
-	0x46, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0c, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f,
-	0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x66, 0x6f, 0x75,
-	0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x42, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74,
-	0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
-	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x00, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x29, 0x0a, 0x10,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x22, 0x3e, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x42, 0x72, 0x61, 0x6e,
-	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x62, 0x72, 0x61,
-	0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f,
-	0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63,
-	0x68, 0x22, 0x4f, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65,
-	0x70, 0x6f, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65,
-	0x72, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72,
-	0x73, 0x65, 0x22, 0x53, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e,
-	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x62, 0x72, 0x61,
-	0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f,
-	0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63,
-	0x68, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x7b, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x29, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x22, 0x4c, 0x0a, 0x15, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x50,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a,
-	0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x42,
-	0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x57, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x29, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66,
-	0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63,
-	0x65, 0x22, 0xfc, 0x01, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x12, 0x2f, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x48, 0x00, 0x52, 0x03, 0x72, 0x61, 0x77, 0x12, 0x2d, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41,
-	0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x2e, 0x55, 0x52, 0x4c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x48, 0x00, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x1a, 0x5d, 0x0a, 0x09, 0x55, 0x52, 0x4c, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73,
-	0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72,
-	0x73, 0x69, 0x76, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x22, 0x36, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x22, 0x74, 0x0a, 0x08, 0x43, 0x6f, 0x70, 0x79,
-	0x46, 0x69, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x64, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x12, 0x28, 0x0a, 0x03,
-	0x73, 0x72, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f,
-	0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10,
-	0x01, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x22, 0x8a,
-	0x02, 0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
-	0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02,
-	0x10, 0x01, 0x48, 0x00, 0x52, 0x09, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12,
-	0x36, 0x0a, 0x08, 0x61, 0x64, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x69,
-	0x6c, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70,
-	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65,
-	0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x63, 0x6f, 0x70, 0x79,
-	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x66,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x08, 0xfa,
-	0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x70, 0x79, 0x46,
-	0x69, 0x6c, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x8e, 0x01, 0x0a, 0x0e,
-	0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
-	0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
-	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55,
-	0x52, 0x4c, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x61,
-	0x74, 0x68, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x09, 0x70, 0x61, 0x74, 0x68, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x36, 0x0a, 0x12,
-	0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04,
-	0x66, 0x69, 0x6c, 0x65, 0x22, 0xa5, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
-	0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x38, 0x0a, 0x10, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69,
-	0x6c, 0x65, 0x52, 0x10, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07,
-	0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72,
-	0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x9f, 0x01, 0x0a,
-	0x0f, 0x57, 0x61, 0x6c, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x20, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69,
-	0x6c, 0x65, 0x12, 0x38, 0x0a, 0x10, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
-	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x10, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x22, 0x85,
-	0x01, 0x0a, 0x0f, 0x47, 0x6c, 0x6f, 0x62, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
-	0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74,
-	0x74, 0x65, 0x72, 0x6e, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x72, 0x61, 0x6e,
-	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
-	0x32, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x70, 0x61, 0x74,
-	0x68, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x7d, 0x0a, 0x0f, 0x44, 0x69, 0x66, 0x66, 0x46, 0x69,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x08, 0x6e, 0x65, 0x77,
-	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x46, 0x69,
-	0x6c, 0x65, 0x12, 0x27, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69,
-	0x6c, 0x65, 0x52, 0x07, 0x6f, 0x6c, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x68, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x68,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x22, 0x6c, 0x0a, 0x10, 0x44, 0x69, 0x66, 0x66, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x6e, 0x65, 0x77,
-	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x66,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6e,
-	0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x66, 0x69,
-	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
-	0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6f, 0x6c, 0x64, 0x46,
-	0x69, 0x6c, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x0b, 0x46, 0x73, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x03, 0x66, 0x69, 0x78, 0x12, 0x35, 0x0a, 0x0d, 0x7a, 0x6f, 0x6d, 0x62, 0x69, 0x65, 0x5f,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70,
-	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x00, 0x52, 0x0c,
-	0x7a, 0x6f, 0x6d, 0x62, 0x69, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0a,
-	0x7a, 0x6f, 0x6d, 0x62, 0x69, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x48, 0x00, 0x52, 0x09, 0x7a, 0x6f, 0x6d, 0x62, 0x69, 0x65, 0x41, 0x6c, 0x6c, 0x42, 0x0e, 0x0a,
-	0x0c, 0x7a, 0x6f, 0x6d, 0x62, 0x69, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x22, 0x36, 0x0a,
-	0x0c, 0x46, 0x73, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66, 0x69, 0x78, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x37, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46,
-	0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e,
-	0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x49, 0x64, 0x22, 0x3b,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x5b, 0x0a, 0x11, 0x41,
-	0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65,
-	0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66,
-	0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x13, 0x52, 0x65, 0x6e, 0x65,
-	0x77, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1e, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x49, 0x64, 0x12,
-	0x1f, 0x0a, 0x0b, 0x74, 0x74, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
-	0x22, 0x74, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x66, 0x69, 0x6c,
-	0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0a, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
-	0x74, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63,
-	0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x22, 0x35, 0x0a, 0x13, 0x53, 0x68, 0x61, 0x72, 0x64, 0x46,
-	0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
-	0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x49, 0x64, 0x22, 0x37, 0x0a,
-	0x09, 0x50, 0x61, 0x74, 0x68, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f,
-	0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72,
-	0x12, 0x14, 0x0a, 0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x22, 0x41, 0x0a, 0x14, 0x53, 0x68, 0x61, 0x72, 0x64, 0x46,
-	0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29,
-	0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x22, 0x7b, 0x0a, 0x13, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x43,
-	0x68, 0x75, 0x6e, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x75, 0x6e,
-	0x6b, 0x5f, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63,
-	0x68, 0x75, 0x6e, 0x6b, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x75,
-	0x6e, 0x6b, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x68,
-	0x75, 0x6e, 0x6b, 0x45, 0x6e, 0x64, 0x22, 0x44, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
-	0x0a, 0x12, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x68, 0x75, 0x6e,
-	0x6b, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x83, 0x01, 0x0a,
-	0x0f, 0x50, 0x75, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20,
-	0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x49, 0x64, 0x73,
-	0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74,
-	0x61, 0x67, 0x22, 0x23, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x3e, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x61,
-	0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x32, 0x0a, 0x11, 0x43, 0x6c, 0x65, 0x61, 0x72,
-	0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x74, 0x61, 0x67, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x74, 0x61, 0x67, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x15, 0x0a, 0x13, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x75,
-	0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x13, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x45, 0x67, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x75, 0x72, 0x6c, 0x22, 0xf3, 0x02, 0x0a, 0x11, 0x53, 0x51, 0x4c, 0x44, 0x61, 0x74, 0x61, 0x62,
-	0x61, 0x73, 0x65, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x45, 0x0a, 0x0b, 0x66,
-	0x69, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x24, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x51, 0x4c, 0x44, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x46, 0x69, 0x6c, 0x65,
-	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x51, 0x4c, 0x44,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x53, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x1a, 0x9a, 0x01, 0x0a,
-	0x0a, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x3d, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x70, 0x66, 0x73, 0x5f,
-	0x76, 0x32, 0x2e, 0x53, 0x51, 0x4c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x67,
-	0x72, 0x65, 0x73, 0x73, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x2e,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x73, 0x22, 0x33, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07,
-	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x53, 0x56,
-	0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07,
-	0x50, 0x41, 0x52, 0x51, 0x55, 0x45, 0x54, 0x10, 0x03, 0x1a, 0x2e, 0x0a, 0x06, 0x53, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0xc7, 0x01, 0x0a, 0x0d, 0x45, 0x67,
-	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x12, 0x44, 0x0a, 0x0e, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x66,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0d, 0x6f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x73, 0x71, 0x6c,
-	0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x51, 0x4c, 0x44, 0x61, 0x74, 0x61,
-	0x62, 0x61, 0x73, 0x65, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x71,
-	0x6c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x22, 0xae, 0x03, 0x0a, 0x0e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a,
-	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x6f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x73,
-	0x71, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x28, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x51, 0x4c, 0x44, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x73,
-	0x71, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x1a, 0x3a, 0x0a, 0x13, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74,
-	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x73, 0x57,
-	0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x1a, 0xb1, 0x01, 0x0a, 0x11, 0x53, 0x51, 0x4c, 0x44, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x5c, 0x0a, 0x0c,
-	0x72, 0x6f, 0x77, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x39, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45, 0x67, 0x72, 0x65,
-	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x51, 0x4c, 0x44, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x52, 0x6f, 0x77,
-	0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x72,
-	0x6f, 0x77, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x1a, 0x3e, 0x0a, 0x10, 0x52, 0x6f,
-	0x77, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x2a, 0x49, 0x0a, 0x0a, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4b, 0x69,
-	0x6e, 0x64, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f, 0x4b, 0x49, 0x4e,
-	0x44, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x55,
-	0x53, 0x45, 0x52, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x02, 0x12,
-	0x08, 0x0a, 0x04, 0x46, 0x53, 0x43, 0x4b, 0x10, 0x03, 0x22, 0x04, 0x08, 0x04, 0x10, 0x04, 0x2a,
-	0x2b, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x52,
-	0x45, 0x53, 0x45, 0x52, 0x56, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x49, 0x4c,
-	0x45, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x49, 0x52, 0x10, 0x02, 0x2a, 0x5c, 0x0a, 0x0b,
-	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x43,
-	0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
-	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44,
-	0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x02, 0x12, 0x0d, 0x0a,
-	0x09, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08,
-	0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x3b, 0x0a, 0x09, 0x44, 0x65,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10,
-	0x00, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4c,
-	0x49, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x51, 0x4c, 0x10, 0x03, 0x12, 0x07,
-	0x0a, 0x03, 0x43, 0x53, 0x56, 0x10, 0x04, 0x32, 0xa8, 0x19, 0x0a, 0x03, 0x41, 0x50, 0x49, 0x12,
-	0x41, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x19, 0x2e,
-	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70,
-	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0b, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70,
-	0x6f, 0x12, 0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65,
-	0x63, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
-	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x22,
-	0x00, 0x12, 0x39, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x17, 0x2e,
-	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
-	0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x0a,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x19, 0x2e, 0x70, 0x66, 0x73,
-	0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70,
-	0x6f, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
-	0x70, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a,
-	0x0b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1a, 0x2e, 0x70,
-	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
-	0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0c, 0x46, 0x69,
-	0x6e, 0x69, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x66, 0x73,
-	0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x43, 0x0a, 0x0b, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x12, 0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0a, 0x4c,
-	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f,
-	0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x0f,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12,
-	0x1e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x10, 0x49, 0x6e, 0x73, 0x70, 0x65,
-	0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x70, 0x66,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70,
-	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x22, 0x00, 0x30, 0x01, 0x12, 0x48, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x53, 0x65, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4b,
-	0x0a, 0x0f, 0x53, 0x71, 0x75, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65,
-	0x74, 0x12, 0x1e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x71, 0x75, 0x61, 0x73,
-	0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0d, 0x44,
-	0x72, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x12, 0x1c, 0x2e, 0x70,
-	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6e,
-	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01,
-	0x12, 0x45, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68,
-	0x12, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x70, 0x65,
-	0x63, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
-	0x32, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
-	0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0a,
-	0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x19, 0x2e, 0x70, 0x66, 0x73,
-	0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42,
-	0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x45, 0x0a,
-	0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x1b, 0x2e,
-	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x72, 0x61,
-	0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x46, 0x69,
-	0x6c, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4d, 0x6f, 0x64, 0x69,
-	0x66, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x28, 0x01, 0x12, 0x42, 0x0a, 0x07, 0x47, 0x65, 0x74,
-	0x46, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65,
-	0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42,
-	0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x45, 0x0a,
-	0x0a, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x41, 0x52, 0x12, 0x16, 0x2e, 0x70, 0x66,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x22, 0x00, 0x30, 0x01, 0x12, 0x3d, 0x0a, 0x0b, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x46,
-	0x69, 0x6c, 0x65, 0x12, 0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x73,
-	0x70, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12,
-	0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
-	0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x39,
-	0x0a, 0x08, 0x57, 0x61, 0x6c, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x66, 0x73,
-	0x5f, 0x76, 0x32, 0x2e, 0x57, 0x61, 0x6c, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x08, 0x47, 0x6c, 0x6f,
-	0x62, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x47,
-	0x6c, 0x6f, 0x62, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
-	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x22, 0x00, 0x30, 0x01, 0x12, 0x41, 0x0a, 0x08, 0x44, 0x69, 0x66, 0x66, 0x46, 0x69, 0x6c, 0x65,
-	0x12, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x46, 0x69,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x66, 0x73, 0x5f,
-	0x76, 0x32, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x12, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c,
-	0x6c, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x04, 0x46, 0x73, 0x63, 0x6b, 0x12, 0x13, 0x2e, 0x70, 0x66,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x73, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x14, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x73, 0x63, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4d, 0x0a, 0x0d, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x66,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x48, 0x0a, 0x0a, 0x47, 0x65, 0x74,
-	0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65,
-	0x74, 0x12, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x69,
-	0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0c, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x46,
-	0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
-	0x52, 0x65, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a,
-	0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12,
-	0x1d, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65,
-	0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
-	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69,
-	0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x4b, 0x0a, 0x0c, 0x53, 0x68, 0x61, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12,
-	0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x46, 0x69,
-	0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70,
-	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x53,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x2e, 0x70,
-	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f,
-	0x76, 0x32, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08, 0x50, 0x75, 0x74,
-	0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50,
-	0x75, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x43,
-	0x61, 0x63, 0x68, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65,
-	0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
-	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0a, 0x43, 0x6c, 0x65,
-	0x61, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x08,
-	0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x61,
-	0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x61, 0x73,
-	0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x06, 0x45, 0x67, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45, 0x67, 0x72,
-	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x66, 0x73,
-	0x5f, 0x76, 0x32, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a,
-	0x0e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12,
-	0x1d, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74,
-	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
-	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x13, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x47, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x66, 0x73,
-	0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64, 0x65, 0x72, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79,
-	0x64, 0x65, 0x72, 0x6d, 0x2f, 0x76, 0x32, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x70, 0x66, 0x73, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	ChunkIds [][]byte `protobuf:"bytes,1,rep,name=chunk_ids,json=chunkIds,proto3" json:"chunk_ids,omitempty"`
 }
 
-var (
-	file_pfs_pfs_proto_rawDescOnce sync.Once
-	file_pfs_pfs_proto_rawDescData = file_pfs_pfs_proto_rawDesc
-)
+func (x *MissingChunksResponse) Reset() {
+	*x = MissingChunksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_pfs_pfs_proto_rawDescGZIP() []byte {
-	file_pfs_pfs_proto_rawDescOnce.Do(func() {
-		file_pfs_pfs_proto_rawDescData = protoimpl.X.CompressGZIP(file_pfs_pfs_proto_rawDescData)
-	})
-	return file_pfs_pfs_proto_rawDescData
+func (x *MissingChunksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_pfs_pfs_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_pfs_pfs_proto_msgTypes = make([]protoimpl.MessageInfo, 83)
-var file_pfs_pfs_proto_goTypes = []interface{}{
-	(OriginKind)(0),                            // 0: pfs_v2.OriginKind
-	(FileType)(0),                              // 1: pfs_v2.FileType
-	(CommitState)(0),                           // 2: pfs_v2.CommitState
-	(Delimiter)(0),                             // 3: pfs_v2.Delimiter
-	(SQLDatabaseEgress_FileFormat_Type)(0),     // 4: pfs_v2.SQLDatabaseEgress.FileFormat.Type
-	(*Repo)(nil),                               // 5: pfs_v2.Repo
-	(*Branch)(nil),                             // 6: pfs_v2.Branch
-	(*File)(nil),                               // 7: pfs_v2.File
-	(*RepoInfo)(nil),                           // 8: pfs_v2.RepoInfo
-	(*AuthInfo)(nil),                           // 9: pfs_v2.AuthInfo
-	(*BranchInfo)(nil),                         // 10: pfs_v2.BranchInfo
-	(*Trigger)(nil),                            // 11: pfs_v2.Trigger
-	(*CommitOrigin)(nil),                       // 12: pfs_v2.CommitOrigin
-	(*Commit)(nil),                             // 13: pfs_v2.Commit
-	(*CommitInfo)(nil),                         // 14: pfs_v2.CommitInfo
-	(*CommitSet)(nil),                          // 15: pfs_v2.CommitSet
-	(*CommitSetInfo)(nil),                      // 16: pfs_v2.CommitSetInfo
-	(*FileInfo)(nil),                           // 17: pfs_v2.FileInfo
-	(*Project)(nil),                            // 18: pfs_v2.Project
-	(*ProjectInfo)(nil),                        // 19: pfs_v2.ProjectInfo
-	(*CreateRepoRequest)(nil),                  // 20: pfs_v2.CreateRepoRequest
-	(*InspectRepoRequest)(nil),                 // 21: pfs_v2.InspectRepoRequest
-	(*ListRepoRequest)(nil),                    // 22: pfs_v2.ListRepoRequest
-	(*DeleteRepoRequest)(nil),                  // 23: pfs_v2.DeleteRepoRequest
-	(*DeleteReposRequest)(nil),                 // 24: pfs_v2.DeleteReposRequest
-	(*DeleteRepoResponse)(nil),                 // 25: pfs_v2.DeleteRepoResponse
-	(*DeleteReposResponse)(nil),                // 26: pfs_v2.DeleteReposResponse
-	(*StartCommitRequest)(nil),                 // 27: pfs_v2.StartCommitRequest
-	(*FinishCommitRequest)(nil),                // 28: pfs_v2.FinishCommitRequest
-	(*InspectCommitRequest)(nil),               // 29: pfs_v2.InspectCommitRequest
-	(*ListCommitRequest)(nil),                  // 30: pfs_v2.ListCommitRequest
-	(*InspectCommitSetRequest)(nil),            // 31: pfs_v2.InspectCommitSetRequest
-	(*ListCommitSetRequest)(nil),               // 32: pfs_v2.ListCommitSetRequest
-	(*SquashCommitSetRequest)(nil),             // 33: pfs_v2.SquashCommitSetRequest
-	(*DropCommitSetRequest)(nil),               // 34: pfs_v2.DropCommitSetRequest
-	(*SubscribeCommitRequest)(nil),             // 35: pfs_v2.SubscribeCommitRequest
-	(*ClearCommitRequest)(nil),                 // 36: pfs_v2.ClearCommitRequest
-	(*CreateBranchRequest)(nil),                // 37: pfs_v2.CreateBranchRequest
-	(*FindCommitsRequest)(nil),                 // 38: pfs_v2.FindCommitsRequest
-	(*FindCommitsResponse)(nil),                // 39: pfs_v2.FindCommitsResponse
-	(*InspectBranchRequest)(nil),               // 40: pfs_v2.InspectBranchRequest
-	(*ListBranchRequest)(nil),                  // 41: pfs_v2.ListBranchRequest
-	(*DeleteBranchRequest)(nil),                // 42: pfs_v2.DeleteBranchRequest
-	(*CreateProjectRequest)(nil),               // 43: pfs_v2.CreateProjectRequest
-	(*InspectProjectRequest)(nil),              // 44: pfs_v2.InspectProjectRequest
-	(*ListProjectRequest)(nil),                 // 45: pfs_v2.ListProjectRequest
-	(*DeleteProjectRequest)(nil),               // 46: pfs_v2.DeleteProjectRequest
-	(*AddFile)(nil),                            // 47: pfs_v2.AddFile
-	(*DeleteFile)(nil),                         // 48: pfs_v2.DeleteFile
-	(*CopyFile)(nil),                           // 49: pfs_v2.CopyFile
-	(*ModifyFileRequest)(nil),                  // 50: pfs_v2.ModifyFileRequest
-	(*GetFileRequest)(nil),                     // 51: pfs_v2.GetFileRequest
-	(*InspectFileRequest)(nil),                 // 52: pfs_v2.InspectFileRequest
-	(*ListFileRequest)(nil),                    // 53: pfs_v2.ListFileRequest
-	(*WalkFileRequest)(nil),                    // 54: pfs_v2.WalkFileRequest
-	(*GlobFileRequest)(nil),                    // 55: pfs_v2.GlobFileRequest
-	(*DiffFileRequest)(nil),                    // 56: pfs_v2.DiffFileRequest
-	(*DiffFileResponse)(nil),                   // 57: pfs_v2.DiffFileResponse
-	(*FsckRequest)(nil),                        // 58: pfs_v2.FsckRequest
-	(*FsckResponse)(nil),                       // 59: pfs_v2.FsckResponse
-	(*CreateFileSetResponse)(nil),              // 60: pfs_v2.CreateFileSetResponse
-	(*GetFileSetRequest)(nil),                  // 61: pfs_v2.GetFileSetRequest
-	(*AddFileSetRequest)(nil),                  // 62: pfs_v2.AddFileSetRequest
-	(*RenewFileSetRequest)(nil),                // 63: pfs_v2.RenewFileSetRequest
-	(*ComposeFileSetRequest)(nil),              // 64: pfs_v2.ComposeFileSetRequest
-	(*ShardFileSetRequest)(nil),                // 65: pfs_v2.ShardFileSetRequest
-	(*PathRange)(nil),                          // 66: pfs_v2.PathRange
-	(*ShardFileSetResponse)(nil),               // 67: pfs_v2.ShardFileSetResponse
-	(*CheckStorageRequest)(nil),                // 68: pfs_v2.CheckStorageRequest
-	(*CheckStorageResponse)(nil),               // 69: pfs_v2.CheckStorageResponse
-	(*PutCacheRequest)(nil),                    // 70: pfs_v2.PutCacheRequest
-	(*GetCacheRequest)(nil),                    // 71: pfs_v2.GetCacheRequest
-	(*GetCacheResponse)(nil),                   // 72: pfs_v2.GetCacheResponse
-	(*ClearCacheRequest)(nil),                  // 73: pfs_v2.ClearCacheRequest
-	(*ActivateAuthRequest)(nil),                // 74: pfs_v2.ActivateAuthRequest
-	(*ActivateAuthResponse)(nil),               // 75: pfs_v2.ActivateAuthResponse
-	(*ObjectStorageEgress)(nil),                // 76: pfs_v2.ObjectStorageEgress
-	(*SQLDatabaseEgress)(nil),                  // 77: pfs_v2.SQLDatabaseEgress
-	(*EgressRequest)(nil),                      // 78: pfs_v2.EgressRequest
-	(*EgressResponse)(nil),                     // 79: pfs_v2.EgressResponse
-	(*RepoInfo_Details)(nil),                   // 80: pfs_v2.RepoInfo.Details
-	(*CommitInfo_Details)(nil),                 // 81: pfs_v2.CommitInfo.Details
-	(*AddFile_URLSource)(nil),                  // 82: pfs_v2.AddFile.URLSource
-	(*SQLDatabaseEgress_FileFormat)(nil),       // 83: pfs_v2.SQLDatabaseEgress.FileFormat
-	(*SQLDatabaseEgress_Secret)(nil),           // 84: pfs_v2.SQLDatabaseEgress.Secret
-	(*EgressResponse_ObjectStorageResult)(nil), // 85: pfs_v2.EgressResponse.ObjectStorageResult
-	(*EgressResponse_SQLDatabaseResult)(nil),   // 86: pfs_v2.EgressResponse.SQLDatabaseResult
-	nil,                                        // 87: pfs_v2.EgressResponse.SQLDatabaseResult.RowsWrittenEntry
-	(*timestamppb.Timestamp)(nil),              // 88: google.protobuf.Timestamp
-	(auth.Permission)(0),                       // 89: auth_v2.Permission
-	(*wrapperspb.BytesValue)(nil),              // 90: google.protobuf.BytesValue
-	(*anypb.Any)(nil),                          // 91: google.protobuf.Any
-	(*durationpb.Duration)(nil),                // 92: google.protobuf.Duration
-	(*emptypb.Empty)(nil),                      // 93: google.protobuf.Empty
-	(*task.ListTaskRequest)(nil),               // 94: taskapi.ListTaskRequest
-	(*task.TaskInfo)(nil),                      // 95: taskapi.TaskInfo
+func (*MissingChunksResponse) ProtoMessage() {}
+
+func (x *MissingChunksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_pfs_pfs_proto_depIdxs = []int32{
-	18,  // 0: pfs_v2.Repo.project:type_name -> pfs_v2.Project
-	5,   // 1: pfs_v2.Branch.repo:type_name -> pfs_v2.Repo
-	13,  // 2: pfs_v2.File.commit:type_name -> pfs_v2.Commit
-	5,   // 3: pfs_v2.RepoInfo.repo:type_name -> pfs_v2.Repo
-	88,  // 4: pfs_v2.RepoInfo.created:type_name -> google.protobuf.Timestamp
-	6,   // 5: pfs_v2.RepoInfo.branches:type_name -> pfs_v2.Branch
-	9,   // 6: pfs_v2.RepoInfo.auth_info:type_name -> pfs_v2.AuthInfo
-	80,  // 7: pfs_v2.RepoInfo.details:type_name -> pfs_v2.RepoInfo.Details
-	89,  // 8: pfs_v2.AuthInfo.permissions:type_name -> auth_v2.Permission
-	6,   // 9: pfs_v2.BranchInfo.branch:type_name -> pfs_v2.Branch
-	13,  // 10: pfs_v2.BranchInfo.head:type_name -> pfs_v2.Commit
-	6,   // 11: pfs_v2.BranchInfo.provenance:type_name -> pfs_v2.Branch
-	6,   // 12: pfs_v2.BranchInfo.subvenance:type_name -> pfs_v2.Branch
-	6,   // 13: pfs_v2.BranchInfo.direct_provenance:type_name -> pfs_v2.Branch
-	11,  // 14: pfs_v2.BranchInfo.trigger:type_name -> pfs_v2.Trigger
-	0,   // 15: pfs_v2.CommitOrigin.kind:type_name -> pfs_v2.OriginKind
-	5,   // 16: pfs_v2.Commit.repo:type_name -> pfs_v2.Repo
-	6,   // 17: pfs_v2.Commit.branch:type_name -> pfs_v2.Branch
-	13,  // 18: pfs_v2.CommitInfo.commit:type_name -> pfs_v2.Commit
-	12,  // 19: pfs_v2.CommitInfo.origin:type_name -> pfs_v2.CommitOrigin
-	13,  // 20: pfs_v2.CommitInfo.parent_commit:type_name -> pfs_v2.Commit
-	13,  // 21: pfs_v2.CommitInfo.child_commits:type_name -> pfs_v2.Commit
-	88,  // 22: pfs_v2.CommitInfo.started:type_name -> google.protobuf.Timestamp
-	88,  // 23: pfs_v2.CommitInfo.finishing:type_name -> google.protobuf.Timestamp
-	88,  // 24: pfs_v2.CommitInfo.finished:type_name -> google.protobuf.Timestamp
-	13,  // 25: pfs_v2.CommitInfo.direct_provenance:type_name -> pfs_v2.Commit
-	81,  // 26: pfs_v2.CommitInfo.details:type_name -> pfs_v2.CommitInfo.Details
-	15,  // 27: pfs_v2.CommitSetInfo.commit_set:type_name -> pfs_v2.CommitSet
-	14,  // 28: pfs_v2.CommitSetInfo.commits:type_name -> pfs_v2.CommitInfo
-	7,   // 29: pfs_v2.FileInfo.file:type_name -> pfs_v2.File
-	1,   // 30: pfs_v2.FileInfo.file_type:type_name -> pfs_v2.FileType
-	88,  // 31: pfs_v2.FileInfo.committed:type_name -> google.protobuf.Timestamp
-	18,  // 32: pfs_v2.ProjectInfo.project:type_name -> pfs_v2.Project
-	9,   // 33: pfs_v2.ProjectInfo.auth_info:type_name -> pfs_v2.AuthInfo
-	88,  // 34: pfs_v2.ProjectInfo.created_at:type_name -> google.protobuf.Timestamp
-	5,   // 35: pfs_v2.CreateRepoRequest.repo:type_name -> pfs_v2.Repo
-	5,   // 36: pfs_v2.InspectRepoRequest.repo:type_name -> pfs_v2.Repo
-	18,  // 37: pfs_v2.ListRepoRequest.projects:type_name -> pfs_v2.Project
-	5,   // 38: pfs_v2.DeleteRepoRequest.repo:type_name -> pfs_v2.Repo
-	18,  // 39: pfs_v2.DeleteReposRequest.projects:type_name -> pfs_v2.Project
-	5,   // 40: pfs_v2.DeleteReposResponse.repos:type_name -> pfs_v2.Repo
-	13,  // 41: pfs_v2.StartCommitRequest.parent:type_name -> pfs_v2.Commit
-	6,   // 42: pfs_v2.StartCommitRequest.branch:type_name -> pfs_v2.Branch
-	13,  // 43: pfs_v2.FinishCommitRequest.commit:type_name -> pfs_v2.Commit
-	13,  // 44: pfs_v2.InspectCommitRequest.commit:type_name -> pfs_v2.Commit
-	2,   // 45: pfs_v2.InspectCommitRequest.wait:type_name -> pfs_v2.CommitState
-	5,   // 46: pfs_v2.ListCommitRequest.repo:type_name -> pfs_v2.Repo
-	13,  // 47: pfs_v2.ListCommitRequest.from:type_name -> pfs_v2.Commit
-	13,  // 48: pfs_v2.ListCommitRequest.to:type_name -> pfs_v2.Commit
-	0,   // 49: pfs_v2.ListCommitRequest.origin_kind:type_name -> pfs_v2.OriginKind
-	88,  // 50: pfs_v2.ListCommitRequest.started_time:type_name -> google.protobuf.Timestamp
-	15,  // 51: pfs_v2.InspectCommitSetRequest.commit_set:type_name -> pfs_v2.CommitSet
-	18,  // 52: pfs_v2.ListCommitSetRequest.project:type_name -> pfs_v2.Project
-	15,  // 53: pfs_v2.SquashCommitSetRequest.commit_set:type_name -> pfs_v2.CommitSet
-	15,  // 54: pfs_v2.DropCommitSetRequest.commit_set:type_name -> pfs_v2.CommitSet
-	5,   // 55: pfs_v2.SubscribeCommitRequest.repo:type_name -> pfs_v2.Repo
-	13,  // 56: pfs_v2.SubscribeCommitRequest.from:type_name -> pfs_v2.Commit
-	2,   // 57: pfs_v2.SubscribeCommitRequest.state:type_name -> pfs_v2.CommitState
-	0,   // 58: pfs_v2.SubscribeCommitRequest.origin_kind:type_name -> pfs_v2.OriginKind
-	13,  // 59: pfs_v2.ClearCommitRequest.commit:type_name -> pfs_v2.Commit
-	13,  // 60: pfs_v2.CreateBranchRequest.head:type_name -> pfs_v2.Commit
-	6,   // 61: pfs_v2.CreateBranchRequest.branch:type_name -> pfs_v2.Branch
-	6,   // 62: pfs_v2.CreateBranchRequest.provenance:type_name -> pfs_v2.Branch
-	11,  // 63: pfs_v2.CreateBranchRequest.trigger:type_name -> pfs_v2.Trigger
-	13,  // 64: pfs_v2.FindCommitsRequest.start:type_name -> pfs_v2.Commit
-	13,  // 65: pfs_v2.FindCommitsResponse.found_commit:type_name -> pfs_v2.Commit
-	13,  // 66: pfs_v2.FindCommitsResponse.last_searched_commit:type_name -> pfs_v2.Commit
-	6,   // 67: pfs_v2.InspectBranchRequest.branch:type_name -> pfs_v2.Branch
-	5,   // 68: pfs_v2.ListBranchRequest.repo:type_name -> pfs_v2.Repo
-	6,   // 69: pfs_v2.DeleteBranchRequest.branch:type_name -> pfs_v2.Branch
-	18,  // 70: pfs_v2.CreateProjectRequest.project:type_name -> pfs_v2.Project
-	18,  // 71: pfs_v2.InspectProjectRequest.project:type_name -> pfs_v2.Project
-	18,  // 72: pfs_v2.DeleteProjectRequest.project:type_name -> pfs_v2.Project
-	90,  // 73: pfs_v2.AddFile.raw:type_name -> google.protobuf.BytesValue
-	82,  // 74: pfs_v2.AddFile.url:type_name -> pfs_v2.AddFile.URLSource
-	7,   // 75: pfs_v2.CopyFile.src:type_name -> pfs_v2.File
-	13,  // 76: pfs_v2.ModifyFileRequest.set_commit:type_name -> pfs_v2.Commit
-	47,  // 77: pfs_v2.ModifyFileRequest.add_file:type_name -> pfs_v2.AddFile
-	48,  // 78: pfs_v2.ModifyFileRequest.delete_file:type_name -> pfs_v2.DeleteFile
-	49,  // 79: pfs_v2.ModifyFileRequest.copy_file:type_name -> pfs_v2.CopyFile
-	7,   // 80: pfs_v2.GetFileRequest.file:type_name -> pfs_v2.File
-	66,  // 81: pfs_v2.GetFileRequest.path_range:type_name -> pfs_v2.PathRange
-	7,   // 82: pfs_v2.InspectFileRequest.file:type_name -> pfs_v2.File
-	7,   // 83: pfs_v2.ListFileRequest.file:type_name -> pfs_v2.File
-	7,   // 84: pfs_v2.ListFileRequest.paginationMarker:type_name -> pfs_v2.File
-	7,   // 85: pfs_v2.WalkFileRequest.file:type_name -> pfs_v2.File
-	7,   // 86: pfs_v2.WalkFileRequest.paginationMarker:type_name -> pfs_v2.File
-	13,  // 87: pfs_v2.GlobFileRequest.commit:type_name -> pfs_v2.Commit
-	66,  // 88: pfs_v2.GlobFileRequest.path_range:type_name -> pfs_v2.PathRange
-	7,   // 89: pfs_v2.DiffFileRequest.new_file:type_name -> pfs_v2.File
-	7,   // 90: pfs_v2.DiffFileRequest.old_file:type_name -> pfs_v2.File
-	17,  // 91: pfs_v2.DiffFileResponse.new_file:type_name -> pfs_v2.FileInfo
-	17,  // 92: pfs_v2.DiffFileResponse.old_file:type_name -> pfs_v2.FileInfo
-	13,  // 93: pfs_v2.FsckRequest.zombie_target:type_name -> pfs_v2.Commit
-	13,  // 94: pfs_v2.GetFileSetRequest.commit:type_name -> pfs_v2.Commit
-	13,  // 95: pfs_v2.AddFileSetRequest.commit:type_name -> pfs_v2.Commit
-	66,  // 96: pfs_v2.ShardFileSetResponse.shards:type_name -> pfs_v2.PathRange
-	91,  // 97: pfs_v2.PutCacheRequest.value:type_name -> google.protobuf.Any
-	91,  // 98: pfs_v2.GetCacheResponse.value:type_name -> google.protobuf.Any
-	83,  // 99: pfs_v2.SQLDatabaseEgress.file_format:type_name -> pfs_v2.SQLDatabaseEgress.FileFormat
-	84,  // 100: pfs_v2.SQLDatabaseEgress.secret:type_name -> pfs_v2.SQLDatabaseEgress.Secret
-	13,  // 101: pfs_v2.EgressRequest.commit:type_name -> pfs_v2.Commit
-	76,  // 102: pfs_v2.EgressRequest.object_storage:type_name -> pfs_v2.ObjectStorageEgress
-	77,  // 103: pfs_v2.EgressRequest.sql_database:type_name -> pfs_v2.SQLDatabaseEgress
-	85,  // 104: pfs_v2.EgressResponse.object_storage:type_name -> pfs_v2.EgressResponse.ObjectStorageResult
-	86,  // 105: pfs_v2.EgressResponse.sql_database:type_name -> pfs_v2.EgressResponse.SQLDatabaseResult
-	92,  // 106: pfs_v2.CommitInfo.Details.compacting_time:type_name -> google.protobuf.Duration
-	92,  // 107: pfs_v2.CommitInfo.Details.validating_time:type_name -> google.protobuf.Duration
-	4,   // 108: pfs_v2.SQLDatabaseEgress.FileFormat.type:type_name -> pfs_v2.SQLDatabaseEgress.FileFormat.Type
-	87,  // 109: pfs_v2.EgressResponse.SQLDatabaseResult.rows_written:type_name -> pfs_v2.EgressResponse.SQLDatabaseResult.RowsWrittenEntry
-	20,  // 110: pfs_v2.API.CreateRepo:input_type -> pfs_v2.CreateRepoRequest
-	21,  // 111: pfs_v2.API.InspectRepo:input_type -> pfs_v2.InspectRepoRequest
-	22,  // 112: pfs_v2.API.ListRepo:input_type -> pfs_v2.ListRepoRequest
-	23,  // 113: pfs_v2.API.DeleteRepo:input_type -> pfs_v2.DeleteRepoRequest
-	24,  // 114: pfs_v2.API.DeleteRepos:input_type -> pfs_v2.DeleteReposRequest
-	27,  // 115: pfs_v2.API.StartCommit:input_type -> pfs_v2.StartCommitRequest
-	28,  // 116: pfs_v2.API.FinishCommit:input_type -> pfs_v2.FinishCommitRequest
-	36,  // 117: pfs_v2.API.ClearCommit:input_type -> pfs_v2.ClearCommitRequest
-	29,  // 118: pfs_v2.API.InspectCommit:input_type -> pfs_v2.InspectCommitRequest
-	30,  // 119: pfs_v2.API.ListCommit:input_type -> pfs_v2.ListCommitRequest
-	35,  // 120: pfs_v2.API.SubscribeCommit:input_type -> pfs_v2.SubscribeCommitRequest
-	31,  // 121: pfs_v2.API.InspectCommitSet:input_type -> pfs_v2.InspectCommitSetRequest
-	32,  // 122: pfs_v2.API.ListCommitSet:input_type -> pfs_v2.ListCommitSetRequest
-	33,  // 123: pfs_v2.API.SquashCommitSet:input_type -> pfs_v2.SquashCommitSetRequest
-	34,  // 124: pfs_v2.API.DropCommitSet:input_type -> pfs_v2.DropCommitSetRequest
-	38,  // 125: pfs_v2.API.FindCommits:input_type -> pfs_v2.FindCommitsRequest
-	37,  // 126: pfs_v2.API.CreateBranch:input_type -> pfs_v2.CreateBranchRequest
-	40,  // 127: pfs_v2.API.InspectBranch:input_type -> pfs_v2.InspectBranchRequest
-	41,  // 128: pfs_v2.API.ListBranch:input_type -> pfs_v2.ListBranchRequest
-	42,  // 129: pfs_v2.API.DeleteBranch:input_type -> pfs_v2.DeleteBranchRequest
-	50,  // 130: pfs_v2.API.ModifyFile:input_type -> pfs_v2.ModifyFileRequest
-	51,  // 131: pfs_v2.API.GetFile:input_type -> pfs_v2.GetFileRequest
-	51,  // 132: pfs_v2.API.GetFileTAR:input_type -> pfs_v2.GetFileRequest
-	52,  // 133: pfs_v2.API.InspectFile:input_type -> pfs_v2.InspectFileRequest
-	53,  // 134: pfs_v2.API.ListFile:input_type -> pfs_v2.ListFileRequest
-	54,  // 135: pfs_v2.API.WalkFile:input_type -> pfs_v2.WalkFileRequest
-	55,  // 136: pfs_v2.API.GlobFile:input_type -> pfs_v2.GlobFileRequest
-	56,  // 137: pfs_v2.API.DiffFile:input_type -> pfs_v2.DiffFileRequest
-	74,  // 138: pfs_v2.API.ActivateAuth:input_type -> pfs_v2.ActivateAuthRequest
-	93,  // 139: pfs_v2.API.DeleteAll:input_type -> google.protobuf.Empty
-	58,  // 140: pfs_v2.API.Fsck:input_type -> pfs_v2.FsckRequest
-	50,  // 141: pfs_v2.API.CreateFileSet:input_type -> pfs_v2.ModifyFileRequest
-	61,  // 142: pfs_v2.API.GetFileSet:input_type -> pfs_v2.GetFileSetRequest
-	62,  // 143: pfs_v2.API.AddFileSet:input_type -> pfs_v2.AddFileSetRequest
-	63,  // 144: pfs_v2.API.RenewFileSet:input_type -> pfs_v2.RenewFileSetRequest
-	64,  // 145: pfs_v2.API.ComposeFileSet:input_type -> pfs_v2.ComposeFileSetRequest
-	65,  // 146: pfs_v2.API.ShardFileSet:input_type -> pfs_v2.ShardFileSetRequest
-	68,  // 147: pfs_v2.API.CheckStorage:input_type -> pfs_v2.CheckStorageRequest
-	70,  // 148: pfs_v2.API.PutCache:input_type -> pfs_v2.PutCacheRequest
-	71,  // 149: pfs_v2.API.GetCache:input_type -> pfs_v2.GetCacheRequest
-	73,  // 150: pfs_v2.API.ClearCache:input_type -> pfs_v2.ClearCacheRequest
-	94,  // 151: pfs_v2.API.ListTask:input_type -> taskapi.ListTaskRequest
-	78,  // 152: pfs_v2.API.Egress:input_type -> pfs_v2.EgressRequest
-	43,  // 153: pfs_v2.API.CreateProject:input_type -> pfs_v2.CreateProjectRequest
-	44,  // 154: pfs_v2.API.InspectProject:input_type -> pfs_v2.InspectProjectRequest
-	45,  // 155: pfs_v2.API.ListProject:input_type -> pfs_v2.ListProjectRequest
-	46,  // 156: pfs_v2.API.DeleteProject:input_type -> pfs_v2.DeleteProjectRequest
-	93,  // 157: pfs_v2.API.CreateRepo:output_type -> google.protobuf.Empty
-	8,   // 158: pfs_v2.API.InspectRepo:output_type -> pfs_v2.RepoInfo
-	8,   // 159: pfs_v2.API.ListRepo:output_type -> pfs_v2.RepoInfo
-	25,  // 160: pfs_v2.API.DeleteRepo:output_type -> pfs_v2.DeleteRepoResponse
-	26,  // 161: pfs_v2.API.DeleteRepos:output_type -> pfs_v2.DeleteReposResponse
-	13,  // 162: pfs_v2.API.StartCommit:output_type -> pfs_v2.Commit
-	93,  // 163: pfs_v2.API.FinishCommit:output_type -> google.protobuf.Empty
-	93,  // 164: pfs_v2.API.ClearCommit:output_type -> google.protobuf.Empty
-	14,  // 165: pfs_v2.API.InspectCommit:output_type -> pfs_v2.CommitInfo
-	14,  // 166: pfs_v2.API.ListCommit:output_type -> pfs_v2.CommitInfo
-	14,  // 167: pfs_v2.API.SubscribeCommit:output_type -> pfs_v2.CommitInfo
-	14,  // 168: pfs_v2.API.InspectCommitSet:output_type -> pfs_v2.CommitInfo
-	16,  // 169: pfs_v2.API.ListCommitSet:output_type -> pfs_v2.CommitSetInfo
-	93,  // 170: pfs_v2.API.SquashCommitSet:output_type -> google.protobuf.Empty
-	93,  // 171: pfs_v2.API.DropCommitSet:output_type -> google.protobuf.Empty
-	39,  // 172: pfs_v2.API.FindCommits:output_type -> pfs_v2.FindCommitsResponse
-	93,  // 173: pfs_v2.API.CreateBranch:output_type -> google.protobuf.Empty
-	10,  // 174: pfs_v2.API.InspectBranch:output_type -> pfs_v2.BranchInfo
-	10,  // 175: pfs_v2.API.ListBranch:output_type -> pfs_v2.BranchInfo
-	93,  // 176: pfs_v2.API.DeleteBranch:output_type -> google.protobuf.Empty
-	93,  // 177: pfs_v2.API.ModifyFile:output_type -> google.protobuf.Empty
-	90,  // 178: pfs_v2.API.GetFile:output_type -> google.protobuf.BytesValue
-	90,  // 179: pfs_v2.API.GetFileTAR:output_type -> google.protobuf.BytesValue
-	17,  // 180: pfs_v2.API.InspectFile:output_type -> pfs_v2.FileInfo
-	17,  // 181: pfs_v2.API.ListFile:output_type -> pfs_v2.FileInfo
-	17,  // 182: pfs_v2.API.WalkFile:output_type -> pfs_v2.FileInfo
-	17,  // 183: pfs_v2.API.GlobFile:output_type -> pfs_v2.FileInfo
-	57,  // 184: pfs_v2.API.DiffFile:output_type -> pfs_v2.DiffFileResponse
-	75,  // 185: pfs_v2.API.ActivateAuth:output_type -> pfs_v2.ActivateAuthResponse
-	93,  // 186: pfs_v2.API.DeleteAll:output_type -> google.protobuf.Empty
-	59,  // 187: pfs_v2.API.Fsck:output_type -> pfs_v2.FsckResponse
-	60,  // 188: pfs_v2.API.CreateFileSet:output_type -> pfs_v2.CreateFileSetResponse
-	60,  // 189: pfs_v2.API.GetFileSet:output_type -> pfs_v2.CreateFileSetResponse
-	93,  // 190: pfs_v2.API.AddFileSet:output_type -> google.protobuf.Empty
-	93,  // 191: pfs_v2.API.RenewFileSet:output_type -> google.protobuf.Empty
-	60,  // 192: pfs_v2.API.ComposeFileSet:output_type -> pfs_v2.CreateFileSetResponse
-	67,  // 193: pfs_v2.API.ShardFileSet:output_type -> pfs_v2.ShardFileSetResponse
-	69,  // 194: pfs_v2.API.CheckStorage:output_type -> pfs_v2.CheckStorageResponse
-	93,  // 195: pfs_v2.API.PutCache:output_type -> google.protobuf.Empty
-	72,  // 196: pfs_v2.API.GetCache:output_type -> pfs_v2.GetCacheResponse
-	93,  // 197: pfs_v2.API.ClearCache:output_type -> google.protobuf.Empty
-	95,  // 198: pfs_v2.API.ListTask:output_type -> taskapi.TaskInfo
-	79,  // 199: pfs_v2.API.Egress:output_type -> pfs_v2.EgressResponse
-	93,  // 200: pfs_v2.API.CreateProject:output_type -> google.protobuf.Empty
-	19,  // 201: pfs_v2.API.InspectProject:output_type -> pfs_v2.ProjectInfo
-	19,  // 202: pfs_v2.API.ListProject:output_type -> pfs_v2.ProjectInfo
-	93,  // 203: pfs_v2.API.DeleteProject:output_type -> google.protobuf.Empty
-	157, // [157:204] is the sub-list for method output_type
-	110, // [110:157] is the sub-list for method input_type
-	110, // [110:110] is the sub-list for extension type_name
-	110, // [110:110] is the sub-list for extension extendee
-	0,   // [0:110] is the sub-list for field type_name
+
+// Deprecated: Use MissingChunksResponse.ProtoReflect.Descriptor instead.
+func (*MissingChunksResponse) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{88}
 }
 
-func init() { file_pfs_pfs_proto_init() }
-func file_pfs_pfs_proto_init() {
-	if File_pfs_pfs_proto != nil {
-		return
+func (x *MissingChunksResponse) GetChunkIds() [][]byte {
+	if x != nil {
+		return x.ChunkIds
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_pfs_pfs_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Repo); i {
+	return nil
+}
+
+type ReplicateFileSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Value:
+	//	*ReplicateFileSetRequest_Chunk_
+	//	*ReplicateFileSetRequest_Primitive
+	Value isReplicateFileSetRequest_Value `protobuf_oneof:"value"`
+}
+
+func (x *ReplicateFileSetRequest) Reset() {
+	*x = ReplicateFileSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicateFileSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicateFileSetRequest) ProtoMessage() {}
+
+func (x *ReplicateFileSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicateFileSetRequest.ProtoReflect.Descriptor instead.
+func (*ReplicateFileSetRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{89}
+}
+
+func (m *ReplicateFileSetRequest) GetValue() isReplicateFileSetRequest_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *ReplicateFileSetRequest) GetChunk() *ReplicateFileSetRequest_Chunk {
+	if x, ok := x.GetValue().(*ReplicateFileSetRequest_Chunk_); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *ReplicateFileSetRequest) GetPrimitive() []byte {
+	if x, ok := x.GetValue().(*ReplicateFileSetRequest_Primitive); ok {
+		return x.Primitive
+	}
+	return nil
+}
+
+type isReplicateFileSetRequest_Value interface {
+	isReplicateFileSetRequest_Value()
+}
+
+type ReplicateFileSetRequest_Chunk_ struct {
+	// Chunks must be sent before any chunk or primitive that points to them.
+	Chunk *ReplicateFileSetRequest_Chunk `protobuf:"bytes,1,opt,name=chunk,proto3,oneof"`
+}
+
+type ReplicateFileSetRequest_Primitive struct {
+	// primitive is a serialized primitive file set.
+	Primitive []byte `protobuf:"bytes,2,opt,name=primitive,proto3,oneof"`
+}
+
+func (*ReplicateFileSetRequest_Chunk_) isReplicateFileSetRequest_Value() {}
+
+func (*ReplicateFileSetRequest_Primitive) isReplicateFileSetRequest_Value() {}
+
+// RetentionPolicy determines which commits on a branch are kept.  A commit is
+// kept if any of the rules keep it, and the head of a branch is always kept.
+// Commits which are not kept are squashed.
+type RetentionPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// keep_last keeps the N most recent commits on a branch.
+	KeepLast uint32 `protobuf:"varint,1,opt,name=keep_last,json=keepLast,proto3" json:"keep_last,omitempty"`
+	// keep_for keeps commits which were created less than keep_for ago.
+	KeepFor *durationpb.Duration `protobuf:"bytes,2,opt,name=keep_for,json=keepFor,proto3" json:"keep_for,omitempty"`
+	// keep_daily_after keeps commits which were created less than
+	// keep_daily_after ago, and the most recent commit of each day (in UTC) for
+	// commits older than that.
+	KeepDailyAfter *durationpb.Duration `protobuf:"bytes,3,opt,name=keep_daily_after,json=keepDailyAfter,proto3" json:"keep_daily_after,omitempty"`
+}
+
+func (x *RetentionPolicy) Reset() {
+	*x = RetentionPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetentionPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionPolicy) ProtoMessage() {}
+
+func (x *RetentionPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionPolicy.ProtoReflect.Descriptor instead.
+func (*RetentionPolicy) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *RetentionPolicy) GetKeepLast() uint32 {
+	if x != nil {
+		return x.KeepLast
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetKeepFor() *durationpb.Duration {
+	if x != nil {
+		return x.KeepFor
+	}
+	return nil
+}
+
+func (x *RetentionPolicy) GetKeepDailyAfter() *durationpb.Duration {
+	if x != nil {
+		return x.KeepDailyAfter
+	}
+	return nil
+}
+
+type RetentionPolicyInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repo *Repo `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// branch is empty for policies that apply to every branch in the repo which
+	// does not have a policy of its own.
+	Branch string           `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	Policy *RetentionPolicy `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *RetentionPolicyInfo) Reset() {
+	*x = RetentionPolicyInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetentionPolicyInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionPolicyInfo) ProtoMessage() {}
+
+func (x *RetentionPolicyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionPolicyInfo.ProtoReflect.Descriptor instead.
+func (*RetentionPolicyInfo) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *RetentionPolicyInfo) GetRepo() *Repo {
+	if x != nil {
+		return x.Repo
+	}
+	return nil
+}
+
+func (x *RetentionPolicyInfo) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *RetentionPolicyInfo) GetPolicy() *RetentionPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type SetRetentionPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repo   *Repo  `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Branch string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	// policy is the new policy, if it is unset the existing policy is removed.
+	Policy *RetentionPolicy `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *SetRetentionPolicyRequest) Reset() {
+	*x = SetRetentionPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRetentionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRetentionPolicyRequest) ProtoMessage() {}
+
+func (x *SetRetentionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRetentionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *SetRetentionPolicyRequest) GetRepo() *Repo {
+	if x != nil {
+		return x.Repo
+	}
+	return nil
+}
+
+func (x *SetRetentionPolicyRequest) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *SetRetentionPolicyRequest) GetPolicy() *RetentionPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type ListRetentionPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo filters the policies to those for the given repo.
+	Repo *Repo `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+}
+
+func (x *ListRetentionPolicyRequest) Reset() {
+	*x = ListRetentionPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRetentionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRetentionPolicyRequest) ProtoMessage() {}
+
+func (x *ListRetentionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRetentionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*ListRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ListRetentionPolicyRequest) GetRepo() *Repo {
+	if x != nil {
+		return x.Repo
+	}
+	return nil
+}
+
+type EnforceRetentionPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo restricts enforcement to the given repo, otherwise every repo with a
+	// retention policy is enforced.
+	Repo *Repo `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// dry_run reports which commit sets would be squashed without squashing
+	// them.
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *EnforceRetentionPolicyRequest) Reset() {
+	*x = EnforceRetentionPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnforceRetentionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnforceRetentionPolicyRequest) ProtoMessage() {}
+
+func (x *EnforceRetentionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnforceRetentionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*EnforceRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *EnforceRetentionPolicyRequest) GetRepo() *Repo {
+	if x != nil {
+		return x.Repo
+	}
+	return nil
+}
+
+func (x *EnforceRetentionPolicyRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type RetentionAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// commit is a commit which is not kept by the retention policy of its branch.
+	Commit *Commit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	// squashed is true if the commit set containing commit was squashed (or
+	// would have been, for a dry run).
+	Squashed bool `protobuf:"varint,2,opt,name=squashed,proto3" json:"squashed,omitempty"`
+	// skipped_reason explains why the commit set could not be squashed.
+	SkippedReason string `protobuf:"bytes,3,opt,name=skipped_reason,json=skippedReason,proto3" json:"skipped_reason,omitempty"`
+}
+
+func (x *RetentionAction) Reset() {
+	*x = RetentionAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetentionAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionAction) ProtoMessage() {}
+
+func (x *RetentionAction) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionAction.ProtoReflect.Descriptor instead.
+func (*RetentionAction) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *RetentionAction) GetCommit() *Commit {
+	if x != nil {
+		return x.Commit
+	}
+	return nil
+}
+
+func (x *RetentionAction) GetSquashed() bool {
+	if x != nil {
+		return x.Squashed
+	}
+	return false
+}
+
+func (x *RetentionAction) GetSkippedReason() string {
+	if x != nil {
+		return x.SkippedReason
+	}
+	return ""
+}
+
+// ContentContract describes what a set of files must look like.  Zero bounds
+// aren't enforced.  It's used both for the output of a pipeline's jobs and
+// for the commits to a repo.
+type ContentContract struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Paths         []*PathContract `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	MinFiles      int64           `protobuf:"varint,2,opt,name=min_files,json=minFiles,proto3" json:"min_files,omitempty"`
+	MaxFiles      int64           `protobuf:"varint,3,opt,name=max_files,json=maxFiles,proto3" json:"max_files,omitempty"`
+	MaxTotalBytes int64           `protobuf:"varint,4,opt,name=max_total_bytes,json=maxTotalBytes,proto3" json:"max_total_bytes,omitempty"`
+}
+
+func (x *ContentContract) Reset() {
+	*x = ContentContract{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContentContract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentContract) ProtoMessage() {}
+
+func (x *ContentContract) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentContract.ProtoReflect.Descriptor instead.
+func (*ContentContract) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ContentContract) GetPaths() []*PathContract {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *ContentContract) GetMinFiles() int64 {
+	if x != nil {
+		return x.MinFiles
+	}
+	return 0
+}
+
+func (x *ContentContract) GetMaxFiles() int64 {
+	if x != nil {
+		return x.MaxFiles
+	}
+	return 0
+}
+
+func (x *ContentContract) GetMaxTotalBytes() int64 {
+	if x != nil {
+		return x.MaxTotalBytes
+	}
+	return 0
+}
+
+// PathContract constrains the files matching a glob.
+type PathContract struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Glob string `protobuf:"bytes,1,opt,name=glob,proto3" json:"glob,omitempty"`
+	// required, if set, requires at least one file to match the glob.
+	Required     bool  `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
+	MinFiles     int64 `protobuf:"varint,3,opt,name=min_files,json=minFiles,proto3" json:"min_files,omitempty"`
+	MaxFiles     int64 `protobuf:"varint,4,opt,name=max_files,json=maxFiles,proto3" json:"max_files,omitempty"`
+	MinFileBytes int64 `protobuf:"varint,5,opt,name=min_file_bytes,json=minFileBytes,proto3" json:"min_file_bytes,omitempty"`
+	MaxFileBytes int64 `protobuf:"varint,6,opt,name=max_file_bytes,json=maxFileBytes,proto3" json:"max_file_bytes,omitempty"`
+	// json_schema, if set, is a JSON Schema that every record of the matching
+	// files, which must hold a stream of JSON values, must satisfy.
+	JsonSchema *structpb.Struct `protobuf:"bytes,7,opt,name=json_schema,json=jsonSchema,proto3" json:"json_schema,omitempty"`
+	// table, if set, is the columns that the matching files must have.
+	Table *TableSchema `protobuf:"bytes,8,opt,name=table,proto3" json:"table,omitempty"`
+}
+
+func (x *PathContract) Reset() {
+	*x = PathContract{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PathContract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathContract) ProtoMessage() {}
+
+func (x *PathContract) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathContract.ProtoReflect.Descriptor instead.
+func (*PathContract) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *PathContract) GetGlob() string {
+	if x != nil {
+		return x.Glob
+	}
+	return ""
+}
+
+func (x *PathContract) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *PathContract) GetMinFiles() int64 {
+	if x != nil {
+		return x.MinFiles
+	}
+	return 0
+}
+
+func (x *PathContract) GetMaxFiles() int64 {
+	if x != nil {
+		return x.MaxFiles
+	}
+	return 0
+}
+
+func (x *PathContract) GetMinFileBytes() int64 {
+	if x != nil {
+		return x.MinFileBytes
+	}
+	return 0
+}
+
+func (x *PathContract) GetMaxFileBytes() int64 {
+	if x != nil {
+		return x.MaxFileBytes
+	}
+	return 0
+}
+
+func (x *PathContract) GetJsonSchema() *structpb.Struct {
+	if x != nil {
+		return x.JsonSchema
+	}
+	return nil
+}
+
+func (x *PathContract) GetTable() *TableSchema {
+	if x != nil {
+		return x.Table
+	}
+	return nil
+}
+
+type TableSchema struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// format is csv, json (newline delimited objects) or parquet.
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	// header is whether CSV files start with a header row.  Without one,
+	// columns are matched by position.
+	Header  bool           `protobuf:"varint,2,opt,name=header,proto3" json:"header,omitempty"`
+	Columns []*TableColumn `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	// allow_extra_columns, if set, allows CSV and Parquet files to have columns
+	// that aren't listed.  JSON records may always have other fields.
+	AllowExtraColumns bool `protobuf:"varint,4,opt,name=allow_extra_columns,json=allowExtraColumns,proto3" json:"allow_extra_columns,omitempty"`
+}
+
+func (x *TableSchema) Reset() {
+	*x = TableSchema{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TableSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableSchema) ProtoMessage() {}
+
+func (x *TableSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableSchema.ProtoReflect.Descriptor instead.
+func (*TableSchema) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *TableSchema) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *TableSchema) GetHeader() bool {
+	if x != nil {
+		return x.Header
+	}
+	return false
+}
+
+func (x *TableSchema) GetColumns() []*TableColumn {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *TableSchema) GetAllowExtraColumns() bool {
+	if x != nil {
+		return x.AllowExtraColumns
+	}
+	return false
+}
+
+type TableColumn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// type is bool, int64, double, string or timestamp.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// nullable, if set, allows the column to hold nulls (empty cells, in CSV).
+	Nullable bool `protobuf:"varint,3,opt,name=nullable,proto3" json:"nullable,omitempty"`
+}
+
+func (x *TableColumn) Reset() {
+	*x = TableColumn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TableColumn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableColumn) ProtoMessage() {}
+
+func (x *TableColumn) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableColumn.ProtoReflect.Descriptor instead.
+func (*TableColumn) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *TableColumn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TableColumn) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TableColumn) GetNullable() bool {
+	if x != nil {
+		return x.Nullable
+	}
+	return false
+}
+
+// CommitValidationInfo is a contract that the files of every commit to a repo
+// must satisfy, and optionally a validator pipeline which must accept them.
+// It's checked when a commit finishes, and a commit which violates it fails,
+// with the violation as its error, so that it isn't processed by downstream
+// pipelines and doesn't fire triggers.
+type CommitValidationInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repo     *Repo            `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Contract *ContentContract `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// validator, if set, is the output repo of a validator pipeline which takes
+	// the repo as input.  A commit which satisfies the contract then waits for
+	// the validator's job in its commit set: it fails if the job fails, and the
+	// branches which trigger on it aren't moved to it until the job succeeds.
+	Validator *Repo `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (x *CommitValidationInfo) Reset() {
+	*x = CommitValidationInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitValidationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitValidationInfo) ProtoMessage() {}
+
+func (x *CommitValidationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitValidationInfo.ProtoReflect.Descriptor instead.
+func (*CommitValidationInfo) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *CommitValidationInfo) GetRepo() *Repo {
+	if x != nil {
+		return x.Repo
+	}
+	return nil
+}
+
+func (x *CommitValidationInfo) GetContract() *ContentContract {
+	if x != nil {
+		return x.Contract
+	}
+	return nil
+}
+
+func (x *CommitValidationInfo) GetValidator() *Repo {
+	if x != nil {
+		return x.Validator
+	}
+	return nil
+}
+
+type SetCommitValidationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repo *Repo `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// contract is the new contract.  If neither it nor validator is set, the
+	// existing validation is removed.
+	Contract  *ContentContract `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	Validator *Repo            `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (x *SetCommitValidationRequest) Reset() {
+	*x = SetCommitValidationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCommitValidationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCommitValidationRequest) ProtoMessage() {}
+
+func (x *SetCommitValidationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCommitValidationRequest.ProtoReflect.Descriptor instead.
+func (*SetCommitValidationRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *SetCommitValidationRequest) GetRepo() *Repo {
+	if x != nil {
+		return x.Repo
+	}
+	return nil
+}
+
+func (x *SetCommitValidationRequest) GetContract() *ContentContract {
+	if x != nil {
+		return x.Contract
+	}
+	return nil
+}
+
+func (x *SetCommitValidationRequest) GetValidator() *Repo {
+	if x != nil {
+		return x.Validator
+	}
+	return nil
+}
+
+type ListCommitValidationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo filters the validations to the one for the given repo.
+	Repo *Repo `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+}
+
+func (x *ListCommitValidationRequest) Reset() {
+	*x = ListCommitValidationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCommitValidationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommitValidationRequest) ProtoMessage() {}
+
+func (x *ListCommitValidationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommitValidationRequest.ProtoReflect.Descriptor instead.
+func (*ListCommitValidationRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ListCommitValidationRequest) GetRepo() *Repo {
+	if x != nil {
+		return x.Repo
+	}
+	return nil
+}
+
+// CommitValidationResult is the result of checking a commit against its
+// repo's contract.
+type CommitValidationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Passed bool `protobuf:"varint,1,opt,name=passed,proto3" json:"passed,omitempty"`
+	// reason is the first violation of the contract found, or the error of the
+	// validator's job, if it wasn't passed.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// pending is set while the commit waits for its validator's job.
+	Pending bool `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
+}
+
+func (x *CommitValidationResult) Reset() {
+	*x = CommitValidationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitValidationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitValidationResult) ProtoMessage() {}
+
+func (x *CommitValidationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitValidationResult.ProtoReflect.Descriptor instead.
+func (*CommitValidationResult) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *CommitValidationResult) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *CommitValidationResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CommitValidationResult) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+// StoragePlacementInfo places the chunks of a repo's commits on storage tiers,
+// the object storage backends configured with STORAGE_TIERS.  Chunks are
+// moved between tiers in the background, and reads are routed to the tier
+// that holds them.
+type StoragePlacementInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repo *Repo `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// tier is the tier that the repo's chunks are placed on.  The empty tier
+	// is the default object storage backend.
+	Tier string `protobuf:"bytes,2,opt,name=tier,proto3" json:"tier,omitempty"`
+	// cold_tier, if set, is the tier that the chunks of commits started more
+	// than cold_after ago are placed on.
+	ColdTier  string               `protobuf:"bytes,3,opt,name=cold_tier,json=coldTier,proto3" json:"cold_tier,omitempty"`
+	ColdAfter *durationpb.Duration `protobuf:"bytes,4,opt,name=cold_after,json=coldAfter,proto3" json:"cold_after,omitempty"`
+}
+
+func (x *StoragePlacementInfo) Reset() {
+	*x = StoragePlacementInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoragePlacementInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoragePlacementInfo) ProtoMessage() {}
+
+func (x *StoragePlacementInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoragePlacementInfo.ProtoReflect.Descriptor instead.
+func (*StoragePlacementInfo) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *StoragePlacementInfo) GetRepo() *Repo {
+	if x != nil {
+		return x.Repo
+	}
+	return nil
+}
+
+func (x *StoragePlacementInfo) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *StoragePlacementInfo) GetColdTier() string {
+	if x != nil {
+		return x.ColdTier
+	}
+	return ""
+}
+
+func (x *StoragePlacementInfo) GetColdAfter() *durationpb.Duration {
+	if x != nil {
+		return x.ColdAfter
+	}
+	return nil
+}
+
+type SetStoragePlacementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repo *Repo `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// tier, cold_tier and cold_after are the new placement.  If tier and
+	// cold_tier are both unset the existing placement is removed.
+	Tier      string               `protobuf:"bytes,2,opt,name=tier,proto3" json:"tier,omitempty"`
+	ColdTier  string               `protobuf:"bytes,3,opt,name=cold_tier,json=coldTier,proto3" json:"cold_tier,omitempty"`
+	ColdAfter *durationpb.Duration `protobuf:"bytes,4,opt,name=cold_after,json=coldAfter,proto3" json:"cold_after,omitempty"`
+}
+
+func (x *SetStoragePlacementRequest) Reset() {
+	*x = SetStoragePlacementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetStoragePlacementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStoragePlacementRequest) ProtoMessage() {}
+
+func (x *SetStoragePlacementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStoragePlacementRequest.ProtoReflect.Descriptor instead.
+func (*SetStoragePlacementRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *SetStoragePlacementRequest) GetRepo() *Repo {
+	if x != nil {
+		return x.Repo
+	}
+	return nil
+}
+
+func (x *SetStoragePlacementRequest) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *SetStoragePlacementRequest) GetColdTier() string {
+	if x != nil {
+		return x.ColdTier
+	}
+	return ""
+}
+
+func (x *SetStoragePlacementRequest) GetColdAfter() *durationpb.Duration {
+	if x != nil {
+		return x.ColdAfter
+	}
+	return nil
+}
+
+type ListStoragePlacementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo filters the placements to the one for the given repo.
+	Repo *Repo `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+}
+
+func (x *ListStoragePlacementRequest) Reset() {
+	*x = ListStoragePlacementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStoragePlacementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStoragePlacementRequest) ProtoMessage() {}
+
+func (x *ListStoragePlacementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStoragePlacementRequest.ProtoReflect.Descriptor instead.
+func (*ListStoragePlacementRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ListStoragePlacementRequest) GetRepo() *Repo {
+	if x != nil {
+		return x.Repo
+	}
+	return nil
+}
+
+// UploadPartInfo describes a byte range of a file uploaded as part of an upload session.
+type UploadPartInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// number orders the parts of the upload when it is committed.
+	Number    int64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	SizeBytes int64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// hash is the hash of the part's content, as PFS computes it for files.
+	Hash []byte `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	// file_set_id is the temporary file set holding the part.
+	FileSetId string `protobuf:"bytes,4,opt,name=file_set_id,json=fileSetId,proto3" json:"file_set_id,omitempty"`
+}
+
+func (x *UploadPartInfo) Reset() {
+	*x = UploadPartInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadPartInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadPartInfo) ProtoMessage() {}
+
+func (x *UploadPartInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadPartInfo.ProtoReflect.Descriptor instead.
+func (*UploadPartInfo) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *UploadPartInfo) GetNumber() int64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *UploadPartInfo) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *UploadPartInfo) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *UploadPartInfo) GetFileSetId() string {
+	if x != nil {
+		return x.FileSetId
+	}
+	return ""
+}
+
+// UploadSessionInfo describes an upload session.  Upload sessions allow a
+// large file to be uploaded in parts which can be retried independently, and
+// committed once every part has been uploaded.
+type UploadSessionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// file is the file that the upload is committed to.
+	File *File `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
+	// append appends the upload to the file, rather than overwriting it.
+	Append  bool                   `protobuf:"varint,3,opt,name=append,proto3" json:"append,omitempty"`
+	Created *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created,proto3" json:"created,omitempty"`
+	// expires is when the session and its parts are garbage collected if the
+	// session isn't committed.  It is extended as parts are uploaded.
+	Expires *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires,proto3" json:"expires,omitempty"`
+	Ttl     *durationpb.Duration   `protobuf:"bytes,6,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// parts are the uploaded parts, ordered by number.
+	Parts []*UploadPartInfo `protobuf:"bytes,7,rep,name=parts,proto3" json:"parts,omitempty"`
+}
+
+func (x *UploadSessionInfo) Reset() {
+	*x = UploadSessionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadSessionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadSessionInfo) ProtoMessage() {}
+
+func (x *UploadSessionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadSessionInfo.ProtoReflect.Descriptor instead.
+func (*UploadSessionInfo) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *UploadSessionInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UploadSessionInfo) GetFile() *File {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *UploadSessionInfo) GetAppend() bool {
+	if x != nil {
+		return x.Append
+	}
+	return false
+}
+
+func (x *UploadSessionInfo) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *UploadSessionInfo) GetExpires() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expires
+	}
+	return nil
+}
+
+func (x *UploadSessionInfo) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *UploadSessionInfo) GetParts() []*UploadPartInfo {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+type CreateUploadSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	File   *File `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	Append bool  `protobuf:"varint,2,opt,name=append,proto3" json:"append,omitempty"`
+	// ttl is how long the session is kept after its last part is uploaded.  It
+	// defaults to 24 hours.
+	Ttl *durationpb.Duration `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *CreateUploadSessionRequest) Reset() {
+	*x = CreateUploadSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateUploadSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUploadSessionRequest) ProtoMessage() {}
+
+func (x *CreateUploadSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUploadSessionRequest.ProtoReflect.Descriptor instead.
+func (*CreateUploadSessionRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *CreateUploadSessionRequest) GetFile() *File {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *CreateUploadSessionRequest) GetAppend() bool {
+	if x != nil {
+		return x.Append
+	}
+	return false
+}
+
+func (x *CreateUploadSessionRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+type InspectUploadSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *InspectUploadSessionRequest) Reset() {
+	*x = InspectUploadSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InspectUploadSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectUploadSessionRequest) ProtoMessage() {}
+
+func (x *InspectUploadSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectUploadSessionRequest.ProtoReflect.Descriptor instead.
+func (*InspectUploadSessionRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *InspectUploadSessionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListUploadSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo filters the sessions to those uploading to the given repo.
+	Repo *Repo `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+}
+
+func (x *ListUploadSessionRequest) Reset() {
+	*x = ListUploadSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUploadSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUploadSessionRequest) ProtoMessage() {}
+
+func (x *ListUploadSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUploadSessionRequest.ProtoReflect.Descriptor instead.
+func (*ListUploadSessionRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ListUploadSessionRequest) GetRepo() *Repo {
+	if x != nil {
+		return x.Repo
+	}
+	return nil
+}
+
+// UploadPartRequest uploads a part of an upload session.  The session and part
+// number are read from the first message of the stream, and the data of every
+// message is concatenated to form the part.  Uploading a part with the same
+// number as an existing part replaces it.
+type UploadPartRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Number    int64  `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	Data      []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *UploadPartRequest) Reset() {
+	*x = UploadPartRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadPartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadPartRequest) ProtoMessage() {}
+
+func (x *UploadPartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadPartRequest.ProtoReflect.Descriptor instead.
+func (*UploadPartRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *UploadPartRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *UploadPartRequest) GetNumber() int64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *UploadPartRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CommitUploadSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// parts are the numbers of the parts to commit, in order.  If unset, every
+	// uploaded part is committed in ascending order.
+	Parts []int64 `protobuf:"varint,2,rep,packed,name=parts,proto3" json:"parts,omitempty"`
+}
+
+func (x *CommitUploadSessionRequest) Reset() {
+	*x = CommitUploadSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitUploadSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitUploadSessionRequest) ProtoMessage() {}
+
+func (x *CommitUploadSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitUploadSessionRequest.ProtoReflect.Descriptor instead.
+func (*CommitUploadSessionRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *CommitUploadSessionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CommitUploadSessionRequest) GetParts() []int64 {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+type DeleteUploadSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteUploadSessionRequest) Reset() {
+	*x = DeleteUploadSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteUploadSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUploadSessionRequest) ProtoMessage() {}
+
+func (x *DeleteUploadSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUploadSessionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUploadSessionRequest) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *DeleteUploadSessionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Details are only provided when explicitly requested
+type RepoInfo_Details struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SizeBytes int64         `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Usage     *StorageUsage `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage,omitempty"`
+}
+
+func (x *RepoInfo_Details) Reset() {
+	*x = RepoInfo_Details{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepoInfo_Details) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoInfo_Details) ProtoMessage() {}
+
+func (x *RepoInfo_Details) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoInfo_Details.ProtoReflect.Descriptor instead.
+func (*RepoInfo_Details) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *RepoInfo_Details) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *RepoInfo_Details) GetUsage() *StorageUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+// Details are only provided when explicitly requested
+type CommitInfo_Details struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SizeBytes      int64                `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	CompactingTime *durationpb.Duration `protobuf:"bytes,2,opt,name=compacting_time,json=compactingTime,proto3" json:"compacting_time,omitempty"`
+	ValidatingTime *durationpb.Duration `protobuf:"bytes,3,opt,name=validating_time,json=validatingTime,proto3" json:"validating_time,omitempty"`
+	Usage          *StorageUsage        `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// validation is the result of checking the commit against its repo's
+	// commit validation, if it has one.
+	Validation *CommitValidationResult `protobuf:"bytes,5,opt,name=validation,proto3" json:"validation,omitempty"`
+}
+
+func (x *CommitInfo_Details) Reset() {
+	*x = CommitInfo_Details{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitInfo_Details) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitInfo_Details) ProtoMessage() {}
+
+func (x *CommitInfo_Details) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitInfo_Details.ProtoReflect.Descriptor instead.
+func (*CommitInfo_Details) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *CommitInfo_Details) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *CommitInfo_Details) GetCompactingTime() *durationpb.Duration {
+	if x != nil {
+		return x.CompactingTime
+	}
+	return nil
+}
+
+func (x *CommitInfo_Details) GetValidatingTime() *durationpb.Duration {
+	if x != nil {
+		return x.ValidatingTime
+	}
+	return nil
+}
+
+func (x *CommitInfo_Details) GetUsage() *StorageUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *CommitInfo_Details) GetValidation() *CommitValidationResult {
+	if x != nil {
+		return x.Validation
+	}
+	return nil
+}
+
+// Details are only provided when explicitly requested
+type ProjectInfo_Details struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Usage *StorageUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+}
+
+func (x *ProjectInfo_Details) Reset() {
+	*x = ProjectInfo_Details{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectInfo_Details) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectInfo_Details) ProtoMessage() {}
+
+func (x *ProjectInfo_Details) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectInfo_Details.ProtoReflect.Descriptor instead.
+func (*ProjectInfo_Details) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *ProjectInfo_Details) GetUsage() *StorageUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+type AddFile_URLSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	URL         string `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
+	Recursive   bool   `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	Concurrency uint32 `protobuf:"varint,3,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+}
+
+func (x *AddFile_URLSource) Reset() {
+	*x = AddFile_URLSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddFile_URLSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFile_URLSource) ProtoMessage() {}
+
+func (x *AddFile_URLSource) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFile_URLSource.ProtoReflect.Descriptor instead.
+func (*AddFile_URLSource) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{46, 0}
+}
+
+func (x *AddFile_URLSource) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+func (x *AddFile_URLSource) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+func (x *AddFile_URLSource) GetConcurrency() uint32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+type SQLDatabaseEgress_FileFormat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    SQLDatabaseEgress_FileFormat_Type `protobuf:"varint,1,opt,name=type,proto3,enum=pfs_v2.SQLDatabaseEgress_FileFormat_Type" json:"type,omitempty"`
+	Columns []string                          `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+}
+
+func (x *SQLDatabaseEgress_FileFormat) Reset() {
+	*x = SQLDatabaseEgress_FileFormat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SQLDatabaseEgress_FileFormat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SQLDatabaseEgress_FileFormat) ProtoMessage() {}
+
+func (x *SQLDatabaseEgress_FileFormat) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SQLDatabaseEgress_FileFormat.ProtoReflect.Descriptor instead.
+func (*SQLDatabaseEgress_FileFormat) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{76, 0}
+}
+
+func (x *SQLDatabaseEgress_FileFormat) GetType() SQLDatabaseEgress_FileFormat_Type {
+	if x != nil {
+		return x.Type
+	}
+	return SQLDatabaseEgress_FileFormat_UNKNOWN
+}
+
+func (x *SQLDatabaseEgress_FileFormat) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+type SQLDatabaseEgress_Secret struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Key  string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *SQLDatabaseEgress_Secret) Reset() {
+	*x = SQLDatabaseEgress_Secret{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SQLDatabaseEgress_Secret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SQLDatabaseEgress_Secret) ProtoMessage() {}
+
+func (x *SQLDatabaseEgress_Secret) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SQLDatabaseEgress_Secret.ProtoReflect.Descriptor instead.
+func (*SQLDatabaseEgress_Secret) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{76, 1}
+}
+
+func (x *SQLDatabaseEgress_Secret) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SQLDatabaseEgress_Secret) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type EgressResponse_ObjectStorageResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BytesWritten int64 `protobuf:"varint,1,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+}
+
+func (x *EgressResponse_ObjectStorageResult) Reset() {
+	*x = EgressResponse_ObjectStorageResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EgressResponse_ObjectStorageResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EgressResponse_ObjectStorageResult) ProtoMessage() {}
+
+func (x *EgressResponse_ObjectStorageResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EgressResponse_ObjectStorageResult.ProtoReflect.Descriptor instead.
+func (*EgressResponse_ObjectStorageResult) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{78, 0}
+}
+
+func (x *EgressResponse_ObjectStorageResult) GetBytesWritten() int64 {
+	if x != nil {
+		return x.BytesWritten
+	}
+	return 0
+}
+
+type EgressResponse_SQLDatabaseResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RowsWritten map[string]int64 `protobuf:"bytes,1,rep,name=rows_written,json=rowsWritten,proto3" json:"rows_written,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *EgressResponse_SQLDatabaseResult) Reset() {
+	*x = EgressResponse_SQLDatabaseResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EgressResponse_SQLDatabaseResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EgressResponse_SQLDatabaseResult) ProtoMessage() {}
+
+func (x *EgressResponse_SQLDatabaseResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EgressResponse_SQLDatabaseResult.ProtoReflect.Descriptor instead.
+func (*EgressResponse_SQLDatabaseResult) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{78, 1}
+}
+
+func (x *EgressResponse_SQLDatabaseResult) GetRowsWritten() map[string]int64 {
+	if x != nil {
+		return x.RowsWritten
+	}
+	return nil
+}
+
+type ReplicationTarget_Secret struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Key  string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *ReplicationTarget_Secret) Reset() {
+	*x = ReplicationTarget_Secret{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicationTarget_Secret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicationTarget_Secret) ProtoMessage() {}
+
+func (x *ReplicationTarget_Secret) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicationTarget_Secret.ProtoReflect.Descriptor instead.
+func (*ReplicationTarget_Secret) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{80, 0}
+}
+
+func (x *ReplicationTarget_Secret) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReplicationTarget_Secret) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// Chunk is a chunk in its stored (compressed and encrypted) form.
+type ReplicateFileSetRequest_Chunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       []byte   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PointsTo [][]byte `protobuf:"bytes,2,rep,name=points_to,json=pointsTo,proto3" json:"points_to,omitempty"`
+	Data     []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ReplicateFileSetRequest_Chunk) Reset() {
+	*x = ReplicateFileSetRequest_Chunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfs_pfs_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicateFileSetRequest_Chunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicateFileSetRequest_Chunk) ProtoMessage() {}
+
+func (x *ReplicateFileSetRequest_Chunk) ProtoReflect() protoreflect.Message {
+	mi := &file_pfs_pfs_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicateFileSetRequest_Chunk.ProtoReflect.Descriptor instead.
+func (*ReplicateFileSetRequest_Chunk) Descriptor() ([]byte, []int) {
+	return file_pfs_pfs_proto_rawDescGZIP(), []int{89, 0}
+}
+
+func (x *ReplicateFileSetRequest_Chunk) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *ReplicateFileSetRequest_Chunk) GetPointsTo() [][]byte {
+	if x != nil {
+		return x.PointsTo
+	}
+	return nil
+}
+
+func (x *ReplicateFileSetRequest_Chunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_pfs_pfs_proto protoreflect.FileDescriptor
+
+var file_pfs_pfs_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x70, 0x66, 0x73, 0x2f, 0x70, 0x66, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x06, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f,
+	0x61, 0x75, 0x74, 0x68, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x0f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x59, 0x0a, 0x04, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x29, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x3e, 0x0a, 0x06, 0x42,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70,
+	0x6f, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x58, 0x0a, 0x04, 0x46,
+	0x69, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x64, 0x61, 0x74, 0x75, 0x6d, 0x22, 0x9e, 0x03, 0x0a, 0x08, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04,
+	0x72, 0x65, 0x70, 0x6f, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x16, 0x73, 0x69,
+	0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x73, 0x69, 0x7a, 0x65,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x55, 0x70, 0x70, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2a, 0x0a, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12, 0x2d, 0x0a,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x32, 0x0a, 0x07,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x2e,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x1a, 0x54, 0x0a, 0x07, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x75, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x22, 0x97, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x73, 0x69, 0x76, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x57, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x35, 0x0a, 0x0b,
+	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0xa0, 0x02, 0x0a, 0x0a, 0x42, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x12, 0x22, 0x0a, 0x04, 0x68, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x04,
+	0x68, 0x65, 0x61, 0x64, 0x12, 0x2e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x76, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x76, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x11, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52,
+	0x10, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x29, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x52, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x22, 0xd1, 0x01, 0x0a,
+	0x07, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61,
+	0x6c, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x6f, 0x6e,
+	0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x6f,
+	0x6e, 0x53, 0x70, 0x65, 0x63, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x22, 0x36, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x12, 0x26, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4b, 0x69,
+	0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x62, 0x0a, 0x06, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04,
+	0x72, 0x65, 0x70, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x22, 0xcd, 0x07, 0x0a,
+	0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a, 0x06, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x33, 0x0a, 0x0d, 0x63, 0x68, 0x69, 0x6c,
+	0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52,
+	0x0c, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x34, 0x0a,
+	0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x36, 0x0a,
+	0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x11, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x52, 0x10, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x16, 0x73, 0x69, 0x7a, 0x65,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x55, 0x70, 0x70, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x32, 0x0a,
+	0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x34, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x1a, 0x9c, 0x02, 0x0a, 0x07, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x42, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69,
+	0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x75, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x09, 0x10, 0x0a, 0x22, 0x80, 0x01, 0x0a,
+	0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x22,
+	0x1b, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6f, 0x0a, 0x0d,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x30, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x53, 0x65, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x12,
+	0x2c, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x22, 0xc8, 0x01,
+	0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a, 0x04, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2d, 0x0a, 0x09,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x1d, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xde, 0x02, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x35,
+	0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74,
+	0x61, 0x1a, 0x35, 0x0a, 0x07, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2a, 0x0a, 0x05,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x22, 0xad, 0x02, 0x0a, 0x0c, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x09, 0x6d, 0x61, 0x78,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x42, 0x0a, 0x0b, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x2e, 0x45, 0x6e, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28,
+	0x00, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x17, 0x0a,
+	0x07, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x70, 0x75, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x61, 0x78, 0x43, 0x70, 0x75, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x21, 0x0a, 0x0b, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x4f, 0x46, 0x54, 0x10, 0x00, 0x12, 0x08,
+	0x0a, 0x04, 0x48, 0x41, 0x52, 0x44, 0x10, 0x01, 0x22, 0x6f, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+	0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x36, 0x0a, 0x12, 0x49, 0x6e, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04, 0x72, 0x65, 0x70,
+	0x6f, 0x22, 0x52, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0x4b, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65,
+	0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x14, 0x0a, 0x05,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x22, 0x69, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61,
+	0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x22, 0x2e, 0x0a,
+	0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x39, 0x0a,
+	0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70,
+	0x6f, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x12, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x26, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52,
+	0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x06, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x22, 0xab, 0x01, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22,
+	0x76, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69,
+	0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
+	0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x22, 0x67, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52,
+	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x77, 0x61, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x04, 0x77, 0x61, 0x69, 0x74,
+	0x22, 0xb1, 0x02, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x22, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c,
+	0x12, 0x33, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x5f, 0x0a, 0x17, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x30, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x61, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x77, 0x61, 0x69, 0x74, 0x22, 0x41, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a,
+	0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x4a, 0x0a, 0x16, 0x53, 0x71, 0x75, 0x61,
+	0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x53, 0x65, 0x74, 0x22, 0x52, 0x0a, 0x14, 0x44, 0x72, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x53, 0x65, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x22, 0xe8, 0x01, 0x0a, 0x16, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52,
+	0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x22, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x61, 0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12, 0x33,
+	0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4b,
+	0x69, 0x6e, 0x64, 0x22, 0x3c, 0x0a, 0x12, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x22, 0xe2, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x68, 0x65, 0x61,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x04, 0x68, 0x65, 0x61, 0x64, 0x12, 0x26, 0x0a,
+	0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x06, 0x62,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x2e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73,
+	0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x22, 0x77, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0xc3, 0x01, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0c, 0x66, 0x6f, 0x75, 0x6e, 0x64,
+	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x00, 0x52,
+	0x0b, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x42, 0x0a, 0x14,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x00, 0x52, 0x12, 0x6c, 0x61,
+	0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x5f, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x73, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x3e, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x06, 0x62,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x22, 0x4f, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65,
+	0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72,
+	0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x22, 0x53, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x06, 0x62,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x14,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x71, 0x75, 0x6f,
+	0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x05,
+	0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x66, 0x0a, 0x15, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33,
+	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x14, 0x0a,
+	0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x57, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
+	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0xfc, 0x01, 0x0a,
+	0x07, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x61, 0x74, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x61, 0x74,
+	0x75, 0x6d, 0x12, 0x2f, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x03,
+	0x72, 0x61, 0x77, 0x12, 0x2d, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c,
+	0x65, 0x2e, 0x55, 0x52, 0x4c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x1a, 0x5d, 0x0a, 0x09, 0x55, 0x52, 0x4c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52,
+	0x4c, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x36, 0x0a, 0x0a, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x61,
+	0x74, 0x75, 0x6d, 0x22, 0x74, 0x0a, 0x08, 0x43, 0x6f, 0x70, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x64, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x12, 0x28, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69,
+	0x6c, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x03, 0x73, 0x72,
+	0x63, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x22, 0x8a, 0x02, 0x0a, 0x11, 0x4d, 0x6f,
+	0x64, 0x69, 0x66, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52,
+	0x09, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x61, 0x64,
+	0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
+	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x07, 0x61, 0x64, 0x64, 0x46, 0x69,
+	0x6c, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x8a, 0x01, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x43, 0x6f, 0x70, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02,
+	0x10, 0x01, 0x48, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x70, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x06,
+	0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x8e, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x55,
+	0x52, 0x4c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x72, 0x61,
+	0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x70, 0x61,
+	0x74, 0x68, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x36, 0x0a, 0x12, 0x49, 0x6e, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+	0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x22,
+	0xa5, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x38, 0x0a, 0x10, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x10, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x9f, 0x01, 0x0a, 0x0f, 0x57, 0x61, 0x6c, 0x6b,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x38, 0x0a,
+	0x10, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x10, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x0f, 0x47, 0x6c,
+	0x6f, 0x62, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12,
+	0x30, 0x0a, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x74,
+	0x68, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x70, 0x61, 0x74, 0x68, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x22, 0x7d, 0x0a, 0x0f, 0x44, 0x69, 0x66, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x27, 0x0a,
+	0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x6f,
+	0x6c, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x68, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x22, 0x6c, 0x0a, 0x10, 0x44, 0x69, 0x66, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c,
+	0x65, 0x12, 0x2b, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6f, 0x6c, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x87,
+	0x01, 0x0a, 0x0b, 0x46, 0x73, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x66, 0x69, 0x78,
+	0x12, 0x35, 0x0a, 0x0d, 0x7a, 0x6f, 0x6d, 0x62, 0x69, 0x65, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x7a, 0x6f, 0x6d, 0x62, 0x69,
+	0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0a, 0x7a, 0x6f, 0x6d, 0x62, 0x69,
+	0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x7a,
+	0x6f, 0x6d, 0x62, 0x69, 0x65, 0x41, 0x6c, 0x6c, 0x42, 0x0e, 0x0a, 0x0c, 0x7a, 0x6f, 0x6d, 0x62,
+	0x69, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x22, 0x36, 0x0a, 0x0c, 0x46, 0x73, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x69, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66, 0x69, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x37, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x66, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26,
+	0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x5b, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c,
+	0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x65,
+	0x74, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x13, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x66, 0x69,
+	0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x74,
+	0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x74, 0x0a, 0x15, 0x43,
+	0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65,
+	0x53, 0x65, 0x74, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x74, 0x6c, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x74, 0x6c,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63,
+	0x74, 0x22, 0x35, 0x0a, 0x13, 0x53, 0x68, 0x61, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66,
+	0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x09, 0x50, 0x61, 0x74, 0x68,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x75,
+	0x70, 0x70, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x70, 0x70, 0x65,
+	0x72, 0x22, 0x41, 0x0a, 0x14, 0x53, 0x68, 0x61, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x68, 0x61,
+	0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x06, 0x73, 0x68,
+	0x61, 0x72, 0x64, 0x73, 0x22, 0x7b, 0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x72,
+	0x65, 0x61, 0x64, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x62, 0x65, 0x67,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x42,
+	0x65, 0x67, 0x69, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x65, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x45, 0x6e,
+	0x64, 0x22, 0x44, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x0f, 0x50, 0x75, 0x74, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41,
+	0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x49, 0x64, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74,
+	0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x23, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x22, 0x3e, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x32, 0x0a, 0x11, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x67, 0x5f, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x67,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x15, 0x0a, 0x13, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x16, 0x0a,
+	0x14, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x13, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0xf3,
+	0x02, 0x0a, 0x11, 0x53, 0x51, 0x4c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x45, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x51, 0x4c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x38, 0x0a,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x51, 0x4c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x1a, 0x9a, 0x01, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x3d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x51,
+	0x4c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e,
+	0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22,
+	0x33, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x08, 0x0a,
+	0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x41, 0x52, 0x51, 0x55,
+	0x45, 0x54, 0x10, 0x03, 0x1a, 0x2e, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x22, 0xc7, 0x01, 0x0a, 0x0d, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x44,
+	0x0a, 0x0e, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x45, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0d, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x73, 0x71, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x53, 0x51, 0x4c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x71, 0x6c, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0xae,
+	0x03, 0x0a, 0x0e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x53, 0x0a, 0x0e, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x73, 0x71, 0x6c, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70,
+	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x51, 0x4c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x71, 0x6c, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x1a, 0x3a, 0x0a, 0x13, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65,
+	0x6e, 0x1a, 0xb1, 0x01, 0x0a, 0x11, 0x53, 0x51, 0x4c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x5c, 0x0a, 0x0c, 0x72, 0x6f, 0x77, 0x73, 0x5f,
+	0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x51, 0x4c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x52, 0x6f, 0x77, 0x73, 0x57, 0x72, 0x69, 0x74,
+	0x74, 0x65, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x72, 0x6f, 0x77, 0x73, 0x57, 0x72,
+	0x69, 0x74, 0x74, 0x65, 0x6e, 0x1a, 0x3e, 0x0a, 0x10, 0x52, 0x6f, 0x77, 0x73, 0x57, 0x72, 0x69,
+	0x74, 0x74, 0x65, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x21, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0xb6, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x63, 0x68,
+	0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x70, 0x61, 0x63, 0x68, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4c, 0x0a,
+	0x11, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x0f, 0x61, 0x75, 0x74, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x1a, 0x2e, 0x0a, 0x06, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0xf1, 0x03, 0x0a, 0x17,
+	0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12,
+	0x44, 0x0a, 0x16, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52,
+	0x14, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x33, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70,
+	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x0c, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x48, 0x0a, 0x12, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x2b, 0x0a, 0x03, 0x6c, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x6c, 0x61,
+	0x67, 0x12, 0x2b, 0x0a, 0x11, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70,
+	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x0d, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x42, 0x0a, 0x15, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x13, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22,
+	0x9b, 0x02, 0x0a, 0x0f, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x35, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x72,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2a, 0x0a,
+	0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52,
+	0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xc8, 0x01,
+	0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x0b, 0x72, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x52, 0x0a, 0x19, 0x49, 0x6e, 0x73, 0x70,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x18, 0x0a, 0x16,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x51, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x35, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x33, 0x0a, 0x14, 0x4d, 0x69, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x64, 0x73, 0x22, 0x34,
+	0x0a, 0x15, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x49, 0x64, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3d, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12,
+	0x1e, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x1a,
+	0x48, 0x0a, 0x05, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x54, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x6c,
+	0x61, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6b, 0x65, 0x65, 0x70, 0x4c,
+	0x61, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x66, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x6b, 0x65, 0x65, 0x70, 0x46, 0x6f, 0x72, 0x12, 0x43, 0x0a, 0x10, 0x6b, 0x65, 0x65,
+	0x70, 0x5f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e,
+	0x6b, 0x65, 0x65, 0x70, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x80,
+	0x01, 0x0a, 0x13, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x12, 0x2f, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x22, 0x86, 0x01, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04, 0x72, 0x65, 0x70,
+	0x6f, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x2f, 0x0a, 0x06, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x3e, 0x0a, 0x1a, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x22, 0x5a, 0x0a, 0x1d, 0x45, 0x6e,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x72,
+	0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x17, 0x0a,
+	0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x7c, 0x0a, 0x0f, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x71, 0x75, 0x61, 0x73, 0x68, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x71, 0x75, 0x61, 0x73, 0x68, 0x65, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x9f, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x50, 0x61, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x05, 0x70,
+	0x61, 0x74, 0x68, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xa9, 0x02, 0x0a, 0x0c, 0x50, 0x61, 0x74, 0x68, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x6c, 0x6f, 0x62, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x6c, 0x6f, 0x62, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x46, 0x69,
+	0x6c, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x6d, 0x61, 0x78, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x38, 0x0a,
+	0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x6a, 0x73, 0x6f,
+	0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x29, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x05, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x0b, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x2d, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x73, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x45, 0x78, 0x74, 0x72, 0x61, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x73, 0x22, 0x51, 0x0a, 0x0b, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x75, 0x6c, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6e, 0x75, 0x6c, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a,
+	0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12,
+	0x33, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x12, 0x2a, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x22, 0x9f, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04, 0x72, 0x65, 0x70,
+	0x6f, 0x12, 0x33, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x08, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x2a, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x22, 0x3f, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04, 0x72,
+	0x65, 0x70, 0x6f, 0x22, 0x62, 0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70,
+	0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0xa3, 0x01, 0x0a, 0x14, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04, 0x72, 0x65,
+	0x70, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x69, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x64, 0x5f, 0x74,
+	0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6c, 0x64, 0x54,
+	0x69, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0xa9, 0x01,
+	0x0a, 0x1a, 0x53, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04,
+	0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69,
+	0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x64, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6c, 0x64, 0x54, 0x69, 0x65, 0x72, 0x12,
+	0x38, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
+	0x63, 0x6f, 0x6c, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x3f, 0x0a, 0x1b, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x22, 0x7b, 0x0a, 0x0e, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1e, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69,
+	0x6c, 0x65, 0x53, 0x65, 0x74, 0x49, 0x64, 0x22, 0xa4, 0x02, 0x0a, 0x11, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a,
+	0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x34, 0x0a,
+	0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x74, 0x6c,
+	0x12, 0x2c, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50,
+	0x61, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x73, 0x22, 0x8d,
+	0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a,
+	0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01,
+	0x02, 0x10, 0x01, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x70, 0x70,
+	0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e,
+	0x64, 0x12, 0x2b, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x2d,
+	0x0a, 0x1b, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3c, 0x0a,
+	0x18, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x70,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x22, 0x5e, 0x0a, 0x11, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x42, 0x0a, 0x1a, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x73, 0x22,
+	0x2c, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x2a, 0x49, 0x0a,
+	0x0a, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x17, 0x0a, 0x13, 0x4f,
+	0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52, 0x10, 0x01, 0x12, 0x08,
+	0x0a, 0x04, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x53, 0x43, 0x4b,
+	0x10, 0x03, 0x22, 0x04, 0x08, 0x04, 0x10, 0x04, 0x2a, 0x2b, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03,
+	0x44, 0x49, 0x52, 0x10, 0x02, 0x2a, 0x5c, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b,
+	0x0a, 0x07, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x52,
+	0x45, 0x41, 0x44, 0x59, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48,
+	0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45,
+	0x44, 0x10, 0x04, 0x2a, 0x3b, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72,
+	0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53,
+	0x4f, 0x4e, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x07,
+	0x0a, 0x03, 0x53, 0x51, 0x4c, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x53, 0x56, 0x10, 0x04,
+	0x32, 0xcb, 0x26, 0x0a, 0x03, 0x41, 0x50, 0x49, 0x12, 0x41, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0b, 0x49,
+	0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x1a, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x08, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x12, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0c, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0b, 0x43, 0x6c,
+	0x65, 0x61, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x47, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x12, 0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x70,
+	0x65, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x3f, 0x0a,
+	0x0a, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x49,
+	0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x12, 0x1e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x10, 0x49, 0x6e, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x12, 0x1f, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x48, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x4b, 0x0a, 0x0f, 0x53, 0x71, 0x75, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x53, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x71, 0x75,
+	0x61, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a,
+	0x0d, 0x44, 0x72, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x12, 0x1c,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x45, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x12, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0d, 0x49, 0x6e, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x1c, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x3f,
+	0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x19, 0x2e, 0x70,
+	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x45, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12,
+	0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79,
+	0x46, 0x69, 0x6c, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4d, 0x6f,
+	0x64, 0x69, 0x66, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x28, 0x01, 0x12, 0x42, 0x0a, 0x07, 0x47,
+	0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x45, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x41, 0x52, 0x12, 0x16, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3d, 0x0a, 0x0b, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49,
+	0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x12, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x39, 0x0a, 0x08, 0x57, 0x61, 0x6c, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x70,
+	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x57, 0x61, 0x6c, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x08, 0x47,
+	0x6c, 0x6f, 0x62, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x41, 0x0a, 0x08, 0x44, 0x69, 0x66, 0x66, 0x46, 0x69,
+	0x6c, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x66, 0x66,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x0c, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x12, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x6c, 0x6c, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x04, 0x46, 0x73, 0x63, 0x6b, 0x12, 0x13, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x73, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x73, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4d, 0x0a, 0x0d,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x19, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x48, 0x0a, 0x0a, 0x47,
+	0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65,
+	0x53, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x64, 0x64,
+	0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0c, 0x52, 0x65, 0x6e, 0x65,
+	0x77, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x50, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65,
+	0x74, 0x12, 0x1d, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x53, 0x68, 0x61, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65,
+	0x74, 0x12, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64,
+	0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x46, 0x69, 0x6c,
+	0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b,
+	0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x1b,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08, 0x50,
+	0x75, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x50, 0x75, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x08, 0x47, 0x65,
+	0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0a, 0x43,
+	0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3b,
+	0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x2e, 0x74, 0x61, 0x73,
+	0x6b, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x61, 0x70, 0x69, 0x2e, 0x54,
+	0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x06, 0x45,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70,
+	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x46, 0x0a, 0x0e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x1d, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x13, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x47, 0x0a, 0x0d, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x2e, 0x70,
+	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x12, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x70,
+	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70,
+	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4f, 0x0a, 0x11, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0d, 0x4d, 0x69,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x66, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x10, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x1f,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x28, 0x01, 0x12, 0x51, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x21, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x22, 0x2e, 0x70,
+	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x5c, 0x0a, 0x16, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x25, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x53, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x70,
+	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22,
+	0x00, 0x30, 0x01, 0x12, 0x53, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x70, 0x66, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x23, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x56, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12,
+	0x58, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70,
+	0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x43, 0x0a, 0x0a, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x72, 0x74, 0x12, 0x19, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x22, 0x00, 0x28, 0x01, 0x12, 0x53, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x13, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x22, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x2b,
+	0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x61, 0x63,
+	0x68, 0x79, 0x64, 0x65, 0x72, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64, 0x65, 0x72, 0x6d,
+	0x2f, 0x76, 0x32, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x70, 0x66, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pfs_pfs_proto_rawDescOnce sync.Once
+	file_pfs_pfs_proto_rawDescData = file_pfs_pfs_proto_rawDesc
+)
+
+func file_pfs_pfs_proto_rawDescGZIP() []byte {
+	file_pfs_pfs_proto_rawDescOnce.Do(func() {
+		file_pfs_pfs_proto_rawDescData = protoimpl.X.CompressGZIP(file_pfs_pfs_proto_rawDescData)
+	})
+	return file_pfs_pfs_proto_rawDescData
+}
+
+var file_pfs_pfs_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_pfs_pfs_proto_msgTypes = make([]protoimpl.MessageInfo, 126)
+var file_pfs_pfs_proto_goTypes = []interface{}{
+	(OriginKind)(0),                            // 0: pfs_v2.OriginKind
+	(FileType)(0),                              // 1: pfs_v2.FileType
+	(CommitState)(0),                           // 2: pfs_v2.CommitState
+	(Delimiter)(0),                             // 3: pfs_v2.Delimiter
+	(ProjectQuota_Enforcement)(0),              // 4: pfs_v2.ProjectQuota.Enforcement
+	(SQLDatabaseEgress_FileFormat_Type)(0),     // 5: pfs_v2.SQLDatabaseEgress.FileFormat.Type
+	(*Repo)(nil),                               // 6: pfs_v2.Repo
+	(*Branch)(nil),                             // 7: pfs_v2.Branch
+	(*File)(nil),                               // 8: pfs_v2.File
+	(*RepoInfo)(nil),                           // 9: pfs_v2.RepoInfo
+	(*StorageUsage)(nil),                       // 10: pfs_v2.StorageUsage
+	(*AuthInfo)(nil),                           // 11: pfs_v2.AuthInfo
+	(*BranchInfo)(nil),                         // 12: pfs_v2.BranchInfo
+	(*Trigger)(nil),                            // 13: pfs_v2.Trigger
+	(*CommitOrigin)(nil),                       // 14: pfs_v2.CommitOrigin
+	(*Commit)(nil),                             // 15: pfs_v2.Commit
+	(*CommitInfo)(nil),                         // 16: pfs_v2.CommitInfo
+	(*CommitApproval)(nil),                     // 17: pfs_v2.CommitApproval
+	(*CommitSet)(nil),                          // 18: pfs_v2.CommitSet
+	(*CommitSetInfo)(nil),                      // 19: pfs_v2.CommitSetInfo
+	(*FileInfo)(nil),                           // 20: pfs_v2.FileInfo
+	(*Project)(nil),                            // 21: pfs_v2.Project
+	(*ProjectInfo)(nil),                        // 22: pfs_v2.ProjectInfo
+	(*ProjectQuota)(nil),                       // 23: pfs_v2.ProjectQuota
+	(*CreateRepoRequest)(nil),                  // 24: pfs_v2.CreateRepoRequest
+	(*InspectRepoRequest)(nil),                 // 25: pfs_v2.InspectRepoRequest
+	(*ListRepoRequest)(nil),                    // 26: pfs_v2.ListRepoRequest
+	(*DeleteRepoRequest)(nil),                  // 27: pfs_v2.DeleteRepoRequest
+	(*DeleteReposRequest)(nil),                 // 28: pfs_v2.DeleteReposRequest
+	(*DeleteRepoResponse)(nil),                 // 29: pfs_v2.DeleteRepoResponse
+	(*DeleteReposResponse)(nil),                // 30: pfs_v2.DeleteReposResponse
+	(*StartCommitRequest)(nil),                 // 31: pfs_v2.StartCommitRequest
+	(*FinishCommitRequest)(nil),                // 32: pfs_v2.FinishCommitRequest
+	(*ApproveCommitRequest)(nil),               // 33: pfs_v2.ApproveCommitRequest
+	(*InspectCommitRequest)(nil),               // 34: pfs_v2.InspectCommitRequest
+	(*ListCommitRequest)(nil),                  // 35: pfs_v2.ListCommitRequest
+	(*InspectCommitSetRequest)(nil),            // 36: pfs_v2.InspectCommitSetRequest
+	(*ListCommitSetRequest)(nil),               // 37: pfs_v2.ListCommitSetRequest
+	(*SquashCommitSetRequest)(nil),             // 38: pfs_v2.SquashCommitSetRequest
+	(*DropCommitSetRequest)(nil),               // 39: pfs_v2.DropCommitSetRequest
+	(*SubscribeCommitRequest)(nil),             // 40: pfs_v2.SubscribeCommitRequest
+	(*ClearCommitRequest)(nil),                 // 41: pfs_v2.ClearCommitRequest
+	(*CreateBranchRequest)(nil),                // 42: pfs_v2.CreateBranchRequest
+	(*FindCommitsRequest)(nil),                 // 43: pfs_v2.FindCommitsRequest
+	(*FindCommitsResponse)(nil),                // 44: pfs_v2.FindCommitsResponse
+	(*InspectBranchRequest)(nil),               // 45: pfs_v2.InspectBranchRequest
+	(*ListBranchRequest)(nil),                  // 46: pfs_v2.ListBranchRequest
+	(*DeleteBranchRequest)(nil),                // 47: pfs_v2.DeleteBranchRequest
+	(*CreateProjectRequest)(nil),               // 48: pfs_v2.CreateProjectRequest
+	(*InspectProjectRequest)(nil),              // 49: pfs_v2.InspectProjectRequest
+	(*ListProjectRequest)(nil),                 // 50: pfs_v2.ListProjectRequest
+	(*DeleteProjectRequest)(nil),               // 51: pfs_v2.DeleteProjectRequest
+	(*AddFile)(nil),                            // 52: pfs_v2.AddFile
+	(*DeleteFile)(nil),                         // 53: pfs_v2.DeleteFile
+	(*CopyFile)(nil),                           // 54: pfs_v2.CopyFile
+	(*ModifyFileRequest)(nil),                  // 55: pfs_v2.ModifyFileRequest
+	(*GetFileRequest)(nil),                     // 56: pfs_v2.GetFileRequest
+	(*InspectFileRequest)(nil),                 // 57: pfs_v2.InspectFileRequest
+	(*ListFileRequest)(nil),                    // 58: pfs_v2.ListFileRequest
+	(*WalkFileRequest)(nil),                    // 59: pfs_v2.WalkFileRequest
+	(*GlobFileRequest)(nil),                    // 60: pfs_v2.GlobFileRequest
+	(*DiffFileRequest)(nil),                    // 61: pfs_v2.DiffFileRequest
+	(*DiffFileResponse)(nil),                   // 62: pfs_v2.DiffFileResponse
+	(*FsckRequest)(nil),                        // 63: pfs_v2.FsckRequest
+	(*FsckResponse)(nil),                       // 64: pfs_v2.FsckResponse
+	(*CreateFileSetResponse)(nil),              // 65: pfs_v2.CreateFileSetResponse
+	(*GetFileSetRequest)(nil),                  // 66: pfs_v2.GetFileSetRequest
+	(*AddFileSetRequest)(nil),                  // 67: pfs_v2.AddFileSetRequest
+	(*RenewFileSetRequest)(nil),                // 68: pfs_v2.RenewFileSetRequest
+	(*ComposeFileSetRequest)(nil),              // 69: pfs_v2.ComposeFileSetRequest
+	(*ShardFileSetRequest)(nil),                // 70: pfs_v2.ShardFileSetRequest
+	(*PathRange)(nil),                          // 71: pfs_v2.PathRange
+	(*ShardFileSetResponse)(nil),               // 72: pfs_v2.ShardFileSetResponse
+	(*CheckStorageRequest)(nil),                // 73: pfs_v2.CheckStorageRequest
+	(*CheckStorageResponse)(nil),               // 74: pfs_v2.CheckStorageResponse
+	(*PutCacheRequest)(nil),                    // 75: pfs_v2.PutCacheRequest
+	(*GetCacheRequest)(nil),                    // 76: pfs_v2.GetCacheRequest
+	(*GetCacheResponse)(nil),                   // 77: pfs_v2.GetCacheResponse
+	(*ClearCacheRequest)(nil),                  // 78: pfs_v2.ClearCacheRequest
+	(*ActivateAuthRequest)(nil),                // 79: pfs_v2.ActivateAuthRequest
+	(*ActivateAuthResponse)(nil),               // 80: pfs_v2.ActivateAuthResponse
+	(*ObjectStorageEgress)(nil),                // 81: pfs_v2.ObjectStorageEgress
+	(*SQLDatabaseEgress)(nil),                  // 82: pfs_v2.SQLDatabaseEgress
+	(*EgressRequest)(nil),                      // 83: pfs_v2.EgressRequest
+	(*EgressResponse)(nil),                     // 84: pfs_v2.EgressResponse
+	(*Replication)(nil),                        // 85: pfs_v2.Replication
+	(*ReplicationTarget)(nil),                  // 86: pfs_v2.ReplicationTarget
+	(*BranchReplicationStatus)(nil),            // 87: pfs_v2.BranchReplicationStatus
+	(*ReplicationInfo)(nil),                    // 88: pfs_v2.ReplicationInfo
+	(*CreateReplicationRequest)(nil),           // 89: pfs_v2.CreateReplicationRequest
+	(*InspectReplicationRequest)(nil),          // 90: pfs_v2.InspectReplicationRequest
+	(*ListReplicationRequest)(nil),             // 91: pfs_v2.ListReplicationRequest
+	(*DeleteReplicationRequest)(nil),           // 92: pfs_v2.DeleteReplicationRequest
+	(*MissingChunksRequest)(nil),               // 93: pfs_v2.MissingChunksRequest
+	(*MissingChunksResponse)(nil),              // 94: pfs_v2.MissingChunksResponse
+	(*ReplicateFileSetRequest)(nil),            // 95: pfs_v2.ReplicateFileSetRequest
+	(*RetentionPolicy)(nil),                    // 96: pfs_v2.RetentionPolicy
+	(*RetentionPolicyInfo)(nil),                // 97: pfs_v2.RetentionPolicyInfo
+	(*SetRetentionPolicyRequest)(nil),          // 98: pfs_v2.SetRetentionPolicyRequest
+	(*ListRetentionPolicyRequest)(nil),         // 99: pfs_v2.ListRetentionPolicyRequest
+	(*EnforceRetentionPolicyRequest)(nil),      // 100: pfs_v2.EnforceRetentionPolicyRequest
+	(*RetentionAction)(nil),                    // 101: pfs_v2.RetentionAction
+	(*ContentContract)(nil),                    // 102: pfs_v2.ContentContract
+	(*PathContract)(nil),                       // 103: pfs_v2.PathContract
+	(*TableSchema)(nil),                        // 104: pfs_v2.TableSchema
+	(*TableColumn)(nil),                        // 105: pfs_v2.TableColumn
+	(*CommitValidationInfo)(nil),               // 106: pfs_v2.CommitValidationInfo
+	(*SetCommitValidationRequest)(nil),         // 107: pfs_v2.SetCommitValidationRequest
+	(*ListCommitValidationRequest)(nil),        // 108: pfs_v2.ListCommitValidationRequest
+	(*CommitValidationResult)(nil),             // 109: pfs_v2.CommitValidationResult
+	(*StoragePlacementInfo)(nil),               // 110: pfs_v2.StoragePlacementInfo
+	(*SetStoragePlacementRequest)(nil),         // 111: pfs_v2.SetStoragePlacementRequest
+	(*ListStoragePlacementRequest)(nil),        // 112: pfs_v2.ListStoragePlacementRequest
+	(*UploadPartInfo)(nil),                     // 113: pfs_v2.UploadPartInfo
+	(*UploadSessionInfo)(nil),                  // 114: pfs_v2.UploadSessionInfo
+	(*CreateUploadSessionRequest)(nil),         // 115: pfs_v2.CreateUploadSessionRequest
+	(*InspectUploadSessionRequest)(nil),        // 116: pfs_v2.InspectUploadSessionRequest
+	(*ListUploadSessionRequest)(nil),           // 117: pfs_v2.ListUploadSessionRequest
+	(*UploadPartRequest)(nil),                  // 118: pfs_v2.UploadPartRequest
+	(*CommitUploadSessionRequest)(nil),         // 119: pfs_v2.CommitUploadSessionRequest
+	(*DeleteUploadSessionRequest)(nil),         // 120: pfs_v2.DeleteUploadSessionRequest
+	(*RepoInfo_Details)(nil),                   // 121: pfs_v2.RepoInfo.Details
+	(*CommitInfo_Details)(nil),                 // 122: pfs_v2.CommitInfo.Details
+	(*ProjectInfo_Details)(nil),                // 123: pfs_v2.ProjectInfo.Details
+	(*AddFile_URLSource)(nil),                  // 124: pfs_v2.AddFile.URLSource
+	(*SQLDatabaseEgress_FileFormat)(nil),       // 125: pfs_v2.SQLDatabaseEgress.FileFormat
+	(*SQLDatabaseEgress_Secret)(nil),           // 126: pfs_v2.SQLDatabaseEgress.Secret
+	(*EgressResponse_ObjectStorageResult)(nil), // 127: pfs_v2.EgressResponse.ObjectStorageResult
+	(*EgressResponse_SQLDatabaseResult)(nil),   // 128: pfs_v2.EgressResponse.SQLDatabaseResult
+	nil,                                        // 129: pfs_v2.EgressResponse.SQLDatabaseResult.RowsWrittenEntry
+	(*ReplicationTarget_Secret)(nil),           // 130: pfs_v2.ReplicationTarget.Secret
+	(*ReplicateFileSetRequest_Chunk)(nil),      // 131: pfs_v2.ReplicateFileSetRequest.Chunk
+	(*timestamppb.Timestamp)(nil),              // 132: google.protobuf.Timestamp
+	(auth.Permission)(0),                       // 133: auth_v2.Permission
+	(*wrapperspb.BytesValue)(nil),              // 134: google.protobuf.BytesValue
+	(*anypb.Any)(nil),                          // 135: google.protobuf.Any
+	(*durationpb.Duration)(nil),                // 136: google.protobuf.Duration
+	(*structpb.Struct)(nil),                    // 137: google.protobuf.Struct
+	(*emptypb.Empty)(nil),                      // 138: google.protobuf.Empty
+	(*task.ListTaskRequest)(nil),               // 139: taskapi.ListTaskRequest
+	(*task.TaskInfo)(nil),                      // 140: taskapi.TaskInfo
+}
+var file_pfs_pfs_proto_depIdxs = []int32{
+	21,  // 0: pfs_v2.Repo.project:type_name -> pfs_v2.Project
+	6,   // 1: pfs_v2.Branch.repo:type_name -> pfs_v2.Repo
+	15,  // 2: pfs_v2.File.commit:type_name -> pfs_v2.Commit
+	6,   // 3: pfs_v2.RepoInfo.repo:type_name -> pfs_v2.Repo
+	132, // 4: pfs_v2.RepoInfo.created:type_name -> google.protobuf.Timestamp
+	7,   // 5: pfs_v2.RepoInfo.branches:type_name -> pfs_v2.Branch
+	11,  // 6: pfs_v2.RepoInfo.auth_info:type_name -> pfs_v2.AuthInfo
+	121, // 7: pfs_v2.RepoInfo.details:type_name -> pfs_v2.RepoInfo.Details
+	132, // 8: pfs_v2.StorageUsage.computed_at:type_name -> google.protobuf.Timestamp
+	133, // 9: pfs_v2.AuthInfo.permissions:type_name -> auth_v2.Permission
+	7,   // 10: pfs_v2.BranchInfo.branch:type_name -> pfs_v2.Branch
+	15,  // 11: pfs_v2.BranchInfo.head:type_name -> pfs_v2.Commit
+	7,   // 12: pfs_v2.BranchInfo.provenance:type_name -> pfs_v2.Branch
+	7,   // 13: pfs_v2.BranchInfo.subvenance:type_name -> pfs_v2.Branch
+	7,   // 14: pfs_v2.BranchInfo.direct_provenance:type_name -> pfs_v2.Branch
+	13,  // 15: pfs_v2.BranchInfo.trigger:type_name -> pfs_v2.Trigger
+	0,   // 16: pfs_v2.CommitOrigin.kind:type_name -> pfs_v2.OriginKind
+	6,   // 17: pfs_v2.Commit.repo:type_name -> pfs_v2.Repo
+	7,   // 18: pfs_v2.Commit.branch:type_name -> pfs_v2.Branch
+	15,  // 19: pfs_v2.CommitInfo.commit:type_name -> pfs_v2.Commit
+	14,  // 20: pfs_v2.CommitInfo.origin:type_name -> pfs_v2.CommitOrigin
+	15,  // 21: pfs_v2.CommitInfo.parent_commit:type_name -> pfs_v2.Commit
+	15,  // 22: pfs_v2.CommitInfo.child_commits:type_name -> pfs_v2.Commit
+	132, // 23: pfs_v2.CommitInfo.started:type_name -> google.protobuf.Timestamp
+	132, // 24: pfs_v2.CommitInfo.finishing:type_name -> google.protobuf.Timestamp
+	132, // 25: pfs_v2.CommitInfo.finished:type_name -> google.protobuf.Timestamp
+	15,  // 26: pfs_v2.CommitInfo.direct_provenance:type_name -> pfs_v2.Commit
+	17,  // 27: pfs_v2.CommitInfo.approval:type_name -> pfs_v2.CommitApproval
+	122, // 28: pfs_v2.CommitInfo.details:type_name -> pfs_v2.CommitInfo.Details
+	132, // 29: pfs_v2.CommitApproval.approved:type_name -> google.protobuf.Timestamp
+	18,  // 30: pfs_v2.CommitSetInfo.commit_set:type_name -> pfs_v2.CommitSet
+	16,  // 31: pfs_v2.CommitSetInfo.commits:type_name -> pfs_v2.CommitInfo
+	8,   // 32: pfs_v2.FileInfo.file:type_name -> pfs_v2.File
+	1,   // 33: pfs_v2.FileInfo.file_type:type_name -> pfs_v2.FileType
+	132, // 34: pfs_v2.FileInfo.committed:type_name -> google.protobuf.Timestamp
+	21,  // 35: pfs_v2.ProjectInfo.project:type_name -> pfs_v2.Project
+	11,  // 36: pfs_v2.ProjectInfo.auth_info:type_name -> pfs_v2.AuthInfo
+	132, // 37: pfs_v2.ProjectInfo.created_at:type_name -> google.protobuf.Timestamp
+	123, // 38: pfs_v2.ProjectInfo.details:type_name -> pfs_v2.ProjectInfo.Details
+	23,  // 39: pfs_v2.ProjectInfo.quota:type_name -> pfs_v2.ProjectQuota
+	4,   // 40: pfs_v2.ProjectQuota.enforcement:type_name -> pfs_v2.ProjectQuota.Enforcement
+	6,   // 41: pfs_v2.CreateRepoRequest.repo:type_name -> pfs_v2.Repo
+	6,   // 42: pfs_v2.InspectRepoRequest.repo:type_name -> pfs_v2.Repo
+	21,  // 43: pfs_v2.ListRepoRequest.projects:type_name -> pfs_v2.Project
+	6,   // 44: pfs_v2.DeleteRepoRequest.repo:type_name -> pfs_v2.Repo
+	21,  // 45: pfs_v2.DeleteReposRequest.projects:type_name -> pfs_v2.Project
+	6,   // 46: pfs_v2.DeleteReposResponse.repos:type_name -> pfs_v2.Repo
+	15,  // 47: pfs_v2.StartCommitRequest.parent:type_name -> pfs_v2.Commit
+	7,   // 48: pfs_v2.StartCommitRequest.branch:type_name -> pfs_v2.Branch
+	15,  // 49: pfs_v2.FinishCommitRequest.commit:type_name -> pfs_v2.Commit
+	15,  // 50: pfs_v2.ApproveCommitRequest.commit:type_name -> pfs_v2.Commit
+	15,  // 51: pfs_v2.InspectCommitRequest.commit:type_name -> pfs_v2.Commit
+	2,   // 52: pfs_v2.InspectCommitRequest.wait:type_name -> pfs_v2.CommitState
+	6,   // 53: pfs_v2.ListCommitRequest.repo:type_name -> pfs_v2.Repo
+	15,  // 54: pfs_v2.ListCommitRequest.from:type_name -> pfs_v2.Commit
+	15,  // 55: pfs_v2.ListCommitRequest.to:type_name -> pfs_v2.Commit
+	0,   // 56: pfs_v2.ListCommitRequest.origin_kind:type_name -> pfs_v2.OriginKind
+	132, // 57: pfs_v2.ListCommitRequest.started_time:type_name -> google.protobuf.Timestamp
+	18,  // 58: pfs_v2.InspectCommitSetRequest.commit_set:type_name -> pfs_v2.CommitSet
+	21,  // 59: pfs_v2.ListCommitSetRequest.project:type_name -> pfs_v2.Project
+	18,  // 60: pfs_v2.SquashCommitSetRequest.commit_set:type_name -> pfs_v2.CommitSet
+	18,  // 61: pfs_v2.DropCommitSetRequest.commit_set:type_name -> pfs_v2.CommitSet
+	6,   // 62: pfs_v2.SubscribeCommitRequest.repo:type_name -> pfs_v2.Repo
+	15,  // 63: pfs_v2.SubscribeCommitRequest.from:type_name -> pfs_v2.Commit
+	2,   // 64: pfs_v2.SubscribeCommitRequest.state:type_name -> pfs_v2.CommitState
+	0,   // 65: pfs_v2.SubscribeCommitRequest.origin_kind:type_name -> pfs_v2.OriginKind
+	15,  // 66: pfs_v2.ClearCommitRequest.commit:type_name -> pfs_v2.Commit
+	15,  // 67: pfs_v2.CreateBranchRequest.head:type_name -> pfs_v2.Commit
+	7,   // 68: pfs_v2.CreateBranchRequest.branch:type_name -> pfs_v2.Branch
+	7,   // 69: pfs_v2.CreateBranchRequest.provenance:type_name -> pfs_v2.Branch
+	13,  // 70: pfs_v2.CreateBranchRequest.trigger:type_name -> pfs_v2.Trigger
+	15,  // 71: pfs_v2.FindCommitsRequest.start:type_name -> pfs_v2.Commit
+	15,  // 72: pfs_v2.FindCommitsResponse.found_commit:type_name -> pfs_v2.Commit
+	15,  // 73: pfs_v2.FindCommitsResponse.last_searched_commit:type_name -> pfs_v2.Commit
+	7,   // 74: pfs_v2.InspectBranchRequest.branch:type_name -> pfs_v2.Branch
+	6,   // 75: pfs_v2.ListBranchRequest.repo:type_name -> pfs_v2.Repo
+	7,   // 76: pfs_v2.DeleteBranchRequest.branch:type_name -> pfs_v2.Branch
+	21,  // 77: pfs_v2.CreateProjectRequest.project:type_name -> pfs_v2.Project
+	23,  // 78: pfs_v2.CreateProjectRequest.quota:type_name -> pfs_v2.ProjectQuota
+	21,  // 79: pfs_v2.InspectProjectRequest.project:type_name -> pfs_v2.Project
+	21,  // 80: pfs_v2.DeleteProjectRequest.project:type_name -> pfs_v2.Project
+	134, // 81: pfs_v2.AddFile.raw:type_name -> google.protobuf.BytesValue
+	124, // 82: pfs_v2.AddFile.url:type_name -> pfs_v2.AddFile.URLSource
+	8,   // 83: pfs_v2.CopyFile.src:type_name -> pfs_v2.File
+	15,  // 84: pfs_v2.ModifyFileRequest.set_commit:type_name -> pfs_v2.Commit
+	52,  // 85: pfs_v2.ModifyFileRequest.add_file:type_name -> pfs_v2.AddFile
+	53,  // 86: pfs_v2.ModifyFileRequest.delete_file:type_name -> pfs_v2.DeleteFile
+	54,  // 87: pfs_v2.ModifyFileRequest.copy_file:type_name -> pfs_v2.CopyFile
+	8,   // 88: pfs_v2.GetFileRequest.file:type_name -> pfs_v2.File
+	71,  // 89: pfs_v2.GetFileRequest.path_range:type_name -> pfs_v2.PathRange
+	8,   // 90: pfs_v2.InspectFileRequest.file:type_name -> pfs_v2.File
+	8,   // 91: pfs_v2.ListFileRequest.file:type_name -> pfs_v2.File
+	8,   // 92: pfs_v2.ListFileRequest.paginationMarker:type_name -> pfs_v2.File
+	8,   // 93: pfs_v2.WalkFileRequest.file:type_name -> pfs_v2.File
+	8,   // 94: pfs_v2.WalkFileRequest.paginationMarker:type_name -> pfs_v2.File
+	15,  // 95: pfs_v2.GlobFileRequest.commit:type_name -> pfs_v2.Commit
+	71,  // 96: pfs_v2.GlobFileRequest.path_range:type_name -> pfs_v2.PathRange
+	8,   // 97: pfs_v2.DiffFileRequest.new_file:type_name -> pfs_v2.File
+	8,   // 98: pfs_v2.DiffFileRequest.old_file:type_name -> pfs_v2.File
+	20,  // 99: pfs_v2.DiffFileResponse.new_file:type_name -> pfs_v2.FileInfo
+	20,  // 100: pfs_v2.DiffFileResponse.old_file:type_name -> pfs_v2.FileInfo
+	15,  // 101: pfs_v2.FsckRequest.zombie_target:type_name -> pfs_v2.Commit
+	15,  // 102: pfs_v2.GetFileSetRequest.commit:type_name -> pfs_v2.Commit
+	15,  // 103: pfs_v2.AddFileSetRequest.commit:type_name -> pfs_v2.Commit
+	71,  // 104: pfs_v2.ShardFileSetResponse.shards:type_name -> pfs_v2.PathRange
+	135, // 105: pfs_v2.PutCacheRequest.value:type_name -> google.protobuf.Any
+	135, // 106: pfs_v2.GetCacheResponse.value:type_name -> google.protobuf.Any
+	125, // 107: pfs_v2.SQLDatabaseEgress.file_format:type_name -> pfs_v2.SQLDatabaseEgress.FileFormat
+	126, // 108: pfs_v2.SQLDatabaseEgress.secret:type_name -> pfs_v2.SQLDatabaseEgress.Secret
+	15,  // 109: pfs_v2.EgressRequest.commit:type_name -> pfs_v2.Commit
+	81,  // 110: pfs_v2.EgressRequest.object_storage:type_name -> pfs_v2.ObjectStorageEgress
+	82,  // 111: pfs_v2.EgressRequest.sql_database:type_name -> pfs_v2.SQLDatabaseEgress
+	127, // 112: pfs_v2.EgressResponse.object_storage:type_name -> pfs_v2.EgressResponse.ObjectStorageResult
+	128, // 113: pfs_v2.EgressResponse.sql_database:type_name -> pfs_v2.EgressResponse.SQLDatabaseResult
+	130, // 114: pfs_v2.ReplicationTarget.auth_token_secret:type_name -> pfs_v2.ReplicationTarget.Secret
+	7,   // 115: pfs_v2.BranchReplicationStatus.branch:type_name -> pfs_v2.Branch
+	15,  // 116: pfs_v2.BranchReplicationStatus.last_replicated_commit:type_name -> pfs_v2.Commit
+	15,  // 117: pfs_v2.BranchReplicationStatus.target_commit:type_name -> pfs_v2.Commit
+	132, // 118: pfs_v2.BranchReplicationStatus.last_replicated_at:type_name -> google.protobuf.Timestamp
+	136, // 119: pfs_v2.BranchReplicationStatus.lag:type_name -> google.protobuf.Duration
+	15,  // 120: pfs_v2.BranchReplicationStatus.pending_commit:type_name -> pfs_v2.Commit
+	15,  // 121: pfs_v2.BranchReplicationStatus.pending_target_commit:type_name -> pfs_v2.Commit
+	85,  // 122: pfs_v2.ReplicationInfo.replication:type_name -> pfs_v2.Replication
+	86,  // 123: pfs_v2.ReplicationInfo.target:type_name -> pfs_v2.ReplicationTarget
+	7,   // 124: pfs_v2.ReplicationInfo.branches:type_name -> pfs_v2.Branch
+	132, // 125: pfs_v2.ReplicationInfo.created_at:type_name -> google.protobuf.Timestamp
+	87,  // 126: pfs_v2.ReplicationInfo.status:type_name -> pfs_v2.BranchReplicationStatus
+	85,  // 127: pfs_v2.CreateReplicationRequest.replication:type_name -> pfs_v2.Replication
+	86,  // 128: pfs_v2.CreateReplicationRequest.target:type_name -> pfs_v2.ReplicationTarget
+	7,   // 129: pfs_v2.CreateReplicationRequest.branches:type_name -> pfs_v2.Branch
+	85,  // 130: pfs_v2.InspectReplicationRequest.replication:type_name -> pfs_v2.Replication
+	85,  // 131: pfs_v2.DeleteReplicationRequest.replication:type_name -> pfs_v2.Replication
+	131, // 132: pfs_v2.ReplicateFileSetRequest.chunk:type_name -> pfs_v2.ReplicateFileSetRequest.Chunk
+	136, // 133: pfs_v2.RetentionPolicy.keep_for:type_name -> google.protobuf.Duration
+	136, // 134: pfs_v2.RetentionPolicy.keep_daily_after:type_name -> google.protobuf.Duration
+	6,   // 135: pfs_v2.RetentionPolicyInfo.repo:type_name -> pfs_v2.Repo
+	96,  // 136: pfs_v2.RetentionPolicyInfo.policy:type_name -> pfs_v2.RetentionPolicy
+	6,   // 137: pfs_v2.SetRetentionPolicyRequest.repo:type_name -> pfs_v2.Repo
+	96,  // 138: pfs_v2.SetRetentionPolicyRequest.policy:type_name -> pfs_v2.RetentionPolicy
+	6,   // 139: pfs_v2.ListRetentionPolicyRequest.repo:type_name -> pfs_v2.Repo
+	6,   // 140: pfs_v2.EnforceRetentionPolicyRequest.repo:type_name -> pfs_v2.Repo
+	15,  // 141: pfs_v2.RetentionAction.commit:type_name -> pfs_v2.Commit
+	103, // 142: pfs_v2.ContentContract.paths:type_name -> pfs_v2.PathContract
+	137, // 143: pfs_v2.PathContract.json_schema:type_name -> google.protobuf.Struct
+	104, // 144: pfs_v2.PathContract.table:type_name -> pfs_v2.TableSchema
+	105, // 145: pfs_v2.TableSchema.columns:type_name -> pfs_v2.TableColumn
+	6,   // 146: pfs_v2.CommitValidationInfo.repo:type_name -> pfs_v2.Repo
+	102, // 147: pfs_v2.CommitValidationInfo.contract:type_name -> pfs_v2.ContentContract
+	6,   // 148: pfs_v2.CommitValidationInfo.validator:type_name -> pfs_v2.Repo
+	6,   // 149: pfs_v2.SetCommitValidationRequest.repo:type_name -> pfs_v2.Repo
+	102, // 150: pfs_v2.SetCommitValidationRequest.contract:type_name -> pfs_v2.ContentContract
+	6,   // 151: pfs_v2.SetCommitValidationRequest.validator:type_name -> pfs_v2.Repo
+	6,   // 152: pfs_v2.ListCommitValidationRequest.repo:type_name -> pfs_v2.Repo
+	6,   // 153: pfs_v2.StoragePlacementInfo.repo:type_name -> pfs_v2.Repo
+	136, // 154: pfs_v2.StoragePlacementInfo.cold_after:type_name -> google.protobuf.Duration
+	6,   // 155: pfs_v2.SetStoragePlacementRequest.repo:type_name -> pfs_v2.Repo
+	136, // 156: pfs_v2.SetStoragePlacementRequest.cold_after:type_name -> google.protobuf.Duration
+	6,   // 157: pfs_v2.ListStoragePlacementRequest.repo:type_name -> pfs_v2.Repo
+	8,   // 158: pfs_v2.UploadSessionInfo.file:type_name -> pfs_v2.File
+	132, // 159: pfs_v2.UploadSessionInfo.created:type_name -> google.protobuf.Timestamp
+	132, // 160: pfs_v2.UploadSessionInfo.expires:type_name -> google.protobuf.Timestamp
+	136, // 161: pfs_v2.UploadSessionInfo.ttl:type_name -> google.protobuf.Duration
+	113, // 162: pfs_v2.UploadSessionInfo.parts:type_name -> pfs_v2.UploadPartInfo
+	8,   // 163: pfs_v2.CreateUploadSessionRequest.file:type_name -> pfs_v2.File
+	136, // 164: pfs_v2.CreateUploadSessionRequest.ttl:type_name -> google.protobuf.Duration
+	6,   // 165: pfs_v2.ListUploadSessionRequest.repo:type_name -> pfs_v2.Repo
+	10,  // 166: pfs_v2.RepoInfo.Details.usage:type_name -> pfs_v2.StorageUsage
+	136, // 167: pfs_v2.CommitInfo.Details.compacting_time:type_name -> google.protobuf.Duration
+	136, // 168: pfs_v2.CommitInfo.Details.validating_time:type_name -> google.protobuf.Duration
+	10,  // 169: pfs_v2.CommitInfo.Details.usage:type_name -> pfs_v2.StorageUsage
+	109, // 170: pfs_v2.CommitInfo.Details.validation:type_name -> pfs_v2.CommitValidationResult
+	10,  // 171: pfs_v2.ProjectInfo.Details.usage:type_name -> pfs_v2.StorageUsage
+	5,   // 172: pfs_v2.SQLDatabaseEgress.FileFormat.type:type_name -> pfs_v2.SQLDatabaseEgress.FileFormat.Type
+	129, // 173: pfs_v2.EgressResponse.SQLDatabaseResult.rows_written:type_name -> pfs_v2.EgressResponse.SQLDatabaseResult.RowsWrittenEntry
+	24,  // 174: pfs_v2.API.CreateRepo:input_type -> pfs_v2.CreateRepoRequest
+	25,  // 175: pfs_v2.API.InspectRepo:input_type -> pfs_v2.InspectRepoRequest
+	26,  // 176: pfs_v2.API.ListRepo:input_type -> pfs_v2.ListRepoRequest
+	27,  // 177: pfs_v2.API.DeleteRepo:input_type -> pfs_v2.DeleteRepoRequest
+	28,  // 178: pfs_v2.API.DeleteRepos:input_type -> pfs_v2.DeleteReposRequest
+	31,  // 179: pfs_v2.API.StartCommit:input_type -> pfs_v2.StartCommitRequest
+	32,  // 180: pfs_v2.API.FinishCommit:input_type -> pfs_v2.FinishCommitRequest
+	41,  // 181: pfs_v2.API.ClearCommit:input_type -> pfs_v2.ClearCommitRequest
+	33,  // 182: pfs_v2.API.ApproveCommit:input_type -> pfs_v2.ApproveCommitRequest
+	34,  // 183: pfs_v2.API.InspectCommit:input_type -> pfs_v2.InspectCommitRequest
+	35,  // 184: pfs_v2.API.ListCommit:input_type -> pfs_v2.ListCommitRequest
+	40,  // 185: pfs_v2.API.SubscribeCommit:input_type -> pfs_v2.SubscribeCommitRequest
+	36,  // 186: pfs_v2.API.InspectCommitSet:input_type -> pfs_v2.InspectCommitSetRequest
+	37,  // 187: pfs_v2.API.ListCommitSet:input_type -> pfs_v2.ListCommitSetRequest
+	38,  // 188: pfs_v2.API.SquashCommitSet:input_type -> pfs_v2.SquashCommitSetRequest
+	39,  // 189: pfs_v2.API.DropCommitSet:input_type -> pfs_v2.DropCommitSetRequest
+	43,  // 190: pfs_v2.API.FindCommits:input_type -> pfs_v2.FindCommitsRequest
+	42,  // 191: pfs_v2.API.CreateBranch:input_type -> pfs_v2.CreateBranchRequest
+	45,  // 192: pfs_v2.API.InspectBranch:input_type -> pfs_v2.InspectBranchRequest
+	46,  // 193: pfs_v2.API.ListBranch:input_type -> pfs_v2.ListBranchRequest
+	47,  // 194: pfs_v2.API.DeleteBranch:input_type -> pfs_v2.DeleteBranchRequest
+	55,  // 195: pfs_v2.API.ModifyFile:input_type -> pfs_v2.ModifyFileRequest
+	56,  // 196: pfs_v2.API.GetFile:input_type -> pfs_v2.GetFileRequest
+	56,  // 197: pfs_v2.API.GetFileTAR:input_type -> pfs_v2.GetFileRequest
+	57,  // 198: pfs_v2.API.InspectFile:input_type -> pfs_v2.InspectFileRequest
+	58,  // 199: pfs_v2.API.ListFile:input_type -> pfs_v2.ListFileRequest
+	59,  // 200: pfs_v2.API.WalkFile:input_type -> pfs_v2.WalkFileRequest
+	60,  // 201: pfs_v2.API.GlobFile:input_type -> pfs_v2.GlobFileRequest
+	61,  // 202: pfs_v2.API.DiffFile:input_type -> pfs_v2.DiffFileRequest
+	79,  // 203: pfs_v2.API.ActivateAuth:input_type -> pfs_v2.ActivateAuthRequest
+	138, // 204: pfs_v2.API.DeleteAll:input_type -> google.protobuf.Empty
+	63,  // 205: pfs_v2.API.Fsck:input_type -> pfs_v2.FsckRequest
+	55,  // 206: pfs_v2.API.CreateFileSet:input_type -> pfs_v2.ModifyFileRequest
+	66,  // 207: pfs_v2.API.GetFileSet:input_type -> pfs_v2.GetFileSetRequest
+	67,  // 208: pfs_v2.API.AddFileSet:input_type -> pfs_v2.AddFileSetRequest
+	68,  // 209: pfs_v2.API.RenewFileSet:input_type -> pfs_v2.RenewFileSetRequest
+	69,  // 210: pfs_v2.API.ComposeFileSet:input_type -> pfs_v2.ComposeFileSetRequest
+	70,  // 211: pfs_v2.API.ShardFileSet:input_type -> pfs_v2.ShardFileSetRequest
+	73,  // 212: pfs_v2.API.CheckStorage:input_type -> pfs_v2.CheckStorageRequest
+	75,  // 213: pfs_v2.API.PutCache:input_type -> pfs_v2.PutCacheRequest
+	76,  // 214: pfs_v2.API.GetCache:input_type -> pfs_v2.GetCacheRequest
+	78,  // 215: pfs_v2.API.ClearCache:input_type -> pfs_v2.ClearCacheRequest
+	139, // 216: pfs_v2.API.ListTask:input_type -> taskapi.ListTaskRequest
+	83,  // 217: pfs_v2.API.Egress:input_type -> pfs_v2.EgressRequest
+	48,  // 218: pfs_v2.API.CreateProject:input_type -> pfs_v2.CreateProjectRequest
+	49,  // 219: pfs_v2.API.InspectProject:input_type -> pfs_v2.InspectProjectRequest
+	50,  // 220: pfs_v2.API.ListProject:input_type -> pfs_v2.ListProjectRequest
+	51,  // 221: pfs_v2.API.DeleteProject:input_type -> pfs_v2.DeleteProjectRequest
+	89,  // 222: pfs_v2.API.CreateReplication:input_type -> pfs_v2.CreateReplicationRequest
+	90,  // 223: pfs_v2.API.InspectReplication:input_type -> pfs_v2.InspectReplicationRequest
+	91,  // 224: pfs_v2.API.ListReplication:input_type -> pfs_v2.ListReplicationRequest
+	92,  // 225: pfs_v2.API.DeleteReplication:input_type -> pfs_v2.DeleteReplicationRequest
+	93,  // 226: pfs_v2.API.MissingChunks:input_type -> pfs_v2.MissingChunksRequest
+	95,  // 227: pfs_v2.API.ReplicateFileSet:input_type -> pfs_v2.ReplicateFileSetRequest
+	98,  // 228: pfs_v2.API.SetRetentionPolicy:input_type -> pfs_v2.SetRetentionPolicyRequest
+	99,  // 229: pfs_v2.API.ListRetentionPolicy:input_type -> pfs_v2.ListRetentionPolicyRequest
+	100, // 230: pfs_v2.API.EnforceRetentionPolicy:input_type -> pfs_v2.EnforceRetentionPolicyRequest
+	107, // 231: pfs_v2.API.SetCommitValidation:input_type -> pfs_v2.SetCommitValidationRequest
+	108, // 232: pfs_v2.API.ListCommitValidation:input_type -> pfs_v2.ListCommitValidationRequest
+	111, // 233: pfs_v2.API.SetStoragePlacement:input_type -> pfs_v2.SetStoragePlacementRequest
+	112, // 234: pfs_v2.API.ListStoragePlacement:input_type -> pfs_v2.ListStoragePlacementRequest
+	115, // 235: pfs_v2.API.CreateUploadSession:input_type -> pfs_v2.CreateUploadSessionRequest
+	116, // 236: pfs_v2.API.InspectUploadSession:input_type -> pfs_v2.InspectUploadSessionRequest
+	117, // 237: pfs_v2.API.ListUploadSession:input_type -> pfs_v2.ListUploadSessionRequest
+	118, // 238: pfs_v2.API.UploadPart:input_type -> pfs_v2.UploadPartRequest
+	119, // 239: pfs_v2.API.CommitUploadSession:input_type -> pfs_v2.CommitUploadSessionRequest
+	120, // 240: pfs_v2.API.DeleteUploadSession:input_type -> pfs_v2.DeleteUploadSessionRequest
+	138, // 241: pfs_v2.API.CreateRepo:output_type -> google.protobuf.Empty
+	9,   // 242: pfs_v2.API.InspectRepo:output_type -> pfs_v2.RepoInfo
+	9,   // 243: pfs_v2.API.ListRepo:output_type -> pfs_v2.RepoInfo
+	29,  // 244: pfs_v2.API.DeleteRepo:output_type -> pfs_v2.DeleteRepoResponse
+	30,  // 245: pfs_v2.API.DeleteRepos:output_type -> pfs_v2.DeleteReposResponse
+	15,  // 246: pfs_v2.API.StartCommit:output_type -> pfs_v2.Commit
+	138, // 247: pfs_v2.API.FinishCommit:output_type -> google.protobuf.Empty
+	138, // 248: pfs_v2.API.ClearCommit:output_type -> google.protobuf.Empty
+	138, // 249: pfs_v2.API.ApproveCommit:output_type -> google.protobuf.Empty
+	16,  // 250: pfs_v2.API.InspectCommit:output_type -> pfs_v2.CommitInfo
+	16,  // 251: pfs_v2.API.ListCommit:output_type -> pfs_v2.CommitInfo
+	16,  // 252: pfs_v2.API.SubscribeCommit:output_type -> pfs_v2.CommitInfo
+	16,  // 253: pfs_v2.API.InspectCommitSet:output_type -> pfs_v2.CommitInfo
+	19,  // 254: pfs_v2.API.ListCommitSet:output_type -> pfs_v2.CommitSetInfo
+	138, // 255: pfs_v2.API.SquashCommitSet:output_type -> google.protobuf.Empty
+	138, // 256: pfs_v2.API.DropCommitSet:output_type -> google.protobuf.Empty
+	44,  // 257: pfs_v2.API.FindCommits:output_type -> pfs_v2.FindCommitsResponse
+	138, // 258: pfs_v2.API.CreateBranch:output_type -> google.protobuf.Empty
+	12,  // 259: pfs_v2.API.InspectBranch:output_type -> pfs_v2.BranchInfo
+	12,  // 260: pfs_v2.API.ListBranch:output_type -> pfs_v2.BranchInfo
+	138, // 261: pfs_v2.API.DeleteBranch:output_type -> google.protobuf.Empty
+	138, // 262: pfs_v2.API.ModifyFile:output_type -> google.protobuf.Empty
+	134, // 263: pfs_v2.API.GetFile:output_type -> google.protobuf.BytesValue
+	134, // 264: pfs_v2.API.GetFileTAR:output_type -> google.protobuf.BytesValue
+	20,  // 265: pfs_v2.API.InspectFile:output_type -> pfs_v2.FileInfo
+	20,  // 266: pfs_v2.API.ListFile:output_type -> pfs_v2.FileInfo
+	20,  // 267: pfs_v2.API.WalkFile:output_type -> pfs_v2.FileInfo
+	20,  // 268: pfs_v2.API.GlobFile:output_type -> pfs_v2.FileInfo
+	62,  // 269: pfs_v2.API.DiffFile:output_type -> pfs_v2.DiffFileResponse
+	80,  // 270: pfs_v2.API.ActivateAuth:output_type -> pfs_v2.ActivateAuthResponse
+	138, // 271: pfs_v2.API.DeleteAll:output_type -> google.protobuf.Empty
+	64,  // 272: pfs_v2.API.Fsck:output_type -> pfs_v2.FsckResponse
+	65,  // 273: pfs_v2.API.CreateFileSet:output_type -> pfs_v2.CreateFileSetResponse
+	65,  // 274: pfs_v2.API.GetFileSet:output_type -> pfs_v2.CreateFileSetResponse
+	138, // 275: pfs_v2.API.AddFileSet:output_type -> google.protobuf.Empty
+	138, // 276: pfs_v2.API.RenewFileSet:output_type -> google.protobuf.Empty
+	65,  // 277: pfs_v2.API.ComposeFileSet:output_type -> pfs_v2.CreateFileSetResponse
+	72,  // 278: pfs_v2.API.ShardFileSet:output_type -> pfs_v2.ShardFileSetResponse
+	74,  // 279: pfs_v2.API.CheckStorage:output_type -> pfs_v2.CheckStorageResponse
+	138, // 280: pfs_v2.API.PutCache:output_type -> google.protobuf.Empty
+	77,  // 281: pfs_v2.API.GetCache:output_type -> pfs_v2.GetCacheResponse
+	138, // 282: pfs_v2.API.ClearCache:output_type -> google.protobuf.Empty
+	140, // 283: pfs_v2.API.ListTask:output_type -> taskapi.TaskInfo
+	84,  // 284: pfs_v2.API.Egress:output_type -> pfs_v2.EgressResponse
+	138, // 285: pfs_v2.API.CreateProject:output_type -> google.protobuf.Empty
+	22,  // 286: pfs_v2.API.InspectProject:output_type -> pfs_v2.ProjectInfo
+	22,  // 287: pfs_v2.API.ListProject:output_type -> pfs_v2.ProjectInfo
+	138, // 288: pfs_v2.API.DeleteProject:output_type -> google.protobuf.Empty
+	138, // 289: pfs_v2.API.CreateReplication:output_type -> google.protobuf.Empty
+	88,  // 290: pfs_v2.API.InspectReplication:output_type -> pfs_v2.ReplicationInfo
+	88,  // 291: pfs_v2.API.ListReplication:output_type -> pfs_v2.ReplicationInfo
+	138, // 292: pfs_v2.API.DeleteReplication:output_type -> google.protobuf.Empty
+	94,  // 293: pfs_v2.API.MissingChunks:output_type -> pfs_v2.MissingChunksResponse
+	65,  // 294: pfs_v2.API.ReplicateFileSet:output_type -> pfs_v2.CreateFileSetResponse
+	138, // 295: pfs_v2.API.SetRetentionPolicy:output_type -> google.protobuf.Empty
+	97,  // 296: pfs_v2.API.ListRetentionPolicy:output_type -> pfs_v2.RetentionPolicyInfo
+	101, // 297: pfs_v2.API.EnforceRetentionPolicy:output_type -> pfs_v2.RetentionAction
+	138, // 298: pfs_v2.API.SetCommitValidation:output_type -> google.protobuf.Empty
+	106, // 299: pfs_v2.API.ListCommitValidation:output_type -> pfs_v2.CommitValidationInfo
+	138, // 300: pfs_v2.API.SetStoragePlacement:output_type -> google.protobuf.Empty
+	110, // 301: pfs_v2.API.ListStoragePlacement:output_type -> pfs_v2.StoragePlacementInfo
+	114, // 302: pfs_v2.API.CreateUploadSession:output_type -> pfs_v2.UploadSessionInfo
+	114, // 303: pfs_v2.API.InspectUploadSession:output_type -> pfs_v2.UploadSessionInfo
+	114, // 304: pfs_v2.API.ListUploadSession:output_type -> pfs_v2.UploadSessionInfo
+	113, // 305: pfs_v2.API.UploadPart:output_type -> pfs_v2.UploadPartInfo
+	138, // 306: pfs_v2.API.CommitUploadSession:output_type -> google.protobuf.Empty
+	138, // 307: pfs_v2.API.DeleteUploadSession:output_type -> google.protobuf.Empty
+	241, // [241:308] is the sub-list for method output_type
+	174, // [174:241] is the sub-list for method input_type
+	174, // [174:174] is the sub-list for extension type_name
+	174, // [174:174] is the sub-list for extension extendee
+	0,   // [0:174] is the sub-list for field type_name
+}
+
+func init() { file_pfs_pfs_proto_init() }
+func file_pfs_pfs_proto_init() {
+	if File_pfs_pfs_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pfs_pfs_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Repo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Branch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*File); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepoInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BranchInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Trigger); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitOrigin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Commit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitApproval); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitSetInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Project); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectQuota); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRepoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectRepoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRepoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRepoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteReposRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRepoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteReposResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartCommitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinishCommitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveCommitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectCommitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCommitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectCommitSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCommitSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SquashCommitSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DropCommitSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeCommitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearCommitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBranchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindCommitsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindCommitsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectBranchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBranchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBranchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateProjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6578,8 +10686,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Branch); i {
+		file_pfs_pfs_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectProjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6590,8 +10698,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*File); i {
+		file_pfs_pfs_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6602,8 +10710,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepoInfo); i {
+		file_pfs_pfs_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6614,8 +10722,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthInfo); i {
+		file_pfs_pfs_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6626,8 +10734,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BranchInfo); i {
+		file_pfs_pfs_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6638,8 +10746,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Trigger); i {
+		file_pfs_pfs_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CopyFile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6650,8 +10758,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitOrigin); i {
+		file_pfs_pfs_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModifyFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6662,8 +10770,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Commit); i {
+		file_pfs_pfs_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6674,8 +10782,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitInfo); i {
+		file_pfs_pfs_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6686,8 +10794,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitSet); i {
+		file_pfs_pfs_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6698,8 +10806,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitSetInfo); i {
+		file_pfs_pfs_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalkFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6710,8 +10818,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileInfo); i {
+		file_pfs_pfs_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GlobFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6722,8 +10830,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Project); i {
+		file_pfs_pfs_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6734,8 +10842,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProjectInfo); i {
+		file_pfs_pfs_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6746,8 +10854,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRepoRequest); i {
+		file_pfs_pfs_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FsckRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6758,8 +10866,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InspectRepoRequest); i {
+		file_pfs_pfs_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FsckResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6770,8 +10878,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRepoRequest); i {
+		file_pfs_pfs_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFileSetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6782,8 +10890,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRepoRequest); i {
+		file_pfs_pfs_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6794,8 +10902,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteReposRequest); i {
+		file_pfs_pfs_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFileSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6806,8 +10914,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRepoResponse); i {
+		file_pfs_pfs_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewFileSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6818,8 +10926,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteReposResponse); i {
+		file_pfs_pfs_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComposeFileSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6830,8 +10938,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartCommitRequest); i {
+		file_pfs_pfs_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShardFileSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6842,8 +10950,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FinishCommitRequest); i {
+		file_pfs_pfs_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PathRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6854,8 +10962,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InspectCommitRequest); i {
+		file_pfs_pfs_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShardFileSetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6866,8 +10974,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCommitRequest); i {
+		file_pfs_pfs_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckStorageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6878,8 +10986,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InspectCommitSetRequest); i {
+		file_pfs_pfs_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckStorageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6890,8 +10998,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCommitSetRequest); i {
+		file_pfs_pfs_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutCacheRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6902,8 +11010,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SquashCommitSetRequest); i {
+		file_pfs_pfs_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCacheRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6914,8 +11022,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DropCommitSetRequest); i {
+		file_pfs_pfs_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCacheResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6926,8 +11034,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeCommitRequest); i {
+		file_pfs_pfs_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearCacheRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6938,8 +11046,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClearCommitRequest); i {
+		file_pfs_pfs_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivateAuthRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6950,8 +11058,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBranchRequest); i {
+		file_pfs_pfs_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivateAuthResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6962,8 +11070,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindCommitsRequest); i {
+		file_pfs_pfs_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObjectStorageEgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6974,8 +11082,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindCommitsResponse); i {
+		file_pfs_pfs_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SQLDatabaseEgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6986,8 +11094,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InspectBranchRequest); i {
+		file_pfs_pfs_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EgressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6998,8 +11106,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBranchRequest); i {
+		file_pfs_pfs_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EgressResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7010,8 +11118,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBranchRequest); i {
+		file_pfs_pfs_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Replication); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7022,8 +11130,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateProjectRequest); i {
+		file_pfs_pfs_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicationTarget); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7034,8 +11142,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InspectProjectRequest); i {
+		file_pfs_pfs_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BranchReplicationStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7046,8 +11154,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListProjectRequest); i {
+		file_pfs_pfs_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicationInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7058,8 +11166,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteProjectRequest); i {
+		file_pfs_pfs_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateReplicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7070,8 +11178,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddFile); i {
+		file_pfs_pfs_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectReplicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7082,8 +11190,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteFile); i {
+		file_pfs_pfs_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReplicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7094,8 +11202,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CopyFile); i {
+		file_pfs_pfs_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteReplicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7106,8 +11214,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModifyFileRequest); i {
+		file_pfs_pfs_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MissingChunksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7118,8 +11226,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFileRequest); i {
+		file_pfs_pfs_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MissingChunksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7130,8 +11238,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InspectFileRequest); i {
+		file_pfs_pfs_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicateFileSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7142,8 +11250,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListFileRequest); i {
+		file_pfs_pfs_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetentionPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7154,8 +11262,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WalkFileRequest); i {
+		file_pfs_pfs_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetentionPolicyInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7166,8 +11274,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GlobFileRequest); i {
+		file_pfs_pfs_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRetentionPolicyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7178,8 +11286,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiffFileRequest); i {
+		file_pfs_pfs_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRetentionPolicyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7190,8 +11298,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiffFileResponse); i {
+		file_pfs_pfs_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnforceRetentionPolicyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7202,8 +11310,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FsckRequest); i {
+		file_pfs_pfs_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetentionAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7214,8 +11322,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FsckResponse); i {
+		file_pfs_pfs_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContentContract); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7226,8 +11334,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateFileSetResponse); i {
+		file_pfs_pfs_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PathContract); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7238,8 +11346,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFileSetRequest); i {
+		file_pfs_pfs_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TableSchema); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7250,8 +11358,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddFileSetRequest); i {
+		file_pfs_pfs_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TableColumn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7262,8 +11370,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenewFileSetRequest); i {
+		file_pfs_pfs_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitValidationInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7274,8 +11382,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ComposeFileSetRequest); i {
+		file_pfs_pfs_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCommitValidationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7286,8 +11394,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShardFileSetRequest); i {
+		file_pfs_pfs_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCommitValidationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7298,8 +11406,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PathRange); i {
+		file_pfs_pfs_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitValidationResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7310,8 +11418,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShardFileSetResponse); i {
+		file_pfs_pfs_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoragePlacementInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7322,8 +11430,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckStorageRequest); i {
+		file_pfs_pfs_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetStoragePlacementRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7334,8 +11442,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckStorageResponse); i {
+		file_pfs_pfs_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStoragePlacementRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7346,8 +11454,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutCacheRequest); i {
+		file_pfs_pfs_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadPartInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7358,8 +11466,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCacheRequest); i {
+		file_pfs_pfs_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadSessionInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7370,8 +11478,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCacheResponse); i {
+		file_pfs_pfs_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUploadSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7382,8 +11490,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClearCacheRequest); i {
+		file_pfs_pfs_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectUploadSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7394,8 +11502,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActivateAuthRequest); i {
+		file_pfs_pfs_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUploadSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7406,8 +11514,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActivateAuthResponse); i {
+		file_pfs_pfs_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadPartRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7418,8 +11526,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ObjectStorageEgress); i {
+		file_pfs_pfs_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitUploadSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7430,8 +11538,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SQLDatabaseEgress); i {
+		file_pfs_pfs_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteUploadSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7442,8 +11550,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EgressRequest); i {
+		file_pfs_pfs_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepoInfo_Details); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7454,8 +11562,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EgressResponse); i {
+		file_pfs_pfs_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitInfo_Details); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7466,8 +11574,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepoInfo_Details); i {
+		file_pfs_pfs_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectInfo_Details); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7478,8 +11586,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitInfo_Details); i {
+		file_pfs_pfs_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFile_URLSource); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7490,8 +11598,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddFile_URLSource); i {
+		file_pfs_pfs_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SQLDatabaseEgress_FileFormat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7502,8 +11610,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SQLDatabaseEgress_FileFormat); i {
+		file_pfs_pfs_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SQLDatabaseEgress_Secret); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7514,8 +11622,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SQLDatabaseEgress_Secret); i {
+		file_pfs_pfs_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EgressResponse_ObjectStorageResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7526,8 +11634,8 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EgressResponse_ObjectStorageResult); i {
+		file_pfs_pfs_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EgressResponse_SQLDatabaseResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7538,8 +11646,20 @@ func file_pfs_pfs_proto_init() {
 				return nil
 			}
 		}
-		file_pfs_pfs_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EgressResponse_SQLDatabaseResult); i {
+		file_pfs_pfs_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicationTarget_Secret); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfs_pfs_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicateFileSetRequest_Chunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7551,39 +11671,43 @@ func file_pfs_pfs_proto_init() {
 			}
 		}
 	}
-	file_pfs_pfs_proto_msgTypes[34].OneofWrappers = []interface{}{
+	file_pfs_pfs_proto_msgTypes[38].OneofWrappers = []interface{}{
 		(*FindCommitsResponse_FoundCommit)(nil),
 		(*FindCommitsResponse_LastSearchedCommit)(nil),
 	}
-	file_pfs_pfs_proto_msgTypes[42].OneofWrappers = []interface{}{
+	file_pfs_pfs_proto_msgTypes[46].OneofWrappers = []interface{}{
 		(*AddFile_Raw)(nil),
 		(*AddFile_Url)(nil),
 	}
-	file_pfs_pfs_proto_msgTypes[45].OneofWrappers = []interface{}{
+	file_pfs_pfs_proto_msgTypes[49].OneofWrappers = []interface{}{
 		(*ModifyFileRequest_SetCommit)(nil),
 		(*ModifyFileRequest_AddFile)(nil),
 		(*ModifyFileRequest_DeleteFile)(nil),
 		(*ModifyFileRequest_CopyFile)(nil),
 	}
-	file_pfs_pfs_proto_msgTypes[53].OneofWrappers = []interface{}{
+	file_pfs_pfs_proto_msgTypes[57].OneofWrappers = []interface{}{
 		(*FsckRequest_ZombieTarget)(nil),
 		(*FsckRequest_ZombieAll)(nil),
 	}
-	file_pfs_pfs_proto_msgTypes[73].OneofWrappers = []interface{}{
+	file_pfs_pfs_proto_msgTypes[77].OneofWrappers = []interface{}{
 		(*EgressRequest_ObjectStorage)(nil),
 		(*EgressRequest_SqlDatabase)(nil),
 	}
-	file_pfs_pfs_proto_msgTypes[74].OneofWrappers = []interface{}{
+	file_pfs_pfs_proto_msgTypes[78].OneofWrappers = []interface{}{
 		(*EgressResponse_ObjectStorage)(nil),
 		(*EgressResponse_SqlDatabase)(nil),
 	}
+	file_pfs_pfs_proto_msgTypes[89].OneofWrappers = []interface{}{
+		(*ReplicateFileSetRequest_Chunk_)(nil),
+		(*ReplicateFileSetRequest_Primitive)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pfs_pfs_proto_rawDesc,
-			NumEnums:      5,
-			NumMessages:   83,
+			NumEnums:      6,
+			NumMessages:   126,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -683,6 +683,138 @@ var _ interface {
 	ErrorName() string
 } = RepoInfoValidationError{}
 
+// Validate checks the field values on StorageUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StorageUsage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageUsage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StorageUsageMultiError, or
+// nil if none found.
+func (m *StorageUsage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageUsage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ExclusiveBytes
+
+	// no validation rules for SharedBytes
+
+	if all {
+		switch v := interface{}(m.GetComputedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageUsageValidationError{
+					field:  "ComputedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageUsageValidationError{
+					field:  "ComputedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetComputedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageUsageValidationError{
+				field:  "ComputedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StorageUsageMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageUsageMultiError is an error wrapping multiple validation errors
+// returned by StorageUsage.ValidateAll() if the designated constraints aren't met.
+type StorageUsageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageUsageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageUsageMultiError) AllErrors() []error { return m }
+
+// StorageUsageValidationError is the validation error returned by
+// StorageUsage.Validate if the designated constraints aren't met.
+type StorageUsageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageUsageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageUsageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageUsageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageUsageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageUsageValidationError) ErrorName() string { return "StorageUsageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StorageUsageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageUsage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageUsageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageUsageValidationError{}
+
 // Validate checks the field values on AuthInfo with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -1103,6 +1235,8 @@ func (m *Trigger) validate(all bool) error {
 
 	// no validation rules for CronSpec
 
+	// no validation rules for RequireApproval
+
 	if len(errors) > 0 {
 		return TriggerMultiError(errors)
 	}
@@ -1709,6 +1843,37 @@ func (m *CommitInfo) validate(all bool) error {
 
 	// no validation rules for SizeBytesUpperBound
 
+	if all {
+		switch v := interface{}(m.GetApproval()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommitInfoValidationError{
+					field:  "Approval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommitInfoValidationError{
+					field:  "Approval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApproval()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommitInfoValidationError{
+				field:  "Approval",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Checkpoint
+
 	if all {
 		switch v := interface{}(m.GetDetails()).(type) {
 		case interface{ ValidateAll() error }:
@@ -1815,6 +1980,139 @@ var _ interface {
 	ErrorName() string
 } = CommitInfoValidationError{}
 
+// Validate checks the field values on CommitApproval with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CommitApproval) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommitApproval with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CommitApprovalMultiError,
+// or nil if none found.
+func (m *CommitApproval) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommitApproval) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Principal
+
+	// no validation rules for Comment
+
+	if all {
+		switch v := interface{}(m.GetApproved()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommitApprovalValidationError{
+					field:  "Approved",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommitApprovalValidationError{
+					field:  "Approved",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApproved()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommitApprovalValidationError{
+				field:  "Approved",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CommitApprovalMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommitApprovalMultiError is an error wrapping multiple validation errors
+// returned by CommitApproval.ValidateAll() if the designated constraints
+// aren't met.
+type CommitApprovalMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommitApprovalMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommitApprovalMultiError) AllErrors() []error { return m }
+
+// CommitApprovalValidationError is the validation error returned by
+// CommitApproval.Validate if the designated constraints aren't met.
+type CommitApprovalValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommitApprovalValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommitApprovalValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommitApprovalValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommitApprovalValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommitApprovalValidationError) ErrorName() string { return "CommitApprovalValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CommitApprovalValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommitApproval.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommitApprovalValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommitApprovalValidationError{}
+
 // Validate checks the field values on CommitSet with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -2453,14 +2751,72 @@ func (m *ProjectInfo) validate(all bool) error {
 		}
 	}
 
-	if len(errors) > 0 {
-		return ProjectInfoMultiError(errors)
+	if all {
+		switch v := interface{}(m.GetDetails()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProjectInfoValidationError{
+					field:  "Details",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProjectInfoValidationError{
+					field:  "Details",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDetails()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProjectInfoValidationError{
+				field:  "Details",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	return nil
-}
-
-// ProjectInfoMultiError is an error wrapping multiple validation errors
+	if all {
+		switch v := interface{}(m.GetQuota()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProjectInfoValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProjectInfoValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProjectInfoValidationError{
+				field:  "Quota",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ProjectInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProjectInfoMultiError is an error wrapping multiple validation errors
 // returned by ProjectInfo.ValidateAll() if the designated constraints aren't met.
 type ProjectInfoMultiError []error
 
@@ -2530,6 +2886,135 @@ var _ interface {
 	ErrorName() string
 } = ProjectInfoValidationError{}
 
+// Validate checks the field values on ProjectQuota with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ProjectQuota) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProjectQuota with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProjectQuotaMultiError, or
+// nil if none found.
+func (m *ProjectQuota) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProjectQuota) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMaxBytes() < 0 {
+		err := ProjectQuotaValidationError{
+			field:  "MaxBytes",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Enforcement
+
+	if m.GetMaxWorkers() < 0 {
+		err := ProjectQuotaValidationError{
+			field:  "MaxWorkers",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for MaxCpu
+
+	// no validation rules for MaxMemory
+
+	// no validation rules for PriorityClassName
+
+	if len(errors) > 0 {
+		return ProjectQuotaMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProjectQuotaMultiError is an error wrapping multiple validation errors
+// returned by ProjectQuota.ValidateAll() if the designated constraints aren't met.
+type ProjectQuotaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProjectQuotaMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProjectQuotaMultiError) AllErrors() []error { return m }
+
+// ProjectQuotaValidationError is the validation error returned by
+// ProjectQuota.Validate if the designated constraints aren't met.
+type ProjectQuotaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProjectQuotaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProjectQuotaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProjectQuotaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProjectQuotaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProjectQuotaValidationError) ErrorName() string { return "ProjectQuotaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProjectQuotaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProjectQuota.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProjectQuotaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProjectQuotaValidationError{}
+
 // Validate checks the field values on CreateRepoRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -3664,6 +4149,8 @@ func (m *FinishCommitRequest) validate(all bool) error {
 
 	// no validation rules for Force
 
+	// no validation rules for Checkpoint
+
 	if len(errors) > 0 {
 		return FinishCommitRequestMultiError(errors)
 	}
@@ -3744,6 +4231,141 @@ var _ interface {
 	ErrorName() string
 } = FinishCommitRequestValidationError{}
 
+// Validate checks the field values on ApproveCommitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveCommitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveCommitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveCommitRequestMultiError, or nil if none found.
+func (m *ApproveCommitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveCommitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCommit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApproveCommitRequestValidationError{
+					field:  "Commit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApproveCommitRequestValidationError{
+					field:  "Commit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApproveCommitRequestValidationError{
+				field:  "Commit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Comment
+
+	// no validation rules for Principal
+
+	if len(errors) > 0 {
+		return ApproveCommitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveCommitRequestMultiError is an error wrapping multiple validation
+// errors returned by ApproveCommitRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ApproveCommitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveCommitRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveCommitRequestMultiError) AllErrors() []error { return m }
+
+// ApproveCommitRequestValidationError is the validation error returned by
+// ApproveCommitRequest.Validate if the designated constraints aren't met.
+type ApproveCommitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveCommitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveCommitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveCommitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveCommitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveCommitRequestValidationError) ErrorName() string {
+	return "ApproveCommitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveCommitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveCommitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveCommitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveCommitRequestValidationError{}
+
 // Validate checks the field values on InspectCommitRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -5953,6 +6575,35 @@ func (m *CreateProjectRequest) validate(all bool) error {
 
 	// no validation rules for Update
 
+	if all {
+		switch v := interface{}(m.GetQuota()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateProjectRequestValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateProjectRequestValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateProjectRequestValidationError{
+				field:  "Quota",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return CreateProjectRequestMultiError(errors)
 	}
@@ -6095,6 +6746,8 @@ func (m *InspectProjectRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Details
+
 	if len(errors) > 0 {
 		return InspectProjectRequestMultiError(errors)
 	}
@@ -10995,6 +11648,5330 @@ var _ interface {
 	ErrorName() string
 } = EgressResponseValidationError{}
 
+// Validate checks the field values on Replication with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Replication) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Replication with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReplicationMultiError, or
+// nil if none found.
+func (m *Replication) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Replication) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return ReplicationMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplicationMultiError is an error wrapping multiple validation errors
+// returned by Replication.ValidateAll() if the designated constraints aren't met.
+type ReplicationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplicationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplicationMultiError) AllErrors() []error { return m }
+
+// ReplicationValidationError is the validation error returned by
+// Replication.Validate if the designated constraints aren't met.
+type ReplicationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplicationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplicationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplicationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplicationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplicationValidationError) ErrorName() string { return "ReplicationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReplicationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplication.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplicationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplicationValidationError{}
+
+// Validate checks the field values on ReplicationTarget with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReplicationTarget) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplicationTarget with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplicationTargetMultiError, or nil if none found.
+func (m *ReplicationTarget) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplicationTarget) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PachdAddress
+
+	if all {
+		switch v := interface{}(m.GetAuthTokenSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReplicationTargetValidationError{
+					field:  "AuthTokenSecret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReplicationTargetValidationError{
+					field:  "AuthTokenSecret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuthTokenSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReplicationTargetValidationError{
+				field:  "AuthTokenSecret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReplicationTargetMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplicationTargetMultiError is an error wrapping multiple validation errors
+// returned by ReplicationTarget.ValidateAll() if the designated constraints
+// aren't met.
+type ReplicationTargetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplicationTargetMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplicationTargetMultiError) AllErrors() []error { return m }
+
+// ReplicationTargetValidationError is the validation error returned by
+// ReplicationTarget.Validate if the designated constraints aren't met.
+type ReplicationTargetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplicationTargetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplicationTargetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplicationTargetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplicationTargetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplicationTargetValidationError) ErrorName() string {
+	return "ReplicationTargetValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplicationTargetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplicationTarget.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplicationTargetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplicationTargetValidationError{}
+
+// Validate checks the field values on BranchReplicationStatus with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BranchReplicationStatus) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BranchReplicationStatus with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BranchReplicationStatusMultiError, or nil if none found.
+func (m *BranchReplicationStatus) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BranchReplicationStatus) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBranch()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BranchReplicationStatusValidationError{
+					field:  "Branch",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BranchReplicationStatusValidationError{
+					field:  "Branch",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBranch()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BranchReplicationStatusValidationError{
+				field:  "Branch",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastReplicatedCommit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BranchReplicationStatusValidationError{
+					field:  "LastReplicatedCommit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BranchReplicationStatusValidationError{
+					field:  "LastReplicatedCommit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastReplicatedCommit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BranchReplicationStatusValidationError{
+				field:  "LastReplicatedCommit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTargetCommit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BranchReplicationStatusValidationError{
+					field:  "TargetCommit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BranchReplicationStatusValidationError{
+					field:  "TargetCommit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTargetCommit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BranchReplicationStatusValidationError{
+				field:  "TargetCommit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastReplicatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BranchReplicationStatusValidationError{
+					field:  "LastReplicatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BranchReplicationStatusValidationError{
+					field:  "LastReplicatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastReplicatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BranchReplicationStatusValidationError{
+				field:  "LastReplicatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLag()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BranchReplicationStatusValidationError{
+					field:  "Lag",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BranchReplicationStatusValidationError{
+					field:  "Lag",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLag()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BranchReplicationStatusValidationError{
+				field:  "Lag",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for BytesTransferred
+
+	// no validation rules for Error
+
+	if all {
+		switch v := interface{}(m.GetPendingCommit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BranchReplicationStatusValidationError{
+					field:  "PendingCommit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BranchReplicationStatusValidationError{
+					field:  "PendingCommit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPendingCommit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BranchReplicationStatusValidationError{
+				field:  "PendingCommit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPendingTargetCommit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BranchReplicationStatusValidationError{
+					field:  "PendingTargetCommit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BranchReplicationStatusValidationError{
+					field:  "PendingTargetCommit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPendingTargetCommit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BranchReplicationStatusValidationError{
+				field:  "PendingTargetCommit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BranchReplicationStatusMultiError(errors)
+	}
+
+	return nil
+}
+
+// BranchReplicationStatusMultiError is an error wrapping multiple validation
+// errors returned by BranchReplicationStatus.ValidateAll() if the designated
+// constraints aren't met.
+type BranchReplicationStatusMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BranchReplicationStatusMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BranchReplicationStatusMultiError) AllErrors() []error { return m }
+
+// BranchReplicationStatusValidationError is the validation error returned by
+// BranchReplicationStatus.Validate if the designated constraints aren't met.
+type BranchReplicationStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BranchReplicationStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BranchReplicationStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BranchReplicationStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BranchReplicationStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BranchReplicationStatusValidationError) ErrorName() string {
+	return "BranchReplicationStatusValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BranchReplicationStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBranchReplicationStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BranchReplicationStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BranchReplicationStatusValidationError{}
+
+// Validate checks the field values on ReplicationInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReplicationInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplicationInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplicationInfoMultiError, or nil if none found.
+func (m *ReplicationInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplicationInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReplication()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReplicationInfoValidationError{
+					field:  "Replication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReplicationInfoValidationError{
+					field:  "Replication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReplication()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReplicationInfoValidationError{
+				field:  "Replication",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTarget()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReplicationInfoValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReplicationInfoValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTarget()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReplicationInfoValidationError{
+				field:  "Target",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetBranches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReplicationInfoValidationError{
+						field:  fmt.Sprintf("Branches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReplicationInfoValidationError{
+						field:  fmt.Sprintf("Branches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReplicationInfoValidationError{
+					field:  fmt.Sprintf("Branches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReplicationInfoValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReplicationInfoValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReplicationInfoValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetStatus() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReplicationInfoValidationError{
+						field:  fmt.Sprintf("Status[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReplicationInfoValidationError{
+						field:  fmt.Sprintf("Status[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReplicationInfoValidationError{
+					field:  fmt.Sprintf("Status[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReplicationInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplicationInfoMultiError is an error wrapping multiple validation errors
+// returned by ReplicationInfo.ValidateAll() if the designated constraints
+// aren't met.
+type ReplicationInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplicationInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplicationInfoMultiError) AllErrors() []error { return m }
+
+// ReplicationInfoValidationError is the validation error returned by
+// ReplicationInfo.Validate if the designated constraints aren't met.
+type ReplicationInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplicationInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplicationInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplicationInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplicationInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplicationInfoValidationError) ErrorName() string { return "ReplicationInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReplicationInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplicationInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplicationInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplicationInfoValidationError{}
+
+// Validate checks the field values on CreateReplicationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReplicationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReplicationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReplicationRequestMultiError, or nil if none found.
+func (m *CreateReplicationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReplicationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReplication()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateReplicationRequestValidationError{
+					field:  "Replication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateReplicationRequestValidationError{
+					field:  "Replication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReplication()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateReplicationRequestValidationError{
+				field:  "Replication",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTarget()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateReplicationRequestValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateReplicationRequestValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTarget()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateReplicationRequestValidationError{
+				field:  "Target",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetBranches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateReplicationRequestValidationError{
+						field:  fmt.Sprintf("Branches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateReplicationRequestValidationError{
+						field:  fmt.Sprintf("Branches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateReplicationRequestValidationError{
+					field:  fmt.Sprintf("Branches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Update
+
+	if len(errors) > 0 {
+		return CreateReplicationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReplicationRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateReplicationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateReplicationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReplicationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReplicationRequestMultiError) AllErrors() []error { return m }
+
+// CreateReplicationRequestValidationError is the validation error returned by
+// CreateReplicationRequest.Validate if the designated constraints aren't met.
+type CreateReplicationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReplicationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReplicationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReplicationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReplicationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReplicationRequestValidationError) ErrorName() string {
+	return "CreateReplicationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReplicationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReplicationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReplicationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReplicationRequestValidationError{}
+
+// Validate checks the field values on InspectReplicationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InspectReplicationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InspectReplicationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InspectReplicationRequestMultiError, or nil if none found.
+func (m *InspectReplicationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InspectReplicationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReplication()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InspectReplicationRequestValidationError{
+					field:  "Replication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InspectReplicationRequestValidationError{
+					field:  "Replication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReplication()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InspectReplicationRequestValidationError{
+				field:  "Replication",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InspectReplicationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InspectReplicationRequestMultiError is an error wrapping multiple validation
+// errors returned by InspectReplicationRequest.ValidateAll() if the
+// designated constraints aren't met.
+type InspectReplicationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InspectReplicationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InspectReplicationRequestMultiError) AllErrors() []error { return m }
+
+// InspectReplicationRequestValidationError is the validation error returned by
+// InspectReplicationRequest.Validate if the designated constraints aren't met.
+type InspectReplicationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InspectReplicationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InspectReplicationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InspectReplicationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InspectReplicationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InspectReplicationRequestValidationError) ErrorName() string {
+	return "InspectReplicationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InspectReplicationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInspectReplicationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InspectReplicationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InspectReplicationRequestValidationError{}
+
+// Validate checks the field values on ListReplicationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReplicationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReplicationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReplicationRequestMultiError, or nil if none found.
+func (m *ListReplicationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReplicationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListReplicationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReplicationRequestMultiError is an error wrapping multiple validation
+// errors returned by ListReplicationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListReplicationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReplicationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReplicationRequestMultiError) AllErrors() []error { return m }
+
+// ListReplicationRequestValidationError is the validation error returned by
+// ListReplicationRequest.Validate if the designated constraints aren't met.
+type ListReplicationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReplicationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReplicationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReplicationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReplicationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReplicationRequestValidationError) ErrorName() string {
+	return "ListReplicationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReplicationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReplicationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReplicationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReplicationRequestValidationError{}
+
+// Validate checks the field values on DeleteReplicationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteReplicationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteReplicationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteReplicationRequestMultiError, or nil if none found.
+func (m *DeleteReplicationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteReplicationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReplication()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteReplicationRequestValidationError{
+					field:  "Replication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteReplicationRequestValidationError{
+					field:  "Replication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReplication()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteReplicationRequestValidationError{
+				field:  "Replication",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteReplicationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteReplicationRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteReplicationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteReplicationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteReplicationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteReplicationRequestMultiError) AllErrors() []error { return m }
+
+// DeleteReplicationRequestValidationError is the validation error returned by
+// DeleteReplicationRequest.Validate if the designated constraints aren't met.
+type DeleteReplicationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteReplicationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteReplicationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteReplicationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteReplicationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteReplicationRequestValidationError) ErrorName() string {
+	return "DeleteReplicationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteReplicationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteReplicationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteReplicationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteReplicationRequestValidationError{}
+
+// Validate checks the field values on MissingChunksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MissingChunksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MissingChunksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MissingChunksRequestMultiError, or nil if none found.
+func (m *MissingChunksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MissingChunksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return MissingChunksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MissingChunksRequestMultiError is an error wrapping multiple validation
+// errors returned by MissingChunksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MissingChunksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MissingChunksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MissingChunksRequestMultiError) AllErrors() []error { return m }
+
+// MissingChunksRequestValidationError is the validation error returned by
+// MissingChunksRequest.Validate if the designated constraints aren't met.
+type MissingChunksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MissingChunksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MissingChunksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MissingChunksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MissingChunksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MissingChunksRequestValidationError) ErrorName() string {
+	return "MissingChunksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MissingChunksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMissingChunksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MissingChunksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MissingChunksRequestValidationError{}
+
+// Validate checks the field values on MissingChunksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MissingChunksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MissingChunksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MissingChunksResponseMultiError, or nil if none found.
+func (m *MissingChunksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MissingChunksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return MissingChunksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MissingChunksResponseMultiError is an error wrapping multiple validation
+// errors returned by MissingChunksResponse.ValidateAll() if the designated
+// constraints aren't met.
+type MissingChunksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MissingChunksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MissingChunksResponseMultiError) AllErrors() []error { return m }
+
+// MissingChunksResponseValidationError is the validation error returned by
+// MissingChunksResponse.Validate if the designated constraints aren't met.
+type MissingChunksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MissingChunksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MissingChunksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MissingChunksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MissingChunksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MissingChunksResponseValidationError) ErrorName() string {
+	return "MissingChunksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MissingChunksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMissingChunksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MissingChunksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MissingChunksResponseValidationError{}
+
+// Validate checks the field values on ReplicateFileSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplicateFileSetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplicateFileSetRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplicateFileSetRequestMultiError, or nil if none found.
+func (m *ReplicateFileSetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplicateFileSetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Value.(type) {
+	case *ReplicateFileSetRequest_Chunk_:
+		if v == nil {
+			err := ReplicateFileSetRequestValidationError{
+				field:  "Value",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetChunk()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReplicateFileSetRequestValidationError{
+						field:  "Chunk",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReplicateFileSetRequestValidationError{
+						field:  "Chunk",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetChunk()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReplicateFileSetRequestValidationError{
+					field:  "Chunk",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ReplicateFileSetRequest_Primitive:
+		if v == nil {
+			err := ReplicateFileSetRequestValidationError{
+				field:  "Value",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Primitive
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ReplicateFileSetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplicateFileSetRequestMultiError is an error wrapping multiple validation
+// errors returned by ReplicateFileSetRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReplicateFileSetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplicateFileSetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplicateFileSetRequestMultiError) AllErrors() []error { return m }
+
+// ReplicateFileSetRequestValidationError is the validation error returned by
+// ReplicateFileSetRequest.Validate if the designated constraints aren't met.
+type ReplicateFileSetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplicateFileSetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplicateFileSetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplicateFileSetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplicateFileSetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplicateFileSetRequestValidationError) ErrorName() string {
+	return "ReplicateFileSetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplicateFileSetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplicateFileSetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplicateFileSetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplicateFileSetRequestValidationError{}
+
+// Validate checks the field values on RetentionPolicy with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RetentionPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetentionPolicy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RetentionPolicyMultiError, or nil if none found.
+func (m *RetentionPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetentionPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for KeepLast
+
+	if all {
+		switch v := interface{}(m.GetKeepFor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RetentionPolicyValidationError{
+					field:  "KeepFor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RetentionPolicyValidationError{
+					field:  "KeepFor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKeepFor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RetentionPolicyValidationError{
+				field:  "KeepFor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetKeepDailyAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RetentionPolicyValidationError{
+					field:  "KeepDailyAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RetentionPolicyValidationError{
+					field:  "KeepDailyAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKeepDailyAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RetentionPolicyValidationError{
+				field:  "KeepDailyAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RetentionPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RetentionPolicyMultiError is an error wrapping multiple validation errors
+// returned by RetentionPolicy.ValidateAll() if the designated constraints
+// aren't met.
+type RetentionPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetentionPolicyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetentionPolicyMultiError) AllErrors() []error { return m }
+
+// RetentionPolicyValidationError is the validation error returned by
+// RetentionPolicy.Validate if the designated constraints aren't met.
+type RetentionPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetentionPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetentionPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetentionPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetentionPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetentionPolicyValidationError) ErrorName() string { return "RetentionPolicyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RetentionPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetentionPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetentionPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetentionPolicyValidationError{}
+
+// Validate checks the field values on RetentionPolicyInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RetentionPolicyInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetentionPolicyInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RetentionPolicyInfoMultiError, or nil if none found.
+func (m *RetentionPolicyInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetentionPolicyInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRepo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RetentionPolicyInfoValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RetentionPolicyInfoValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRepo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RetentionPolicyInfoValidationError{
+				field:  "Repo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Branch
+
+	if all {
+		switch v := interface{}(m.GetPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RetentionPolicyInfoValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RetentionPolicyInfoValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RetentionPolicyInfoValidationError{
+				field:  "Policy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RetentionPolicyInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// RetentionPolicyInfoMultiError is an error wrapping multiple validation
+// errors returned by RetentionPolicyInfo.ValidateAll() if the designated
+// constraints aren't met.
+type RetentionPolicyInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetentionPolicyInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetentionPolicyInfoMultiError) AllErrors() []error { return m }
+
+// RetentionPolicyInfoValidationError is the validation error returned by
+// RetentionPolicyInfo.Validate if the designated constraints aren't met.
+type RetentionPolicyInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetentionPolicyInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetentionPolicyInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetentionPolicyInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetentionPolicyInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetentionPolicyInfoValidationError) ErrorName() string {
+	return "RetentionPolicyInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RetentionPolicyInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetentionPolicyInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetentionPolicyInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetentionPolicyInfoValidationError{}
+
+// Validate checks the field values on SetRetentionPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetRetentionPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetRetentionPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetRetentionPolicyRequestMultiError, or nil if none found.
+func (m *SetRetentionPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetRetentionPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRepo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetRetentionPolicyRequestValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetRetentionPolicyRequestValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRepo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetRetentionPolicyRequestValidationError{
+				field:  "Repo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Branch
+
+	if all {
+		switch v := interface{}(m.GetPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetRetentionPolicyRequestValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetRetentionPolicyRequestValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetRetentionPolicyRequestValidationError{
+				field:  "Policy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetRetentionPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetRetentionPolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by SetRetentionPolicyRequest.ValidateAll() if the
+// designated constraints aren't met.
+type SetRetentionPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetRetentionPolicyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetRetentionPolicyRequestMultiError) AllErrors() []error { return m }
+
+// SetRetentionPolicyRequestValidationError is the validation error returned by
+// SetRetentionPolicyRequest.Validate if the designated constraints aren't met.
+type SetRetentionPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetRetentionPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetRetentionPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetRetentionPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetRetentionPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetRetentionPolicyRequestValidationError) ErrorName() string {
+	return "SetRetentionPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetRetentionPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetRetentionPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetRetentionPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetRetentionPolicyRequestValidationError{}
+
+// Validate checks the field values on ListRetentionPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRetentionPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRetentionPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRetentionPolicyRequestMultiError, or nil if none found.
+func (m *ListRetentionPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRetentionPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRepo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListRetentionPolicyRequestValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListRetentionPolicyRequestValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRepo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListRetentionPolicyRequestValidationError{
+				field:  "Repo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListRetentionPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRetentionPolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by ListRetentionPolicyRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListRetentionPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRetentionPolicyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRetentionPolicyRequestMultiError) AllErrors() []error { return m }
+
+// ListRetentionPolicyRequestValidationError is the validation error returned
+// by ListRetentionPolicyRequest.Validate if the designated constraints aren't met.
+type ListRetentionPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRetentionPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRetentionPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRetentionPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRetentionPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRetentionPolicyRequestValidationError) ErrorName() string {
+	return "ListRetentionPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRetentionPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRetentionPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRetentionPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRetentionPolicyRequestValidationError{}
+
+// Validate checks the field values on EnforceRetentionPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnforceRetentionPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnforceRetentionPolicyRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// EnforceRetentionPolicyRequestMultiError, or nil if none found.
+func (m *EnforceRetentionPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnforceRetentionPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRepo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EnforceRetentionPolicyRequestValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EnforceRetentionPolicyRequestValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRepo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EnforceRetentionPolicyRequestValidationError{
+				field:  "Repo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return EnforceRetentionPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnforceRetentionPolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by EnforceRetentionPolicyRequest.ValidateAll()
+// if the designated constraints aren't met.
+type EnforceRetentionPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnforceRetentionPolicyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnforceRetentionPolicyRequestMultiError) AllErrors() []error { return m }
+
+// EnforceRetentionPolicyRequestValidationError is the validation error
+// returned by EnforceRetentionPolicyRequest.Validate if the designated
+// constraints aren't met.
+type EnforceRetentionPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnforceRetentionPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnforceRetentionPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnforceRetentionPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnforceRetentionPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnforceRetentionPolicyRequestValidationError) ErrorName() string {
+	return "EnforceRetentionPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnforceRetentionPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnforceRetentionPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnforceRetentionPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnforceRetentionPolicyRequestValidationError{}
+
+// Validate checks the field values on RetentionAction with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RetentionAction) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetentionAction with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RetentionActionMultiError, or nil if none found.
+func (m *RetentionAction) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetentionAction) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCommit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RetentionActionValidationError{
+					field:  "Commit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RetentionActionValidationError{
+					field:  "Commit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RetentionActionValidationError{
+				field:  "Commit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Squashed
+
+	// no validation rules for SkippedReason
+
+	if len(errors) > 0 {
+		return RetentionActionMultiError(errors)
+	}
+
+	return nil
+}
+
+// RetentionActionMultiError is an error wrapping multiple validation errors
+// returned by RetentionAction.ValidateAll() if the designated constraints
+// aren't met.
+type RetentionActionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetentionActionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetentionActionMultiError) AllErrors() []error { return m }
+
+// RetentionActionValidationError is the validation error returned by
+// RetentionAction.Validate if the designated constraints aren't met.
+type RetentionActionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetentionActionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetentionActionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetentionActionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetentionActionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetentionActionValidationError) ErrorName() string { return "RetentionActionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RetentionActionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetentionAction.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetentionActionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetentionActionValidationError{}
+
+// Validate checks the field values on ContentContract with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ContentContract) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ContentContract with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ContentContractMultiError, or nil if none found.
+func (m *ContentContract) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ContentContract) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPaths() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ContentContractValidationError{
+						field:  fmt.Sprintf("Paths[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ContentContractValidationError{
+						field:  fmt.Sprintf("Paths[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ContentContractValidationError{
+					field:  fmt.Sprintf("Paths[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for MinFiles
+
+	// no validation rules for MaxFiles
+
+	// no validation rules for MaxTotalBytes
+
+	if len(errors) > 0 {
+		return ContentContractMultiError(errors)
+	}
+
+	return nil
+}
+
+// ContentContractMultiError is an error wrapping multiple validation errors
+// returned by ContentContract.ValidateAll() if the designated constraints
+// aren't met.
+type ContentContractMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ContentContractMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ContentContractMultiError) AllErrors() []error { return m }
+
+// ContentContractValidationError is the validation error returned by
+// ContentContract.Validate if the designated constraints aren't met.
+type ContentContractValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ContentContractValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ContentContractValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ContentContractValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ContentContractValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ContentContractValidationError) ErrorName() string { return "ContentContractValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ContentContractValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sContentContract.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ContentContractValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ContentContractValidationError{}
+
+// Validate checks the field values on PathContract with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PathContract) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PathContract with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PathContractMultiError, or
+// nil if none found.
+func (m *PathContract) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PathContract) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Glob
+
+	// no validation rules for Required
+
+	// no validation rules for MinFiles
+
+	// no validation rules for MaxFiles
+
+	// no validation rules for MinFileBytes
+
+	// no validation rules for MaxFileBytes
+
+	if all {
+		switch v := interface{}(m.GetJsonSchema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PathContractValidationError{
+					field:  "JsonSchema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PathContractValidationError{
+					field:  "JsonSchema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJsonSchema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PathContractValidationError{
+				field:  "JsonSchema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTable()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PathContractValidationError{
+					field:  "Table",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PathContractValidationError{
+					field:  "Table",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTable()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PathContractValidationError{
+				field:  "Table",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PathContractMultiError(errors)
+	}
+
+	return nil
+}
+
+// PathContractMultiError is an error wrapping multiple validation errors
+// returned by PathContract.ValidateAll() if the designated constraints aren't met.
+type PathContractMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PathContractMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PathContractMultiError) AllErrors() []error { return m }
+
+// PathContractValidationError is the validation error returned by
+// PathContract.Validate if the designated constraints aren't met.
+type PathContractValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PathContractValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PathContractValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PathContractValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PathContractValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PathContractValidationError) ErrorName() string { return "PathContractValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PathContractValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPathContract.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PathContractValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PathContractValidationError{}
+
+// Validate checks the field values on TableSchema with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TableSchema) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TableSchema with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TableSchemaMultiError, or
+// nil if none found.
+func (m *TableSchema) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TableSchema) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Format
+
+	// no validation rules for Header
+
+	for idx, item := range m.GetColumns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TableSchemaValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TableSchemaValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TableSchemaValidationError{
+					field:  fmt.Sprintf("Columns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for AllowExtraColumns
+
+	if len(errors) > 0 {
+		return TableSchemaMultiError(errors)
+	}
+
+	return nil
+}
+
+// TableSchemaMultiError is an error wrapping multiple validation errors
+// returned by TableSchema.ValidateAll() if the designated constraints aren't met.
+type TableSchemaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TableSchemaMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TableSchemaMultiError) AllErrors() []error { return m }
+
+// TableSchemaValidationError is the validation error returned by
+// TableSchema.Validate if the designated constraints aren't met.
+type TableSchemaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TableSchemaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TableSchemaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TableSchemaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TableSchemaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TableSchemaValidationError) ErrorName() string { return "TableSchemaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TableSchemaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTableSchema.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TableSchemaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TableSchemaValidationError{}
+
+// Validate checks the field values on TableColumn with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TableColumn) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TableColumn with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TableColumnMultiError, or
+// nil if none found.
+func (m *TableColumn) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TableColumn) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Type
+
+	// no validation rules for Nullable
+
+	if len(errors) > 0 {
+		return TableColumnMultiError(errors)
+	}
+
+	return nil
+}
+
+// TableColumnMultiError is an error wrapping multiple validation errors
+// returned by TableColumn.ValidateAll() if the designated constraints aren't met.
+type TableColumnMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TableColumnMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TableColumnMultiError) AllErrors() []error { return m }
+
+// TableColumnValidationError is the validation error returned by
+// TableColumn.Validate if the designated constraints aren't met.
+type TableColumnValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TableColumnValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TableColumnValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TableColumnValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TableColumnValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TableColumnValidationError) ErrorName() string { return "TableColumnValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TableColumnValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTableColumn.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TableColumnValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TableColumnValidationError{}
+
+// Validate checks the field values on CommitValidationInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommitValidationInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommitValidationInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommitValidationInfoMultiError, or nil if none found.
+func (m *CommitValidationInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommitValidationInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRepo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommitValidationInfoValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommitValidationInfoValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRepo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommitValidationInfoValidationError{
+				field:  "Repo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetContract()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommitValidationInfoValidationError{
+					field:  "Contract",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommitValidationInfoValidationError{
+					field:  "Contract",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContract()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommitValidationInfoValidationError{
+				field:  "Contract",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetValidator()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommitValidationInfoValidationError{
+					field:  "Validator",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommitValidationInfoValidationError{
+					field:  "Validator",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValidator()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommitValidationInfoValidationError{
+				field:  "Validator",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CommitValidationInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommitValidationInfoMultiError is an error wrapping multiple validation
+// errors returned by CommitValidationInfo.ValidateAll() if the designated
+// constraints aren't met.
+type CommitValidationInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommitValidationInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommitValidationInfoMultiError) AllErrors() []error { return m }
+
+// CommitValidationInfoValidationError is the validation error returned by
+// CommitValidationInfo.Validate if the designated constraints aren't met.
+type CommitValidationInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommitValidationInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommitValidationInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommitValidationInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommitValidationInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommitValidationInfoValidationError) ErrorName() string {
+	return "CommitValidationInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommitValidationInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommitValidationInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommitValidationInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommitValidationInfoValidationError{}
+
+// Validate checks the field values on SetCommitValidationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetCommitValidationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetCommitValidationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetCommitValidationRequestMultiError, or nil if none found.
+func (m *SetCommitValidationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetCommitValidationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRepo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetCommitValidationRequestValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetCommitValidationRequestValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRepo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetCommitValidationRequestValidationError{
+				field:  "Repo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetContract()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetCommitValidationRequestValidationError{
+					field:  "Contract",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetCommitValidationRequestValidationError{
+					field:  "Contract",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContract()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetCommitValidationRequestValidationError{
+				field:  "Contract",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetValidator()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetCommitValidationRequestValidationError{
+					field:  "Validator",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetCommitValidationRequestValidationError{
+					field:  "Validator",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValidator()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetCommitValidationRequestValidationError{
+				field:  "Validator",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetCommitValidationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetCommitValidationRequestMultiError is an error wrapping multiple
+// validation errors returned by SetCommitValidationRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetCommitValidationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetCommitValidationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetCommitValidationRequestMultiError) AllErrors() []error { return m }
+
+// SetCommitValidationRequestValidationError is the validation error returned
+// by SetCommitValidationRequest.Validate if the designated constraints aren't met.
+type SetCommitValidationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetCommitValidationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetCommitValidationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetCommitValidationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetCommitValidationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetCommitValidationRequestValidationError) ErrorName() string {
+	return "SetCommitValidationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetCommitValidationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetCommitValidationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetCommitValidationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetCommitValidationRequestValidationError{}
+
+// Validate checks the field values on ListCommitValidationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCommitValidationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCommitValidationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCommitValidationRequestMultiError, or nil if none found.
+func (m *ListCommitValidationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCommitValidationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRepo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListCommitValidationRequestValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListCommitValidationRequestValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRepo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListCommitValidationRequestValidationError{
+				field:  "Repo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListCommitValidationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCommitValidationRequestMultiError is an error wrapping multiple
+// validation errors returned by ListCommitValidationRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListCommitValidationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCommitValidationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCommitValidationRequestMultiError) AllErrors() []error { return m }
+
+// ListCommitValidationRequestValidationError is the validation error returned
+// by ListCommitValidationRequest.Validate if the designated constraints
+// aren't met.
+type ListCommitValidationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCommitValidationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCommitValidationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCommitValidationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCommitValidationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCommitValidationRequestValidationError) ErrorName() string {
+	return "ListCommitValidationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCommitValidationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCommitValidationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCommitValidationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCommitValidationRequestValidationError{}
+
+// Validate checks the field values on CommitValidationResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommitValidationResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommitValidationResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommitValidationResultMultiError, or nil if none found.
+func (m *CommitValidationResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommitValidationResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Passed
+
+	// no validation rules for Reason
+
+	// no validation rules for Pending
+
+	if len(errors) > 0 {
+		return CommitValidationResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommitValidationResultMultiError is an error wrapping multiple validation
+// errors returned by CommitValidationResult.ValidateAll() if the designated
+// constraints aren't met.
+type CommitValidationResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommitValidationResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommitValidationResultMultiError) AllErrors() []error { return m }
+
+// CommitValidationResultValidationError is the validation error returned by
+// CommitValidationResult.Validate if the designated constraints aren't met.
+type CommitValidationResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommitValidationResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommitValidationResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommitValidationResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommitValidationResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommitValidationResultValidationError) ErrorName() string {
+	return "CommitValidationResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommitValidationResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommitValidationResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommitValidationResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommitValidationResultValidationError{}
+
+// Validate checks the field values on StoragePlacementInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StoragePlacementInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StoragePlacementInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StoragePlacementInfoMultiError, or nil if none found.
+func (m *StoragePlacementInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StoragePlacementInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRepo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StoragePlacementInfoValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StoragePlacementInfoValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRepo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StoragePlacementInfoValidationError{
+				field:  "Repo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Tier
+
+	// no validation rules for ColdTier
+
+	if all {
+		switch v := interface{}(m.GetColdAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StoragePlacementInfoValidationError{
+					field:  "ColdAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StoragePlacementInfoValidationError{
+					field:  "ColdAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetColdAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StoragePlacementInfoValidationError{
+				field:  "ColdAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StoragePlacementInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// StoragePlacementInfoMultiError is an error wrapping multiple validation
+// errors returned by StoragePlacementInfo.ValidateAll() if the designated
+// constraints aren't met.
+type StoragePlacementInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StoragePlacementInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StoragePlacementInfoMultiError) AllErrors() []error { return m }
+
+// StoragePlacementInfoValidationError is the validation error returned by
+// StoragePlacementInfo.Validate if the designated constraints aren't met.
+type StoragePlacementInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StoragePlacementInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StoragePlacementInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StoragePlacementInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StoragePlacementInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StoragePlacementInfoValidationError) ErrorName() string {
+	return "StoragePlacementInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StoragePlacementInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStoragePlacementInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StoragePlacementInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StoragePlacementInfoValidationError{}
+
+// Validate checks the field values on SetStoragePlacementRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetStoragePlacementRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetStoragePlacementRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetStoragePlacementRequestMultiError, or nil if none found.
+func (m *SetStoragePlacementRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetStoragePlacementRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRepo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetStoragePlacementRequestValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetStoragePlacementRequestValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRepo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetStoragePlacementRequestValidationError{
+				field:  "Repo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Tier
+
+	// no validation rules for ColdTier
+
+	if all {
+		switch v := interface{}(m.GetColdAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetStoragePlacementRequestValidationError{
+					field:  "ColdAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetStoragePlacementRequestValidationError{
+					field:  "ColdAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetColdAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetStoragePlacementRequestValidationError{
+				field:  "ColdAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetStoragePlacementRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetStoragePlacementRequestMultiError is an error wrapping multiple
+// validation errors returned by SetStoragePlacementRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetStoragePlacementRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetStoragePlacementRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetStoragePlacementRequestMultiError) AllErrors() []error { return m }
+
+// SetStoragePlacementRequestValidationError is the validation error returned
+// by SetStoragePlacementRequest.Validate if the designated constraints aren't met.
+type SetStoragePlacementRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetStoragePlacementRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetStoragePlacementRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetStoragePlacementRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetStoragePlacementRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetStoragePlacementRequestValidationError) ErrorName() string {
+	return "SetStoragePlacementRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetStoragePlacementRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetStoragePlacementRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetStoragePlacementRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetStoragePlacementRequestValidationError{}
+
+// Validate checks the field values on ListStoragePlacementRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStoragePlacementRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStoragePlacementRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStoragePlacementRequestMultiError, or nil if none found.
+func (m *ListStoragePlacementRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStoragePlacementRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRepo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListStoragePlacementRequestValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListStoragePlacementRequestValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRepo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListStoragePlacementRequestValidationError{
+				field:  "Repo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListStoragePlacementRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStoragePlacementRequestMultiError is an error wrapping multiple
+// validation errors returned by ListStoragePlacementRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListStoragePlacementRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStoragePlacementRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStoragePlacementRequestMultiError) AllErrors() []error { return m }
+
+// ListStoragePlacementRequestValidationError is the validation error returned
+// by ListStoragePlacementRequest.Validate if the designated constraints
+// aren't met.
+type ListStoragePlacementRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStoragePlacementRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStoragePlacementRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStoragePlacementRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStoragePlacementRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStoragePlacementRequestValidationError) ErrorName() string {
+	return "ListStoragePlacementRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStoragePlacementRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStoragePlacementRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStoragePlacementRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStoragePlacementRequestValidationError{}
+
+// Validate checks the field values on UploadPartInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UploadPartInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadPartInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UploadPartInfoMultiError,
+// or nil if none found.
+func (m *UploadPartInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadPartInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Number
+
+	// no validation rules for SizeBytes
+
+	// no validation rules for Hash
+
+	// no validation rules for FileSetId
+
+	if len(errors) > 0 {
+		return UploadPartInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadPartInfoMultiError is an error wrapping multiple validation errors
+// returned by UploadPartInfo.ValidateAll() if the designated constraints
+// aren't met.
+type UploadPartInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadPartInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadPartInfoMultiError) AllErrors() []error { return m }
+
+// UploadPartInfoValidationError is the validation error returned by
+// UploadPartInfo.Validate if the designated constraints aren't met.
+type UploadPartInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadPartInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadPartInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadPartInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadPartInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadPartInfoValidationError) ErrorName() string { return "UploadPartInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UploadPartInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadPartInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadPartInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadPartInfoValidationError{}
+
+// Validate checks the field values on UploadSessionInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UploadSessionInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadSessionInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadSessionInfoMultiError, or nil if none found.
+func (m *UploadSessionInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadSessionInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetFile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadSessionInfoValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadSessionInfoValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadSessionInfoValidationError{
+				field:  "File",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Append
+
+	if all {
+		switch v := interface{}(m.GetCreated()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadSessionInfoValidationError{
+					field:  "Created",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadSessionInfoValidationError{
+					field:  "Created",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreated()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadSessionInfoValidationError{
+				field:  "Created",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpires()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadSessionInfoValidationError{
+					field:  "Expires",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadSessionInfoValidationError{
+					field:  "Expires",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpires()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadSessionInfoValidationError{
+				field:  "Expires",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTtl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadSessionInfoValidationError{
+					field:  "Ttl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadSessionInfoValidationError{
+					field:  "Ttl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTtl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadSessionInfoValidationError{
+				field:  "Ttl",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetParts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadSessionInfoValidationError{
+						field:  fmt.Sprintf("Parts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadSessionInfoValidationError{
+						field:  fmt.Sprintf("Parts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UploadSessionInfoValidationError{
+					field:  fmt.Sprintf("Parts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UploadSessionInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadSessionInfoMultiError is an error wrapping multiple validation errors
+// returned by UploadSessionInfo.ValidateAll() if the designated constraints
+// aren't met.
+type UploadSessionInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadSessionInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadSessionInfoMultiError) AllErrors() []error { return m }
+
+// UploadSessionInfoValidationError is the validation error returned by
+// UploadSessionInfo.Validate if the designated constraints aren't met.
+type UploadSessionInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadSessionInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadSessionInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadSessionInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadSessionInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadSessionInfoValidationError) ErrorName() string {
+	return "UploadSessionInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadSessionInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadSessionInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadSessionInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadSessionInfoValidationError{}
+
+// Validate checks the field values on CreateUploadSessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateUploadSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateUploadSessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateUploadSessionRequestMultiError, or nil if none found.
+func (m *CreateUploadSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateUploadSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetFile() == nil {
+		err := CreateUploadSessionRequestValidationError{
+			field:  "File",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateUploadSessionRequestValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateUploadSessionRequestValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateUploadSessionRequestValidationError{
+				field:  "File",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Append
+
+	if all {
+		switch v := interface{}(m.GetTtl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateUploadSessionRequestValidationError{
+					field:  "Ttl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateUploadSessionRequestValidationError{
+					field:  "Ttl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTtl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateUploadSessionRequestValidationError{
+				field:  "Ttl",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateUploadSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateUploadSessionRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateUploadSessionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateUploadSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateUploadSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateUploadSessionRequestMultiError) AllErrors() []error { return m }
+
+// CreateUploadSessionRequestValidationError is the validation error returned
+// by CreateUploadSessionRequest.Validate if the designated constraints aren't met.
+type CreateUploadSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateUploadSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateUploadSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateUploadSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateUploadSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateUploadSessionRequestValidationError) ErrorName() string {
+	return "CreateUploadSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateUploadSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateUploadSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateUploadSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateUploadSessionRequestValidationError{}
+
+// Validate checks the field values on InspectUploadSessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InspectUploadSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InspectUploadSessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InspectUploadSessionRequestMultiError, or nil if none found.
+func (m *InspectUploadSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InspectUploadSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return InspectUploadSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InspectUploadSessionRequestMultiError is an error wrapping multiple
+// validation errors returned by InspectUploadSessionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InspectUploadSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InspectUploadSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InspectUploadSessionRequestMultiError) AllErrors() []error { return m }
+
+// InspectUploadSessionRequestValidationError is the validation error returned
+// by InspectUploadSessionRequest.Validate if the designated constraints
+// aren't met.
+type InspectUploadSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InspectUploadSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InspectUploadSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InspectUploadSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InspectUploadSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InspectUploadSessionRequestValidationError) ErrorName() string {
+	return "InspectUploadSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InspectUploadSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInspectUploadSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InspectUploadSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InspectUploadSessionRequestValidationError{}
+
+// Validate checks the field values on ListUploadSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUploadSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUploadSessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUploadSessionRequestMultiError, or nil if none found.
+func (m *ListUploadSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUploadSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRepo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListUploadSessionRequestValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListUploadSessionRequestValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRepo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListUploadSessionRequestValidationError{
+				field:  "Repo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListUploadSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUploadSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by ListUploadSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListUploadSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUploadSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUploadSessionRequestMultiError) AllErrors() []error { return m }
+
+// ListUploadSessionRequestValidationError is the validation error returned by
+// ListUploadSessionRequest.Validate if the designated constraints aren't met.
+type ListUploadSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUploadSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUploadSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUploadSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUploadSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUploadSessionRequestValidationError) ErrorName() string {
+	return "ListUploadSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUploadSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUploadSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUploadSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUploadSessionRequestValidationError{}
+
+// Validate checks the field values on UploadPartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UploadPartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadPartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadPartRequestMultiError, or nil if none found.
+func (m *UploadPartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadPartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SessionId
+
+	// no validation rules for Number
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return UploadPartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadPartRequestMultiError is an error wrapping multiple validation errors
+// returned by UploadPartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UploadPartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadPartRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadPartRequestMultiError) AllErrors() []error { return m }
+
+// UploadPartRequestValidationError is the validation error returned by
+// UploadPartRequest.Validate if the designated constraints aren't met.
+type UploadPartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadPartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadPartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadPartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadPartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadPartRequestValidationError) ErrorName() string {
+	return "UploadPartRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadPartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadPartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadPartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadPartRequestValidationError{}
+
+// Validate checks the field values on CommitUploadSessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommitUploadSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommitUploadSessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommitUploadSessionRequestMultiError, or nil if none found.
+func (m *CommitUploadSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommitUploadSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return CommitUploadSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommitUploadSessionRequestMultiError is an error wrapping multiple
+// validation errors returned by CommitUploadSessionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CommitUploadSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommitUploadSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommitUploadSessionRequestMultiError) AllErrors() []error { return m }
+
+// CommitUploadSessionRequestValidationError is the validation error returned
+// by CommitUploadSessionRequest.Validate if the designated constraints aren't met.
+type CommitUploadSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommitUploadSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommitUploadSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommitUploadSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommitUploadSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommitUploadSessionRequestValidationError) ErrorName() string {
+	return "CommitUploadSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommitUploadSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommitUploadSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommitUploadSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommitUploadSessionRequestValidationError{}
+
+// Validate checks the field values on DeleteUploadSessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteUploadSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteUploadSessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteUploadSessionRequestMultiError, or nil if none found.
+func (m *DeleteUploadSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteUploadSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteUploadSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteUploadSessionRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteUploadSessionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteUploadSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteUploadSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteUploadSessionRequestMultiError) AllErrors() []error { return m }
+
+// DeleteUploadSessionRequestValidationError is the validation error returned
+// by DeleteUploadSessionRequest.Validate if the designated constraints aren't met.
+type DeleteUploadSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteUploadSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteUploadSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteUploadSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteUploadSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteUploadSessionRequestValidationError) ErrorName() string {
+	return "DeleteUploadSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteUploadSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteUploadSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteUploadSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteUploadSessionRequestValidationError{}
+
 // Validate checks the field values on RepoInfo_Details with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -11019,6 +16996,35 @@ func (m *RepoInfo_Details) validate(all bool) error {
 
 	// no validation rules for SizeBytes
 
+	if all {
+		switch v := interface{}(m.GetUsage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RepoInfo_DetailsValidationError{
+					field:  "Usage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RepoInfo_DetailsValidationError{
+					field:  "Usage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUsage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RepoInfo_DetailsValidationError{
+				field:  "Usage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return RepoInfo_DetailsMultiError(errors)
 	}
@@ -11041,11 +17047,229 @@ func (m RepoInfo_DetailsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RepoInfo_DetailsMultiError) AllErrors() []error { return m }
+func (m RepoInfo_DetailsMultiError) AllErrors() []error { return m }
+
+// RepoInfo_DetailsValidationError is the validation error returned by
+// RepoInfo_Details.Validate if the designated constraints aren't met.
+type RepoInfo_DetailsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RepoInfo_DetailsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RepoInfo_DetailsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RepoInfo_DetailsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RepoInfo_DetailsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RepoInfo_DetailsValidationError) ErrorName() string { return "RepoInfo_DetailsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RepoInfo_DetailsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRepoInfo_Details.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RepoInfo_DetailsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RepoInfo_DetailsValidationError{}
+
+// Validate checks the field values on CommitInfo_Details with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommitInfo_Details) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommitInfo_Details with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommitInfo_DetailsMultiError, or nil if none found.
+func (m *CommitInfo_Details) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommitInfo_Details) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SizeBytes
+
+	if all {
+		switch v := interface{}(m.GetCompactingTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommitInfo_DetailsValidationError{
+					field:  "CompactingTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommitInfo_DetailsValidationError{
+					field:  "CompactingTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCompactingTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommitInfo_DetailsValidationError{
+				field:  "CompactingTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetValidatingTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommitInfo_DetailsValidationError{
+					field:  "ValidatingTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommitInfo_DetailsValidationError{
+					field:  "ValidatingTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValidatingTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommitInfo_DetailsValidationError{
+				field:  "ValidatingTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUsage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommitInfo_DetailsValidationError{
+					field:  "Usage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommitInfo_DetailsValidationError{
+					field:  "Usage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUsage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommitInfo_DetailsValidationError{
+				field:  "Usage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetValidation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommitInfo_DetailsValidationError{
+					field:  "Validation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommitInfo_DetailsValidationError{
+					field:  "Validation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValidation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommitInfo_DetailsValidationError{
+				field:  "Validation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CommitInfo_DetailsMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommitInfo_DetailsMultiError is an error wrapping multiple validation errors
+// returned by CommitInfo_Details.ValidateAll() if the designated constraints
+// aren't met.
+type CommitInfo_DetailsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommitInfo_DetailsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommitInfo_DetailsMultiError) AllErrors() []error { return m }
 
-// RepoInfo_DetailsValidationError is the validation error returned by
-// RepoInfo_Details.Validate if the designated constraints aren't met.
-type RepoInfo_DetailsValidationError struct {
+// CommitInfo_DetailsValidationError is the validation error returned by
+// CommitInfo_Details.Validate if the designated constraints aren't met.
+type CommitInfo_DetailsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11053,22 +17277,24 @@ type RepoInfo_DetailsValidationError struct {
 }
 
 // Field function returns field value.
-func (e RepoInfo_DetailsValidationError) Field() string { return e.field }
+func (e CommitInfo_DetailsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RepoInfo_DetailsValidationError) Reason() string { return e.reason }
+func (e CommitInfo_DetailsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RepoInfo_DetailsValidationError) Cause() error { return e.cause }
+func (e CommitInfo_DetailsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RepoInfo_DetailsValidationError) Key() bool { return e.key }
+func (e CommitInfo_DetailsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RepoInfo_DetailsValidationError) ErrorName() string { return "RepoInfo_DetailsValidationError" }
+func (e CommitInfo_DetailsValidationError) ErrorName() string {
+	return "CommitInfo_DetailsValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RepoInfo_DetailsValidationError) Error() string {
+func (e CommitInfo_DetailsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11080,14 +17306,14 @@ func (e RepoInfo_DetailsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRepoInfo_Details.%s: %s%s",
+		"invalid %sCommitInfo_Details.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RepoInfo_DetailsValidationError{}
+var _ error = CommitInfo_DetailsValidationError{}
 
 var _ interface {
 	Field() string
@@ -11095,84 +17321,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RepoInfo_DetailsValidationError{}
+} = CommitInfo_DetailsValidationError{}
 
-// Validate checks the field values on CommitInfo_Details with the rules
+// Validate checks the field values on ProjectInfo_Details with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CommitInfo_Details) Validate() error {
+func (m *ProjectInfo_Details) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CommitInfo_Details with the rules
+// ValidateAll checks the field values on ProjectInfo_Details with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CommitInfo_DetailsMultiError, or nil if none found.
-func (m *CommitInfo_Details) ValidateAll() error {
+// ProjectInfo_DetailsMultiError, or nil if none found.
+func (m *ProjectInfo_Details) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CommitInfo_Details) validate(all bool) error {
+func (m *ProjectInfo_Details) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SizeBytes
-
-	if all {
-		switch v := interface{}(m.GetCompactingTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CommitInfo_DetailsValidationError{
-					field:  "CompactingTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CommitInfo_DetailsValidationError{
-					field:  "CompactingTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCompactingTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CommitInfo_DetailsValidationError{
-				field:  "CompactingTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if all {
-		switch v := interface{}(m.GetValidatingTime()).(type) {
+		switch v := interface{}(m.GetUsage()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CommitInfo_DetailsValidationError{
-					field:  "ValidatingTime",
+				errors = append(errors, ProjectInfo_DetailsValidationError{
+					field:  "Usage",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CommitInfo_DetailsValidationError{
-					field:  "ValidatingTime",
+				errors = append(errors, ProjectInfo_DetailsValidationError{
+					field:  "Usage",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetValidatingTime()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetUsage()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CommitInfo_DetailsValidationError{
-				field:  "ValidatingTime",
+			return ProjectInfo_DetailsValidationError{
+				field:  "Usage",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -11180,19 +17375,19 @@ func (m *CommitInfo_Details) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CommitInfo_DetailsMultiError(errors)
+		return ProjectInfo_DetailsMultiError(errors)
 	}
 
 	return nil
 }
 
-// CommitInfo_DetailsMultiError is an error wrapping multiple validation errors
-// returned by CommitInfo_Details.ValidateAll() if the designated constraints
-// aren't met.
-type CommitInfo_DetailsMultiError []error
+// ProjectInfo_DetailsMultiError is an error wrapping multiple validation
+// errors returned by ProjectInfo_Details.ValidateAll() if the designated
+// constraints aren't met.
+type ProjectInfo_DetailsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CommitInfo_DetailsMultiError) Error() string {
+func (m ProjectInfo_DetailsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11201,11 +17396,11 @@ func (m CommitInfo_DetailsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CommitInfo_DetailsMultiError) AllErrors() []error { return m }
+func (m ProjectInfo_DetailsMultiError) AllErrors() []error { return m }
 
-// CommitInfo_DetailsValidationError is the validation error returned by
-// CommitInfo_Details.Validate if the designated constraints aren't met.
-type CommitInfo_DetailsValidationError struct {
+// ProjectInfo_DetailsValidationError is the validation error returned by
+// ProjectInfo_Details.Validate if the designated constraints aren't met.
+type ProjectInfo_DetailsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11213,24 +17408,24 @@ type CommitInfo_DetailsValidationError struct {
 }
 
 // Field function returns field value.
-func (e CommitInfo_DetailsValidationError) Field() string { return e.field }
+func (e ProjectInfo_DetailsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CommitInfo_DetailsValidationError) Reason() string { return e.reason }
+func (e ProjectInfo_DetailsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CommitInfo_DetailsValidationError) Cause() error { return e.cause }
+func (e ProjectInfo_DetailsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CommitInfo_DetailsValidationError) Key() bool { return e.key }
+func (e ProjectInfo_DetailsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CommitInfo_DetailsValidationError) ErrorName() string {
-	return "CommitInfo_DetailsValidationError"
+func (e ProjectInfo_DetailsValidationError) ErrorName() string {
+	return "ProjectInfo_DetailsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CommitInfo_DetailsValidationError) Error() string {
+func (e ProjectInfo_DetailsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11242,14 +17437,14 @@ func (e CommitInfo_DetailsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCommitInfo_Details.%s: %s%s",
+		"invalid %sProjectInfo_Details.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CommitInfo_DetailsValidationError{}
+var _ error = ProjectInfo_DetailsValidationError{}
 
 var _ interface {
 	Field() string
@@ -11257,7 +17452,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CommitInfo_DetailsValidationError{}
+} = ProjectInfo_DetailsValidationError{}
 
 // Validate checks the field values on AddFile_URLSource with the rules defined
 // in the proto definition for this message. If any rules are violated, the
@@ -11791,3 +17986,216 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = EgressResponse_SQLDatabaseResultValidationError{}
+
+// Validate checks the field values on ReplicationTarget_Secret with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplicationTarget_Secret) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplicationTarget_Secret with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplicationTarget_SecretMultiError, or nil if none found.
+func (m *ReplicationTarget_Secret) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplicationTarget_Secret) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return ReplicationTarget_SecretMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplicationTarget_SecretMultiError is an error wrapping multiple validation
+// errors returned by ReplicationTarget_Secret.ValidateAll() if the designated
+// constraints aren't met.
+type ReplicationTarget_SecretMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplicationTarget_SecretMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplicationTarget_SecretMultiError) AllErrors() []error { return m }
+
+// ReplicationTarget_SecretValidationError is the validation error returned by
+// ReplicationTarget_Secret.Validate if the designated constraints aren't met.
+type ReplicationTarget_SecretValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplicationTarget_SecretValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplicationTarget_SecretValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplicationTarget_SecretValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplicationTarget_SecretValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplicationTarget_SecretValidationError) ErrorName() string {
+	return "ReplicationTarget_SecretValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplicationTarget_SecretValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplicationTarget_Secret.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplicationTarget_SecretValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplicationTarget_SecretValidationError{}
+
+// Validate checks the field values on ReplicateFileSetRequest_Chunk with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplicateFileSetRequest_Chunk) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplicateFileSetRequest_Chunk with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ReplicateFileSetRequest_ChunkMultiError, or nil if none found.
+func (m *ReplicateFileSetRequest_Chunk) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplicateFileSetRequest_Chunk) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return ReplicateFileSetRequest_ChunkMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplicateFileSetRequest_ChunkMultiError is an error wrapping multiple
+// validation errors returned by ReplicateFileSetRequest_Chunk.ValidateAll()
+// if the designated constraints aren't met.
+type ReplicateFileSetRequest_ChunkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplicateFileSetRequest_ChunkMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplicateFileSetRequest_ChunkMultiError) AllErrors() []error { return m }
+
+// ReplicateFileSetRequest_ChunkValidationError is the validation error
+// returned by ReplicateFileSetRequest_Chunk.Validate if the designated
+// constraints aren't met.
+type ReplicateFileSetRequest_ChunkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplicateFileSetRequest_ChunkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplicateFileSetRequest_ChunkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplicateFileSetRequest_ChunkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplicateFileSetRequest_ChunkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplicateFileSetRequest_ChunkValidationError) ErrorName() string {
+	return "ReplicateFileSetRequest_ChunkValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplicateFileSetRequest_ChunkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplicateFileSetRequest_Chunk.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplicateFileSetRequest_ChunkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplicateFileSetRequest_ChunkValidationError{}
@@ -64,6 +64,17 @@ func (x *RepoInfo_Details) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 		return nil
 	}
 	enc.AddInt64("size_bytes", x.SizeBytes)
+	enc.AddObject("usage", x.Usage)
+	return nil
+}
+
+func (x *StorageUsage) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddInt64("exclusive_bytes", x.ExclusiveBytes)
+	enc.AddInt64("shared_bytes", x.SharedBytes)
+	protoextensions.AddTimestamp(enc, "computed_at", x.ComputedAt)
 	return nil
 }
 
@@ -129,6 +140,7 @@ func (x *Trigger) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("size", x.Size)
 	enc.AddInt64("commits", x.Commits)
 	enc.AddString("cron_spec", x.CronSpec)
+	enc.AddBool("require_approval", x.RequireApproval)
 	return nil
 }
 
@@ -177,6 +189,8 @@ func (x *CommitInfo) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddArray("direct_provenance", zapcore.ArrayMarshalerFunc(direct_provenanceArrMarshaller))
 	enc.AddString("error", x.Error)
 	enc.AddInt64("size_bytes_upper_bound", x.SizeBytesUpperBound)
+	enc.AddObject("approval", x.Approval)
+	enc.AddString("checkpoint", x.Checkpoint)
 	enc.AddObject("details", x.Details)
 	return nil
 }
@@ -188,6 +202,18 @@ func (x *CommitInfo_Details) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddInt64("size_bytes", x.SizeBytes)
 	protoextensions.AddDuration(enc, "compacting_time", x.CompactingTime)
 	protoextensions.AddDuration(enc, "validating_time", x.ValidatingTime)
+	enc.AddObject("usage", x.Usage)
+	enc.AddObject("validation", x.Validation)
+	return nil
+}
+
+func (x *CommitApproval) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("principal", x.Principal)
+	enc.AddString("comment", x.Comment)
+	protoextensions.AddTimestamp(enc, "approved", x.Approved)
 	return nil
 }
 
@@ -242,6 +268,29 @@ func (x *ProjectInfo) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("description", x.Description)
 	enc.AddObject("auth_info", x.AuthInfo)
 	protoextensions.AddTimestamp(enc, "created_at", x.CreatedAt)
+	enc.AddObject("details", x.Details)
+	enc.AddObject("quota", x.Quota)
+	return nil
+}
+
+func (x *ProjectInfo_Details) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("usage", x.Usage)
+	return nil
+}
+
+func (x *ProjectQuota) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddInt64("max_bytes", x.MaxBytes)
+	enc.AddString("enforcement", x.Enforcement.String())
+	enc.AddInt64("max_workers", x.MaxWorkers)
+	enc.AddString("max_cpu", x.MaxCpu)
+	enc.AddString("max_memory", x.MaxMemory)
+	enc.AddString("priority_class_name", x.PriorityClassName)
 	return nil
 }
 
@@ -343,6 +392,17 @@ func (x *FinishCommitRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error
 	enc.AddString("description", x.Description)
 	enc.AddString("error", x.Error)
 	enc.AddBool("force", x.Force)
+	enc.AddString("checkpoint", x.Checkpoint)
+	return nil
+}
+
+func (x *ApproveCommitRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("commit", x.Commit)
+	enc.AddString("comment", x.Comment)
+	enc.AddString("principal", x.Principal)
 	return nil
 }
 
@@ -495,6 +555,7 @@ func (x *CreateProjectRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error
 	enc.AddObject("project", x.Project)
 	enc.AddString("description", x.Description)
 	enc.AddBool("update", x.Update)
+	enc.AddObject("quota", x.Quota)
 	return nil
 }
 
@@ -503,6 +564,7 @@ func (x *InspectProjectRequest) MarshalLogObject(enc zapcore.ObjectEncoder) erro
 		return nil
 	}
 	enc.AddObject("project", x.Project)
+	enc.AddBool("details", x.Details)
 	return nil
 }
 
@@ -898,3 +960,440 @@ func (x *EgressResponse_SQLDatabaseResult) MarshalLogObject(enc zapcore.ObjectEn
 	}))
 	return nil
 }
+
+func (x *Replication) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("name", x.Name)
+	return nil
+}
+
+func (x *ReplicationTarget) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("pachd_address", x.PachdAddress)
+	enc.AddObject("auth_token_secret", x.AuthTokenSecret)
+	return nil
+}
+
+func (x *ReplicationTarget_Secret) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("name", x.Name)
+	enc.AddString("key", x.Key)
+	return nil
+}
+
+func (x *BranchReplicationStatus) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("branch", x.Branch)
+	enc.AddObject("last_replicated_commit", x.LastReplicatedCommit)
+	enc.AddObject("target_commit", x.TargetCommit)
+	protoextensions.AddTimestamp(enc, "last_replicated_at", x.LastReplicatedAt)
+	protoextensions.AddDuration(enc, "lag", x.Lag)
+	enc.AddInt64("bytes_transferred", x.BytesTransferred)
+	enc.AddString("error", x.Error)
+	enc.AddObject("pending_commit", x.PendingCommit)
+	enc.AddObject("pending_target_commit", x.PendingTargetCommit)
+	return nil
+}
+
+func (x *ReplicationInfo) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("replication", x.Replication)
+	enc.AddObject("target", x.Target)
+	branchesArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Branches {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("branches", zapcore.ArrayMarshalerFunc(branchesArrMarshaller))
+	protoextensions.AddTimestamp(enc, "created_at", x.CreatedAt)
+	statusArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Status {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("status", zapcore.ArrayMarshalerFunc(statusArrMarshaller))
+	return nil
+}
+
+func (x *CreateReplicationRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("replication", x.Replication)
+	enc.AddObject("target", x.Target)
+	branchesArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Branches {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("branches", zapcore.ArrayMarshalerFunc(branchesArrMarshaller))
+	enc.AddBool("update", x.Update)
+	return nil
+}
+
+func (x *InspectReplicationRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("replication", x.Replication)
+	return nil
+}
+
+func (x *ListReplicationRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	return nil
+}
+
+func (x *DeleteReplicationRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("replication", x.Replication)
+	return nil
+}
+
+func (x *MissingChunksRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	chunk_idsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.ChunkIds {
+			enc.AppendByteString(v)
+		}
+		return nil
+	}
+	enc.AddArray("chunk_ids", zapcore.ArrayMarshalerFunc(chunk_idsArrMarshaller))
+	return nil
+}
+
+func (x *MissingChunksResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	chunk_idsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.ChunkIds {
+			enc.AppendByteString(v)
+		}
+		return nil
+	}
+	enc.AddArray("chunk_ids", zapcore.ArrayMarshalerFunc(chunk_idsArrMarshaller))
+	return nil
+}
+
+func (x *ReplicateFileSetRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("chunk", x.GetChunk())
+	protoextensions.AddBytes(enc, "primitive", x.GetPrimitive())
+	return nil
+}
+
+func (x *ReplicateFileSetRequest_Chunk) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	protoextensions.AddBytes(enc, "id", x.Id)
+	points_toArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.PointsTo {
+			enc.AppendByteString(v)
+		}
+		return nil
+	}
+	enc.AddArray("points_to", zapcore.ArrayMarshalerFunc(points_toArrMarshaller))
+	protoextensions.AddBytes(enc, "data", x.Data)
+	return nil
+}
+
+func (x *RetentionPolicy) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddUint32("keep_last", x.KeepLast)
+	protoextensions.AddDuration(enc, "keep_for", x.KeepFor)
+	protoextensions.AddDuration(enc, "keep_daily_after", x.KeepDailyAfter)
+	return nil
+}
+
+func (x *RetentionPolicyInfo) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("repo", x.Repo)
+	enc.AddString("branch", x.Branch)
+	enc.AddObject("policy", x.Policy)
+	return nil
+}
+
+func (x *SetRetentionPolicyRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("repo", x.Repo)
+	enc.AddString("branch", x.Branch)
+	enc.AddObject("policy", x.Policy)
+	return nil
+}
+
+func (x *ListRetentionPolicyRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("repo", x.Repo)
+	return nil
+}
+
+func (x *EnforceRetentionPolicyRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("repo", x.Repo)
+	enc.AddBool("dry_run", x.DryRun)
+	return nil
+}
+
+func (x *RetentionAction) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("commit", x.Commit)
+	enc.AddBool("squashed", x.Squashed)
+	enc.AddString("skipped_reason", x.SkippedReason)
+	return nil
+}
+
+func (x *ContentContract) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	pathsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Paths {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("paths", zapcore.ArrayMarshalerFunc(pathsArrMarshaller))
+	enc.AddInt64("min_files", x.MinFiles)
+	enc.AddInt64("max_files", x.MaxFiles)
+	enc.AddInt64("max_total_bytes", x.MaxTotalBytes)
+	return nil
+}
+
+func (x *PathContract) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("glob", x.Glob)
+	enc.AddBool("required", x.Required)
+	enc.AddInt64("min_files", x.MinFiles)
+	enc.AddInt64("max_files", x.MaxFiles)
+	enc.AddInt64("min_file_bytes", x.MinFileBytes)
+	enc.AddInt64("max_file_bytes", x.MaxFileBytes)
+	if obj, ok := interface{}(x.JsonSchema).(zapcore.ObjectMarshaler); ok {
+		enc.AddObject("json_schema", obj)
+	} else {
+		enc.AddReflected("json_schema", x.JsonSchema)
+	}
+	enc.AddObject("table", x.Table)
+	return nil
+}
+
+func (x *TableSchema) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("format", x.Format)
+	enc.AddBool("header", x.Header)
+	columnsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Columns {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("columns", zapcore.ArrayMarshalerFunc(columnsArrMarshaller))
+	enc.AddBool("allow_extra_columns", x.AllowExtraColumns)
+	return nil
+}
+
+func (x *TableColumn) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("name", x.Name)
+	enc.AddString("type", x.Type)
+	enc.AddBool("nullable", x.Nullable)
+	return nil
+}
+
+func (x *CommitValidationInfo) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("repo", x.Repo)
+	enc.AddObject("contract", x.Contract)
+	enc.AddObject("validator", x.Validator)
+	return nil
+}
+
+func (x *SetCommitValidationRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("repo", x.Repo)
+	enc.AddObject("contract", x.Contract)
+	enc.AddObject("validator", x.Validator)
+	return nil
+}
+
+func (x *ListCommitValidationRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("repo", x.Repo)
+	return nil
+}
+
+func (x *CommitValidationResult) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddBool("passed", x.Passed)
+	enc.AddString("reason", x.Reason)
+	enc.AddBool("pending", x.Pending)
+	return nil
+}
+
+func (x *StoragePlacementInfo) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("repo", x.Repo)
+	enc.AddString("tier", x.Tier)
+	enc.AddString("cold_tier", x.ColdTier)
+	protoextensions.AddDuration(enc, "cold_after", x.ColdAfter)
+	return nil
+}
+
+func (x *SetStoragePlacementRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("repo", x.Repo)
+	enc.AddString("tier", x.Tier)
+	enc.AddString("cold_tier", x.ColdTier)
+	protoextensions.AddDuration(enc, "cold_after", x.ColdAfter)
+	return nil
+}
+
+func (x *ListStoragePlacementRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("repo", x.Repo)
+	return nil
+}
+
+func (x *UploadPartInfo) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddInt64("number", x.Number)
+	enc.AddInt64("size_bytes", x.SizeBytes)
+	protoextensions.AddBytes(enc, "hash", x.Hash)
+	enc.AddString("file_set_id", x.FileSetId)
+	return nil
+}
+
+func (x *UploadSessionInfo) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("id", x.Id)
+	enc.AddObject("file", x.File)
+	enc.AddBool("append", x.Append)
+	protoextensions.AddTimestamp(enc, "created", x.Created)
+	protoextensions.AddTimestamp(enc, "expires", x.Expires)
+	protoextensions.AddDuration(enc, "ttl", x.Ttl)
+	partsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Parts {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("parts", zapcore.ArrayMarshalerFunc(partsArrMarshaller))
+	return nil
+}
+
+func (x *CreateUploadSessionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("file", x.File)
+	enc.AddBool("append", x.Append)
+	protoextensions.AddDuration(enc, "ttl", x.Ttl)
+	return nil
+}
+
+func (x *InspectUploadSessionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("id", x.Id)
+	return nil
+}
+
+func (x *ListUploadSessionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("repo", x.Repo)
+	return nil
+}
+
+func (x *UploadPartRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("session_id", x.SessionId)
+	enc.AddInt64("number", x.Number)
+	protoextensions.AddBytes(enc, "data", x.Data)
+	return nil
+}
+
+func (x *CommitUploadSessionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("id", x.Id)
+	partsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Parts {
+			enc.AppendInt64(v)
+		}
+		return nil
+	}
+	enc.AddArray("parts", zapcore.ArrayMarshalerFunc(partsArrMarshaller))
+	return nil
+}
+
+func (x *DeleteUploadSessionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("id", x.Id)
+	return nil
+}
@@ -22,53 +22,73 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	API_CreateRepo_FullMethodName       = "/pfs_v2.API/CreateRepo"
-	API_InspectRepo_FullMethodName      = "/pfs_v2.API/InspectRepo"
-	API_ListRepo_FullMethodName         = "/pfs_v2.API/ListRepo"
-	API_DeleteRepo_FullMethodName       = "/pfs_v2.API/DeleteRepo"
-	API_DeleteRepos_FullMethodName      = "/pfs_v2.API/DeleteRepos"
-	API_StartCommit_FullMethodName      = "/pfs_v2.API/StartCommit"
-	API_FinishCommit_FullMethodName     = "/pfs_v2.API/FinishCommit"
-	API_ClearCommit_FullMethodName      = "/pfs_v2.API/ClearCommit"
-	API_InspectCommit_FullMethodName    = "/pfs_v2.API/InspectCommit"
-	API_ListCommit_FullMethodName       = "/pfs_v2.API/ListCommit"
-	API_SubscribeCommit_FullMethodName  = "/pfs_v2.API/SubscribeCommit"
-	API_InspectCommitSet_FullMethodName = "/pfs_v2.API/InspectCommitSet"
-	API_ListCommitSet_FullMethodName    = "/pfs_v2.API/ListCommitSet"
-	API_SquashCommitSet_FullMethodName  = "/pfs_v2.API/SquashCommitSet"
-	API_DropCommitSet_FullMethodName    = "/pfs_v2.API/DropCommitSet"
-	API_FindCommits_FullMethodName      = "/pfs_v2.API/FindCommits"
-	API_CreateBranch_FullMethodName     = "/pfs_v2.API/CreateBranch"
-	API_InspectBranch_FullMethodName    = "/pfs_v2.API/InspectBranch"
-	API_ListBranch_FullMethodName       = "/pfs_v2.API/ListBranch"
-	API_DeleteBranch_FullMethodName     = "/pfs_v2.API/DeleteBranch"
-	API_ModifyFile_FullMethodName       = "/pfs_v2.API/ModifyFile"
-	API_GetFile_FullMethodName          = "/pfs_v2.API/GetFile"
-	API_GetFileTAR_FullMethodName       = "/pfs_v2.API/GetFileTAR"
-	API_InspectFile_FullMethodName      = "/pfs_v2.API/InspectFile"
-	API_ListFile_FullMethodName         = "/pfs_v2.API/ListFile"
-	API_WalkFile_FullMethodName         = "/pfs_v2.API/WalkFile"
-	API_GlobFile_FullMethodName         = "/pfs_v2.API/GlobFile"
-	API_DiffFile_FullMethodName         = "/pfs_v2.API/DiffFile"
-	API_ActivateAuth_FullMethodName     = "/pfs_v2.API/ActivateAuth"
-	API_DeleteAll_FullMethodName        = "/pfs_v2.API/DeleteAll"
-	API_Fsck_FullMethodName             = "/pfs_v2.API/Fsck"
-	API_CreateFileSet_FullMethodName    = "/pfs_v2.API/CreateFileSet"
-	API_GetFileSet_FullMethodName       = "/pfs_v2.API/GetFileSet"
-	API_AddFileSet_FullMethodName       = "/pfs_v2.API/AddFileSet"
-	API_RenewFileSet_FullMethodName     = "/pfs_v2.API/RenewFileSet"
-	API_ComposeFileSet_FullMethodName   = "/pfs_v2.API/ComposeFileSet"
-	API_ShardFileSet_FullMethodName     = "/pfs_v2.API/ShardFileSet"
-	API_CheckStorage_FullMethodName     = "/pfs_v2.API/CheckStorage"
-	API_PutCache_FullMethodName         = "/pfs_v2.API/PutCache"
-	API_GetCache_FullMethodName         = "/pfs_v2.API/GetCache"
-	API_ClearCache_FullMethodName       = "/pfs_v2.API/ClearCache"
-	API_ListTask_FullMethodName         = "/pfs_v2.API/ListTask"
-	API_Egress_FullMethodName           = "/pfs_v2.API/Egress"
-	API_CreateProject_FullMethodName    = "/pfs_v2.API/CreateProject"
-	API_InspectProject_FullMethodName   = "/pfs_v2.API/InspectProject"
-	API_ListProject_FullMethodName      = "/pfs_v2.API/ListProject"
-	API_DeleteProject_FullMethodName    = "/pfs_v2.API/DeleteProject"
+	API_CreateRepo_FullMethodName             = "/pfs_v2.API/CreateRepo"
+	API_InspectRepo_FullMethodName            = "/pfs_v2.API/InspectRepo"
+	API_ListRepo_FullMethodName               = "/pfs_v2.API/ListRepo"
+	API_DeleteRepo_FullMethodName             = "/pfs_v2.API/DeleteRepo"
+	API_DeleteRepos_FullMethodName            = "/pfs_v2.API/DeleteRepos"
+	API_StartCommit_FullMethodName            = "/pfs_v2.API/StartCommit"
+	API_FinishCommit_FullMethodName           = "/pfs_v2.API/FinishCommit"
+	API_ClearCommit_FullMethodName            = "/pfs_v2.API/ClearCommit"
+	API_ApproveCommit_FullMethodName          = "/pfs_v2.API/ApproveCommit"
+	API_InspectCommit_FullMethodName          = "/pfs_v2.API/InspectCommit"
+	API_ListCommit_FullMethodName             = "/pfs_v2.API/ListCommit"
+	API_SubscribeCommit_FullMethodName        = "/pfs_v2.API/SubscribeCommit"
+	API_InspectCommitSet_FullMethodName       = "/pfs_v2.API/InspectCommitSet"
+	API_ListCommitSet_FullMethodName          = "/pfs_v2.API/ListCommitSet"
+	API_SquashCommitSet_FullMethodName        = "/pfs_v2.API/SquashCommitSet"
+	API_DropCommitSet_FullMethodName          = "/pfs_v2.API/DropCommitSet"
+	API_FindCommits_FullMethodName            = "/pfs_v2.API/FindCommits"
+	API_CreateBranch_FullMethodName           = "/pfs_v2.API/CreateBranch"
+	API_InspectBranch_FullMethodName          = "/pfs_v2.API/InspectBranch"
+	API_ListBranch_FullMethodName             = "/pfs_v2.API/ListBranch"
+	API_DeleteBranch_FullMethodName           = "/pfs_v2.API/DeleteBranch"
+	API_ModifyFile_FullMethodName             = "/pfs_v2.API/ModifyFile"
+	API_GetFile_FullMethodName                = "/pfs_v2.API/GetFile"
+	API_GetFileTAR_FullMethodName             = "/pfs_v2.API/GetFileTAR"
+	API_InspectFile_FullMethodName            = "/pfs_v2.API/InspectFile"
+	API_ListFile_FullMethodName               = "/pfs_v2.API/ListFile"
+	API_WalkFile_FullMethodName               = "/pfs_v2.API/WalkFile"
+	API_GlobFile_FullMethodName               = "/pfs_v2.API/GlobFile"
+	API_DiffFile_FullMethodName               = "/pfs_v2.API/DiffFile"
+	API_ActivateAuth_FullMethodName           = "/pfs_v2.API/ActivateAuth"
+	API_DeleteAll_FullMethodName              = "/pfs_v2.API/DeleteAll"
+	API_Fsck_FullMethodName                   = "/pfs_v2.API/Fsck"
+	API_CreateFileSet_FullMethodName          = "/pfs_v2.API/CreateFileSet"
+	API_GetFileSet_FullMethodName             = "/pfs_v2.API/GetFileSet"
+	API_AddFileSet_FullMethodName             = "/pfs_v2.API/AddFileSet"
+	API_RenewFileSet_FullMethodName           = "/pfs_v2.API/RenewFileSet"
+	API_ComposeFileSet_FullMethodName         = "/pfs_v2.API/ComposeFileSet"
+	API_ShardFileSet_FullMethodName           = "/pfs_v2.API/ShardFileSet"
+	API_CheckStorage_FullMethodName           = "/pfs_v2.API/CheckStorage"
+	API_PutCache_FullMethodName               = "/pfs_v2.API/PutCache"
+	API_GetCache_FullMethodName               = "/pfs_v2.API/GetCache"
+	API_ClearCache_FullMethodName             = "/pfs_v2.API/ClearCache"
+	API_ListTask_FullMethodName               = "/pfs_v2.API/ListTask"
+	API_Egress_FullMethodName                 = "/pfs_v2.API/Egress"
+	API_CreateProject_FullMethodName          = "/pfs_v2.API/CreateProject"
+	API_InspectProject_FullMethodName         = "/pfs_v2.API/InspectProject"
+	API_ListProject_FullMethodName            = "/pfs_v2.API/ListProject"
+	API_DeleteProject_FullMethodName          = "/pfs_v2.API/DeleteProject"
+	API_CreateReplication_FullMethodName      = "/pfs_v2.API/CreateReplication"
+	API_InspectReplication_FullMethodName     = "/pfs_v2.API/InspectReplication"
+	API_ListReplication_FullMethodName        = "/pfs_v2.API/ListReplication"
+	API_DeleteReplication_FullMethodName      = "/pfs_v2.API/DeleteReplication"
+	API_MissingChunks_FullMethodName          = "/pfs_v2.API/MissingChunks"
+	API_ReplicateFileSet_FullMethodName       = "/pfs_v2.API/ReplicateFileSet"
+	API_SetRetentionPolicy_FullMethodName     = "/pfs_v2.API/SetRetentionPolicy"
+	API_ListRetentionPolicy_FullMethodName    = "/pfs_v2.API/ListRetentionPolicy"
+	API_EnforceRetentionPolicy_FullMethodName = "/pfs_v2.API/EnforceRetentionPolicy"
+	API_SetCommitValidation_FullMethodName    = "/pfs_v2.API/SetCommitValidation"
+	API_ListCommitValidation_FullMethodName   = "/pfs_v2.API/ListCommitValidation"
+	API_SetStoragePlacement_FullMethodName    = "/pfs_v2.API/SetStoragePlacement"
+	API_ListStoragePlacement_FullMethodName   = "/pfs_v2.API/ListStoragePlacement"
+	API_CreateUploadSession_FullMethodName    = "/pfs_v2.API/CreateUploadSession"
+	API_InspectUploadSession_FullMethodName   = "/pfs_v2.API/InspectUploadSession"
+	API_ListUploadSession_FullMethodName      = "/pfs_v2.API/ListUploadSession"
+	API_UploadPart_FullMethodName             = "/pfs_v2.API/UploadPart"
+	API_CommitUploadSession_FullMethodName    = "/pfs_v2.API/CommitUploadSession"
+	API_DeleteUploadSession_FullMethodName    = "/pfs_v2.API/DeleteUploadSession"
 )
 
 // APIClient is the client API for API service.
@@ -96,6 +116,9 @@ type APIClient interface {
 	FinishCommit(ctx context.Context, in *FinishCommitRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ClearCommit removes all data from the commit.
 	ClearCommit(ctx context.Context, in *ClearCommitRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ApproveCommit approves a commit, so that it can fire the triggers which
+	// require approval.
+	ApproveCommit(ctx context.Context, in *ApproveCommitRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// InspectCommit returns the info about a commit.
 	InspectCommit(ctx context.Context, in *InspectCommitRequest, opts ...grpc.CallOption) (*CommitInfo, error)
 	// ListCommit returns info about all commits.
@@ -172,6 +195,55 @@ type APIClient interface {
 	ListProject(ctx context.Context, in *ListProjectRequest, opts ...grpc.CallOption) (API_ListProjectClient, error)
 	// DeleteProject deletes a project.
 	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Replication API
+	// CreateReplication creates a new replication, or updates an existing one.
+	CreateReplication(ctx context.Context, in *CreateReplicationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// InspectReplication returns info about a replication.
+	InspectReplication(ctx context.Context, in *InspectReplicationRequest, opts ...grpc.CallOption) (*ReplicationInfo, error)
+	// ListReplication returns info about all replications.
+	ListReplication(ctx context.Context, in *ListReplicationRequest, opts ...grpc.CallOption) (API_ListReplicationClient, error)
+	// DeleteReplication deletes a replication.
+	DeleteReplication(ctx context.Context, in *DeleteReplicationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// MissingChunks returns the subset of the requested chunks which are not
+	// present in this cluster's storage.  It is called by replication sources.
+	MissingChunks(ctx context.Context, in *MissingChunksRequest, opts ...grpc.CallOption) (*MissingChunksResponse, error)
+	// ReplicateFileSet creates a file set from chunks and primitive file sets
+	// copied from another cluster.  It is called by replication sources.
+	ReplicateFileSet(ctx context.Context, opts ...grpc.CallOption) (API_ReplicateFileSetClient, error)
+	// Retention API
+	// SetRetentionPolicy sets or removes the retention policy of a repo or branch.
+	SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListRetentionPolicy returns all retention policies.
+	ListRetentionPolicy(ctx context.Context, in *ListRetentionPolicyRequest, opts ...grpc.CallOption) (API_ListRetentionPolicyClient, error)
+	// EnforceRetentionPolicy squashes the commits which are not kept by retention policies.
+	EnforceRetentionPolicy(ctx context.Context, in *EnforceRetentionPolicyRequest, opts ...grpc.CallOption) (API_EnforceRetentionPolicyClient, error)
+	// Commit validation API
+	// SetCommitValidation sets or removes the contract that commits to a repo
+	// must satisfy.
+	SetCommitValidation(ctx context.Context, in *SetCommitValidationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListCommitValidation returns all commit validations.
+	ListCommitValidation(ctx context.Context, in *ListCommitValidationRequest, opts ...grpc.CallOption) (API_ListCommitValidationClient, error)
+	// Storage placement API
+	// SetStoragePlacement sets or removes the storage tiers that a repo's
+	// chunks are placed on.
+	SetStoragePlacement(ctx context.Context, in *SetStoragePlacementRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListStoragePlacement returns all storage placements.
+	ListStoragePlacement(ctx context.Context, in *ListStoragePlacementRequest, opts ...grpc.CallOption) (API_ListStoragePlacementClient, error)
+	// Upload session API
+	// CreateUploadSession starts a resumable upload of a file.
+	CreateUploadSession(ctx context.Context, in *CreateUploadSessionRequest, opts ...grpc.CallOption) (*UploadSessionInfo, error)
+	// InspectUploadSession returns info about an upload session, including the
+	// parts which have been uploaded.
+	InspectUploadSession(ctx context.Context, in *InspectUploadSessionRequest, opts ...grpc.CallOption) (*UploadSessionInfo, error)
+	// ListUploadSession returns info about all unexpired upload sessions.
+	ListUploadSession(ctx context.Context, in *ListUploadSessionRequest, opts ...grpc.CallOption) (API_ListUploadSessionClient, error)
+	// UploadPart uploads a part of an upload session.
+	UploadPart(ctx context.Context, opts ...grpc.CallOption) (API_UploadPartClient, error)
+	// CommitUploadSession writes the parts of an upload session to its file,
+	// in a single commit, and deletes the session.
+	CommitUploadSession(ctx context.Context, in *CommitUploadSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// DeleteUploadSession abandons an upload session.
+	DeleteUploadSession(ctx context.Context, in *DeleteUploadSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type aPIClient struct {
@@ -277,6 +349,15 @@ func (c *aPIClient) ClearCommit(ctx context.Context, in *ClearCommitRequest, opt
 	return out, nil
 }
 
+func (c *aPIClient) ApproveCommit(ctx context.Context, in *ApproveCommitRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, API_ApproveCommit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) InspectCommit(ctx context.Context, in *InspectCommitRequest, opts ...grpc.CallOption) (*CommitInfo, error) {
 	out := new(CommitInfo)
 	err := c.cc.Invoke(ctx, API_InspectCommit_FullMethodName, in, out, opts...)
@@ -1023,1174 +1104,2039 @@ func (c *aPIClient) DeleteProject(ctx context.Context, in *DeleteProjectRequest,
 	return out, nil
 }
 
-// APIServer is the server API for API service.
-// All implementations must embed UnimplementedAPIServer
-// for forward compatibility
-type APIServer interface {
-	// CreateRepo creates a new repo.
-	CreateRepo(context.Context, *CreateRepoRequest) (*emptypb.Empty, error)
-	// InspectRepo returns info about a repo.
-	InspectRepo(context.Context, *InspectRepoRequest) (*RepoInfo, error)
-	// ListRepo returns info about all repos.
-	ListRepo(*ListRepoRequest, API_ListRepoServer) error
-	// DeleteRepo deletes a repo.
-	DeleteRepo(context.Context, *DeleteRepoRequest) (*DeleteRepoResponse, error)
-	// DeleteRepos deletes more than one repo at once.  It attempts to
-	// delete every repo matching the DeleteReposRequest.  When deleting
-	// all repos matching a project, any repos not deletable by the
-	// caller will remain, and the project will not be empty; this is
-	// not an error.  The returned DeleteReposResponse will contain a
-	// list of all actually-deleted repos.
-	DeleteRepos(context.Context, *DeleteReposRequest) (*DeleteReposResponse, error)
-	// StartCommit creates a new write commit from a parent commit.
-	StartCommit(context.Context, *StartCommitRequest) (*Commit, error)
-	// FinishCommit turns a write commit into a read commit.
-	FinishCommit(context.Context, *FinishCommitRequest) (*emptypb.Empty, error)
-	// ClearCommit removes all data from the commit.
-	ClearCommit(context.Context, *ClearCommitRequest) (*emptypb.Empty, error)
-	// InspectCommit returns the info about a commit.
-	InspectCommit(context.Context, *InspectCommitRequest) (*CommitInfo, error)
-	// ListCommit returns info about all commits.
-	ListCommit(*ListCommitRequest, API_ListCommitServer) error
-	// SubscribeCommit subscribes for new commits on a given branch.
-	SubscribeCommit(*SubscribeCommitRequest, API_SubscribeCommitServer) error
-	// InspectCommitSet returns the info about a CommitSet.
-	InspectCommitSet(*InspectCommitSetRequest, API_InspectCommitSetServer) error
-	// ListCommitSet returns info about all CommitSets.
-	ListCommitSet(*ListCommitSetRequest, API_ListCommitSetServer) error
-	// SquashCommitSet squashes the commits of a CommitSet into their children.
-	SquashCommitSet(context.Context, *SquashCommitSetRequest) (*emptypb.Empty, error)
-	// DropCommitSet drops the commits of a CommitSet and all data included in the commits.
-	DropCommitSet(context.Context, *DropCommitSetRequest) (*emptypb.Empty, error)
-	// FindCommits searches for commits that reference a supplied file being modified in a branch.
-	FindCommits(*FindCommitsRequest, API_FindCommitsServer) error
-	// CreateBranch creates a new branch.
-	CreateBranch(context.Context, *CreateBranchRequest) (*emptypb.Empty, error)
-	// InspectBranch returns info about a branch.
-	InspectBranch(context.Context, *InspectBranchRequest) (*BranchInfo, error)
-	// ListBranch returns info about the heads of branches.
-	ListBranch(*ListBranchRequest, API_ListBranchServer) error
-	// DeleteBranch deletes a branch; note that the commits still exist.
-	DeleteBranch(context.Context, *DeleteBranchRequest) (*emptypb.Empty, error)
-	// ModifyFile performs modifications on a set of files.
-	ModifyFile(API_ModifyFileServer) error
-	// GetFile returns the contents of a single file
-	GetFile(*GetFileRequest, API_GetFileServer) error
-	// GetFileTAR returns a TAR stream of the contents matched by the request
-	GetFileTAR(*GetFileRequest, API_GetFileTARServer) error
-	// InspectFile returns info about a file.
-	InspectFile(context.Context, *InspectFileRequest) (*FileInfo, error)
-	// ListFile returns info about all files.
-	ListFile(*ListFileRequest, API_ListFileServer) error
-	// WalkFile walks over all the files under a directory, including children of children.
-	WalkFile(*WalkFileRequest, API_WalkFileServer) error
-	// GlobFile returns info about all files.
-	GlobFile(*GlobFileRequest, API_GlobFileServer) error
-	// DiffFile returns the differences between 2 paths at 2 commits.
-	DiffFile(*DiffFileRequest, API_DiffFileServer) error
-	// ActivateAuth creates a role binding for all existing repos
-	ActivateAuth(context.Context, *ActivateAuthRequest) (*ActivateAuthResponse, error)
-	// DeleteAll deletes everything.
-	DeleteAll(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
-	// Fsck does a file system consistency check for pfs.
-	Fsck(*FsckRequest, API_FsckServer) error
-	// FileSet API
-	// CreateFileSet creates a new file set.
-	CreateFileSet(API_CreateFileSetServer) error
-	// GetFileSet returns a file set with the data from a commit
-	GetFileSet(context.Context, *GetFileSetRequest) (*CreateFileSetResponse, error)
-	// AddFileSet associates a file set with a commit
-	AddFileSet(context.Context, *AddFileSetRequest) (*emptypb.Empty, error)
-	// RenewFileSet prevents a file set from being deleted for a set amount of time.
-	RenewFileSet(context.Context, *RenewFileSetRequest) (*emptypb.Empty, error)
-	// ComposeFileSet composes a file set from a list of file sets.
-	ComposeFileSet(context.Context, *ComposeFileSetRequest) (*CreateFileSetResponse, error)
-	ShardFileSet(context.Context, *ShardFileSetRequest) (*ShardFileSetResponse, error)
-	// CheckStorage runs integrity checks for the storage layer.
-	CheckStorage(context.Context, *CheckStorageRequest) (*CheckStorageResponse, error)
-	PutCache(context.Context, *PutCacheRequest) (*emptypb.Empty, error)
-	GetCache(context.Context, *GetCacheRequest) (*GetCacheResponse, error)
-	ClearCache(context.Context, *ClearCacheRequest) (*emptypb.Empty, error)
-	// ListTask lists PFS tasks
-	ListTask(*task.ListTaskRequest, API_ListTaskServer) error
-	// Egress writes data from a commit to an external system
-	Egress(context.Context, *EgressRequest) (*EgressResponse, error)
-	// Project API
-	// CreateProject creates a new project.
-	CreateProject(context.Context, *CreateProjectRequest) (*emptypb.Empty, error)
-	// InspectProject returns info about a project.
-	InspectProject(context.Context, *InspectProjectRequest) (*ProjectInfo, error)
-	// ListProject returns info about all projects.
-	ListProject(*ListProjectRequest, API_ListProjectServer) error
-	// DeleteProject deletes a project.
-	DeleteProject(context.Context, *DeleteProjectRequest) (*emptypb.Empty, error)
-	mustEmbedUnimplementedAPIServer()
+func (c *aPIClient) CreateReplication(ctx context.Context, in *CreateReplicationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, API_CreateReplication_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedAPIServer must be embedded to have forward compatible implementations.
-type UnimplementedAPIServer struct {
+func (c *aPIClient) InspectReplication(ctx context.Context, in *InspectReplicationRequest, opts ...grpc.CallOption) (*ReplicationInfo, error) {
+	out := new(ReplicationInfo)
+	err := c.cc.Invoke(ctx, API_InspectReplication_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedAPIServer) CreateRepo(context.Context, *CreateRepoRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRepo not implemented")
-}
-func (UnimplementedAPIServer) InspectRepo(context.Context, *InspectRepoRequest) (*RepoInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InspectRepo not implemented")
-}
-func (UnimplementedAPIServer) ListRepo(*ListRepoRequest, API_ListRepoServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListRepo not implemented")
+func (c *aPIClient) ListReplication(ctx context.Context, in *ListReplicationRequest, opts ...grpc.CallOption) (API_ListReplicationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[18], API_ListReplication_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIListReplicationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedAPIServer) DeleteRepo(context.Context, *DeleteRepoRequest) (*DeleteRepoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRepo not implemented")
+
+type API_ListReplicationClient interface {
+	Recv() (*ReplicationInfo, error)
+	grpc.ClientStream
 }
-func (UnimplementedAPIServer) DeleteRepos(context.Context, *DeleteReposRequest) (*DeleteReposResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRepos not implemented")
+
+type aPIListReplicationClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedAPIServer) StartCommit(context.Context, *StartCommitRequest) (*Commit, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartCommit not implemented")
+
+func (x *aPIListReplicationClient) Recv() (*ReplicationInfo, error) {
+	m := new(ReplicationInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedAPIServer) FinishCommit(context.Context, *FinishCommitRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinishCommit not implemented")
+
+func (c *aPIClient) DeleteReplication(ctx context.Context, in *DeleteReplicationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, API_DeleteReplication_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAPIServer) ClearCommit(context.Context, *ClearCommitRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClearCommit not implemented")
+
+func (c *aPIClient) MissingChunks(ctx context.Context, in *MissingChunksRequest, opts ...grpc.CallOption) (*MissingChunksResponse, error) {
+	out := new(MissingChunksResponse)
+	err := c.cc.Invoke(ctx, API_MissingChunks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAPIServer) InspectCommit(context.Context, *InspectCommitRequest) (*CommitInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InspectCommit not implemented")
+
+func (c *aPIClient) ReplicateFileSet(ctx context.Context, opts ...grpc.CallOption) (API_ReplicateFileSetClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[19], API_ReplicateFileSet_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIReplicateFileSetClient{stream}
+	return x, nil
 }
-func (UnimplementedAPIServer) ListCommit(*ListCommitRequest, API_ListCommitServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListCommit not implemented")
+
+type API_ReplicateFileSetClient interface {
+	Send(*ReplicateFileSetRequest) error
+	CloseAndRecv() (*CreateFileSetResponse, error)
+	grpc.ClientStream
 }
-func (UnimplementedAPIServer) SubscribeCommit(*SubscribeCommitRequest, API_SubscribeCommitServer) error {
-	return status.Errorf(codes.Unimplemented, "method SubscribeCommit not implemented")
+
+type aPIReplicateFileSetClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedAPIServer) InspectCommitSet(*InspectCommitSetRequest, API_InspectCommitSetServer) error {
-	return status.Errorf(codes.Unimplemented, "method InspectCommitSet not implemented")
+
+func (x *aPIReplicateFileSetClient) Send(m *ReplicateFileSetRequest) error {
+	return x.ClientStream.SendMsg(m)
 }
-func (UnimplementedAPIServer) ListCommitSet(*ListCommitSetRequest, API_ListCommitSetServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListCommitSet not implemented")
+
+func (x *aPIReplicateFileSetClient) CloseAndRecv() (*CreateFileSetResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(CreateFileSetResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedAPIServer) SquashCommitSet(context.Context, *SquashCommitSetRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SquashCommitSet not implemented")
+
+func (c *aPIClient) SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, API_SetRetentionPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAPIServer) DropCommitSet(context.Context, *DropCommitSetRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DropCommitSet not implemented")
+
+func (c *aPIClient) ListRetentionPolicy(ctx context.Context, in *ListRetentionPolicyRequest, opts ...grpc.CallOption) (API_ListRetentionPolicyClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[20], API_ListRetentionPolicy_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIListRetentionPolicyClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedAPIServer) FindCommits(*FindCommitsRequest, API_FindCommitsServer) error {
-	return status.Errorf(codes.Unimplemented, "method FindCommits not implemented")
+
+type API_ListRetentionPolicyClient interface {
+	Recv() (*RetentionPolicyInfo, error)
+	grpc.ClientStream
 }
-func (UnimplementedAPIServer) CreateBranch(context.Context, *CreateBranchRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBranch not implemented")
+
+type aPIListRetentionPolicyClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedAPIServer) InspectBranch(context.Context, *InspectBranchRequest) (*BranchInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InspectBranch not implemented")
+
+func (x *aPIListRetentionPolicyClient) Recv() (*RetentionPolicyInfo, error) {
+	m := new(RetentionPolicyInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedAPIServer) ListBranch(*ListBranchRequest, API_ListBranchServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListBranch not implemented")
+
+func (c *aPIClient) EnforceRetentionPolicy(ctx context.Context, in *EnforceRetentionPolicyRequest, opts ...grpc.CallOption) (API_EnforceRetentionPolicyClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[21], API_EnforceRetentionPolicy_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIEnforceRetentionPolicyClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedAPIServer) DeleteBranch(context.Context, *DeleteBranchRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteBranch not implemented")
+
+type API_EnforceRetentionPolicyClient interface {
+	Recv() (*RetentionAction, error)
+	grpc.ClientStream
 }
-func (UnimplementedAPIServer) ModifyFile(API_ModifyFileServer) error {
-	return status.Errorf(codes.Unimplemented, "method ModifyFile not implemented")
+
+type aPIEnforceRetentionPolicyClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIEnforceRetentionPolicyClient) Recv() (*RetentionAction, error) {
+	m := new(RetentionAction)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) SetCommitValidation(ctx context.Context, in *SetCommitValidationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, API_SetCommitValidation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListCommitValidation(ctx context.Context, in *ListCommitValidationRequest, opts ...grpc.CallOption) (API_ListCommitValidationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[22], API_ListCommitValidation_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIListCommitValidationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_ListCommitValidationClient interface {
+	Recv() (*CommitValidationInfo, error)
+	grpc.ClientStream
+}
+
+type aPIListCommitValidationClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIListCommitValidationClient) Recv() (*CommitValidationInfo, error) {
+	m := new(CommitValidationInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) SetStoragePlacement(ctx context.Context, in *SetStoragePlacementRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, API_SetStoragePlacement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListStoragePlacement(ctx context.Context, in *ListStoragePlacementRequest, opts ...grpc.CallOption) (API_ListStoragePlacementClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[23], API_ListStoragePlacement_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIListStoragePlacementClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_ListStoragePlacementClient interface {
+	Recv() (*StoragePlacementInfo, error)
+	grpc.ClientStream
+}
+
+type aPIListStoragePlacementClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIListStoragePlacementClient) Recv() (*StoragePlacementInfo, error) {
+	m := new(StoragePlacementInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) CreateUploadSession(ctx context.Context, in *CreateUploadSessionRequest, opts ...grpc.CallOption) (*UploadSessionInfo, error) {
+	out := new(UploadSessionInfo)
+	err := c.cc.Invoke(ctx, API_CreateUploadSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) InspectUploadSession(ctx context.Context, in *InspectUploadSessionRequest, opts ...grpc.CallOption) (*UploadSessionInfo, error) {
+	out := new(UploadSessionInfo)
+	err := c.cc.Invoke(ctx, API_InspectUploadSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListUploadSession(ctx context.Context, in *ListUploadSessionRequest, opts ...grpc.CallOption) (API_ListUploadSessionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[24], API_ListUploadSession_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIListUploadSessionClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_ListUploadSessionClient interface {
+	Recv() (*UploadSessionInfo, error)
+	grpc.ClientStream
+}
+
+type aPIListUploadSessionClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIListUploadSessionClient) Recv() (*UploadSessionInfo, error) {
+	m := new(UploadSessionInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) UploadPart(ctx context.Context, opts ...grpc.CallOption) (API_UploadPartClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[25], API_UploadPart_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIUploadPartClient{stream}
+	return x, nil
+}
+
+type API_UploadPartClient interface {
+	Send(*UploadPartRequest) error
+	CloseAndRecv() (*UploadPartInfo, error)
+	grpc.ClientStream
+}
+
+type aPIUploadPartClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIUploadPartClient) Send(m *UploadPartRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *aPIUploadPartClient) CloseAndRecv() (*UploadPartInfo, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadPartInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) CommitUploadSession(ctx context.Context, in *CommitUploadSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, API_CommitUploadSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeleteUploadSession(ctx context.Context, in *DeleteUploadSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, API_DeleteUploadSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// APIServer is the server API for API service.
+// All implementations must embed UnimplementedAPIServer
+// for forward compatibility
+type APIServer interface {
+	// CreateRepo creates a new repo.
+	CreateRepo(context.Context, *CreateRepoRequest) (*emptypb.Empty, error)
+	// InspectRepo returns info about a repo.
+	InspectRepo(context.Context, *InspectRepoRequest) (*RepoInfo, error)
+	// ListRepo returns info about all repos.
+	ListRepo(*ListRepoRequest, API_ListRepoServer) error
+	// DeleteRepo deletes a repo.
+	DeleteRepo(context.Context, *DeleteRepoRequest) (*DeleteRepoResponse, error)
+	// DeleteRepos deletes more than one repo at once.  It attempts to
+	// delete every repo matching the DeleteReposRequest.  When deleting
+	// all repos matching a project, any repos not deletable by the
+	// caller will remain, and the project will not be empty; this is
+	// not an error.  The returned DeleteReposResponse will contain a
+	// list of all actually-deleted repos.
+	DeleteRepos(context.Context, *DeleteReposRequest) (*DeleteReposResponse, error)
+	// StartCommit creates a new write commit from a parent commit.
+	StartCommit(context.Context, *StartCommitRequest) (*Commit, error)
+	// FinishCommit turns a write commit into a read commit.
+	FinishCommit(context.Context, *FinishCommitRequest) (*emptypb.Empty, error)
+	// ClearCommit removes all data from the commit.
+	ClearCommit(context.Context, *ClearCommitRequest) (*emptypb.Empty, error)
+	// ApproveCommit approves a commit, so that it can fire the triggers which
+	// require approval.
+	ApproveCommit(context.Context, *ApproveCommitRequest) (*emptypb.Empty, error)
+	// InspectCommit returns the info about a commit.
+	InspectCommit(context.Context, *InspectCommitRequest) (*CommitInfo, error)
+	// ListCommit returns info about all commits.
+	ListCommit(*ListCommitRequest, API_ListCommitServer) error
+	// SubscribeCommit subscribes for new commits on a given branch.
+	SubscribeCommit(*SubscribeCommitRequest, API_SubscribeCommitServer) error
+	// InspectCommitSet returns the info about a CommitSet.
+	InspectCommitSet(*InspectCommitSetRequest, API_InspectCommitSetServer) error
+	// ListCommitSet returns info about all CommitSets.
+	ListCommitSet(*ListCommitSetRequest, API_ListCommitSetServer) error
+	// SquashCommitSet squashes the commits of a CommitSet into their children.
+	SquashCommitSet(context.Context, *SquashCommitSetRequest) (*emptypb.Empty, error)
+	// DropCommitSet drops the commits of a CommitSet and all data included in the commits.
+	DropCommitSet(context.Context, *DropCommitSetRequest) (*emptypb.Empty, error)
+	// FindCommits searches for commits that reference a supplied file being modified in a branch.
+	FindCommits(*FindCommitsRequest, API_FindCommitsServer) error
+	// CreateBranch creates a new branch.
+	CreateBranch(context.Context, *CreateBranchRequest) (*emptypb.Empty, error)
+	// InspectBranch returns info about a branch.
+	InspectBranch(context.Context, *InspectBranchRequest) (*BranchInfo, error)
+	// ListBranch returns info about the heads of branches.
+	ListBranch(*ListBranchRequest, API_ListBranchServer) error
+	// DeleteBranch deletes a branch; note that the commits still exist.
+	DeleteBranch(context.Context, *DeleteBranchRequest) (*emptypb.Empty, error)
+	// ModifyFile performs modifications on a set of files.
+	ModifyFile(API_ModifyFileServer) error
+	// GetFile returns the contents of a single file
+	GetFile(*GetFileRequest, API_GetFileServer) error
+	// GetFileTAR returns a TAR stream of the contents matched by the request
+	GetFileTAR(*GetFileRequest, API_GetFileTARServer) error
+	// InspectFile returns info about a file.
+	InspectFile(context.Context, *InspectFileRequest) (*FileInfo, error)
+	// ListFile returns info about all files.
+	ListFile(*ListFileRequest, API_ListFileServer) error
+	// WalkFile walks over all the files under a directory, including children of children.
+	WalkFile(*WalkFileRequest, API_WalkFileServer) error
+	// GlobFile returns info about all files.
+	GlobFile(*GlobFileRequest, API_GlobFileServer) error
+	// DiffFile returns the differences between 2 paths at 2 commits.
+	DiffFile(*DiffFileRequest, API_DiffFileServer) error
+	// ActivateAuth creates a role binding for all existing repos
+	ActivateAuth(context.Context, *ActivateAuthRequest) (*ActivateAuthResponse, error)
+	// DeleteAll deletes everything.
+	DeleteAll(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Fsck does a file system consistency check for pfs.
+	Fsck(*FsckRequest, API_FsckServer) error
+	// FileSet API
+	// CreateFileSet creates a new file set.
+	CreateFileSet(API_CreateFileSetServer) error
+	// GetFileSet returns a file set with the data from a commit
+	GetFileSet(context.Context, *GetFileSetRequest) (*CreateFileSetResponse, error)
+	// AddFileSet associates a file set with a commit
+	AddFileSet(context.Context, *AddFileSetRequest) (*emptypb.Empty, error)
+	// RenewFileSet prevents a file set from being deleted for a set amount of time.
+	RenewFileSet(context.Context, *RenewFileSetRequest) (*emptypb.Empty, error)
+	// ComposeFileSet composes a file set from a list of file sets.
+	ComposeFileSet(context.Context, *ComposeFileSetRequest) (*CreateFileSetResponse, error)
+	ShardFileSet(context.Context, *ShardFileSetRequest) (*ShardFileSetResponse, error)
+	// CheckStorage runs integrity checks for the storage layer.
+	CheckStorage(context.Context, *CheckStorageRequest) (*CheckStorageResponse, error)
+	PutCache(context.Context, *PutCacheRequest) (*emptypb.Empty, error)
+	GetCache(context.Context, *GetCacheRequest) (*GetCacheResponse, error)
+	ClearCache(context.Context, *ClearCacheRequest) (*emptypb.Empty, error)
+	// ListTask lists PFS tasks
+	ListTask(*task.ListTaskRequest, API_ListTaskServer) error
+	// Egress writes data from a commit to an external system
+	Egress(context.Context, *EgressRequest) (*EgressResponse, error)
+	// Project API
+	// CreateProject creates a new project.
+	CreateProject(context.Context, *CreateProjectRequest) (*emptypb.Empty, error)
+	// InspectProject returns info about a project.
+	InspectProject(context.Context, *InspectProjectRequest) (*ProjectInfo, error)
+	// ListProject returns info about all projects.
+	ListProject(*ListProjectRequest, API_ListProjectServer) error
+	// DeleteProject deletes a project.
+	DeleteProject(context.Context, *DeleteProjectRequest) (*emptypb.Empty, error)
+	// Replication API
+	// CreateReplication creates a new replication, or updates an existing one.
+	CreateReplication(context.Context, *CreateReplicationRequest) (*emptypb.Empty, error)
+	// InspectReplication returns info about a replication.
+	InspectReplication(context.Context, *InspectReplicationRequest) (*ReplicationInfo, error)
+	// ListReplication returns info about all replications.
+	ListReplication(*ListReplicationRequest, API_ListReplicationServer) error
+	// DeleteReplication deletes a replication.
+	DeleteReplication(context.Context, *DeleteReplicationRequest) (*emptypb.Empty, error)
+	// MissingChunks returns the subset of the requested chunks which are not
+	// present in this cluster's storage.  It is called by replication sources.
+	MissingChunks(context.Context, *MissingChunksRequest) (*MissingChunksResponse, error)
+	// ReplicateFileSet creates a file set from chunks and primitive file sets
+	// copied from another cluster.  It is called by replication sources.
+	ReplicateFileSet(API_ReplicateFileSetServer) error
+	// Retention API
+	// SetRetentionPolicy sets or removes the retention policy of a repo or branch.
+	SetRetentionPolicy(context.Context, *SetRetentionPolicyRequest) (*emptypb.Empty, error)
+	// ListRetentionPolicy returns all retention policies.
+	ListRetentionPolicy(*ListRetentionPolicyRequest, API_ListRetentionPolicyServer) error
+	// EnforceRetentionPolicy squashes the commits which are not kept by retention policies.
+	EnforceRetentionPolicy(*EnforceRetentionPolicyRequest, API_EnforceRetentionPolicyServer) error
+	// Commit validation API
+	// SetCommitValidation sets or removes the contract that commits to a repo
+	// must satisfy.
+	SetCommitValidation(context.Context, *SetCommitValidationRequest) (*emptypb.Empty, error)
+	// ListCommitValidation returns all commit validations.
+	ListCommitValidation(*ListCommitValidationRequest, API_ListCommitValidationServer) error
+	// Storage placement API
+	// SetStoragePlacement sets or removes the storage tiers that a repo's
+	// chunks are placed on.
+	SetStoragePlacement(context.Context, *SetStoragePlacementRequest) (*emptypb.Empty, error)
+	// ListStoragePlacement returns all storage placements.
+	ListStoragePlacement(*ListStoragePlacementRequest, API_ListStoragePlacementServer) error
+	// Upload session API
+	// CreateUploadSession starts a resumable upload of a file.
+	CreateUploadSession(context.Context, *CreateUploadSessionRequest) (*UploadSessionInfo, error)
+	// InspectUploadSession returns info about an upload session, including the
+	// parts which have been uploaded.
+	InspectUploadSession(context.Context, *InspectUploadSessionRequest) (*UploadSessionInfo, error)
+	// ListUploadSession returns info about all unexpired upload sessions.
+	ListUploadSession(*ListUploadSessionRequest, API_ListUploadSessionServer) error
+	// UploadPart uploads a part of an upload session.
+	UploadPart(API_UploadPartServer) error
+	// CommitUploadSession writes the parts of an upload session to its file,
+	// in a single commit, and deletes the session.
+	CommitUploadSession(context.Context, *CommitUploadSessionRequest) (*emptypb.Empty, error)
+	// DeleteUploadSession abandons an upload session.
+	DeleteUploadSession(context.Context, *DeleteUploadSessionRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedAPIServer()
+}
+
+// UnimplementedAPIServer must be embedded to have forward compatible implementations.
+type UnimplementedAPIServer struct {
+}
+
+func (UnimplementedAPIServer) CreateRepo(context.Context, *CreateRepoRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRepo not implemented")
+}
+func (UnimplementedAPIServer) InspectRepo(context.Context, *InspectRepoRequest) (*RepoInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectRepo not implemented")
+}
+func (UnimplementedAPIServer) ListRepo(*ListRepoRequest, API_ListRepoServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListRepo not implemented")
+}
+func (UnimplementedAPIServer) DeleteRepo(context.Context, *DeleteRepoRequest) (*DeleteRepoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRepo not implemented")
+}
+func (UnimplementedAPIServer) DeleteRepos(context.Context, *DeleteReposRequest) (*DeleteReposResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRepos not implemented")
+}
+func (UnimplementedAPIServer) StartCommit(context.Context, *StartCommitRequest) (*Commit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartCommit not implemented")
+}
+func (UnimplementedAPIServer) FinishCommit(context.Context, *FinishCommitRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishCommit not implemented")
+}
+func (UnimplementedAPIServer) ClearCommit(context.Context, *ClearCommitRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearCommit not implemented")
+}
+func (UnimplementedAPIServer) ApproveCommit(context.Context, *ApproveCommitRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveCommit not implemented")
+}
+func (UnimplementedAPIServer) InspectCommit(context.Context, *InspectCommitRequest) (*CommitInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectCommit not implemented")
+}
+func (UnimplementedAPIServer) ListCommit(*ListCommitRequest, API_ListCommitServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListCommit not implemented")
+}
+func (UnimplementedAPIServer) SubscribeCommit(*SubscribeCommitRequest, API_SubscribeCommitServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeCommit not implemented")
+}
+func (UnimplementedAPIServer) InspectCommitSet(*InspectCommitSetRequest, API_InspectCommitSetServer) error {
+	return status.Errorf(codes.Unimplemented, "method InspectCommitSet not implemented")
+}
+func (UnimplementedAPIServer) ListCommitSet(*ListCommitSetRequest, API_ListCommitSetServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListCommitSet not implemented")
+}
+func (UnimplementedAPIServer) SquashCommitSet(context.Context, *SquashCommitSetRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SquashCommitSet not implemented")
+}
+func (UnimplementedAPIServer) DropCommitSet(context.Context, *DropCommitSetRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropCommitSet not implemented")
+}
+func (UnimplementedAPIServer) FindCommits(*FindCommitsRequest, API_FindCommitsServer) error {
+	return status.Errorf(codes.Unimplemented, "method FindCommits not implemented")
+}
+func (UnimplementedAPIServer) CreateBranch(context.Context, *CreateBranchRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBranch not implemented")
+}
+func (UnimplementedAPIServer) InspectBranch(context.Context, *InspectBranchRequest) (*BranchInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectBranch not implemented")
+}
+func (UnimplementedAPIServer) ListBranch(*ListBranchRequest, API_ListBranchServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListBranch not implemented")
+}
+func (UnimplementedAPIServer) DeleteBranch(context.Context, *DeleteBranchRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBranch not implemented")
+}
+func (UnimplementedAPIServer) ModifyFile(API_ModifyFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method ModifyFile not implemented")
 }
 func (UnimplementedAPIServer) GetFile(*GetFileRequest, API_GetFileServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetFile not implemented")
 }
-func (UnimplementedAPIServer) GetFileTAR(*GetFileRequest, API_GetFileTARServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetFileTAR not implemented")
+func (UnimplementedAPIServer) GetFileTAR(*GetFileRequest, API_GetFileTARServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetFileTAR not implemented")
+}
+func (UnimplementedAPIServer) InspectFile(context.Context, *InspectFileRequest) (*FileInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectFile not implemented")
+}
+func (UnimplementedAPIServer) ListFile(*ListFileRequest, API_ListFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListFile not implemented")
+}
+func (UnimplementedAPIServer) WalkFile(*WalkFileRequest, API_WalkFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method WalkFile not implemented")
+}
+func (UnimplementedAPIServer) GlobFile(*GlobFileRequest, API_GlobFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method GlobFile not implemented")
+}
+func (UnimplementedAPIServer) DiffFile(*DiffFileRequest, API_DiffFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method DiffFile not implemented")
+}
+func (UnimplementedAPIServer) ActivateAuth(context.Context, *ActivateAuthRequest) (*ActivateAuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateAuth not implemented")
+}
+func (UnimplementedAPIServer) DeleteAll(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAll not implemented")
+}
+func (UnimplementedAPIServer) Fsck(*FsckRequest, API_FsckServer) error {
+	return status.Errorf(codes.Unimplemented, "method Fsck not implemented")
+}
+func (UnimplementedAPIServer) CreateFileSet(API_CreateFileSetServer) error {
+	return status.Errorf(codes.Unimplemented, "method CreateFileSet not implemented")
+}
+func (UnimplementedAPIServer) GetFileSet(context.Context, *GetFileSetRequest) (*CreateFileSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileSet not implemented")
+}
+func (UnimplementedAPIServer) AddFileSet(context.Context, *AddFileSetRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFileSet not implemented")
+}
+func (UnimplementedAPIServer) RenewFileSet(context.Context, *RenewFileSetRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewFileSet not implemented")
+}
+func (UnimplementedAPIServer) ComposeFileSet(context.Context, *ComposeFileSetRequest) (*CreateFileSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComposeFileSet not implemented")
+}
+func (UnimplementedAPIServer) ShardFileSet(context.Context, *ShardFileSetRequest) (*ShardFileSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShardFileSet not implemented")
+}
+func (UnimplementedAPIServer) CheckStorage(context.Context, *CheckStorageRequest) (*CheckStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckStorage not implemented")
+}
+func (UnimplementedAPIServer) PutCache(context.Context, *PutCacheRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutCache not implemented")
+}
+func (UnimplementedAPIServer) GetCache(context.Context, *GetCacheRequest) (*GetCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCache not implemented")
+}
+func (UnimplementedAPIServer) ClearCache(context.Context, *ClearCacheRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearCache not implemented")
+}
+func (UnimplementedAPIServer) ListTask(*task.ListTaskRequest, API_ListTaskServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListTask not implemented")
+}
+func (UnimplementedAPIServer) Egress(context.Context, *EgressRequest) (*EgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Egress not implemented")
+}
+func (UnimplementedAPIServer) CreateProject(context.Context, *CreateProjectRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProject not implemented")
+}
+func (UnimplementedAPIServer) InspectProject(context.Context, *InspectProjectRequest) (*ProjectInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectProject not implemented")
+}
+func (UnimplementedAPIServer) ListProject(*ListProjectRequest, API_ListProjectServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListProject not implemented")
+}
+func (UnimplementedAPIServer) DeleteProject(context.Context, *DeleteProjectRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
+}
+func (UnimplementedAPIServer) CreateReplication(context.Context, *CreateReplicationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReplication not implemented")
+}
+func (UnimplementedAPIServer) InspectReplication(context.Context, *InspectReplicationRequest) (*ReplicationInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectReplication not implemented")
+}
+func (UnimplementedAPIServer) ListReplication(*ListReplicationRequest, API_ListReplicationServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListReplication not implemented")
+}
+func (UnimplementedAPIServer) DeleteReplication(context.Context, *DeleteReplicationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReplication not implemented")
+}
+func (UnimplementedAPIServer) MissingChunks(context.Context, *MissingChunksRequest) (*MissingChunksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MissingChunks not implemented")
+}
+func (UnimplementedAPIServer) ReplicateFileSet(API_ReplicateFileSetServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReplicateFileSet not implemented")
+}
+func (UnimplementedAPIServer) SetRetentionPolicy(context.Context, *SetRetentionPolicyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRetentionPolicy not implemented")
+}
+func (UnimplementedAPIServer) ListRetentionPolicy(*ListRetentionPolicyRequest, API_ListRetentionPolicyServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListRetentionPolicy not implemented")
+}
+func (UnimplementedAPIServer) EnforceRetentionPolicy(*EnforceRetentionPolicyRequest, API_EnforceRetentionPolicyServer) error {
+	return status.Errorf(codes.Unimplemented, "method EnforceRetentionPolicy not implemented")
+}
+func (UnimplementedAPIServer) SetCommitValidation(context.Context, *SetCommitValidationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCommitValidation not implemented")
+}
+func (UnimplementedAPIServer) ListCommitValidation(*ListCommitValidationRequest, API_ListCommitValidationServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListCommitValidation not implemented")
+}
+func (UnimplementedAPIServer) SetStoragePlacement(context.Context, *SetStoragePlacementRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStoragePlacement not implemented")
+}
+func (UnimplementedAPIServer) ListStoragePlacement(*ListStoragePlacementRequest, API_ListStoragePlacementServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListStoragePlacement not implemented")
+}
+func (UnimplementedAPIServer) CreateUploadSession(context.Context, *CreateUploadSessionRequest) (*UploadSessionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUploadSession not implemented")
+}
+func (UnimplementedAPIServer) InspectUploadSession(context.Context, *InspectUploadSessionRequest) (*UploadSessionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectUploadSession not implemented")
+}
+func (UnimplementedAPIServer) ListUploadSession(*ListUploadSessionRequest, API_ListUploadSessionServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListUploadSession not implemented")
+}
+func (UnimplementedAPIServer) UploadPart(API_UploadPartServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadPart not implemented")
+}
+func (UnimplementedAPIServer) CommitUploadSession(context.Context, *CommitUploadSessionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitUploadSession not implemented")
+}
+func (UnimplementedAPIServer) DeleteUploadSession(context.Context, *DeleteUploadSessionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUploadSession not implemented")
+}
+func (UnimplementedAPIServer) mustEmbedUnimplementedAPIServer() {}
+
+// UnsafeAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to APIServer will
+// result in compilation errors.
+type UnsafeAPIServer interface {
+	mustEmbedUnimplementedAPIServer()
+}
+
+func RegisterAPIServer(s grpc.ServiceRegistrar, srv APIServer) {
+	s.RegisterService(&API_ServiceDesc, srv)
+}
+
+func _API_CreateRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CreateRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateRepo(ctx, req.(*CreateRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_InspectRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).InspectRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_InspectRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectRepo(ctx, req.(*InspectRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListRepo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRepoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).ListRepo(m, &aPIListRepoServer{stream})
+}
+
+type API_ListRepoServer interface {
+	Send(*RepoInfo) error
+	grpc.ServerStream
+}
+
+type aPIListRepoServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListRepoServer) Send(m *RepoInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_DeleteRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DeleteRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteRepo(ctx, req.(*DeleteRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeleteRepos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReposRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteRepos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DeleteRepos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteRepos(ctx, req.(*DeleteReposRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_StartCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).StartCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_StartCommit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).StartCommit(ctx, req.(*StartCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_FinishCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).FinishCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_FinishCommit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).FinishCommit(ctx, req.(*FinishCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ClearCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ClearCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_ClearCommit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ClearCommit(ctx, req.(*ClearCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ApproveCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ApproveCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_ApproveCommit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ApproveCommit(ctx, req.(*ApproveCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_InspectCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).InspectCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_InspectCommit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectCommit(ctx, req.(*InspectCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListCommit_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListCommitRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).ListCommit(m, &aPIListCommitServer{stream})
+}
+
+type API_ListCommitServer interface {
+	Send(*CommitInfo) error
+	grpc.ServerStream
+}
+
+type aPIListCommitServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListCommitServer) Send(m *CommitInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_SubscribeCommit_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeCommitRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).SubscribeCommit(m, &aPISubscribeCommitServer{stream})
+}
+
+type API_SubscribeCommitServer interface {
+	Send(*CommitInfo) error
+	grpc.ServerStream
+}
+
+type aPISubscribeCommitServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPISubscribeCommitServer) Send(m *CommitInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_InspectCommitSet_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InspectCommitSetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).InspectCommitSet(m, &aPIInspectCommitSetServer{stream})
+}
+
+type API_InspectCommitSetServer interface {
+	Send(*CommitInfo) error
+	grpc.ServerStream
+}
+
+type aPIInspectCommitSetServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIInspectCommitSetServer) Send(m *CommitInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_ListCommitSet_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListCommitSetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).ListCommitSet(m, &aPIListCommitSetServer{stream})
+}
+
+type API_ListCommitSetServer interface {
+	Send(*CommitSetInfo) error
+	grpc.ServerStream
+}
+
+type aPIListCommitSetServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListCommitSetServer) Send(m *CommitSetInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_SquashCommitSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SquashCommitSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SquashCommitSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_SquashCommitSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SquashCommitSet(ctx, req.(*SquashCommitSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DropCommitSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropCommitSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DropCommitSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DropCommitSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DropCommitSet(ctx, req.(*DropCommitSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_FindCommits_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FindCommitsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).FindCommits(m, &aPIFindCommitsServer{stream})
+}
+
+type API_FindCommitsServer interface {
+	Send(*FindCommitsResponse) error
+	grpc.ServerStream
+}
+
+type aPIFindCommitsServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIFindCommitsServer) Send(m *FindCommitsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_CreateBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CreateBranch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateBranch(ctx, req.(*CreateBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_InspectBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).InspectBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_InspectBranch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectBranch(ctx, req.(*InspectBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAPIServer) InspectFile(context.Context, *InspectFileRequest) (*FileInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InspectFile not implemented")
+
+func _API_ListBranch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListBranchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).ListBranch(m, &aPIListBranchServer{stream})
 }
-func (UnimplementedAPIServer) ListFile(*ListFileRequest, API_ListFileServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListFile not implemented")
+
+type API_ListBranchServer interface {
+	Send(*BranchInfo) error
+	grpc.ServerStream
 }
-func (UnimplementedAPIServer) WalkFile(*WalkFileRequest, API_WalkFileServer) error {
-	return status.Errorf(codes.Unimplemented, "method WalkFile not implemented")
+
+type aPIListBranchServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedAPIServer) GlobFile(*GlobFileRequest, API_GlobFileServer) error {
-	return status.Errorf(codes.Unimplemented, "method GlobFile not implemented")
+
+func (x *aPIListBranchServer) Send(m *BranchInfo) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedAPIServer) DiffFile(*DiffFileRequest, API_DiffFileServer) error {
-	return status.Errorf(codes.Unimplemented, "method DiffFile not implemented")
+
+func _API_DeleteBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DeleteBranch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteBranch(ctx, req.(*DeleteBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAPIServer) ActivateAuth(context.Context, *ActivateAuthRequest) (*ActivateAuthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActivateAuth not implemented")
+
+func _API_ModifyFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).ModifyFile(&aPIModifyFileServer{stream})
 }
-func (UnimplementedAPIServer) DeleteAll(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAll not implemented")
+
+type API_ModifyFileServer interface {
+	SendAndClose(*emptypb.Empty) error
+	Recv() (*ModifyFileRequest, error)
+	grpc.ServerStream
 }
-func (UnimplementedAPIServer) Fsck(*FsckRequest, API_FsckServer) error {
-	return status.Errorf(codes.Unimplemented, "method Fsck not implemented")
+
+type aPIModifyFileServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedAPIServer) CreateFileSet(API_CreateFileSetServer) error {
-	return status.Errorf(codes.Unimplemented, "method CreateFileSet not implemented")
+
+func (x *aPIModifyFileServer) SendAndClose(m *emptypb.Empty) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedAPIServer) GetFileSet(context.Context, *GetFileSetRequest) (*CreateFileSetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFileSet not implemented")
+
+func (x *aPIModifyFileServer) Recv() (*ModifyFileRequest, error) {
+	m := new(ModifyFileRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedAPIServer) AddFileSet(context.Context, *AddFileSetRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddFileSet not implemented")
+
+func _API_GetFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).GetFile(m, &aPIGetFileServer{stream})
 }
-func (UnimplementedAPIServer) RenewFileSet(context.Context, *RenewFileSetRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RenewFileSet not implemented")
+
+type API_GetFileServer interface {
+	Send(*wrapperspb.BytesValue) error
+	grpc.ServerStream
 }
-func (UnimplementedAPIServer) ComposeFileSet(context.Context, *ComposeFileSetRequest) (*CreateFileSetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ComposeFileSet not implemented")
+
+type aPIGetFileServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedAPIServer) ShardFileSet(context.Context, *ShardFileSetRequest) (*ShardFileSetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShardFileSet not implemented")
+
+func (x *aPIGetFileServer) Send(m *wrapperspb.BytesValue) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedAPIServer) CheckStorage(context.Context, *CheckStorageRequest) (*CheckStorageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckStorage not implemented")
+
+func _API_GetFileTAR_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).GetFileTAR(m, &aPIGetFileTARServer{stream})
 }
-func (UnimplementedAPIServer) PutCache(context.Context, *PutCacheRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PutCache not implemented")
+
+type API_GetFileTARServer interface {
+	Send(*wrapperspb.BytesValue) error
+	grpc.ServerStream
 }
-func (UnimplementedAPIServer) GetCache(context.Context, *GetCacheRequest) (*GetCacheResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCache not implemented")
+
+type aPIGetFileTARServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedAPIServer) ClearCache(context.Context, *ClearCacheRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClearCache not implemented")
+
+func (x *aPIGetFileTARServer) Send(m *wrapperspb.BytesValue) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedAPIServer) ListTask(*task.ListTaskRequest, API_ListTaskServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListTask not implemented")
+
+func _API_InspectFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).InspectFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_InspectFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectFile(ctx, req.(*InspectFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAPIServer) Egress(context.Context, *EgressRequest) (*EgressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Egress not implemented")
+
+func _API_ListFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).ListFile(m, &aPIListFileServer{stream})
 }
-func (UnimplementedAPIServer) CreateProject(context.Context, *CreateProjectRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateProject not implemented")
+
+type API_ListFileServer interface {
+	Send(*FileInfo) error
+	grpc.ServerStream
 }
-func (UnimplementedAPIServer) InspectProject(context.Context, *InspectProjectRequest) (*ProjectInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InspectProject not implemented")
+
+type aPIListFileServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedAPIServer) ListProject(*ListProjectRequest, API_ListProjectServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListProject not implemented")
+
+func (x *aPIListFileServer) Send(m *FileInfo) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedAPIServer) DeleteProject(context.Context, *DeleteProjectRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
+
+func _API_WalkFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WalkFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).WalkFile(m, &aPIWalkFileServer{stream})
 }
-func (UnimplementedAPIServer) mustEmbedUnimplementedAPIServer() {}
 
-// UnsafeAPIServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to APIServer will
-// result in compilation errors.
-type UnsafeAPIServer interface {
-	mustEmbedUnimplementedAPIServer()
+type API_WalkFileServer interface {
+	Send(*FileInfo) error
+	grpc.ServerStream
+}
+
+type aPIWalkFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIWalkFileServer) Send(m *FileInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_GlobFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GlobFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).GlobFile(m, &aPIGlobFileServer{stream})
+}
+
+type API_GlobFileServer interface {
+	Send(*FileInfo) error
+	grpc.ServerStream
+}
+
+type aPIGlobFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIGlobFileServer) Send(m *FileInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_DiffFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DiffFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).DiffFile(m, &aPIDiffFileServer{stream})
+}
+
+type API_DiffFileServer interface {
+	Send(*DiffFileResponse) error
+	grpc.ServerStream
+}
+
+type aPIDiffFileServer struct {
+	grpc.ServerStream
 }
 
-func RegisterAPIServer(s grpc.ServiceRegistrar, srv APIServer) {
-	s.RegisterService(&API_ServiceDesc, srv)
+func (x *aPIDiffFileServer) Send(m *DiffFileResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func _API_CreateRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRepoRequest)
+func _API_ActivateAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateAuthRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).CreateRepo(ctx, in)
+		return srv.(APIServer).ActivateAuth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: API_CreateRepo_FullMethodName,
+		FullMethod: API_ActivateAuth_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).CreateRepo(ctx, req.(*CreateRepoRequest))
+		return srv.(APIServer).ActivateAuth(ctx, req.(*ActivateAuthRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_InspectRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectRepoRequest)
+func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).InspectRepo(ctx, in)
+		return srv.(APIServer).DeleteAll(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: API_InspectRepo_FullMethodName,
+		FullMethod: API_DeleteAll_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectRepo(ctx, req.(*InspectRepoRequest))
+		return srv.(APIServer).DeleteAll(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_ListRepo_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListRepoRequest)
+func _API_Fsck_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FsckRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(APIServer).ListRepo(m, &aPIListRepoServer{stream})
+	return srv.(APIServer).Fsck(m, &aPIFsckServer{stream})
 }
 
-type API_ListRepoServer interface {
-	Send(*RepoInfo) error
+type API_FsckServer interface {
+	Send(*FsckResponse) error
 	grpc.ServerStream
 }
 
-type aPIListRepoServer struct {
+type aPIFsckServer struct {
 	grpc.ServerStream
 }
 
-func (x *aPIListRepoServer) Send(m *RepoInfo) error {
+func (x *aPIFsckServer) Send(m *FsckResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _API_DeleteRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRepoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).DeleteRepo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: API_DeleteRepo_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteRepo(ctx, req.(*DeleteRepoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func _API_CreateFileSet_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).CreateFileSet(&aPICreateFileSetServer{stream})
 }
 
-func _API_DeleteRepos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteReposRequest)
-	if err := dec(in); err != nil {
+type API_CreateFileSetServer interface {
+	SendAndClose(*CreateFileSetResponse) error
+	Recv() (*ModifyFileRequest, error)
+	grpc.ServerStream
+}
+
+type aPICreateFileSetServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPICreateFileSetServer) SendAndClose(m *CreateFileSetResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *aPICreateFileSetServer) Recv() (*ModifyFileRequest, error) {
+	m := new(ModifyFileRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).DeleteRepos(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: API_DeleteRepos_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteRepos(ctx, req.(*DeleteReposRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _API_StartCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartCommitRequest)
+func _API_GetFileSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileSetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).StartCommit(ctx, in)
+		return srv.(APIServer).GetFileSet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: API_StartCommit_FullMethodName,
+		FullMethod: API_GetFileSet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).StartCommit(ctx, req.(*StartCommitRequest))
+		return srv.(APIServer).GetFileSet(ctx, req.(*GetFileSetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_FinishCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FinishCommitRequest)
+func _API_AddFileSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFileSetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).FinishCommit(ctx, in)
+		return srv.(APIServer).AddFileSet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: API_FinishCommit_FullMethodName,
+		FullMethod: API_AddFileSet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).FinishCommit(ctx, req.(*FinishCommitRequest))
+		return srv.(APIServer).AddFileSet(ctx, req.(*AddFileSetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_ClearCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClearCommitRequest)
+func _API_RenewFileSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewFileSetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).ClearCommit(ctx, in)
+		return srv.(APIServer).RenewFileSet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: API_ClearCommit_FullMethodName,
+		FullMethod: API_RenewFileSet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ClearCommit(ctx, req.(*ClearCommitRequest))
+		return srv.(APIServer).RenewFileSet(ctx, req.(*RenewFileSetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_InspectCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectCommitRequest)
+func _API_ComposeFileSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComposeFileSetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).InspectCommit(ctx, in)
+		return srv.(APIServer).ComposeFileSet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: API_InspectCommit_FullMethodName,
+		FullMethod: API_ComposeFileSet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectCommit(ctx, req.(*InspectCommitRequest))
+		return srv.(APIServer).ComposeFileSet(ctx, req.(*ComposeFileSetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_ListCommit_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListCommitRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).ListCommit(m, &aPIListCommitServer{stream})
-}
-
-type API_ListCommitServer interface {
-	Send(*CommitInfo) error
-	grpc.ServerStream
-}
-
-type aPIListCommitServer struct {
-	grpc.ServerStream
-}
-
-func (x *aPIListCommitServer) Send(m *CommitInfo) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _API_SubscribeCommit_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SubscribeCommitRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).SubscribeCommit(m, &aPISubscribeCommitServer{stream})
-}
-
-type API_SubscribeCommitServer interface {
-	Send(*CommitInfo) error
-	grpc.ServerStream
-}
-
-type aPISubscribeCommitServer struct {
-	grpc.ServerStream
-}
-
-func (x *aPISubscribeCommitServer) Send(m *CommitInfo) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _API_InspectCommitSet_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(InspectCommitSetRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).InspectCommitSet(m, &aPIInspectCommitSetServer{stream})
-}
-
-type API_InspectCommitSetServer interface {
-	Send(*CommitInfo) error
-	grpc.ServerStream
-}
-
-type aPIInspectCommitSetServer struct {
-	grpc.ServerStream
-}
-
-func (x *aPIInspectCommitSetServer) Send(m *CommitInfo) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _API_ListCommitSet_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListCommitSetRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).ListCommitSet(m, &aPIListCommitSetServer{stream})
-}
-
-type API_ListCommitSetServer interface {
-	Send(*CommitSetInfo) error
-	grpc.ServerStream
-}
-
-type aPIListCommitSetServer struct {
-	grpc.ServerStream
-}
-
-func (x *aPIListCommitSetServer) Send(m *CommitSetInfo) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _API_SquashCommitSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SquashCommitSetRequest)
+func _API_ShardFileSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShardFileSetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).SquashCommitSet(ctx, in)
+		return srv.(APIServer).ShardFileSet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: API_SquashCommitSet_FullMethodName,
+		FullMethod: API_ShardFileSet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).SquashCommitSet(ctx, req.(*SquashCommitSetRequest))
+		return srv.(APIServer).ShardFileSet(ctx, req.(*ShardFileSetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_DropCommitSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DropCommitSetRequest)
+func _API_CheckStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckStorageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).DropCommitSet(ctx, in)
+		return srv.(APIServer).CheckStorage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: API_DropCommitSet_FullMethodName,
+		FullMethod: API_CheckStorage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DropCommitSet(ctx, req.(*DropCommitSetRequest))
+		return srv.(APIServer).CheckStorage(ctx, req.(*CheckStorageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_FindCommits_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(FindCommitsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).FindCommits(m, &aPIFindCommitsServer{stream})
-}
-
-type API_FindCommitsServer interface {
-	Send(*FindCommitsResponse) error
-	grpc.ServerStream
-}
-
-type aPIFindCommitsServer struct {
-	grpc.ServerStream
-}
-
-func (x *aPIFindCommitsServer) Send(m *FindCommitsResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _API_CreateBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBranchRequest)
+func _API_PutCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutCacheRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).CreateBranch(ctx, in)
+		return srv.(APIServer).PutCache(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: API_CreateBranch_FullMethodName,
+		FullMethod: API_PutCache_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).CreateBranch(ctx, req.(*CreateBranchRequest))
+		return srv.(APIServer).PutCache(ctx, req.(*PutCacheRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_InspectBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectBranchRequest)
+func _API_GetCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCacheRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).InspectBranch(ctx, in)
+		return srv.(APIServer).GetCache(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: API_InspectBranch_FullMethodName,
+		FullMethod: API_GetCache_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectBranch(ctx, req.(*InspectBranchRequest))
+		return srv.(APIServer).GetCache(ctx, req.(*GetCacheRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_ListBranch_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListBranchRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).ListBranch(m, &aPIListBranchServer{stream})
-}
-
-type API_ListBranchServer interface {
-	Send(*BranchInfo) error
-	grpc.ServerStream
-}
-
-type aPIListBranchServer struct {
-	grpc.ServerStream
-}
-
-func (x *aPIListBranchServer) Send(m *BranchInfo) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _API_DeleteBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteBranchRequest)
+func _API_ClearCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearCacheRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).DeleteBranch(ctx, in)
+		return srv.(APIServer).ClearCache(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: API_DeleteBranch_FullMethodName,
+		FullMethod: API_ClearCache_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteBranch(ctx, req.(*DeleteBranchRequest))
+		return srv.(APIServer).ClearCache(ctx, req.(*ClearCacheRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_ModifyFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(APIServer).ModifyFile(&aPIModifyFileServer{stream})
-}
-
-type API_ModifyFileServer interface {
-	SendAndClose(*emptypb.Empty) error
-	Recv() (*ModifyFileRequest, error)
-	grpc.ServerStream
-}
-
-type aPIModifyFileServer struct {
-	grpc.ServerStream
-}
-
-func (x *aPIModifyFileServer) SendAndClose(m *emptypb.Empty) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *aPIModifyFileServer) Recv() (*ModifyFileRequest, error) {
-	m := new(ModifyFileRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func _API_GetFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).GetFile(m, &aPIGetFileServer{stream})
-}
-
-type API_GetFileServer interface {
-	Send(*wrapperspb.BytesValue) error
-	grpc.ServerStream
-}
-
-type aPIGetFileServer struct {
-	grpc.ServerStream
-}
-
-func (x *aPIGetFileServer) Send(m *wrapperspb.BytesValue) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _API_GetFileTAR_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetFileRequest)
+func _API_ListTask_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(task.ListTaskRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(APIServer).GetFileTAR(m, &aPIGetFileTARServer{stream})
+	return srv.(APIServer).ListTask(m, &aPIListTaskServer{stream})
 }
 
-type API_GetFileTARServer interface {
-	Send(*wrapperspb.BytesValue) error
+type API_ListTaskServer interface {
+	Send(*task.TaskInfo) error
 	grpc.ServerStream
 }
 
-type aPIGetFileTARServer struct {
+type aPIListTaskServer struct {
 	grpc.ServerStream
 }
 
-func (x *aPIGetFileTARServer) Send(m *wrapperspb.BytesValue) error {
+func (x *aPIListTaskServer) Send(m *task.TaskInfo) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _API_InspectFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectFileRequest)
+func _API_Egress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EgressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).InspectFile(ctx, in)
+		return srv.(APIServer).Egress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: API_InspectFile_FullMethodName,
+		FullMethod: API_Egress_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectFile(ctx, req.(*InspectFileRequest))
+		return srv.(APIServer).Egress(ctx, req.(*EgressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_ListFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).ListFile(m, &aPIListFileServer{stream})
-}
-
-type API_ListFileServer interface {
-	Send(*FileInfo) error
-	grpc.ServerStream
-}
-
-type aPIListFileServer struct {
-	grpc.ServerStream
-}
-
-func (x *aPIListFileServer) Send(m *FileInfo) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _API_WalkFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(WalkFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).WalkFile(m, &aPIWalkFileServer{stream})
-}
-
-type API_WalkFileServer interface {
-	Send(*FileInfo) error
-	grpc.ServerStream
-}
-
-type aPIWalkFileServer struct {
-	grpc.ServerStream
-}
-
-func (x *aPIWalkFileServer) Send(m *FileInfo) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _API_GlobFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GlobFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).GlobFile(m, &aPIGlobFileServer{stream})
-}
-
-type API_GlobFileServer interface {
-	Send(*FileInfo) error
-	grpc.ServerStream
-}
-
-type aPIGlobFileServer struct {
-	grpc.ServerStream
-}
-
-func (x *aPIGlobFileServer) Send(m *FileInfo) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _API_DiffFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(DiffFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).DiffFile(m, &aPIDiffFileServer{stream})
-}
-
-type API_DiffFileServer interface {
-	Send(*DiffFileResponse) error
-	grpc.ServerStream
-}
-
-type aPIDiffFileServer struct {
-	grpc.ServerStream
-}
-
-func (x *aPIDiffFileServer) Send(m *DiffFileResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _API_ActivateAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ActivateAuthRequest)
+func _API_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).ActivateAuth(ctx, in)
+		return srv.(APIServer).CreateProject(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: API_ActivateAuth_FullMethodName,
+		FullMethod: API_CreateProject_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ActivateAuth(ctx, req.(*ActivateAuthRequest))
+		return srv.(APIServer).CreateProject(ctx, req.(*CreateProjectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _API_InspectProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectProjectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).DeleteAll(ctx, in)
+		return srv.(APIServer).InspectProject(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: API_DeleteAll_FullMethodName,
+		FullMethod: API_InspectProject_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteAll(ctx, req.(*emptypb.Empty))
+		return srv.(APIServer).InspectProject(ctx, req.(*InspectProjectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_Fsck_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(FsckRequest)
+func _API_ListProject_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListProjectRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(APIServer).Fsck(m, &aPIFsckServer{stream})
-}
-
-type API_FsckServer interface {
-	Send(*FsckResponse) error
-	grpc.ServerStream
-}
-
-type aPIFsckServer struct {
-	grpc.ServerStream
-}
-
-func (x *aPIFsckServer) Send(m *FsckResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _API_CreateFileSet_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(APIServer).CreateFileSet(&aPICreateFileSetServer{stream})
+	return srv.(APIServer).ListProject(m, &aPIListProjectServer{stream})
 }
 
-type API_CreateFileSetServer interface {
-	SendAndClose(*CreateFileSetResponse) error
-	Recv() (*ModifyFileRequest, error)
+type API_ListProjectServer interface {
+	Send(*ProjectInfo) error
 	grpc.ServerStream
 }
 
-type aPICreateFileSetServer struct {
+type aPIListProjectServer struct {
 	grpc.ServerStream
 }
 
-func (x *aPICreateFileSetServer) SendAndClose(m *CreateFileSetResponse) error {
+func (x *aPIListProjectServer) Send(m *ProjectInfo) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func (x *aPICreateFileSetServer) Recv() (*ModifyFileRequest, error) {
-	m := new(ModifyFileRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func _API_GetFileSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFileSetRequest)
+func _API_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).GetFileSet(ctx, in)
+		return srv.(APIServer).DeleteProject(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: API_GetFileSet_FullMethodName,
+		FullMethod: API_DeleteProject_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).GetFileSet(ctx, req.(*GetFileSetRequest))
+		return srv.(APIServer).DeleteProject(ctx, req.(*DeleteProjectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_AddFileSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddFileSetRequest)
+func _API_CreateReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReplicationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).AddFileSet(ctx, in)
+		return srv.(APIServer).CreateReplication(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: API_AddFileSet_FullMethodName,
+		FullMethod: API_CreateReplication_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).AddFileSet(ctx, req.(*AddFileSetRequest))
+		return srv.(APIServer).CreateReplication(ctx, req.(*CreateReplicationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_RenewFileSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RenewFileSetRequest)
+func _API_InspectReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectReplicationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).RenewFileSet(ctx, in)
+		return srv.(APIServer).InspectReplication(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: API_RenewFileSet_FullMethodName,
+		FullMethod: API_InspectReplication_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).RenewFileSet(ctx, req.(*RenewFileSetRequest))
+		return srv.(APIServer).InspectReplication(ctx, req.(*InspectReplicationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_ComposeFileSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ComposeFileSetRequest)
+func _API_ListReplication_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListReplicationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).ListReplication(m, &aPIListReplicationServer{stream})
+}
+
+type API_ListReplicationServer interface {
+	Send(*ReplicationInfo) error
+	grpc.ServerStream
+}
+
+type aPIListReplicationServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListReplicationServer) Send(m *ReplicationInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_DeleteReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReplicationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).ComposeFileSet(ctx, in)
+		return srv.(APIServer).DeleteReplication(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: API_ComposeFileSet_FullMethodName,
+		FullMethod: API_DeleteReplication_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ComposeFileSet(ctx, req.(*ComposeFileSetRequest))
+		return srv.(APIServer).DeleteReplication(ctx, req.(*DeleteReplicationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_ShardFileSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ShardFileSetRequest)
+func _API_MissingChunks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MissingChunksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).ShardFileSet(ctx, in)
+		return srv.(APIServer).MissingChunks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: API_ShardFileSet_FullMethodName,
+		FullMethod: API_MissingChunks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ShardFileSet(ctx, req.(*ShardFileSetRequest))
+		return srv.(APIServer).MissingChunks(ctx, req.(*MissingChunksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_CheckStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckStorageRequest)
-	if err := dec(in); err != nil {
+func _API_ReplicateFileSet_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).ReplicateFileSet(&aPIReplicateFileSetServer{stream})
+}
+
+type API_ReplicateFileSetServer interface {
+	SendAndClose(*CreateFileSetResponse) error
+	Recv() (*ReplicateFileSetRequest, error)
+	grpc.ServerStream
+}
+
+type aPIReplicateFileSetServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIReplicateFileSetServer) SendAndClose(m *CreateFileSetResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *aPIReplicateFileSetServer) Recv() (*ReplicateFileSetRequest, error) {
+	m := new(ReplicateFileSetRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).CheckStorage(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: API_CheckStorage_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).CheckStorage(ctx, req.(*CheckStorageRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _API_PutCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PutCacheRequest)
+func _API_SetRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRetentionPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).PutCache(ctx, in)
+		return srv.(APIServer).SetRetentionPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: API_PutCache_FullMethodName,
+		FullMethod: API_SetRetentionPolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).PutCache(ctx, req.(*PutCacheRequest))
+		return srv.(APIServer).SetRetentionPolicy(ctx, req.(*SetRetentionPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_GetCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCacheRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).GetCache(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: API_GetCache_FullMethodName,
+func _API_ListRetentionPolicy_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRetentionPolicyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).GetCache(ctx, req.(*GetCacheRequest))
+	return srv.(APIServer).ListRetentionPolicy(m, &aPIListRetentionPolicyServer{stream})
+}
+
+type API_ListRetentionPolicyServer interface {
+	Send(*RetentionPolicyInfo) error
+	grpc.ServerStream
+}
+
+type aPIListRetentionPolicyServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListRetentionPolicyServer) Send(m *RetentionPolicyInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_EnforceRetentionPolicy_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EnforceRetentionPolicyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(APIServer).EnforceRetentionPolicy(m, &aPIEnforceRetentionPolicyServer{stream})
 }
 
-func _API_ClearCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClearCacheRequest)
+type API_EnforceRetentionPolicyServer interface {
+	Send(*RetentionAction) error
+	grpc.ServerStream
+}
+
+type aPIEnforceRetentionPolicyServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIEnforceRetentionPolicyServer) Send(m *RetentionAction) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_SetCommitValidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCommitValidationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).ClearCache(ctx, in)
+		return srv.(APIServer).SetCommitValidation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: API_ClearCache_FullMethodName,
+		FullMethod: API_SetCommitValidation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ClearCache(ctx, req.(*ClearCacheRequest))
+		return srv.(APIServer).SetCommitValidation(ctx, req.(*SetCommitValidationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_ListTask_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(task.ListTaskRequest)
+func _API_ListCommitValidation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListCommitValidationRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(APIServer).ListTask(m, &aPIListTaskServer{stream})
+	return srv.(APIServer).ListCommitValidation(m, &aPIListCommitValidationServer{stream})
 }
 
-type API_ListTaskServer interface {
-	Send(*task.TaskInfo) error
+type API_ListCommitValidationServer interface {
+	Send(*CommitValidationInfo) error
 	grpc.ServerStream
 }
 
-type aPIListTaskServer struct {
+type aPIListCommitValidationServer struct {
 	grpc.ServerStream
 }
 
-func (x *aPIListTaskServer) Send(m *task.TaskInfo) error {
+func (x *aPIListCommitValidationServer) Send(m *CommitValidationInfo) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _API_Egress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EgressRequest)
+func _API_SetStoragePlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStoragePlacementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).Egress(ctx, in)
+		return srv.(APIServer).SetStoragePlacement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: API_Egress_FullMethodName,
+		FullMethod: API_SetStoragePlacement_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).Egress(ctx, req.(*EgressRequest))
+		return srv.(APIServer).SetStoragePlacement(ctx, req.(*SetStoragePlacementRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProjectRequest)
+func _API_ListStoragePlacement_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListStoragePlacementRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).ListStoragePlacement(m, &aPIListStoragePlacementServer{stream})
+}
+
+type API_ListStoragePlacementServer interface {
+	Send(*StoragePlacementInfo) error
+	grpc.ServerStream
+}
+
+type aPIListStoragePlacementServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListStoragePlacementServer) Send(m *StoragePlacementInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_CreateUploadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUploadSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).CreateProject(ctx, in)
+		return srv.(APIServer).CreateUploadSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: API_CreateProject_FullMethodName,
+		FullMethod: API_CreateUploadSession_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).CreateProject(ctx, req.(*CreateProjectRequest))
+		return srv.(APIServer).CreateUploadSession(ctx, req.(*CreateUploadSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_InspectProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectProjectRequest)
+func _API_InspectUploadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectUploadSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).InspectProject(ctx, in)
+		return srv.(APIServer).InspectUploadSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: API_InspectProject_FullMethodName,
+		FullMethod: API_InspectUploadSession_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectProject(ctx, req.(*InspectProjectRequest))
+		return srv.(APIServer).InspectUploadSession(ctx, req.(*InspectUploadSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_ListProject_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListProjectRequest)
+func _API_ListUploadSession_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListUploadSessionRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(APIServer).ListProject(m, &aPIListProjectServer{stream})
+	return srv.(APIServer).ListUploadSession(m, &aPIListUploadSessionServer{stream})
 }
 
-type API_ListProjectServer interface {
-	Send(*ProjectInfo) error
+type API_ListUploadSessionServer interface {
+	Send(*UploadSessionInfo) error
 	grpc.ServerStream
 }
 
-type aPIListProjectServer struct {
+type aPIListUploadSessionServer struct {
 	grpc.ServerStream
 }
 
-func (x *aPIListProjectServer) Send(m *ProjectInfo) error {
+func (x *aPIListUploadSessionServer) Send(m *UploadSessionInfo) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _API_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteProjectRequest)
+func _API_UploadPart_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).UploadPart(&aPIUploadPartServer{stream})
+}
+
+type API_UploadPartServer interface {
+	SendAndClose(*UploadPartInfo) error
+	Recv() (*UploadPartRequest, error)
+	grpc.ServerStream
+}
+
+type aPIUploadPartServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIUploadPartServer) SendAndClose(m *UploadPartInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *aPIUploadPartServer) Recv() (*UploadPartRequest, error) {
+	m := new(UploadPartRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _API_CommitUploadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitUploadSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).DeleteProject(ctx, in)
+		return srv.(APIServer).CommitUploadSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: API_DeleteProject_FullMethodName,
+		FullMethod: API_CommitUploadSession_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteProject(ctx, req.(*DeleteProjectRequest))
+		return srv.(APIServer).CommitUploadSession(ctx, req.(*CommitUploadSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeleteUploadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUploadSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteUploadSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DeleteUploadSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteUploadSession(ctx, req.(*DeleteUploadSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2230,6 +3176,10 @@ var API_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClearCommit",
 			Handler:    _API_ClearCommit_Handler,
 		},
+		{
+			MethodName: "ApproveCommit",
+			Handler:    _API_ApproveCommit_Handler,
+		},
 		{
 			MethodName: "InspectCommit",
 			Handler:    _API_InspectCommit_Handler,
@@ -2318,6 +3268,50 @@ var API_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProject",
 			Handler:    _API_DeleteProject_Handler,
 		},
+		{
+			MethodName: "CreateReplication",
+			Handler:    _API_CreateReplication_Handler,
+		},
+		{
+			MethodName: "InspectReplication",
+			Handler:    _API_InspectReplication_Handler,
+		},
+		{
+			MethodName: "DeleteReplication",
+			Handler:    _API_DeleteReplication_Handler,
+		},
+		{
+			MethodName: "MissingChunks",
+			Handler:    _API_MissingChunks_Handler,
+		},
+		{
+			MethodName: "SetRetentionPolicy",
+			Handler:    _API_SetRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "SetCommitValidation",
+			Handler:    _API_SetCommitValidation_Handler,
+		},
+		{
+			MethodName: "SetStoragePlacement",
+			Handler:    _API_SetStoragePlacement_Handler,
+		},
+		{
+			MethodName: "CreateUploadSession",
+			Handler:    _API_CreateUploadSession_Handler,
+		},
+		{
+			MethodName: "InspectUploadSession",
+			Handler:    _API_InspectUploadSession_Handler,
+		},
+		{
+			MethodName: "CommitUploadSession",
+			Handler:    _API_CommitUploadSession_Handler,
+		},
+		{
+			MethodName: "DeleteUploadSession",
+			Handler:    _API_DeleteUploadSession_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2410,6 +3404,46 @@ var API_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _API_ListProject_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListReplication",
+			Handler:       _API_ListReplication_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReplicateFileSet",
+			Handler:       _API_ReplicateFileSet_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ListRetentionPolicy",
+			Handler:       _API_ListRetentionPolicy_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "EnforceRetentionPolicy",
+			Handler:       _API_EnforceRetentionPolicy_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListCommitValidation",
+			Handler:       _API_ListCommitValidation_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListStoragePlacement",
+			Handler:       _API_ListStoragePlacement_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListUploadSession",
+			Handler:       _API_ListUploadSession_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadPart",
+			Handler:       _API_UploadPart_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "pfs/pfs.proto",
 }
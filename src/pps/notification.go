@@ -0,0 +1,32 @@
+package pps
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+const (
+	// NotificationSignatureHeader is the header of a notification request which
+	// holds the signature of its payload.
+	NotificationSignatureHeader = "X-Pachyderm-Signature"
+	// NotificationDeliveryHeader is the header of a notification request which
+	// holds its delivery ID.
+	NotificationDeliveryHeader = "X-Pachyderm-Delivery"
+
+	notificationSignaturePrefix = "sha256="
+)
+
+// SignNotificationPayload returns the signature of a notification payload,
+// keyed by the notification's secret.
+func SignNotificationPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return notificationSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyNotificationPayload reports whether signature, from the
+// NotificationSignatureHeader of a request, is the signature of payload.
+func VerifyNotificationPayload(secret string, payload []byte, signature string) bool {
+	return hmac.Equal([]byte(SignNotificationPayload(secret, payload)), []byte(signature))
+}
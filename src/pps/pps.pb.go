@@ -18,6 +18,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -392,6 +393,61 @@ func (TaintEffect) EnumDescriptor() ([]byte, []int) {
 	return file_pps_pps_proto_rawDescGZIP(), []int{5}
 }
 
+type NotificationDeliveryState int32
+
+const (
+	NotificationDeliveryState_NOTIFICATION_DELIVERY_STATE_UNKNOWN NotificationDeliveryState = 0
+	// NOTIFICATION_DELIVERY_PENDING deliveries have not been accepted by the
+	// endpoint yet, and will be retried.
+	NotificationDeliveryState_NOTIFICATION_DELIVERY_PENDING   NotificationDeliveryState = 1
+	NotificationDeliveryState_NOTIFICATION_DELIVERY_DELIVERED NotificationDeliveryState = 2
+	// NOTIFICATION_DELIVERY_FAILED deliveries ran out of attempts.
+	NotificationDeliveryState_NOTIFICATION_DELIVERY_FAILED NotificationDeliveryState = 3
+)
+
+// Enum value maps for NotificationDeliveryState.
+var (
+	NotificationDeliveryState_name = map[int32]string{
+		0: "NOTIFICATION_DELIVERY_STATE_UNKNOWN",
+		1: "NOTIFICATION_DELIVERY_PENDING",
+		2: "NOTIFICATION_DELIVERY_DELIVERED",
+		3: "NOTIFICATION_DELIVERY_FAILED",
+	}
+	NotificationDeliveryState_value = map[string]int32{
+		"NOTIFICATION_DELIVERY_STATE_UNKNOWN": 0,
+		"NOTIFICATION_DELIVERY_PENDING":       1,
+		"NOTIFICATION_DELIVERY_DELIVERED":     2,
+		"NOTIFICATION_DELIVERY_FAILED":        3,
+	}
+)
+
+func (x NotificationDeliveryState) Enum() *NotificationDeliveryState {
+	p := new(NotificationDeliveryState)
+	*p = x
+	return p
+}
+
+func (x NotificationDeliveryState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationDeliveryState) Descriptor() protoreflect.EnumDescriptor {
+	return file_pps_pps_proto_enumTypes[6].Descriptor()
+}
+
+func (NotificationDeliveryState) Type() protoreflect.EnumType {
+	return &file_pps_pps_proto_enumTypes[6]
+}
+
+func (x NotificationDeliveryState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationDeliveryState.Descriptor instead.
+func (NotificationDeliveryState) EnumDescriptor() ([]byte, []int) {
+	return file_pps_pps_proto_rawDescGZIP(), []int{6}
+}
+
 // The pipeline type is stored here so that we can internally know the type of
 // the pipeline without loading the spec from PFS.
 type PipelineInfo_PipelineType int32
@@ -430,11 +486,11 @@ func (x PipelineInfo_PipelineType) String() string {
 }
 
 func (PipelineInfo_PipelineType) Descriptor() protoreflect.EnumDescriptor {
-	return file_pps_pps_proto_enumTypes[6].Descriptor()
+	return file_pps_pps_proto_enumTypes[7].Descriptor()
 }
 
 func (PipelineInfo_PipelineType) Type() protoreflect.EnumType {
-	return &file_pps_pps_proto_enumTypes[6]
+	return &file_pps_pps_proto_enumTypes[7]
 }
 
 func (x PipelineInfo_PipelineType) Number() protoreflect.EnumNumber {
@@ -443,7 +499,7 @@ func (x PipelineInfo_PipelineType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PipelineInfo_PipelineType.Descriptor instead.
 func (PipelineInfo_PipelineType) EnumDescriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{29, 0}
+	return file_pps_pps_proto_rawDescGZIP(), []int{32, 0}
 }
 
 type SecretMount struct {
@@ -539,6 +595,13 @@ type Transform struct {
 	Dockerfile       string            `protobuf:"bytes,13,opt,name=dockerfile,proto3" json:"dockerfile,omitempty"`
 	MemoryVolume     bool              `protobuf:"varint,14,opt,name=memory_volume,json=memoryVolume,proto3" json:"memory_volume,omitempty"`
 	DatumBatching    bool              `protobuf:"varint,15,opt,name=datum_batching,json=datumBatching,proto3" json:"datum_batching,omitempty"`
+	// Starlark, if set, is a Starlark script that the worker runs on each datum
+	// in place of cmd.  No user image is pulled; image is ignored.
+	Starlark *StarlarkTransform `protobuf:"bytes,16,opt,name=starlark,proto3" json:"starlark,omitempty"`
+	// Builtin, if set, is one of the transforms built into the worker, which it
+	// runs on each datum in place of cmd.  No user image is pulled; image is
+	// ignored.
+	Builtin *BuiltinTransform `protobuf:"bytes,17,opt,name=builtin,proto3" json:"builtin,omitempty"`
 }
 
 func (x *Transform) Reset() {
@@ -678,6 +741,161 @@ func (x *Transform) GetDatumBatching() bool {
 	return false
 }
 
+func (x *Transform) GetStarlark() *StarlarkTransform {
+	if x != nil {
+		return x.Starlark
+	}
+	return nil
+}
+
+func (x *Transform) GetBuiltin() *BuiltinTransform {
+	if x != nil {
+		return x.Builtin
+	}
+	return nil
+}
+
+// StarlarkTransform is a transform written in Starlark and run inside the
+// worker.  The script reads the datum's inputs and writes its output through
+// the predefined pfs module.  Limits that are unset or zero take their
+// defaults.
+type StarlarkTransform struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Script string `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	// max_steps limits the number of Starlark execution steps per datum; the
+	// default is 1e9.
+	MaxSteps uint64 `protobuf:"varint,2,opt,name=max_steps,json=maxSteps,proto3" json:"max_steps,omitempty"`
+	// memory_limit_bytes limits the memory allocated by the script per datum;
+	// the default is 256MiB.
+	MemoryLimitBytes int64 `protobuf:"varint,3,opt,name=memory_limit_bytes,json=memoryLimitBytes,proto3" json:"memory_limit_bytes,omitempty"`
+	// timeout limits the time the script runs per datum; the default is 10
+	// minutes.
+	Timeout *durationpb.Duration `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *StarlarkTransform) Reset() {
+	*x = StarlarkTransform{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pps_pps_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StarlarkTransform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StarlarkTransform) ProtoMessage() {}
+
+func (x *StarlarkTransform) ProtoReflect() protoreflect.Message {
+	mi := &file_pps_pps_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StarlarkTransform.ProtoReflect.Descriptor instead.
+func (*StarlarkTransform) Descriptor() ([]byte, []int) {
+	return file_pps_pps_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StarlarkTransform) GetScript() string {
+	if x != nil {
+		return x.Script
+	}
+	return ""
+}
+
+func (x *StarlarkTransform) GetMaxSteps() uint64 {
+	if x != nil {
+		return x.MaxSteps
+	}
+	return 0
+}
+
+func (x *StarlarkTransform) GetMemoryLimitBytes() int64 {
+	if x != nil {
+		return x.MemoryLimitBytes
+	}
+	return 0
+}
+
+func (x *StarlarkTransform) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+// BuiltinTransform selects one of the transforms built into the worker and
+// configures it.  The transforms are csv_to_json, csv_to_parquet,
+// json_to_csv, json_to_parquet, parquet_to_csv, parquet_to_json, split,
+// merge, extract, validate_json and dedup; args holds the options of the
+// selected one.
+type BuiltinTransform struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Args *structpb.Struct `protobuf:"bytes,2,opt,name=args,proto3" json:"args,omitempty"`
+}
+
+func (x *BuiltinTransform) Reset() {
+	*x = BuiltinTransform{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pps_pps_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuiltinTransform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuiltinTransform) ProtoMessage() {}
+
+func (x *BuiltinTransform) ProtoReflect() protoreflect.Message {
+	mi := &file_pps_pps_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuiltinTransform.ProtoReflect.Descriptor instead.
+func (*BuiltinTransform) Descriptor() ([]byte, []int) {
+	return file_pps_pps_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BuiltinTransform) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BuiltinTransform) GetArgs() *structpb.Struct {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
 type TFJob struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -692,7 +910,7 @@ type TFJob struct {
 func (x *TFJob) Reset() {
 	*x = TFJob{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[2]
+		mi := &file_pps_pps_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -705,7 +923,7 @@ func (x *TFJob) String() string {
 func (*TFJob) ProtoMessage() {}
 
 func (x *TFJob) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[2]
+	mi := &file_pps_pps_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -718,7 +936,7 @@ func (x *TFJob) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TFJob.ProtoReflect.Descriptor instead.
 func (*TFJob) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{2}
+	return file_pps_pps_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *TFJob) GetTfJob() string {
@@ -744,7 +962,7 @@ type Egress struct {
 func (x *Egress) Reset() {
 	*x = Egress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[3]
+		mi := &file_pps_pps_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -757,7 +975,7 @@ func (x *Egress) String() string {
 func (*Egress) ProtoMessage() {}
 
 func (x *Egress) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[3]
+	mi := &file_pps_pps_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -770,7 +988,7 @@ func (x *Egress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Egress.ProtoReflect.Descriptor instead.
 func (*Egress) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{3}
+	return file_pps_pps_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Egress) GetURL() string {
@@ -828,7 +1046,7 @@ type Determined struct {
 func (x *Determined) Reset() {
 	*x = Determined{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[4]
+		mi := &file_pps_pps_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -841,7 +1059,7 @@ func (x *Determined) String() string {
 func (*Determined) ProtoMessage() {}
 
 func (x *Determined) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[4]
+	mi := &file_pps_pps_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -854,7 +1072,7 @@ func (x *Determined) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Determined.ProtoReflect.Descriptor instead.
 func (*Determined) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{4}
+	return file_pps_pps_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Determined) GetWorkspaces() []string {
@@ -876,7 +1094,7 @@ type Job struct {
 func (x *Job) Reset() {
 	*x = Job{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[5]
+		mi := &file_pps_pps_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -889,7 +1107,7 @@ func (x *Job) autogeneratedString() string {
 func (*Job) ProtoMessage() {}
 
 func (x *Job) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[5]
+	mi := &file_pps_pps_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -902,7 +1120,7 @@ func (x *Job) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Job.ProtoReflect.Descriptor instead.
 func (*Job) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{5}
+	return file_pps_pps_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Job) GetPipeline() *Pipeline {
@@ -931,7 +1149,7 @@ type Metadata struct {
 func (x *Metadata) Reset() {
 	*x = Metadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[6]
+		mi := &file_pps_pps_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -944,7 +1162,7 @@ func (x *Metadata) String() string {
 func (*Metadata) ProtoMessage() {}
 
 func (x *Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[6]
+	mi := &file_pps_pps_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -957,7 +1175,7 @@ func (x *Metadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Metadata.ProtoReflect.Descriptor instead.
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{6}
+	return file_pps_pps_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Metadata) GetAnnotations() map[string]string {
@@ -988,7 +1206,7 @@ type Service struct {
 func (x *Service) Reset() {
 	*x = Service{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[7]
+		mi := &file_pps_pps_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1001,7 +1219,7 @@ func (x *Service) String() string {
 func (*Service) ProtoMessage() {}
 
 func (x *Service) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[7]
+	mi := &file_pps_pps_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1014,7 +1232,7 @@ func (x *Service) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Service.ProtoReflect.Descriptor instead.
 func (*Service) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{7}
+	return file_pps_pps_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Service) GetInternalPort() int32 {
@@ -1051,12 +1269,18 @@ type Spout struct {
 	unknownFields protoimpl.UnknownFields
 
 	Service *Service `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	// exactly_once makes the spout's output consistent with its checkpoints.
+	// Before the user code starts, commits left open by a previous run are
+	// finished with an error, which discards their data, and the checkpoint of
+	// the latest finished commit on the output branch is passed to the user
+	// code in PACH_SPOUT_CHECKPOINT.
+	ExactlyOnce bool `protobuf:"varint,2,opt,name=exactly_once,json=exactlyOnce,proto3" json:"exactly_once,omitempty"`
 }
 
 func (x *Spout) Reset() {
 	*x = Spout{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[8]
+		mi := &file_pps_pps_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1069,7 +1293,7 @@ func (x *Spout) String() string {
 func (*Spout) ProtoMessage() {}
 
 func (x *Spout) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[8]
+	mi := &file_pps_pps_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1082,7 +1306,7 @@ func (x *Spout) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Spout.ProtoReflect.Descriptor instead.
 func (*Spout) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{8}
+	return file_pps_pps_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Spout) GetService() *Service {
@@ -1092,6 +1316,13 @@ func (x *Spout) GetService() *Service {
 	return nil
 }
 
+func (x *Spout) GetExactlyOnce() bool {
+	if x != nil {
+		return x.ExactlyOnce
+	}
+	return false
+}
+
 type PFSInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1121,12 +1352,27 @@ type PFSInput struct {
 	// Trigger defines when this input is processed by the pipeline, if it's nil
 	// the input is processed anytime something is committed to the input branch.
 	Trigger *pfs.Trigger `protobuf:"bytes,12,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	// Incremental, if true, will cause only the files from this input that were
+	// added or changed since the input commit processed by the pipeline's last
+	// successful job to be presented to user code.  The output of that job is
+	// kept, with the output of the new datums added to it, and is presented at
+	// /pfs/<name>_previous_output.  The first job of a pipeline sees the full
+	// input.
+	Incremental bool `protobuf:"varint,15,opt,name=incremental,proto3" json:"incremental,omitempty"`
+	// Window, if set, will cause the most recent commits of the input branch to
+	// be presented to user code, each in a subdirectory of /pfs/<name> named
+	// after the commit's ID.
+	Window *PFSWindow `protobuf:"bytes,16,opt,name=window,proto3" json:"window,omitempty"`
+	// PreviousOutput is set by Pachyderm on the incremental inputs of a job to
+	// the output commit of the pipeline's last successful job.  It cannot be
+	// set in a pipeline spec.
+	PreviousOutput *pfs.Commit `protobuf:"bytes,17,opt,name=previous_output,json=previousOutput,proto3" json:"previous_output,omitempty"`
 }
 
 func (x *PFSInput) Reset() {
 	*x = PFSInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[9]
+		mi := &file_pps_pps_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1139,7 +1385,7 @@ func (x *PFSInput) String() string {
 func (*PFSInput) ProtoMessage() {}
 
 func (x *PFSInput) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[9]
+	mi := &file_pps_pps_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1152,7 +1398,7 @@ func (x *PFSInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PFSInput.ProtoReflect.Descriptor instead.
 func (*PFSInput) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{9}
+	return file_pps_pps_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *PFSInput) GetProject() string {
@@ -1253,6 +1499,88 @@ func (x *PFSInput) GetTrigger() *pfs.Trigger {
 	return nil
 }
 
+func (x *PFSInput) GetIncremental() bool {
+	if x != nil {
+		return x.Incremental
+	}
+	return false
+}
+
+func (x *PFSInput) GetWindow() *PFSWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *PFSInput) GetPreviousOutput() *pfs.Commit {
+	if x != nil {
+		return x.PreviousOutput
+	}
+	return nil
+}
+
+// PFSWindow selects the commits of a windowed PFS input.  Exactly one of
+// commits and duration must be set.
+type PFSWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Commits is the number of most recent commits in the window, including the
+	// input commit.
+	Commits int64 `protobuf:"varint,1,opt,name=commits,proto3" json:"commits,omitempty"`
+	// Duration selects the commits started within this duration before the
+	// input commit was started.
+	Duration *durationpb.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *PFSWindow) Reset() {
+	*x = PFSWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pps_pps_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PFSWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PFSWindow) ProtoMessage() {}
+
+func (x *PFSWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_pps_pps_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PFSWindow.ProtoReflect.Descriptor instead.
+func (*PFSWindow) Descriptor() ([]byte, []int) {
+	return file_pps_pps_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PFSWindow) GetCommits() int64 {
+	if x != nil {
+		return x.Commits
+	}
+	return 0
+}
+
+func (x *PFSWindow) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
 type CronInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1272,7 +1600,7 @@ type CronInput struct {
 func (x *CronInput) Reset() {
 	*x = CronInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[10]
+		mi := &file_pps_pps_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1285,7 +1613,7 @@ func (x *CronInput) String() string {
 func (*CronInput) ProtoMessage() {}
 
 func (x *CronInput) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[10]
+	mi := &file_pps_pps_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1298,7 +1626,7 @@ func (x *CronInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CronInput.ProtoReflect.Descriptor instead.
 func (*CronInput) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{10}
+	return file_pps_pps_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CronInput) GetName() string {
@@ -1366,7 +1694,7 @@ type Input struct {
 func (x *Input) Reset() {
 	*x = Input{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[11]
+		mi := &file_pps_pps_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1379,7 +1707,7 @@ func (x *Input) String() string {
 func (*Input) ProtoMessage() {}
 
 func (x *Input) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[11]
+	mi := &file_pps_pps_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1392,7 +1720,7 @@ func (x *Input) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Input.ProtoReflect.Descriptor instead.
 func (*Input) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{11}
+	return file_pps_pps_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Input) GetPfs() *PFSInput {
@@ -1451,7 +1779,7 @@ type JobInput struct {
 func (x *JobInput) Reset() {
 	*x = JobInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[12]
+		mi := &file_pps_pps_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1464,7 +1792,7 @@ func (x *JobInput) String() string {
 func (*JobInput) ProtoMessage() {}
 
 func (x *JobInput) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[12]
+	mi := &file_pps_pps_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1477,7 +1805,7 @@ func (x *JobInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobInput.ProtoReflect.Descriptor instead.
 func (*JobInput) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{12}
+	return file_pps_pps_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *JobInput) GetName() string {
@@ -1523,7 +1851,7 @@ type ParallelismSpec struct {
 func (x *ParallelismSpec) Reset() {
 	*x = ParallelismSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[13]
+		mi := &file_pps_pps_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1536,7 +1864,7 @@ func (x *ParallelismSpec) String() string {
 func (*ParallelismSpec) ProtoMessage() {}
 
 func (x *ParallelismSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[13]
+	mi := &file_pps_pps_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1549,7 +1877,7 @@ func (x *ParallelismSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParallelismSpec.ProtoReflect.Descriptor instead.
 func (*ParallelismSpec) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{13}
+	return file_pps_pps_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ParallelismSpec) GetConstant() uint64 {
@@ -1573,7 +1901,7 @@ type InputFile struct {
 func (x *InputFile) Reset() {
 	*x = InputFile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[14]
+		mi := &file_pps_pps_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1586,7 +1914,7 @@ func (x *InputFile) String() string {
 func (*InputFile) ProtoMessage() {}
 
 func (x *InputFile) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[14]
+	mi := &file_pps_pps_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1599,7 +1927,7 @@ func (x *InputFile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InputFile.ProtoReflect.Descriptor instead.
 func (*InputFile) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{14}
+	return file_pps_pps_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *InputFile) GetPath() string {
@@ -1629,7 +1957,7 @@ type Datum struct {
 func (x *Datum) Reset() {
 	*x = Datum{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[15]
+		mi := &file_pps_pps_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1642,7 +1970,7 @@ func (x *Datum) String() string {
 func (*Datum) ProtoMessage() {}
 
 func (x *Datum) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[15]
+	mi := &file_pps_pps_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1655,7 +1983,7 @@ func (x *Datum) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Datum.ProtoReflect.Descriptor instead.
 func (*Datum) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{15}
+	return file_pps_pps_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *Datum) GetJob() *Job {
@@ -1688,7 +2016,7 @@ type DatumInfo struct {
 func (x *DatumInfo) Reset() {
 	*x = DatumInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[16]
+		mi := &file_pps_pps_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1701,7 +2029,7 @@ func (x *DatumInfo) String() string {
 func (*DatumInfo) ProtoMessage() {}
 
 func (x *DatumInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[16]
+	mi := &file_pps_pps_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1714,7 +2042,7 @@ func (x *DatumInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DatumInfo.ProtoReflect.Descriptor instead.
 func (*DatumInfo) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{16}
+	return file_pps_pps_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *DatumInfo) GetDatum() *Datum {
@@ -1774,7 +2102,7 @@ type Aggregate struct {
 func (x *Aggregate) Reset() {
 	*x = Aggregate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[17]
+		mi := &file_pps_pps_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1787,7 +2115,7 @@ func (x *Aggregate) String() string {
 func (*Aggregate) ProtoMessage() {}
 
 func (x *Aggregate) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[17]
+	mi := &file_pps_pps_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1800,7 +2128,7 @@ func (x *Aggregate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Aggregate.ProtoReflect.Descriptor instead.
 func (*Aggregate) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{17}
+	return file_pps_pps_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *Aggregate) GetCount() int64 {
@@ -1853,7 +2181,7 @@ type ProcessStats struct {
 func (x *ProcessStats) Reset() {
 	*x = ProcessStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[18]
+		mi := &file_pps_pps_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1866,7 +2194,7 @@ func (x *ProcessStats) String() string {
 func (*ProcessStats) ProtoMessage() {}
 
 func (x *ProcessStats) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[18]
+	mi := &file_pps_pps_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1879,7 +2207,7 @@ func (x *ProcessStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcessStats.ProtoReflect.Descriptor instead.
 func (*ProcessStats) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{18}
+	return file_pps_pps_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ProcessStats) GetDownloadTime() *durationpb.Duration {
@@ -1932,7 +2260,7 @@ type AggregateProcessStats struct {
 func (x *AggregateProcessStats) Reset() {
 	*x = AggregateProcessStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[19]
+		mi := &file_pps_pps_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1945,7 +2273,7 @@ func (x *AggregateProcessStats) String() string {
 func (*AggregateProcessStats) ProtoMessage() {}
 
 func (x *AggregateProcessStats) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[19]
+	mi := &file_pps_pps_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1958,7 +2286,7 @@ func (x *AggregateProcessStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AggregateProcessStats.ProtoReflect.Descriptor instead.
 func (*AggregateProcessStats) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{19}
+	return file_pps_pps_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *AggregateProcessStats) GetDownloadTime() *Aggregate {
@@ -2009,7 +2337,7 @@ type WorkerStatus struct {
 func (x *WorkerStatus) Reset() {
 	*x = WorkerStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[20]
+		mi := &file_pps_pps_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2022,7 +2350,7 @@ func (x *WorkerStatus) String() string {
 func (*WorkerStatus) ProtoMessage() {}
 
 func (x *WorkerStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[20]
+	mi := &file_pps_pps_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2035,7 +2363,7 @@ func (x *WorkerStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkerStatus.ProtoReflect.Descriptor instead.
 func (*WorkerStatus) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{20}
+	return file_pps_pps_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *WorkerStatus) GetWorkerId() string {
@@ -2072,7 +2400,7 @@ type DatumStatus struct {
 func (x *DatumStatus) Reset() {
 	*x = DatumStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[21]
+		mi := &file_pps_pps_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2085,7 +2413,7 @@ func (x *DatumStatus) String() string {
 func (*DatumStatus) ProtoMessage() {}
 
 func (x *DatumStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[21]
+	mi := &file_pps_pps_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2098,7 +2426,7 @@ func (x *DatumStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DatumStatus.ProtoReflect.Descriptor instead.
 func (*DatumStatus) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{21}
+	return file_pps_pps_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *DatumStatus) GetStarted() *timestamppb.Timestamp {
@@ -2138,7 +2466,7 @@ type ResourceSpec struct {
 func (x *ResourceSpec) Reset() {
 	*x = ResourceSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[22]
+		mi := &file_pps_pps_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2151,7 +2479,7 @@ func (x *ResourceSpec) String() string {
 func (*ResourceSpec) ProtoMessage() {}
 
 func (x *ResourceSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[22]
+	mi := &file_pps_pps_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2164,7 +2492,7 @@ func (x *ResourceSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResourceSpec.ProtoReflect.Descriptor instead.
 func (*ResourceSpec) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{22}
+	return file_pps_pps_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ResourceSpec) GetCpu() float32 {
@@ -2209,7 +2537,7 @@ type GPUSpec struct {
 func (x *GPUSpec) Reset() {
 	*x = GPUSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[23]
+		mi := &file_pps_pps_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2222,7 +2550,7 @@ func (x *GPUSpec) String() string {
 func (*GPUSpec) ProtoMessage() {}
 
 func (x *GPUSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[23]
+	mi := &file_pps_pps_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2235,7 +2563,7 @@ func (x *GPUSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GPUSpec.ProtoReflect.Descriptor instead.
 func (*GPUSpec) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{23}
+	return file_pps_pps_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GPUSpec) GetType() string {
@@ -2264,7 +2592,7 @@ type JobSetInfo struct {
 func (x *JobSetInfo) Reset() {
 	*x = JobSetInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[24]
+		mi := &file_pps_pps_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2277,7 +2605,7 @@ func (x *JobSetInfo) String() string {
 func (*JobSetInfo) ProtoMessage() {}
 
 func (x *JobSetInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[24]
+	mi := &file_pps_pps_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2290,7 +2618,7 @@ func (x *JobSetInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobSetInfo.ProtoReflect.Descriptor instead.
 func (*JobSetInfo) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{24}
+	return file_pps_pps_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *JobSetInfo) GetJobSet() *JobSet {
@@ -2336,12 +2664,16 @@ type JobInfo struct {
 	Finished  *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=finished,proto3" json:"finished,omitempty"`
 	Details   *JobInfo_Details       `protobuf:"bytes,16,opt,name=details,proto3" json:"details,omitempty"`
 	AuthToken string                 `protobuf:"bytes,17,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	// trace_context is the W3C trace context of the request that created the
+	// job, if it was traced.  Workers add their spans to this trace and pass it
+	// to user code in the TRACEPARENT and TRACESTATE environment variables.
+	TraceContext map[string]string `protobuf:"bytes,18,rep,name=trace_context,json=traceContext,proto3" json:"trace_context,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *JobInfo) Reset() {
 	*x = JobInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[25]
+		mi := &file_pps_pps_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2354,7 +2686,7 @@ func (x *JobInfo) String() string {
 func (*JobInfo) ProtoMessage() {}
 
 func (x *JobInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[25]
+	mi := &file_pps_pps_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2367,7 +2699,7 @@ func (x *JobInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobInfo.ProtoReflect.Descriptor instead.
 func (*JobInfo) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{25}
+	return file_pps_pps_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *JobInfo) GetJob() *Job {
@@ -2489,6 +2821,13 @@ func (x *JobInfo) GetAuthToken() string {
 	return ""
 }
 
+func (x *JobInfo) GetTraceContext() map[string]string {
+	if x != nil {
+		return x.TraceContext
+	}
+	return nil
+}
+
 type Worker struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2501,7 +2840,7 @@ type Worker struct {
 func (x *Worker) Reset() {
 	*x = Worker{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[26]
+		mi := &file_pps_pps_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2514,7 +2853,7 @@ func (x *Worker) String() string {
 func (*Worker) ProtoMessage() {}
 
 func (x *Worker) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[26]
+	mi := &file_pps_pps_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2527,7 +2866,7 @@ func (x *Worker) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Worker.ProtoReflect.Descriptor instead.
 func (*Worker) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{26}
+	return file_pps_pps_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *Worker) GetName() string {
@@ -2556,7 +2895,7 @@ type Pipeline struct {
 func (x *Pipeline) Reset() {
 	*x = Pipeline{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[27]
+		mi := &file_pps_pps_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2569,7 +2908,7 @@ func (x *Pipeline) autogeneratedString() string {
 func (*Pipeline) ProtoMessage() {}
 
 func (x *Pipeline) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[27]
+	mi := &file_pps_pps_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2582,7 +2921,7 @@ func (x *Pipeline) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Pipeline.ProtoReflect.Descriptor instead.
 func (*Pipeline) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{27}
+	return file_pps_pps_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *Pipeline) GetProject() *pfs.Project {
@@ -2622,7 +2961,7 @@ type Toleration struct {
 func (x *Toleration) Reset() {
 	*x = Toleration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[28]
+		mi := &file_pps_pps_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2635,7 +2974,7 @@ func (x *Toleration) String() string {
 func (*Toleration) ProtoMessage() {}
 
 func (x *Toleration) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[28]
+	mi := &file_pps_pps_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2648,7 +2987,7 @@ func (x *Toleration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Toleration.ProtoReflect.Descriptor instead.
 func (*Toleration) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{28}
+	return file_pps_pps_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *Toleration) GetKey() string {
@@ -2720,7 +3059,7 @@ type PipelineInfo struct {
 func (x *PipelineInfo) Reset() {
 	*x = PipelineInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[29]
+		mi := &file_pps_pps_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2733,7 +3072,7 @@ func (x *PipelineInfo) String() string {
 func (*PipelineInfo) ProtoMessage() {}
 
 func (x *PipelineInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[29]
+	mi := &file_pps_pps_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2746,7 +3085,7 @@ func (x *PipelineInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PipelineInfo.ProtoReflect.Descriptor instead.
 func (*PipelineInfo) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{29}
+	return file_pps_pps_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *PipelineInfo) GetPipeline() *Pipeline {
@@ -2851,7 +3190,7 @@ type PipelineInfos struct {
 func (x *PipelineInfos) Reset() {
 	*x = PipelineInfos{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[30]
+		mi := &file_pps_pps_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2864,7 +3203,7 @@ func (x *PipelineInfos) String() string {
 func (*PipelineInfos) ProtoMessage() {}
 
 func (x *PipelineInfos) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[30]
+	mi := &file_pps_pps_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2877,7 +3216,7 @@ func (x *PipelineInfos) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PipelineInfos.ProtoReflect.Descriptor instead.
 func (*PipelineInfos) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{30}
+	return file_pps_pps_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *PipelineInfos) GetPipelineInfo() []*PipelineInfo {
@@ -2898,7 +3237,7 @@ type JobSet struct {
 func (x *JobSet) Reset() {
 	*x = JobSet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[31]
+		mi := &file_pps_pps_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2911,7 +3250,7 @@ func (x *JobSet) String() string {
 func (*JobSet) ProtoMessage() {}
 
 func (x *JobSet) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[31]
+	mi := &file_pps_pps_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2924,7 +3263,7 @@ func (x *JobSet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobSet.ProtoReflect.Descriptor instead.
 func (*JobSet) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{31}
+	return file_pps_pps_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *JobSet) GetId() string {
@@ -2947,7 +3286,7 @@ type InspectJobSetRequest struct {
 func (x *InspectJobSetRequest) Reset() {
 	*x = InspectJobSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[32]
+		mi := &file_pps_pps_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2960,7 +3299,7 @@ func (x *InspectJobSetRequest) String() string {
 func (*InspectJobSetRequest) ProtoMessage() {}
 
 func (x *InspectJobSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[32]
+	mi := &file_pps_pps_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2973,7 +3312,7 @@ func (x *InspectJobSetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InspectJobSetRequest.ProtoReflect.Descriptor instead.
 func (*InspectJobSetRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{32}
+	return file_pps_pps_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *InspectJobSetRequest) GetJobSet() *JobSet {
@@ -3018,7 +3357,7 @@ type ListJobSetRequest struct {
 func (x *ListJobSetRequest) Reset() {
 	*x = ListJobSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[33]
+		mi := &file_pps_pps_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3031,7 +3370,7 @@ func (x *ListJobSetRequest) String() string {
 func (*ListJobSetRequest) ProtoMessage() {}
 
 func (x *ListJobSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[33]
+	mi := &file_pps_pps_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3044,7 +3383,7 @@ func (x *ListJobSetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListJobSetRequest.ProtoReflect.Descriptor instead.
 func (*ListJobSetRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{33}
+	return file_pps_pps_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ListJobSetRequest) GetDetails() bool {
@@ -3103,7 +3442,7 @@ type InspectJobRequest struct {
 func (x *InspectJobRequest) Reset() {
 	*x = InspectJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[34]
+		mi := &file_pps_pps_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3116,7 +3455,7 @@ func (x *InspectJobRequest) String() string {
 func (*InspectJobRequest) ProtoMessage() {}
 
 func (x *InspectJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[34]
+	mi := &file_pps_pps_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3129,7 +3468,7 @@ func (x *InspectJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InspectJobRequest.ProtoReflect.Descriptor instead.
 func (*InspectJobRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{34}
+	return file_pps_pps_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *InspectJobRequest) GetJob() *Job {
@@ -3189,7 +3528,7 @@ type ListJobRequest struct {
 func (x *ListJobRequest) Reset() {
 	*x = ListJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[35]
+		mi := &file_pps_pps_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3202,7 +3541,7 @@ func (x *ListJobRequest) String() string {
 func (*ListJobRequest) ProtoMessage() {}
 
 func (x *ListJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[35]
+	mi := &file_pps_pps_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3215,7 +3554,7 @@ func (x *ListJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListJobRequest.ProtoReflect.Descriptor instead.
 func (*ListJobRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{35}
+	return file_pps_pps_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ListJobRequest) GetProjects() []*pfs.Project {
@@ -3294,7 +3633,7 @@ type SubscribeJobRequest struct {
 func (x *SubscribeJobRequest) Reset() {
 	*x = SubscribeJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[36]
+		mi := &file_pps_pps_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3307,7 +3646,7 @@ func (x *SubscribeJobRequest) String() string {
 func (*SubscribeJobRequest) ProtoMessage() {}
 
 func (x *SubscribeJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[36]
+	mi := &file_pps_pps_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3320,7 +3659,7 @@ func (x *SubscribeJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeJobRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeJobRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{36}
+	return file_pps_pps_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *SubscribeJobRequest) GetPipeline() *Pipeline {
@@ -3348,7 +3687,7 @@ type DeleteJobRequest struct {
 func (x *DeleteJobRequest) Reset() {
 	*x = DeleteJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[37]
+		mi := &file_pps_pps_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3361,7 +3700,7 @@ func (x *DeleteJobRequest) String() string {
 func (*DeleteJobRequest) ProtoMessage() {}
 
 func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[37]
+	mi := &file_pps_pps_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3374,7 +3713,7 @@ func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteJobRequest.ProtoReflect.Descriptor instead.
 func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{37}
+	return file_pps_pps_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *DeleteJobRequest) GetJob() *Job {
@@ -3396,7 +3735,7 @@ type StopJobRequest struct {
 func (x *StopJobRequest) Reset() {
 	*x = StopJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[38]
+		mi := &file_pps_pps_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3409,7 +3748,7 @@ func (x *StopJobRequest) String() string {
 func (*StopJobRequest) ProtoMessage() {}
 
 func (x *StopJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[38]
+	mi := &file_pps_pps_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3422,7 +3761,7 @@ func (x *StopJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopJobRequest.ProtoReflect.Descriptor instead.
 func (*StopJobRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{38}
+	return file_pps_pps_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *StopJobRequest) GetJob() *Job {
@@ -3459,7 +3798,7 @@ type UpdateJobStateRequest struct {
 func (x *UpdateJobStateRequest) Reset() {
 	*x = UpdateJobStateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[39]
+		mi := &file_pps_pps_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3472,7 +3811,7 @@ func (x *UpdateJobStateRequest) String() string {
 func (*UpdateJobStateRequest) ProtoMessage() {}
 
 func (x *UpdateJobStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[39]
+	mi := &file_pps_pps_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3485,7 +3824,7 @@ func (x *UpdateJobStateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateJobStateRequest.ProtoReflect.Descriptor instead.
 func (*UpdateJobStateRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{39}
+	return file_pps_pps_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *UpdateJobStateRequest) GetJob() *Job {
@@ -3594,7 +3933,7 @@ type GetLogsRequest struct {
 func (x *GetLogsRequest) Reset() {
 	*x = GetLogsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[40]
+		mi := &file_pps_pps_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3607,7 +3946,7 @@ func (x *GetLogsRequest) String() string {
 func (*GetLogsRequest) ProtoMessage() {}
 
 func (x *GetLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[40]
+	mi := &file_pps_pps_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3620,7 +3959,7 @@ func (x *GetLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLogsRequest.ProtoReflect.Descriptor instead.
 func (*GetLogsRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{40}
+	return file_pps_pps_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *GetLogsRequest) GetPipeline() *Pipeline {
@@ -3713,7 +4052,7 @@ type LogMessage struct {
 func (x *LogMessage) Reset() {
 	*x = LogMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[41]
+		mi := &file_pps_pps_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3726,7 +4065,7 @@ func (x *LogMessage) String() string {
 func (*LogMessage) ProtoMessage() {}
 
 func (x *LogMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[41]
+	mi := &file_pps_pps_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3739,7 +4078,7 @@ func (x *LogMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogMessage.ProtoReflect.Descriptor instead.
 func (*LogMessage) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{41}
+	return file_pps_pps_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *LogMessage) GetProjectName() string {
@@ -3824,7 +4163,7 @@ type RestartDatumRequest struct {
 func (x *RestartDatumRequest) Reset() {
 	*x = RestartDatumRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[42]
+		mi := &file_pps_pps_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3837,7 +4176,7 @@ func (x *RestartDatumRequest) String() string {
 func (*RestartDatumRequest) ProtoMessage() {}
 
 func (x *RestartDatumRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[42]
+	mi := &file_pps_pps_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3850,7 +4189,7 @@ func (x *RestartDatumRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestartDatumRequest.ProtoReflect.Descriptor instead.
 func (*RestartDatumRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{42}
+	return file_pps_pps_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *RestartDatumRequest) GetJob() *Job {
@@ -3878,7 +4217,7 @@ type InspectDatumRequest struct {
 func (x *InspectDatumRequest) Reset() {
 	*x = InspectDatumRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[43]
+		mi := &file_pps_pps_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3891,7 +4230,7 @@ func (x *InspectDatumRequest) String() string {
 func (*InspectDatumRequest) ProtoMessage() {}
 
 func (x *InspectDatumRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[43]
+	mi := &file_pps_pps_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3904,7 +4243,7 @@ func (x *InspectDatumRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InspectDatumRequest.ProtoReflect.Descriptor instead.
 func (*InspectDatumRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{43}
+	return file_pps_pps_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *InspectDatumRequest) GetDatum() *Datum {
@@ -3939,7 +4278,7 @@ type ListDatumRequest struct {
 func (x *ListDatumRequest) Reset() {
 	*x = ListDatumRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[44]
+		mi := &file_pps_pps_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3952,7 +4291,7 @@ func (x *ListDatumRequest) String() string {
 func (*ListDatumRequest) ProtoMessage() {}
 
 func (x *ListDatumRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[44]
+	mi := &file_pps_pps_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3965,7 +4304,7 @@ func (x *ListDatumRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDatumRequest.ProtoReflect.Descriptor instead.
 func (*ListDatumRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{44}
+	return file_pps_pps_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ListDatumRequest) GetJob() *Job {
@@ -4032,7 +4371,7 @@ type DatumSetSpec struct {
 func (x *DatumSetSpec) Reset() {
 	*x = DatumSetSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[45]
+		mi := &file_pps_pps_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4045,7 +4384,7 @@ func (x *DatumSetSpec) String() string {
 func (*DatumSetSpec) ProtoMessage() {}
 
 func (x *DatumSetSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[45]
+	mi := &file_pps_pps_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4058,7 +4397,7 @@ func (x *DatumSetSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DatumSetSpec.ProtoReflect.Descriptor instead.
 func (*DatumSetSpec) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{45}
+	return file_pps_pps_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *DatumSetSpec) GetNumber() int64 {
@@ -4094,7 +4433,7 @@ type SchedulingSpec struct {
 func (x *SchedulingSpec) Reset() {
 	*x = SchedulingSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[46]
+		mi := &file_pps_pps_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4107,7 +4446,7 @@ func (x *SchedulingSpec) String() string {
 func (*SchedulingSpec) ProtoMessage() {}
 
 func (x *SchedulingSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[46]
+	mi := &file_pps_pps_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4120,7 +4459,7 @@ func (x *SchedulingSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SchedulingSpec.ProtoReflect.Descriptor instead.
 func (*SchedulingSpec) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{46}
+	return file_pps_pps_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *SchedulingSpec) GetNodeSelector() map[string]string {
@@ -4150,7 +4489,7 @@ type RerunPipelineRequest struct {
 func (x *RerunPipelineRequest) Reset() {
 	*x = RerunPipelineRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[47]
+		mi := &file_pps_pps_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4163,7 +4502,7 @@ func (x *RerunPipelineRequest) String() string {
 func (*RerunPipelineRequest) ProtoMessage() {}
 
 func (x *RerunPipelineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[47]
+	mi := &file_pps_pps_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4176,7 +4515,7 @@ func (x *RerunPipelineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RerunPipelineRequest.ProtoReflect.Descriptor instead.
 func (*RerunPipelineRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{47}
+	return file_pps_pps_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *RerunPipelineRequest) GetPipeline() *Pipeline {
@@ -4240,12 +4579,16 @@ type CreatePipelineRequest struct {
 	SidecarResourceRequests *ResourceSpec        `protobuf:"bytes,35,opt,name=sidecar_resource_requests,json=sidecarResourceRequests,proto3" json:"sidecar_resource_requests,omitempty"`
 	DryRun                  bool                 `protobuf:"varint,37,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	Determined              *Determined          `protobuf:"bytes,38,opt,name=determined,proto3" json:"determined,omitempty"`
+	// output_contract, if set, is checked once all of a job's datums have
+	// been processed, and a job whose output violates it fails, with the
+	// violation as its reason.
+	OutputContract *pfs.ContentContract `protobuf:"bytes,39,opt,name=output_contract,json=outputContract,proto3" json:"output_contract,omitempty"`
 }
 
 func (x *CreatePipelineRequest) Reset() {
 	*x = CreatePipelineRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[48]
+		mi := &file_pps_pps_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4258,7 +4601,7 @@ func (x *CreatePipelineRequest) String() string {
 func (*CreatePipelineRequest) ProtoMessage() {}
 
 func (x *CreatePipelineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[48]
+	mi := &file_pps_pps_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4271,7 +4614,7 @@ func (x *CreatePipelineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePipelineRequest.ProtoReflect.Descriptor instead.
 func (*CreatePipelineRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{48}
+	return file_pps_pps_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *CreatePipelineRequest) GetPipeline() *Pipeline {
@@ -4498,6 +4841,13 @@ func (x *CreatePipelineRequest) GetDetermined() *Determined {
 	return nil
 }
 
+func (x *CreatePipelineRequest) GetOutputContract() *pfs.ContentContract {
+	if x != nil {
+		return x.OutputContract
+	}
+	return nil
+}
+
 type CreatePipelineV2Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -4512,7 +4862,7 @@ type CreatePipelineV2Request struct {
 func (x *CreatePipelineV2Request) Reset() {
 	*x = CreatePipelineV2Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[49]
+		mi := &file_pps_pps_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4525,7 +4875,7 @@ func (x *CreatePipelineV2Request) String() string {
 func (*CreatePipelineV2Request) ProtoMessage() {}
 
 func (x *CreatePipelineV2Request) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[49]
+	mi := &file_pps_pps_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4538,7 +4888,7 @@ func (x *CreatePipelineV2Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePipelineV2Request.ProtoReflect.Descriptor instead.
 func (*CreatePipelineV2Request) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{49}
+	return file_pps_pps_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *CreatePipelineV2Request) GetCreatePipelineRequestJson() string {
@@ -4580,7 +4930,7 @@ type CreatePipelineV2Response struct {
 func (x *CreatePipelineV2Response) Reset() {
 	*x = CreatePipelineV2Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[50]
+		mi := &file_pps_pps_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4593,7 +4943,7 @@ func (x *CreatePipelineV2Response) String() string {
 func (*CreatePipelineV2Response) ProtoMessage() {}
 
 func (x *CreatePipelineV2Response) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[50]
+	mi := &file_pps_pps_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4606,7 +4956,7 @@ func (x *CreatePipelineV2Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePipelineV2Response.ProtoReflect.Descriptor instead.
 func (*CreatePipelineV2Response) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{50}
+	return file_pps_pps_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *CreatePipelineV2Response) GetEffectiveCreatePipelineRequestJson() string {
@@ -4630,7 +4980,7 @@ type InspectPipelineRequest struct {
 func (x *InspectPipelineRequest) Reset() {
 	*x = InspectPipelineRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[51]
+		mi := &file_pps_pps_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4643,7 +4993,7 @@ func (x *InspectPipelineRequest) String() string {
 func (*InspectPipelineRequest) ProtoMessage() {}
 
 func (x *InspectPipelineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[51]
+	mi := &file_pps_pps_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4656,7 +5006,7 @@ func (x *InspectPipelineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InspectPipelineRequest.ProtoReflect.Descriptor instead.
 func (*InspectPipelineRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{51}
+	return file_pps_pps_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *InspectPipelineRequest) GetPipeline() *Pipeline {
@@ -4702,7 +5052,7 @@ type ListPipelineRequest struct {
 func (x *ListPipelineRequest) Reset() {
 	*x = ListPipelineRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[52]
+		mi := &file_pps_pps_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4715,7 +5065,7 @@ func (x *ListPipelineRequest) String() string {
 func (*ListPipelineRequest) ProtoMessage() {}
 
 func (x *ListPipelineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[52]
+	mi := &file_pps_pps_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4728,7 +5078,7 @@ func (x *ListPipelineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPipelineRequest.ProtoReflect.Descriptor instead.
 func (*ListPipelineRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{52}
+	return file_pps_pps_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *ListPipelineRequest) GetPipeline() *Pipeline {
@@ -4792,7 +5142,7 @@ type DeletePipelineRequest struct {
 func (x *DeletePipelineRequest) Reset() {
 	*x = DeletePipelineRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[53]
+		mi := &file_pps_pps_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4805,7 +5155,7 @@ func (x *DeletePipelineRequest) String() string {
 func (*DeletePipelineRequest) ProtoMessage() {}
 
 func (x *DeletePipelineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[53]
+	mi := &file_pps_pps_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4818,7 +5168,7 @@ func (x *DeletePipelineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePipelineRequest.ProtoReflect.Descriptor instead.
 func (*DeletePipelineRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{53}
+	return file_pps_pps_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *DeletePipelineRequest) GetPipeline() *Pipeline {
@@ -4876,7 +5226,7 @@ type DeletePipelinesRequest struct {
 func (x *DeletePipelinesRequest) Reset() {
 	*x = DeletePipelinesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[54]
+		mi := &file_pps_pps_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4889,7 +5239,7 @@ func (x *DeletePipelinesRequest) String() string {
 func (*DeletePipelinesRequest) ProtoMessage() {}
 
 func (x *DeletePipelinesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[54]
+	mi := &file_pps_pps_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4902,7 +5252,7 @@ func (x *DeletePipelinesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePipelinesRequest.ProtoReflect.Descriptor instead.
 func (*DeletePipelinesRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{54}
+	return file_pps_pps_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *DeletePipelinesRequest) GetProjects() []*pfs.Project {
@@ -4944,7 +5294,7 @@ type DeletePipelinesResponse struct {
 func (x *DeletePipelinesResponse) Reset() {
 	*x = DeletePipelinesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[55]
+		mi := &file_pps_pps_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4957,7 +5307,7 @@ func (x *DeletePipelinesResponse) String() string {
 func (*DeletePipelinesResponse) ProtoMessage() {}
 
 func (x *DeletePipelinesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[55]
+	mi := &file_pps_pps_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4970,7 +5320,7 @@ func (x *DeletePipelinesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePipelinesResponse.ProtoReflect.Descriptor instead.
 func (*DeletePipelinesResponse) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{55}
+	return file_pps_pps_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *DeletePipelinesResponse) GetPipelines() []*Pipeline {
@@ -4991,7 +5341,7 @@ type StartPipelineRequest struct {
 func (x *StartPipelineRequest) Reset() {
 	*x = StartPipelineRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[56]
+		mi := &file_pps_pps_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5004,7 +5354,7 @@ func (x *StartPipelineRequest) String() string {
 func (*StartPipelineRequest) ProtoMessage() {}
 
 func (x *StartPipelineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[56]
+	mi := &file_pps_pps_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5017,7 +5367,7 @@ func (x *StartPipelineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartPipelineRequest.ProtoReflect.Descriptor instead.
 func (*StartPipelineRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{56}
+	return file_pps_pps_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *StartPipelineRequest) GetPipeline() *Pipeline {
@@ -5040,7 +5390,7 @@ type StopPipelineRequest struct {
 func (x *StopPipelineRequest) Reset() {
 	*x = StopPipelineRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[57]
+		mi := &file_pps_pps_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5053,7 +5403,7 @@ func (x *StopPipelineRequest) String() string {
 func (*StopPipelineRequest) ProtoMessage() {}
 
 func (x *StopPipelineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[57]
+	mi := &file_pps_pps_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5066,7 +5416,7 @@ func (x *StopPipelineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopPipelineRequest.ProtoReflect.Descriptor instead.
 func (*StopPipelineRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{57}
+	return file_pps_pps_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *StopPipelineRequest) GetPipeline() *Pipeline {
@@ -5096,7 +5446,7 @@ type RunPipelineRequest struct {
 func (x *RunPipelineRequest) Reset() {
 	*x = RunPipelineRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[58]
+		mi := &file_pps_pps_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5109,7 +5459,7 @@ func (x *RunPipelineRequest) String() string {
 func (*RunPipelineRequest) ProtoMessage() {}
 
 func (x *RunPipelineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[58]
+	mi := &file_pps_pps_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5122,7 +5472,7 @@ func (x *RunPipelineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunPipelineRequest.ProtoReflect.Descriptor instead.
 func (*RunPipelineRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{58}
+	return file_pps_pps_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *RunPipelineRequest) GetPipeline() *Pipeline {
@@ -5157,7 +5507,7 @@ type RunCronRequest struct {
 func (x *RunCronRequest) Reset() {
 	*x = RunCronRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[59]
+		mi := &file_pps_pps_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5170,7 +5520,7 @@ func (x *RunCronRequest) String() string {
 func (*RunCronRequest) ProtoMessage() {}
 
 func (x *RunCronRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[59]
+	mi := &file_pps_pps_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5183,7 +5533,7 @@ func (x *RunCronRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunCronRequest.ProtoReflect.Descriptor instead.
 func (*RunCronRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{59}
+	return file_pps_pps_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *RunCronRequest) GetPipeline() *Pipeline {
@@ -5204,7 +5554,7 @@ type CreateSecretRequest struct {
 func (x *CreateSecretRequest) Reset() {
 	*x = CreateSecretRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[60]
+		mi := &file_pps_pps_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5217,7 +5567,7 @@ func (x *CreateSecretRequest) String() string {
 func (*CreateSecretRequest) ProtoMessage() {}
 
 func (x *CreateSecretRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[60]
+	mi := &file_pps_pps_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5230,7 +5580,7 @@ func (x *CreateSecretRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSecretRequest.ProtoReflect.Descriptor instead.
 func (*CreateSecretRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{60}
+	return file_pps_pps_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *CreateSecretRequest) GetFile() []byte {
@@ -5251,7 +5601,7 @@ type DeleteSecretRequest struct {
 func (x *DeleteSecretRequest) Reset() {
 	*x = DeleteSecretRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[61]
+		mi := &file_pps_pps_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5264,7 +5614,7 @@ func (x *DeleteSecretRequest) String() string {
 func (*DeleteSecretRequest) ProtoMessage() {}
 
 func (x *DeleteSecretRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[61]
+	mi := &file_pps_pps_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5277,7 +5627,7 @@ func (x *DeleteSecretRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSecretRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSecretRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{61}
+	return file_pps_pps_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *DeleteSecretRequest) GetSecret() *Secret {
@@ -5298,7 +5648,7 @@ type InspectSecretRequest struct {
 func (x *InspectSecretRequest) Reset() {
 	*x = InspectSecretRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[62]
+		mi := &file_pps_pps_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5311,7 +5661,7 @@ func (x *InspectSecretRequest) String() string {
 func (*InspectSecretRequest) ProtoMessage() {}
 
 func (x *InspectSecretRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[62]
+	mi := &file_pps_pps_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5324,7 +5674,7 @@ func (x *InspectSecretRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InspectSecretRequest.ProtoReflect.Descriptor instead.
 func (*InspectSecretRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{62}
+	return file_pps_pps_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *InspectSecretRequest) GetSecret() *Secret {
@@ -5345,7 +5695,7 @@ type Secret struct {
 func (x *Secret) Reset() {
 	*x = Secret{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[63]
+		mi := &file_pps_pps_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5358,7 +5708,7 @@ func (x *Secret) String() string {
 func (*Secret) ProtoMessage() {}
 
 func (x *Secret) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[63]
+	mi := &file_pps_pps_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5371,7 +5721,7 @@ func (x *Secret) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Secret.ProtoReflect.Descriptor instead.
 func (*Secret) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{63}
+	return file_pps_pps_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *Secret) GetName() string {
@@ -5394,7 +5744,7 @@ type SecretInfo struct {
 func (x *SecretInfo) Reset() {
 	*x = SecretInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[64]
+		mi := &file_pps_pps_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5407,7 +5757,7 @@ func (x *SecretInfo) String() string {
 func (*SecretInfo) ProtoMessage() {}
 
 func (x *SecretInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[64]
+	mi := &file_pps_pps_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5420,7 +5770,7 @@ func (x *SecretInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SecretInfo.ProtoReflect.Descriptor instead.
 func (*SecretInfo) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{64}
+	return file_pps_pps_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *SecretInfo) GetSecret() *Secret {
@@ -5455,7 +5805,7 @@ type SecretInfos struct {
 func (x *SecretInfos) Reset() {
 	*x = SecretInfos{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[65]
+		mi := &file_pps_pps_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5468,7 +5818,7 @@ func (x *SecretInfos) String() string {
 func (*SecretInfos) ProtoMessage() {}
 
 func (x *SecretInfos) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[65]
+	mi := &file_pps_pps_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5481,7 +5831,7 @@ func (x *SecretInfos) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SecretInfos.ProtoReflect.Descriptor instead.
 func (*SecretInfos) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{65}
+	return file_pps_pps_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *SecretInfos) GetSecretInfo() []*SecretInfo {
@@ -5500,7 +5850,7 @@ type ActivateAuthRequest struct {
 func (x *ActivateAuthRequest) Reset() {
 	*x = ActivateAuthRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[66]
+		mi := &file_pps_pps_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5513,7 +5863,7 @@ func (x *ActivateAuthRequest) String() string {
 func (*ActivateAuthRequest) ProtoMessage() {}
 
 func (x *ActivateAuthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[66]
+	mi := &file_pps_pps_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5526,7 +5876,7 @@ func (x *ActivateAuthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActivateAuthRequest.ProtoReflect.Descriptor instead.
 func (*ActivateAuthRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{66}
+	return file_pps_pps_proto_rawDescGZIP(), []int{69}
 }
 
 type ActivateAuthResponse struct {
@@ -5538,7 +5888,7 @@ type ActivateAuthResponse struct {
 func (x *ActivateAuthResponse) Reset() {
 	*x = ActivateAuthResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[67]
+		mi := &file_pps_pps_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5551,7 +5901,7 @@ func (x *ActivateAuthResponse) String() string {
 func (*ActivateAuthResponse) ProtoMessage() {}
 
 func (x *ActivateAuthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[67]
+	mi := &file_pps_pps_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5564,7 +5914,7 @@ func (x *ActivateAuthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActivateAuthResponse.ProtoReflect.Descriptor instead.
 func (*ActivateAuthResponse) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{67}
+	return file_pps_pps_proto_rawDescGZIP(), []int{70}
 }
 
 type RunLoadTestRequest struct {
@@ -5583,7 +5933,7 @@ type RunLoadTestRequest struct {
 func (x *RunLoadTestRequest) Reset() {
 	*x = RunLoadTestRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[68]
+		mi := &file_pps_pps_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5596,7 +5946,7 @@ func (x *RunLoadTestRequest) String() string {
 func (*RunLoadTestRequest) ProtoMessage() {}
 
 func (x *RunLoadTestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[68]
+	mi := &file_pps_pps_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5609,7 +5959,7 @@ func (x *RunLoadTestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunLoadTestRequest.ProtoReflect.Descriptor instead.
 func (*RunLoadTestRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{68}
+	return file_pps_pps_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *RunLoadTestRequest) GetDagSpec() string {
@@ -5666,7 +6016,7 @@ type RunLoadTestResponse struct {
 func (x *RunLoadTestResponse) Reset() {
 	*x = RunLoadTestResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[69]
+		mi := &file_pps_pps_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5679,7 +6029,7 @@ func (x *RunLoadTestResponse) String() string {
 func (*RunLoadTestResponse) ProtoMessage() {}
 
 func (x *RunLoadTestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[69]
+	mi := &file_pps_pps_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5692,7 +6042,7 @@ func (x *RunLoadTestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunLoadTestResponse.ProtoReflect.Descriptor instead.
 func (*RunLoadTestResponse) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{69}
+	return file_pps_pps_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *RunLoadTestResponse) GetError() string {
@@ -5721,7 +6071,7 @@ type RenderTemplateRequest struct {
 func (x *RenderTemplateRequest) Reset() {
 	*x = RenderTemplateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[70]
+		mi := &file_pps_pps_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5734,7 +6084,7 @@ func (x *RenderTemplateRequest) String() string {
 func (*RenderTemplateRequest) ProtoMessage() {}
 
 func (x *RenderTemplateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[70]
+	mi := &file_pps_pps_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5747,7 +6097,7 @@ func (x *RenderTemplateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenderTemplateRequest.ProtoReflect.Descriptor instead.
 func (*RenderTemplateRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{70}
+	return file_pps_pps_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *RenderTemplateRequest) GetTemplate() string {
@@ -5776,7 +6126,7 @@ type RenderTemplateResponse struct {
 func (x *RenderTemplateResponse) Reset() {
 	*x = RenderTemplateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[71]
+		mi := &file_pps_pps_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5789,7 +6139,7 @@ func (x *RenderTemplateResponse) String() string {
 func (*RenderTemplateResponse) ProtoMessage() {}
 
 func (x *RenderTemplateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[71]
+	mi := &file_pps_pps_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5802,7 +6152,7 @@ func (x *RenderTemplateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenderTemplateResponse.ProtoReflect.Descriptor instead.
 func (*RenderTemplateResponse) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{71}
+	return file_pps_pps_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *RenderTemplateResponse) GetJson() string {
@@ -5831,7 +6181,7 @@ type LokiRequest struct {
 func (x *LokiRequest) Reset() {
 	*x = LokiRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[72]
+		mi := &file_pps_pps_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5844,7 +6194,7 @@ func (x *LokiRequest) String() string {
 func (*LokiRequest) ProtoMessage() {}
 
 func (x *LokiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[72]
+	mi := &file_pps_pps_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5857,7 +6207,7 @@ func (x *LokiRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LokiRequest.ProtoReflect.Descriptor instead.
 func (*LokiRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{72}
+	return file_pps_pps_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *LokiRequest) GetSince() *durationpb.Duration {
@@ -5885,7 +6235,7 @@ type LokiLogMessage struct {
 func (x *LokiLogMessage) Reset() {
 	*x = LokiLogMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[73]
+		mi := &file_pps_pps_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5898,7 +6248,7 @@ func (x *LokiLogMessage) String() string {
 func (*LokiLogMessage) ProtoMessage() {}
 
 func (x *LokiLogMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[73]
+	mi := &file_pps_pps_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5911,7 +6261,7 @@ func (x *LokiLogMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LokiLogMessage.ProtoReflect.Descriptor instead.
 func (*LokiLogMessage) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{73}
+	return file_pps_pps_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *LokiLogMessage) GetMessage() string {
@@ -5935,7 +6285,7 @@ type ClusterDefaults struct {
 func (x *ClusterDefaults) Reset() {
 	*x = ClusterDefaults{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[74]
+		mi := &file_pps_pps_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5948,7 +6298,7 @@ func (x *ClusterDefaults) String() string {
 func (*ClusterDefaults) ProtoMessage() {}
 
 func (x *ClusterDefaults) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[74]
+	mi := &file_pps_pps_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5961,7 +6311,7 @@ func (x *ClusterDefaults) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterDefaults.ProtoReflect.Descriptor instead.
 func (*ClusterDefaults) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{74}
+	return file_pps_pps_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *ClusterDefaults) GetCreatePipelineRequest() *CreatePipelineRequest {
@@ -5980,7 +6330,7 @@ type GetClusterDefaultsRequest struct {
 func (x *GetClusterDefaultsRequest) Reset() {
 	*x = GetClusterDefaultsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[75]
+		mi := &file_pps_pps_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5993,7 +6343,7 @@ func (x *GetClusterDefaultsRequest) String() string {
 func (*GetClusterDefaultsRequest) ProtoMessage() {}
 
 func (x *GetClusterDefaultsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[75]
+	mi := &file_pps_pps_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6006,7 +6356,7 @@ func (x *GetClusterDefaultsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetClusterDefaultsRequest.ProtoReflect.Descriptor instead.
 func (*GetClusterDefaultsRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{75}
+	return file_pps_pps_proto_rawDescGZIP(), []int{78}
 }
 
 type GetClusterDefaultsResponse struct {
@@ -6022,7 +6372,7 @@ type GetClusterDefaultsResponse struct {
 func (x *GetClusterDefaultsResponse) Reset() {
 	*x = GetClusterDefaultsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[76]
+		mi := &file_pps_pps_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6035,7 +6385,7 @@ func (x *GetClusterDefaultsResponse) String() string {
 func (*GetClusterDefaultsResponse) ProtoMessage() {}
 
 func (x *GetClusterDefaultsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[76]
+	mi := &file_pps_pps_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6048,7 +6398,7 @@ func (x *GetClusterDefaultsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetClusterDefaultsResponse.ProtoReflect.Descriptor instead.
 func (*GetClusterDefaultsResponse) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{76}
+	return file_pps_pps_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *GetClusterDefaultsResponse) GetClusterDefaultsJson() string {
@@ -6073,7 +6423,7 @@ type SetClusterDefaultsRequest struct {
 func (x *SetClusterDefaultsRequest) Reset() {
 	*x = SetClusterDefaultsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[77]
+		mi := &file_pps_pps_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6086,7 +6436,7 @@ func (x *SetClusterDefaultsRequest) String() string {
 func (*SetClusterDefaultsRequest) ProtoMessage() {}
 
 func (x *SetClusterDefaultsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[77]
+	mi := &file_pps_pps_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6099,7 +6449,7 @@ func (x *SetClusterDefaultsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetClusterDefaultsRequest.ProtoReflect.Descriptor instead.
 func (*SetClusterDefaultsRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{77}
+	return file_pps_pps_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *SetClusterDefaultsRequest) GetRegenerate() bool {
@@ -6141,7 +6491,7 @@ type SetClusterDefaultsResponse struct {
 func (x *SetClusterDefaultsResponse) Reset() {
 	*x = SetClusterDefaultsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[78]
+		mi := &file_pps_pps_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6154,7 +6504,7 @@ func (x *SetClusterDefaultsResponse) String() string {
 func (*SetClusterDefaultsResponse) ProtoMessage() {}
 
 func (x *SetClusterDefaultsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[78]
+	mi := &file_pps_pps_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6167,7 +6517,7 @@ func (x *SetClusterDefaultsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetClusterDefaultsResponse.ProtoReflect.Descriptor instead.
 func (*SetClusterDefaultsResponse) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{78}
+	return file_pps_pps_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *SetClusterDefaultsResponse) GetAffectedPipelines() []*Pipeline {
@@ -6190,7 +6540,7 @@ type CreatePipelineTransaction struct {
 func (x *CreatePipelineTransaction) Reset() {
 	*x = CreatePipelineTransaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[79]
+		mi := &file_pps_pps_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6203,7 +6553,7 @@ func (x *CreatePipelineTransaction) String() string {
 func (*CreatePipelineTransaction) ProtoMessage() {}
 
 func (x *CreatePipelineTransaction) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[79]
+	mi := &file_pps_pps_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6216,7 +6566,7 @@ func (x *CreatePipelineTransaction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePipelineTransaction.ProtoReflect.Descriptor instead.
 func (*CreatePipelineTransaction) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{79}
+	return file_pps_pps_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *CreatePipelineTransaction) GetCreatePipelineRequest() *CreatePipelineRequest {
@@ -6251,7 +6601,7 @@ type ProjectDefaults struct {
 func (x *ProjectDefaults) Reset() {
 	*x = ProjectDefaults{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[80]
+		mi := &file_pps_pps_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6264,7 +6614,7 @@ func (x *ProjectDefaults) String() string {
 func (*ProjectDefaults) ProtoMessage() {}
 
 func (x *ProjectDefaults) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[80]
+	mi := &file_pps_pps_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6277,7 +6627,7 @@ func (x *ProjectDefaults) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProjectDefaults.ProtoReflect.Descriptor instead.
 func (*ProjectDefaults) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{80}
+	return file_pps_pps_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *ProjectDefaults) GetCreatePipelineRequest() *CreatePipelineRequest {
@@ -6298,7 +6648,7 @@ type GetProjectDefaultsRequest struct {
 func (x *GetProjectDefaultsRequest) Reset() {
 	*x = GetProjectDefaultsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[81]
+		mi := &file_pps_pps_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6311,7 +6661,7 @@ func (x *GetProjectDefaultsRequest) String() string {
 func (*GetProjectDefaultsRequest) ProtoMessage() {}
 
 func (x *GetProjectDefaultsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[81]
+	mi := &file_pps_pps_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6324,7 +6674,7 @@ func (x *GetProjectDefaultsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProjectDefaultsRequest.ProtoReflect.Descriptor instead.
 func (*GetProjectDefaultsRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{81}
+	return file_pps_pps_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *GetProjectDefaultsRequest) GetProject() *pfs.Project {
@@ -6347,7 +6697,7 @@ type GetProjectDefaultsResponse struct {
 func (x *GetProjectDefaultsResponse) Reset() {
 	*x = GetProjectDefaultsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[82]
+		mi := &file_pps_pps_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6360,7 +6710,7 @@ func (x *GetProjectDefaultsResponse) String() string {
 func (*GetProjectDefaultsResponse) ProtoMessage() {}
 
 func (x *GetProjectDefaultsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[82]
+	mi := &file_pps_pps_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6373,7 +6723,7 @@ func (x *GetProjectDefaultsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProjectDefaultsResponse.ProtoReflect.Descriptor instead.
 func (*GetProjectDefaultsResponse) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{82}
+	return file_pps_pps_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *GetProjectDefaultsResponse) GetProjectDefaultsJson() string {
@@ -6399,7 +6749,7 @@ type SetProjectDefaultsRequest struct {
 func (x *SetProjectDefaultsRequest) Reset() {
 	*x = SetProjectDefaultsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[83]
+		mi := &file_pps_pps_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6412,7 +6762,7 @@ func (x *SetProjectDefaultsRequest) String() string {
 func (*SetProjectDefaultsRequest) ProtoMessage() {}
 
 func (x *SetProjectDefaultsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[83]
+	mi := &file_pps_pps_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6425,7 +6775,7 @@ func (x *SetProjectDefaultsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetProjectDefaultsRequest.ProtoReflect.Descriptor instead.
 func (*SetProjectDefaultsRequest) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{83}
+	return file_pps_pps_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *SetProjectDefaultsRequest) GetProject() *pfs.Project {
@@ -6474,7 +6824,7 @@ type SetProjectDefaultsResponse struct {
 func (x *SetProjectDefaultsResponse) Reset() {
 	*x = SetProjectDefaultsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[84]
+		mi := &file_pps_pps_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6487,7 +6837,7 @@ func (x *SetProjectDefaultsResponse) String() string {
 func (*SetProjectDefaultsResponse) ProtoMessage() {}
 
 func (x *SetProjectDefaultsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[84]
+	mi := &file_pps_pps_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6500,7 +6850,7 @@ func (x *SetProjectDefaultsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetProjectDefaultsResponse.ProtoReflect.Descriptor instead.
 func (*SetProjectDefaultsResponse) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{84}
+	return file_pps_pps_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *SetProjectDefaultsResponse) GetAffectedPipelines() []*Pipeline {
@@ -6510,34 +6860,16 @@ func (x *SetProjectDefaultsResponse) GetAffectedPipelines() []*Pipeline {
 	return nil
 }
 
-type JobInfo_Details struct {
+type GetProjectComputeUsageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Transform               *Transform           `protobuf:"bytes,1,opt,name=transform,proto3" json:"transform,omitempty"`
-	ParallelismSpec         *ParallelismSpec     `protobuf:"bytes,2,opt,name=parallelism_spec,json=parallelismSpec,proto3" json:"parallelism_spec,omitempty"`
-	Egress                  *Egress              `protobuf:"bytes,3,opt,name=egress,proto3" json:"egress,omitempty"`
-	Service                 *Service             `protobuf:"bytes,4,opt,name=service,proto3" json:"service,omitempty"`
-	Spout                   *Spout               `protobuf:"bytes,5,opt,name=spout,proto3" json:"spout,omitempty"`
-	WorkerStatus            []*WorkerStatus      `protobuf:"bytes,6,rep,name=worker_status,json=workerStatus,proto3" json:"worker_status,omitempty"`
-	ResourceRequests        *ResourceSpec        `protobuf:"bytes,7,opt,name=resource_requests,json=resourceRequests,proto3" json:"resource_requests,omitempty"`
-	ResourceLimits          *ResourceSpec        `protobuf:"bytes,8,opt,name=resource_limits,json=resourceLimits,proto3" json:"resource_limits,omitempty"`
-	SidecarResourceLimits   *ResourceSpec        `protobuf:"bytes,9,opt,name=sidecar_resource_limits,json=sidecarResourceLimits,proto3" json:"sidecar_resource_limits,omitempty"`
-	Input                   *Input               `protobuf:"bytes,10,opt,name=input,proto3" json:"input,omitempty"`
-	Salt                    string               `protobuf:"bytes,11,opt,name=salt,proto3" json:"salt,omitempty"`
-	DatumSetSpec            *DatumSetSpec        `protobuf:"bytes,12,opt,name=datum_set_spec,json=datumSetSpec,proto3" json:"datum_set_spec,omitempty"`
-	DatumTimeout            *durationpb.Duration `protobuf:"bytes,13,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
-	JobTimeout              *durationpb.Duration `protobuf:"bytes,14,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
-	DatumTries              int64                `protobuf:"varint,15,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
-	SchedulingSpec          *SchedulingSpec      `protobuf:"bytes,16,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
-	PodSpec                 string               `protobuf:"bytes,17,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
-	PodPatch                string               `protobuf:"bytes,18,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
-	SidecarResourceRequests *ResourceSpec        `protobuf:"bytes,19,opt,name=sidecar_resource_requests,json=sidecarResourceRequests,proto3" json:"sidecar_resource_requests,omitempty"`
+	Project *pfs.Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
 }
 
-func (x *JobInfo_Details) Reset() {
-	*x = JobInfo_Details{}
+func (x *GetProjectComputeUsageRequest) Reset() {
+	*x = GetProjectComputeUsageRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pps_pps_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6545,13 +6877,13 @@ func (x *JobInfo_Details) Reset() {
 	}
 }
 
-func (x *JobInfo_Details) String() string {
+func (x *GetProjectComputeUsageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobInfo_Details) ProtoMessage() {}
+func (*GetProjectComputeUsageRequest) ProtoMessage() {}
 
-func (x *JobInfo_Details) ProtoReflect() protoreflect.Message {
+func (x *GetProjectComputeUsageRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pps_pps_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6563,51 +6895,909 @@ func (x *JobInfo_Details) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobInfo_Details.ProtoReflect.Descriptor instead.
-func (*JobInfo_Details) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{25, 0}
+// Deprecated: Use GetProjectComputeUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectComputeUsageRequest) Descriptor() ([]byte, []int) {
+	return file_pps_pps_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *JobInfo_Details) GetTransform() *Transform {
+func (x *GetProjectComputeUsageRequest) GetProject() *pfs.Project {
 	if x != nil {
-		return x.Transform
+		return x.Project
 	}
 	return nil
 }
 
-func (x *JobInfo_Details) GetParallelismSpec() *ParallelismSpec {
-	if x != nil {
-		return x.ParallelismSpec
-	}
-	return nil
+// GetProjectComputeUsageResponse is the compute resources requested by the
+// workers of a project's pipelines.
+type GetProjectComputeUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workers int64 `protobuf:"varint,1,opt,name=workers,proto3" json:"workers,omitempty"`
+	// cpu and memory are Kubernetes quantities.
+	Cpu    string `protobuf:"bytes,2,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Memory string `protobuf:"bytes,3,opt,name=memory,proto3" json:"memory,omitempty"`
 }
 
-func (x *JobInfo_Details) GetEgress() *Egress {
-	if x != nil {
-		return x.Egress
+func (x *GetProjectComputeUsageResponse) Reset() {
+	*x = GetProjectComputeUsageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pps_pps_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *JobInfo_Details) GetService() *Service {
-	if x != nil {
-		return x.Service
+func (x *GetProjectComputeUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectComputeUsageResponse) ProtoMessage() {}
+
+func (x *GetProjectComputeUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pps_pps_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *JobInfo_Details) GetSpout() *Spout {
+// Deprecated: Use GetProjectComputeUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectComputeUsageResponse) Descriptor() ([]byte, []int) {
+	return file_pps_pps_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetProjectComputeUsageResponse) GetWorkers() int64 {
 	if x != nil {
-		return x.Spout
+		return x.Workers
 	}
-	return nil
+	return 0
 }
 
-func (x *JobInfo_Details) GetWorkerStatus() []*WorkerStatus {
+func (x *GetProjectComputeUsageResponse) GetCpu() string {
 	if x != nil {
-		return x.WorkerStatus
+		return x.Cpu
 	}
-	return nil
+	return ""
+}
+
+func (x *GetProjectComputeUsageResponse) GetMemory() string {
+	if x != nil {
+		return x.Memory
+	}
+	return ""
+}
+
+// Notification names a subscription to PPS and PFS events, which are delivered
+// to an HTTP endpoint.
+type Notification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pps_pps_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_pps_pps_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_pps_pps_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *Notification) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// NotificationEvent is a kind of event which a notification is delivered for.
+type NotificationEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*NotificationEvent_CommitFinished_
+	//	*NotificationEvent_JobFinished_
+	//	*NotificationEvent_PipelineStateChanged_
+	Event isNotificationEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *NotificationEvent) Reset() {
+	*x = NotificationEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pps_pps_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationEvent) ProtoMessage() {}
+
+func (x *NotificationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pps_pps_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationEvent.ProtoReflect.Descriptor instead.
+func (*NotificationEvent) Descriptor() ([]byte, []int) {
+	return file_pps_pps_proto_rawDescGZIP(), []int{91}
+}
+
+func (m *NotificationEvent) GetEvent() isNotificationEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetCommitFinished() *NotificationEvent_CommitFinished {
+	if x, ok := x.GetEvent().(*NotificationEvent_CommitFinished_); ok {
+		return x.CommitFinished
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetJobFinished() *NotificationEvent_JobFinished {
+	if x, ok := x.GetEvent().(*NotificationEvent_JobFinished_); ok {
+		return x.JobFinished
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetPipelineStateChanged() *NotificationEvent_PipelineStateChanged {
+	if x, ok := x.GetEvent().(*NotificationEvent_PipelineStateChanged_); ok {
+		return x.PipelineStateChanged
+	}
+	return nil
+}
+
+type isNotificationEvent_Event interface {
+	isNotificationEvent_Event()
+}
+
+type NotificationEvent_CommitFinished_ struct {
+	CommitFinished *NotificationEvent_CommitFinished `protobuf:"bytes,1,opt,name=commit_finished,json=commitFinished,proto3,oneof"`
+}
+
+type NotificationEvent_JobFinished_ struct {
+	JobFinished *NotificationEvent_JobFinished `protobuf:"bytes,2,opt,name=job_finished,json=jobFinished,proto3,oneof"`
+}
+
+type NotificationEvent_PipelineStateChanged_ struct {
+	PipelineStateChanged *NotificationEvent_PipelineStateChanged `protobuf:"bytes,3,opt,name=pipeline_state_changed,json=pipelineStateChanged,proto3,oneof"`
+}
+
+func (*NotificationEvent_CommitFinished_) isNotificationEvent_Event() {}
+
+func (*NotificationEvent_JobFinished_) isNotificationEvent_Event() {}
+
+func (*NotificationEvent_PipelineStateChanged_) isNotificationEvent_Event() {}
+
+type NotificationInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Notification *Notification `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification,omitempty"`
+	// url is the HTTP or HTTPS endpoint which matching events are POSTed to.
+	Url    string               `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Events []*NotificationEvent `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	// secret is the key with which payloads are signed.  It is not returned by
+	// ListNotification.
+	Secret    string                 `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *NotificationInfo) Reset() {
+	*x = NotificationInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pps_pps_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationInfo) ProtoMessage() {}
+
+func (x *NotificationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pps_pps_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationInfo.ProtoReflect.Descriptor instead.
+func (*NotificationInfo) Descriptor() ([]byte, []int) {
+	return file_pps_pps_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *NotificationInfo) GetNotification() *Notification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+func (x *NotificationInfo) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *NotificationInfo) GetEvents() []*NotificationEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *NotificationInfo) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *NotificationInfo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateNotificationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Notification *Notification        `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification,omitempty"`
+	Url          string               `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Events       []*NotificationEvent `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	// secret is the key with which payloads are signed.  If it is empty, a
+	// random secret is generated.
+	Secret string `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	// update replaces an existing notification of the same name.
+	Update bool `protobuf:"varint,5,opt,name=update,proto3" json:"update,omitempty"`
+}
+
+func (x *CreateNotificationRequest) Reset() {
+	*x = CreateNotificationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pps_pps_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNotificationRequest) ProtoMessage() {}
+
+func (x *CreateNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pps_pps_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNotificationRequest.ProtoReflect.Descriptor instead.
+func (*CreateNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_pps_pps_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *CreateNotificationRequest) GetNotification() *Notification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+func (x *CreateNotificationRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateNotificationRequest) GetEvents() []*NotificationEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *CreateNotificationRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *CreateNotificationRequest) GetUpdate() bool {
+	if x != nil {
+		return x.Update
+	}
+	return false
+}
+
+type CreateNotificationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// secret is the key with which payloads are signed.
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *CreateNotificationResponse) Reset() {
+	*x = CreateNotificationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pps_pps_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNotificationResponse) ProtoMessage() {}
+
+func (x *CreateNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pps_pps_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNotificationResponse.ProtoReflect.Descriptor instead.
+func (*CreateNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_pps_pps_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *CreateNotificationResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type ListNotificationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListNotificationRequest) Reset() {
+	*x = ListNotificationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pps_pps_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationRequest) ProtoMessage() {}
+
+func (x *ListNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pps_pps_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_pps_pps_proto_rawDescGZIP(), []int{95}
+}
+
+type DeleteNotificationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Notification *Notification `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification,omitempty"`
+}
+
+func (x *DeleteNotificationRequest) Reset() {
+	*x = DeleteNotificationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pps_pps_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotificationRequest) ProtoMessage() {}
+
+func (x *DeleteNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pps_pps_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotificationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_pps_pps_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *DeleteNotificationRequest) GetNotification() *Notification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+// NotificationDeliveryInfo is an entry in the delivery log of a notification.
+type NotificationDeliveryInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Notification *Notification `protobuf:"bytes,2,opt,name=notification,proto3" json:"notification,omitempty"`
+	// event_id identifies the event which was delivered, e.g. the key of the
+	// commit which finished.
+	EventId  string                    `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	State    NotificationDeliveryState `protobuf:"varint,4,opt,name=state,proto3,enum=pps_v2.NotificationDeliveryState" json:"state,omitempty"`
+	Attempts int32                     `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// status_code and error are the result of the last attempt.
+	StatusCode  int32                  `protobuf:"varint,6,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Error       string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DeliveredAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+}
+
+func (x *NotificationDeliveryInfo) Reset() {
+	*x = NotificationDeliveryInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pps_pps_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationDeliveryInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationDeliveryInfo) ProtoMessage() {}
+
+func (x *NotificationDeliveryInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pps_pps_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationDeliveryInfo.ProtoReflect.Descriptor instead.
+func (*NotificationDeliveryInfo) Descriptor() ([]byte, []int) {
+	return file_pps_pps_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *NotificationDeliveryInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NotificationDeliveryInfo) GetNotification() *Notification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+func (x *NotificationDeliveryInfo) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *NotificationDeliveryInfo) GetState() NotificationDeliveryState {
+	if x != nil {
+		return x.State
+	}
+	return NotificationDeliveryState_NOTIFICATION_DELIVERY_STATE_UNKNOWN
+}
+
+func (x *NotificationDeliveryInfo) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *NotificationDeliveryInfo) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *NotificationDeliveryInfo) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *NotificationDeliveryInfo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *NotificationDeliveryInfo) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+type ListNotificationDeliveryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Notification *Notification `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification,omitempty"`
+}
+
+func (x *ListNotificationDeliveryRequest) Reset() {
+	*x = ListNotificationDeliveryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pps_pps_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNotificationDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationDeliveryRequest) ProtoMessage() {}
+
+func (x *ListNotificationDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pps_pps_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_pps_pps_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ListNotificationDeliveryRequest) GetNotification() *Notification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+// NotificationPayload is the JSON body POSTed to a notification's endpoint.
+// The X-Pachyderm-Signature header of the request holds the hex-encoded
+// HMAC-SHA256 of the body, keyed by the notification's secret, prefixed with
+// "sha256=".
+type NotificationPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// delivery_id is the same for every attempt to deliver an event, so that
+	// endpoints can discard retries they've already processed.
+	DeliveryId   string                 `protobuf:"bytes,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	Notification *Notification          `protobuf:"bytes,2,opt,name=notification,proto3" json:"notification,omitempty"`
+	Time         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	// Types that are assignable to Event:
+	//	*NotificationPayload_CommitFinished
+	//	*NotificationPayload_JobFinished
+	//	*NotificationPayload_PipelineStateChanged
+	Event isNotificationPayload_Event `protobuf_oneof:"event"`
+}
+
+func (x *NotificationPayload) Reset() {
+	*x = NotificationPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pps_pps_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPayload) ProtoMessage() {}
+
+func (x *NotificationPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_pps_pps_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPayload.ProtoReflect.Descriptor instead.
+func (*NotificationPayload) Descriptor() ([]byte, []int) {
+	return file_pps_pps_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *NotificationPayload) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+func (x *NotificationPayload) GetNotification() *Notification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+func (x *NotificationPayload) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (m *NotificationPayload) GetEvent() isNotificationPayload_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *NotificationPayload) GetCommitFinished() *pfs.CommitInfo {
+	if x, ok := x.GetEvent().(*NotificationPayload_CommitFinished); ok {
+		return x.CommitFinished
+	}
+	return nil
+}
+
+func (x *NotificationPayload) GetJobFinished() *JobInfo {
+	if x, ok := x.GetEvent().(*NotificationPayload_JobFinished); ok {
+		return x.JobFinished
+	}
+	return nil
+}
+
+func (x *NotificationPayload) GetPipelineStateChanged() *PipelineInfo {
+	if x, ok := x.GetEvent().(*NotificationPayload_PipelineStateChanged); ok {
+		return x.PipelineStateChanged
+	}
+	return nil
+}
+
+type isNotificationPayload_Event interface {
+	isNotificationPayload_Event()
+}
+
+type NotificationPayload_CommitFinished struct {
+	CommitFinished *pfs.CommitInfo `protobuf:"bytes,4,opt,name=commit_finished,json=commitFinished,proto3,oneof"`
+}
+
+type NotificationPayload_JobFinished struct {
+	JobFinished *JobInfo `protobuf:"bytes,5,opt,name=job_finished,json=jobFinished,proto3,oneof"`
+}
+
+type NotificationPayload_PipelineStateChanged struct {
+	PipelineStateChanged *PipelineInfo `protobuf:"bytes,6,opt,name=pipeline_state_changed,json=pipelineStateChanged,proto3,oneof"`
+}
+
+func (*NotificationPayload_CommitFinished) isNotificationPayload_Event() {}
+
+func (*NotificationPayload_JobFinished) isNotificationPayload_Event() {}
+
+func (*NotificationPayload_PipelineStateChanged) isNotificationPayload_Event() {}
+
+type JobInfo_Details struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transform               *Transform           `protobuf:"bytes,1,opt,name=transform,proto3" json:"transform,omitempty"`
+	ParallelismSpec         *ParallelismSpec     `protobuf:"bytes,2,opt,name=parallelism_spec,json=parallelismSpec,proto3" json:"parallelism_spec,omitempty"`
+	Egress                  *Egress              `protobuf:"bytes,3,opt,name=egress,proto3" json:"egress,omitempty"`
+	Service                 *Service             `protobuf:"bytes,4,opt,name=service,proto3" json:"service,omitempty"`
+	Spout                   *Spout               `protobuf:"bytes,5,opt,name=spout,proto3" json:"spout,omitempty"`
+	WorkerStatus            []*WorkerStatus      `protobuf:"bytes,6,rep,name=worker_status,json=workerStatus,proto3" json:"worker_status,omitempty"`
+	ResourceRequests        *ResourceSpec        `protobuf:"bytes,7,opt,name=resource_requests,json=resourceRequests,proto3" json:"resource_requests,omitempty"`
+	ResourceLimits          *ResourceSpec        `protobuf:"bytes,8,opt,name=resource_limits,json=resourceLimits,proto3" json:"resource_limits,omitempty"`
+	SidecarResourceLimits   *ResourceSpec        `protobuf:"bytes,9,opt,name=sidecar_resource_limits,json=sidecarResourceLimits,proto3" json:"sidecar_resource_limits,omitempty"`
+	Input                   *Input               `protobuf:"bytes,10,opt,name=input,proto3" json:"input,omitempty"`
+	Salt                    string               `protobuf:"bytes,11,opt,name=salt,proto3" json:"salt,omitempty"`
+	DatumSetSpec            *DatumSetSpec        `protobuf:"bytes,12,opt,name=datum_set_spec,json=datumSetSpec,proto3" json:"datum_set_spec,omitempty"`
+	DatumTimeout            *durationpb.Duration `protobuf:"bytes,13,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
+	JobTimeout              *durationpb.Duration `protobuf:"bytes,14,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
+	DatumTries              int64                `protobuf:"varint,15,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	SchedulingSpec          *SchedulingSpec      `protobuf:"bytes,16,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
+	PodSpec                 string               `protobuf:"bytes,17,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
+	PodPatch                string               `protobuf:"bytes,18,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
+	SidecarResourceRequests *ResourceSpec        `protobuf:"bytes,19,opt,name=sidecar_resource_requests,json=sidecarResourceRequests,proto3" json:"sidecar_resource_requests,omitempty"`
+}
+
+func (x *JobInfo_Details) Reset() {
+	*x = JobInfo_Details{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pps_pps_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobInfo_Details) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobInfo_Details) ProtoMessage() {}
+
+func (x *JobInfo_Details) ProtoReflect() protoreflect.Message {
+	mi := &file_pps_pps_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobInfo_Details.ProtoReflect.Descriptor instead.
+func (*JobInfo_Details) Descriptor() ([]byte, []int) {
+	return file_pps_pps_proto_rawDescGZIP(), []int{28, 0}
+}
+
+func (x *JobInfo_Details) GetTransform() *Transform {
+	if x != nil {
+		return x.Transform
+	}
+	return nil
+}
+
+func (x *JobInfo_Details) GetParallelismSpec() *ParallelismSpec {
+	if x != nil {
+		return x.ParallelismSpec
+	}
+	return nil
+}
+
+func (x *JobInfo_Details) GetEgress() *Egress {
+	if x != nil {
+		return x.Egress
+	}
+	return nil
+}
+
+func (x *JobInfo_Details) GetService() *Service {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+func (x *JobInfo_Details) GetSpout() *Spout {
+	if x != nil {
+		return x.Spout
+	}
+	return nil
+}
+
+func (x *JobInfo_Details) GetWorkerStatus() []*WorkerStatus {
+	if x != nil {
+		return x.WorkerStatus
+	}
+	return nil
 }
 
 func (x *JobInfo_Details) GetResourceRequests() *ResourceSpec {
@@ -6743,12 +7933,14 @@ type PipelineInfo_Details struct {
 	Tolerations             []*Toleration          `protobuf:"bytes,34,rep,name=tolerations,proto3" json:"tolerations,omitempty"`
 	SidecarResourceRequests *ResourceSpec          `protobuf:"bytes,35,opt,name=sidecar_resource_requests,json=sidecarResourceRequests,proto3" json:"sidecar_resource_requests,omitempty"`
 	Determined              *Determined            `protobuf:"bytes,36,opt,name=determined,proto3" json:"determined,omitempty"`
+	// output_contract is checked against the output of each job.
+	OutputContract *pfs.ContentContract `protobuf:"bytes,37,opt,name=output_contract,json=outputContract,proto3" json:"output_contract,omitempty"`
 }
 
 func (x *PipelineInfo_Details) Reset() {
 	*x = PipelineInfo_Details{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[89]
+		mi := &file_pps_pps_proto_msgTypes[105]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6761,7 +7953,7 @@ func (x *PipelineInfo_Details) String() string {
 func (*PipelineInfo_Details) ProtoMessage() {}
 
 func (x *PipelineInfo_Details) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[89]
+	mi := &file_pps_pps_proto_msgTypes[105]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6774,7 +7966,7 @@ func (x *PipelineInfo_Details) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PipelineInfo_Details.ProtoReflect.Descriptor instead.
 func (*PipelineInfo_Details) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{29, 0}
+	return file_pps_pps_proto_rawDescGZIP(), []int{32, 0}
 }
 
 func (x *PipelineInfo_Details) GetTransform() *Transform {
@@ -7015,6 +8207,13 @@ func (x *PipelineInfo_Details) GetDetermined() *Determined {
 	return nil
 }
 
+func (x *PipelineInfo_Details) GetOutputContract() *pfs.ContentContract {
+	if x != nil {
+		return x.OutputContract
+	}
+	return nil
+}
+
 // Filter restricts returned DatumInfo messages to those which match
 // all of the filtered attributes.
 type ListDatumRequest_Filter struct {
@@ -7028,7 +8227,7 @@ type ListDatumRequest_Filter struct {
 func (x *ListDatumRequest_Filter) Reset() {
 	*x = ListDatumRequest_Filter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pps_pps_proto_msgTypes[90]
+		mi := &file_pps_pps_proto_msgTypes[106]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7041,7 +8240,7 @@ func (x *ListDatumRequest_Filter) String() string {
 func (*ListDatumRequest_Filter) ProtoMessage() {}
 
 func (x *ListDatumRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_pps_pps_proto_msgTypes[90]
+	mi := &file_pps_pps_proto_msgTypes[106]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7054,7 +8253,7 @@ func (x *ListDatumRequest_Filter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDatumRequest_Filter.ProtoReflect.Descriptor instead.
 func (*ListDatumRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_pps_pps_proto_rawDescGZIP(), []int{44, 0}
+	return file_pps_pps_proto_rawDescGZIP(), []int{47, 0}
 }
 
 func (x *ListDatumRequest_Filter) GetState() []DatumState {
@@ -7064,6 +8263,171 @@ func (x *ListDatumRequest_Filter) GetState() []DatumState {
 	return nil
 }
 
+// CommitFinished matches commits finishing on branch.  If the branch's name
+// is empty, commits finishing on any branch of its repo match.
+type NotificationEvent_CommitFinished struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Branch *pfs.Branch `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
+}
+
+func (x *NotificationEvent_CommitFinished) Reset() {
+	*x = NotificationEvent_CommitFinished{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pps_pps_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationEvent_CommitFinished) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationEvent_CommitFinished) ProtoMessage() {}
+
+func (x *NotificationEvent_CommitFinished) ProtoReflect() protoreflect.Message {
+	mi := &file_pps_pps_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationEvent_CommitFinished.ProtoReflect.Descriptor instead.
+func (*NotificationEvent_CommitFinished) Descriptor() ([]byte, []int) {
+	return file_pps_pps_proto_rawDescGZIP(), []int{91, 0}
+}
+
+func (x *NotificationEvent_CommitFinished) GetBranch() *pfs.Branch {
+	if x != nil {
+		return x.Branch
+	}
+	return nil
+}
+
+// JobFinished matches the jobs of pipeline finishing in one of states, which
+// defaults to JOB_SUCCESS and JOB_FAILURE.  If the pipeline's name is empty,
+// the jobs of every pipeline in its project match.
+type NotificationEvent_JobFinished struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pipeline *Pipeline  `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	States   []JobState `protobuf:"varint,2,rep,packed,name=states,proto3,enum=pps_v2.JobState" json:"states,omitempty"`
+}
+
+func (x *NotificationEvent_JobFinished) Reset() {
+	*x = NotificationEvent_JobFinished{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pps_pps_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationEvent_JobFinished) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationEvent_JobFinished) ProtoMessage() {}
+
+func (x *NotificationEvent_JobFinished) ProtoReflect() protoreflect.Message {
+	mi := &file_pps_pps_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationEvent_JobFinished.ProtoReflect.Descriptor instead.
+func (*NotificationEvent_JobFinished) Descriptor() ([]byte, []int) {
+	return file_pps_pps_proto_rawDescGZIP(), []int{91, 1}
+}
+
+func (x *NotificationEvent_JobFinished) GetPipeline() *Pipeline {
+	if x != nil {
+		return x.Pipeline
+	}
+	return nil
+}
+
+func (x *NotificationEvent_JobFinished) GetStates() []JobState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+// PipelineStateChanged matches pipeline entering one of states, which
+// defaults to PIPELINE_CRASHING and PIPELINE_FAILURE.  If the pipeline's name
+// is empty, every pipeline in its project matches.
+type NotificationEvent_PipelineStateChanged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pipeline *Pipeline       `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	States   []PipelineState `protobuf:"varint,2,rep,packed,name=states,proto3,enum=pps_v2.PipelineState" json:"states,omitempty"`
+}
+
+func (x *NotificationEvent_PipelineStateChanged) Reset() {
+	*x = NotificationEvent_PipelineStateChanged{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pps_pps_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationEvent_PipelineStateChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationEvent_PipelineStateChanged) ProtoMessage() {}
+
+func (x *NotificationEvent_PipelineStateChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_pps_pps_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationEvent_PipelineStateChanged.ProtoReflect.Descriptor instead.
+func (*NotificationEvent_PipelineStateChanged) Descriptor() ([]byte, []int) {
+	return file_pps_pps_proto_rawDescGZIP(), []int{91, 2}
+}
+
+func (x *NotificationEvent_PipelineStateChanged) GetPipeline() *Pipeline {
+	if x != nil {
+		return x.Pipeline
+	}
+	return nil
+}
+
+func (x *NotificationEvent_PipelineStateChanged) GetStates() []PipelineState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
 var File_pps_pps_proto protoreflect.FileDescriptor
 
 var file_pps_pps_proto_rawDesc = []byte{
@@ -7076,822 +8440,880 @@ var file_pps_pps_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0d, 0x70, 0x66, 0x73, 0x2f, 0x70, 0x66, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x29, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x65, 0x78, 0x74, 0x65,
-	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x2d, 0x73, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x2d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x1e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x6b, 0x0a, 0x0b, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x0d, 0x70, 0x66, 0x73, 0x2f, 0x70, 0x66, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x0f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x29, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x2d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x2d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6b,
+	0x0a, 0x0b, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x6e, 0x76, 0x5f, 0x76, 0x61, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x76, 0x56, 0x61, 0x72, 0x22, 0x99, 0x05, 0x0a, 0x09,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x63, 0x6d, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x63, 0x6d,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x5f, 0x63, 0x6d, 0x64, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x43, 0x6d, 0x64, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e,
+	0x76, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x45, 0x6e, 0x76, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x2d, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x70, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x5f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x10, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x75, 0x6c, 0x6c, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x65,
+	0x72, 0x72, 0x5f, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x65, 0x72, 0x72, 0x53, 0x74, 0x64, 0x69, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x03, 0x52, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x69, 0x72, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69,
+	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x66, 0x69, 0x6c, 0x65, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x66, 0x69, 0x6c,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x5f,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x64, 0x61, 0x74, 0x75, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x35, 0x0a,
+	0x08, 0x73, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72,
+	0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72,
+	0x6c, 0x61, 0x72, 0x6b, 0x12, 0x32, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42,
+	0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52,
+	0x07, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x1a, 0x36, 0x0a, 0x08, 0x45, 0x6e, 0x76, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x3a, 0x05, 0xba, 0x46, 0x02, 0x18, 0x01, 0x22, 0xab, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72,
+	0x6c, 0x61, 0x72, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x65,
+	0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x65,
+	0x70, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x53, 0x0a, 0x10, 0x42, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a,
+	0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x1e, 0x0a, 0x05, 0x54, 0x46,
+	0x4a, 0x6f, 0x62, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x66, 0x5f, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x66, 0x4a, 0x6f, 0x62, 0x22, 0xb1, 0x01, 0x0a, 0x06, 0x45,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x44, 0x0a, 0x0e, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0d,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x3e, 0x0a,
+	0x0c, 0x73, 0x71, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x51, 0x4c,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00,
+	0x52, 0x0b, 0x73, 0x71, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x3a, 0x05, 0xba,
+	0x46, 0x02, 0x18, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x2c,
+	0x0a, 0x0a, 0x44, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x22, 0x43, 0x0a, 0x03,
+	0x4a, 0x6f, 0x62, 0x12, 0x2c, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x87, 0x02, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x43,
+	0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x41, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x3a, 0x05, 0xba, 0x46, 0x02, 0x18, 0x01, 0x22, 0x77, 0x0a, 0x07, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x55, 0x0a, 0x05, 0x53, 0x70, 0x6f, 0x75, 0x74, 0x12, 0x29, 0x0a,
+	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52,
+	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x61, 0x63,
+	0x74, 0x6c, 0x79, 0x5f, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x65, 0x78, 0x61, 0x63, 0x74, 0x6c, 0x79, 0x4f, 0x6e, 0x63, 0x65, 0x22, 0xfd, 0x03, 0x0a, 0x08,
+	0x50, 0x46, 0x53, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65,
+	0x70, 0x6f, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x6c, 0x6f, 0x62, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x6c, 0x6f, 0x62, 0x12, 0x17, 0x0a, 0x07, 0x6a,
+	0x6f, 0x69, 0x6e, 0x5f, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6a, 0x6f,
+	0x69, 0x6e, 0x4f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x6a, 0x6f,
+	0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x4a,
+	0x6f, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x6c, 0x61, 0x7a, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x61,
+	0x7a, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x73, 0x33, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x02, 0x73, 0x33, 0x12, 0x29, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x20,
+	0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x12, 0x29, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x46, 0x53, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x37, 0x0a, 0x0f, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x3a, 0x05, 0xba, 0x46, 0x02, 0x18, 0x01, 0x22, 0x5c, 0x0a, 0x09, 0x50,
+	0x46, 0x53, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc9, 0x01, 0x0a, 0x09, 0x43, 0x72,
+	0x6f, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x22, 0xeb, 0x01, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x22, 0x0a, 0x03, 0x70, 0x66, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70,
+	0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x46, 0x53, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x03,
+	0x70, 0x66, 0x73, 0x12, 0x21, 0x0a, 0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x52, 0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x12, 0x23, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x23, 0x0a, 0x05, 0x63,
+	0x72, 0x6f, 0x73, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x70, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x63, 0x72, 0x6f, 0x73, 0x73,
+	0x12, 0x23, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05,
+	0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x6f,
+	0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x3a, 0x05, 0xba, 0x46,
+	0x02, 0x18, 0x01, 0x22, 0x6e, 0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
 	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x50, 0x61, 0x74, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x6e, 0x76, 0x5f, 0x76, 0x61, 0x72, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x76, 0x56, 0x61, 0x72, 0x22, 0xae, 0x04,
-	0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x69,
-	0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6d, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03,
-	0x63, 0x6d, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x5f, 0x63, 0x6d, 0x64, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x43, 0x6d, 0x64, 0x12, 0x2c, 0x0a, 0x03,
-	0x65, 0x6e, 0x76, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x70, 0x73, 0x5f,
-	0x76, 0x32, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x45, 0x6e, 0x76,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x2d, 0x0a, 0x07, 0x73, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x70,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4d, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x5f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18,
-	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x75, 0x6c, 0x6c,
-	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x64, 0x69, 0x6e,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x12, 0x1b, 0x0a,
-	0x09, 0x65, 0x72, 0x72, 0x5f, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x08, 0x65, 0x72, 0x72, 0x53, 0x74, 0x64, 0x69, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x63,
-	0x63, 0x65, 0x70, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x09, 0x20, 0x03, 0x28, 0x03, 0x52, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x62, 0x75,
-	0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x12, 0x12,
-	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73,
-	0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x69,
-	0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
-	0x44, 0x69, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x66, 0x69, 0x6c,
-	0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x66,
-	0x69, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x76, 0x6f,
-	0x6c, 0x75, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x75,
-	0x6d, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0d, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x1a,
-	0x36, 0x0a, 0x08, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x05, 0xba, 0x46, 0x02, 0x18, 0x01, 0x22, 0x1e,
-	0x0a, 0x05, 0x54, 0x46, 0x4a, 0x6f, 0x62, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x66, 0x5f, 0x6a, 0x6f,
-	0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x66, 0x4a, 0x6f, 0x62, 0x22, 0xb1,
-	0x01, 0x0a, 0x06, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x44, 0x0a, 0x0e, 0x6f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73,
-	0x48, 0x00, 0x52, 0x0d, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x73, 0x71, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x53, 0x51, 0x4c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x67, 0x72, 0x65,
-	0x73, 0x73, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x71, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
-	0x65, 0x3a, 0x05, 0xba, 0x46, 0x02, 0x18, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x22, 0x2c, 0x0a, 0x0a, 0x44, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x65, 0x64,
-	0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73,
-	0x22, 0x43, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x12, 0x2c, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x70, 0x73, 0x5f,
-	0x76, 0x32, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x70, 0x69, 0x70,
-	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x87, 0x02, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x43, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x3e, 0x0a,
-	0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a,
-	0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x05, 0xba, 0x46, 0x02, 0x18, 0x01, 0x22,
-	0x77, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x12,
-	0x23, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x72, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x50, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x32, 0x0a, 0x05, 0x53, 0x70, 0x6f, 0x75,
-	0x74, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0xf7, 0x02, 0x0a,
-	0x08, 0x50, 0x46, 0x53, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x72,
-	0x65, 0x70, 0x6f, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x72, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e,
-	0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
-	0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x6c, 0x6f, 0x62,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x6c, 0x6f, 0x62, 0x12, 0x17, 0x0a, 0x07,
-	0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6a,
-	0x6f, 0x69, 0x6e, 0x4f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x6a,
-	0x6f, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x75, 0x74, 0x65, 0x72,
-	0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12,
-	0x12, 0x0a, 0x04, 0x6c, 0x61, 0x7a, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c,
-	0x61, 0x7a, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x5f, 0x66, 0x69, 0x6c,
-	0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x46,
-	0x69, 0x6c, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x73, 0x33, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x02, 0x73, 0x33, 0x12, 0x29, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x54,
-	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x3a,
-	0x05, 0xba, 0x46, 0x02, 0x18, 0x01, 0x22, 0xc9, 0x01, 0x0a, 0x09, 0x43, 0x72, 0x6f, 0x6e, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x70,
-	0x65, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65,
-	0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x22, 0xeb, 0x01, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x03,
-	0x70, 0x66, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x70, 0x73, 0x5f,
-	0x76, 0x32, 0x2e, 0x50, 0x46, 0x53, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x03, 0x70, 0x66, 0x73,
-	0x12, 0x21, 0x0a, 0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
-	0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x04, 0x6a,
-	0x6f, 0x69, 0x6e, 0x12, 0x23, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x70, 0x75,
-	0x74, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x23, 0x0a, 0x05, 0x63, 0x72, 0x6f, 0x73,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x12, 0x23, 0x0a,
-	0x05, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70,
-	0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x75, 0x6e, 0x69,
-	0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x6f, 0x6e, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x3a, 0x05, 0xba, 0x46, 0x02, 0x18, 0x01,
-	0x22, 0x6e, 0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x6c, 0x6f, 0x62,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x6c, 0x6f, 0x62, 0x12, 0x12, 0x0a, 0x04,
-	0x6c, 0x61, 0x7a, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x61, 0x7a, 0x79,
-	0x22, 0x34, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x53,
-	0x70, 0x65, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x3a,
-	0x05, 0xba, 0x46, 0x02, 0x18, 0x01, 0x22, 0x33, 0x0a, 0x09, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x46,
-	0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x36, 0x0a, 0x05, 0x44,
-	0x61, 0x74, 0x75, 0x6d, 0x12, 0x1d, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03,
-	0x6a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0xf2, 0x01, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x23, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x52,
-	0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44,
-	0x61, 0x74, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x09,
-	0x70, 0x66, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x70,
-	0x66, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46,
-	0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a,
-	0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0xb0, 0x01, 0x0a, 0x09, 0x41, 0x67, 0x67,
-	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x6d, 0x65, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x64, 0x65, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x06, 0x73, 0x74, 0x64, 0x64, 0x65, 0x76, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x69, 0x66, 0x74,
-	0x68, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x0f, 0x66, 0x69, 0x66, 0x74, 0x68, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
-	0x69, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x6e, 0x69, 0x6e, 0x65, 0x74, 0x79, 0x5f, 0x66, 0x69,
-	0x66, 0x74, 0x68, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x6e, 0x69, 0x6e, 0x65, 0x74, 0x79, 0x46, 0x69, 0x66, 0x74,
-	0x68, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x22, 0x92, 0x02, 0x0a, 0x0c,
-	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x3e, 0x0a, 0x0d,
-	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c,
-	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x0c,
-	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x67,
+	0x6c, 0x6f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x6c, 0x6f, 0x62, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x61, 0x7a, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c,
+	0x61, 0x7a, 0x79, 0x22, 0x34, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69,
+	0x73, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x74, 0x3a, 0x05, 0xba, 0x46, 0x02, 0x18, 0x01, 0x22, 0x33, 0x0a, 0x09, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x36,
+	0x0a, 0x05, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x12, 0x1d, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f,
+	0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xf2, 0x01, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x75, 0x6d,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x61, 0x74,
+	0x75, 0x6d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x29, 0x0a, 0x09, 0x70, 0x66, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x08, 0x70, 0x66, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x19, 0x0a, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0xb0, 0x01, 0x0a, 0x09,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d,
+	0x65, 0x61, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x64, 0x65, 0x76, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x06, 0x73, 0x74, 0x64, 0x64, 0x65, 0x76, 0x12, 0x29, 0x0a, 0x10, 0x66,
+	0x69, 0x66, 0x74, 0x68, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x66, 0x69, 0x66, 0x74, 0x68, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x6e, 0x69, 0x6e, 0x65, 0x74, 0x79,
+	0x5f, 0x66, 0x69, 0x66, 0x74, 0x68, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x6e, 0x69, 0x6e, 0x65, 0x74, 0x79, 0x46,
+	0x69, 0x66, 0x74, 0x68, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x22, 0x92,
+	0x02, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x3e, 0x0a, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x3c, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3a, 0x0a,
+	0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x75, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x75, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
-	0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
-	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a,
-	0x0c, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x22, 0xa9, 0x02, 0x0a, 0x15, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x0d, 0x64, 0x6f,
-	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65,
-	0x67, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x34, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76,
-	0x32, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x75, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
-	0x52, 0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x0e,
-	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x75,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x22, 0xa9, 0x02, 0x0a, 0x15, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x36, 0x0a,
+	0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x67,
-	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
-	0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0c, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
-	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70,
-	0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52,
-	0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x7a, 0x0a, 0x0c,
-	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09,
-	0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64,
-	0x12, 0x36, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e,
-	0x44, 0x61, 0x74, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x64, 0x61, 0x74,
-	0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x6a, 0x0a, 0x0b, 0x44, 0x61, 0x74, 0x75,
-	0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x25, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x70,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0x76, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x53, 0x70, 0x65, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x21,
-	0x0a, 0x03, 0x67, 0x70, 0x75, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x70,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x50, 0x55, 0x53, 0x70, 0x65, 0x63, 0x52, 0x03, 0x67, 0x70,
-	0x75, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x64, 0x69, 0x73, 0x6b, 0x3a, 0x05, 0xba, 0x46, 0x02, 0x18, 0x01, 0x22, 0x3c, 0x0a, 0x07,
-	0x47, 0x50, 0x55, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x3a, 0x05, 0xba, 0x46, 0x02, 0x18, 0x01, 0x22, 0x5a, 0x0a, 0x0a, 0x4a, 0x6f,
-	0x62, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x27, 0x0a, 0x07, 0x6a, 0x6f, 0x62, 0x5f,
-	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x70, 0x73, 0x5f,
-	0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x06, 0x6a, 0x6f, 0x62, 0x53, 0x65,
-	0x74, 0x12, 0x23, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0x96, 0x0d, 0x0a, 0x07, 0x4a, 0x6f, 0x62, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0b, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f,
-	0x62, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x69, 0x70,
-	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x0d,
-	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x07, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64,
-	0x61, 0x74, 0x61, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x70,
-	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6b,
-	0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x54,
-	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x66, 0x61, 0x69,
-	0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x46,
-	0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x72, 0x65,
-	0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64,
-	0x61, 0x74, 0x61, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74,
-	0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x34,
-	0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
-	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x07,
-	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12,
-	0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x11, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0xde,
-	0x07, 0x0a, 0x07, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
-	0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x42, 0x0a, 0x10, 0x70,
-	0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50,
-	0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0f,
-	0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x12,
-	0x26, 0x0a, 0x06, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52,
-	0x06, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76,
-	0x32, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x73, 0x70, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x70, 0x6f, 0x75, 0x74,
-	0x52, 0x05, 0x73, 0x70, 0x6f, 0x75, 0x74, 0x12, 0x39, 0x0a, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x65,
-	0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x41, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53,
-	0x70, 0x65, 0x63, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x53, 0x70, 0x65, 0x63, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x73, 0x12, 0x4c, 0x0a, 0x17, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x15, 0x73, 0x69, 0x64,
-	0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x73, 0x12, 0x23, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x12, 0x3a, 0x0a, 0x0e, 0x64,
-	0x61, 0x74, 0x75, 0x6d, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x61, 0x74,
-	0x75, 0x6d, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x75, 0x6d,
-	0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x3e, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x75, 0x6d,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x75, 0x6d,
-	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x6a, 0x6f, 0x62, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6a, 0x6f, 0x62, 0x54, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x5f, 0x74, 0x72, 0x69,
-	0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x54,
-	0x72, 0x69, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69,
-	0x6e, 0x67, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e,
-	0x67, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e,
-	0x67, 0x53, 0x70, 0x65, 0x63, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x64, 0x5f, 0x73, 0x70, 0x65,
-	0x63, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x53, 0x70, 0x65, 0x63,
-	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x12, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x64, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x50, 0x0a,
-	0x19, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x17, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22,
-	0x47, 0x0a, 0x06, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70,
-	0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x52, 0x0a, 0x08, 0x50, 0x69, 0x70, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12,
-	0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
-	0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xe5, 0x01, 0x0a,
-	0x0a, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a,
-	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x1a, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x65,
-	0x66, 0x66, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x70,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x61, 0x69, 0x6e, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74,
-	0x52, 0x06, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x12, 0x4a, 0x0a, 0x12, 0x74, 0x6f, 0x6c, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x11, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63,
-	0x6f, 0x6e, 0x64, 0x73, 0x22, 0xd5, 0x11, 0x0a, 0x0c, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2c, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a,
-	0x0b, 0x73, 0x70, 0x65, 0x63, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x63, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x36, 0x0a,
-	0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a,
-	0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x62,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65,
-	0x6c, 0x69, 0x73, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x61,
-	0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x12, 0x35, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50,
-	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x69, 0x70, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x36, 0x0a,
-	0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x70,
-	0x65, 0x63, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75,
-	0x73, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x65,
-	0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x5f, 0x6a, 0x73,
-	0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x53, 0x70, 0x65, 0x63, 0x4a, 0x73, 0x6f, 0x6e, 0x1a, 0x9e, 0x0c, 0x0a, 0x07,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x6f, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x70, 0x73,
-	0x5f, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x09, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x24, 0x0a, 0x06, 0x74, 0x66, 0x5f, 0x6a,
-	0x6f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76,
-	0x32, 0x2e, 0x54, 0x46, 0x4a, 0x6f, 0x62, 0x52, 0x05, 0x74, 0x66, 0x4a, 0x6f, 0x62, 0x12, 0x42,
-	0x0a, 0x10, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x5f, 0x73, 0x70,
-	0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76,
-	0x32, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x53, 0x70, 0x65,
-	0x63, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x53, 0x70,
-	0x65, 0x63, 0x12, 0x26, 0x0a, 0x06, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45, 0x67, 0x72, 0x65,
-	0x73, 0x73, 0x52, 0x06, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x63,
-	0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x77, 0x6f, 0x72, 0x6b,
-	0x65, 0x72, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73,
-	0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
-	0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x62, 0x72, 0x61,
-	0x6e, 0x63, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x41, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x0f, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x4c, 0x0a, 0x17, 0x73, 0x69, 0x64,
-	0x65, 0x63, 0x61, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70, 0x73,
-	0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63,
-	0x52, 0x15, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e,
-	0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
-	0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x61,
-	0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x70,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x73, 0x70, 0x6f, 0x75, 0x74, 0x18, 0x14,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x70,
-	0x6f, 0x75, 0x74, 0x52, 0x05, 0x73, 0x70, 0x6f, 0x75, 0x74, 0x12, 0x3a, 0x0a, 0x0e, 0x64, 0x61,
-	0x74, 0x75, 0x6d, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x15, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x61, 0x74, 0x75,
-	0x6d, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x53,
-	0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x3e, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x54,
-	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6a, 0x6f, 0x62, 0x54, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x5f, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x54, 0x72,
-	0x69, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e,
-	0x67, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70,
-	0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67,
-	0x53, 0x70, 0x65, 0x63, 0x52, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67,
-	0x53, 0x70, 0x65, 0x63, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x63,
-	0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x53, 0x70, 0x65, 0x63, 0x12,
-	0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x1b, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x64, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x15, 0x0a, 0x06,
-	0x73, 0x33, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x33,
-	0x4f, 0x75, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x73,
-	0x70, 0x65, 0x63, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x53, 0x70, 0x65, 0x63, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x6e, 0x63, 0x6c,
-	0x61, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x1f, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0e, 0x75, 0x6e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b,
-	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x72, 0x63, 0x18, 0x20,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x63, 0x12, 0x20,
-	0x0a, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x21, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67,
-	0x12, 0x34, 0x0a, 0x0b, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x22, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x54,
-	0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x6f, 0x6c, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x50, 0x0a, 0x19, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61,
-	0x72, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x73, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f,
-	0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52,
-	0x17, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x64, 0x65, 0x74, 0x65,
-	0x72, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70,
-	0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x65, 0x64,
-	0x52, 0x0a, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x22, 0x7a, 0x0a, 0x0c,
-	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15,
-	0x50, 0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
-	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x49, 0x50, 0x45, 0x4c,
-	0x49, 0x4e, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f,
-	0x52, 0x4d, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x50, 0x4f, 0x55, 0x54, 0x10, 0x02, 0x12, 0x19, 0x0a,
-	0x15, 0x50, 0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53,
-	0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x52, 0x0c,
-	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x4a, 0x0a, 0x0d,
-	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x39, 0x0a,
-	0x0d, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x69,
-	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x70, 0x69, 0x70, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x18, 0x0a, 0x06, 0x4a, 0x6f, 0x62, 0x53,
-	0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x77, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x4a, 0x6f, 0x62,
-	0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x6a, 0x6f,
-	0x62, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x70,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05,
-	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x6a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x77, 0x61, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x77, 0x61, 0x69,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0xf0, 0x01, 0x0a, 0x11,
-	0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x08,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x46, 0x0a, 0x10, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72,
-	0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65,
-	0x72, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72,
-	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6a, 0x71, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x71, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x60,
-	0x0a, 0x11, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0b, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a,
-	0x6f, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x61, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x04, 0x77, 0x61, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x22, 0xe8, 0x02, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18,
-	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73,
-	0x12, 0x2c, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x70, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x31,
-	0x0a, 0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x52, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x64,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6a, 0x71, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x71, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x12, 0x46, 0x0a, 0x10, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x70,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x0b,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x75,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x52, 0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x38, 0x0a, 0x0e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x64, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0c, 0x75, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x52, 0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22,
+	0x7a, 0x0a, 0x0c, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06,
+	0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f,
+	0x62, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x70, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b,
+	0x64, 0x61, 0x74, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x6a, 0x0a, 0x0b, 0x44,
+	0x61, 0x74, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x22, 0x5d, 0x0a, 0x13, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x69,
-	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x31, 0x0a, 0x10, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x70,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x47, 0x0a,
-	0x0e, 0x53, 0x74, 0x6f, 0x70, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1d, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70,
-	0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x16,
-	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xf7, 0x02, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x27, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
-	0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a,
-	0x01, 0x02, 0x10, 0x01, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76,
-	0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x65, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x70, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x61, 0x74,
-	0x61, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61,
-	0x74, 0x61, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1f, 0x0a,
-	0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x25,
-	0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x63, 0x6f,
-	0x76, 0x65, 0x72, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x54,
-	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
-	0x22, 0xc4, 0x02, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50,
-	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
-	0x65, 0x12, 0x1d, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
-	0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62,
-	0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x61, 0x74, 0x75,
-	0x6d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x73, 0x74,
-	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
-	0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x69, 0x6c,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x28, 0x0a, 0x10,
-	0x75, 0x73, 0x65, 0x5f, 0x6c, 0x6f, 0x6b, 0x69, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x4c, 0x6f, 0x6b, 0x69, 0x42,
-	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x22, 0xbc, 0x02, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x69, 0x70,
-	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x15,
-	0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x49, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6d,
-	0x61, 0x73, 0x74, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x70,
-	0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x12, 0x2a, 0x0a, 0x02, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x76, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x12, 0x21, 0x0a, 0x03, 0x67, 0x70, 0x75, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x50, 0x55, 0x53, 0x70, 0x65, 0x63, 0x52,
+	0x03, 0x67, 0x70, 0x75, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x3a, 0x05, 0xba, 0x46, 0x02, 0x18, 0x01, 0x22,
+	0x3c, 0x0a, 0x07, 0x47, 0x50, 0x55, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x3a, 0x05, 0xba, 0x46, 0x02, 0x18, 0x01, 0x22, 0x5a, 0x0a,
+	0x0a, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x27, 0x0a, 0x07, 0x6a,
+	0x6f, 0x62, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70,
+	0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x06, 0x6a, 0x6f,
+	0x62, 0x53, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0x9f, 0x0e, 0x0a, 0x07, 0x4a, 0x6f,
+	0x62, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x52,
+	0x03, 0x6a, 0x6f, 0x62, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x33, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6b,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x61, 0x74,
+	0x61, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x61,
+	0x74, 0x61, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x61,
+	0x74, 0x61, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x12,
+	0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x70, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x57, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
-	0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x70, 0x73,
-	0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x21, 0x0a, 0x0c,
-	0x64, 0x61, 0x74, 0x61, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22,
-	0x3a, 0x0a, 0x13, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44,
-	0x61, 0x74, 0x75, 0x6d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x22, 0xa1, 0x02, 0x0a, 0x10,
-	0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1d, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
-	0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12,
-	0x23, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
-	0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69,
-	0x6e, 0x70, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a,
-	0x10, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x1a, 0x32, 0x0a, 0x06, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x61,
-	0x74, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22,
-	0x6b, 0x0a, 0x0c, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12,
-	0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f,
-	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a,
-	0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x6f,
-	0x72, 0x6b, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x65, 0x72, 0x57,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x3a, 0x05, 0xba, 0x46, 0x02, 0x18, 0x01, 0x22, 0xd7, 0x01, 0x0a,
-	0x0e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x63, 0x12,
-	0x4d, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e,
-	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x4e,
-	0x6f, 0x64, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2e,
-	0x0a, 0x13, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x69,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x3f,
-	0x0a, 0x11, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a,
-	0x05, 0xba, 0x46, 0x02, 0x18, 0x01, 0x22, 0x6c, 0x0a, 0x14, 0x52, 0x65, 0x72, 0x75, 0x6e, 0x50,
-	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36,
-	0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x70, 0x69,
-	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x70, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x22, 0xfb, 0x0b, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
-	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c,
-	0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x24, 0x0a, 0x06,
-	0x74, 0x66, 0x5f, 0x6a, 0x6f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70,
-	0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x46, 0x4a, 0x6f, 0x62, 0x52, 0x05, 0x74, 0x66, 0x4a,
-	0x6f, 0x62, 0x12, 0x2f, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x6f, 0x72, 0x6d, 0x12, 0x42, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69,
-	0x73, 0x6d, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69,
-	0x73, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c,
-	0x69, 0x73, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x12, 0x26, 0x0a, 0x06, 0x65, 0x67, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x06, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x1d, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42,
-	0x05, 0xaa, 0x46, 0x02, 0x08, 0x01, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x23,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12,
+	0x31, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66,
+	0x6f, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x46, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x1a, 0xde, 0x07, 0x0a, 0x07, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x09, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x42, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c,
+	0x65, 0x6c, 0x69, 0x73, 0x6d, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x6c,
+	0x65, 0x6c, 0x69, 0x73, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x61, 0x6c,
+	0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x12, 0x26, 0x0a, 0x06, 0x65, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x70, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x06, 0x65, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a,
+	0x05, 0x73, 0x70, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70,
+	0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x70, 0x6f, 0x75, 0x74, 0x52, 0x05, 0x73, 0x70, 0x6f,
+	0x75, 0x74, 0x12, 0x39, 0x0a, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x41, 0x0a,
+	0x11, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x10,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x12, 0x3d, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52,
+	0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12,
+	0x4c, 0x0a, 0x17, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x15, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x23, 0x0a,
+	0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70,
+	0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x12, 0x3a, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x5f,
+	0x73, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x53, 0x65, 0x74,
+	0x53, 0x70, 0x65, 0x63, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x53, 0x65, 0x74, 0x53, 0x70,
+	0x65, 0x63, 0x12, 0x3e, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x6a, 0x6f, 0x62, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x5f, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x54, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x3f, 0x0a, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x70,
+	0x65, 0x63, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x63,
+	0x52, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x63,
+	0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x53, 0x70, 0x65, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x6f, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x6f, 0x64, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x50, 0x0a, 0x19, 0x73, 0x69, 0x64, 0x65,
+	0x63, 0x61, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65,
+	0x63, 0x52, 0x17, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x54, 0x72,
+	0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x47, 0x0a, 0x06, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x22, 0x52, 0x0a, 0x08, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x29, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x20, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xe5, 0x01, 0x0a, 0x0a, 0x54, 0x6f, 0x6c,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x08, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x70,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x65, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x54, 0x61, 0x69, 0x6e, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x52, 0x06, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x12, 0x4a, 0x0a, 0x12, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x74,
+	0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x22, 0x97, 0x12, 0x0a, 0x0c, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x2c, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x0b, 0x73, 0x70, 0x65,
+	0x63, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x0a,
+	0x73, 0x70, 0x65, 0x63, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74,
+	0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x74, 0x6f,
+	0x70, 0x70, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0e, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c,
+	0x69, 0x73, 0x6d, 0x12, 0x35, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x21, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75,
+	0x74, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x70, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x12, 0x24, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x5f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x53,
+	0x70, 0x65, 0x63, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53,
+	0x70, 0x65, 0x63, 0x4a, 0x73, 0x6f, 0x6e, 0x1a, 0xe0, 0x0c, 0x0a, 0x07, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x12, 0x24, 0x0a, 0x06, 0x74, 0x66, 0x5f, 0x6a, 0x6f, 0x62, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x46,
+	0x4a, 0x6f, 0x62, 0x52, 0x05, 0x74, 0x66, 0x4a, 0x6f, 0x62, 0x12, 0x42, 0x0a, 0x10, 0x70, 0x61,
+	0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x61,
+	0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0f, 0x70,
+	0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x12, 0x26,
+	0x0a, 0x06, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x06,
+	0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x12, 0x2b, 0x0a, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x5f, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x77, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x23,
 	0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x72, 0x61,
-	0x6e, 0x63, 0x68, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x33, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x33, 0x4f, 0x75, 0x74, 0x12, 0x41, 0x0a, 0x11, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x10, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x3d, 0x0a,
-	0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0e, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x4c, 0x0a, 0x17,
-	0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53,
-	0x70, 0x65, 0x63, 0x52, 0x15, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x05, 0x69, 0x6e,
-	0x70, 0x75, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x70, 0x73, 0x5f,
-	0x76, 0x32, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x23, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x0f,
-	0x20, 0x01, 0x28, 0x08, 0x42, 0x05, 0xaa, 0x46, 0x02, 0x08, 0x01, 0x52, 0x09, 0x72, 0x65, 0x70,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x12, 0x41, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x53, 0x70, 0x65, 0x63, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x4c, 0x0a, 0x17, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72,
+	0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x15, 0x73, 0x69,
+	0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61,
+	0x6c, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32,
 	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x23, 0x0a, 0x05, 0x73, 0x70, 0x6f, 0x75, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b,
+	0x65, 0x12, 0x23, 0x0a, 0x05, 0x73, 0x70, 0x6f, 0x75, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x0d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x70, 0x6f, 0x75, 0x74, 0x52,
 	0x05, 0x73, 0x70, 0x6f, 0x75, 0x74, 0x12, 0x3a, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x5f,
-	0x73, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x73, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
 	0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x53, 0x65, 0x74,
 	0x53, 0x70, 0x65, 0x63, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x53, 0x65, 0x74, 0x53, 0x70,
 	0x65, 0x63, 0x12, 0x3e, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6f, 0x75, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x6f,
 	0x75, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x0a, 0x6a, 0x6f, 0x62, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x61,
-	0x6c, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x5f, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x54, 0x72,
-	0x69, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e,
-	0x67, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70,
-	0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67,
-	0x53, 0x70, 0x65, 0x63, 0x52, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67,
-	0x53, 0x70, 0x65, 0x63, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x63,
-	0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x53, 0x70, 0x65, 0x63, 0x12,
-	0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x1a, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x64, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x36, 0x0a, 0x0b,
-	0x73, 0x70, 0x65, 0x63, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x1b, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x42, 0x05, 0xaa, 0x46, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x63, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f,
-	0x73, 0x70, 0x65, 0x63, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x70, 0x65, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x75, 0x74,
-	0x6f, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
-	0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x34, 0x0a, 0x0b, 0x74,
-	0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x22, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x50, 0x0a, 0x19, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x23,
+	0x6f, 0x6e, 0x52, 0x0a, 0x6a, 0x6f, 0x62, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x5f, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x18, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x54, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x3f, 0x0a, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x70,
+	0x65, 0x63, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x63,
+	0x52, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x63,
+	0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x53, 0x70, 0x65, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x6f, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x6f, 0x64, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x33, 0x5f, 0x6f,
+	0x75, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x33, 0x4f, 0x75, 0x74, 0x12,
+	0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x1d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18,
+	0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x53, 0x70, 0x65, 0x63, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x6e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65,
+	0x64, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x75,
+	0x6e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x72, 0x63, 0x18, 0x20, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x75,
+	0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x21, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x34, 0x0a, 0x0b,
+	0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x22, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x6f, 0x6c, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x50, 0x0a, 0x19, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
+	0x23, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x17, 0x73, 0x69, 0x64,
+	0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x65, 0x64, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x0a, 0x64, 0x65,
+	0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x0f, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x25, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x22, 0x7a, 0x0a, 0x0c, 0x50, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x49,
+	0x50, 0x45, 0x4c, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e,
+	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d,
+	0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x53, 0x50, 0x4f, 0x55, 0x54, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x50,
+	0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x52,
+	0x56, 0x49, 0x43, 0x45, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x52, 0x0c, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x4a, 0x0a, 0x0d, 0x50, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x70,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x18, 0x0a, 0x06, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x77, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x6a, 0x6f, 0x62, 0x5f,
+	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x70, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01,
+	0x02, 0x10, 0x01, 0x52, 0x06, 0x6a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x77,
+	0x61, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x77, 0x61, 0x69, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0xf0, 0x01, 0x0a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x46, 0x0a, 0x10, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x6a, 0x71, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6a, 0x71, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x60, 0x0a, 0x11,
+	0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62,
+	0x12, 0x12, 0x0a, 0x04, 0x77, 0x61, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x77, 0x61, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0xe8,
+	0x02, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x2c,
+	0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x31, 0x0a, 0x0c,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x52, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6a, 0x71, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x71, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x46, 0x0a, 0x10, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x22, 0x5d, 0x0a, 0x13, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2c, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x31, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x03,
+	0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x70, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x47, 0x0a, 0x0e, 0x53,
+	0x74, 0x6f, 0x70, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x70, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0xf7, 0x02, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a,
+	0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27,
+	0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x70,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02,
+	0x10, 0x01, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x64, 0x61, 0x74, 0x61, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xc4,
+	0x02, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2c, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x1d, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70,
+	0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x23, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x52,
+	0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x75, 0x73,
+	0x65, 0x5f, 0x6c, 0x6f, 0x6b, 0x69, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x4c, 0x6f, 0x6b, 0x69, 0x42, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x22, 0xbc, 0x02, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06,
+	0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f,
+	0x62, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x2a,
+	0x0a, 0x02, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x57, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x03, 0x6a,
+	0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x3a, 0x0a,
+	0x13, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x61, 0x74,
+	0x75, 0x6d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x22, 0xa1, 0x02, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x70,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x23, 0x0a,
+	0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70,
+	0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x61, 0x74, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x1a, 0x32, 0x0a, 0x06, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x61, 0x74, 0x75,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x6b, 0x0a,
+	0x0c, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x16, 0x0a,
+	0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x65, 0x72, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x3a, 0x05, 0xba, 0x46, 0x02, 0x18, 0x01, 0x22, 0xd7, 0x01, 0x0a, 0x0e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x63, 0x12, 0x4d, 0x0a,
+	0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c,
+	0x6e, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x13,
+	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x3f, 0x0a, 0x11,
+	0x4e, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x05, 0xba,
+	0x46, 0x02, 0x18, 0x01, 0x22, 0x6c, 0x0a, 0x14, 0x52, 0x65, 0x72, 0x75, 0x6e, 0x50, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x08,
+	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x22, 0xbd, 0x0c, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08,
+	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x74, 0x66,
+	0x5f, 0x6a, 0x6f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x70, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x54, 0x46, 0x4a, 0x6f, 0x62, 0x52, 0x05, 0x74, 0x66, 0x4a, 0x6f, 0x62,
+	0x12, 0x2f, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
+	0x6d, 0x12, 0x42, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d,
+	0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x70,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d,
+	0x53, 0x70, 0x65, 0x63, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73,
+	0x6d, 0x53, 0x70, 0x65, 0x63, 0x12, 0x26, 0x0a, 0x06, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x45,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x06, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a,
+	0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x05, 0xaa,
+	0x46, 0x02, 0x08, 0x01, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x33, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x73, 0x33, 0x4f, 0x75, 0x74, 0x12, 0x41, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x0f, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x0a,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x17, 0x73, 0x69, 0x64, 0x65,
-	0x63, 0x61, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x25,
-	0x20, 0x01, 0x28, 0x08, 0x42, 0x05, 0xaa, 0x46, 0x02, 0x08, 0x01, 0x52, 0x06, 0x64, 0x72, 0x79,
-	0x52, 0x75, 0x6e, 0x12, 0x32, 0x0a, 0x0a, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x65,
-	0x64, 0x18, 0x26, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x44, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x0a, 0x64, 0x65, 0x74,
-	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x3a, 0x05, 0xba, 0x46, 0x02, 0x18, 0x01, 0x4a, 0x04,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x4c, 0x0a, 0x17, 0x73, 0x69,
+	0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65,
+	0x63, 0x52, 0x15, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x23, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x05, 0xaa, 0x46, 0x02, 0x08, 0x01, 0x52, 0x09, 0x72, 0x65, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x23, 0x0a, 0x05, 0x73, 0x70, 0x6f, 0x75, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x70, 0x6f, 0x75, 0x74, 0x52, 0x05, 0x73,
+	0x70, 0x6f, 0x75, 0x74, 0x12, 0x3a, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x5f, 0x73, 0x65,
+	0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x53, 0x65, 0x74, 0x53, 0x70,
+	0x65, 0x63, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63,
+	0x12, 0x3e, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x12, 0x3a, 0x0a, 0x0b, 0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x6a, 0x6f, 0x62, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x61, 0x6c, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x5f, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x54, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x3f, 0x0a, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x5f,
+	0x73, 0x70, 0x65, 0x63, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x70, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x70,
+	0x65, 0x63, 0x52, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x70,
+	0x65, 0x63, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x19,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x53, 0x70, 0x65, 0x63, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x6f, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x6f, 0x64, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x36, 0x0a, 0x0b, 0x73, 0x70,
+	0x65, 0x63, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42,
+	0x05, 0xaa, 0x46, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x63, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x1c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x70,
+	0x65, 0x63, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x53, 0x70, 0x65, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x73,
+	0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x75,
+	0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x34, 0x0a, 0x0b, 0x74, 0x6f, 0x6c,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x22, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x50, 0x0a, 0x19, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x23, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x17, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61,
+	0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x12, 0x1e, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x25, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x05, 0xaa, 0x46, 0x02, 0x08, 0x01, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x12, 0x32, 0x0a, 0x0a, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x18,
+	0x26, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44,
+	0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x0a, 0x64, 0x65, 0x74, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x0f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x27, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x3a, 0x05, 0xba, 0x46, 0x02, 0x18, 0x01, 0x4a, 0x04,
 	0x08, 0x24, 0x10, 0x25, 0x52, 0x0c, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x5f, 0x6a, 0x73,
 	0x6f, 0x6e, 0x22, 0xa9, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70,
 	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x56, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f,
@@ -8134,225 +9556,406 @@ var file_pps_pps_proto_rawDesc = []byte{
 	0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
 	0x74, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x22, 0x5d, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x12, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64,
-	0x5f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x52, 0x11, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x69, 0x70, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x2a, 0xc1, 0x01, 0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
-	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4a, 0x4f, 0x42,
-	0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4a, 0x4f,
-	0x42, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b,
-	0x4a, 0x4f, 0x42, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x0f, 0x0a,
-	0x0b, 0x4a, 0x4f, 0x42, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x04, 0x12, 0x0f,
-	0x0a, 0x0b, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x05, 0x12,
-	0x0e, 0x0a, 0x0a, 0x4a, 0x4f, 0x42, 0x5f, 0x4b, 0x49, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x12,
-	0x11, 0x0a, 0x0d, 0x4a, 0x4f, 0x42, 0x5f, 0x45, 0x47, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47,
-	0x10, 0x07, 0x12, 0x11, 0x0a, 0x0d, 0x4a, 0x4f, 0x42, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48,
-	0x49, 0x4e, 0x47, 0x10, 0x08, 0x12, 0x12, 0x0a, 0x0e, 0x4a, 0x4f, 0x42, 0x5f, 0x55, 0x4e, 0x52,
-	0x55, 0x4e, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x09, 0x2a, 0x5c, 0x0a, 0x0a, 0x44, 0x61, 0x74,
-	0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
-	0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01,
-	0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x0b, 0x0a,
-	0x07, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x54,
-	0x41, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x45, 0x43, 0x4f,
-	0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x05, 0x2a, 0x59, 0x0a, 0x0b, 0x57, 0x6f, 0x72, 0x6b, 0x65,
-	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
-	0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x4f, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10,
-	0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x4f, 0x44, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53,
-	0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x4f, 0x44, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
-	0x10, 0x03, 0x2a, 0xc9, 0x01, 0x0a, 0x0d, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
-	0x12, 0x15, 0x0a, 0x11, 0x50, 0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41,
-	0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x49, 0x50, 0x45, 0x4c,
-	0x49, 0x4e, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x17, 0x0a,
-	0x13, 0x50, 0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52,
-	0x54, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x49, 0x50, 0x45, 0x4c, 0x49,
-	0x4e, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f,
-	0x50, 0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x10,
-	0x05, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54,
-	0x41, 0x4e, 0x44, 0x42, 0x59, 0x10, 0x06, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x49, 0x50, 0x45, 0x4c,
-	0x49, 0x4e, 0x45, 0x5f, 0x43, 0x52, 0x41, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x10, 0x07, 0x2a, 0x36,
-	0x0a, 0x12, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x00, 0x12,
-	0x0a, 0x0a, 0x06, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45,
-	0x51, 0x55, 0x41, 0x4c, 0x10, 0x02, 0x2a, 0x57, 0x0a, 0x0b, 0x54, 0x61, 0x69, 0x6e, 0x74, 0x45,
-	0x66, 0x66, 0x65, 0x63, 0x74, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x4c, 0x4c, 0x5f, 0x45, 0x46, 0x46,
-	0x45, 0x43, 0x54, 0x53, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x5f, 0x53, 0x43, 0x48,
-	0x45, 0x44, 0x55, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x52, 0x45, 0x46, 0x45,
-	0x52, 0x5f, 0x4e, 0x4f, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x10, 0x02, 0x12,
-	0x0e, 0x0a, 0x0a, 0x4e, 0x4f, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45, 0x10, 0x03, 0x32,
-	0xbe, 0x14, 0x0a, 0x03, 0x41, 0x50, 0x49, 0x12, 0x3a, 0x0a, 0x0a, 0x49, 0x6e, 0x73, 0x70, 0x65,
-	0x63, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x19, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49,
-	0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x0f, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66,
-	0x6f, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x4a, 0x6f,
-	0x62, 0x53, 0x65, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e,
-	0x73, 0x70, 0x65, 0x63, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x49,
-	0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x36, 0x0a, 0x07, 0x4c, 0x69, 0x73, 0x74, 0x4a,
-	0x6f, 0x62, 0x12, 0x16, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x70, 0x70, 0x73,
-	0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12,
-	0x3f, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x12, 0x19, 0x2e,
-	0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76,
-	0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01,
-	0x12, 0x40, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x6f, 0x62,
-	0x12, 0x1b, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e,
-	0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00,
-	0x30, 0x01, 0x12, 0x3f, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x12,
-	0x18, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a,
-	0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x07, 0x53, 0x74, 0x6f, 0x70, 0x4a, 0x6f, 0x62, 0x12, 0x16,
-	0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x4a, 0x6f, 0x62, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x40, 0x0a, 0x0c, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x44, 0x61, 0x74, 0x75, 0x6d,
-	0x12, 0x1b, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63,
-	0x74, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
-	0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x49, 0x6e, 0x66, 0x6f,
-	0x22, 0x00, 0x12, 0x3c, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x12,
-	0x18, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74,
-	0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x70, 0x73, 0x5f,
-	0x76, 0x32, 0x2e, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01,
-	0x12, 0x45, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x75, 0x6d,
-	0x12, 0x1b, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0d, 0x52, 0x65, 0x72, 0x75, 0x6e,
-	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1c, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76,
-	0x32, 0x2e, 0x52, 0x65, 0x72, 0x75, 0x6e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x49, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x56, 0x32, 0x12,
-	0x1f, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
-	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x56, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x20, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x56, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0f, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x50,
-	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12,
-	0x45, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12,
-	0x1b, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x70,
-	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70,
+	0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x12, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x52, 0x11, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x54, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02,
+	0x10, 0x01, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x64, 0x0a, 0x1e, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x22, 0x22, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xbb, 0x04, 0x0a, 0x11, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x53, 0x0a, 0x0f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x48, 0x00,
+	0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x12, 0x4a, 0x0a, 0x0c, 0x6a, 0x6f, 0x62, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x2e, 0x4a, 0x6f, 0x62, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x48, 0x00, 0x52,
+	0x0b, 0x6a, 0x6f, 0x62, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x66, 0x0a, 0x16,
+	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x70,
+	0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x48, 0x00, 0x52, 0x14,
+	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x1a, 0x38, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x1a, 0x65,
+	0x0a, 0x0b, 0x4a, 0x6f, 0x62, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x2c, 0x0a,
+	0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x70,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x73, 0x1a, 0x73, 0x0a, 0x14, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x2c, 0x0a,
+	0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x70,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x22, 0xe4, 0x01, 0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x38, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x31, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xca, 0x01, 0x0a, 0x19, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x31, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x34, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x19, 0x0a,
+	0x17, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x55, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x85, 0x03, 0x0a, 0x18, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x0c,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x37, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x21, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5b, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0c, 0x6e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xec, 0x02, 0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x49, 0x64, 0x12, 0x38, 0x0a,
+	0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x0c, 0x6a, 0x6f, 0x62, 0x5f, 0x66, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
+	0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52,
+	0x0b, 0x6a, 0x6f, 0x62, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x16,
+	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
 	0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76,
-	0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x54, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1c, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76,
-	0x32, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x45, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x12, 0x1b, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x69,
-	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x50, 0x69,
-	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1a, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e,
-	0x52, 0x75, 0x6e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x07,
-	0x52, 0x75, 0x6e, 0x43, 0x72, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x52, 0x75, 0x6e, 0x43, 0x72, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0c, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x70, 0x73, 0x5f,
-	0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52,
+	0x66, 0x6f, 0x48, 0x00, 0x52, 0x14, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x2a, 0xc1, 0x01, 0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x15, 0x0a, 0x11, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4a, 0x4f, 0x42, 0x5f, 0x43,
+	0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4a, 0x4f, 0x42, 0x5f,
+	0x53, 0x54, 0x41, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4a, 0x4f,
+	0x42, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x4a,
+	0x4f, 0x42, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b,
+	0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x05, 0x12, 0x0e, 0x0a,
+	0x0a, 0x4a, 0x4f, 0x42, 0x5f, 0x4b, 0x49, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x12, 0x11, 0x0a,
+	0x0d, 0x4a, 0x4f, 0x42, 0x5f, 0x45, 0x47, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x07,
+	0x12, 0x11, 0x0a, 0x0d, 0x4a, 0x4f, 0x42, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x49, 0x4e,
+	0x47, 0x10, 0x08, 0x12, 0x12, 0x0a, 0x0e, 0x4a, 0x4f, 0x42, 0x5f, 0x55, 0x4e, 0x52, 0x55, 0x4e,
+	0x4e, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x09, 0x2a, 0x5c, 0x0a, 0x0a, 0x44, 0x61, 0x74, 0x75, 0x6d,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b,
+	0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x53,
+	0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x54, 0x41, 0x52,
+	0x54, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45,
+	0x52, 0x45, 0x44, 0x10, 0x05, 0x2a, 0x59, 0x0a, 0x0b, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0f,
+	0x0a, 0x0b, 0x50, 0x4f, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
+	0x0f, 0x0a, 0x0b, 0x50, 0x4f, 0x44, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x02,
+	0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x4f, 0x44, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03,
+	0x2a, 0xc9, 0x01, 0x0a, 0x0d, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x15,
+	0x0a, 0x11, 0x50, 0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e,
+	0x45, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x50,
+	0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52, 0x54, 0x49,
+	0x4e, 0x47, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x49,
+	0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x05, 0x12,
+	0x14, 0x0a, 0x10, 0x50, 0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x4e,
+	0x44, 0x42, 0x59, 0x10, 0x06, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e,
+	0x45, 0x5f, 0x43, 0x52, 0x41, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x10, 0x07, 0x2a, 0x36, 0x0a, 0x12,
+	0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x00, 0x12, 0x0a, 0x0a,
+	0x06, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x51, 0x55,
+	0x41, 0x4c, 0x10, 0x02, 0x2a, 0x57, 0x0a, 0x0b, 0x54, 0x61, 0x69, 0x6e, 0x74, 0x45, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x4c, 0x4c, 0x5f, 0x45, 0x46, 0x46, 0x45, 0x43,
+	0x54, 0x53, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44,
+	0x55, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x52, 0x45, 0x46, 0x45, 0x52, 0x5f,
+	0x4e, 0x4f, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x0e, 0x0a,
+	0x0a, 0x4e, 0x4f, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45, 0x10, 0x03, 0x2a, 0xae, 0x01,
+	0x0a, 0x19, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x23, 0x4e,
+	0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x49,
+	0x56, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x50, 0x45,
+	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x4e, 0x4f, 0x54, 0x49, 0x46,
+	0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59,
+	0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c,
+	0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4c,
+	0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x32, 0x99,
+	0x18, 0x0a, 0x03, 0x41, 0x50, 0x49, 0x12, 0x3a, 0x0a, 0x0a, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x4a, 0x6f, 0x62, 0x12, 0x19, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0f, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f,
+	0x22, 0x00, 0x12, 0x42, 0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x4a, 0x6f, 0x62,
+	0x53, 0x65, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0f, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x36, 0x0a, 0x07, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f,
+	0x62, 0x12, 0x16, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x70, 0x70, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3f,
+	0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x70,
+	0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x40, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x6f, 0x62, 0x12,
+	0x1b, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x70,
+	0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x3f, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x18,
+	0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x3b, 0x0a, 0x07, 0x53, 0x74, 0x6f, 0x70, 0x4a, 0x6f, 0x62, 0x12, 0x16, 0x2e,
+	0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x40, 0x0a, 0x0c, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x12,
+	0x1b, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x44, 0x61, 0x74, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70,
+	0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x22,
+	0x00, 0x12, 0x3c, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x12, 0x18,
+	0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x75,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x45, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x12,
+	0x1b, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x44, 0x61, 0x74, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0d, 0x52, 0x65, 0x72, 0x75, 0x6e, 0x50,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1c, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x52, 0x65, 0x72, 0x75, 0x6e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x49, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x1d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x56, 0x32, 0x12, 0x1f,
+	0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x56, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x56, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0f, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x50, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x45,
+	0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1b,
+	0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x70,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x45, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x12, 0x1b, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x53,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e,
-	0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x73, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x53,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49,
-	0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x09, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4c,
-	0x6f, 0x67, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74,
-	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x70,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0x00, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41,
-	0x75, 0x74, 0x68, 0x12, 0x1b, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1c, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x49, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x12, 0x54, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1c, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x45, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x1b, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x50, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1a, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52,
+	0x75, 0x6e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x52,
-	0x75, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x70, 0x73,
-	0x5f, 0x76, 0x32, 0x2e, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x65, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e,
-	0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x12, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x61, 0x64,
-	0x54, 0x65, 0x73, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x75, 0x6e,
-	0x4c, 0x6f, 0x61, 0x64, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x6e,
-	0x64, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73,
-	0x6b, 0x12, 0x18, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x74, 0x61,
-	0x73, 0x6b, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00,
-	0x30, 0x01, 0x12, 0x40, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x12, 0x13, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x6f, 0x6b,
-	0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76,
-	0x32, 0x2e, 0x4c, 0x6f, 0x6b, 0x69, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x00, 0x30, 0x01, 0x12, 0x3c, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f, 0x6b,
-	0x69, 0x12, 0x13, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x6f, 0x6b, 0x69, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e,
-	0x4c, 0x6f, 0x6b, 0x69, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00,
-	0x30, 0x01, 0x12, 0x5d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76,
-	0x32, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x70,
-	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x5d, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x07, 0x52,
+	0x75, 0x6e, 0x43, 0x72, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x52, 0x75, 0x6e, 0x43, 0x72, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76,
+	0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x45, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12,
+	0x1b, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x70,
+	0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x73, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x49, 0x6e,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x41, 0x6c, 0x6c, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4c, 0x6f,
+	0x67, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x4c,
+	0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x70, 0x73,
+	0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x4b, 0x0a, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x75,
+	0x74, 0x68, 0x12, 0x1b, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x49, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x1d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x52, 0x75,
+	0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x70, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52,
+	0x75, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x12, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x54,
+	0x65, 0x73, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x75, 0x6e, 0x4c,
+	0x6f, 0x61, 0x64, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x51, 0x0a, 0x0e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b,
+	0x12, 0x18, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x74, 0x61, 0x73,
+	0x6b, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x40, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x13, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x6f, 0x6b, 0x69,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x4c, 0x6f, 0x6b, 0x69, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x00, 0x30, 0x01, 0x12, 0x3c, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f, 0x6b, 0x69,
+	0x12, 0x13, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x6f, 0x6b, 0x69, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c,
+	0x6f, 0x6b, 0x69, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x5d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44,
 	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32,
-	0x2e, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x66, 0x61, 0x75,
 	0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x70, 0x73,
-	0x5f, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65,
+	0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65,
 	0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
-	0x61, 0x63, 0x68, 0x79, 0x64, 0x65, 0x72, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64, 0x65,
-	0x72, 0x6d, 0x2f, 0x76, 0x32, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x70, 0x70, 0x73, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x5d, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e,
+	0x53, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x70, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x69, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x75, 0x74, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x2e, 0x70, 0x70, 0x73, 0x5f,
+	0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x75, 0x74, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x12, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x21, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e,
+	0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x51, 0x0a, 0x12,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x69, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x27, 0x2e, 0x70, 0x70,
+	0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x70, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64, 0x65,
+	0x72, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64, 0x65, 0x72, 0x6d, 0x2f, 0x76, 0x32, 0x2f,
+	0x73, 0x72, 0x63, 0x2f, 0x70, 0x70, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -8367,370 +9970,439 @@ func file_pps_pps_proto_rawDescGZIP() []byte {
 	return file_pps_pps_proto_rawDescData
 }
 
-var file_pps_pps_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_pps_pps_proto_msgTypes = make([]protoimpl.MessageInfo, 93)
+var file_pps_pps_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_pps_pps_proto_msgTypes = make([]protoimpl.MessageInfo, 112)
 var file_pps_pps_proto_goTypes = []interface{}{
-	(JobState)(0),                      // 0: pps_v2.JobState
-	(DatumState)(0),                    // 1: pps_v2.DatumState
-	(WorkerState)(0),                   // 2: pps_v2.WorkerState
-	(PipelineState)(0),                 // 3: pps_v2.PipelineState
-	(TolerationOperator)(0),            // 4: pps_v2.TolerationOperator
-	(TaintEffect)(0),                   // 5: pps_v2.TaintEffect
-	(PipelineInfo_PipelineType)(0),     // 6: pps_v2.PipelineInfo.PipelineType
-	(*SecretMount)(nil),                // 7: pps_v2.SecretMount
-	(*Transform)(nil),                  // 8: pps_v2.Transform
-	(*TFJob)(nil),                      // 9: pps_v2.TFJob
-	(*Egress)(nil),                     // 10: pps_v2.Egress
-	(*Determined)(nil),                 // 11: pps_v2.Determined
-	(*Job)(nil),                        // 12: pps_v2.Job
-	(*Metadata)(nil),                   // 13: pps_v2.Metadata
-	(*Service)(nil),                    // 14: pps_v2.Service
-	(*Spout)(nil),                      // 15: pps_v2.Spout
-	(*PFSInput)(nil),                   // 16: pps_v2.PFSInput
-	(*CronInput)(nil),                  // 17: pps_v2.CronInput
-	(*Input)(nil),                      // 18: pps_v2.Input
-	(*JobInput)(nil),                   // 19: pps_v2.JobInput
-	(*ParallelismSpec)(nil),            // 20: pps_v2.ParallelismSpec
-	(*InputFile)(nil),                  // 21: pps_v2.InputFile
-	(*Datum)(nil),                      // 22: pps_v2.Datum
-	(*DatumInfo)(nil),                  // 23: pps_v2.DatumInfo
-	(*Aggregate)(nil),                  // 24: pps_v2.Aggregate
-	(*ProcessStats)(nil),               // 25: pps_v2.ProcessStats
-	(*AggregateProcessStats)(nil),      // 26: pps_v2.AggregateProcessStats
-	(*WorkerStatus)(nil),               // 27: pps_v2.WorkerStatus
-	(*DatumStatus)(nil),                // 28: pps_v2.DatumStatus
-	(*ResourceSpec)(nil),               // 29: pps_v2.ResourceSpec
-	(*GPUSpec)(nil),                    // 30: pps_v2.GPUSpec
-	(*JobSetInfo)(nil),                 // 31: pps_v2.JobSetInfo
-	(*JobInfo)(nil),                    // 32: pps_v2.JobInfo
-	(*Worker)(nil),                     // 33: pps_v2.Worker
-	(*Pipeline)(nil),                   // 34: pps_v2.Pipeline
-	(*Toleration)(nil),                 // 35: pps_v2.Toleration
-	(*PipelineInfo)(nil),               // 36: pps_v2.PipelineInfo
-	(*PipelineInfos)(nil),              // 37: pps_v2.PipelineInfos
-	(*JobSet)(nil),                     // 38: pps_v2.JobSet
-	(*InspectJobSetRequest)(nil),       // 39: pps_v2.InspectJobSetRequest
-	(*ListJobSetRequest)(nil),          // 40: pps_v2.ListJobSetRequest
-	(*InspectJobRequest)(nil),          // 41: pps_v2.InspectJobRequest
-	(*ListJobRequest)(nil),             // 42: pps_v2.ListJobRequest
-	(*SubscribeJobRequest)(nil),        // 43: pps_v2.SubscribeJobRequest
-	(*DeleteJobRequest)(nil),           // 44: pps_v2.DeleteJobRequest
-	(*StopJobRequest)(nil),             // 45: pps_v2.StopJobRequest
-	(*UpdateJobStateRequest)(nil),      // 46: pps_v2.UpdateJobStateRequest
-	(*GetLogsRequest)(nil),             // 47: pps_v2.GetLogsRequest
-	(*LogMessage)(nil),                 // 48: pps_v2.LogMessage
-	(*RestartDatumRequest)(nil),        // 49: pps_v2.RestartDatumRequest
-	(*InspectDatumRequest)(nil),        // 50: pps_v2.InspectDatumRequest
-	(*ListDatumRequest)(nil),           // 51: pps_v2.ListDatumRequest
-	(*DatumSetSpec)(nil),               // 52: pps_v2.DatumSetSpec
-	(*SchedulingSpec)(nil),             // 53: pps_v2.SchedulingSpec
-	(*RerunPipelineRequest)(nil),       // 54: pps_v2.RerunPipelineRequest
-	(*CreatePipelineRequest)(nil),      // 55: pps_v2.CreatePipelineRequest
-	(*CreatePipelineV2Request)(nil),    // 56: pps_v2.CreatePipelineV2Request
-	(*CreatePipelineV2Response)(nil),   // 57: pps_v2.CreatePipelineV2Response
-	(*InspectPipelineRequest)(nil),     // 58: pps_v2.InspectPipelineRequest
-	(*ListPipelineRequest)(nil),        // 59: pps_v2.ListPipelineRequest
-	(*DeletePipelineRequest)(nil),      // 60: pps_v2.DeletePipelineRequest
-	(*DeletePipelinesRequest)(nil),     // 61: pps_v2.DeletePipelinesRequest
-	(*DeletePipelinesResponse)(nil),    // 62: pps_v2.DeletePipelinesResponse
-	(*StartPipelineRequest)(nil),       // 63: pps_v2.StartPipelineRequest
-	(*StopPipelineRequest)(nil),        // 64: pps_v2.StopPipelineRequest
-	(*RunPipelineRequest)(nil),         // 65: pps_v2.RunPipelineRequest
-	(*RunCronRequest)(nil),             // 66: pps_v2.RunCronRequest
-	(*CreateSecretRequest)(nil),        // 67: pps_v2.CreateSecretRequest
-	(*DeleteSecretRequest)(nil),        // 68: pps_v2.DeleteSecretRequest
-	(*InspectSecretRequest)(nil),       // 69: pps_v2.InspectSecretRequest
-	(*Secret)(nil),                     // 70: pps_v2.Secret
-	(*SecretInfo)(nil),                 // 71: pps_v2.SecretInfo
-	(*SecretInfos)(nil),                // 72: pps_v2.SecretInfos
-	(*ActivateAuthRequest)(nil),        // 73: pps_v2.ActivateAuthRequest
-	(*ActivateAuthResponse)(nil),       // 74: pps_v2.ActivateAuthResponse
-	(*RunLoadTestRequest)(nil),         // 75: pps_v2.RunLoadTestRequest
-	(*RunLoadTestResponse)(nil),        // 76: pps_v2.RunLoadTestResponse
-	(*RenderTemplateRequest)(nil),      // 77: pps_v2.RenderTemplateRequest
-	(*RenderTemplateResponse)(nil),     // 78: pps_v2.RenderTemplateResponse
-	(*LokiRequest)(nil),                // 79: pps_v2.LokiRequest
-	(*LokiLogMessage)(nil),             // 80: pps_v2.LokiLogMessage
-	(*ClusterDefaults)(nil),            // 81: pps_v2.ClusterDefaults
-	(*GetClusterDefaultsRequest)(nil),  // 82: pps_v2.GetClusterDefaultsRequest
-	(*GetClusterDefaultsResponse)(nil), // 83: pps_v2.GetClusterDefaultsResponse
-	(*SetClusterDefaultsRequest)(nil),  // 84: pps_v2.SetClusterDefaultsRequest
-	(*SetClusterDefaultsResponse)(nil), // 85: pps_v2.SetClusterDefaultsResponse
-	(*CreatePipelineTransaction)(nil),  // 86: pps_v2.CreatePipelineTransaction
-	(*ProjectDefaults)(nil),            // 87: pps_v2.ProjectDefaults
-	(*GetProjectDefaultsRequest)(nil),  // 88: pps_v2.GetProjectDefaultsRequest
-	(*GetProjectDefaultsResponse)(nil), // 89: pps_v2.GetProjectDefaultsResponse
-	(*SetProjectDefaultsRequest)(nil),  // 90: pps_v2.SetProjectDefaultsRequest
-	(*SetProjectDefaultsResponse)(nil), // 91: pps_v2.SetProjectDefaultsResponse
-	nil,                                // 92: pps_v2.Transform.EnvEntry
-	nil,                                // 93: pps_v2.Metadata.AnnotationsEntry
-	nil,                                // 94: pps_v2.Metadata.LabelsEntry
-	(*JobInfo_Details)(nil),            // 95: pps_v2.JobInfo.Details
-	(*PipelineInfo_Details)(nil),       // 96: pps_v2.PipelineInfo.Details
-	(*ListDatumRequest_Filter)(nil),    // 97: pps_v2.ListDatumRequest.Filter
-	nil,                                // 98: pps_v2.SchedulingSpec.NodeSelectorEntry
-	nil,                                // 99: pps_v2.RenderTemplateRequest.ArgsEntry
-	(*pfs.ObjectStorageEgress)(nil),    // 100: pfs_v2.ObjectStorageEgress
-	(*pfs.SQLDatabaseEgress)(nil),      // 101: pfs_v2.SQLDatabaseEgress
-	(*pfs.Trigger)(nil),                // 102: pfs_v2.Trigger
-	(*timestamppb.Timestamp)(nil),      // 103: google.protobuf.Timestamp
-	(*pfs.Commit)(nil),                 // 104: pfs_v2.Commit
-	(*pfs.File)(nil),                   // 105: pfs_v2.File
-	(*pfs.FileInfo)(nil),               // 106: pfs_v2.FileInfo
-	(*durationpb.Duration)(nil),        // 107: google.protobuf.Duration
-	(*pfs.Project)(nil),                // 108: pfs_v2.Project
-	(*wrapperspb.Int64Value)(nil),      // 109: google.protobuf.Int64Value
-	(*pfs.CommitSet)(nil),              // 110: pfs_v2.CommitSet
-	(*emptypb.Empty)(nil),              // 111: google.protobuf.Empty
-	(*task.ListTaskRequest)(nil),       // 112: taskapi.ListTaskRequest
-	(*task.TaskInfo)(nil),              // 113: taskapi.TaskInfo
+	(JobState)(0),                                  // 0: pps_v2.JobState
+	(DatumState)(0),                                // 1: pps_v2.DatumState
+	(WorkerState)(0),                               // 2: pps_v2.WorkerState
+	(PipelineState)(0),                             // 3: pps_v2.PipelineState
+	(TolerationOperator)(0),                        // 4: pps_v2.TolerationOperator
+	(TaintEffect)(0),                               // 5: pps_v2.TaintEffect
+	(NotificationDeliveryState)(0),                 // 6: pps_v2.NotificationDeliveryState
+	(PipelineInfo_PipelineType)(0),                 // 7: pps_v2.PipelineInfo.PipelineType
+	(*SecretMount)(nil),                            // 8: pps_v2.SecretMount
+	(*Transform)(nil),                              // 9: pps_v2.Transform
+	(*StarlarkTransform)(nil),                      // 10: pps_v2.StarlarkTransform
+	(*BuiltinTransform)(nil),                       // 11: pps_v2.BuiltinTransform
+	(*TFJob)(nil),                                  // 12: pps_v2.TFJob
+	(*Egress)(nil),                                 // 13: pps_v2.Egress
+	(*Determined)(nil),                             // 14: pps_v2.Determined
+	(*Job)(nil),                                    // 15: pps_v2.Job
+	(*Metadata)(nil),                               // 16: pps_v2.Metadata
+	(*Service)(nil),                                // 17: pps_v2.Service
+	(*Spout)(nil),                                  // 18: pps_v2.Spout
+	(*PFSInput)(nil),                               // 19: pps_v2.PFSInput
+	(*PFSWindow)(nil),                              // 20: pps_v2.PFSWindow
+	(*CronInput)(nil),                              // 21: pps_v2.CronInput
+	(*Input)(nil),                                  // 22: pps_v2.Input
+	(*JobInput)(nil),                               // 23: pps_v2.JobInput
+	(*ParallelismSpec)(nil),                        // 24: pps_v2.ParallelismSpec
+	(*InputFile)(nil),                              // 25: pps_v2.InputFile
+	(*Datum)(nil),                                  // 26: pps_v2.Datum
+	(*DatumInfo)(nil),                              // 27: pps_v2.DatumInfo
+	(*Aggregate)(nil),                              // 28: pps_v2.Aggregate
+	(*ProcessStats)(nil),                           // 29: pps_v2.ProcessStats
+	(*AggregateProcessStats)(nil),                  // 30: pps_v2.AggregateProcessStats
+	(*WorkerStatus)(nil),                           // 31: pps_v2.WorkerStatus
+	(*DatumStatus)(nil),                            // 32: pps_v2.DatumStatus
+	(*ResourceSpec)(nil),                           // 33: pps_v2.ResourceSpec
+	(*GPUSpec)(nil),                                // 34: pps_v2.GPUSpec
+	(*JobSetInfo)(nil),                             // 35: pps_v2.JobSetInfo
+	(*JobInfo)(nil),                                // 36: pps_v2.JobInfo
+	(*Worker)(nil),                                 // 37: pps_v2.Worker
+	(*Pipeline)(nil),                               // 38: pps_v2.Pipeline
+	(*Toleration)(nil),                             // 39: pps_v2.Toleration
+	(*PipelineInfo)(nil),                           // 40: pps_v2.PipelineInfo
+	(*PipelineInfos)(nil),                          // 41: pps_v2.PipelineInfos
+	(*JobSet)(nil),                                 // 42: pps_v2.JobSet
+	(*InspectJobSetRequest)(nil),                   // 43: pps_v2.InspectJobSetRequest
+	(*ListJobSetRequest)(nil),                      // 44: pps_v2.ListJobSetRequest
+	(*InspectJobRequest)(nil),                      // 45: pps_v2.InspectJobRequest
+	(*ListJobRequest)(nil),                         // 46: pps_v2.ListJobRequest
+	(*SubscribeJobRequest)(nil),                    // 47: pps_v2.SubscribeJobRequest
+	(*DeleteJobRequest)(nil),                       // 48: pps_v2.DeleteJobRequest
+	(*StopJobRequest)(nil),                         // 49: pps_v2.StopJobRequest
+	(*UpdateJobStateRequest)(nil),                  // 50: pps_v2.UpdateJobStateRequest
+	(*GetLogsRequest)(nil),                         // 51: pps_v2.GetLogsRequest
+	(*LogMessage)(nil),                             // 52: pps_v2.LogMessage
+	(*RestartDatumRequest)(nil),                    // 53: pps_v2.RestartDatumRequest
+	(*InspectDatumRequest)(nil),                    // 54: pps_v2.InspectDatumRequest
+	(*ListDatumRequest)(nil),                       // 55: pps_v2.ListDatumRequest
+	(*DatumSetSpec)(nil),                           // 56: pps_v2.DatumSetSpec
+	(*SchedulingSpec)(nil),                         // 57: pps_v2.SchedulingSpec
+	(*RerunPipelineRequest)(nil),                   // 58: pps_v2.RerunPipelineRequest
+	(*CreatePipelineRequest)(nil),                  // 59: pps_v2.CreatePipelineRequest
+	(*CreatePipelineV2Request)(nil),                // 60: pps_v2.CreatePipelineV2Request
+	(*CreatePipelineV2Response)(nil),               // 61: pps_v2.CreatePipelineV2Response
+	(*InspectPipelineRequest)(nil),                 // 62: pps_v2.InspectPipelineRequest
+	(*ListPipelineRequest)(nil),                    // 63: pps_v2.ListPipelineRequest
+	(*DeletePipelineRequest)(nil),                  // 64: pps_v2.DeletePipelineRequest
+	(*DeletePipelinesRequest)(nil),                 // 65: pps_v2.DeletePipelinesRequest
+	(*DeletePipelinesResponse)(nil),                // 66: pps_v2.DeletePipelinesResponse
+	(*StartPipelineRequest)(nil),                   // 67: pps_v2.StartPipelineRequest
+	(*StopPipelineRequest)(nil),                    // 68: pps_v2.StopPipelineRequest
+	(*RunPipelineRequest)(nil),                     // 69: pps_v2.RunPipelineRequest
+	(*RunCronRequest)(nil),                         // 70: pps_v2.RunCronRequest
+	(*CreateSecretRequest)(nil),                    // 71: pps_v2.CreateSecretRequest
+	(*DeleteSecretRequest)(nil),                    // 72: pps_v2.DeleteSecretRequest
+	(*InspectSecretRequest)(nil),                   // 73: pps_v2.InspectSecretRequest
+	(*Secret)(nil),                                 // 74: pps_v2.Secret
+	(*SecretInfo)(nil),                             // 75: pps_v2.SecretInfo
+	(*SecretInfos)(nil),                            // 76: pps_v2.SecretInfos
+	(*ActivateAuthRequest)(nil),                    // 77: pps_v2.ActivateAuthRequest
+	(*ActivateAuthResponse)(nil),                   // 78: pps_v2.ActivateAuthResponse
+	(*RunLoadTestRequest)(nil),                     // 79: pps_v2.RunLoadTestRequest
+	(*RunLoadTestResponse)(nil),                    // 80: pps_v2.RunLoadTestResponse
+	(*RenderTemplateRequest)(nil),                  // 81: pps_v2.RenderTemplateRequest
+	(*RenderTemplateResponse)(nil),                 // 82: pps_v2.RenderTemplateResponse
+	(*LokiRequest)(nil),                            // 83: pps_v2.LokiRequest
+	(*LokiLogMessage)(nil),                         // 84: pps_v2.LokiLogMessage
+	(*ClusterDefaults)(nil),                        // 85: pps_v2.ClusterDefaults
+	(*GetClusterDefaultsRequest)(nil),              // 86: pps_v2.GetClusterDefaultsRequest
+	(*GetClusterDefaultsResponse)(nil),             // 87: pps_v2.GetClusterDefaultsResponse
+	(*SetClusterDefaultsRequest)(nil),              // 88: pps_v2.SetClusterDefaultsRequest
+	(*SetClusterDefaultsResponse)(nil),             // 89: pps_v2.SetClusterDefaultsResponse
+	(*CreatePipelineTransaction)(nil),              // 90: pps_v2.CreatePipelineTransaction
+	(*ProjectDefaults)(nil),                        // 91: pps_v2.ProjectDefaults
+	(*GetProjectDefaultsRequest)(nil),              // 92: pps_v2.GetProjectDefaultsRequest
+	(*GetProjectDefaultsResponse)(nil),             // 93: pps_v2.GetProjectDefaultsResponse
+	(*SetProjectDefaultsRequest)(nil),              // 94: pps_v2.SetProjectDefaultsRequest
+	(*SetProjectDefaultsResponse)(nil),             // 95: pps_v2.SetProjectDefaultsResponse
+	(*GetProjectComputeUsageRequest)(nil),          // 96: pps_v2.GetProjectComputeUsageRequest
+	(*GetProjectComputeUsageResponse)(nil),         // 97: pps_v2.GetProjectComputeUsageResponse
+	(*Notification)(nil),                           // 98: pps_v2.Notification
+	(*NotificationEvent)(nil),                      // 99: pps_v2.NotificationEvent
+	(*NotificationInfo)(nil),                       // 100: pps_v2.NotificationInfo
+	(*CreateNotificationRequest)(nil),              // 101: pps_v2.CreateNotificationRequest
+	(*CreateNotificationResponse)(nil),             // 102: pps_v2.CreateNotificationResponse
+	(*ListNotificationRequest)(nil),                // 103: pps_v2.ListNotificationRequest
+	(*DeleteNotificationRequest)(nil),              // 104: pps_v2.DeleteNotificationRequest
+	(*NotificationDeliveryInfo)(nil),               // 105: pps_v2.NotificationDeliveryInfo
+	(*ListNotificationDeliveryRequest)(nil),        // 106: pps_v2.ListNotificationDeliveryRequest
+	(*NotificationPayload)(nil),                    // 107: pps_v2.NotificationPayload
+	nil,                                            // 108: pps_v2.Transform.EnvEntry
+	nil,                                            // 109: pps_v2.Metadata.AnnotationsEntry
+	nil,                                            // 110: pps_v2.Metadata.LabelsEntry
+	(*JobInfo_Details)(nil),                        // 111: pps_v2.JobInfo.Details
+	nil,                                            // 112: pps_v2.JobInfo.TraceContextEntry
+	(*PipelineInfo_Details)(nil),                   // 113: pps_v2.PipelineInfo.Details
+	(*ListDatumRequest_Filter)(nil),                // 114: pps_v2.ListDatumRequest.Filter
+	nil,                                            // 115: pps_v2.SchedulingSpec.NodeSelectorEntry
+	nil,                                            // 116: pps_v2.RenderTemplateRequest.ArgsEntry
+	(*NotificationEvent_CommitFinished)(nil),       // 117: pps_v2.NotificationEvent.CommitFinished
+	(*NotificationEvent_JobFinished)(nil),          // 118: pps_v2.NotificationEvent.JobFinished
+	(*NotificationEvent_PipelineStateChanged)(nil), // 119: pps_v2.NotificationEvent.PipelineStateChanged
+	(*durationpb.Duration)(nil),                    // 120: google.protobuf.Duration
+	(*structpb.Struct)(nil),                        // 121: google.protobuf.Struct
+	(*pfs.ObjectStorageEgress)(nil),                // 122: pfs_v2.ObjectStorageEgress
+	(*pfs.SQLDatabaseEgress)(nil),                  // 123: pfs_v2.SQLDatabaseEgress
+	(*pfs.Trigger)(nil),                            // 124: pfs_v2.Trigger
+	(*pfs.Commit)(nil),                             // 125: pfs_v2.Commit
+	(*timestamppb.Timestamp)(nil),                  // 126: google.protobuf.Timestamp
+	(*pfs.File)(nil),                               // 127: pfs_v2.File
+	(*pfs.FileInfo)(nil),                           // 128: pfs_v2.FileInfo
+	(*pfs.Project)(nil),                            // 129: pfs_v2.Project
+	(*wrapperspb.Int64Value)(nil),                  // 130: google.protobuf.Int64Value
+	(*pfs.ContentContract)(nil),                    // 131: pfs_v2.ContentContract
+	(*pfs.CommitSet)(nil),                          // 132: pfs_v2.CommitSet
+	(*pfs.CommitInfo)(nil),                         // 133: pfs_v2.CommitInfo
+	(*pfs.Branch)(nil),                             // 134: pfs_v2.Branch
+	(*emptypb.Empty)(nil),                          // 135: google.protobuf.Empty
+	(*task.ListTaskRequest)(nil),                   // 136: taskapi.ListTaskRequest
+	(*task.TaskInfo)(nil),                          // 137: taskapi.TaskInfo
 }
 var file_pps_pps_proto_depIdxs = []int32{
-	92,  // 0: pps_v2.Transform.env:type_name -> pps_v2.Transform.EnvEntry
-	7,   // 1: pps_v2.Transform.secrets:type_name -> pps_v2.SecretMount
-	100, // 2: pps_v2.Egress.object_storage:type_name -> pfs_v2.ObjectStorageEgress
-	101, // 3: pps_v2.Egress.sql_database:type_name -> pfs_v2.SQLDatabaseEgress
-	34,  // 4: pps_v2.Job.pipeline:type_name -> pps_v2.Pipeline
-	93,  // 5: pps_v2.Metadata.annotations:type_name -> pps_v2.Metadata.AnnotationsEntry
-	94,  // 6: pps_v2.Metadata.labels:type_name -> pps_v2.Metadata.LabelsEntry
-	14,  // 7: pps_v2.Spout.service:type_name -> pps_v2.Service
-	102, // 8: pps_v2.PFSInput.trigger:type_name -> pfs_v2.Trigger
-	103, // 9: pps_v2.CronInput.start:type_name -> google.protobuf.Timestamp
-	16,  // 10: pps_v2.Input.pfs:type_name -> pps_v2.PFSInput
-	18,  // 11: pps_v2.Input.join:type_name -> pps_v2.Input
-	18,  // 12: pps_v2.Input.group:type_name -> pps_v2.Input
-	18,  // 13: pps_v2.Input.cross:type_name -> pps_v2.Input
-	18,  // 14: pps_v2.Input.union:type_name -> pps_v2.Input
-	17,  // 15: pps_v2.Input.cron:type_name -> pps_v2.CronInput
-	104, // 16: pps_v2.JobInput.commit:type_name -> pfs_v2.Commit
-	12,  // 17: pps_v2.Datum.job:type_name -> pps_v2.Job
-	22,  // 18: pps_v2.DatumInfo.datum:type_name -> pps_v2.Datum
-	1,   // 19: pps_v2.DatumInfo.state:type_name -> pps_v2.DatumState
-	25,  // 20: pps_v2.DatumInfo.stats:type_name -> pps_v2.ProcessStats
-	105, // 21: pps_v2.DatumInfo.pfs_state:type_name -> pfs_v2.File
-	106, // 22: pps_v2.DatumInfo.data:type_name -> pfs_v2.FileInfo
-	107, // 23: pps_v2.ProcessStats.download_time:type_name -> google.protobuf.Duration
-	107, // 24: pps_v2.ProcessStats.process_time:type_name -> google.protobuf.Duration
-	107, // 25: pps_v2.ProcessStats.upload_time:type_name -> google.protobuf.Duration
-	24,  // 26: pps_v2.AggregateProcessStats.download_time:type_name -> pps_v2.Aggregate
-	24,  // 27: pps_v2.AggregateProcessStats.process_time:type_name -> pps_v2.Aggregate
-	24,  // 28: pps_v2.AggregateProcessStats.upload_time:type_name -> pps_v2.Aggregate
-	24,  // 29: pps_v2.AggregateProcessStats.download_bytes:type_name -> pps_v2.Aggregate
-	24,  // 30: pps_v2.AggregateProcessStats.upload_bytes:type_name -> pps_v2.Aggregate
-	28,  // 31: pps_v2.WorkerStatus.datum_status:type_name -> pps_v2.DatumStatus
-	103, // 32: pps_v2.DatumStatus.started:type_name -> google.protobuf.Timestamp
-	21,  // 33: pps_v2.DatumStatus.data:type_name -> pps_v2.InputFile
-	30,  // 34: pps_v2.ResourceSpec.gpu:type_name -> pps_v2.GPUSpec
-	38,  // 35: pps_v2.JobSetInfo.job_set:type_name -> pps_v2.JobSet
-	32,  // 36: pps_v2.JobSetInfo.jobs:type_name -> pps_v2.JobInfo
-	12,  // 37: pps_v2.JobInfo.job:type_name -> pps_v2.Job
-	104, // 38: pps_v2.JobInfo.output_commit:type_name -> pfs_v2.Commit
-	25,  // 39: pps_v2.JobInfo.stats:type_name -> pps_v2.ProcessStats
-	0,   // 40: pps_v2.JobInfo.state:type_name -> pps_v2.JobState
-	103, // 41: pps_v2.JobInfo.created:type_name -> google.protobuf.Timestamp
-	103, // 42: pps_v2.JobInfo.started:type_name -> google.protobuf.Timestamp
-	103, // 43: pps_v2.JobInfo.finished:type_name -> google.protobuf.Timestamp
-	95,  // 44: pps_v2.JobInfo.details:type_name -> pps_v2.JobInfo.Details
-	2,   // 45: pps_v2.Worker.state:type_name -> pps_v2.WorkerState
-	108, // 46: pps_v2.Pipeline.project:type_name -> pfs_v2.Project
-	4,   // 47: pps_v2.Toleration.operator:type_name -> pps_v2.TolerationOperator
-	5,   // 48: pps_v2.Toleration.effect:type_name -> pps_v2.TaintEffect
-	109, // 49: pps_v2.Toleration.toleration_seconds:type_name -> google.protobuf.Int64Value
-	34,  // 50: pps_v2.PipelineInfo.pipeline:type_name -> pps_v2.Pipeline
-	104, // 51: pps_v2.PipelineInfo.spec_commit:type_name -> pfs_v2.Commit
-	3,   // 52: pps_v2.PipelineInfo.state:type_name -> pps_v2.PipelineState
-	0,   // 53: pps_v2.PipelineInfo.last_job_state:type_name -> pps_v2.JobState
-	6,   // 54: pps_v2.PipelineInfo.type:type_name -> pps_v2.PipelineInfo.PipelineType
-	96,  // 55: pps_v2.PipelineInfo.details:type_name -> pps_v2.PipelineInfo.Details
-	36,  // 56: pps_v2.PipelineInfos.pipeline_info:type_name -> pps_v2.PipelineInfo
-	38,  // 57: pps_v2.InspectJobSetRequest.job_set:type_name -> pps_v2.JobSet
-	108, // 58: pps_v2.ListJobSetRequest.projects:type_name -> pfs_v2.Project
-	103, // 59: pps_v2.ListJobSetRequest.paginationMarker:type_name -> google.protobuf.Timestamp
-	12,  // 60: pps_v2.InspectJobRequest.job:type_name -> pps_v2.Job
-	108, // 61: pps_v2.ListJobRequest.projects:type_name -> pfs_v2.Project
-	34,  // 62: pps_v2.ListJobRequest.pipeline:type_name -> pps_v2.Pipeline
-	104, // 63: pps_v2.ListJobRequest.input_commit:type_name -> pfs_v2.Commit
-	103, // 64: pps_v2.ListJobRequest.paginationMarker:type_name -> google.protobuf.Timestamp
-	34,  // 65: pps_v2.SubscribeJobRequest.pipeline:type_name -> pps_v2.Pipeline
-	12,  // 66: pps_v2.DeleteJobRequest.job:type_name -> pps_v2.Job
-	12,  // 67: pps_v2.StopJobRequest.job:type_name -> pps_v2.Job
-	12,  // 68: pps_v2.UpdateJobStateRequest.job:type_name -> pps_v2.Job
-	0,   // 69: pps_v2.UpdateJobStateRequest.state:type_name -> pps_v2.JobState
-	25,  // 70: pps_v2.UpdateJobStateRequest.stats:type_name -> pps_v2.ProcessStats
-	34,  // 71: pps_v2.GetLogsRequest.pipeline:type_name -> pps_v2.Pipeline
-	12,  // 72: pps_v2.GetLogsRequest.job:type_name -> pps_v2.Job
-	22,  // 73: pps_v2.GetLogsRequest.datum:type_name -> pps_v2.Datum
-	107, // 74: pps_v2.GetLogsRequest.since:type_name -> google.protobuf.Duration
-	21,  // 75: pps_v2.LogMessage.data:type_name -> pps_v2.InputFile
-	103, // 76: pps_v2.LogMessage.ts:type_name -> google.protobuf.Timestamp
-	12,  // 77: pps_v2.RestartDatumRequest.job:type_name -> pps_v2.Job
-	22,  // 78: pps_v2.InspectDatumRequest.datum:type_name -> pps_v2.Datum
-	12,  // 79: pps_v2.ListDatumRequest.job:type_name -> pps_v2.Job
-	18,  // 80: pps_v2.ListDatumRequest.input:type_name -> pps_v2.Input
-	97,  // 81: pps_v2.ListDatumRequest.filter:type_name -> pps_v2.ListDatumRequest.Filter
-	98,  // 82: pps_v2.SchedulingSpec.node_selector:type_name -> pps_v2.SchedulingSpec.NodeSelectorEntry
-	34,  // 83: pps_v2.RerunPipelineRequest.pipeline:type_name -> pps_v2.Pipeline
-	34,  // 84: pps_v2.CreatePipelineRequest.pipeline:type_name -> pps_v2.Pipeline
-	9,   // 85: pps_v2.CreatePipelineRequest.tf_job:type_name -> pps_v2.TFJob
-	8,   // 86: pps_v2.CreatePipelineRequest.transform:type_name -> pps_v2.Transform
-	20,  // 87: pps_v2.CreatePipelineRequest.parallelism_spec:type_name -> pps_v2.ParallelismSpec
-	10,  // 88: pps_v2.CreatePipelineRequest.egress:type_name -> pps_v2.Egress
-	29,  // 89: pps_v2.CreatePipelineRequest.resource_requests:type_name -> pps_v2.ResourceSpec
-	29,  // 90: pps_v2.CreatePipelineRequest.resource_limits:type_name -> pps_v2.ResourceSpec
-	29,  // 91: pps_v2.CreatePipelineRequest.sidecar_resource_limits:type_name -> pps_v2.ResourceSpec
-	18,  // 92: pps_v2.CreatePipelineRequest.input:type_name -> pps_v2.Input
-	14,  // 93: pps_v2.CreatePipelineRequest.service:type_name -> pps_v2.Service
-	15,  // 94: pps_v2.CreatePipelineRequest.spout:type_name -> pps_v2.Spout
-	52,  // 95: pps_v2.CreatePipelineRequest.datum_set_spec:type_name -> pps_v2.DatumSetSpec
-	107, // 96: pps_v2.CreatePipelineRequest.datum_timeout:type_name -> google.protobuf.Duration
-	107, // 97: pps_v2.CreatePipelineRequest.job_timeout:type_name -> google.protobuf.Duration
-	53,  // 98: pps_v2.CreatePipelineRequest.scheduling_spec:type_name -> pps_v2.SchedulingSpec
-	104, // 99: pps_v2.CreatePipelineRequest.spec_commit:type_name -> pfs_v2.Commit
-	13,  // 100: pps_v2.CreatePipelineRequest.metadata:type_name -> pps_v2.Metadata
-	35,  // 101: pps_v2.CreatePipelineRequest.tolerations:type_name -> pps_v2.Toleration
-	29,  // 102: pps_v2.CreatePipelineRequest.sidecar_resource_requests:type_name -> pps_v2.ResourceSpec
-	11,  // 103: pps_v2.CreatePipelineRequest.determined:type_name -> pps_v2.Determined
-	34,  // 104: pps_v2.InspectPipelineRequest.pipeline:type_name -> pps_v2.Pipeline
-	34,  // 105: pps_v2.ListPipelineRequest.pipeline:type_name -> pps_v2.Pipeline
-	110, // 106: pps_v2.ListPipelineRequest.commit_set:type_name -> pfs_v2.CommitSet
-	108, // 107: pps_v2.ListPipelineRequest.projects:type_name -> pfs_v2.Project
-	34,  // 108: pps_v2.DeletePipelineRequest.pipeline:type_name -> pps_v2.Pipeline
-	108, // 109: pps_v2.DeletePipelinesRequest.projects:type_name -> pfs_v2.Project
-	34,  // 110: pps_v2.DeletePipelinesResponse.pipelines:type_name -> pps_v2.Pipeline
-	34,  // 111: pps_v2.StartPipelineRequest.pipeline:type_name -> pps_v2.Pipeline
-	34,  // 112: pps_v2.StopPipelineRequest.pipeline:type_name -> pps_v2.Pipeline
-	34,  // 113: pps_v2.RunPipelineRequest.pipeline:type_name -> pps_v2.Pipeline
-	104, // 114: pps_v2.RunPipelineRequest.provenance:type_name -> pfs_v2.Commit
-	34,  // 115: pps_v2.RunCronRequest.pipeline:type_name -> pps_v2.Pipeline
-	70,  // 116: pps_v2.DeleteSecretRequest.secret:type_name -> pps_v2.Secret
-	70,  // 117: pps_v2.InspectSecretRequest.secret:type_name -> pps_v2.Secret
-	70,  // 118: pps_v2.SecretInfo.secret:type_name -> pps_v2.Secret
-	103, // 119: pps_v2.SecretInfo.creation_timestamp:type_name -> google.protobuf.Timestamp
-	71,  // 120: pps_v2.SecretInfos.secret_info:type_name -> pps_v2.SecretInfo
-	99,  // 121: pps_v2.RenderTemplateRequest.args:type_name -> pps_v2.RenderTemplateRequest.ArgsEntry
-	55,  // 122: pps_v2.RenderTemplateResponse.specs:type_name -> pps_v2.CreatePipelineRequest
-	107, // 123: pps_v2.LokiRequest.since:type_name -> google.protobuf.Duration
-	55,  // 124: pps_v2.ClusterDefaults.create_pipeline_request:type_name -> pps_v2.CreatePipelineRequest
-	34,  // 125: pps_v2.SetClusterDefaultsResponse.affected_pipelines:type_name -> pps_v2.Pipeline
-	55,  // 126: pps_v2.CreatePipelineTransaction.create_pipeline_request:type_name -> pps_v2.CreatePipelineRequest
-	55,  // 127: pps_v2.ProjectDefaults.create_pipeline_request:type_name -> pps_v2.CreatePipelineRequest
-	108, // 128: pps_v2.GetProjectDefaultsRequest.project:type_name -> pfs_v2.Project
-	108, // 129: pps_v2.SetProjectDefaultsRequest.project:type_name -> pfs_v2.Project
-	34,  // 130: pps_v2.SetProjectDefaultsResponse.affected_pipelines:type_name -> pps_v2.Pipeline
-	8,   // 131: pps_v2.JobInfo.Details.transform:type_name -> pps_v2.Transform
-	20,  // 132: pps_v2.JobInfo.Details.parallelism_spec:type_name -> pps_v2.ParallelismSpec
-	10,  // 133: pps_v2.JobInfo.Details.egress:type_name -> pps_v2.Egress
-	14,  // 134: pps_v2.JobInfo.Details.service:type_name -> pps_v2.Service
-	15,  // 135: pps_v2.JobInfo.Details.spout:type_name -> pps_v2.Spout
-	27,  // 136: pps_v2.JobInfo.Details.worker_status:type_name -> pps_v2.WorkerStatus
-	29,  // 137: pps_v2.JobInfo.Details.resource_requests:type_name -> pps_v2.ResourceSpec
-	29,  // 138: pps_v2.JobInfo.Details.resource_limits:type_name -> pps_v2.ResourceSpec
-	29,  // 139: pps_v2.JobInfo.Details.sidecar_resource_limits:type_name -> pps_v2.ResourceSpec
-	18,  // 140: pps_v2.JobInfo.Details.input:type_name -> pps_v2.Input
-	52,  // 141: pps_v2.JobInfo.Details.datum_set_spec:type_name -> pps_v2.DatumSetSpec
-	107, // 142: pps_v2.JobInfo.Details.datum_timeout:type_name -> google.protobuf.Duration
-	107, // 143: pps_v2.JobInfo.Details.job_timeout:type_name -> google.protobuf.Duration
-	53,  // 144: pps_v2.JobInfo.Details.scheduling_spec:type_name -> pps_v2.SchedulingSpec
-	29,  // 145: pps_v2.JobInfo.Details.sidecar_resource_requests:type_name -> pps_v2.ResourceSpec
-	8,   // 146: pps_v2.PipelineInfo.Details.transform:type_name -> pps_v2.Transform
-	9,   // 147: pps_v2.PipelineInfo.Details.tf_job:type_name -> pps_v2.TFJob
-	20,  // 148: pps_v2.PipelineInfo.Details.parallelism_spec:type_name -> pps_v2.ParallelismSpec
-	10,  // 149: pps_v2.PipelineInfo.Details.egress:type_name -> pps_v2.Egress
-	103, // 150: pps_v2.PipelineInfo.Details.created_at:type_name -> google.protobuf.Timestamp
-	29,  // 151: pps_v2.PipelineInfo.Details.resource_requests:type_name -> pps_v2.ResourceSpec
-	29,  // 152: pps_v2.PipelineInfo.Details.resource_limits:type_name -> pps_v2.ResourceSpec
-	29,  // 153: pps_v2.PipelineInfo.Details.sidecar_resource_limits:type_name -> pps_v2.ResourceSpec
-	18,  // 154: pps_v2.PipelineInfo.Details.input:type_name -> pps_v2.Input
-	14,  // 155: pps_v2.PipelineInfo.Details.service:type_name -> pps_v2.Service
-	15,  // 156: pps_v2.PipelineInfo.Details.spout:type_name -> pps_v2.Spout
-	52,  // 157: pps_v2.PipelineInfo.Details.datum_set_spec:type_name -> pps_v2.DatumSetSpec
-	107, // 158: pps_v2.PipelineInfo.Details.datum_timeout:type_name -> google.protobuf.Duration
-	107, // 159: pps_v2.PipelineInfo.Details.job_timeout:type_name -> google.protobuf.Duration
-	53,  // 160: pps_v2.PipelineInfo.Details.scheduling_spec:type_name -> pps_v2.SchedulingSpec
-	13,  // 161: pps_v2.PipelineInfo.Details.metadata:type_name -> pps_v2.Metadata
-	35,  // 162: pps_v2.PipelineInfo.Details.tolerations:type_name -> pps_v2.Toleration
-	29,  // 163: pps_v2.PipelineInfo.Details.sidecar_resource_requests:type_name -> pps_v2.ResourceSpec
-	11,  // 164: pps_v2.PipelineInfo.Details.determined:type_name -> pps_v2.Determined
-	1,   // 165: pps_v2.ListDatumRequest.Filter.state:type_name -> pps_v2.DatumState
-	41,  // 166: pps_v2.API.InspectJob:input_type -> pps_v2.InspectJobRequest
-	39,  // 167: pps_v2.API.InspectJobSet:input_type -> pps_v2.InspectJobSetRequest
-	42,  // 168: pps_v2.API.ListJob:input_type -> pps_v2.ListJobRequest
-	40,  // 169: pps_v2.API.ListJobSet:input_type -> pps_v2.ListJobSetRequest
-	43,  // 170: pps_v2.API.SubscribeJob:input_type -> pps_v2.SubscribeJobRequest
-	44,  // 171: pps_v2.API.DeleteJob:input_type -> pps_v2.DeleteJobRequest
-	45,  // 172: pps_v2.API.StopJob:input_type -> pps_v2.StopJobRequest
-	50,  // 173: pps_v2.API.InspectDatum:input_type -> pps_v2.InspectDatumRequest
-	51,  // 174: pps_v2.API.ListDatum:input_type -> pps_v2.ListDatumRequest
-	49,  // 175: pps_v2.API.RestartDatum:input_type -> pps_v2.RestartDatumRequest
-	54,  // 176: pps_v2.API.RerunPipeline:input_type -> pps_v2.RerunPipelineRequest
-	55,  // 177: pps_v2.API.CreatePipeline:input_type -> pps_v2.CreatePipelineRequest
-	56,  // 178: pps_v2.API.CreatePipelineV2:input_type -> pps_v2.CreatePipelineV2Request
-	58,  // 179: pps_v2.API.InspectPipeline:input_type -> pps_v2.InspectPipelineRequest
-	59,  // 180: pps_v2.API.ListPipeline:input_type -> pps_v2.ListPipelineRequest
-	60,  // 181: pps_v2.API.DeletePipeline:input_type -> pps_v2.DeletePipelineRequest
-	61,  // 182: pps_v2.API.DeletePipelines:input_type -> pps_v2.DeletePipelinesRequest
-	63,  // 183: pps_v2.API.StartPipeline:input_type -> pps_v2.StartPipelineRequest
-	64,  // 184: pps_v2.API.StopPipeline:input_type -> pps_v2.StopPipelineRequest
-	65,  // 185: pps_v2.API.RunPipeline:input_type -> pps_v2.RunPipelineRequest
-	66,  // 186: pps_v2.API.RunCron:input_type -> pps_v2.RunCronRequest
-	67,  // 187: pps_v2.API.CreateSecret:input_type -> pps_v2.CreateSecretRequest
-	68,  // 188: pps_v2.API.DeleteSecret:input_type -> pps_v2.DeleteSecretRequest
-	111, // 189: pps_v2.API.ListSecret:input_type -> google.protobuf.Empty
-	69,  // 190: pps_v2.API.InspectSecret:input_type -> pps_v2.InspectSecretRequest
-	111, // 191: pps_v2.API.DeleteAll:input_type -> google.protobuf.Empty
-	47,  // 192: pps_v2.API.GetLogs:input_type -> pps_v2.GetLogsRequest
-	73,  // 193: pps_v2.API.ActivateAuth:input_type -> pps_v2.ActivateAuthRequest
-	46,  // 194: pps_v2.API.UpdateJobState:input_type -> pps_v2.UpdateJobStateRequest
-	75,  // 195: pps_v2.API.RunLoadTest:input_type -> pps_v2.RunLoadTestRequest
-	111, // 196: pps_v2.API.RunLoadTestDefault:input_type -> google.protobuf.Empty
-	77,  // 197: pps_v2.API.RenderTemplate:input_type -> pps_v2.RenderTemplateRequest
-	112, // 198: pps_v2.API.ListTask:input_type -> taskapi.ListTaskRequest
-	79,  // 199: pps_v2.API.GetKubeEvents:input_type -> pps_v2.LokiRequest
-	79,  // 200: pps_v2.API.QueryLoki:input_type -> pps_v2.LokiRequest
-	82,  // 201: pps_v2.API.GetClusterDefaults:input_type -> pps_v2.GetClusterDefaultsRequest
-	84,  // 202: pps_v2.API.SetClusterDefaults:input_type -> pps_v2.SetClusterDefaultsRequest
-	32,  // 203: pps_v2.API.InspectJob:output_type -> pps_v2.JobInfo
-	32,  // 204: pps_v2.API.InspectJobSet:output_type -> pps_v2.JobInfo
-	32,  // 205: pps_v2.API.ListJob:output_type -> pps_v2.JobInfo
-	31,  // 206: pps_v2.API.ListJobSet:output_type -> pps_v2.JobSetInfo
-	32,  // 207: pps_v2.API.SubscribeJob:output_type -> pps_v2.JobInfo
-	111, // 208: pps_v2.API.DeleteJob:output_type -> google.protobuf.Empty
-	111, // 209: pps_v2.API.StopJob:output_type -> google.protobuf.Empty
-	23,  // 210: pps_v2.API.InspectDatum:output_type -> pps_v2.DatumInfo
-	23,  // 211: pps_v2.API.ListDatum:output_type -> pps_v2.DatumInfo
-	111, // 212: pps_v2.API.RestartDatum:output_type -> google.protobuf.Empty
-	111, // 213: pps_v2.API.RerunPipeline:output_type -> google.protobuf.Empty
-	111, // 214: pps_v2.API.CreatePipeline:output_type -> google.protobuf.Empty
-	57,  // 215: pps_v2.API.CreatePipelineV2:output_type -> pps_v2.CreatePipelineV2Response
-	36,  // 216: pps_v2.API.InspectPipeline:output_type -> pps_v2.PipelineInfo
-	36,  // 217: pps_v2.API.ListPipeline:output_type -> pps_v2.PipelineInfo
-	111, // 218: pps_v2.API.DeletePipeline:output_type -> google.protobuf.Empty
-	62,  // 219: pps_v2.API.DeletePipelines:output_type -> pps_v2.DeletePipelinesResponse
-	111, // 220: pps_v2.API.StartPipeline:output_type -> google.protobuf.Empty
-	111, // 221: pps_v2.API.StopPipeline:output_type -> google.protobuf.Empty
-	111, // 222: pps_v2.API.RunPipeline:output_type -> google.protobuf.Empty
-	111, // 223: pps_v2.API.RunCron:output_type -> google.protobuf.Empty
-	111, // 224: pps_v2.API.CreateSecret:output_type -> google.protobuf.Empty
-	111, // 225: pps_v2.API.DeleteSecret:output_type -> google.protobuf.Empty
-	72,  // 226: pps_v2.API.ListSecret:output_type -> pps_v2.SecretInfos
-	71,  // 227: pps_v2.API.InspectSecret:output_type -> pps_v2.SecretInfo
-	111, // 228: pps_v2.API.DeleteAll:output_type -> google.protobuf.Empty
-	48,  // 229: pps_v2.API.GetLogs:output_type -> pps_v2.LogMessage
-	74,  // 230: pps_v2.API.ActivateAuth:output_type -> pps_v2.ActivateAuthResponse
-	111, // 231: pps_v2.API.UpdateJobState:output_type -> google.protobuf.Empty
-	76,  // 232: pps_v2.API.RunLoadTest:output_type -> pps_v2.RunLoadTestResponse
-	76,  // 233: pps_v2.API.RunLoadTestDefault:output_type -> pps_v2.RunLoadTestResponse
-	78,  // 234: pps_v2.API.RenderTemplate:output_type -> pps_v2.RenderTemplateResponse
-	113, // 235: pps_v2.API.ListTask:output_type -> taskapi.TaskInfo
-	80,  // 236: pps_v2.API.GetKubeEvents:output_type -> pps_v2.LokiLogMessage
-	80,  // 237: pps_v2.API.QueryLoki:output_type -> pps_v2.LokiLogMessage
-	83,  // 238: pps_v2.API.GetClusterDefaults:output_type -> pps_v2.GetClusterDefaultsResponse
-	85,  // 239: pps_v2.API.SetClusterDefaults:output_type -> pps_v2.SetClusterDefaultsResponse
-	203, // [203:240] is the sub-list for method output_type
-	166, // [166:203] is the sub-list for method input_type
-	166, // [166:166] is the sub-list for extension type_name
-	166, // [166:166] is the sub-list for extension extendee
-	0,   // [0:166] is the sub-list for field type_name
+	108, // 0: pps_v2.Transform.env:type_name -> pps_v2.Transform.EnvEntry
+	8,   // 1: pps_v2.Transform.secrets:type_name -> pps_v2.SecretMount
+	10,  // 2: pps_v2.Transform.starlark:type_name -> pps_v2.StarlarkTransform
+	11,  // 3: pps_v2.Transform.builtin:type_name -> pps_v2.BuiltinTransform
+	120, // 4: pps_v2.StarlarkTransform.timeout:type_name -> google.protobuf.Duration
+	121, // 5: pps_v2.BuiltinTransform.args:type_name -> google.protobuf.Struct
+	122, // 6: pps_v2.Egress.object_storage:type_name -> pfs_v2.ObjectStorageEgress
+	123, // 7: pps_v2.Egress.sql_database:type_name -> pfs_v2.SQLDatabaseEgress
+	38,  // 8: pps_v2.Job.pipeline:type_name -> pps_v2.Pipeline
+	109, // 9: pps_v2.Metadata.annotations:type_name -> pps_v2.Metadata.AnnotationsEntry
+	110, // 10: pps_v2.Metadata.labels:type_name -> pps_v2.Metadata.LabelsEntry
+	17,  // 11: pps_v2.Spout.service:type_name -> pps_v2.Service
+	124, // 12: pps_v2.PFSInput.trigger:type_name -> pfs_v2.Trigger
+	20,  // 13: pps_v2.PFSInput.window:type_name -> pps_v2.PFSWindow
+	125, // 14: pps_v2.PFSInput.previous_output:type_name -> pfs_v2.Commit
+	120, // 15: pps_v2.PFSWindow.duration:type_name -> google.protobuf.Duration
+	126, // 16: pps_v2.CronInput.start:type_name -> google.protobuf.Timestamp
+	19,  // 17: pps_v2.Input.pfs:type_name -> pps_v2.PFSInput
+	22,  // 18: pps_v2.Input.join:type_name -> pps_v2.Input
+	22,  // 19: pps_v2.Input.group:type_name -> pps_v2.Input
+	22,  // 20: pps_v2.Input.cross:type_name -> pps_v2.Input
+	22,  // 21: pps_v2.Input.union:type_name -> pps_v2.Input
+	21,  // 22: pps_v2.Input.cron:type_name -> pps_v2.CronInput
+	125, // 23: pps_v2.JobInput.commit:type_name -> pfs_v2.Commit
+	15,  // 24: pps_v2.Datum.job:type_name -> pps_v2.Job
+	26,  // 25: pps_v2.DatumInfo.datum:type_name -> pps_v2.Datum
+	1,   // 26: pps_v2.DatumInfo.state:type_name -> pps_v2.DatumState
+	29,  // 27: pps_v2.DatumInfo.stats:type_name -> pps_v2.ProcessStats
+	127, // 28: pps_v2.DatumInfo.pfs_state:type_name -> pfs_v2.File
+	128, // 29: pps_v2.DatumInfo.data:type_name -> pfs_v2.FileInfo
+	120, // 30: pps_v2.ProcessStats.download_time:type_name -> google.protobuf.Duration
+	120, // 31: pps_v2.ProcessStats.process_time:type_name -> google.protobuf.Duration
+	120, // 32: pps_v2.ProcessStats.upload_time:type_name -> google.protobuf.Duration
+	28,  // 33: pps_v2.AggregateProcessStats.download_time:type_name -> pps_v2.Aggregate
+	28,  // 34: pps_v2.AggregateProcessStats.process_time:type_name -> pps_v2.Aggregate
+	28,  // 35: pps_v2.AggregateProcessStats.upload_time:type_name -> pps_v2.Aggregate
+	28,  // 36: pps_v2.AggregateProcessStats.download_bytes:type_name -> pps_v2.Aggregate
+	28,  // 37: pps_v2.AggregateProcessStats.upload_bytes:type_name -> pps_v2.Aggregate
+	32,  // 38: pps_v2.WorkerStatus.datum_status:type_name -> pps_v2.DatumStatus
+	126, // 39: pps_v2.DatumStatus.started:type_name -> google.protobuf.Timestamp
+	25,  // 40: pps_v2.DatumStatus.data:type_name -> pps_v2.InputFile
+	34,  // 41: pps_v2.ResourceSpec.gpu:type_name -> pps_v2.GPUSpec
+	42,  // 42: pps_v2.JobSetInfo.job_set:type_name -> pps_v2.JobSet
+	36,  // 43: pps_v2.JobSetInfo.jobs:type_name -> pps_v2.JobInfo
+	15,  // 44: pps_v2.JobInfo.job:type_name -> pps_v2.Job
+	125, // 45: pps_v2.JobInfo.output_commit:type_name -> pfs_v2.Commit
+	29,  // 46: pps_v2.JobInfo.stats:type_name -> pps_v2.ProcessStats
+	0,   // 47: pps_v2.JobInfo.state:type_name -> pps_v2.JobState
+	126, // 48: pps_v2.JobInfo.created:type_name -> google.protobuf.Timestamp
+	126, // 49: pps_v2.JobInfo.started:type_name -> google.protobuf.Timestamp
+	126, // 50: pps_v2.JobInfo.finished:type_name -> google.protobuf.Timestamp
+	111, // 51: pps_v2.JobInfo.details:type_name -> pps_v2.JobInfo.Details
+	112, // 52: pps_v2.JobInfo.trace_context:type_name -> pps_v2.JobInfo.TraceContextEntry
+	2,   // 53: pps_v2.Worker.state:type_name -> pps_v2.WorkerState
+	129, // 54: pps_v2.Pipeline.project:type_name -> pfs_v2.Project
+	4,   // 55: pps_v2.Toleration.operator:type_name -> pps_v2.TolerationOperator
+	5,   // 56: pps_v2.Toleration.effect:type_name -> pps_v2.TaintEffect
+	130, // 57: pps_v2.Toleration.toleration_seconds:type_name -> google.protobuf.Int64Value
+	38,  // 58: pps_v2.PipelineInfo.pipeline:type_name -> pps_v2.Pipeline
+	125, // 59: pps_v2.PipelineInfo.spec_commit:type_name -> pfs_v2.Commit
+	3,   // 60: pps_v2.PipelineInfo.state:type_name -> pps_v2.PipelineState
+	0,   // 61: pps_v2.PipelineInfo.last_job_state:type_name -> pps_v2.JobState
+	7,   // 62: pps_v2.PipelineInfo.type:type_name -> pps_v2.PipelineInfo.PipelineType
+	113, // 63: pps_v2.PipelineInfo.details:type_name -> pps_v2.PipelineInfo.Details
+	40,  // 64: pps_v2.PipelineInfos.pipeline_info:type_name -> pps_v2.PipelineInfo
+	42,  // 65: pps_v2.InspectJobSetRequest.job_set:type_name -> pps_v2.JobSet
+	129, // 66: pps_v2.ListJobSetRequest.projects:type_name -> pfs_v2.Project
+	126, // 67: pps_v2.ListJobSetRequest.paginationMarker:type_name -> google.protobuf.Timestamp
+	15,  // 68: pps_v2.InspectJobRequest.job:type_name -> pps_v2.Job
+	129, // 69: pps_v2.ListJobRequest.projects:type_name -> pfs_v2.Project
+	38,  // 70: pps_v2.ListJobRequest.pipeline:type_name -> pps_v2.Pipeline
+	125, // 71: pps_v2.ListJobRequest.input_commit:type_name -> pfs_v2.Commit
+	126, // 72: pps_v2.ListJobRequest.paginationMarker:type_name -> google.protobuf.Timestamp
+	38,  // 73: pps_v2.SubscribeJobRequest.pipeline:type_name -> pps_v2.Pipeline
+	15,  // 74: pps_v2.DeleteJobRequest.job:type_name -> pps_v2.Job
+	15,  // 75: pps_v2.StopJobRequest.job:type_name -> pps_v2.Job
+	15,  // 76: pps_v2.UpdateJobStateRequest.job:type_name -> pps_v2.Job
+	0,   // 77: pps_v2.UpdateJobStateRequest.state:type_name -> pps_v2.JobState
+	29,  // 78: pps_v2.UpdateJobStateRequest.stats:type_name -> pps_v2.ProcessStats
+	38,  // 79: pps_v2.GetLogsRequest.pipeline:type_name -> pps_v2.Pipeline
+	15,  // 80: pps_v2.GetLogsRequest.job:type_name -> pps_v2.Job
+	26,  // 81: pps_v2.GetLogsRequest.datum:type_name -> pps_v2.Datum
+	120, // 82: pps_v2.GetLogsRequest.since:type_name -> google.protobuf.Duration
+	25,  // 83: pps_v2.LogMessage.data:type_name -> pps_v2.InputFile
+	126, // 84: pps_v2.LogMessage.ts:type_name -> google.protobuf.Timestamp
+	15,  // 85: pps_v2.RestartDatumRequest.job:type_name -> pps_v2.Job
+	26,  // 86: pps_v2.InspectDatumRequest.datum:type_name -> pps_v2.Datum
+	15,  // 87: pps_v2.ListDatumRequest.job:type_name -> pps_v2.Job
+	22,  // 88: pps_v2.ListDatumRequest.input:type_name -> pps_v2.Input
+	114, // 89: pps_v2.ListDatumRequest.filter:type_name -> pps_v2.ListDatumRequest.Filter
+	115, // 90: pps_v2.SchedulingSpec.node_selector:type_name -> pps_v2.SchedulingSpec.NodeSelectorEntry
+	38,  // 91: pps_v2.RerunPipelineRequest.pipeline:type_name -> pps_v2.Pipeline
+	38,  // 92: pps_v2.CreatePipelineRequest.pipeline:type_name -> pps_v2.Pipeline
+	12,  // 93: pps_v2.CreatePipelineRequest.tf_job:type_name -> pps_v2.TFJob
+	9,   // 94: pps_v2.CreatePipelineRequest.transform:type_name -> pps_v2.Transform
+	24,  // 95: pps_v2.CreatePipelineRequest.parallelism_spec:type_name -> pps_v2.ParallelismSpec
+	13,  // 96: pps_v2.CreatePipelineRequest.egress:type_name -> pps_v2.Egress
+	33,  // 97: pps_v2.CreatePipelineRequest.resource_requests:type_name -> pps_v2.ResourceSpec
+	33,  // 98: pps_v2.CreatePipelineRequest.resource_limits:type_name -> pps_v2.ResourceSpec
+	33,  // 99: pps_v2.CreatePipelineRequest.sidecar_resource_limits:type_name -> pps_v2.ResourceSpec
+	22,  // 100: pps_v2.CreatePipelineRequest.input:type_name -> pps_v2.Input
+	17,  // 101: pps_v2.CreatePipelineRequest.service:type_name -> pps_v2.Service
+	18,  // 102: pps_v2.CreatePipelineRequest.spout:type_name -> pps_v2.Spout
+	56,  // 103: pps_v2.CreatePipelineRequest.datum_set_spec:type_name -> pps_v2.DatumSetSpec
+	120, // 104: pps_v2.CreatePipelineRequest.datum_timeout:type_name -> google.protobuf.Duration
+	120, // 105: pps_v2.CreatePipelineRequest.job_timeout:type_name -> google.protobuf.Duration
+	57,  // 106: pps_v2.CreatePipelineRequest.scheduling_spec:type_name -> pps_v2.SchedulingSpec
+	125, // 107: pps_v2.CreatePipelineRequest.spec_commit:type_name -> pfs_v2.Commit
+	16,  // 108: pps_v2.CreatePipelineRequest.metadata:type_name -> pps_v2.Metadata
+	39,  // 109: pps_v2.CreatePipelineRequest.tolerations:type_name -> pps_v2.Toleration
+	33,  // 110: pps_v2.CreatePipelineRequest.sidecar_resource_requests:type_name -> pps_v2.ResourceSpec
+	14,  // 111: pps_v2.CreatePipelineRequest.determined:type_name -> pps_v2.Determined
+	131, // 112: pps_v2.CreatePipelineRequest.output_contract:type_name -> pfs_v2.ContentContract
+	38,  // 113: pps_v2.InspectPipelineRequest.pipeline:type_name -> pps_v2.Pipeline
+	38,  // 114: pps_v2.ListPipelineRequest.pipeline:type_name -> pps_v2.Pipeline
+	132, // 115: pps_v2.ListPipelineRequest.commit_set:type_name -> pfs_v2.CommitSet
+	129, // 116: pps_v2.ListPipelineRequest.projects:type_name -> pfs_v2.Project
+	38,  // 117: pps_v2.DeletePipelineRequest.pipeline:type_name -> pps_v2.Pipeline
+	129, // 118: pps_v2.DeletePipelinesRequest.projects:type_name -> pfs_v2.Project
+	38,  // 119: pps_v2.DeletePipelinesResponse.pipelines:type_name -> pps_v2.Pipeline
+	38,  // 120: pps_v2.StartPipelineRequest.pipeline:type_name -> pps_v2.Pipeline
+	38,  // 121: pps_v2.StopPipelineRequest.pipeline:type_name -> pps_v2.Pipeline
+	38,  // 122: pps_v2.RunPipelineRequest.pipeline:type_name -> pps_v2.Pipeline
+	125, // 123: pps_v2.RunPipelineRequest.provenance:type_name -> pfs_v2.Commit
+	38,  // 124: pps_v2.RunCronRequest.pipeline:type_name -> pps_v2.Pipeline
+	74,  // 125: pps_v2.DeleteSecretRequest.secret:type_name -> pps_v2.Secret
+	74,  // 126: pps_v2.InspectSecretRequest.secret:type_name -> pps_v2.Secret
+	74,  // 127: pps_v2.SecretInfo.secret:type_name -> pps_v2.Secret
+	126, // 128: pps_v2.SecretInfo.creation_timestamp:type_name -> google.protobuf.Timestamp
+	75,  // 129: pps_v2.SecretInfos.secret_info:type_name -> pps_v2.SecretInfo
+	116, // 130: pps_v2.RenderTemplateRequest.args:type_name -> pps_v2.RenderTemplateRequest.ArgsEntry
+	59,  // 131: pps_v2.RenderTemplateResponse.specs:type_name -> pps_v2.CreatePipelineRequest
+	120, // 132: pps_v2.LokiRequest.since:type_name -> google.protobuf.Duration
+	59,  // 133: pps_v2.ClusterDefaults.create_pipeline_request:type_name -> pps_v2.CreatePipelineRequest
+	38,  // 134: pps_v2.SetClusterDefaultsResponse.affected_pipelines:type_name -> pps_v2.Pipeline
+	59,  // 135: pps_v2.CreatePipelineTransaction.create_pipeline_request:type_name -> pps_v2.CreatePipelineRequest
+	59,  // 136: pps_v2.ProjectDefaults.create_pipeline_request:type_name -> pps_v2.CreatePipelineRequest
+	129, // 137: pps_v2.GetProjectDefaultsRequest.project:type_name -> pfs_v2.Project
+	129, // 138: pps_v2.SetProjectDefaultsRequest.project:type_name -> pfs_v2.Project
+	38,  // 139: pps_v2.SetProjectDefaultsResponse.affected_pipelines:type_name -> pps_v2.Pipeline
+	129, // 140: pps_v2.GetProjectComputeUsageRequest.project:type_name -> pfs_v2.Project
+	117, // 141: pps_v2.NotificationEvent.commit_finished:type_name -> pps_v2.NotificationEvent.CommitFinished
+	118, // 142: pps_v2.NotificationEvent.job_finished:type_name -> pps_v2.NotificationEvent.JobFinished
+	119, // 143: pps_v2.NotificationEvent.pipeline_state_changed:type_name -> pps_v2.NotificationEvent.PipelineStateChanged
+	98,  // 144: pps_v2.NotificationInfo.notification:type_name -> pps_v2.Notification
+	99,  // 145: pps_v2.NotificationInfo.events:type_name -> pps_v2.NotificationEvent
+	126, // 146: pps_v2.NotificationInfo.created_at:type_name -> google.protobuf.Timestamp
+	98,  // 147: pps_v2.CreateNotificationRequest.notification:type_name -> pps_v2.Notification
+	99,  // 148: pps_v2.CreateNotificationRequest.events:type_name -> pps_v2.NotificationEvent
+	98,  // 149: pps_v2.DeleteNotificationRequest.notification:type_name -> pps_v2.Notification
+	98,  // 150: pps_v2.NotificationDeliveryInfo.notification:type_name -> pps_v2.Notification
+	6,   // 151: pps_v2.NotificationDeliveryInfo.state:type_name -> pps_v2.NotificationDeliveryState
+	126, // 152: pps_v2.NotificationDeliveryInfo.created_at:type_name -> google.protobuf.Timestamp
+	126, // 153: pps_v2.NotificationDeliveryInfo.delivered_at:type_name -> google.protobuf.Timestamp
+	98,  // 154: pps_v2.ListNotificationDeliveryRequest.notification:type_name -> pps_v2.Notification
+	98,  // 155: pps_v2.NotificationPayload.notification:type_name -> pps_v2.Notification
+	126, // 156: pps_v2.NotificationPayload.time:type_name -> google.protobuf.Timestamp
+	133, // 157: pps_v2.NotificationPayload.commit_finished:type_name -> pfs_v2.CommitInfo
+	36,  // 158: pps_v2.NotificationPayload.job_finished:type_name -> pps_v2.JobInfo
+	40,  // 159: pps_v2.NotificationPayload.pipeline_state_changed:type_name -> pps_v2.PipelineInfo
+	9,   // 160: pps_v2.JobInfo.Details.transform:type_name -> pps_v2.Transform
+	24,  // 161: pps_v2.JobInfo.Details.parallelism_spec:type_name -> pps_v2.ParallelismSpec
+	13,  // 162: pps_v2.JobInfo.Details.egress:type_name -> pps_v2.Egress
+	17,  // 163: pps_v2.JobInfo.Details.service:type_name -> pps_v2.Service
+	18,  // 164: pps_v2.JobInfo.Details.spout:type_name -> pps_v2.Spout
+	31,  // 165: pps_v2.JobInfo.Details.worker_status:type_name -> pps_v2.WorkerStatus
+	33,  // 166: pps_v2.JobInfo.Details.resource_requests:type_name -> pps_v2.ResourceSpec
+	33,  // 167: pps_v2.JobInfo.Details.resource_limits:type_name -> pps_v2.ResourceSpec
+	33,  // 168: pps_v2.JobInfo.Details.sidecar_resource_limits:type_name -> pps_v2.ResourceSpec
+	22,  // 169: pps_v2.JobInfo.Details.input:type_name -> pps_v2.Input
+	56,  // 170: pps_v2.JobInfo.Details.datum_set_spec:type_name -> pps_v2.DatumSetSpec
+	120, // 171: pps_v2.JobInfo.Details.datum_timeout:type_name -> google.protobuf.Duration
+	120, // 172: pps_v2.JobInfo.Details.job_timeout:type_name -> google.protobuf.Duration
+	57,  // 173: pps_v2.JobInfo.Details.scheduling_spec:type_name -> pps_v2.SchedulingSpec
+	33,  // 174: pps_v2.JobInfo.Details.sidecar_resource_requests:type_name -> pps_v2.ResourceSpec
+	9,   // 175: pps_v2.PipelineInfo.Details.transform:type_name -> pps_v2.Transform
+	12,  // 176: pps_v2.PipelineInfo.Details.tf_job:type_name -> pps_v2.TFJob
+	24,  // 177: pps_v2.PipelineInfo.Details.parallelism_spec:type_name -> pps_v2.ParallelismSpec
+	13,  // 178: pps_v2.PipelineInfo.Details.egress:type_name -> pps_v2.Egress
+	126, // 179: pps_v2.PipelineInfo.Details.created_at:type_name -> google.protobuf.Timestamp
+	33,  // 180: pps_v2.PipelineInfo.Details.resource_requests:type_name -> pps_v2.ResourceSpec
+	33,  // 181: pps_v2.PipelineInfo.Details.resource_limits:type_name -> pps_v2.ResourceSpec
+	33,  // 182: pps_v2.PipelineInfo.Details.sidecar_resource_limits:type_name -> pps_v2.ResourceSpec
+	22,  // 183: pps_v2.PipelineInfo.Details.input:type_name -> pps_v2.Input
+	17,  // 184: pps_v2.PipelineInfo.Details.service:type_name -> pps_v2.Service
+	18,  // 185: pps_v2.PipelineInfo.Details.spout:type_name -> pps_v2.Spout
+	56,  // 186: pps_v2.PipelineInfo.Details.datum_set_spec:type_name -> pps_v2.DatumSetSpec
+	120, // 187: pps_v2.PipelineInfo.Details.datum_timeout:type_name -> google.protobuf.Duration
+	120, // 188: pps_v2.PipelineInfo.Details.job_timeout:type_name -> google.protobuf.Duration
+	57,  // 189: pps_v2.PipelineInfo.Details.scheduling_spec:type_name -> pps_v2.SchedulingSpec
+	16,  // 190: pps_v2.PipelineInfo.Details.metadata:type_name -> pps_v2.Metadata
+	39,  // 191: pps_v2.PipelineInfo.Details.tolerations:type_name -> pps_v2.Toleration
+	33,  // 192: pps_v2.PipelineInfo.Details.sidecar_resource_requests:type_name -> pps_v2.ResourceSpec
+	14,  // 193: pps_v2.PipelineInfo.Details.determined:type_name -> pps_v2.Determined
+	131, // 194: pps_v2.PipelineInfo.Details.output_contract:type_name -> pfs_v2.ContentContract
+	1,   // 195: pps_v2.ListDatumRequest.Filter.state:type_name -> pps_v2.DatumState
+	134, // 196: pps_v2.NotificationEvent.CommitFinished.branch:type_name -> pfs_v2.Branch
+	38,  // 197: pps_v2.NotificationEvent.JobFinished.pipeline:type_name -> pps_v2.Pipeline
+	0,   // 198: pps_v2.NotificationEvent.JobFinished.states:type_name -> pps_v2.JobState
+	38,  // 199: pps_v2.NotificationEvent.PipelineStateChanged.pipeline:type_name -> pps_v2.Pipeline
+	3,   // 200: pps_v2.NotificationEvent.PipelineStateChanged.states:type_name -> pps_v2.PipelineState
+	45,  // 201: pps_v2.API.InspectJob:input_type -> pps_v2.InspectJobRequest
+	43,  // 202: pps_v2.API.InspectJobSet:input_type -> pps_v2.InspectJobSetRequest
+	46,  // 203: pps_v2.API.ListJob:input_type -> pps_v2.ListJobRequest
+	44,  // 204: pps_v2.API.ListJobSet:input_type -> pps_v2.ListJobSetRequest
+	47,  // 205: pps_v2.API.SubscribeJob:input_type -> pps_v2.SubscribeJobRequest
+	48,  // 206: pps_v2.API.DeleteJob:input_type -> pps_v2.DeleteJobRequest
+	49,  // 207: pps_v2.API.StopJob:input_type -> pps_v2.StopJobRequest
+	54,  // 208: pps_v2.API.InspectDatum:input_type -> pps_v2.InspectDatumRequest
+	55,  // 209: pps_v2.API.ListDatum:input_type -> pps_v2.ListDatumRequest
+	53,  // 210: pps_v2.API.RestartDatum:input_type -> pps_v2.RestartDatumRequest
+	58,  // 211: pps_v2.API.RerunPipeline:input_type -> pps_v2.RerunPipelineRequest
+	59,  // 212: pps_v2.API.CreatePipeline:input_type -> pps_v2.CreatePipelineRequest
+	60,  // 213: pps_v2.API.CreatePipelineV2:input_type -> pps_v2.CreatePipelineV2Request
+	62,  // 214: pps_v2.API.InspectPipeline:input_type -> pps_v2.InspectPipelineRequest
+	63,  // 215: pps_v2.API.ListPipeline:input_type -> pps_v2.ListPipelineRequest
+	64,  // 216: pps_v2.API.DeletePipeline:input_type -> pps_v2.DeletePipelineRequest
+	65,  // 217: pps_v2.API.DeletePipelines:input_type -> pps_v2.DeletePipelinesRequest
+	67,  // 218: pps_v2.API.StartPipeline:input_type -> pps_v2.StartPipelineRequest
+	68,  // 219: pps_v2.API.StopPipeline:input_type -> pps_v2.StopPipelineRequest
+	69,  // 220: pps_v2.API.RunPipeline:input_type -> pps_v2.RunPipelineRequest
+	70,  // 221: pps_v2.API.RunCron:input_type -> pps_v2.RunCronRequest
+	71,  // 222: pps_v2.API.CreateSecret:input_type -> pps_v2.CreateSecretRequest
+	72,  // 223: pps_v2.API.DeleteSecret:input_type -> pps_v2.DeleteSecretRequest
+	135, // 224: pps_v2.API.ListSecret:input_type -> google.protobuf.Empty
+	73,  // 225: pps_v2.API.InspectSecret:input_type -> pps_v2.InspectSecretRequest
+	135, // 226: pps_v2.API.DeleteAll:input_type -> google.protobuf.Empty
+	51,  // 227: pps_v2.API.GetLogs:input_type -> pps_v2.GetLogsRequest
+	77,  // 228: pps_v2.API.ActivateAuth:input_type -> pps_v2.ActivateAuthRequest
+	50,  // 229: pps_v2.API.UpdateJobState:input_type -> pps_v2.UpdateJobStateRequest
+	79,  // 230: pps_v2.API.RunLoadTest:input_type -> pps_v2.RunLoadTestRequest
+	135, // 231: pps_v2.API.RunLoadTestDefault:input_type -> google.protobuf.Empty
+	81,  // 232: pps_v2.API.RenderTemplate:input_type -> pps_v2.RenderTemplateRequest
+	136, // 233: pps_v2.API.ListTask:input_type -> taskapi.ListTaskRequest
+	83,  // 234: pps_v2.API.GetKubeEvents:input_type -> pps_v2.LokiRequest
+	83,  // 235: pps_v2.API.QueryLoki:input_type -> pps_v2.LokiRequest
+	86,  // 236: pps_v2.API.GetClusterDefaults:input_type -> pps_v2.GetClusterDefaultsRequest
+	88,  // 237: pps_v2.API.SetClusterDefaults:input_type -> pps_v2.SetClusterDefaultsRequest
+	96,  // 238: pps_v2.API.GetProjectComputeUsage:input_type -> pps_v2.GetProjectComputeUsageRequest
+	101, // 239: pps_v2.API.CreateNotification:input_type -> pps_v2.CreateNotificationRequest
+	103, // 240: pps_v2.API.ListNotification:input_type -> pps_v2.ListNotificationRequest
+	104, // 241: pps_v2.API.DeleteNotification:input_type -> pps_v2.DeleteNotificationRequest
+	106, // 242: pps_v2.API.ListNotificationDelivery:input_type -> pps_v2.ListNotificationDeliveryRequest
+	36,  // 243: pps_v2.API.InspectJob:output_type -> pps_v2.JobInfo
+	36,  // 244: pps_v2.API.InspectJobSet:output_type -> pps_v2.JobInfo
+	36,  // 245: pps_v2.API.ListJob:output_type -> pps_v2.JobInfo
+	35,  // 246: pps_v2.API.ListJobSet:output_type -> pps_v2.JobSetInfo
+	36,  // 247: pps_v2.API.SubscribeJob:output_type -> pps_v2.JobInfo
+	135, // 248: pps_v2.API.DeleteJob:output_type -> google.protobuf.Empty
+	135, // 249: pps_v2.API.StopJob:output_type -> google.protobuf.Empty
+	27,  // 250: pps_v2.API.InspectDatum:output_type -> pps_v2.DatumInfo
+	27,  // 251: pps_v2.API.ListDatum:output_type -> pps_v2.DatumInfo
+	135, // 252: pps_v2.API.RestartDatum:output_type -> google.protobuf.Empty
+	135, // 253: pps_v2.API.RerunPipeline:output_type -> google.protobuf.Empty
+	135, // 254: pps_v2.API.CreatePipeline:output_type -> google.protobuf.Empty
+	61,  // 255: pps_v2.API.CreatePipelineV2:output_type -> pps_v2.CreatePipelineV2Response
+	40,  // 256: pps_v2.API.InspectPipeline:output_type -> pps_v2.PipelineInfo
+	40,  // 257: pps_v2.API.ListPipeline:output_type -> pps_v2.PipelineInfo
+	135, // 258: pps_v2.API.DeletePipeline:output_type -> google.protobuf.Empty
+	66,  // 259: pps_v2.API.DeletePipelines:output_type -> pps_v2.DeletePipelinesResponse
+	135, // 260: pps_v2.API.StartPipeline:output_type -> google.protobuf.Empty
+	135, // 261: pps_v2.API.StopPipeline:output_type -> google.protobuf.Empty
+	135, // 262: pps_v2.API.RunPipeline:output_type -> google.protobuf.Empty
+	135, // 263: pps_v2.API.RunCron:output_type -> google.protobuf.Empty
+	135, // 264: pps_v2.API.CreateSecret:output_type -> google.protobuf.Empty
+	135, // 265: pps_v2.API.DeleteSecret:output_type -> google.protobuf.Empty
+	76,  // 266: pps_v2.API.ListSecret:output_type -> pps_v2.SecretInfos
+	75,  // 267: pps_v2.API.InspectSecret:output_type -> pps_v2.SecretInfo
+	135, // 268: pps_v2.API.DeleteAll:output_type -> google.protobuf.Empty
+	52,  // 269: pps_v2.API.GetLogs:output_type -> pps_v2.LogMessage
+	78,  // 270: pps_v2.API.ActivateAuth:output_type -> pps_v2.ActivateAuthResponse
+	135, // 271: pps_v2.API.UpdateJobState:output_type -> google.protobuf.Empty
+	80,  // 272: pps_v2.API.RunLoadTest:output_type -> pps_v2.RunLoadTestResponse
+	80,  // 273: pps_v2.API.RunLoadTestDefault:output_type -> pps_v2.RunLoadTestResponse
+	82,  // 274: pps_v2.API.RenderTemplate:output_type -> pps_v2.RenderTemplateResponse
+	137, // 275: pps_v2.API.ListTask:output_type -> taskapi.TaskInfo
+	84,  // 276: pps_v2.API.GetKubeEvents:output_type -> pps_v2.LokiLogMessage
+	84,  // 277: pps_v2.API.QueryLoki:output_type -> pps_v2.LokiLogMessage
+	87,  // 278: pps_v2.API.GetClusterDefaults:output_type -> pps_v2.GetClusterDefaultsResponse
+	89,  // 279: pps_v2.API.SetClusterDefaults:output_type -> pps_v2.SetClusterDefaultsResponse
+	97,  // 280: pps_v2.API.GetProjectComputeUsage:output_type -> pps_v2.GetProjectComputeUsageResponse
+	102, // 281: pps_v2.API.CreateNotification:output_type -> pps_v2.CreateNotificationResponse
+	100, // 282: pps_v2.API.ListNotification:output_type -> pps_v2.NotificationInfo
+	135, // 283: pps_v2.API.DeleteNotification:output_type -> google.protobuf.Empty
+	105, // 284: pps_v2.API.ListNotificationDelivery:output_type -> pps_v2.NotificationDeliveryInfo
+	243, // [243:285] is the sub-list for method output_type
+	201, // [201:243] is the sub-list for method input_type
+	201, // [201:201] is the sub-list for extension type_name
+	201, // [201:201] is the sub-list for extension extendee
+	0,   // [0:201] is the sub-list for field type_name
 }
 
 func init() { file_pps_pps_proto_init() }
@@ -8764,7 +10436,7 @@ func file_pps_pps_proto_init() {
 			}
 		}
 		file_pps_pps_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TFJob); i {
+			switch v := v.(*StarlarkTransform); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8776,7 +10448,7 @@ func file_pps_pps_proto_init() {
 			}
 		}
 		file_pps_pps_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Egress); i {
+			switch v := v.(*BuiltinTransform); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8788,7 +10460,7 @@ func file_pps_pps_proto_init() {
 			}
 		}
 		file_pps_pps_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Determined); i {
+			switch v := v.(*TFJob); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8800,7 +10472,7 @@ func file_pps_pps_proto_init() {
 			}
 		}
 		file_pps_pps_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Job); i {
+			switch v := v.(*Egress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8812,7 +10484,7 @@ func file_pps_pps_proto_init() {
 			}
 		}
 		file_pps_pps_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Metadata); i {
+			switch v := v.(*Determined); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8824,7 +10496,7 @@ func file_pps_pps_proto_init() {
 			}
 		}
 		file_pps_pps_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Service); i {
+			switch v := v.(*Job); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8836,7 +10508,7 @@ func file_pps_pps_proto_init() {
 			}
 		}
 		file_pps_pps_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Spout); i {
+			switch v := v.(*Metadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8848,7 +10520,7 @@ func file_pps_pps_proto_init() {
 			}
 		}
 		file_pps_pps_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PFSInput); i {
+			switch v := v.(*Service); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8860,7 +10532,7 @@ func file_pps_pps_proto_init() {
 			}
 		}
 		file_pps_pps_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CronInput); i {
+			switch v := v.(*Spout); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8872,7 +10544,7 @@ func file_pps_pps_proto_init() {
 			}
 		}
 		file_pps_pps_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Input); i {
+			switch v := v.(*PFSInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8884,7 +10556,7 @@ func file_pps_pps_proto_init() {
 			}
 		}
 		file_pps_pps_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JobInput); i {
+			switch v := v.(*PFSWindow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8896,7 +10568,7 @@ func file_pps_pps_proto_init() {
 			}
 		}
 		file_pps_pps_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ParallelismSpec); i {
+			switch v := v.(*CronInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8908,7 +10580,7 @@ func file_pps_pps_proto_init() {
 			}
 		}
 		file_pps_pps_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InputFile); i {
+			switch v := v.(*Input); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8920,7 +10592,7 @@ func file_pps_pps_proto_init() {
 			}
 		}
 		file_pps_pps_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Datum); i {
+			switch v := v.(*JobInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8932,6 +10604,42 @@ func file_pps_pps_proto_init() {
 			}
 		}
 		file_pps_pps_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParallelismSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pps_pps_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InputFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pps_pps_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Datum); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pps_pps_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DatumInfo); i {
 			case 0:
 				return &v.state
@@ -8943,8 +10651,188 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Aggregate); i {
+		file_pps_pps_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Aggregate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pps_pps_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pps_pps_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateProcessStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pps_pps_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkerStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pps_pps_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatumStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pps_pps_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pps_pps_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GPUSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pps_pps_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobSetInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pps_pps_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pps_pps_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Worker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pps_pps_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pipeline); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pps_pps_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Toleration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pps_pps_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PipelineInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pps_pps_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PipelineInfos); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pps_pps_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pps_pps_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectJobSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8955,8 +10843,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessStats); i {
+		file_pps_pps_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJobSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8967,8 +10855,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AggregateProcessStats); i {
+		file_pps_pps_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8979,8 +10867,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkerStatus); i {
+		file_pps_pps_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8991,8 +10879,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DatumStatus); i {
+		file_pps_pps_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9003,8 +10891,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResourceSpec); i {
+		file_pps_pps_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9015,8 +10903,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GPUSpec); i {
+		file_pps_pps_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9027,8 +10915,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JobSetInfo); i {
+		file_pps_pps_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateJobStateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9039,8 +10927,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JobInfo); i {
+		file_pps_pps_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLogsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9051,8 +10939,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Worker); i {
+		file_pps_pps_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9063,8 +10951,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pipeline); i {
+		file_pps_pps_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestartDatumRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9075,8 +10963,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Toleration); i {
+		file_pps_pps_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectDatumRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9087,8 +10975,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PipelineInfo); i {
+		file_pps_pps_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDatumRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9099,8 +10987,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PipelineInfos); i {
+		file_pps_pps_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatumSetSpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9111,8 +10999,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JobSet); i {
+		file_pps_pps_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchedulingSpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9123,8 +11011,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InspectJobSetRequest); i {
+		file_pps_pps_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RerunPipelineRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9135,8 +11023,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListJobSetRequest); i {
+		file_pps_pps_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePipelineRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9147,8 +11035,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InspectJobRequest); i {
+		file_pps_pps_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePipelineV2Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9159,8 +11047,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListJobRequest); i {
+		file_pps_pps_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePipelineV2Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9171,8 +11059,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeJobRequest); i {
+		file_pps_pps_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectPipelineRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9183,8 +11071,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteJobRequest); i {
+		file_pps_pps_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPipelineRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9195,8 +11083,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopJobRequest); i {
+		file_pps_pps_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePipelineRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9207,8 +11095,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateJobStateRequest); i {
+		file_pps_pps_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePipelinesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9219,8 +11107,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLogsRequest); i {
+		file_pps_pps_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePipelinesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9231,8 +11119,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogMessage); i {
+		file_pps_pps_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartPipelineRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9243,8 +11131,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestartDatumRequest); i {
+		file_pps_pps_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopPipelineRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9255,8 +11143,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InspectDatumRequest); i {
+		file_pps_pps_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunPipelineRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9267,8 +11155,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDatumRequest); i {
+		file_pps_pps_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunCronRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9279,8 +11167,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DatumSetSpec); i {
+		file_pps_pps_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSecretRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9291,8 +11179,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SchedulingSpec); i {
+		file_pps_pps_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSecretRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9303,8 +11191,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RerunPipelineRequest); i {
+		file_pps_pps_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectSecretRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9315,8 +11203,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePipelineRequest); i {
+		file_pps_pps_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secret); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9327,8 +11215,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePipelineV2Request); i {
+		file_pps_pps_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9339,8 +11227,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePipelineV2Response); i {
+		file_pps_pps_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretInfos); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9351,8 +11239,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InspectPipelineRequest); i {
+		file_pps_pps_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivateAuthRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9363,8 +11251,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPipelineRequest); i {
+		file_pps_pps_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivateAuthResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9375,8 +11263,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePipelineRequest); i {
+		file_pps_pps_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunLoadTestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9387,8 +11275,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePipelinesRequest); i {
+		file_pps_pps_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunLoadTestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9399,8 +11287,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePipelinesResponse); i {
+		file_pps_pps_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenderTemplateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9411,8 +11299,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartPipelineRequest); i {
+		file_pps_pps_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenderTemplateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9423,8 +11311,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopPipelineRequest); i {
+		file_pps_pps_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LokiRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9435,8 +11323,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunPipelineRequest); i {
+		file_pps_pps_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LokiLogMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9447,8 +11335,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunCronRequest); i {
+		file_pps_pps_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterDefaults); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9459,8 +11347,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSecretRequest); i {
+		file_pps_pps_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClusterDefaultsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9471,8 +11359,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteSecretRequest); i {
+		file_pps_pps_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClusterDefaultsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9483,8 +11371,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InspectSecretRequest); i {
+		file_pps_pps_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetClusterDefaultsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9495,8 +11383,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Secret); i {
+		file_pps_pps_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetClusterDefaultsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9507,8 +11395,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SecretInfo); i {
+		file_pps_pps_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePipelineTransaction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9519,8 +11407,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SecretInfos); i {
+		file_pps_pps_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectDefaults); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9531,8 +11419,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActivateAuthRequest); i {
+		file_pps_pps_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProjectDefaultsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9543,8 +11431,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActivateAuthResponse); i {
+		file_pps_pps_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProjectDefaultsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9555,8 +11443,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunLoadTestRequest); i {
+		file_pps_pps_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetProjectDefaultsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9567,8 +11455,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunLoadTestResponse); i {
+		file_pps_pps_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetProjectDefaultsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9579,8 +11467,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenderTemplateRequest); i {
+		file_pps_pps_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProjectComputeUsageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9591,8 +11479,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenderTemplateResponse); i {
+		file_pps_pps_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProjectComputeUsageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9603,8 +11491,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LokiRequest); i {
+		file_pps_pps_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Notification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9615,8 +11503,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LokiLogMessage); i {
+		file_pps_pps_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9627,8 +11515,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterDefaults); i {
+		file_pps_pps_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9639,8 +11527,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetClusterDefaultsRequest); i {
+		file_pps_pps_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateNotificationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9651,8 +11539,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetClusterDefaultsResponse); i {
+		file_pps_pps_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateNotificationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9663,8 +11551,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetClusterDefaultsRequest); i {
+		file_pps_pps_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNotificationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9675,8 +11563,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetClusterDefaultsResponse); i {
+		file_pps_pps_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteNotificationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9687,8 +11575,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePipelineTransaction); i {
+		file_pps_pps_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationDeliveryInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9699,8 +11587,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProjectDefaults); i {
+		file_pps_pps_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNotificationDeliveryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9711,8 +11599,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProjectDefaultsRequest); i {
+		file_pps_pps_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationPayload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9723,8 +11611,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProjectDefaultsResponse); i {
+		file_pps_pps_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobInfo_Details); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9735,8 +11623,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetProjectDefaultsRequest); i {
+		file_pps_pps_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PipelineInfo_Details); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9747,8 +11635,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetProjectDefaultsResponse); i {
+		file_pps_pps_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDatumRequest_Filter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9759,8 +11647,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JobInfo_Details); i {
+		file_pps_pps_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationEvent_CommitFinished); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9771,8 +11659,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PipelineInfo_Details); i {
+		file_pps_pps_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationEvent_JobFinished); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9783,8 +11671,8 @@ func file_pps_pps_proto_init() {
 				return nil
 			}
 		}
-		file_pps_pps_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDatumRequest_Filter); i {
+		file_pps_pps_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationEvent_PipelineStateChanged); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9796,17 +11684,27 @@ func file_pps_pps_proto_init() {
 			}
 		}
 	}
-	file_pps_pps_proto_msgTypes[3].OneofWrappers = []interface{}{
+	file_pps_pps_proto_msgTypes[5].OneofWrappers = []interface{}{
 		(*Egress_ObjectStorage)(nil),
 		(*Egress_SqlDatabase)(nil),
 	}
+	file_pps_pps_proto_msgTypes[91].OneofWrappers = []interface{}{
+		(*NotificationEvent_CommitFinished_)(nil),
+		(*NotificationEvent_JobFinished_)(nil),
+		(*NotificationEvent_PipelineStateChanged_)(nil),
+	}
+	file_pps_pps_proto_msgTypes[99].OneofWrappers = []interface{}{
+		(*NotificationPayload_CommitFinished)(nil),
+		(*NotificationPayload_JobFinished)(nil),
+		(*NotificationPayload_PipelineStateChanged)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pps_pps_proto_rawDesc,
-			NumEnums:      7,
-			NumMessages:   93,
+			NumEnums:      8,
+			NumMessages:   112,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -214,6 +214,64 @@ func (m *Transform) validate(all bool) error {
 
 	// no validation rules for DatumBatching
 
+	if all {
+		switch v := interface{}(m.GetStarlark()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransformValidationError{
+					field:  "Starlark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransformValidationError{
+					field:  "Starlark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStarlark()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransformValidationError{
+				field:  "Starlark",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBuiltin()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransformValidationError{
+					field:  "Builtin",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransformValidationError{
+					field:  "Builtin",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBuiltin()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransformValidationError{
+				field:  "Builtin",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return TransformMultiError(errors)
 	}
@@ -291,6 +349,274 @@ var _ interface {
 	ErrorName() string
 } = TransformValidationError{}
 
+// Validate checks the field values on StarlarkTransform with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StarlarkTransform) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StarlarkTransform with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StarlarkTransformMultiError, or nil if none found.
+func (m *StarlarkTransform) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StarlarkTransform) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Script
+
+	// no validation rules for MaxSteps
+
+	// no validation rules for MemoryLimitBytes
+
+	if all {
+		switch v := interface{}(m.GetTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StarlarkTransformValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StarlarkTransformValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StarlarkTransformValidationError{
+				field:  "Timeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StarlarkTransformMultiError(errors)
+	}
+
+	return nil
+}
+
+// StarlarkTransformMultiError is an error wrapping multiple validation errors
+// returned by StarlarkTransform.ValidateAll() if the designated constraints
+// aren't met.
+type StarlarkTransformMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StarlarkTransformMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StarlarkTransformMultiError) AllErrors() []error { return m }
+
+// StarlarkTransformValidationError is the validation error returned by
+// StarlarkTransform.Validate if the designated constraints aren't met.
+type StarlarkTransformValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StarlarkTransformValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StarlarkTransformValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StarlarkTransformValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StarlarkTransformValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StarlarkTransformValidationError) ErrorName() string {
+	return "StarlarkTransformValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StarlarkTransformValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStarlarkTransform.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StarlarkTransformValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StarlarkTransformValidationError{}
+
+// Validate checks the field values on BuiltinTransform with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BuiltinTransform) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BuiltinTransform with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BuiltinTransformMultiError, or nil if none found.
+func (m *BuiltinTransform) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BuiltinTransform) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetArgs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BuiltinTransformValidationError{
+					field:  "Args",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BuiltinTransformValidationError{
+					field:  "Args",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArgs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BuiltinTransformValidationError{
+				field:  "Args",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BuiltinTransformMultiError(errors)
+	}
+
+	return nil
+}
+
+// BuiltinTransformMultiError is an error wrapping multiple validation errors
+// returned by BuiltinTransform.ValidateAll() if the designated constraints
+// aren't met.
+type BuiltinTransformMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BuiltinTransformMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BuiltinTransformMultiError) AllErrors() []error { return m }
+
+// BuiltinTransformValidationError is the validation error returned by
+// BuiltinTransform.Validate if the designated constraints aren't met.
+type BuiltinTransformValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BuiltinTransformValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BuiltinTransformValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BuiltinTransformValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BuiltinTransformValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BuiltinTransformValidationError) ErrorName() string { return "BuiltinTransformValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BuiltinTransformValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBuiltinTransform.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BuiltinTransformValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BuiltinTransformValidationError{}
+
 // Validate checks the field values on TFJob with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -1065,6 +1391,8 @@ func (m *Spout) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for ExactlyOnce
+
 	if len(errors) > 0 {
 		return SpoutMultiError(errors)
 	}
@@ -1219,20 +1547,80 @@ func (m *PFSInput) validate(all bool) error {
 		}
 	}
 
-	if len(errors) > 0 {
-		return PFSInputMultiError(errors)
-	}
-
-	return nil
-}
-
-// PFSInputMultiError is an error wrapping multiple validation errors returned
-// by PFSInput.ValidateAll() if the designated constraints aren't met.
-type PFSInputMultiError []error
+	// no validation rules for Incremental
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m PFSInputMultiError) Error() string {
-	var msgs []string
+	if all {
+		switch v := interface{}(m.GetWindow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PFSInputValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PFSInputValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWindow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PFSInputValidationError{
+				field:  "Window",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPreviousOutput()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PFSInputValidationError{
+					field:  "PreviousOutput",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PFSInputValidationError{
+					field:  "PreviousOutput",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreviousOutput()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PFSInputValidationError{
+				field:  "PreviousOutput",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PFSInputMultiError(errors)
+	}
+
+	return nil
+}
+
+// PFSInputMultiError is an error wrapping multiple validation errors returned
+// by PFSInput.ValidateAll() if the designated constraints aren't met.
+type PFSInputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PFSInputMultiError) Error() string {
+	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
@@ -1296,6 +1684,136 @@ var _ interface {
 	ErrorName() string
 } = PFSInputValidationError{}
 
+// Validate checks the field values on PFSWindow with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PFSWindow) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PFSWindow with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PFSWindowMultiError, or nil
+// if none found.
+func (m *PFSWindow) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PFSWindow) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Commits
+
+	if all {
+		switch v := interface{}(m.GetDuration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PFSWindowValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PFSWindowValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PFSWindowValidationError{
+				field:  "Duration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PFSWindowMultiError(errors)
+	}
+
+	return nil
+}
+
+// PFSWindowMultiError is an error wrapping multiple validation errors returned
+// by PFSWindow.ValidateAll() if the designated constraints aren't met.
+type PFSWindowMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PFSWindowMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PFSWindowMultiError) AllErrors() []error { return m }
+
+// PFSWindowValidationError is the validation error returned by
+// PFSWindow.Validate if the designated constraints aren't met.
+type PFSWindowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PFSWindowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PFSWindowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PFSWindowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PFSWindowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PFSWindowValidationError) ErrorName() string { return "PFSWindowValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PFSWindowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPFSWindow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PFSWindowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PFSWindowValidationError{}
+
 // Validate checks the field values on CronInput with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -3902,6 +4420,8 @@ func (m *JobInfo) validate(all bool) error {
 
 	// no validation rules for AuthToken
 
+	// no validation rules for TraceContext
+
 	if len(errors) > 0 {
 		return JobInfoMultiError(errors)
 	}
@@ -7907,6 +8427,35 @@ func (m *CreatePipelineRequest) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetOutputContract()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePipelineRequestValidationError{
+					field:  "OutputContract",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePipelineRequestValidationError{
+					field:  "OutputContract",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOutputContract()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePipelineRequestValidationError{
+				field:  "OutputContract",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return CreatePipelineRequestMultiError(errors)
 	}
@@ -12579,104 +13128,2014 @@ var _ interface {
 	ErrorName() string
 } = SetProjectDefaultsResponseValidationError{}
 
-// Validate checks the field values on JobInfo_Details with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *JobInfo_Details) Validate() error {
+// Validate checks the field values on GetProjectComputeUsageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProjectComputeUsageRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobInfo_Details with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// JobInfo_DetailsMultiError, or nil if none found.
-func (m *JobInfo_Details) ValidateAll() error {
+// ValidateAll checks the field values on GetProjectComputeUsageRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetProjectComputeUsageRequestMultiError, or nil if none found.
+func (m *GetProjectComputeUsageRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobInfo_Details) validate(all bool) error {
+func (m *GetProjectComputeUsageRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTransform()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobInfo_DetailsValidationError{
-					field:  "Transform",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobInfo_DetailsValidationError{
-					field:  "Transform",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if m.GetProject() == nil {
+		err := GetProjectComputeUsageRequestValidationError{
+			field:  "Project",
+			reason: "value is required",
 		}
-	} else if v, ok := interface{}(m.GetTransform()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobInfo_DetailsValidationError{
-				field:  "Transform",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if all {
-		switch v := interface{}(m.GetParallelismSpec()).(type) {
+		switch v := interface{}(m.GetProject()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobInfo_DetailsValidationError{
-					field:  "ParallelismSpec",
+				errors = append(errors, GetProjectComputeUsageRequestValidationError{
+					field:  "Project",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, JobInfo_DetailsValidationError{
-					field:  "ParallelismSpec",
+				errors = append(errors, GetProjectComputeUsageRequestValidationError{
+					field:  "Project",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetParallelismSpec()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return JobInfo_DetailsValidationError{
-				field:  "ParallelismSpec",
+			return GetProjectComputeUsageRequestValidationError{
+				field:  "Project",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetEgress()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobInfo_DetailsValidationError{
-					field:  "Egress",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobInfo_DetailsValidationError{
-					field:  "Egress",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if len(errors) > 0 {
+		return GetProjectComputeUsageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProjectComputeUsageRequestMultiError is an error wrapping multiple
+// validation errors returned by GetProjectComputeUsageRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetProjectComputeUsageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProjectComputeUsageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProjectComputeUsageRequestMultiError) AllErrors() []error { return m }
+
+// GetProjectComputeUsageRequestValidationError is the validation error
+// returned by GetProjectComputeUsageRequest.Validate if the designated
+// constraints aren't met.
+type GetProjectComputeUsageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProjectComputeUsageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProjectComputeUsageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProjectComputeUsageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProjectComputeUsageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProjectComputeUsageRequestValidationError) ErrorName() string {
+	return "GetProjectComputeUsageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProjectComputeUsageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProjectComputeUsageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProjectComputeUsageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProjectComputeUsageRequestValidationError{}
+
+// Validate checks the field values on GetProjectComputeUsageResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProjectComputeUsageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProjectComputeUsageResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetProjectComputeUsageResponseMultiError, or nil if none found.
+func (m *GetProjectComputeUsageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProjectComputeUsageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Workers
+
+	// no validation rules for Cpu
+
+	// no validation rules for Memory
+
+	if len(errors) > 0 {
+		return GetProjectComputeUsageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProjectComputeUsageResponseMultiError is an error wrapping multiple
+// validation errors returned by GetProjectComputeUsageResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetProjectComputeUsageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProjectComputeUsageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProjectComputeUsageResponseMultiError) AllErrors() []error { return m }
+
+// GetProjectComputeUsageResponseValidationError is the validation error
+// returned by GetProjectComputeUsageResponse.Validate if the designated
+// constraints aren't met.
+type GetProjectComputeUsageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProjectComputeUsageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProjectComputeUsageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProjectComputeUsageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProjectComputeUsageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProjectComputeUsageResponseValidationError) ErrorName() string {
+	return "GetProjectComputeUsageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProjectComputeUsageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProjectComputeUsageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProjectComputeUsageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProjectComputeUsageResponseValidationError{}
+
+// Validate checks the field values on Notification with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Notification) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Notification with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NotificationMultiError, or
+// nil if none found.
+func (m *Notification) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Notification) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return NotificationMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationMultiError is an error wrapping multiple validation errors
+// returned by Notification.ValidateAll() if the designated constraints aren't met.
+type NotificationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationMultiError) AllErrors() []error { return m }
+
+// NotificationValidationError is the validation error returned by
+// Notification.Validate if the designated constraints aren't met.
+type NotificationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationValidationError) ErrorName() string { return "NotificationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NotificationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotification.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationValidationError{}
+
+// Validate checks the field values on NotificationEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *NotificationEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NotificationEventMultiError, or nil if none found.
+func (m *NotificationEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Event.(type) {
+	case *NotificationEvent_CommitFinished_:
+		if v == nil {
+			err := NotificationEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetCommitFinished()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NotificationEventValidationError{
+						field:  "CommitFinished",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NotificationEventValidationError{
+						field:  "CommitFinished",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCommitFinished()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NotificationEventValidationError{
+					field:  "CommitFinished",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *NotificationEvent_JobFinished_:
+		if v == nil {
+			err := NotificationEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetJobFinished()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NotificationEventValidationError{
+						field:  "JobFinished",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NotificationEventValidationError{
+						field:  "JobFinished",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetJobFinished()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NotificationEventValidationError{
+					field:  "JobFinished",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *NotificationEvent_PipelineStateChanged_:
+		if v == nil {
+			err := NotificationEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPipelineStateChanged()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NotificationEventValidationError{
+						field:  "PipelineStateChanged",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NotificationEventValidationError{
+						field:  "PipelineStateChanged",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPipelineStateChanged()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NotificationEventValidationError{
+					field:  "PipelineStateChanged",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return NotificationEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationEventMultiError is an error wrapping multiple validation errors
+// returned by NotificationEvent.ValidateAll() if the designated constraints
+// aren't met.
+type NotificationEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationEventMultiError) AllErrors() []error { return m }
+
+// NotificationEventValidationError is the validation error returned by
+// NotificationEvent.Validate if the designated constraints aren't met.
+type NotificationEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationEventValidationError) ErrorName() string {
+	return "NotificationEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationEventValidationError{}
+
+// Validate checks the field values on NotificationInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *NotificationInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NotificationInfoMultiError, or nil if none found.
+func (m *NotificationInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetNotification()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationInfoValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationInfoValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNotification()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationInfoValidationError{
+				field:  "Notification",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Url
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NotificationInfoValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NotificationInfoValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NotificationInfoValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Secret
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationInfoValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationInfoValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationInfoValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return NotificationInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationInfoMultiError is an error wrapping multiple validation errors
+// returned by NotificationInfo.ValidateAll() if the designated constraints
+// aren't met.
+type NotificationInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationInfoMultiError) AllErrors() []error { return m }
+
+// NotificationInfoValidationError is the validation error returned by
+// NotificationInfo.Validate if the designated constraints aren't met.
+type NotificationInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationInfoValidationError) ErrorName() string { return "NotificationInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NotificationInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationInfoValidationError{}
+
+// Validate checks the field values on CreateNotificationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateNotificationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateNotificationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateNotificationRequestMultiError, or nil if none found.
+func (m *CreateNotificationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateNotificationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetNotification()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateNotificationRequestValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateNotificationRequestValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNotification()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateNotificationRequestValidationError{
+				field:  "Notification",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Url
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateNotificationRequestValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateNotificationRequestValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateNotificationRequestValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Secret
+
+	// no validation rules for Update
+
+	if len(errors) > 0 {
+		return CreateNotificationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateNotificationRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateNotificationRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateNotificationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateNotificationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateNotificationRequestMultiError) AllErrors() []error { return m }
+
+// CreateNotificationRequestValidationError is the validation error returned by
+// CreateNotificationRequest.Validate if the designated constraints aren't met.
+type CreateNotificationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateNotificationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateNotificationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateNotificationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateNotificationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateNotificationRequestValidationError) ErrorName() string {
+	return "CreateNotificationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateNotificationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateNotificationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateNotificationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateNotificationRequestValidationError{}
+
+// Validate checks the field values on CreateNotificationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateNotificationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateNotificationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateNotificationResponseMultiError, or nil if none found.
+func (m *CreateNotificationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateNotificationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Secret
+
+	if len(errors) > 0 {
+		return CreateNotificationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateNotificationResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateNotificationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateNotificationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateNotificationResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateNotificationResponseMultiError) AllErrors() []error { return m }
+
+// CreateNotificationResponseValidationError is the validation error returned
+// by CreateNotificationResponse.Validate if the designated constraints aren't met.
+type CreateNotificationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateNotificationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateNotificationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateNotificationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateNotificationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateNotificationResponseValidationError) ErrorName() string {
+	return "CreateNotificationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateNotificationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateNotificationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateNotificationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateNotificationResponseValidationError{}
+
+// Validate checks the field values on ListNotificationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNotificationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNotificationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNotificationRequestMultiError, or nil if none found.
+func (m *ListNotificationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNotificationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListNotificationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNotificationRequestMultiError is an error wrapping multiple validation
+// errors returned by ListNotificationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListNotificationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNotificationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNotificationRequestMultiError) AllErrors() []error { return m }
+
+// ListNotificationRequestValidationError is the validation error returned by
+// ListNotificationRequest.Validate if the designated constraints aren't met.
+type ListNotificationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNotificationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNotificationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNotificationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNotificationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNotificationRequestValidationError) ErrorName() string {
+	return "ListNotificationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNotificationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNotificationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNotificationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNotificationRequestValidationError{}
+
+// Validate checks the field values on DeleteNotificationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteNotificationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteNotificationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteNotificationRequestMultiError, or nil if none found.
+func (m *DeleteNotificationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteNotificationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetNotification()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteNotificationRequestValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteNotificationRequestValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNotification()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteNotificationRequestValidationError{
+				field:  "Notification",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteNotificationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteNotificationRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteNotificationRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteNotificationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteNotificationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteNotificationRequestMultiError) AllErrors() []error { return m }
+
+// DeleteNotificationRequestValidationError is the validation error returned by
+// DeleteNotificationRequest.Validate if the designated constraints aren't met.
+type DeleteNotificationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteNotificationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteNotificationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteNotificationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteNotificationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteNotificationRequestValidationError) ErrorName() string {
+	return "DeleteNotificationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteNotificationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteNotificationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteNotificationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteNotificationRequestValidationError{}
+
+// Validate checks the field values on NotificationDeliveryInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NotificationDeliveryInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationDeliveryInfo with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NotificationDeliveryInfoMultiError, or nil if none found.
+func (m *NotificationDeliveryInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationDeliveryInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetNotification()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationDeliveryInfoValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationDeliveryInfoValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNotification()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationDeliveryInfoValidationError{
+				field:  "Notification",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for EventId
+
+	// no validation rules for State
+
+	// no validation rules for Attempts
+
+	// no validation rules for StatusCode
+
+	// no validation rules for Error
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationDeliveryInfoValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationDeliveryInfoValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationDeliveryInfoValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeliveredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationDeliveryInfoValidationError{
+					field:  "DeliveredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationDeliveryInfoValidationError{
+					field:  "DeliveredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeliveredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationDeliveryInfoValidationError{
+				field:  "DeliveredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return NotificationDeliveryInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationDeliveryInfoMultiError is an error wrapping multiple validation
+// errors returned by NotificationDeliveryInfo.ValidateAll() if the designated
+// constraints aren't met.
+type NotificationDeliveryInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationDeliveryInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationDeliveryInfoMultiError) AllErrors() []error { return m }
+
+// NotificationDeliveryInfoValidationError is the validation error returned by
+// NotificationDeliveryInfo.Validate if the designated constraints aren't met.
+type NotificationDeliveryInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationDeliveryInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationDeliveryInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationDeliveryInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationDeliveryInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationDeliveryInfoValidationError) ErrorName() string {
+	return "NotificationDeliveryInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationDeliveryInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationDeliveryInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationDeliveryInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationDeliveryInfoValidationError{}
+
+// Validate checks the field values on ListNotificationDeliveryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNotificationDeliveryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNotificationDeliveryRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListNotificationDeliveryRequestMultiError, or nil if none found.
+func (m *ListNotificationDeliveryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNotificationDeliveryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetNotification()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListNotificationDeliveryRequestValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListNotificationDeliveryRequestValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNotification()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListNotificationDeliveryRequestValidationError{
+				field:  "Notification",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListNotificationDeliveryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNotificationDeliveryRequestMultiError is an error wrapping multiple
+// validation errors returned by ListNotificationDeliveryRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListNotificationDeliveryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNotificationDeliveryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNotificationDeliveryRequestMultiError) AllErrors() []error { return m }
+
+// ListNotificationDeliveryRequestValidationError is the validation error
+// returned by ListNotificationDeliveryRequest.Validate if the designated
+// constraints aren't met.
+type ListNotificationDeliveryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNotificationDeliveryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNotificationDeliveryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNotificationDeliveryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNotificationDeliveryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNotificationDeliveryRequestValidationError) ErrorName() string {
+	return "ListNotificationDeliveryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNotificationDeliveryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNotificationDeliveryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNotificationDeliveryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNotificationDeliveryRequestValidationError{}
+
+// Validate checks the field values on NotificationPayload with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NotificationPayload) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationPayload with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NotificationPayloadMultiError, or nil if none found.
+func (m *NotificationPayload) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationPayload) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeliveryId
+
+	if all {
+		switch v := interface{}(m.GetNotification()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationPayloadValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationPayloadValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNotification()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationPayloadValidationError{
+				field:  "Notification",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationPayloadValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationPayloadValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationPayloadValidationError{
+				field:  "Time",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.Event.(type) {
+	case *NotificationPayload_CommitFinished:
+		if v == nil {
+			err := NotificationPayloadValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetCommitFinished()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NotificationPayloadValidationError{
+						field:  "CommitFinished",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NotificationPayloadValidationError{
+						field:  "CommitFinished",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCommitFinished()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NotificationPayloadValidationError{
+					field:  "CommitFinished",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *NotificationPayload_JobFinished:
+		if v == nil {
+			err := NotificationPayloadValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetJobFinished()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NotificationPayloadValidationError{
+						field:  "JobFinished",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NotificationPayloadValidationError{
+						field:  "JobFinished",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetJobFinished()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NotificationPayloadValidationError{
+					field:  "JobFinished",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *NotificationPayload_PipelineStateChanged:
+		if v == nil {
+			err := NotificationPayloadValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPipelineStateChanged()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NotificationPayloadValidationError{
+						field:  "PipelineStateChanged",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NotificationPayloadValidationError{
+						field:  "PipelineStateChanged",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPipelineStateChanged()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NotificationPayloadValidationError{
+					field:  "PipelineStateChanged",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return NotificationPayloadMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationPayloadMultiError is an error wrapping multiple validation
+// errors returned by NotificationPayload.ValidateAll() if the designated
+// constraints aren't met.
+type NotificationPayloadMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationPayloadMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationPayloadMultiError) AllErrors() []error { return m }
+
+// NotificationPayloadValidationError is the validation error returned by
+// NotificationPayload.Validate if the designated constraints aren't met.
+type NotificationPayloadValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationPayloadValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationPayloadValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationPayloadValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationPayloadValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationPayloadValidationError) ErrorName() string {
+	return "NotificationPayloadValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationPayloadValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationPayload.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationPayloadValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationPayloadValidationError{}
+
+// Validate checks the field values on JobInfo_Details with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *JobInfo_Details) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobInfo_Details with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobInfo_DetailsMultiError, or nil if none found.
+func (m *JobInfo_Details) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobInfo_Details) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTransform()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobInfo_DetailsValidationError{
+					field:  "Transform",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobInfo_DetailsValidationError{
+					field:  "Transform",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransform()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobInfo_DetailsValidationError{
+				field:  "Transform",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetParallelismSpec()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobInfo_DetailsValidationError{
+					field:  "ParallelismSpec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobInfo_DetailsValidationError{
+					field:  "ParallelismSpec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetParallelismSpec()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobInfo_DetailsValidationError{
+				field:  "ParallelismSpec",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEgress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobInfo_DetailsValidationError{
+					field:  "Egress",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobInfo_DetailsValidationError{
+					field:  "Egress",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
 	} else if v, ok := interface{}(m.GetEgress()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
@@ -13434,31 +15893,255 @@ func (m *PipelineInfo_Details) validate(all bool) error {
 					cause:  err,
 				})
 			}
-		case interface{ Validate() error }:
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PipelineInfo_DetailsValidationError{
+					field:  "Service",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetService()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PipelineInfo_DetailsValidationError{
+				field:  "Service",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSpout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PipelineInfo_DetailsValidationError{
+					field:  "Spout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PipelineInfo_DetailsValidationError{
+					field:  "Spout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PipelineInfo_DetailsValidationError{
+				field:  "Spout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDatumSetSpec()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PipelineInfo_DetailsValidationError{
+					field:  "DatumSetSpec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PipelineInfo_DetailsValidationError{
+					field:  "DatumSetSpec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDatumSetSpec()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PipelineInfo_DetailsValidationError{
+				field:  "DatumSetSpec",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDatumTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PipelineInfo_DetailsValidationError{
+					field:  "DatumTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PipelineInfo_DetailsValidationError{
+					field:  "DatumTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDatumTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PipelineInfo_DetailsValidationError{
+				field:  "DatumTimeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetJobTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PipelineInfo_DetailsValidationError{
+					field:  "JobTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PipelineInfo_DetailsValidationError{
+					field:  "JobTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJobTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PipelineInfo_DetailsValidationError{
+				field:  "JobTimeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DatumTries
+
+	if all {
+		switch v := interface{}(m.GetSchedulingSpec()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PipelineInfo_DetailsValidationError{
+					field:  "SchedulingSpec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PipelineInfo_DetailsValidationError{
+					field:  "SchedulingSpec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchedulingSpec()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PipelineInfo_DetailsValidationError{
+				field:  "SchedulingSpec",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PodSpec
+
+	// no validation rules for PodPatch
+
+	// no validation rules for S3Out
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PipelineInfo_DetailsValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PipelineInfo_DetailsValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PipelineInfo_DetailsValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ReprocessSpec
+
+	// no validation rules for UnclaimedTasks
+
+	// no validation rules for WorkerRc
+
+	// no validation rules for Autoscaling
+
+	for idx, item := range m.GetTolerations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PipelineInfo_DetailsValidationError{
+						field:  fmt.Sprintf("Tolerations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PipelineInfo_DetailsValidationError{
+						field:  fmt.Sprintf("Tolerations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PipelineInfo_DetailsValidationError{
-					field:  "Service",
+				return PipelineInfo_DetailsValidationError{
+					field:  fmt.Sprintf("Tolerations[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetService()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PipelineInfo_DetailsValidationError{
-				field:  "Service",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if all {
-		switch v := interface{}(m.GetSpout()).(type) {
+		switch v := interface{}(m.GetSidecarResourceRequests()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
 				errors = append(errors, PipelineInfo_DetailsValidationError{
-					field:  "Spout",
+					field:  "SidecarResourceRequests",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
@@ -13466,16 +16149,16 @@ func (m *PipelineInfo_Details) validate(all bool) error {
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
 				errors = append(errors, PipelineInfo_DetailsValidationError{
-					field:  "Spout",
+					field:  "SidecarResourceRequests",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSpout()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSidecarResourceRequests()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
 			return PipelineInfo_DetailsValidationError{
-				field:  "Spout",
+				field:  "SidecarResourceRequests",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -13483,11 +16166,11 @@ func (m *PipelineInfo_Details) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetDatumSetSpec()).(type) {
+		switch v := interface{}(m.GetDetermined()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
 				errors = append(errors, PipelineInfo_DetailsValidationError{
-					field:  "DatumSetSpec",
+					field:  "Determined",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
@@ -13495,16 +16178,16 @@ func (m *PipelineInfo_Details) validate(all bool) error {
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
 				errors = append(errors, PipelineInfo_DetailsValidationError{
-					field:  "DatumSetSpec",
+					field:  "Determined",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDatumSetSpec()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDetermined()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
 			return PipelineInfo_DetailsValidationError{
-				field:  "DatumSetSpec",
+				field:  "Determined",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -13512,11 +16195,11 @@ func (m *PipelineInfo_Details) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetDatumTimeout()).(type) {
+		switch v := interface{}(m.GetOutputContract()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
 				errors = append(errors, PipelineInfo_DetailsValidationError{
-					field:  "DatumTimeout",
+					field:  "OutputContract",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
@@ -13524,211 +16207,383 @@ func (m *PipelineInfo_Details) validate(all bool) error {
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
 				errors = append(errors, PipelineInfo_DetailsValidationError{
-					field:  "DatumTimeout",
+					field:  "OutputContract",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDatumTimeout()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetOutputContract()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
 			return PipelineInfo_DetailsValidationError{
-				field:  "DatumTimeout",
+				field:  "OutputContract",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetJobTimeout()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PipelineInfo_DetailsValidationError{
-					field:  "JobTimeout",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PipelineInfo_DetailsValidationError{
-					field:  "JobTimeout",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetJobTimeout()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PipelineInfo_DetailsValidationError{
-				field:  "JobTimeout",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if len(errors) > 0 {
+		return PipelineInfo_DetailsMultiError(errors)
+	}
+
+	return nil
+}
+
+// PipelineInfo_DetailsMultiError is an error wrapping multiple validation
+// errors returned by PipelineInfo_Details.ValidateAll() if the designated
+// constraints aren't met.
+type PipelineInfo_DetailsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PipelineInfo_DetailsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PipelineInfo_DetailsMultiError) AllErrors() []error { return m }
+
+// PipelineInfo_DetailsValidationError is the validation error returned by
+// PipelineInfo_Details.Validate if the designated constraints aren't met.
+type PipelineInfo_DetailsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PipelineInfo_DetailsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PipelineInfo_DetailsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PipelineInfo_DetailsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PipelineInfo_DetailsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PipelineInfo_DetailsValidationError) ErrorName() string {
+	return "PipelineInfo_DetailsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PipelineInfo_DetailsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPipelineInfo_Details.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PipelineInfo_DetailsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PipelineInfo_DetailsValidationError{}
+
+// Validate checks the field values on ListDatumRequest_Filter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDatumRequest_Filter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDatumRequest_Filter with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDatumRequest_FilterMultiError, or nil if none found.
+func (m *ListDatumRequest_Filter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDatumRequest_Filter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListDatumRequest_FilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDatumRequest_FilterMultiError is an error wrapping multiple validation
+// errors returned by ListDatumRequest_Filter.ValidateAll() if the designated
+// constraints aren't met.
+type ListDatumRequest_FilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDatumRequest_FilterMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDatumRequest_FilterMultiError) AllErrors() []error { return m }
+
+// ListDatumRequest_FilterValidationError is the validation error returned by
+// ListDatumRequest_Filter.Validate if the designated constraints aren't met.
+type ListDatumRequest_FilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDatumRequest_FilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDatumRequest_FilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDatumRequest_FilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDatumRequest_FilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDatumRequest_FilterValidationError) ErrorName() string {
+	return "ListDatumRequest_FilterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDatumRequest_FilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDatumRequest_Filter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDatumRequest_FilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDatumRequest_FilterValidationError{}
+
+// Validate checks the field values on NotificationEvent_CommitFinished with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *NotificationEvent_CommitFinished) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationEvent_CommitFinished with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// NotificationEvent_CommitFinishedMultiError, or nil if none found.
+func (m *NotificationEvent_CommitFinished) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationEvent_CommitFinished) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	// no validation rules for DatumTries
+	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetSchedulingSpec()).(type) {
+		switch v := interface{}(m.GetBranch()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PipelineInfo_DetailsValidationError{
-					field:  "SchedulingSpec",
+				errors = append(errors, NotificationEvent_CommitFinishedValidationError{
+					field:  "Branch",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PipelineInfo_DetailsValidationError{
-					field:  "SchedulingSpec",
+				errors = append(errors, NotificationEvent_CommitFinishedValidationError{
+					field:  "Branch",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSchedulingSpec()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetBranch()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PipelineInfo_DetailsValidationError{
-				field:  "SchedulingSpec",
+			return NotificationEvent_CommitFinishedValidationError{
+				field:  "Branch",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for PodSpec
+	if len(errors) > 0 {
+		return NotificationEvent_CommitFinishedMultiError(errors)
+	}
 
-	// no validation rules for PodPatch
+	return nil
+}
 
-	// no validation rules for S3Out
+// NotificationEvent_CommitFinishedMultiError is an error wrapping multiple
+// validation errors returned by
+// NotificationEvent_CommitFinished.ValidateAll() if the designated
+// constraints aren't met.
+type NotificationEvent_CommitFinishedMultiError []error
 
-	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PipelineInfo_DetailsValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PipelineInfo_DetailsValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PipelineInfo_DetailsValidationError{
-				field:  "Metadata",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationEvent_CommitFinishedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	// no validation rules for ReprocessSpec
+// AllErrors returns a list of validation violation errors.
+func (m NotificationEvent_CommitFinishedMultiError) AllErrors() []error { return m }
 
-	// no validation rules for UnclaimedTasks
+// NotificationEvent_CommitFinishedValidationError is the validation error
+// returned by NotificationEvent_CommitFinished.Validate if the designated
+// constraints aren't met.
+type NotificationEvent_CommitFinishedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-	// no validation rules for WorkerRc
+// Field function returns field value.
+func (e NotificationEvent_CommitFinishedValidationError) Field() string { return e.field }
 
-	// no validation rules for Autoscaling
+// Reason function returns reason value.
+func (e NotificationEvent_CommitFinishedValidationError) Reason() string { return e.reason }
 
-	for idx, item := range m.GetTolerations() {
-		_, _ = idx, item
+// Cause function returns cause value.
+func (e NotificationEvent_CommitFinishedValidationError) Cause() error { return e.cause }
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PipelineInfo_DetailsValidationError{
-						field:  fmt.Sprintf("Tolerations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, PipelineInfo_DetailsValidationError{
-						field:  fmt.Sprintf("Tolerations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return PipelineInfo_DetailsValidationError{
-					field:  fmt.Sprintf("Tolerations[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// Key function returns key value.
+func (e NotificationEvent_CommitFinishedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationEvent_CommitFinishedValidationError) ErrorName() string {
+	return "NotificationEvent_CommitFinishedValidationError"
+}
 
+// Error satisfies the builtin error interface
+func (e NotificationEvent_CommitFinishedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	if all {
-		switch v := interface{}(m.GetSidecarResourceRequests()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PipelineInfo_DetailsValidationError{
-					field:  "SidecarResourceRequests",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PipelineInfo_DetailsValidationError{
-					field:  "SidecarResourceRequests",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSidecarResourceRequests()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PipelineInfo_DetailsValidationError{
-				field:  "SidecarResourceRequests",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationEvent_CommitFinished.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationEvent_CommitFinishedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationEvent_CommitFinishedValidationError{}
+
+// Validate checks the field values on NotificationEvent_JobFinished with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NotificationEvent_JobFinished) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationEvent_JobFinished with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// NotificationEvent_JobFinishedMultiError, or nil if none found.
+func (m *NotificationEvent_JobFinished) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationEvent_JobFinished) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
 	if all {
-		switch v := interface{}(m.GetDetermined()).(type) {
+		switch v := interface{}(m.GetPipeline()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PipelineInfo_DetailsValidationError{
-					field:  "Determined",
+				errors = append(errors, NotificationEvent_JobFinishedValidationError{
+					field:  "Pipeline",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PipelineInfo_DetailsValidationError{
-					field:  "Determined",
+				errors = append(errors, NotificationEvent_JobFinishedValidationError{
+					field:  "Pipeline",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDetermined()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetPipeline()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PipelineInfo_DetailsValidationError{
-				field:  "Determined",
+			return NotificationEvent_JobFinishedValidationError{
+				field:  "Pipeline",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -13736,19 +16591,19 @@ func (m *PipelineInfo_Details) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return PipelineInfo_DetailsMultiError(errors)
+		return NotificationEvent_JobFinishedMultiError(errors)
 	}
 
 	return nil
 }
 
-// PipelineInfo_DetailsMultiError is an error wrapping multiple validation
-// errors returned by PipelineInfo_Details.ValidateAll() if the designated
-// constraints aren't met.
-type PipelineInfo_DetailsMultiError []error
+// NotificationEvent_JobFinishedMultiError is an error wrapping multiple
+// validation errors returned by NotificationEvent_JobFinished.ValidateAll()
+// if the designated constraints aren't met.
+type NotificationEvent_JobFinishedMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PipelineInfo_DetailsMultiError) Error() string {
+func (m NotificationEvent_JobFinishedMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13757,11 +16612,12 @@ func (m PipelineInfo_DetailsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PipelineInfo_DetailsMultiError) AllErrors() []error { return m }
+func (m NotificationEvent_JobFinishedMultiError) AllErrors() []error { return m }
 
-// PipelineInfo_DetailsValidationError is the validation error returned by
-// PipelineInfo_Details.Validate if the designated constraints aren't met.
-type PipelineInfo_DetailsValidationError struct {
+// NotificationEvent_JobFinishedValidationError is the validation error
+// returned by NotificationEvent_JobFinished.Validate if the designated
+// constraints aren't met.
+type NotificationEvent_JobFinishedValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13769,24 +16625,24 @@ type PipelineInfo_DetailsValidationError struct {
 }
 
 // Field function returns field value.
-func (e PipelineInfo_DetailsValidationError) Field() string { return e.field }
+func (e NotificationEvent_JobFinishedValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PipelineInfo_DetailsValidationError) Reason() string { return e.reason }
+func (e NotificationEvent_JobFinishedValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PipelineInfo_DetailsValidationError) Cause() error { return e.cause }
+func (e NotificationEvent_JobFinishedValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PipelineInfo_DetailsValidationError) Key() bool { return e.key }
+func (e NotificationEvent_JobFinishedValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PipelineInfo_DetailsValidationError) ErrorName() string {
-	return "PipelineInfo_DetailsValidationError"
+func (e NotificationEvent_JobFinishedValidationError) ErrorName() string {
+	return "NotificationEvent_JobFinishedValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PipelineInfo_DetailsValidationError) Error() string {
+func (e NotificationEvent_JobFinishedValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13798,14 +16654,14 @@ func (e PipelineInfo_DetailsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPipelineInfo_Details.%s: %s%s",
+		"invalid %sNotificationEvent_JobFinished.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PipelineInfo_DetailsValidationError{}
+var _ error = NotificationEvent_JobFinishedValidationError{}
 
 var _ interface {
 	Field() string
@@ -13813,44 +16669,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PipelineInfo_DetailsValidationError{}
+} = NotificationEvent_JobFinishedValidationError{}
 
-// Validate checks the field values on ListDatumRequest_Filter with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListDatumRequest_Filter) Validate() error {
+// Validate checks the field values on NotificationEvent_PipelineStateChanged
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *NotificationEvent_PipelineStateChanged) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListDatumRequest_Filter with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListDatumRequest_FilterMultiError, or nil if none found.
-func (m *ListDatumRequest_Filter) ValidateAll() error {
+// ValidateAll checks the field values on
+// NotificationEvent_PipelineStateChanged with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// NotificationEvent_PipelineStateChangedMultiError, or nil if none found.
+func (m *NotificationEvent_PipelineStateChanged) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListDatumRequest_Filter) validate(all bool) error {
+func (m *NotificationEvent_PipelineStateChanged) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetPipeline()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationEvent_PipelineStateChangedValidationError{
+					field:  "Pipeline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationEvent_PipelineStateChangedValidationError{
+					field:  "Pipeline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPipeline()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationEvent_PipelineStateChangedValidationError{
+				field:  "Pipeline",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return ListDatumRequest_FilterMultiError(errors)
+		return NotificationEvent_PipelineStateChangedMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListDatumRequest_FilterMultiError is an error wrapping multiple validation
-// errors returned by ListDatumRequest_Filter.ValidateAll() if the designated
+// NotificationEvent_PipelineStateChangedMultiError is an error wrapping
+// multiple validation errors returned by
+// NotificationEvent_PipelineStateChanged.ValidateAll() if the designated
 // constraints aren't met.
-type ListDatumRequest_FilterMultiError []error
+type NotificationEvent_PipelineStateChangedMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListDatumRequest_FilterMultiError) Error() string {
+func (m NotificationEvent_PipelineStateChangedMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13859,11 +16747,12 @@ func (m ListDatumRequest_FilterMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListDatumRequest_FilterMultiError) AllErrors() []error { return m }
+func (m NotificationEvent_PipelineStateChangedMultiError) AllErrors() []error { return m }
 
-// ListDatumRequest_FilterValidationError is the validation error returned by
-// ListDatumRequest_Filter.Validate if the designated constraints aren't met.
-type ListDatumRequest_FilterValidationError struct {
+// NotificationEvent_PipelineStateChangedValidationError is the validation
+// error returned by NotificationEvent_PipelineStateChanged.Validate if the
+// designated constraints aren't met.
+type NotificationEvent_PipelineStateChangedValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13871,24 +16760,24 @@ type ListDatumRequest_FilterValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListDatumRequest_FilterValidationError) Field() string { return e.field }
+func (e NotificationEvent_PipelineStateChangedValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListDatumRequest_FilterValidationError) Reason() string { return e.reason }
+func (e NotificationEvent_PipelineStateChangedValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListDatumRequest_FilterValidationError) Cause() error { return e.cause }
+func (e NotificationEvent_PipelineStateChangedValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListDatumRequest_FilterValidationError) Key() bool { return e.key }
+func (e NotificationEvent_PipelineStateChangedValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListDatumRequest_FilterValidationError) ErrorName() string {
-	return "ListDatumRequest_FilterValidationError"
+func (e NotificationEvent_PipelineStateChangedValidationError) ErrorName() string {
+	return "NotificationEvent_PipelineStateChangedValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListDatumRequest_FilterValidationError) Error() string {
+func (e NotificationEvent_PipelineStateChangedValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13900,14 +16789,14 @@ func (e ListDatumRequest_FilterValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListDatumRequest_Filter.%s: %s%s",
+		"invalid %sNotificationEvent_PipelineStateChanged.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListDatumRequest_FilterValidationError{}
+var _ error = NotificationEvent_PipelineStateChangedValidationError{}
 
 var _ interface {
 	Field() string
@@ -13915,4 +16804,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListDatumRequest_FilterValidationError{}
+} = NotificationEvent_PipelineStateChangedValidationError{}
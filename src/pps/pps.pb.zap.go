@@ -87,6 +87,32 @@ func (x *Transform) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("dockerfile", x.Dockerfile)
 	enc.AddBool("memory_volume", x.MemoryVolume)
 	enc.AddBool("datum_batching", x.DatumBatching)
+	enc.AddObject("starlark", x.Starlark)
+	enc.AddObject("builtin", x.Builtin)
+	return nil
+}
+
+func (x *StarlarkTransform) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("script", x.Script)
+	enc.AddUint64("max_steps", x.MaxSteps)
+	enc.AddInt64("memory_limit_bytes", x.MemoryLimitBytes)
+	protoextensions.AddDuration(enc, "timeout", x.Timeout)
+	return nil
+}
+
+func (x *BuiltinTransform) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("name", x.Name)
+	if obj, ok := interface{}(x.Args).(zapcore.ObjectMarshaler); ok {
+		enc.AddObject("args", obj)
+	} else {
+		enc.AddReflected("args", x.Args)
+	}
 	return nil
 }
 
@@ -166,6 +192,7 @@ func (x *Spout) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 		return nil
 	}
 	enc.AddObject("service", x.Service)
+	enc.AddBool("exactly_once", x.ExactlyOnce)
 	return nil
 }
 
@@ -187,6 +214,18 @@ func (x *PFSInput) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddBool("empty_files", x.EmptyFiles)
 	enc.AddBool("s3", x.S3)
 	enc.AddObject("trigger", x.Trigger)
+	enc.AddBool("incremental", x.Incremental)
+	enc.AddObject("window", x.Window)
+	enc.AddObject("previous_output", x.PreviousOutput)
+	return nil
+}
+
+func (x *PFSWindow) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddInt64("commits", x.Commits)
+	protoextensions.AddDuration(enc, "duration", x.Duration)
 	return nil
 }
 
@@ -414,6 +453,12 @@ func (x *JobInfo) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	protoextensions.AddTimestamp(enc, "finished", x.Finished)
 	enc.AddObject("details", x.Details)
 	enc.AddString("auth_token", x.AuthToken)
+	enc.AddObject("trace_context", zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+		for k, v := range x.TraceContext {
+			enc.AddString(fmt.Sprintf("%v", k), v)
+		}
+		return nil
+	}))
 	return nil
 }
 
@@ -543,6 +588,7 @@ func (x *PipelineInfo_Details) MarshalLogObject(enc zapcore.ObjectEncoder) error
 	enc.AddArray("tolerations", zapcore.ArrayMarshalerFunc(tolerationsArrMarshaller))
 	enc.AddObject("sidecar_resource_requests", x.SidecarResourceRequests)
 	enc.AddObject("determined", x.Determined)
+	enc.AddObject("output_contract", x.OutputContract)
 	return nil
 }
 
@@ -848,6 +894,7 @@ func (x *CreatePipelineRequest) MarshalLogObject(enc zapcore.ObjectEncoder) erro
 	enc.AddObject("sidecar_resource_requests", x.SidecarResourceRequests)
 	enc.AddBool("dry_run", x.DryRun)
 	enc.AddObject("determined", x.Determined)
+	enc.AddObject("output_contract", x.OutputContract)
 	return nil
 }
 
@@ -1227,3 +1274,173 @@ func (x *SetProjectDefaultsResponse) MarshalLogObject(enc zapcore.ObjectEncoder)
 	enc.AddArray("affected_pipelines", zapcore.ArrayMarshalerFunc(affected_pipelinesArrMarshaller))
 	return nil
 }
+
+func (x *GetProjectComputeUsageRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("project", x.Project)
+	return nil
+}
+
+func (x *GetProjectComputeUsageResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddInt64("workers", x.Workers)
+	enc.AddString("cpu", x.Cpu)
+	enc.AddString("memory", x.Memory)
+	return nil
+}
+
+func (x *Notification) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("name", x.Name)
+	return nil
+}
+
+func (x *NotificationEvent) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("commit_finished", x.GetCommitFinished())
+	enc.AddObject("job_finished", x.GetJobFinished())
+	enc.AddObject("pipeline_state_changed", x.GetPipelineStateChanged())
+	return nil
+}
+
+func (x *NotificationEvent_CommitFinished) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("branch", x.Branch)
+	return nil
+}
+
+func (x *NotificationEvent_JobFinished) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("pipeline", x.Pipeline)
+	statesArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.States {
+			enc.AppendString(v.String())
+		}
+		return nil
+	}
+	enc.AddArray("states", zapcore.ArrayMarshalerFunc(statesArrMarshaller))
+	return nil
+}
+
+func (x *NotificationEvent_PipelineStateChanged) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("pipeline", x.Pipeline)
+	statesArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.States {
+			enc.AppendString(v.String())
+		}
+		return nil
+	}
+	enc.AddArray("states", zapcore.ArrayMarshalerFunc(statesArrMarshaller))
+	return nil
+}
+
+func (x *NotificationInfo) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("notification", x.Notification)
+	enc.AddString("url", x.Url)
+	eventsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Events {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("events", zapcore.ArrayMarshalerFunc(eventsArrMarshaller))
+	enc.AddString("secret", x.Secret)
+	protoextensions.AddTimestamp(enc, "created_at", x.CreatedAt)
+	return nil
+}
+
+func (x *CreateNotificationRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("notification", x.Notification)
+	enc.AddString("url", x.Url)
+	eventsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Events {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("events", zapcore.ArrayMarshalerFunc(eventsArrMarshaller))
+	enc.AddString("secret", x.Secret)
+	enc.AddBool("update", x.Update)
+	return nil
+}
+
+func (x *CreateNotificationResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("secret", x.Secret)
+	return nil
+}
+
+func (x *ListNotificationRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	return nil
+}
+
+func (x *DeleteNotificationRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("notification", x.Notification)
+	return nil
+}
+
+func (x *NotificationDeliveryInfo) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("id", x.Id)
+	enc.AddObject("notification", x.Notification)
+	enc.AddString("event_id", x.EventId)
+	enc.AddString("state", x.State.String())
+	enc.AddInt32("attempts", x.Attempts)
+	enc.AddInt32("status_code", x.StatusCode)
+	enc.AddString("error", x.Error)
+	protoextensions.AddTimestamp(enc, "created_at", x.CreatedAt)
+	protoextensions.AddTimestamp(enc, "delivered_at", x.DeliveredAt)
+	return nil
+}
+
+func (x *ListNotificationDeliveryRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("notification", x.Notification)
+	return nil
+}
+
+func (x *NotificationPayload) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("delivery_id", x.DeliveryId)
+	enc.AddObject("notification", x.Notification)
+	protoextensions.AddTimestamp(enc, "time", x.Time)
+	enc.AddObject("commit_finished", x.GetCommitFinished())
+	enc.AddObject("job_finished", x.GetJobFinished())
+	enc.AddObject("pipeline_state_changed", x.GetPipelineStateChanged())
+	return nil
+}
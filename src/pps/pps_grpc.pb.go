@@ -21,43 +21,48 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	API_InspectJob_FullMethodName         = "/pps_v2.API/InspectJob"
-	API_InspectJobSet_FullMethodName      = "/pps_v2.API/InspectJobSet"
-	API_ListJob_FullMethodName            = "/pps_v2.API/ListJob"
-	API_ListJobSet_FullMethodName         = "/pps_v2.API/ListJobSet"
-	API_SubscribeJob_FullMethodName       = "/pps_v2.API/SubscribeJob"
-	API_DeleteJob_FullMethodName          = "/pps_v2.API/DeleteJob"
-	API_StopJob_FullMethodName            = "/pps_v2.API/StopJob"
-	API_InspectDatum_FullMethodName       = "/pps_v2.API/InspectDatum"
-	API_ListDatum_FullMethodName          = "/pps_v2.API/ListDatum"
-	API_RestartDatum_FullMethodName       = "/pps_v2.API/RestartDatum"
-	API_RerunPipeline_FullMethodName      = "/pps_v2.API/RerunPipeline"
-	API_CreatePipeline_FullMethodName     = "/pps_v2.API/CreatePipeline"
-	API_CreatePipelineV2_FullMethodName   = "/pps_v2.API/CreatePipelineV2"
-	API_InspectPipeline_FullMethodName    = "/pps_v2.API/InspectPipeline"
-	API_ListPipeline_FullMethodName       = "/pps_v2.API/ListPipeline"
-	API_DeletePipeline_FullMethodName     = "/pps_v2.API/DeletePipeline"
-	API_DeletePipelines_FullMethodName    = "/pps_v2.API/DeletePipelines"
-	API_StartPipeline_FullMethodName      = "/pps_v2.API/StartPipeline"
-	API_StopPipeline_FullMethodName       = "/pps_v2.API/StopPipeline"
-	API_RunPipeline_FullMethodName        = "/pps_v2.API/RunPipeline"
-	API_RunCron_FullMethodName            = "/pps_v2.API/RunCron"
-	API_CreateSecret_FullMethodName       = "/pps_v2.API/CreateSecret"
-	API_DeleteSecret_FullMethodName       = "/pps_v2.API/DeleteSecret"
-	API_ListSecret_FullMethodName         = "/pps_v2.API/ListSecret"
-	API_InspectSecret_FullMethodName      = "/pps_v2.API/InspectSecret"
-	API_DeleteAll_FullMethodName          = "/pps_v2.API/DeleteAll"
-	API_GetLogs_FullMethodName            = "/pps_v2.API/GetLogs"
-	API_ActivateAuth_FullMethodName       = "/pps_v2.API/ActivateAuth"
-	API_UpdateJobState_FullMethodName     = "/pps_v2.API/UpdateJobState"
-	API_RunLoadTest_FullMethodName        = "/pps_v2.API/RunLoadTest"
-	API_RunLoadTestDefault_FullMethodName = "/pps_v2.API/RunLoadTestDefault"
-	API_RenderTemplate_FullMethodName     = "/pps_v2.API/RenderTemplate"
-	API_ListTask_FullMethodName           = "/pps_v2.API/ListTask"
-	API_GetKubeEvents_FullMethodName      = "/pps_v2.API/GetKubeEvents"
-	API_QueryLoki_FullMethodName          = "/pps_v2.API/QueryLoki"
-	API_GetClusterDefaults_FullMethodName = "/pps_v2.API/GetClusterDefaults"
-	API_SetClusterDefaults_FullMethodName = "/pps_v2.API/SetClusterDefaults"
+	API_InspectJob_FullMethodName               = "/pps_v2.API/InspectJob"
+	API_InspectJobSet_FullMethodName            = "/pps_v2.API/InspectJobSet"
+	API_ListJob_FullMethodName                  = "/pps_v2.API/ListJob"
+	API_ListJobSet_FullMethodName               = "/pps_v2.API/ListJobSet"
+	API_SubscribeJob_FullMethodName             = "/pps_v2.API/SubscribeJob"
+	API_DeleteJob_FullMethodName                = "/pps_v2.API/DeleteJob"
+	API_StopJob_FullMethodName                  = "/pps_v2.API/StopJob"
+	API_InspectDatum_FullMethodName             = "/pps_v2.API/InspectDatum"
+	API_ListDatum_FullMethodName                = "/pps_v2.API/ListDatum"
+	API_RestartDatum_FullMethodName             = "/pps_v2.API/RestartDatum"
+	API_RerunPipeline_FullMethodName            = "/pps_v2.API/RerunPipeline"
+	API_CreatePipeline_FullMethodName           = "/pps_v2.API/CreatePipeline"
+	API_CreatePipelineV2_FullMethodName         = "/pps_v2.API/CreatePipelineV2"
+	API_InspectPipeline_FullMethodName          = "/pps_v2.API/InspectPipeline"
+	API_ListPipeline_FullMethodName             = "/pps_v2.API/ListPipeline"
+	API_DeletePipeline_FullMethodName           = "/pps_v2.API/DeletePipeline"
+	API_DeletePipelines_FullMethodName          = "/pps_v2.API/DeletePipelines"
+	API_StartPipeline_FullMethodName            = "/pps_v2.API/StartPipeline"
+	API_StopPipeline_FullMethodName             = "/pps_v2.API/StopPipeline"
+	API_RunPipeline_FullMethodName              = "/pps_v2.API/RunPipeline"
+	API_RunCron_FullMethodName                  = "/pps_v2.API/RunCron"
+	API_CreateSecret_FullMethodName             = "/pps_v2.API/CreateSecret"
+	API_DeleteSecret_FullMethodName             = "/pps_v2.API/DeleteSecret"
+	API_ListSecret_FullMethodName               = "/pps_v2.API/ListSecret"
+	API_InspectSecret_FullMethodName            = "/pps_v2.API/InspectSecret"
+	API_DeleteAll_FullMethodName                = "/pps_v2.API/DeleteAll"
+	API_GetLogs_FullMethodName                  = "/pps_v2.API/GetLogs"
+	API_ActivateAuth_FullMethodName             = "/pps_v2.API/ActivateAuth"
+	API_UpdateJobState_FullMethodName           = "/pps_v2.API/UpdateJobState"
+	API_RunLoadTest_FullMethodName              = "/pps_v2.API/RunLoadTest"
+	API_RunLoadTestDefault_FullMethodName       = "/pps_v2.API/RunLoadTestDefault"
+	API_RenderTemplate_FullMethodName           = "/pps_v2.API/RenderTemplate"
+	API_ListTask_FullMethodName                 = "/pps_v2.API/ListTask"
+	API_GetKubeEvents_FullMethodName            = "/pps_v2.API/GetKubeEvents"
+	API_QueryLoki_FullMethodName                = "/pps_v2.API/QueryLoki"
+	API_GetClusterDefaults_FullMethodName       = "/pps_v2.API/GetClusterDefaults"
+	API_SetClusterDefaults_FullMethodName       = "/pps_v2.API/SetClusterDefaults"
+	API_GetProjectComputeUsage_FullMethodName   = "/pps_v2.API/GetProjectComputeUsage"
+	API_CreateNotification_FullMethodName       = "/pps_v2.API/CreateNotification"
+	API_ListNotification_FullMethodName         = "/pps_v2.API/ListNotification"
+	API_DeleteNotification_FullMethodName       = "/pps_v2.API/DeleteNotification"
+	API_ListNotificationDelivery_FullMethodName = "/pps_v2.API/ListNotificationDelivery"
 )
 
 // APIClient is the client API for API service.
@@ -115,6 +120,17 @@ type APIClient interface {
 	GetClusterDefaults(ctx context.Context, in *GetClusterDefaultsRequest, opts ...grpc.CallOption) (*GetClusterDefaultsResponse, error)
 	// SetClusterDefaults returns the current cluster defaults.
 	SetClusterDefaults(ctx context.Context, in *SetClusterDefaultsRequest, opts ...grpc.CallOption) (*SetClusterDefaultsResponse, error)
+	// GetProjectComputeUsage returns the compute resources used by a project's
+	// pipelines.
+	GetProjectComputeUsage(ctx context.Context, in *GetProjectComputeUsageRequest, opts ...grpc.CallOption) (*GetProjectComputeUsageResponse, error)
+	// CreateNotification subscribes an HTTP endpoint to commit, job and pipeline
+	// events.
+	CreateNotification(ctx context.Context, in *CreateNotificationRequest, opts ...grpc.CallOption) (*CreateNotificationResponse, error)
+	ListNotification(ctx context.Context, in *ListNotificationRequest, opts ...grpc.CallOption) (API_ListNotificationClient, error)
+	DeleteNotification(ctx context.Context, in *DeleteNotificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListNotificationDelivery returns the delivery log of a notification, most
+	// recent first.
+	ListNotificationDelivery(ctx context.Context, in *ListNotificationDeliveryRequest, opts ...grpc.CallOption) (API_ListNotificationDeliveryClient, error)
 }
 
 type aPIClient struct {
@@ -688,6 +704,97 @@ func (c *aPIClient) SetClusterDefaults(ctx context.Context, in *SetClusterDefaul
 	return out, nil
 }
 
+func (c *aPIClient) GetProjectComputeUsage(ctx context.Context, in *GetProjectComputeUsageRequest, opts ...grpc.CallOption) (*GetProjectComputeUsageResponse, error) {
+	out := new(GetProjectComputeUsageResponse)
+	err := c.cc.Invoke(ctx, API_GetProjectComputeUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CreateNotification(ctx context.Context, in *CreateNotificationRequest, opts ...grpc.CallOption) (*CreateNotificationResponse, error) {
+	out := new(CreateNotificationResponse)
+	err := c.cc.Invoke(ctx, API_CreateNotification_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListNotification(ctx context.Context, in *ListNotificationRequest, opts ...grpc.CallOption) (API_ListNotificationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[10], API_ListNotification_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIListNotificationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_ListNotificationClient interface {
+	Recv() (*NotificationInfo, error)
+	grpc.ClientStream
+}
+
+type aPIListNotificationClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIListNotificationClient) Recv() (*NotificationInfo, error) {
+	m := new(NotificationInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) DeleteNotification(ctx context.Context, in *DeleteNotificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, API_DeleteNotification_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListNotificationDelivery(ctx context.Context, in *ListNotificationDeliveryRequest, opts ...grpc.CallOption) (API_ListNotificationDeliveryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[11], API_ListNotificationDelivery_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIListNotificationDeliveryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_ListNotificationDeliveryClient interface {
+	Recv() (*NotificationDeliveryInfo, error)
+	grpc.ClientStream
+}
+
+type aPIListNotificationDeliveryClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIListNotificationDeliveryClient) Recv() (*NotificationDeliveryInfo, error) {
+	m := new(NotificationDeliveryInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // APIServer is the server API for API service.
 // All implementations must embed UnimplementedAPIServer
 // for forward compatibility
@@ -743,6 +850,17 @@ type APIServer interface {
 	GetClusterDefaults(context.Context, *GetClusterDefaultsRequest) (*GetClusterDefaultsResponse, error)
 	// SetClusterDefaults returns the current cluster defaults.
 	SetClusterDefaults(context.Context, *SetClusterDefaultsRequest) (*SetClusterDefaultsResponse, error)
+	// GetProjectComputeUsage returns the compute resources used by a project's
+	// pipelines.
+	GetProjectComputeUsage(context.Context, *GetProjectComputeUsageRequest) (*GetProjectComputeUsageResponse, error)
+	// CreateNotification subscribes an HTTP endpoint to commit, job and pipeline
+	// events.
+	CreateNotification(context.Context, *CreateNotificationRequest) (*CreateNotificationResponse, error)
+	ListNotification(*ListNotificationRequest, API_ListNotificationServer) error
+	DeleteNotification(context.Context, *DeleteNotificationRequest) (*emptypb.Empty, error)
+	// ListNotificationDelivery returns the delivery log of a notification, most
+	// recent first.
+	ListNotificationDelivery(*ListNotificationDeliveryRequest, API_ListNotificationDeliveryServer) error
 	mustEmbedUnimplementedAPIServer()
 }
 
@@ -861,6 +979,21 @@ func (UnimplementedAPIServer) GetClusterDefaults(context.Context, *GetClusterDef
 func (UnimplementedAPIServer) SetClusterDefaults(context.Context, *SetClusterDefaultsRequest) (*SetClusterDefaultsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetClusterDefaults not implemented")
 }
+func (UnimplementedAPIServer) GetProjectComputeUsage(context.Context, *GetProjectComputeUsageRequest) (*GetProjectComputeUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectComputeUsage not implemented")
+}
+func (UnimplementedAPIServer) CreateNotification(context.Context, *CreateNotificationRequest) (*CreateNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNotification not implemented")
+}
+func (UnimplementedAPIServer) ListNotification(*ListNotificationRequest, API_ListNotificationServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListNotification not implemented")
+}
+func (UnimplementedAPIServer) DeleteNotification(context.Context, *DeleteNotificationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNotification not implemented")
+}
+func (UnimplementedAPIServer) ListNotificationDelivery(*ListNotificationDeliveryRequest, API_ListNotificationDeliveryServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListNotificationDelivery not implemented")
+}
 func (UnimplementedAPIServer) mustEmbedUnimplementedAPIServer() {}
 
 // UnsafeAPIServer may be embedded to opt out of forward compatibility for this service.
@@ -1570,6 +1703,102 @@ func _API_SetClusterDefaults_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_GetProjectComputeUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectComputeUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetProjectComputeUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetProjectComputeUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetProjectComputeUsage(ctx, req.(*GetProjectComputeUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CreateNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CreateNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateNotification(ctx, req.(*CreateNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListNotification_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListNotificationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).ListNotification(m, &aPIListNotificationServer{stream})
+}
+
+type API_ListNotificationServer interface {
+	Send(*NotificationInfo) error
+	grpc.ServerStream
+}
+
+type aPIListNotificationServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListNotificationServer) Send(m *NotificationInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_DeleteNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DeleteNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteNotification(ctx, req.(*DeleteNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListNotificationDelivery_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListNotificationDeliveryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).ListNotificationDelivery(m, &aPIListNotificationDeliveryServer{stream})
+}
+
+type API_ListNotificationDeliveryServer interface {
+	Send(*NotificationDeliveryInfo) error
+	grpc.ServerStream
+}
+
+type aPIListNotificationDeliveryServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListNotificationDeliveryServer) Send(m *NotificationDeliveryInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // API_ServiceDesc is the grpc.ServiceDesc for API service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1685,6 +1914,18 @@ var API_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetClusterDefaults",
 			Handler:    _API_SetClusterDefaults_Handler,
 		},
+		{
+			MethodName: "GetProjectComputeUsage",
+			Handler:    _API_GetProjectComputeUsage_Handler,
+		},
+		{
+			MethodName: "CreateNotification",
+			Handler:    _API_CreateNotification_Handler,
+		},
+		{
+			MethodName: "DeleteNotification",
+			Handler:    _API_DeleteNotification_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1737,6 +1978,16 @@ var API_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _API_QueryLoki_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListNotification",
+			Handler:       _API_ListNotification_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListNotificationDelivery",
+			Handler:       _API_ListNotificationDelivery_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "pps/pps.proto",
 }
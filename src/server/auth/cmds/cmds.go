@@ -17,12 +17,15 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachctl"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pretty"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tabwriter"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 	"github.com/pkg/browser"
 	"golang.org/x/text/feature/plural"
 	"golang.org/x/text/language"
 	"golang.org/x/text/message"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/spf13/cobra"
 )
@@ -57,8 +60,44 @@ func printRoleBinding(b *auth.RoleBinding) {
 		for r := range roles.Roles {
 			roleList = append(roleList, r)
 		}
-		fmt.Printf("%v: %v\n", principal, roleList)
+		var details string
+		if roles.Expiration != nil {
+			expiration := roles.Expiration.AsTime()
+			if time.Now().Before(expiration) {
+				details += fmt.Sprintf(" (expires %s)", expiration.Local().Format(time.RFC3339))
+			} else {
+				details += fmt.Sprintf(" (expired %s)", expiration.Local().Format(time.RFC3339))
+			}
+		}
+		if roles.Justification != "" {
+			details += fmt.Sprintf(" justification: %q", roles.Justification)
+		}
+		fmt.Printf("%v: %v%s\n", principal, roleList, details)
+	}
+}
+
+// roleBindingExpiration returns the expiration of a role binding that is set
+// with an --expires-in flag, or nil if the flag is empty.
+func roleBindingExpiration(expiresIn string) (*timestamppb.Timestamp, error) {
+	if expiresIn == "" {
+		return nil, nil
 	}
+	d, err := time.ParseDuration(expiresIn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse duration %q", expiresIn)
+	}
+	if d <= 0 {
+		return nil, errors.Errorf("--expires-in must be positive, not %v", d)
+	}
+	return timestamppb.New(time.Now().Add(d)), nil
+}
+
+// addRoleBindingExpirationFlags adds the flags that make a role binding
+// temporary to cmd.
+func addRoleBindingExpirationFlags(cmd *cobra.Command, expiresIn, justification *string) {
+	cmd.Flags().StringVar(expiresIn, "expires-in", "", "If set, the roles stop granting any permissions after this long"+
+		" (a golang duration, e.g. \"8h\" or \"72h\"), and are eventually removed.")
+	cmd.Flags().StringVar(justification, "justification", "", "Why the roles are granted, shown by `pachctl auth get` for access reviews.")
 }
 
 // ActivateCmd returns a cobra.Command to activate Pachyderm's auth system
@@ -281,6 +320,7 @@ func DeactivateCmd(ctx context.Context, pachctlCfg *pachctl.Config) *cobra.Comma
 // registered with your GitHub account will subsequently be accessible.
 func LoginCmd(ctx context.Context, pachctlCfg *pachctl.Config) *cobra.Command {
 	var noBrowser, enterprise, idToken bool
+	var workloadTokenFile string
 	login := &cobra.Command{
 		Short: "Log in to Pachyderm",
 		Long: "This command Logs in to Pachyderm. Any resources that have been restricted to " +
@@ -296,7 +336,19 @@ func LoginCmd(ctx context.Context, pachctlCfg *pachctl.Config) *cobra.Command {
 			// Issue authentication request to Pachyderm and get response
 			var resp *auth.AuthenticateResponse
 			var authErr error
-			if idToken {
+			if workloadTokenFile != "" {
+				token, err := os.ReadFile(workloadTokenFile)
+				if err != nil {
+					return errors.Wrapf(err, "could not read workload token")
+				}
+				resp, authErr = c.Authenticate(
+					c.Ctx(),
+					&auth.AuthenticateRequest{WorkloadToken: strings.TrimSpace(string(token))})
+				if authErr != nil {
+					return errors.Wrapf(grpcutil.ScrubGRPC(authErr),
+						"authorization failed (Pachyderm logs may contain more information)")
+				}
+			} else if idToken {
 				token, err := cmdutil.ReadPassword("ID token: ")
 				if err != nil {
 					return errors.Wrapf(err, "could not read id token")
@@ -336,6 +388,8 @@ func LoginCmd(ctx context.Context, pachctlCfg *pachctl.Config) *cobra.Command {
 		"If set, don't try to open a web browser")
 	login.PersistentFlags().BoolVarP(&idToken, "id-token", "t", false,
 		"If set, read an ID token on stdin to authenticate the user")
+	login.PersistentFlags().StringVar(&workloadTokenFile, "workload-token-file", "",
+		"Authenticate with the workload identity token (e.g. a projected Kubernetes service account token) in this file")
 	login.PersistentFlags().BoolVar(&enterprise, "enterprise", false, "Login for the active enterprise context.")
 	return cmdutil.CreateAlias(login, "auth login")
 }
@@ -401,6 +455,9 @@ func WhoamiCmd(ctx context.Context, pachctlCfg *pachctl.Config) *cobra.Command {
 			if e := resp.Expiration; e != nil {
 				fmt.Printf("session expires: %v\n", e.AsTime().Format(time.RFC822))
 			}
+			if len(resp.Scopes) > 0 {
+				fmt.Printf("token scopes: %s\n", formatTokenScopes(resp.Scopes))
+			}
 			return nil
 		}),
 	}
@@ -414,6 +471,7 @@ func GetRobotTokenCmd(ctx context.Context, pachctlCfg *pachctl.Config) *cobra.Co
 	var enterprise bool
 	var quiet bool
 	var ttl string
+	var scopes []string
 	getAuthToken := &cobra.Command{
 		Use:   "{{alias}} [username]",
 		Short: "Get an auth token for a robot user with the specified name.",
@@ -422,6 +480,7 @@ func GetRobotTokenCmd(ctx context.Context, pachctlCfg *pachctl.Config) *cobra.Co
 			"\t- {{alias}} my-robot --ttl 1h" +
 			"\t- {{alias}} my-robot --quiet" +
 			"\t- {{alias}} my-robot --quiet --ttl 1h" +
+			"\t- {{alias}} my-robot --scope repo:images@master=REPO_WRITE --scope repo:images=REPO_READ" +
 			"\t- {{alias}} my-robot --enterprise",
 		Run: cmdutil.RunBoundedArgs(1, 1, func(args []string) error {
 			c, err := pachctlCfg.NewOnUserMachine(ctx, enterprise)
@@ -433,6 +492,9 @@ func GetRobotTokenCmd(ctx context.Context, pachctlCfg *pachctl.Config) *cobra.Co
 			req := &auth.GetRobotTokenRequest{
 				Robot: args[0],
 			}
+			if req.Scopes, err = parseTokenScopes(scopes); err != nil {
+				return err
+			}
 			if ttl != "" {
 				d, err := time.ParseDuration(ttl)
 				if err != nil {
@@ -458,26 +520,139 @@ func GetRobotTokenCmd(ctx context.Context, pachctlCfg *pachctl.Config) *cobra.Co
 	getAuthToken.PersistentFlags().StringVar(&ttl, "ttl", "", "if set, the "+
 		"resulting auth token will have the given lifetime. If not set, the token does not expire."+
 		" This flag should be a golang duration (e.g. \"30s\" or \"1h2m3s\").")
+	getAuthToken.PersistentFlags().StringArrayVar(&scopes, "scope", nil, "if set, the "+
+		"resulting auth token is restricted to this scope, of the form "+tokenScopeFormat+". May be repeated.")
 	getAuthToken.PersistentFlags().BoolVar(&enterprise, "enterprise", false, "Get a robot token for the enterprise context.")
 	return cmdutil.CreateAlias(getAuthToken, "auth get-robot-token")
 }
 
+// GetScopedTokenCmd returns a cobra command that gets a token for the caller
+// that is restricted to a set of scopes.
+func GetScopedTokenCmd(ctx context.Context, pachctlCfg *pachctl.Config) *cobra.Command {
+	var enterprise bool
+	var quiet bool
+	var ttl string
+	var scopes []string
+	getScopedToken := &cobra.Command{
+		Short: "Get an auth token for yourself that is restricted to a set of scopes.",
+		Long: "This command returns an auth token for you that only has the permissions granted by both your role bindings and its scopes. " +
+			"Each scope has the form " + tokenScopeFormat + ". " +
+			"A scope on a project also applies to the project's repos, and a scope on a repo branch only applies to operations on that branch, such as starting commits on it. " +
+			"The token can't outlive your current session, and can't be used to create, list or revoke other tokens.",
+		Example: "\t- {{alias}} --scope repo:images=REPO_READ" +
+			"\t- {{alias}} --scope repo:images@master=REPO_READ,REPO_WRITE --ttl 24h" +
+			"\t- {{alias}} --scope project:default=REPO_READ,REPO_LIST_COMMIT --quiet",
+		Run: cmdutil.RunFixedArgs(0, func([]string) error {
+			req := &auth.GetScopedTokenRequest{}
+			var err error
+			if req.Scopes, err = parseTokenScopes(scopes); err != nil {
+				return err
+			}
+			if len(req.Scopes) == 0 {
+				return errors.New("at least one --scope must be set")
+			}
+			if ttl != "" {
+				d, err := time.ParseDuration(ttl)
+				if err != nil {
+					return errors.Wrapf(err, "could not parse duration %q", ttl)
+				}
+				req.Ttl = int64(d.Seconds())
+			}
+			c, err := pachctlCfg.NewOnUserMachine(ctx, enterprise)
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			resp, err := c.GetScopedToken(c.Ctx(), req)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if quiet {
+				fmt.Println(resp.Token)
+			} else {
+				fmt.Printf("Token: %s\nID: %s\n", resp.Token, resp.Id)
+			}
+			return nil
+		}),
+	}
+	getScopedToken.PersistentFlags().StringArrayVar(&scopes, "scope", nil, "A scope that the token is restricted to, of the form "+tokenScopeFormat+". May be repeated.")
+	getScopedToken.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false, "if "+
+		"set, only print the resulting token (if successful).")
+	getScopedToken.PersistentFlags().StringVar(&ttl, "ttl", "", "if set, the "+
+		"resulting auth token will have the given lifetime, up to the remaining lifetime of your session."+
+		" This flag should be a golang duration (e.g. \"30s\" or \"1h2m3s\").")
+	getScopedToken.PersistentFlags().BoolVar(&enterprise, "enterprise", false, "Get a scoped token for the enterprise context.")
+	return cmdutil.CreateAlias(getScopedToken, "auth get-scoped-token")
+}
+
+// ListTokensCmd returns a cobra command that lists the auth tokens of a
+// principal, without the tokens themselves.
+func ListTokensCmd(ctx context.Context, pachctlCfg *pachctl.Config) *cobra.Command {
+	var enterprise bool
+	listTokens := &cobra.Command{
+		Use:   "{{alias}} [principal]",
+		Short: "List the auth tokens of a principal.",
+		Long: "This command lists your auth tokens, or those of another principal, by ID. " +
+			"The IDs can be passed to `pachctl auth revoke --id`. Listing the tokens of another principal requires the CLUSTER_AUTH_EXTRACT_TOKENS permission.",
+		Example: "\t- {{alias}}" +
+			"\t- {{alias}} robot:ci",
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			c, err := pachctlCfg.NewOnUserMachine(ctx, enterprise)
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			req := &auth.ListAuthTokensRequest{}
+			if len(args) > 0 {
+				req.Principal = args[0]
+			}
+			resp, err := c.ListAuthTokens(c.Ctx(), req)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			writer := tabwriter.NewWriter(os.Stdout, "ID\tSUBJECT\tCREATED\tEXPIRES\tSCOPES\n")
+			for _, t := range resp.Tokens {
+				expires := "never"
+				if t.Expiration != nil {
+					expires = pretty.Timestamp(t.Expiration)
+				}
+				scopes := "-"
+				if len(t.Scopes) > 0 {
+					scopes = formatTokenScopes(t.Scopes)
+				}
+				fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n", t.Id, t.Subject, pretty.Ago(t.CreatedAt), expires, scopes)
+			}
+			return errors.EnsureStack(writer.Flush())
+		}),
+	}
+	listTokens.PersistentFlags().BoolVar(&enterprise, "enterprise", false, "List auth tokens on the enterprise server.")
+	return cmdutil.CreateAlias(listTokens, "auth list-tokens")
+}
+
 // RevokeCmd returns a cobra.Command that revokes a Pachyderm token.
 func RevokeCmd(ctx context.Context, pachctlCfg *pachctl.Config) *cobra.Command {
 	var enterprise bool
 	var token string
+	var id string
 	var user string
 	revoke := &cobra.Command{
 		Short: "Revoke a Pachyderm auth token",
-		Long:  "This command revokes a Pachyderm auth token.",
+		Long:  "This command revokes a Pachyderm auth token, given the token, its ID (see `pachctl auth list-tokens`), or the user whose tokens should all be revoked.",
 		Example: "\t- {{alias}} --token <token>" +
+			"\t- {{alias}} --id <id>" +
 			"\t- {{alias}} --user <user>" +
 			"\t- {{alias}} --enterprise --user <user>",
 		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
-			if token == "" && user == "" {
-				return errors.Errorf("one of --token or --user must be set")
-			} else if token != "" && user != "" {
-				return errors.Errorf("only one of --token or --user may be set")
+			var set int
+			for _, f := range []string{token, id, user} {
+				if f != "" {
+					set++
+				}
+			}
+			if set == 0 {
+				return errors.Errorf("one of --token, --id or --user must be set")
+			} else if set > 1 {
+				return errors.Errorf("only one of --token, --id or --user may be set")
 			}
 
 			c, err := pachctlCfg.NewOnUserMachine(ctx, enterprise)
@@ -493,8 +668,8 @@ func RevokeCmd(ctx context.Context, pachctlCfg *pachctl.Config) *cobra.Command {
 			))
 			p := message.NewPrinter(language.English)
 
-			if token != "" {
-				resp, err := c.RevokeAuthToken(c.Ctx(), &auth.RevokeAuthTokenRequest{Token: token})
+			if token != "" || id != "" {
+				resp, err := c.RevokeAuthToken(c.Ctx(), &auth.RevokeAuthTokenRequest{Token: token, Id: id})
 				if err != nil {
 					return errors.Wrapf(grpcutil.ScrubGRPC(err), "error")
 				}
@@ -510,8 +685,9 @@ func RevokeCmd(ctx context.Context, pachctlCfg *pachctl.Config) *cobra.Command {
 		}),
 	}
 	revoke.PersistentFlags().BoolVar(&enterprise, "enterprise", false, "Revoke an auth token (or all auth tokens minted for one user) on the enterprise server.")
-	revoke.PersistentFlags().StringVar(&token, "token", "", "Pachyderm auth token that should be revoked (one of --token or --user must be set).")
-	revoke.PersistentFlags().StringVar(&user, "user", "", "User whose Pachyderm auth tokens should be revoked (one of --token or --user must be set).")
+	revoke.PersistentFlags().StringVar(&token, "token", "", "Pachyderm auth token that should be revoked (one of --token, --id or --user must be set).")
+	revoke.PersistentFlags().StringVar(&id, "id", "", "ID of the Pachyderm auth token that should be revoked (one of --token, --id or --user must be set).")
+	revoke.PersistentFlags().StringVar(&user, "user", "", "User whose Pachyderm auth tokens should be revoked (one of --token, --id or --user must be set).")
 	return cmdutil.CreateAlias(revoke, "auth revoke")
 }
 
@@ -615,13 +791,15 @@ func CheckRepoCmd(ctx context.Context, pachCtx *config.Context, pachctlCfg *pach
 // SetRepoRoleBindingCmd returns a cobra command that sets the roles for a user on a repo
 func SetRepoRoleBindingCmd(ctx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.Config) *cobra.Command {
 	project := pachCtx.Project
+	var expiresIn, justification string
 	setScope := &cobra.Command{
 		Use:   "{{alias}} <repo> [role1,role2 | none ] <subject>",
 		Short: "Set the roles that a subject has on repo",
 		Long:  "This command sets the roles (`repoReader`, `repoWriter`, `repoOwner`) that a subject (user, robot) has on a given repo.",
 		Example: "\t- {{alias}} foo repoOwner user:alan.watts@domain.com" +
 			"\t- {{alias}} foo repoWriter, repoReader robot:my-robot" +
-			"\t- {{alias}} foo none robot:my-robot --project foobar",
+			"\t- {{alias}} foo none robot:my-robot --project foobar" +
+			"\t- {{alias}} foo repoReader user:contractor@domain.com --expires-in 72h --justification \"data audit\"",
 		Run: cmdutil.RunFixedArgs(3, func(args []string) error {
 			var roles []string
 			if args[1] == "none" {
@@ -629,6 +807,10 @@ func SetRepoRoleBindingCmd(ctx context.Context, pachCtx *config.Context, pachctl
 			} else {
 				roles = strings.Split(args[1], ",")
 			}
+			expiration, err := roleBindingExpiration(expiresIn)
+			if err != nil {
+				return err
+			}
 
 			subject, repo := args[2], args[0]
 			c, err := pachctlCfg.NewOnUserMachine(ctx, false)
@@ -636,11 +818,18 @@ func SetRepoRoleBindingCmd(ctx context.Context, pachCtx *config.Context, pachctl
 				return errors.Wrapf(err, "could not connect")
 			}
 			defer c.Close()
-			err = c.ModifyRepoRoleBinding(project, repo, subject, roles)
+			_, err = c.ModifyRoleBinding(c.Ctx(), &auth.ModifyRoleBindingRequest{
+				Resource:      client.NewRepo(project, repo).AuthResource(),
+				Principal:     subject,
+				Roles:         roles,
+				Expiration:    expiration,
+				Justification: justification,
+			})
 			return grpcutil.ScrubGRPC(err)
 		}),
 	}
 	setScope.Flags().StringVar(&project, "project", project, "The project containing the repo.")
+	addRoleBindingExpirationFlags(setScope, &expiresIn, &justification)
 	return cmdutil.CreateAliases(setScope, "auth set repo", "repos")
 }
 
@@ -711,13 +900,19 @@ func CheckProjectCmd(ctx context.Context, pachctlCfg *pachctl.Config) *cobra.Com
 
 // SetProjectRoleBindingCmd returns a cobra command that sets the roles for a user on a project
 func SetProjectRoleBindingCmd(ctx context.Context, pachctlCfg *pachctl.Config) *cobra.Command {
+	var expiresIn, justification string
 	cmd := &cobra.Command{
 		Use:   "{{alias}} <project> [role1,role2 | none ] <subject>",
 		Short: "Set the roles that a subject has on a project ",
 		Long:  "This command sets the roles that a given subject has on a given project (`projectViewer`, `projectWriter`, `projectOwner`, `projectCreator`).",
 		Example: "\t- {{alias}} foo projectOwner user:alan.watts@domain.com" +
-			"\t- {{alias}} foo projectWriter, projectReader robot:my-robot",
+			"\t- {{alias}} foo projectWriter, projectReader robot:my-robot" +
+			"\t- {{alias}} foo projectOwner user:oncall@domain.com --expires-in 8h --justification \"incident response\"",
 		Run: cmdutil.RunFixedArgs(3, func(args []string) error {
+			expiration, err := roleBindingExpiration(expiresIn)
+			if err != nil {
+				return err
+			}
 			c, err := pachctlCfg.NewOnUserMachine(ctx, false)
 			if err != nil {
 				return errors.Wrapf(err, "could not connect")
@@ -736,13 +931,16 @@ func SetProjectRoleBindingCmd(ctx context.Context, pachctlCfg *pachctl.Config) *
 			}
 
 			_, err = c.ModifyRoleBinding(c.Ctx(), &auth.ModifyRoleBindingRequest{
-				Resource:  project,
-				Principal: user,
-				Roles:     roles,
+				Resource:      project,
+				Principal:     user,
+				Roles:         roles,
+				Expiration:    expiration,
+				Justification: justification,
 			})
 			return grpcutil.ScrubGRPC(err)
 		}),
 	}
+	addRoleBindingExpirationFlags(cmd, &expiresIn, &justification)
 	return cmdutil.CreateAliases(cmd, "auth set project")
 }
 
@@ -772,12 +970,14 @@ func GetProjectRoleBindingCmd(ctx context.Context, pachctlCfg *pachctl.Config) *
 
 // SetClusterRoleBindingCmd returns a cobra command that sets the roles for a user on a resource
 func SetClusterRoleBindingCmd(ctx context.Context, pachctlCfg *pachctl.Config) *cobra.Command {
+	var expiresIn, justification string
 	setScope := &cobra.Command{
 		Use:   "{{alias}} [role1,role2 | none ] subject",
 		Short: "Set the roles that a subject has on the cluster",
 		Long:  "This command sets the roles that a given subject has on the cluster.",
 		Example: "\t- {{alias}} clusterOwner user:alan.watts@domain.com" +
-			"\t- {{alias}} clusterWriter, clusterReader robot:my-robot",
+			"\t- {{alias}} clusterWriter, clusterReader robot:my-robot" +
+			"\t- {{alias}} clusterAdmin user:oncall@domain.com --expires-in 4h --justification \"incident response\"",
 		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
 			var roles []string
 			if args[0] == "none" {
@@ -785,6 +985,10 @@ func SetClusterRoleBindingCmd(ctx context.Context, pachctlCfg *pachctl.Config) *
 			} else {
 				roles = strings.Split(args[0], ",")
 			}
+			expiration, err := roleBindingExpiration(expiresIn)
+			if err != nil {
+				return err
+			}
 
 			subject := args[1]
 			c, err := pachctlCfg.NewOnUserMachine(ctx, false)
@@ -792,10 +996,17 @@ func SetClusterRoleBindingCmd(ctx context.Context, pachctlCfg *pachctl.Config) *
 				return errors.Wrapf(err, "could not connect")
 			}
 			defer c.Close()
-			err = c.ModifyClusterRoleBinding(subject, roles)
+			_, err = c.ModifyRoleBinding(c.Ctx(), &auth.ModifyRoleBindingRequest{
+				Resource:      &auth.Resource{Type: auth.ResourceType_CLUSTER},
+				Principal:     subject,
+				Roles:         roles,
+				Expiration:    expiration,
+				Justification: justification,
+			})
 			return grpcutil.ScrubGRPC(err)
 		}),
 	}
+	addRoleBindingExpirationFlags(setScope, &expiresIn, &justification)
 	return cmdutil.CreateAlias(setScope, "auth set cluster")
 }
 
@@ -982,6 +1193,8 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 	commands = append(commands, LogoutCmd())
 	commands = append(commands, WhoamiCmd(mainCtx, pachctlCfg))
 	commands = append(commands, GetRobotTokenCmd(mainCtx, pachctlCfg))
+	commands = append(commands, GetScopedTokenCmd(mainCtx, pachctlCfg))
+	commands = append(commands, ListTokensCmd(mainCtx, pachctlCfg))
 	commands = append(commands, UseAuthTokenCmd())
 	commands = append(commands, GetConfigCmd(mainCtx, pachctlCfg))
 	commands = append(commands, SetConfigCmd(mainCtx, pachctlCfg))
@@ -1001,3 +1214,73 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 	commands = append(commands, RolesForPermissionCmd(mainCtx, pachctlCfg))
 	return commands
 }
+
+const tokenScopeFormat = "cluster=<permissions>, project:<project>=<permissions> or repo:[<project>/]<repo>[@<branch>]=<permissions>"
+
+// parseTokenScopes parses token scopes of the form described by
+// tokenScopeFormat, where permissions are comma-separated permission names such
+// as REPO_READ.  Repos without a project are in the default project.
+func parseTokenScopes(specs []string) ([]*auth.TokenScope, error) {
+	var scopes []*auth.TokenScope
+	for _, spec := range specs {
+		resource, perms, ok := strings.Cut(spec, "=")
+		if !ok || perms == "" {
+			return nil, errors.Errorf("invalid token scope %q, must be of the form %s", spec, tokenScopeFormat)
+		}
+		scope := &auth.TokenScope{}
+		for _, p := range strings.Split(perms, ",") {
+			v, ok := auth.Permission_value[strings.ToUpper(strings.TrimSpace(p))]
+			if !ok {
+				return nil, errors.Errorf("invalid token scope %q, unknown permission %q", spec, p)
+			}
+			scope.Permissions = append(scope.Permissions, auth.Permission(v))
+		}
+		kind, name, _ := strings.Cut(resource, ":")
+		switch kind {
+		case "cluster":
+			scope.Resource = &auth.Resource{Type: auth.ResourceType_CLUSTER}
+		case "project":
+			scope.Resource = &auth.Resource{Type: auth.ResourceType_PROJECT, Name: name}
+		case "repo":
+			repo, branch, ok := strings.Cut(name, "@")
+			if ok {
+				scope.Branches = []string{branch}
+			}
+			if !strings.Contains(repo, "/") {
+				repo = pfs.DefaultProjectName + "/" + repo
+			}
+			scope.Resource = &auth.Resource{Type: auth.ResourceType_REPO, Name: repo}
+		default:
+			return nil, errors.Errorf("invalid token scope %q, must be of the form %s", spec, tokenScopeFormat)
+		}
+		scopes = append(scopes, scope)
+	}
+	return scopes, nil
+}
+
+// formatTokenScopes formats scopes in the form parsed by parseTokenScopes.
+func formatTokenScopes(scopes []*auth.TokenScope) string {
+	var specs []string
+	for _, s := range scopes {
+		var resource string
+		switch s.Resource.GetType() {
+		case auth.ResourceType_CLUSTER:
+			resource = "cluster"
+		case auth.ResourceType_PROJECT:
+			resource = "project:" + s.Resource.Name
+		default:
+			resource = "repo:" + s.Resource.GetName()
+		}
+		var perms []string
+		for _, p := range s.Permissions {
+			perms = append(perms, p.String())
+		}
+		if len(s.Branches) == 0 {
+			specs = append(specs, resource+"="+strings.Join(perms, ","))
+		}
+		for _, b := range s.Branches {
+			specs = append(specs, resource+"@"+b+"="+strings.Join(perms, ","))
+		}
+	}
+	return strings.Join(specs, " ")
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"database/sql"
+	"encoding/hex"
 	"fmt"
 	"net/http"
 	"os"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/ghodss/yaml"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.uber.org/zap"
 
@@ -76,6 +78,8 @@ type apiServer struct {
 	authConfig col.PostgresCollection
 	// oidcStates  contains the set of OIDC nonces for requests that are in progress
 	oidcStates col.EtcdCollection
+	// workloadKeySets caches the signing keys of workload identity providers
+	workloadKeySets workloadKeySets
 
 	// public addresses the fact that pachd in full mode initializes two auth
 	// servers: one that exposes a public API, possibly over TLS, and one that
@@ -465,7 +469,7 @@ func (a *apiServer) hasClusterRole(ctx context.Context, username string, role st
 	if err != nil {
 		return false, err
 	}
-	if roles, ok := bindings.Entries[username]; ok {
+	if roles, ok := bindings.Entries[username]; ok && !rolesExpired(roles, time.Now()) {
 		for r := range roles.Roles {
 			if r == role {
 				return true, nil
@@ -680,6 +684,33 @@ func (a *apiServer) Authenticate(ctx context.Context, req *auth.AuthenticateRequ
 		}
 		pachToken = t
 
+	case req.WorkloadToken != "":
+		config, ok := a.configCache.Load().(*auth.OIDCConfig)
+		if !ok {
+			return nil, errors.New("unable to load cached OIDC configuration")
+		}
+		principal, expiry, err := a.authenticateWorkloadToken(ctx, config.WorkloadIdentityProviders, req.WorkloadToken)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := a.expiredEnterpriseCheck(ctx, principal); err != nil {
+			return nil, err
+		}
+
+		// As with ID tokens, the pach token expires with the workload token,
+		// and at most after the default TTL.
+		expirationSecs := int64(time.Until(expiry).Seconds())
+		if expirationSecs > int64(60*a.env.Config.SessionDurationMinutes) {
+			expirationSecs = int64(60 * a.env.Config.SessionDurationMinutes)
+		}
+
+		t, err := a.generateAndInsertAuthToken(ctx, principal, expirationSecs)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error storing auth token for %q", principal)
+		}
+		pachToken = t
+
 	default:
 		return nil, errors.Errorf("unrecognized authentication mechanism (old pachd?)")
 	}
@@ -778,20 +809,27 @@ func (a *apiServer) AuthorizeInTransaction(
 	txnCtx *txncontext.TransactionContext,
 	req *auth.AuthorizeRequest,
 ) (resp *auth.AuthorizeResponse, retErr error) {
+	return a.authorizeInTransaction(txnCtx, req.Resource, "", req.Permissions)
+}
+
+// authorizeInTransaction is AuthorizeInTransaction for a request that may be
+// about a branch of a repo resource, which scoped tokens can be restricted to.
+func (a *apiServer) authorizeInTransaction(txnCtx *txncontext.TransactionContext, resource *auth.Resource, branch string, p []auth.Permission) (*auth.AuthorizeResponse, error) {
 	me, err := txnCtx.WhoAmI()
 	if err != nil {
 		return nil, err
 	}
 
 	permissions := make(map[auth.Permission]bool)
-	for _, p := range req.Permissions {
+	for _, p := range p {
 		permissions[p] = true
 	}
 
-	request, err := a.evaluateRoleBindingInTransaction(txnCtx, me.Username, req.Resource, permissions)
+	request, err := a.evaluateRoleBindingInTransaction(txnCtx, me.Username, resource, permissions)
 	if err != nil {
 		return nil, err
 	}
+	request.restrictToScopes(me.Scopes, resource, branch)
 
 	return &auth.AuthorizeResponse{
 		Principal:  me.Username,
@@ -847,6 +885,7 @@ func (a *apiServer) GetPermissions(ctx context.Context, req *auth.GetPermissions
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get permissions for principal")
 	}
+	restrictPermissionsToScopes(resp, callerInfo.Scopes, req.Resource)
 	return resp, nil
 }
 
@@ -857,7 +896,27 @@ func (a *apiServer) GetPermissionsInTransaction(txnCtx *txncontext.TransactionCo
 		return nil, err
 	}
 
-	return a.getPermissionsForPrincipalInTransaction(txnCtx, &auth.GetPermissionsForPrincipalRequest{Principal: callerInfo.Username, Resource: req.Resource})
+	resp, err := a.getPermissionsForPrincipalInTransaction(txnCtx, &auth.GetPermissionsForPrincipalRequest{Principal: callerInfo.Username, Resource: req.Resource})
+	if err != nil {
+		return nil, err
+	}
+	restrictPermissionsToScopes(resp, callerInfo.Scopes, req.Resource)
+	return resp, nil
+}
+
+// restrictPermissionsToScopes removes the permissions in resp that scopes
+// don't grant on resource, for callers with a scoped token.
+func restrictPermissionsToScopes(resp *auth.GetPermissionsResponse, scopes []*auth.TokenScope, resource *auth.Resource) {
+	if len(scopes) == 0 {
+		return
+	}
+	permissions := make([]auth.Permission, 0, len(resp.Permissions))
+	for _, p := range resp.Permissions {
+		if scopesGrant(scopes, resource, "", p) {
+			permissions = append(permissions, p)
+		}
+	}
+	resp.Permissions = permissions
 }
 
 func (a *apiServer) getPermissionsForPrincipalInTransaction(txnCtx *txncontext.TransactionContext, req *auth.GetPermissionsForPrincipalRequest) (*auth.GetPermissionsResponse, error) {
@@ -892,6 +951,7 @@ func (a *apiServer) WhoAmI(ctx context.Context, req *auth.WhoAmIRequest) (resp *
 	return &auth.WhoAmIResponse{
 		Username:   callerInfo.Subject,
 		Expiration: callerInfo.Expiration,
+		Scopes:     callerInfo.Scopes,
 	}, nil
 }
 
@@ -1072,23 +1132,61 @@ func (a *apiServer) ModifyRoleBindingInTransaction(
 	default:
 		return nil, errors.Errorf("unknown resource type %v", req.Resource.Type)
 	}
-	if err := a.checkResourceIsAuthorizedInTransaction(txnCtx, req.Resource, permission); err != nil {
+	if err := a.checkResourceIsAuthorizedInTransaction(txnCtx, req.Resource, "", permission); err != nil {
 		return nil, err
 	}
 
-	if err := a.setUserRoleBindingInTransaction(txnCtx, req.Resource, req.Principal, req.Roles); err != nil {
+	roles, err := rolesFromModifyRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.setRoleBindingEntryInTransaction(txnCtx, req.Resource, req.Principal, roles); err != nil {
 		return nil, err
 	}
 
 	return &auth.ModifyRoleBindingResponse{}, nil
 }
 
+// rolesFromModifyRequest returns the role binding entry that req sets, or nil
+// if req removes the principal's roles.
+func rolesFromModifyRequest(req *auth.ModifyRoleBindingRequest) (*auth.Roles, error) {
+	roles, err := rolesFromRoleSlice(req.Roles)
+	if err != nil {
+		return nil, err
+	}
+	if roles == nil {
+		if req.Expiration != nil || req.Justification != "" {
+			return nil, errors.New("an expiration or justification can only be set when granting roles")
+		}
+		return nil, nil
+	}
+	if req.Expiration != nil {
+		if err := req.Expiration.CheckValid(); err != nil {
+			return nil, errors.Wrap(err, "invalid role binding expiration")
+		}
+		if !req.Expiration.AsTime().After(time.Now()) {
+			return nil, errors.Errorf("role binding expiration %v is in the past", req.Expiration.AsTime())
+		}
+		if strings.HasPrefix(req.Principal, auth.PachPrefix) || strings.HasPrefix(req.Principal, auth.InternalPrefix) {
+			return nil, errors.Errorf("role bindings for %q cannot expire", req.Principal)
+		}
+	}
+	roles.Expiration = req.Expiration
+	roles.Justification = req.Justification
+	return roles, nil
+}
+
 func (a *apiServer) setUserRoleBindingInTransaction(txnCtx *txncontext.TransactionContext, resource *auth.Resource, principal string, roleSlice []string) error {
 	roles, err := rolesFromRoleSlice(roleSlice)
 	if err != nil {
 		return err
 	}
+	return a.setRoleBindingEntryInTransaction(txnCtx, resource, principal, roles)
+}
 
+// setRoleBindingEntryInTransaction sets the roles of principal on resource,
+// removing the principal from the role binding if roles is nil.
+func (a *apiServer) setRoleBindingEntryInTransaction(txnCtx *txncontext.TransactionContext, resource *auth.Resource, principal string, roles *auth.Roles) error {
 	key := authdb.ResourceKey(resource)
 	roleBindings := a.roleBindings.ReadWrite(txnCtx.SqlTx)
 	var bindings auth.RoleBinding
@@ -1105,7 +1203,7 @@ func (a *apiServer) setUserRoleBindingInTransaction(txnCtx *txncontext.Transacti
 		bindings.Entries = make(map[string]*auth.Roles)
 	}
 
-	if len(roleSlice) == 0 {
+	if roles == nil {
 		delete(bindings.Entries, principal)
 	} else {
 		bindings.Entries[principal] = roles
@@ -1130,6 +1228,10 @@ func (a *apiServer) ModifyRoleBinding(ctx context.Context, req *auth.ModifyRoleB
 		if err != nil {
 			return nil, err
 		}
+		if expected != nil {
+			expected.Expiration = req.Expiration
+			expected.Justification = req.Justification
+		}
 		if err := backoff.Retry(func() error {
 			bindings, ok := a.clusterRoleBindingCache.Load().(*auth.RoleBinding)
 			if !ok {
@@ -1196,7 +1298,9 @@ func (a *apiServer) GetRobotToken(ctx context.Context, req *auth.GetRobotTokenRe
 	// generate new token, and write to postgres
 	var token string
 	var err error
-	if req.Ttl > 0 {
+	if len(req.Scopes) > 0 {
+		token, err = a.generateAndInsertScopedAuthToken(ctx, subject, req.Ttl, req.Scopes)
+	} else if req.Ttl > 0 {
 		token, err = a.generateAndInsertAuthToken(ctx, subject, req.Ttl)
 	} else {
 		token, err = a.generateAndInsertAuthTokenNoTTL(ctx, subject)
@@ -1209,6 +1313,67 @@ func (a *apiServer) GetRobotToken(ctx context.Context, req *auth.GetRobotTokenRe
 	}, nil
 }
 
+// GetScopedToken implements the protobuf auth.GetScopedToken RPC
+func (a *apiServer) GetScopedToken(ctx context.Context, req *auth.GetScopedTokenRequest) (resp *auth.GetScopedTokenResponse, retErr error) {
+	callerInfo, err := a.getAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(callerInfo.Scopes) > 0 {
+		return nil, errScopedTokenManagement
+	}
+	if len(req.Scopes) == 0 {
+		return nil, errors.New("a scoped token must have at least one scope")
+	}
+	// A scoped token can't outlive the session it was created with.
+	ttl := req.Ttl
+	if callerInfo.Expiration != nil {
+		remaining := int64(time.Until(callerInfo.Expiration.AsTime()).Seconds())
+		if remaining < 1 {
+			return nil, auth.ErrExpiredToken
+		}
+		if ttl <= 0 || ttl > remaining {
+			ttl = remaining
+		}
+	}
+	token, err := a.generateAndInsertScopedAuthToken(ctx, callerInfo.Subject, ttl, req.Scopes)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.GetScopedTokenResponse{
+		Token: token,
+		Id:    auth.TokenID(auth.HashToken(token)),
+	}, nil
+}
+
+// ListAuthTokens implements the protobuf auth.ListAuthTokens RPC
+func (a *apiServer) ListAuthTokens(ctx context.Context, req *auth.ListAuthTokensRequest) (resp *auth.ListAuthTokensResponse, retErr error) {
+	callerInfo, err := a.getAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(callerInfo.Scopes) > 0 {
+		return nil, errScopedTokenManagement
+	}
+	principal := req.Principal
+	if principal == "" {
+		principal = callerInfo.Subject
+	}
+	if principal != callerInfo.Subject {
+		if err := a.CheckClusterIsAuthorized(ctx, auth.Permission_CLUSTER_AUTH_EXTRACT_TOKENS); err != nil {
+			return nil, err
+		}
+	}
+	tokens, err := a.listAuthTokensForSubject(ctx, principal)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tokens {
+		t.HashedToken = ""
+	}
+	return &auth.ListAuthTokensResponse{Tokens: tokens}, nil
+}
+
 // GetPipelineAuthTokenInTransaction is an internal API used to create a pipeline token for a given pipeline.
 // Not an RPC.
 func (a *apiServer) GetPipelineAuthTokenInTransaction(txnCtx *txncontext.TransactionContext, pipeline *pps.Pipeline) (string, error) {
@@ -1252,6 +1417,16 @@ func (a *apiServer) RevokeAuthTokenInTransaction(txnCtx *txncontext.TransactionC
 		return nil, err
 	}
 
+	if req.Id != "" {
+		if req.Token != "" {
+			return nil, errors.New("only one of token or id may be set")
+		}
+		n, err := a.revokeAuthTokenByIDInTransaction(txnCtx, req.Id)
+		if err != nil {
+			return nil, err
+		}
+		return &auth.RevokeAuthTokenResponse{Number: n}, nil
+	}
 	n, err := a.deleteAuthToken(txnCtx.SqlTx, auth.HashToken(req.Token))
 	if err != nil {
 		return nil, err
@@ -1259,6 +1434,49 @@ func (a *apiServer) RevokeAuthTokenInTransaction(txnCtx *txncontext.TransactionC
 	return &auth.RevokeAuthTokenResponse{Number: n}, nil
 }
 
+// revokeAuthTokenByIDInTransaction deletes the token with the given ID.
+// Callers may revoke their own tokens, while revoking the tokens of other
+// principals requires CLUSTER_AUTH_REVOKE_USER_TOKENS.
+func (a *apiServer) revokeAuthTokenByIDInTransaction(txnCtx *txncontext.TransactionContext, id string) (int64, error) {
+	if _, err := hex.DecodeString(id); err != nil || len(id) != auth.TokenIDLength {
+		return 0, errors.Errorf("invalid token id %q", id)
+	}
+	me, err := txnCtx.WhoAmI()
+	if err != nil {
+		return 0, err
+	}
+	if len(me.Scopes) > 0 {
+		return 0, errScopedTokenManagement
+	}
+	var subjects []string
+	if err := txnCtx.SqlTx.Select(&subjects, `SELECT subject FROM auth.auth_tokens WHERE token_hash LIKE $1 || '%'`, id); err != nil {
+		return 0, errors.Wrap(err, "error querying token")
+	}
+	switch {
+	case len(subjects) == 0:
+		return 0, nil
+	case len(subjects) > 1:
+		return 0, errors.Errorf("token id %q matches more than one token", id)
+	}
+	if subjects[0] != me.Username {
+		if strings.HasPrefix(subjects[0], auth.PachPrefix) {
+			return 0, errors.New("cannot revoke tokens for pach: users")
+		}
+		if err := a.CheckClusterIsAuthorizedInTransaction(txnCtx, auth.Permission_CLUSTER_AUTH_REVOKE_USER_TOKENS); err != nil {
+			return 0, err
+		}
+	}
+	result, err := txnCtx.SqlTx.Exec(`DELETE FROM auth.auth_tokens WHERE token_hash LIKE $1 || '%'`, id)
+	if err != nil {
+		return 0, errors.Wrap(err, "error deleting token")
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "error getting rows affected")
+	}
+	return n, nil
+}
+
 // setGroupsForUserInternal is a helper function used by SetGroupsForUser, and
 // also by handleOIDCExchangeInternal (which updates group membership information
 // based on signed JWT claims). This does no auth checks, so the caller must do all
@@ -1597,6 +1815,9 @@ func (a *apiServer) SetConfiguration(ctx context.Context, req *auth.SetConfigura
 		if err := validateOIDCConfig(ctx, req.Configuration); err != nil {
 			return nil, err
 		}
+		if err := validateWorkloadIdentityProviders(req.Configuration.WorkloadIdentityProviders); err != nil {
+			return nil, err
+		}
 		configToStore = req.Configuration
 	} else {
 		configToStore = proto.Clone(&DefaultOIDCConfig).(*auth.OIDCConfig)
@@ -1649,6 +1870,9 @@ func (a *apiServer) RestoreAuthToken(ctx context.Context, req *auth.RestoreAuthT
 	}
 
 	if err := func() error {
+		if len(req.Token.Scopes) > 0 {
+			return a.insertScopedAuthToken(ctx, req.Token.HashedToken, req.Token.Subject, ttl, req.Token.Scopes)
+		}
 		if ttl > 0 {
 			return a.insertAuthToken(ctx, req.Token.HashedToken, req.Token.Subject, ttl)
 		} else {
@@ -1673,6 +1897,48 @@ func (a *apiServer) DeleteExpiredAuthTokens(ctx context.Context, req *auth.Delet
 	return &auth.DeleteExpiredAuthTokensResponse{}, nil
 }
 
+// deleteExpiredRoleBindings removes role binding entries whose expiration has
+// passed. Expired entries already grant no permissions; this keeps forgotten
+// temporary grants from piling up in role bindings.
+func (a *apiServer) deleteExpiredRoleBindings(ctx context.Context) error {
+	var n int
+	if err := dbutil.WithTx(ctx, a.env.DB, func(ctx context.Context, sqlTx *pachsql.Tx) error {
+		n = 0
+		now := time.Now()
+		roleBindings := a.roleBindings.ReadWrite(sqlTx)
+		updated := make(map[string]*auth.RoleBinding)
+		var binding auth.RoleBinding
+		if err := roleBindings.List(&binding, col.DefaultOptions(), func(key string) error {
+			var expired bool
+			for principal, roles := range binding.Entries {
+				if rolesExpired(roles, now) {
+					delete(binding.Entries, principal)
+					expired = true
+					n++
+				}
+			}
+			if expired {
+				updated[key] = proto.Clone(&binding).(*auth.RoleBinding)
+			}
+			return nil
+		}); err != nil {
+			return errors.EnsureStack(err)
+		}
+		for key, binding := range updated {
+			if err := roleBindings.Put(key, binding); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "error deleting expired role bindings")
+	}
+	if n > 0 {
+		log.Info(ctx, "deleted expired role bindings", zap.Int("n", n))
+	}
+	return nil
+}
+
 func (a *apiServer) RevokeAuthTokensForUser(ctx context.Context, req *auth.RevokeAuthTokensForUserRequest) (resp *auth.RevokeAuthTokensForUserResponse, retErr error) {
 	// Allow revoking auth tokens for pipelines, robots and IDP users,
 	// but not the root token or PPS user
@@ -1700,6 +1966,9 @@ func (a *apiServer) deleteExpiredTokensRoutine(ctx context.Context) error {
 			if _, err := a.DeleteExpiredAuthTokens(ctx, &auth.DeleteExpiredAuthTokensRequest{}); err != nil {
 				log.Error(ctx, "could not delete expired tokens", zap.Error(err))
 			}
+			if err := a.deleteExpiredRoleBindings(ctx); err != nil {
+				log.Error(ctx, "could not delete expired role bindings", zap.Error(err))
+			}
 			select {
 			case <-ctx.Done():
 				return
@@ -1715,38 +1984,67 @@ type dbTokenInfo struct {
 	Subject     string     `db:"subject"`
 	Expiration  *time.Time `db:"expiration"`
 	HashedToken string     `db:"token_hash"`
+	CreatedAt   time.Time  `db:"created_at"`
+	Scopes      []byte     `db:"scopes"`
 }
 
-func (ti dbTokenInfo) ToProto() *auth.TokenInfo {
+func (ti dbTokenInfo) ToProto() (*auth.TokenInfo, error) {
+	scopes, err := unmarshalTokenScopes(ti.Scopes)
+	if err != nil {
+		return nil, err
+	}
 	return &auth.TokenInfo{
 		Subject:     ti.Subject,
 		Expiration:  protoutil.MustTimestampFromPointer(ti.Expiration),
 		HashedToken: ti.HashedToken,
-	}
+		Scopes:      scopes,
+		CreatedAt:   timestamppb.New(ti.CreatedAt),
+		Id:          auth.TokenID(ti.HashedToken),
+	}, nil
 }
 
 // we interpret an expiration value of NULL as "lives forever".
 func (a *apiServer) lookupAuthTokenInfo(ctx context.Context, tokenHash string) (*auth.TokenInfo, error) {
 	var tokenInfo dbTokenInfo
-	err := a.env.DB.GetContext(ctx, &tokenInfo, `SELECT subject, expiration FROM auth.auth_tokens WHERE token_hash = $1`, tokenHash)
+	err := a.env.DB.GetContext(ctx, &tokenInfo, `SELECT token_hash, subject, expiration, created_at, scopes FROM auth.auth_tokens WHERE token_hash = $1`, tokenHash)
 	if err != nil {
 		return nil, col.ErrNotFound{Type: "auth_tokens", Key: tokenHash}
 	}
-	return tokenInfo.ToProto(), nil
+	return tokenInfo.ToProto()
 }
 
 // we will sometimes have expiration values set in the past, since we only remove those values in the deleteExpiredTokensRoutine() goroutine
 func (a *apiServer) listRobotTokens(ctx context.Context) ([]*auth.TokenInfo, error) {
 	var robotTokens []dbTokenInfo
 	if err := a.env.DB.SelectContext(ctx, &robotTokens,
-		`SELECT token_hash, subject, expiration
+		`SELECT token_hash, subject, expiration, created_at, scopes
 		FROM auth.auth_tokens
 		WHERE subject LIKE $1 || '%'`, auth.RobotPrefix); err != nil {
 		return nil, errors.Wrapf(err, "error querying token")
 	}
-	result := make([]*auth.TokenInfo, len(robotTokens))
-	for i, t := range robotTokens {
-		result[i] = t.ToProto()
+	return dbTokenInfosToProto(robotTokens)
+}
+
+// listAuthTokensForSubject returns the tokens of subject, oldest first.
+func (a *apiServer) listAuthTokensForSubject(ctx context.Context, subject string) ([]*auth.TokenInfo, error) {
+	var tokens []dbTokenInfo
+	if err := a.env.DB.SelectContext(ctx, &tokens,
+		`SELECT token_hash, subject, expiration, created_at, scopes
+		FROM auth.auth_tokens
+		WHERE subject = $1
+		ORDER BY created_at`, subject); err != nil {
+		return nil, errors.Wrapf(err, "error querying tokens")
+	}
+	return dbTokenInfosToProto(tokens)
+}
+
+func dbTokenInfosToProto(tokens []dbTokenInfo) ([]*auth.TokenInfo, error) {
+	result := make([]*auth.TokenInfo, len(tokens))
+	for i, t := range tokens {
+		var err error
+		if result[i], err = t.ToProto(); err != nil {
+			return nil, err
+		}
 	}
 	return result, nil
 }
@@ -1768,6 +2066,36 @@ func (a *apiServer) generateAndInsertAuthTokenNoTTL(ctx context.Context, subject
 	return token, nil
 }
 
+// generateAndInsertScopedAuthToken generates a token for subject that's
+// restricted to scopes.  The token doesn't expire if ttlSeconds isn't positive.
+func (a *apiServer) generateAndInsertScopedAuthToken(ctx context.Context, subject string, ttlSeconds int64, scopes []*auth.TokenScope) (string, error) {
+	token := uuid.NewWithoutDashes()
+	if err := a.insertScopedAuthToken(ctx, auth.HashToken(token), subject, ttlSeconds, scopes); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+func (a *apiServer) insertScopedAuthToken(ctx context.Context, tokenHash string, subject string, ttlSeconds int64, scopes []*auth.TokenScope) error {
+	if err := validateTokenScopes(scopes); err != nil {
+		return err
+	}
+	data, err := marshalTokenScopes(scopes)
+	if err != nil {
+		return err
+	}
+	if _, err := a.env.DB.ExecContext(ctx,
+		`INSERT INTO auth.auth_tokens (token_hash, subject, expiration, scopes)
+		VALUES ($1, $2, CASE WHEN $3::bigint > 0 THEN NOW() + $3::bigint * interval '1 sec' END, $4::jsonb)`,
+		tokenHash, subject, ttlSeconds, string(data)); err != nil {
+		if dbutil.IsUniqueViolation(err) {
+			return errors.New("cannot overwrite existing token with same hash")
+		}
+		return errors.Wrapf(err, "error storing token")
+	}
+	return nil
+}
+
 // generates a token, and stores it's hash and supporting data in postgres
 func (a *apiServer) insertAuthToken(ctx context.Context, tokenHash string, subject string, ttlSeconds int64) error {
 	if _, err := a.env.DB.ExecContext(ctx,
@@ -1,7 +1,10 @@
 package server
 
 import (
+	"slices"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
 
@@ -115,7 +118,7 @@ func (r *authorizeRequest) evaluateRoleBindingForSubject(subject string, binding
 		return nil
 	}
 
-	if entry, ok := binding.Entries[subject]; ok {
+	if entry, ok := binding.Entries[subject]; ok && !rolesExpired(entry, time.Now()) {
 		for role := range entry.Roles {
 			// Don't look up permissions for a role we already saw in another binding
 			if _, ok := r.roleMap[role]; ok {
@@ -139,3 +142,67 @@ func (r *authorizeRequest) evaluateRoleBindingForSubject(subject string, binding
 	}
 	return nil
 }
+
+// rolesExpired returns true if roles have an expiration that is not after now.
+// Expired roles grant no permissions, even before they're garbage collected.
+func rolesExpired(roles *auth.Roles, now time.Time) bool {
+	return roles.Expiration != nil && !now.Before(roles.Expiration.AsTime())
+}
+
+// restrictToScopes intersects the permissions satisfied by role bindings with
+// the permissions that scopes grant on resource, for requests made with a
+// scoped token.  branch is the branch of resource that the request is about,
+// if any.  Satisfied permissions that no scope grants become missing again.
+func (r *authorizeRequest) restrictToScopes(scopes []*auth.TokenScope, resource *auth.Resource, branch string) {
+	if len(scopes) == 0 {
+		return
+	}
+	satisfied := make([]auth.Permission, 0, len(r.satisfiedPermissions))
+	for _, p := range r.satisfiedPermissions {
+		if scopesGrant(scopes, resource, branch, p) {
+			satisfied = append(satisfied, p)
+		} else {
+			r.permissions[p] = true
+		}
+	}
+	r.satisfiedPermissions = satisfied
+}
+
+// scopesGrant returns true if any of scopes grants permission on resource.
+func scopesGrant(scopes []*auth.TokenScope, resource *auth.Resource, branch string, permission auth.Permission) bool {
+	for _, s := range scopes {
+		if !scopeHasPermission(s, permission) || !scopeCoversResource(s.Resource, resource) {
+			continue
+		}
+		if len(s.Branches) > 0 && !slices.Contains(s.Branches, branch) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+func scopeHasPermission(s *auth.TokenScope, permission auth.Permission) bool {
+	return slices.Contains(s.Permissions, permission)
+}
+
+// scopeCoversResource returns true if a scope on scoped applies to resource.
+// Scopes on the cluster cover everything, and scopes on a project cover the
+// project's repos.
+func scopeCoversResource(scoped, resource *auth.Resource) bool {
+	isRepo := func(rt auth.ResourceType) bool {
+		return rt == auth.ResourceType_REPO || rt == auth.ResourceType_SPEC_REPO
+	}
+	switch scoped.GetType() {
+	case auth.ResourceType_CLUSTER:
+		return true
+	case auth.ResourceType_PROJECT:
+		if resource.Type == auth.ResourceType_PROJECT {
+			return resource.Name == scoped.Name
+		}
+		return isRepo(resource.Type) && strings.HasPrefix(resource.Name, scoped.Name+"/")
+	case auth.ResourceType_REPO:
+		return isRepo(resource.Type) && resource.Name == scoped.Name
+	}
+	return false
+}
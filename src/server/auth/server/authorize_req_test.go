@@ -0,0 +1,127 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestRestrictToScopes(t *testing.T) {
+	images := &auth.Resource{Type: auth.ResourceType_REPO, Name: "default/images"}
+	edges := &auth.Resource{Type: auth.ResourceType_REPO, Name: "default/edges"}
+	other := &auth.Resource{Type: auth.ResourceType_REPO, Name: "other/images"}
+	scopes := []*auth.TokenScope{
+		{Resource: images, Permissions: []auth.Permission{auth.Permission_REPO_READ}},
+		{Resource: images, Permissions: []auth.Permission{auth.Permission_REPO_WRITE}, Branches: []string{"master"}},
+		{Resource: &auth.Resource{Type: auth.ResourceType_PROJECT, Name: "default"}, Permissions: []auth.Permission{auth.Permission_REPO_LIST_COMMIT}},
+	}
+	authorize := func(resource *auth.Resource, branch string, p ...auth.Permission) bool {
+		// The subject's roles grant every requested permission, so only the
+		// scopes restrict the request.
+		r := newAuthorizeRequest("robot:ci", map[auth.Permission]bool{}, nil)
+		r.satisfiedPermissions = p
+		r.restrictToScopes(scopes, resource, branch)
+		return r.isSatisfied()
+	}
+	require.True(t, authorize(images, "", auth.Permission_REPO_READ))
+	require.True(t, authorize(images, "master", auth.Permission_REPO_READ, auth.Permission_REPO_WRITE))
+	require.False(t, authorize(images, "", auth.Permission_REPO_WRITE))
+	require.False(t, authorize(images, "dev", auth.Permission_REPO_WRITE))
+	require.False(t, authorize(images, "", auth.Permission_REPO_DELETE))
+	require.False(t, authorize(edges, "", auth.Permission_REPO_READ))
+	require.True(t, authorize(edges, "", auth.Permission_REPO_LIST_COMMIT))
+	require.False(t, authorize(other, "", auth.Permission_REPO_LIST_COMMIT))
+	require.True(t, authorize(&auth.Resource{Type: auth.ResourceType_SPEC_REPO, Name: "default/images"}, "", auth.Permission_REPO_READ))
+	require.False(t, authorize(&auth.Resource{Type: auth.ResourceType_CLUSTER}, "", auth.Permission_REPO_READ))
+
+	// Tokens without scopes have all of their subject's permissions.
+	r := newAuthorizeRequest("robot:ci", map[auth.Permission]bool{}, nil)
+	r.satisfiedPermissions = []auth.Permission{auth.Permission_CLUSTER_MODIFY_BINDINGS}
+	r.restrictToScopes(nil, &auth.Resource{Type: auth.ResourceType_CLUSTER}, "")
+	require.True(t, r.isSatisfied())
+
+	// A scope on the cluster covers everything.
+	clusterScopes := []*auth.TokenScope{{Resource: &auth.Resource{Type: auth.ResourceType_CLUSTER}, Permissions: []auth.Permission{auth.Permission_REPO_READ}}}
+	require.True(t, scopesGrant(clusterScopes, other, "", auth.Permission_REPO_READ))
+}
+
+func TestTokenScopes(t *testing.T) {
+	scopes := []*auth.TokenScope{
+		{Resource: &auth.Resource{Type: auth.ResourceType_REPO, Name: "default/images"}, Permissions: []auth.Permission{auth.Permission_REPO_WRITE}, Branches: []string{"master"}},
+		{Resource: &auth.Resource{Type: auth.ResourceType_CLUSTER}, Permissions: []auth.Permission{auth.Permission_REPO_READ}},
+	}
+	require.NoError(t, validateTokenScopes(scopes))
+	data, err := marshalTokenScopes(scopes)
+	require.NoError(t, err)
+	got, err := unmarshalTokenScopes(data)
+	require.NoError(t, err)
+	if diff := cmp.Diff(scopes, got, protocmp.Transform()); diff != "" {
+		t.Errorf("scopes (-want +got):\n%s", diff)
+	}
+
+	data, err = marshalTokenScopes(nil)
+	require.NoError(t, err)
+	require.Nil(t, data)
+
+	for name, s := range map[string]*auth.TokenScope{
+		"no resource":       {Permissions: []auth.Permission{auth.Permission_REPO_READ}},
+		"no permissions":    {Resource: &auth.Resource{Type: auth.ResourceType_CLUSTER}},
+		"bad repo name":     {Resource: &auth.Resource{Type: auth.ResourceType_REPO, Name: "images"}, Permissions: []auth.Permission{auth.Permission_REPO_READ}},
+		"branch on project": {Resource: &auth.Resource{Type: auth.ResourceType_PROJECT, Name: "default"}, Permissions: []auth.Permission{auth.Permission_REPO_READ}, Branches: []string{"master"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.YesError(t, validateTokenScopes([]*auth.TokenScope{s}))
+		})
+	}
+}
+
+func TestExpiringRoleBindings(t *testing.T) {
+	binding := &auth.RoleBinding{Entries: map[string]*auth.Roles{
+		"user:oncall": {
+			Roles:         map[string]bool{auth.RepoWriterRole: true},
+			Expiration:    timestamppb.New(time.Now().Add(-time.Minute)),
+			Justification: "incident response",
+		},
+		"user:contractor": {
+			Roles:      map[string]bool{auth.RepoReaderRole: true},
+			Expiration: timestamppb.New(time.Now().Add(time.Hour)),
+		},
+	}}
+	authorize := func(subject string, p auth.Permission) bool {
+		r := newAuthorizeRequest(subject, map[auth.Permission]bool{p: true}, nil)
+		require.NoError(t, r.evaluateRoleBindingForSubject(subject, binding))
+		return r.isSatisfied()
+	}
+	require.False(t, authorize("user:oncall", auth.Permission_REPO_READ))
+	require.True(t, authorize("user:contractor", auth.Permission_REPO_READ))
+	require.False(t, authorize("user:contractor", auth.Permission_REPO_WRITE))
+
+	expiration := timestamppb.New(time.Now().Add(time.Hour))
+	roles, err := rolesFromModifyRequest(&auth.ModifyRoleBindingRequest{
+		Principal:     "user:contractor",
+		Roles:         []string{auth.RepoReaderRole},
+		Expiration:    expiration,
+		Justification: "data audit",
+	})
+	require.NoError(t, err)
+	want := &auth.Roles{Roles: map[string]bool{auth.RepoReaderRole: true}, Expiration: expiration, Justification: "data audit"}
+	if diff := cmp.Diff(want, roles, protocmp.Transform()); diff != "" {
+		t.Errorf("roles (-want +got):\n%s", diff)
+	}
+	for name, req := range map[string]*auth.ModifyRoleBindingRequest{
+		"expired":                  {Principal: "user:x", Roles: []string{auth.RepoReaderRole}, Expiration: timestamppb.New(time.Now().Add(-time.Hour))},
+		"expiration without roles": {Principal: "user:x", Expiration: expiration},
+		"pach principal":           {Principal: auth.RootUser, Roles: []string{auth.ClusterAdminRole}, Expiration: expiration},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := rolesFromModifyRequest(req)
+			require.YesError(t, err)
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/auth"
@@ -37,8 +39,16 @@ type IDTokenClaims struct {
 	Email         string   `json:"email"`
 	EmailVerified bool     `json:"email_verified"`
 	Groups        []string `json:"groups"`
+
+	// Raw holds every claim in the token, for claims that are configured
+	// rather than fixed, such as the claim that groups are synced from.
+	Raw map[string]interface{} `json:"-"`
 }
 
+// defaultGroupsClaim is the ID token claim that groups are synced from if
+// the OIDC config doesn't name one.
+const defaultGroupsClaim = "groups"
+
 // validateOIDC validates an OIDC configuration before it's stored in etcd.
 func validateOIDCConfig(ctx context.Context, config *auth.OIDCConfig) error {
 	if _, err := url.Parse(config.Issuer); err != nil {
@@ -353,6 +363,9 @@ func (a *apiServer) validateIDToken(ctx context.Context, rawIDToken string) (*oi
 	if err := idToken.Claims(&claims); err != nil {
 		return nil, nil, errors.Wrapf(err, "could not get claims")
 	}
+	if err := idToken.Claims(&claims.Raw); err != nil {
+		return nil, nil, errors.Wrapf(err, "could not get claims")
+	}
 
 	if !claims.EmailVerified && config.RequireEmailVerified {
 		return nil, nil, errors.New("email_verified claim was false, and require_email_verified was set")
@@ -361,12 +374,82 @@ func (a *apiServer) validateIDToken(ctx context.Context, rawIDToken string) (*oi
 }
 
 func (a *apiServer) syncGroupMembership(ctx context.Context, claims *IDTokenClaims) error {
-	groups := make([]string, len(claims.Groups))
-	for i, g := range claims.Groups {
-		groups[i] = fmt.Sprintf("%s%s", auth.GroupPrefix, g)
+	config, ok := a.configCache.Load().(*auth.OIDCConfig)
+	if !ok {
+		return errors.New("unable to load cached OIDC configuration")
+	}
+	sync := config.GroupSync
+	if sync.GetDisabled() {
+		return nil
+	}
+	subject := auth.UserPrefix + claims.Email
+	groups := groupsFromClaims(sync, claims.Raw)
+	if sync.GetPrefix() != "" {
+		// Only groups with the prefix come from the ID provider, so keep the
+		// rest of the user's groups.
+		existing, err := a.getGroups(ctx, subject)
+		if err != nil {
+			return err
+		}
+		for _, g := range existing {
+			if !strings.HasPrefix(g, auth.GroupPrefix+sync.Prefix) {
+				groups = append(groups, g)
+			}
+		}
 	}
 	// Sync group membership based on the groups claim, if any
-	return a.setGroupsForUserInternal(ctx, auth.UserPrefix+claims.Email, groups)
+	return a.setGroupsForUserInternal(ctx, subject, groups)
+}
+
+// groupsFromClaims returns the group: principals that the groups claim in
+// claims names, as configured by sync.
+func groupsFromClaims(sync *auth.OIDCGroupSync, claims map[string]interface{}) []string {
+	claim := sync.GetClaim()
+	if claim == "" {
+		claim = defaultGroupsClaim
+	}
+	var names []string
+	switch value := lookupClaim(claims, claim).(type) {
+	case string:
+		names = append(names, value)
+	case []interface{}:
+		for _, v := range value {
+			if name, ok := v.(string); ok {
+				names = append(names, name)
+			}
+		}
+	}
+	groups := make([]string, 0, len(names))
+	for _, name := range names {
+		if name == "" {
+			continue
+		}
+		if allowed := sync.GetAllowedGroups(); len(allowed) > 0 && !slices.ContainsFunc(allowed, func(pattern string) bool {
+			return claimMatches(pattern, name)
+		}) {
+			continue
+		}
+		groups = append(groups, auth.GroupPrefix+sync.GetPrefix()+name)
+	}
+	return groups
+}
+
+// lookupClaim returns the value of the claim called name in claims, or nil if
+// there's no such claim.  A name that isn't a top level claim is looked up as
+// a nested claim, with the names of its parents joined by '/'.
+func lookupClaim(claims map[string]interface{}, name string) interface{} {
+	if value, ok := claims[name]; ok {
+		return value
+	}
+	var value interface{} = claims
+	for _, part := range strings.Split(name, "/") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = m[part]
+	}
+	return value
 }
 
 // handleOIDCExchangeInternal is a convenience function for converting an
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestGroupsFromClaims(t *testing.T) {
+	claims := map[string]interface{}{
+		"groups":                   []interface{}{"authors", "eng-data", "eng-infra"},
+		"team":                     "editors",
+		"https://example.com/orgs": []interface{}{"acme"},
+		"realm_access": map[string]interface{}{
+			"roles": []interface{}{"admin", 7},
+		},
+	}
+	for name, tc := range map[string]struct {
+		sync *auth.OIDCGroupSync
+		want []string
+	}{
+		"default": {
+			want: []string{"group:authors", "group:eng-data", "group:eng-infra"},
+		},
+		"prefix": {
+			sync: &auth.OIDCGroupSync{Prefix: "okta-"},
+			want: []string{"group:okta-authors", "group:okta-eng-data", "group:okta-eng-infra"},
+		},
+		"allowed groups": {
+			sync: &auth.OIDCGroupSync{AllowedGroups: []string{"authors", "eng-*"}, Prefix: "okta-"},
+			want: []string{"group:okta-authors", "group:okta-eng-data", "group:okta-eng-infra"},
+		},
+		"allowed prefix": {
+			sync: &auth.OIDCGroupSync{AllowedGroups: []string{"eng-d*"}},
+			want: []string{"group:eng-data"},
+		},
+		"string claim": {
+			sync: &auth.OIDCGroupSync{Claim: "team"},
+			want: []string{"group:editors"},
+		},
+		"claim with slashes": {
+			sync: &auth.OIDCGroupSync{Claim: "https://example.com/orgs"},
+			want: []string{"group:acme"},
+		},
+		"nested claim": {
+			sync: &auth.OIDCGroupSync{Claim: "realm_access/roles"},
+			want: []string{"group:admin"},
+		},
+		"missing claim": {
+			sync: &auth.OIDCGroupSync{Claim: "departments"},
+			want: []string{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.ElementsEqual(t, tc.want, groupsFromClaims(tc.sync, claims))
+		})
+	}
+}
@@ -79,6 +79,19 @@ func init() {
 		Permissions: combinePermissions(repoWriterRole.Permissions, []auth.Permission{
 			auth.Permission_REPO_MODIFY_BINDINGS,
 			auth.Permission_REPO_DELETE,
+			auth.Permission_REPO_APPROVE_COMMIT,
+		}),
+	})
+
+	// repoApprover has the ability to approve commits for the branch
+	// triggers which require approval, plus all the permissions of
+	// repoReader.
+	registerRole(&auth.Role{
+		Name:         auth.RepoApproverRole,
+		CanBeBoundTo: []auth.ResourceType{auth.ResourceType_CLUSTER, auth.ResourceType_PROJECT, auth.ResourceType_REPO},
+		ReturnedFor:  []auth.ResourceType{auth.ResourceType_REPO},
+		Permissions: combinePermissions(repoReaderRole.Permissions, []auth.Permission{
+			auth.Permission_REPO_APPROVE_COMMIT,
 		}),
 	})
 
@@ -272,6 +285,9 @@ func init() {
 				auth.Permission_CLUSTER_DELETE_ALL,
 				auth.Permission_CLUSTER_ENTERPRISE_PAUSE,
 				auth.Permission_CLUSTER_SET_DEFAULTS,
+				auth.Permission_CLUSTER_MANAGE_REPLICATIONS,
+				auth.Permission_CLUSTER_RECEIVE_REPLICATION,
+				auth.Permission_CLUSTER_SET_PROJECT_QUOTAS,
 			}),
 	})
 }
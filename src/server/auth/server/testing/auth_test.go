@@ -1007,6 +1007,7 @@ func TestListAndInspectRepo(t *testing.T) {
 			auth.Permission_REPO_READ,
 			auth.Permission_REPO_REMOVE_PIPELINE_READER,
 			auth.Permission_REPO_WRITE,
+			auth.Permission_REPO_APPROVE_COMMIT,
 		},
 		repoWriter: {
 			auth.Permission_PIPELINE_LIST_JOB,
@@ -1071,6 +1072,96 @@ func TestUnprivilegedUserCannotMakeSelfOwner(t *testing.T) {
 	require.Equal(t, tu.BuildBindings(alice, auth.RepoOwnerRole), tu.GetRepoRoleBinding(t, aliceClient, pfs.DefaultProjectName, repo))
 }
 
+// TestApproveCommitRequiresApprover tests that writing to a repo doesn't allow
+// approving its commits.
+func TestApproveCommitRequiresApprover(t *testing.T) {
+	t.Parallel()
+	env := envWithAuth(t)
+	c := env.PachClient
+	alice, bob := tu.Robot(tu.UniqueString("alice")), tu.Robot(tu.UniqueString("bob"))
+	aliceClient, bobClient := tu.AuthenticateClient(t, c, alice), tu.AuthenticateClient(t, c, bob)
+
+	// alice creates a repo, and bob writes a commit to it
+	repo := tu.UniqueString(t.Name())
+	require.NoError(t, aliceClient.CreateRepo(pfs.DefaultProjectName, repo))
+	require.NoError(t, aliceClient.ModifyRepoRoleBinding(pfs.DefaultProjectName, repo, bob, []string{auth.RepoWriterRole}))
+	commit := client.NewCommit(pfs.DefaultProjectName, repo, "master", "")
+	require.NoError(t, bobClient.PutFile(commit, "/file", strings.NewReader("data")))
+
+	// bob can't approve his own commit as a writer
+	_, err := bobClient.PfsAPIClient.ApproveCommit(bobClient.Ctx(), &pfs.ApproveCommitRequest{Commit: commit})
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+
+	// but can once he's an approver
+	require.NoError(t, aliceClient.ModifyRepoRoleBinding(pfs.DefaultProjectName, repo, bob, []string{auth.RepoWriterRole, auth.RepoApproverRole}))
+	_, err = bobClient.PfsAPIClient.ApproveCommit(bobClient.Ctx(), &pfs.ApproveCommitRequest{Commit: commit})
+	require.NoError(t, err)
+}
+
+// TestRetentionPolicyRequiresDeleteCommit tests that listing and enforcing
+// retention policies without a repo only covers the repos the caller can
+// access.
+func TestRetentionPolicyRequiresDeleteCommit(t *testing.T) {
+	t.Parallel()
+	env := envWithAuth(t)
+	c := env.PachClient
+	alice, bob := tu.Robot(tu.UniqueString("alice")), tu.Robot(tu.UniqueString("bob"))
+	aliceClient, bobClient := tu.AuthenticateClient(t, c, alice), tu.AuthenticateClient(t, c, bob)
+
+	// alice creates a repo with a retention policy and commits to squash
+	repo := tu.UniqueString(t.Name())
+	require.NoError(t, aliceClient.CreateRepo(pfs.DefaultProjectName, repo))
+	for i := 0; i < 3; i++ {
+		commit := client.NewCommit(pfs.DefaultProjectName, repo, "master", "")
+		require.NoError(t, aliceClient.PutFile(commit, fmt.Sprintf("/file%d", i), strings.NewReader("data")))
+	}
+	_, err := aliceClient.PfsAPIClient.SetRetentionPolicy(aliceClient.Ctx(), &pfs.SetRetentionPolicyRequest{
+		Repo:   client.NewRepo(pfs.DefaultProjectName, repo),
+		Policy: &pfs.RetentionPolicy{KeepLast: 1},
+	})
+	require.NoError(t, err)
+
+	// bob can't see alice's policy or her commits
+	listClient, err := bobClient.PfsAPIClient.ListRetentionPolicy(bobClient.Ctx(), &pfs.ListRetentionPolicyRequest{})
+	require.NoError(t, err)
+	infos, err := grpcutil.Collect[*pfs.RetentionPolicyInfo](listClient, 1000)
+	require.NoError(t, err)
+	for _, info := range infos {
+		require.NotEqual(t, repo, info.Repo.Name)
+	}
+	listClient, err = bobClient.PfsAPIClient.ListRetentionPolicy(bobClient.Ctx(), &pfs.ListRetentionPolicyRequest{Repo: client.NewRepo(pfs.DefaultProjectName, repo)})
+	require.NoError(t, err)
+	_, err = grpcutil.Collect[*pfs.RetentionPolicyInfo](listClient, 1000)
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+
+	// nor squash them by enforcing every policy
+	enforceClient, err := bobClient.PfsAPIClient.EnforceRetentionPolicy(bobClient.Ctx(), &pfs.EnforceRetentionPolicyRequest{})
+	require.NoError(t, err)
+	actions, err := grpcutil.Collect[*pfs.RetentionAction](enforceClient, 1000)
+	require.NoError(t, err)
+	for _, action := range actions {
+		require.NotEqual(t, repo, action.Commit.Repo.Name)
+	}
+	cis, err := aliceClient.ListCommitByRepo(client.NewRepo(pfs.DefaultProjectName, repo))
+	require.NoError(t, err)
+	require.Len(t, cis, 3)
+
+	// alice can
+	enforceClient, err = aliceClient.PfsAPIClient.EnforceRetentionPolicy(aliceClient.Ctx(), &pfs.EnforceRetentionPolicyRequest{})
+	require.NoError(t, err)
+	actions, err = grpcutil.Collect[*pfs.RetentionAction](enforceClient, 1000)
+	require.NoError(t, err)
+	var squashed int
+	for _, action := range actions {
+		if action.Commit.Repo.Name == repo && action.Squashed {
+			squashed++
+		}
+	}
+	require.Equal(t, 2, squashed)
+}
+
 // TestListRepoNotLoggedInError makes sure that if a user isn't logged in, and
 // they call ListRepo(), they get an error.
 func TestListRepoNotLoggedInError(t *testing.T) {
@@ -2066,7 +2157,7 @@ func TestRolesForPermission(t *testing.T) {
 		names[i] = r.Name
 	}
 	sort.Strings(names)
-	require.Equal(t, []string{"clusterAdmin", "projectOwner", "repoOwner", "repoReader", "repoWriter"}, names)
+	require.Equal(t, []string{"clusterAdmin", "projectOwner", "repoApprover", "repoOwner", "repoReader", "repoWriter"}, names)
 }
 
 // TODO: This test mirrors TestLoad in src/server/pfs/server/testing/load_test.go.
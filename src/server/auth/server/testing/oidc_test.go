@@ -132,3 +132,45 @@ func TestCannotAuthenticateWithExpiredLicense(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, tu.User(tu.DexMockConnectorEmail), whoAmIResp.Username)
 }
+
+// TestOIDCGroupSyncKeepsManagedGroups tests that when group sync is
+// configured with a prefix, logging in only replaces the user's groups that
+// start with the prefix.
+func TestOIDCGroupSyncKeepsManagedGroups(t *testing.T) {
+	t.Parallel()
+	ctx := pctx.TestContext(t)
+	env := realenv.NewRealEnvWithIdentity(ctx, t, dockertestenv.NewTestDBConfig(t))
+	peerPort := strconv.Itoa(int(env.ServiceEnv.Config().PeerPort))
+	c := env.PachClient
+	tu.ActivateAuthClient(t, c, peerPort)
+	require.NoError(t, tu.ConfigureOIDCProvider(t, c, true))
+	adminClient := tu.AuthenticateClient(t, c, auth.RootUser)
+
+	config, err := adminClient.GetConfiguration(adminClient.Ctx(), &auth.GetConfigurationRequest{})
+	require.NoError(t, err)
+	config.Configuration.GroupSync = &auth.OIDCGroupSync{Prefix: "idp-"}
+	_, err = adminClient.SetConfiguration(adminClient.Ctx(), &auth.SetConfigurationRequest{Configuration: config.Configuration})
+	require.NoError(t, err)
+
+	// The mock connector's user is in no groups, so only the group synced
+	// from the ID provider is removed.
+	user := tu.User(tu.DexMockConnectorEmail)
+	_, err = adminClient.SetGroupsForUser(adminClient.Ctx(), &auth.SetGroupsForUserRequest{
+		Username: user,
+		Groups:   []string{"group:managed", "group:idp-stale"},
+	})
+	require.NoError(t, err)
+
+	testClient := tu.UnauthenticatedPachClient(t, c)
+	loginInfo, err := testClient.GetOIDCLogin(testClient.Ctx(), &auth.GetOIDCLoginRequest{})
+	require.NoError(t, err)
+	tu.DoOAuthExchange(t, testClient, testClient, loginInfo.LoginUrl)
+	_, err = testClient.Authenticate(testClient.Ctx(), &auth.AuthenticateRequest{OidcState: loginInfo.State})
+	require.NoError(t, err)
+
+	groups, err := adminClient.GetGroupsForPrincipal(adminClient.Ctx(), &auth.GetGroupsForPrincipalRequest{
+		Principal: user,
+	})
+	require.NoError(t, err)
+	require.ElementsEqual(t, []string{"group:managed"}, groups.Groups)
+}
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"encoding/json"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+// errScopedTokenManagement is returned when a scoped token is used to manage
+// tokens, which would let it escape its scopes.
+var errScopedTokenManagement = errors.New("scoped tokens cannot be used to create, list or revoke other tokens")
+
+// validateTokenScopes returns an error if any of scopes isn't a valid scope on
+// the cluster, a project or a repo.
+func validateTokenScopes(scopes []*auth.TokenScope) error {
+	for _, s := range scopes {
+		switch s.Resource.GetType() {
+		case auth.ResourceType_CLUSTER:
+		case auth.ResourceType_PROJECT:
+			if s.Resource.Name == "" {
+				return errors.New("token scopes on a project must name the project")
+			}
+		case auth.ResourceType_REPO:
+			if _, err := authRepoResourceToRepo(s.Resource); err != nil {
+				return errors.Wrap(err, "invalid token scope")
+			}
+		default:
+			return errors.Errorf("token scopes must be on the cluster, a project or a repo, not %v", s.Resource.GetType())
+		}
+		if len(s.Permissions) == 0 {
+			return errors.Errorf("token scope on %v %q must grant at least one permission", s.Resource.Type, s.Resource.Name)
+		}
+		if len(s.Branches) > 0 && s.Resource.Type != auth.ResourceType_REPO {
+			return errors.New("only token scopes on a repo can be restricted to branches")
+		}
+	}
+	return nil
+}
+
+// marshalTokenScopes returns the JSON array stored in the scopes column of
+// auth.auth_tokens, which is NULL for tokens without scopes.
+func marshalTokenScopes(scopes []*auth.TokenScope) ([]byte, error) {
+	if len(scopes) == 0 {
+		return nil, nil
+	}
+	msgs := make([]json.RawMessage, len(scopes))
+	for i, s := range scopes {
+		data, err := protojson.Marshal(s)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		msgs[i] = data
+	}
+	data, err := json.Marshal(msgs)
+	return data, errors.EnsureStack(err)
+}
+
+func unmarshalTokenScopes(data []byte) ([]*auth.TokenScope, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var msgs []json.RawMessage
+	if err := json.Unmarshal(data, &msgs); err != nil {
+		return nil, errors.Wrap(err, "unmarshal token scopes")
+	}
+	scopes := make([]*auth.TokenScope, len(msgs))
+	for i, msg := range msgs {
+		scopes[i] = &auth.TokenScope{}
+		if err := protojson.Unmarshal(msg, scopes[i]); err != nil {
+			return nil, errors.Wrap(err, "unmarshal token scope")
+		}
+	}
+	return scopes, nil
+}
@@ -12,13 +12,13 @@ import (
 // CheckClusterIsAuthorizedInTransaction returns an error if the current user doesn't have
 // the permissions in `p` on the cluster
 func (a *apiServer) CheckClusterIsAuthorizedInTransaction(txnCtx *txncontext.TransactionContext, p ...auth.Permission) error {
-	return a.checkResourceIsAuthorizedInTransaction(txnCtx, &auth.Resource{Type: auth.ResourceType_CLUSTER}, p...)
+	return a.checkResourceIsAuthorizedInTransaction(txnCtx, &auth.Resource{Type: auth.ResourceType_CLUSTER}, "", p...)
 }
 
 // CheckProjectIsAuthorizedInTransaction returns an error if the current user doesn't have the permissions in `p` on the project.
 // Projects inherit access controls from its parent cluster. Therefore, behind the scene, we check the cluster first, followed by this project.
 func (a *apiServer) CheckProjectIsAuthorizedInTransaction(txnCtx *txncontext.TransactionContext, project *pfs.Project, p ...auth.Permission) error {
-	return a.checkResourceIsAuthorizedInTransaction(txnCtx, &auth.Resource{Type: auth.ResourceType_PROJECT, Name: project.Name}, p...)
+	return a.checkResourceIsAuthorizedInTransaction(txnCtx, &auth.Resource{Type: auth.ResourceType_PROJECT, Name: project.Name}, "", p...)
 }
 
 // CheckRepoIsAuthorizedInTransaction is identical to CheckRepoIsAuthorized except that
@@ -26,11 +26,18 @@ func (a *apiServer) CheckProjectIsAuthorizedInTransaction(txnCtx *txncontext.Tra
 // Repos inherit access controls from its parent project, which in turn inherits from the cluster.
 // Therefore, behind the scene, we check the cluster first, then this repo's project, and finally this repo.
 func (a *apiServer) CheckRepoIsAuthorizedInTransaction(txnCtx *txncontext.TransactionContext, repo *pfs.Repo, p ...auth.Permission) error {
-	return a.checkResourceIsAuthorizedInTransaction(txnCtx, repo.AuthResource(), p...)
+	return a.checkResourceIsAuthorizedInTransaction(txnCtx, repo.AuthResource(), "", p...)
 }
 
-// CheckResourceIsAuthorizedInTransaction returns an error if the subject/user doesn't have permission in `p` on the `resource`
-func (a *apiServer) checkResourceIsAuthorizedInTransaction(txnCtx *txncontext.TransactionContext, resource *auth.Resource, p ...auth.Permission) error {
+// CheckBranchIsAuthorizedInTransaction is identical to CheckRepoIsAuthorizedInTransaction
+// except that a scoped token may also be restricted to a subset of the repo's branches.
+func (a *apiServer) CheckBranchIsAuthorizedInTransaction(txnCtx *txncontext.TransactionContext, branch *pfs.Branch, p ...auth.Permission) error {
+	return a.checkResourceIsAuthorizedInTransaction(txnCtx, branch.Repo.AuthResource(), branch.Name, p...)
+}
+
+// CheckResourceIsAuthorizedInTransaction returns an error if the subject/user doesn't have permission in `p` on the `resource`,
+// or on `branch` of the resource if it is set.
+func (a *apiServer) checkResourceIsAuthorizedInTransaction(txnCtx *txncontext.TransactionContext, resource *auth.Resource, branch string, p ...auth.Permission) error {
 	me, err := txnCtx.WhoAmI()
 	if err != nil {
 		if errors.Is(err, auth.ErrNotActivated) {
@@ -39,8 +46,7 @@ func (a *apiServer) checkResourceIsAuthorizedInTransaction(txnCtx *txncontext.Tr
 		return err
 	}
 
-	req := &auth.AuthorizeRequest{Resource: resource, Permissions: p}
-	resp, err := a.AuthorizeInTransaction(txnCtx, req)
+	resp, err := a.authorizeInTransaction(txnCtx, resource, branch, p)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,203 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	oidc "github.com/coreos/go-oidc"
+	"k8s.io/client-go/rest"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+// workloadKeySets caches the signing keys of workload identity providers, so
+// that they're only fetched when a token is signed with a key that isn't
+// cached yet.
+type workloadKeySets struct {
+	mu      sync.Mutex
+	keySets map[string]oidc.KeySet
+}
+
+// validateWorkloadIdentityProviders returns an error if any of providers can't
+// be used to authenticate workloads.
+func validateWorkloadIdentityProviders(providers []*auth.WorkloadIdentityProvider) error {
+	for _, p := range providers {
+		if p.Issuer == "" {
+			return errors.New("workload identity providers must have an issuer")
+		}
+		if len(p.Audiences) == 0 {
+			return errors.Errorf("workload identity provider %q must have at least one audience", p.Issuer)
+		}
+		if len(p.Rules) == 0 {
+			return errors.Errorf("workload identity provider %q must have at least one rule", p.Issuer)
+		}
+		for _, r := range p.Rules {
+			if !strings.HasPrefix(r.Principal, auth.RobotPrefix) && !strings.HasPrefix(r.Principal, auth.UserPrefix) {
+				return errors.Errorf("workload identity rule principal %q must be a robot: or user: principal", r.Principal)
+			}
+			if len(r.Claims) == 0 {
+				return errors.Errorf("workload identity rule for %q must match at least one claim", r.Principal)
+			}
+		}
+	}
+	return nil
+}
+
+// authenticateWorkloadToken verifies rawToken with the provider in providers
+// that issued it, and returns the principal that the provider's rules map the
+// token to, along with the token's expiry.
+func (a *apiServer) authenticateWorkloadToken(ctx context.Context, providers []*auth.WorkloadIdentityProvider, rawToken string) (string, time.Time, error) {
+	issuer, err := unverifiedIssuer(rawToken)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	var provider *auth.WorkloadIdentityProvider
+	for _, p := range providers {
+		if p.Issuer == issuer {
+			provider = p
+			break
+		}
+	}
+	if provider == nil {
+		return "", time.Time{}, errors.Errorf("no workload identity provider is configured for issuer %q", issuer)
+	}
+	keySet, err := a.workloadKeySet(ctx, provider)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	// Audiences are checked below, since any of several may be accepted.
+	verifier := oidc.NewVerifier(provider.Issuer, keySet, &oidc.Config{SkipClientIDCheck: true})
+	token, err := verifier.Verify(ctx, rawToken)
+	if err != nil {
+		return "", time.Time{}, errors.Wrap(err, "could not verify workload token")
+	}
+	if !audienceAccepted(provider.Audiences, token.Audience) {
+		return "", time.Time{}, errors.Errorf("workload token audience %v is not one of %v", token.Audience, provider.Audiences)
+	}
+	claims := make(map[string]interface{})
+	if err := token.Claims(&claims); err != nil {
+		return "", time.Time{}, errors.Wrap(err, "could not get workload token claims")
+	}
+	for _, r := range provider.Rules {
+		if workloadRuleMatches(r, claims) {
+			return r.Principal, token.Expiry, nil
+		}
+	}
+	return "", time.Time{}, errors.Errorf("workload token for subject %q matches no rule of issuer %q", token.Subject, issuer)
+}
+
+// workloadKeySet returns the key set that verifies the tokens of p, fetching
+// the location of its keys if they haven't been fetched before.
+func (a *apiServer) workloadKeySet(ctx context.Context, p *auth.WorkloadIdentityProvider) (oidc.KeySet, error) {
+	key := fmt.Sprintf("%s %s %t", p.Issuer, p.JwksUrl, p.KubernetesApi)
+	a.workloadKeySets.mu.Lock()
+	keySet, ok := a.workloadKeySets.keySets[key]
+	a.workloadKeySets.mu.Unlock()
+	if ok {
+		return keySet, nil
+	}
+	// The key set refreshes its keys with the context it's created with, so
+	// it must outlive this request.
+	keySetCtx := a.env.BackgroundContext
+	jwksURL := p.JwksUrl
+	if p.KubernetesApi {
+		config, err := rest.InClusterConfig()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get kubernetes API config")
+		}
+		client, err := rest.HTTPClientFor(config)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create kubernetes API client")
+		}
+		keySetCtx = oidc.ClientContext(keySetCtx, client)
+		ctx = oidc.ClientContext(ctx, client)
+		if jwksURL == "" {
+			jwksURL = strings.TrimSuffix(config.Host, "/") + "/openid/v1/jwks"
+		}
+	}
+	if jwksURL == "" {
+		provider, err := oidc.NewProvider(ctx, p.Issuer)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not discover the keys of issuer %q", p.Issuer)
+		}
+		var discovery struct {
+			JWKSURL string `json:"jwks_uri"`
+		}
+		if err := provider.Claims(&discovery); err != nil {
+			return nil, errors.Wrapf(err, "could not discover the keys of issuer %q", p.Issuer)
+		}
+		jwksURL = discovery.JWKSURL
+	}
+	keySet = oidc.NewRemoteKeySet(keySetCtx, jwksURL)
+	a.workloadKeySets.mu.Lock()
+	defer a.workloadKeySets.mu.Unlock()
+	if a.workloadKeySets.keySets == nil {
+		a.workloadKeySets.keySets = make(map[string]oidc.KeySet)
+	}
+	a.workloadKeySets.keySets[key] = keySet
+	return keySet, nil
+}
+
+// unverifiedIssuer returns the iss claim of rawToken without verifying it, to
+// find the provider that can verify it.
+func unverifiedIssuer(rawToken string) (string, error) {
+	parts := strings.Split(rawToken, ".")
+	if len(parts) != 3 {
+		return "", errors.New("workload token is not a JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", errors.Wrap(err, "could not decode workload token")
+	}
+	var claims struct {
+		Issuer string `json:"iss"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", errors.Wrap(err, "could not decode workload token")
+	}
+	return claims.Issuer, nil
+}
+
+func audienceAccepted(accepted, audience []string) bool {
+	for _, a := range accepted {
+		for _, aud := range audience {
+			if a == aud {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// workloadRuleMatches returns true if every claim of r matches claims.
+func workloadRuleMatches(r *auth.WorkloadIdentityRule, claims map[string]interface{}) bool {
+	for name, want := range r.Claims {
+		if !claimMatches(want, lookupClaim(claims, name)) {
+			return false
+		}
+	}
+	return true
+}
+
+func claimMatches(want string, value interface{}) bool {
+	switch value := value.(type) {
+	case string:
+		if prefix, ok := strings.CutSuffix(want, "*"); ok {
+			return strings.HasPrefix(value, prefix)
+		}
+		return value == want
+	case []interface{}:
+		for _, v := range value {
+			if claimMatches(want, v) {
+				return true
+			}
+		}
+	}
+	return false
+}
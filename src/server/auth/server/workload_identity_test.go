@@ -0,0 +1,146 @@
+package server
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+// testJWKS serves a key set with one RSA key, standing in for an issuer's
+// JWKS endpoint, and signs tokens with that key.
+type testJWKS struct {
+	key    *rsa.PrivateKey
+	server *httptest.Server
+}
+
+func newTestJWKS(t *testing.T) *testJWKS {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	jwks := map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"alg": "RS256",
+			"use": "sig",
+			"kid": "test",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(jwks))
+	}))
+	t.Cleanup(server.Close)
+	return &testJWKS{key: key, server: server}
+}
+
+func (j *testJWKS) sign(t *testing.T, claims map[string]interface{}) string {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "kid": "test", "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, j.key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func TestAuthenticateWorkloadToken(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	jwks := newTestJWKS(t)
+	issuer := "https://kubernetes.default.svc"
+	providers := []*auth.WorkloadIdentityProvider{{
+		Issuer:    issuer,
+		Audiences: []string{"pachd"},
+		JwksUrl:   jwks.server.URL,
+		Rules: []*auth.WorkloadIdentityRule{
+			{
+				Claims:    map[string]string{"kubernetes.io/namespace": "etl", "kubernetes.io/serviceaccount/name": "ingest-*"},
+				Principal: auth.RobotPrefix + "etl-ingest",
+			},
+			{
+				Claims:    map[string]string{"groups": "admins"},
+				Principal: auth.UserPrefix + "admin@example.com",
+			},
+		},
+	}}
+	require.NoError(t, validateWorkloadIdentityProviders(providers))
+	a := &apiServer{env: Env{BackgroundContext: ctx}}
+	expiry := time.Now().Add(time.Hour).Truncate(time.Second)
+	claims := func(aud string, extra map[string]interface{}) map[string]interface{} {
+		c := map[string]interface{}{
+			"iss": issuer,
+			"sub": "system:serviceaccount:etl:ingest-1",
+			"aud": []string{aud},
+			"exp": expiry.Unix(),
+		}
+		for k, v := range extra {
+			c[k] = v
+		}
+		return c
+	}
+
+	principal, gotExpiry, err := a.authenticateWorkloadToken(ctx, providers, jwks.sign(t, claims("pachd", map[string]interface{}{
+		"kubernetes.io": map[string]interface{}{
+			"namespace":      "etl",
+			"serviceaccount": map[string]interface{}{"name": "ingest-1"},
+		},
+	})))
+	require.NoError(t, err)
+	require.Equal(t, auth.RobotPrefix+"etl-ingest", principal)
+	require.True(t, expiry.Equal(gotExpiry))
+
+	principal, _, err = a.authenticateWorkloadToken(ctx, providers, jwks.sign(t, claims("pachd", map[string]interface{}{
+		"groups": []string{"developers", "admins"},
+	})))
+	require.NoError(t, err)
+	require.Equal(t, auth.UserPrefix+"admin@example.com", principal)
+
+	// No rule matches a service account in another namespace.
+	_, _, err = a.authenticateWorkloadToken(ctx, providers, jwks.sign(t, claims("pachd", map[string]interface{}{
+		"kubernetes.io": map[string]interface{}{
+			"namespace":      "default",
+			"serviceaccount": map[string]interface{}{"name": "ingest-1"},
+		},
+	})))
+	require.YesError(t, err)
+
+	_, _, err = a.authenticateWorkloadToken(ctx, providers, jwks.sign(t, claims("vault", map[string]interface{}{
+		"groups": []string{"admins"},
+	})))
+	require.YesError(t, err)
+
+	c := claims("pachd", map[string]interface{}{"groups": []string{"admins"}})
+	c["iss"] = "https://accounts.example.com"
+	_, _, err = a.authenticateWorkloadToken(ctx, providers, jwks.sign(t, c))
+	require.YesError(t, err)
+
+	// A token signed by a different key is rejected.
+	other := newTestJWKS(t)
+	_, _, err = a.authenticateWorkloadToken(ctx, providers, other.sign(t, claims("pachd", map[string]interface{}{
+		"groups": []string{"admins"},
+	})))
+	require.YesError(t, err)
+}
+
+func TestValidateWorkloadIdentityProviders(t *testing.T) {
+	rule := &auth.WorkloadIdentityRule{Claims: map[string]string{"sub": "x"}, Principal: auth.RobotPrefix + "x"}
+	require.YesError(t, validateWorkloadIdentityProviders([]*auth.WorkloadIdentityProvider{{Audiences: []string{"pachd"}, Rules: []*auth.WorkloadIdentityRule{rule}}}))
+	require.YesError(t, validateWorkloadIdentityProviders([]*auth.WorkloadIdentityProvider{{Issuer: "i", Rules: []*auth.WorkloadIdentityRule{rule}}}))
+	require.YesError(t, validateWorkloadIdentityProviders([]*auth.WorkloadIdentityProvider{{Issuer: "i", Audiences: []string{"pachd"}}}))
+	require.YesError(t, validateWorkloadIdentityProviders([]*auth.WorkloadIdentityProvider{{Issuer: "i", Audiences: []string{"pachd"}, Rules: []*auth.WorkloadIdentityRule{{Claims: rule.Claims, Principal: "x"}}}}))
+	require.NoError(t, validateWorkloadIdentityProviders([]*auth.WorkloadIdentityProvider{{Issuer: "i", Audiences: []string{"pachd"}, Rules: []*auth.WorkloadIdentityRule{rule}}}))
+}
@@ -221,6 +221,11 @@ func (a *InactiveAPIServer) CheckRepoIsAuthorizedInTransaction(*txncontext.Trans
 	return nil
 }
 
+// CheckBranchIsAuthorizedInTransaction returns nil when auth is not activated
+func (a *InactiveAPIServer) CheckBranchIsAuthorizedInTransaction(*txncontext.TransactionContext, *pfs.Branch, ...auth.Permission) error {
+	return nil
+}
+
 // CheckProjectIsAuthorizedInTransaction returns nil when auth is not activated
 func (a *InactiveAPIServer) CheckProjectIsAuthorizedInTransaction(*txncontext.TransactionContext, *pfs.Project, ...auth.Permission) error {
 	return nil
@@ -17,7 +17,7 @@ func main() {
 	// (we link the kubernetes client, so otherwise they're in 'pachctl --help')
 	pflag.CommandLine = pflag.NewFlagSet(os.Args[0], pflag.ExitOnError)
 	log.InitPachctlLogger()
-	tracing.InstallJaegerTracerFromEnv()
+	tracing.InstallTracerFromEnv("mount-server")
 	err := func() error {
 		defer tracing.CloseAndReportTraces()
 		return errors.EnsureStack(cmd.MountServerCmd().Execute())
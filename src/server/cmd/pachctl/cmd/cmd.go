@@ -331,9 +331,10 @@ func PachctlCmd() (*cobra.Command, error) {
 			"PachCTL Environment Variables:" +
 			"\n" +
 			"\t- PACH_CONFIG=<path> | (Req) PachCTL config location. \n" +
-			"\t- PACH_TRACE={true,false} | (Opt) Attach Jaeger trace to outgoing RPCs; JAEGER_ENDPOINT must be specified. \n" +
+			"\t- PACH_TRACE={true,false} | (Opt) Attach an OpenTelemetry trace to outgoing RPCs; OTEL_EXPORTER_OTLP_ENDPOINT must be specified. \n" +
 			"\t\t[req. PACH_TRACE={true}]: \n" +
-			"\t\t- JAEGER_ENDPOINT=<host>:<port>  | Jaeger server to connect to. \n" +
+			"\t\t- OTEL_EXPORTER_OTLP_ENDPOINT=<url> | OTLP collector to export traces to. \n" +
+			"\t\t- OTEL_EXPORTER_OTLP_PROTOCOL={grpc,http/protobuf} | (Opt) OTLP transport; defaults to grpc. \n" +
 			"\t\t- PACH_TRACE_DURATION=<duration> | Duration to trace pipelines after 'pachctl create-pipeline'. \n \n" +
 			"Documentation: https://docs.pachyderm.com/latest/",
 		PersistentPreRun: func(cmd *cobra.Command, args []string) {
@@ -758,6 +759,18 @@ This resets the cluster to its initial state.`,
 	}
 	subcommands = append(subcommands, cmdutil.CreateAlias(findDocs, "find"))
 
+	approveDocs := &cobra.Command{
+		Short: "Approve a Pachyderm resource.",
+		Long:  "Approve a Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(approveDocs, "approve"))
+
+	enforceDocs := &cobra.Command{
+		Short: "Enforce the policies of a Pachyderm resource.",
+		Long:  "Enforce the policies of a Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(enforceDocs, "enforce"))
+
 	waitDocs := &cobra.Command{
 		Short: "Wait for the side-effects of a Pachyderm resource to propagate.",
 		Long:  "Wait for the side-effects of a Pachyderm resource to propagate.",
@@ -903,11 +916,13 @@ func applyRootUsageFunc(rootCmd *cobra.Command) {
 			"tag":
 			// These are ignored - they will show up in the help topics section
 		case
+			"approve",
 			"copy",
 			"create",
 			"delete",
 			"diff",
 			"edit",
+			"enforce",
 			"finish",
 			"wait",
 			"get",
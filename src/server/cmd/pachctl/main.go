@@ -19,7 +19,7 @@ func main() {
 	// Remove kubernetes client flags from the spf13 flag set
 	// (we link the kubernetes client, so otherwise they're in 'pachctl --help')
 	pflag.CommandLine = pflag.NewFlagSet(os.Args[0], pflag.ExitOnError)
-	tracing.InstallJaegerTracerFromEnv()
+	tracing.InstallTracerFromEnv("pachctl")
 	err := func() error {
 		defer tracing.CloseAndReportTraces()
 		pachctl, err := cmd.PachctlCmd()
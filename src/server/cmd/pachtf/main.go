@@ -60,6 +60,7 @@ var entrypoints = map[string]Entrypoint{
 	"sql-ingest":      sqlIngest,
 	"sql-gen-queries": sqlGenQueries,
 	"sql-run":         sqlRun,
+	"builtin":         builtin,
 }
 
 func sqlIngest(ctx context.Context, args []string) error {
@@ -152,3 +153,23 @@ func sqlRun(ctx context.Context, args []string) error {
 	}
 	return transforms.RunSQLRaw(ctx, params)
 }
+
+// builtin runs one of the builtin transforms, with its args as a JSON object.
+func builtin(ctx context.Context, args []string) error {
+	if len(args) < 1 {
+		return errors.Errorf("must provide the name of a builtin transform")
+	}
+	var transformArgs []byte
+	if len(args) > 1 {
+		transformArgs = []byte(args[1])
+	}
+	b, err := transforms.ParseBuiltin(args[0], transformArgs)
+	if err != nil {
+		return err
+	}
+	inputDirs, err := transforms.InputDirs(filepath.FromSlash(pfs))
+	if err != nil {
+		return err
+	}
+	return b.Run(ctx, inputDirs, filepath.FromSlash(pfsOut))
+}
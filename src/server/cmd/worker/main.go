@@ -46,8 +46,9 @@ func main() {
 }
 
 func do(ctx context.Context, config *pachconfig.WorkerFullConfiguration) error {
-	// must run InstallJaegerTracer before InitWithKube/pach client initialization
-	tracing.InstallJaegerTracerFromEnv()
+	// must run InstallTracerFromEnv before InitWithKube/pach client initialization
+	tracing.InstallTracerFromEnv("worker")
+	defer tracing.CloseAndReportTraces()
 	env := serviceenv.InitWithKube(ctx, pachconfig.NewConfiguration(config))
 
 	// Enable cloud profilers if the configuration allows.
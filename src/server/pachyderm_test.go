@@ -3969,6 +3969,56 @@ func TestPipelineWithFullObjects(t *testing.T) {
 	require.Equal(t, "foo\nbar\n", buffer.String())
 }
 
+func TestPipelineWithIncrementalInput(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	t.Parallel()
+	c, _ := minikubetestenv.AcquireCluster(t)
+	dataRepo := tu.UniqueString("TestPipelineWithIncrementalInput_data")
+	require.NoError(t, c.CreateRepo(pfs.DefaultProjectName, dataRepo))
+	pipelineName := tu.UniqueString("pipeline")
+	input := client.NewPFSInput(pfs.DefaultProjectName, dataRepo, "/*")
+	input.Pfs.Incremental = true
+	require.NoError(t, c.CreatePipeline(pfs.DefaultProjectName,
+		pipelineName,
+		"",
+		[]string{"bash"},
+		[]string{fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo)},
+		&pps.ParallelismSpec{
+			Constant: 1,
+		},
+		input,
+		"",
+		false,
+	))
+
+	// Each job only sees the files that changed since the previous job, but
+	// the output of the previous jobs is kept.
+	for i, file := range []string{"a", "b", "c"} {
+		commit, err := c.StartCommit(pfs.DefaultProjectName, dataRepo, "master")
+		require.NoError(t, err)
+		require.NoError(t, c.PutFile(commit, file, strings.NewReader(file)))
+		require.NoError(t, c.FinishCommit(pfs.DefaultProjectName, dataRepo, commit.Branch.Name, commit.Id))
+		_, err = c.WaitCommitSetAll(commit.Id)
+		require.NoError(t, err)
+
+		jobInfo, err := c.InspectJob(pfs.DefaultProjectName, pipelineName, commit.Id, false)
+		require.NoError(t, err)
+		require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+		require.Equal(t, int64(1), jobInfo.DataProcessed)
+
+		outputCommit := client.NewCommit(pfs.DefaultProjectName, pipelineName, "master", commit.Id)
+		fis, err := c.ListFileAll(outputCommit, "/")
+		require.NoError(t, err)
+		require.Equal(t, i+1, len(fis))
+	}
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(client.NewCommit(pfs.DefaultProjectName, pipelineName, "master", ""), "a", &buf))
+	require.Equal(t, "a", buf.String())
+}
+
 func TestPipelineWithExistingInputCommits(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
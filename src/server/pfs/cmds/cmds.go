@@ -18,9 +18,11 @@ import (
 	"time"
 
 	prompt "github.com/c-bata/go-prompt"
+	units "github.com/docker/go-units"
 	"github.com/mattn/go-isatty"
 	"github.com/spf13/cobra"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/pachyderm/pachyderm/v2/src/debug"
@@ -34,6 +36,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/pager"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsload"
 	"github.com/pachyderm/pachyderm/v2/src/internal/progress"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serde"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tabwriter"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tarutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
@@ -50,11 +53,15 @@ const (
 	DefaultParallelism = 10
 
 	// Plural variables are used below for user convenience.
-	branches = "branches"
-	commits  = "commits"
-	files    = "files"
-	repos    = "repos"
-	projects = "projects"
+	branches     = "branches"
+	commits      = "commits"
+	files        = "files"
+	repos        = "repos"
+	projects     = "projects"
+	replications = "replications"
+	retentions   = "retentions"
+	validations  = "validations"
+	placements   = "placements"
 )
 
 // Cmds returns a slice containing pfs commands.
@@ -430,6 +437,7 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 	shell.RegisterCompletionFunc(startCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAliases(startCommit, "start commit", commits))
 
+	var checkpoint string
 	finishCommit := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>",
 		Short: "Finish a started commit.",
@@ -437,12 +445,14 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 			"\n" +
 			"\t- To force finish a commit, use the `--force` flag \n" +
 			"\t- To add a message to the commit, use the `--message` or `--description` flag \n" +
+			"\t- To record how far the commit's data goes, such as the offset of the last message a spout wrote, use the `--checkpoint` flag \n" +
 			"\t- To specify which project the repo is in, use the `--project` flag \n",
 		Example: "\t- {{alias}} foo@master \n" +
 			"\t- {{alias}} foo@master --force \n" +
 			"\t- {{alias}} foo@master --project bar" +
 			"\t- {{alias}} foo@master --message 'my commit message' \n" +
-			"\t- {{alias}} foo@master --description 'my commit description' --project bar \n",
+			"\t- {{alias}} foo@master --description 'my commit description' --project bar \n" +
+			"\t- {{alias}} foo@master --checkpoint 1234 \n",
 
 		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
 			commit, err := cmdutil.ParseCommit(project, args[0])
@@ -462,6 +472,7 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 						Commit:      commit,
 						Description: description,
 						Force:       force,
+						Checkpoint:  checkpoint,
 					},
 				)
 				return errors.EnsureStack(err)
@@ -471,11 +482,53 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 	}
 	finishCommit.Flags().StringVarP(&description, "message", "m", "", "Set a description of this commit's contents; overwrites existing commit description (synonym for --description).")
 	finishCommit.Flags().StringVar(&description, "description", "", "Set a description of this commit's contents; overwrites existing commit description (synonym for --message).")
+	finishCommit.Flags().StringVar(&checkpoint, "checkpoint", "", "Record an opaque checkpoint in the commit, which spouts with exactly_once set are passed when they restart.")
 	finishCommit.Flags().BoolVarP(&force, "force", "f", false, "Force finish commit, even if it has provenance, which could break jobs; prefer 'pachctl stop stop job'")
 	finishCommit.Flags().StringVar(&project, "project", project, "Specify the project (by name) where the repo for this commit is located.")
 	shell.RegisterCompletionFunc(finishCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAliases(finishCommit, "finish commit", commits))
 
+	var approvalComment, approvalPrincipal string
+	approveCommit := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch-or-commit>",
+		Short: "Approve a commit.",
+		Long: "This command approves a commit, so that it can fire the branch triggers which require approval. \n" +
+			"If the commit is finished and is the head of its branch, the triggers fire immediately; otherwise they fire when it finishes. \n" +
+			"When auth is activated, approving requires the repoApprover or repoOwner role on the repo. \n" +
+			"\n" +
+			"\t- To record why the commit was approved, use the `--comment` flag \n" +
+			"\t- To name who approved the commit when auth is not activated, use the `--principal` flag \n" +
+			"\t- To specify which project the repo is in, use the `--project` flag \n",
+		Example: "\t- {{alias}} models@candidate \n" +
+			"\t- {{alias}} models@0001a0100b1c10d01111e001fg00h00i --comment 'accuracy checked' \n" +
+			"\t- {{alias}} models@candidate --principal user:alice --project bar \n",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			commit, err := cmdutil.ParseCommit(project, args[0])
+			if err != nil {
+				return err
+			}
+			c, err := newClient(mainCtx, pachctlCfg)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			_, err = c.PfsAPIClient.ApproveCommit(
+				c.Ctx(),
+				&pfs.ApproveCommitRequest{
+					Commit:    commit,
+					Comment:   approvalComment,
+					Principal: approvalPrincipal,
+				},
+			)
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	approveCommit.Flags().StringVarP(&approvalComment, "comment", "c", "", "Set a comment recorded with the approval.")
+	approveCommit.Flags().StringVar(&approvalPrincipal, "principal", "", "Set who approved the commit; required if auth is not activated, and must be the caller if it is.")
+	approveCommit.Flags().StringVar(&project, "project", project, "Specify the project (by name) where the repo for this commit is located.")
+	shell.RegisterCompletionFunc(approveCommit, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAliases(approveCommit, "approve commit", commits))
+
 	inspectCommit := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>",
 		Short: "Return info about a commit.",
@@ -938,6 +991,7 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 			"\t- To attach an existing commit as the head commit of the new branch, use the `--head` flag \n" +
 			"\t- To set a trigger, use the `--trigger` flag, pass in the branch (from same repo, without `repo@`), and set conditions using any of the `--trigger` options \n" +
 			"\t- To require all defined triggering conditions to be met, use the `--trigger-all` flag; otherwise, each condition can execute the trigger \n" +
+			"\t- To require the new head of the trigger branch to be approved with `pachctl approve commit`, use the `--trigger-require-approval` flag \n" +
 			"\t- To attach provenance to the new branch, use the `--provenance` flag. You can inspect provenance using `pachctl inspect branch foo@bar` \n" +
 			"\n" +
 			"Note: Starting a commit on the branch also creates it, so there's often no need to call this.",
@@ -950,6 +1004,7 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 			"\t- {{alias}} foo@master --trigger staging --trigger-size=100M \n" +
 			"\t- {{alias}} foo@master --trigger staging --trigger-cron='@every 1h' \n" +
 			"\t- {{alias}} foo@master --trigger staging --trigger-commits=10' \n" +
+			"\t- {{alias}} foo@production --trigger candidate --trigger-require-approval \n" +
 			"\t- {{alias}} foo@master --trigger staging --trigger-size=100M --trigger-cron='@every 1h --trigger-commits=10 --trigger-all \n",
 		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
 			branch, err := cmdutil.ParseBranch(project, args[0])
@@ -963,7 +1018,7 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 			if len(provenance) != 0 && trigger.Branch != "" {
 				return errors.Errorf("cannot use provenance and triggers on the same branch")
 			}
-			if (trigger.CronSpec != "" || trigger.Size != "" || trigger.Commits != 0) && trigger.Branch == "" {
+			if (trigger.CronSpec != "" || trigger.Size != "" || trigger.Commits != 0 || trigger.RequireApproval) && trigger.Branch == "" {
 				return errors.Errorf("trigger condition specified without a branch to trigger on, specify a branch with --trigger")
 			}
 			if proto.Equal(trigger, &pfs.Trigger{}) {
@@ -1010,6 +1065,7 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 	createBranch.Flags().StringVar(&trigger.Size, "trigger-size", "", "Set data size as a condition for the trigger.")
 	createBranch.Flags().Int64Var(&trigger.Commits, "trigger-commits", 0, "Set the number of commits as a condition for the trigger.")
 	createBranch.Flags().BoolVar(&trigger.All, "trigger-all", false, "Specify that all set conditions must be met for the trigger.")
+	createBranch.Flags().BoolVar(&trigger.RequireApproval, "trigger-require-approval", false, "Specify that the new head of the trigger branch must be approved before the trigger fires.")
 	createBranch.Flags().StringVar(&project, "project", project, "Specify the project (by name) where the repo for this branch is located.")
 	commands = append(commands, cmdutil.CreateAliases(createBranch, "create branch", branches))
 
@@ -1239,41 +1295,103 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 	createProject.Flags().StringVarP(&description, "description", "d", "", "Set a description for the newly-created project.")
 	commands = append(commands, cmdutil.CreateAliases(createProject, "create project", projects))
 
+	var quota, quotaEnforcement, maxCPU, maxMemory, priorityClass string
+	var maxWorkers int64
 	updateProject := &cobra.Command{
-		Use:     "{{alias}} <project>",
-		Short:   "Update a project.",
-		Long:    "This command updates a project's description.",
-		Example: "\t- {{alias}} foo-project --description 'This is a project for foo.' \n",
-		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+		Use:   "{{alias}} <project>",
+		Short: "Update a project.",
+		Long: "This command updates a project's description, storage quota or compute quota. \n" +
+			"\n" +
+			"\t- To set the storage quota of the project, use the `--quota` flag; a quota of 0 removes it \n" +
+			"\t- To fail `finish commit` rather than finishing commits with an error once the quota is exceeded, use `--quota-enforcement hard` \n" +
+			"\t- To limit the workers, CPU or memory that the project's pipelines may use, use the `--max-workers`, `--max-cpu` and `--max-memory` flags; 0 or an empty value removes the limit \n" +
+			"\t- To schedule the project's workers with a Kubernetes priority class, use the `--priority-class` flag \n",
+		Example: "\t- {{alias}} foo-project --description 'This is a project for foo.' \n" +
+			"\t- {{alias}} foo-project --quota 100GB \n" +
+			"\t- {{alias}} foo-project --quota 100GB --quota-enforcement hard \n" +
+			"\t- {{alias}} foo-project --max-workers 20 --max-cpu 16 --max-memory 64Gi \n" +
+			"\t- {{alias}} foo-project --priority-class high-priority \n",
+		Run: cmdutil.RunFixedArgsCmd(1, func(cmd *cobra.Command, args []string) error {
 			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
 			if err != nil {
 				return err
 			}
 			defer c.Close()
-			_, err = c.PfsAPIClient.CreateProject(
-				c.Ctx(),
-				&pfs.CreateProjectRequest{
-					Project:     &pfs.Project{Name: args[0]},
-					Description: description,
-					Update:      true,
-				})
+			req := &pfs.CreateProjectRequest{
+				Project:     &pfs.Project{Name: args[0]},
+				Description: description,
+				Update:      true,
+			}
+			var quotaChanged bool
+			for _, flag := range []string{"quota", "quota-enforcement", "max-workers", "max-cpu", "max-memory", "priority-class"} {
+				quotaChanged = quotaChanged || cmd.Flags().Changed(flag)
+			}
+			if quotaChanged {
+				pi, err := c.PfsAPIClient.InspectProject(c.Ctx(), &pfs.InspectProjectRequest{Project: req.Project})
+				if err != nil {
+					return grpcutil.ScrubGRPC(err)
+				}
+				if !cmd.Flags().Changed("description") {
+					req.Description = pi.Description
+				}
+				req.Quota = &pfs.ProjectQuota{}
+				if pi.Quota != nil {
+					req.Quota = proto.Clone(pi.Quota).(*pfs.ProjectQuota)
+				}
+				if cmd.Flags().Changed("quota") {
+					maxBytes, err := units.FromHumanSize(quota)
+					if err != nil {
+						return errors.Wrapf(err, "invalid quota %q", quota)
+					}
+					req.Quota.MaxBytes = maxBytes
+				}
+				if cmd.Flags().Changed("quota-enforcement") {
+					enforcement, ok := pfs.ProjectQuota_Enforcement_value[strings.ToUpper(quotaEnforcement)]
+					if !ok {
+						return errors.Errorf("invalid quota enforcement %q, must be soft or hard", quotaEnforcement)
+					}
+					req.Quota.Enforcement = pfs.ProjectQuota_Enforcement(enforcement)
+				}
+				if cmd.Flags().Changed("max-workers") {
+					req.Quota.MaxWorkers = maxWorkers
+				}
+				if cmd.Flags().Changed("max-cpu") {
+					req.Quota.MaxCpu = maxCPU
+				}
+				if cmd.Flags().Changed("max-memory") {
+					req.Quota.MaxMemory = maxMemory
+				}
+				if cmd.Flags().Changed("priority-class") {
+					req.Quota.PriorityClassName = priorityClass
+				}
+			}
+			_, err = c.PfsAPIClient.CreateProject(c.Ctx(), req)
 			return grpcutil.ScrubGRPC(err)
 
 		}),
 	}
 	updateProject.Flags().StringVarP(&description, "description", "d", "", "Set a new description of the updated project.")
+	updateProject.Flags().StringVar(&quota, "quota", "", "Set the storage quota of the project (e.g. 100GB), or 0 to remove it.")
+	updateProject.Flags().StringVar(&quotaEnforcement, "quota-enforcement", "soft", "Set how the storage quota is enforced (soft or hard).")
+	updateProject.Flags().Int64Var(&maxWorkers, "max-workers", 0, "Set the maximum number of workers that the project's pipelines may run at once, or 0 to remove the limit.")
+	updateProject.Flags().StringVar(&maxCPU, "max-cpu", "", "Set the maximum CPU that the project's workers may request (e.g. 16).")
+	updateProject.Flags().StringVar(&maxMemory, "max-memory", "", "Set the maximum memory that the project's workers may request (e.g. 64Gi).")
+	updateProject.Flags().StringVar(&priorityClass, "priority-class", "", "Set the Kubernetes priority class of the project's workers.")
 	shell.RegisterCompletionFunc(updateProject, shell.ProjectCompletion)
 	commands = append(commands, cmdutil.CreateAliases(updateProject, "update project", projects))
 
+	var usage bool
 	inspectProject := &cobra.Command{
 		Use:   "{{alias}} <project>",
 		Short: "Inspect a project.",
 		Long: "This command inspects a project and returns information like its `Name` and `Created at` time. \n" +
 			"\n" +
-			"\t- To return additional details, use the `--raw` flag \n",
+			"\t- To return additional details, use the `--raw` flag \n" +
+			"\t- To return the storage and compute resources used by the project, use the `--usage` flag \n",
 
 		Example: "\t- {{alias}} foo-project \n" +
 			"\t- {{alias}} foo-project --raw \n" +
+			"\t- {{alias}} foo-project --usage \n" +
 			"\t- {{alias}} foo-project --output=yaml \n",
 
 		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
@@ -1286,6 +1404,7 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 				c.Ctx(),
 				&pfs.InspectProjectRequest{
 					Project: &pfs.Project{Name: args[0]},
+					Details: usage,
 				})
 			if err != nil {
 				return grpcutil.ScrubGRPC(err)
@@ -1295,10 +1414,18 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 			} else if output != "" {
 				return errors.New("cannot set --output (-o) without --raw")
 			}
-			return pretty.PrintDetailedProjectInfo(pi)
+			ppi := pretty.NewPrintableProjectInfo(pi)
+			if usage {
+				ppi.ComputeUsage, err = c.PpsAPIClient.GetProjectComputeUsage(c.Ctx(), &pps.GetProjectComputeUsageRequest{Project: pi.Project})
+				if err != nil {
+					return grpcutil.ScrubGRPC(err)
+				}
+			}
+			return pretty.PrintDetailedProjectInfo(ppi)
 		}),
 	}
 	inspectProject.Flags().AddFlagSet(outputFlags)
+	inspectProject.Flags().BoolVar(&usage, "usage", false, "Return the storage and compute resources used by the project.")
 	shell.RegisterCompletionFunc(inspectProject, shell.ProjectCompletion)
 	commands = append(commands, cmdutil.CreateAliases(inspectProject, "inspect project", projects))
 
@@ -1432,6 +1559,621 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 	deleteProject.Flags().BoolVarP(&force, "force", "f", false, "Force delete the project regardless of errors; use with caution.")
 	commands = append(commands, cmdutil.CreateAliases(deleteProject, "delete project", projects))
 
+	// REPLICATION COMMANDS
+
+	replicationDocs := &cobra.Command{
+		Short: "Docs for replications.",
+		Long: "Replications continuously copy the commits on a set of branches to another Pachyderm cluster. \n" +
+			"Only the chunks that the target cluster does not already have are transferred.",
+	}
+	commands = append(commands, cmdutil.CreateDocsAliases(replicationDocs, "replication", " replication", replications))
+
+	var replicationTarget, replicationSecret string
+	var replicationBranches []string
+	replicationRequest := func(name string, update bool) (*pfs.CreateReplicationRequest, error) {
+		req := &pfs.CreateReplicationRequest{
+			Replication: &pfs.Replication{Name: name},
+			Target:      &pfs.ReplicationTarget{PachdAddress: replicationTarget},
+			Update:      update,
+		}
+		if replicationSecret != "" {
+			secretName, key, ok := strings.Cut(replicationSecret, ":")
+			if !ok {
+				return nil, errors.Errorf("invalid secret %q, expected <name>:<key>", replicationSecret)
+			}
+			req.Target.AuthTokenSecret = &pfs.ReplicationTarget_Secret{Name: secretName, Key: key}
+		}
+		branches, err := cmdutil.ParseBranches(project, replicationBranches)
+		if err != nil {
+			return nil, err
+		}
+		req.Branches = branches
+		return req, nil
+	}
+
+	createReplication := &cobra.Command{
+		Use:   "{{alias}} <replication>",
+		Short: "Create a new replication.",
+		Long: "This command creates a replication which copies every commit finished on the given branches to the target cluster. \n" +
+			"\n" +
+			"\t- To specify the target cluster, use the `--target` flag \n" +
+			"\t- To specify a branch to replicate, use the `--branch` flag; it can be repeated \n" +
+			"\t- To authenticate with the target cluster, use the `--secret` flag to name a Kubernetes secret and key holding an auth token \n",
+		Example: "\t- {{alias}} dr --target grpcs://pachd.example.com:30650 --branch images@master \n" +
+			"\t- {{alias}} dr --target grpcs://pachd.example.com:30650 --branch images@master --branch labels@master --secret dr-token:auth-token \n",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			req, err := replicationRequest(args[0], false)
+			if err != nil {
+				return err
+			}
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			_, err = c.PfsAPIClient.CreateReplication(c.Ctx(), req)
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	createReplication.Flags().StringVar(&replicationTarget, "target", "", "Specify the address of the target pachd.")
+	createReplication.Flags().StringArrayVarP(&replicationBranches, "branch", "b", nil, "Specify a branch to replicate, in the form <repo>@<branch>.")
+	createReplication.Flags().StringVar(&replicationSecret, "secret", "", "Specify the Kubernetes secret holding the target auth token, in the form <name>:<key>.")
+	createReplication.Flags().StringVar(&project, "project", project, "Specify the project (by name) containing the branches.")
+	commands = append(commands, cmdutil.CreateAliases(createReplication, "create replication", replications))
+
+	updateReplication := &cobra.Command{
+		Use:   "{{alias}} <replication>",
+		Short: "Update a replication.",
+		Long: "This command updates the target and branches of a replication. \n" +
+			"Branches which are still replicated after the update resume from their last replicated commit.",
+		Example: "\t- {{alias}} dr --target grpcs://pachd.example.com:30650 --branch images@master --branch labels@master \n",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			req, err := replicationRequest(args[0], true)
+			if err != nil {
+				return err
+			}
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			_, err = c.PfsAPIClient.CreateReplication(c.Ctx(), req)
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	updateReplication.Flags().StringVar(&replicationTarget, "target", "", "Specify the address of the target pachd.")
+	updateReplication.Flags().StringArrayVarP(&replicationBranches, "branch", "b", nil, "Specify a branch to replicate, in the form <repo>@<branch>.")
+	updateReplication.Flags().StringVar(&replicationSecret, "secret", "", "Specify the Kubernetes secret holding the target auth token, in the form <name>:<key>.")
+	updateReplication.Flags().StringVar(&project, "project", project, "Specify the project (by name) containing the branches.")
+	commands = append(commands, cmdutil.CreateAliases(updateReplication, "update replication", replications))
+
+	inspectReplication := &cobra.Command{
+		Use:   "{{alias}} <replication>",
+		Short: "Inspect a replication.",
+		Long:  "This command returns the target and branches of a replication, along with the replication status of each branch.",
+		Example: "\t- {{alias}} dr \n" +
+			"\t- {{alias}} dr --raw \n",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			ri, err := c.PfsAPIClient.InspectReplication(
+				c.Ctx(),
+				&pfs.InspectReplicationRequest{
+					Replication: &pfs.Replication{Name: args[0]},
+				})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				return errors.EnsureStack(cmdutil.Encoder(output, os.Stdout).EncodeProto(ri))
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			return pretty.PrintDetailedReplicationInfo(ri)
+		}),
+	}
+	inspectReplication.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAliases(inspectReplication, "inspect replication", replications))
+
+	listReplication := &cobra.Command{
+		Use:   "{{alias}}",
+		Short: "Return all replications.",
+		Long:  "This command returns all replications.",
+		Example: "\t- {{alias}} \n" +
+			"\t- {{alias}} --raw \n",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			client, err := c.PfsAPIClient.ListReplication(c.Ctx(), &pfs.ListReplicationRequest{})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			ris, err := grpcutil.Collect[*pfs.ReplicationInfo](client, 1000)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				encoder := cmdutil.Encoder(output, os.Stdout)
+				for _, ri := range ris {
+					if err := encoder.EncodeProto(ri); err != nil {
+						return errors.EnsureStack(err)
+					}
+				}
+				return nil
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.ReplicationHeader)
+			for _, ri := range ris {
+				pretty.PrintReplicationInfo(writer, ri)
+			}
+			return writer.Flush()
+		}),
+	}
+	listReplication.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAliases(listReplication, "list replication", replications))
+
+	deleteReplication := &cobra.Command{
+		Use:   "{{alias}} <replication>",
+		Short: "Delete a replication.",
+		Long: "This command stops and deletes a replication. \n" +
+			"Data which has already been replicated is left on the target cluster.",
+		Example: "\t- {{alias}} dr \n",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			_, err = c.PfsAPIClient.DeleteReplication(
+				c.Ctx(),
+				&pfs.DeleteReplicationRequest{
+					Replication: &pfs.Replication{Name: args[0]},
+				})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAliases(deleteReplication, "delete replication", replications))
+
+	// RETENTION COMMANDS
+
+	retentionDocs := &cobra.Command{
+		Short: "Docs for retention policies.",
+		Long: "Retention policies determine which commits are kept on the branches of a repo. \n" +
+			"Commits which are not kept by any policy are periodically squashed. \n" +
+			"A policy set on a branch takes precedence over the policy set on its repo.",
+	}
+	commands = append(commands, cmdutil.CreateDocsAliases(retentionDocs, "retention", " retention", retentions))
+
+	var keepLast uint32
+	var keepFor, keepDailyAfter time.Duration
+	createRetention := &cobra.Command{
+		Use:   "{{alias}} <repo>[@<branch>]",
+		Short: "Create or replace the retention policy of a repo or branch.",
+		Long: "This command sets the retention policy of a repo, or of a single branch if one is given. \n" +
+			"A commit is kept if any of the rules keep it, and the head of a branch is always kept. \n" +
+			"\n" +
+			"\t- To keep the most recent commits on each branch, use the `--keep-last` flag \n" +
+			"\t- To keep commits younger than a duration, use the `--keep-for` flag \n" +
+			"\t- To keep one commit per day for commits older than a duration, use the `--keep-daily-after` flag \n",
+		Example: "\t- {{alias}} raw --keep-last 10 \n" +
+			"\t- {{alias}} raw@master --keep-for 168h --keep-daily-after 720h \n",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			branch, err := cmdutil.ParseBranch(project, args[0])
+			if err != nil {
+				return err
+			}
+			policy := &pfs.RetentionPolicy{KeepLast: keepLast}
+			if keepFor != 0 {
+				policy.KeepFor = durationpb.New(keepFor)
+			}
+			if keepDailyAfter != 0 {
+				policy.KeepDailyAfter = durationpb.New(keepDailyAfter)
+			}
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			_, err = c.PfsAPIClient.SetRetentionPolicy(
+				c.Ctx(),
+				&pfs.SetRetentionPolicyRequest{
+					Repo:   branch.Repo,
+					Branch: branch.Name,
+					Policy: policy,
+				})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	createRetention.Flags().Uint32Var(&keepLast, "keep-last", 0, "Keep the given number of most recent commits on each branch.")
+	createRetention.Flags().DurationVar(&keepFor, "keep-for", 0, "Keep commits created less than the given duration ago.")
+	createRetention.Flags().DurationVar(&keepDailyAfter, "keep-daily-after", 0, "Keep commits created less than the given duration ago, and the most recent commit of each day for older commits.")
+	createRetention.Flags().StringVar(&project, "project", project, "Specify the project (by name) containing the repo.")
+	shell.RegisterCompletionFunc(createRetention, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAliases(createRetention, "create retention", retentions))
+
+	listRetention := &cobra.Command{
+		Use:   "{{alias}} [<repo>]",
+		Short: "Return the retention policies.",
+		Long:  "This command returns all retention policies, or the retention policies of a repo and its branches.",
+		Example: "\t- {{alias}} \n" +
+			"\t- {{alias}} raw \n",
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			req := &pfs.ListRetentionPolicyRequest{}
+			if len(args) == 1 {
+				req.Repo = cmdutil.ParseRepo(project, args[0])
+			}
+			client, err := c.PfsAPIClient.ListRetentionPolicy(c.Ctx(), req)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			infos, err := grpcutil.Collect[*pfs.RetentionPolicyInfo](client, 1000)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				encoder := cmdutil.Encoder(output, os.Stdout)
+				for _, info := range infos {
+					if err := encoder.EncodeProto(info); err != nil {
+						return errors.EnsureStack(err)
+					}
+				}
+				return nil
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.RetentionPolicyHeader)
+			for _, info := range infos {
+				pretty.PrintRetentionPolicyInfo(writer, info)
+			}
+			return writer.Flush()
+		}),
+	}
+	listRetention.Flags().AddFlagSet(outputFlags)
+	listRetention.Flags().StringVar(&project, "project", project, "Specify the project (by name) containing the repo.")
+	shell.RegisterCompletionFunc(listRetention, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAliases(listRetention, "list retention", retentions))
+
+	deleteRetention := &cobra.Command{
+		Use:   "{{alias}} <repo>[@<branch>]",
+		Short: "Delete the retention policy of a repo or branch.",
+		Long:  "This command deletes the retention policy of a repo, or of a single branch if one is given.",
+		Example: "\t- {{alias}} raw \n" +
+			"\t- {{alias}} raw@master \n",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			branch, err := cmdutil.ParseBranch(project, args[0])
+			if err != nil {
+				return err
+			}
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			_, err = c.PfsAPIClient.SetRetentionPolicy(
+				c.Ctx(),
+				&pfs.SetRetentionPolicyRequest{
+					Repo:   branch.Repo,
+					Branch: branch.Name,
+				})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	deleteRetention.Flags().StringVar(&project, "project", project, "Specify the project (by name) containing the repo.")
+	shell.RegisterCompletionFunc(deleteRetention, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAliases(deleteRetention, "delete retention", retentions))
+
+	var dryRun bool
+	enforceRetention := &cobra.Command{
+		Use:   "{{alias}} [<repo>]",
+		Short: "Squash the commits which are not kept by retention policies.",
+		Long: "This command enforces retention policies immediately, rather than waiting for pachd to enforce them. \n" +
+			"Commit sets which contain commits kept by a policy, or commits which are not finished, are not squashed. \n" +
+			"\n" +
+			"\t- To only enforce the policies of one repo, pass the repo \n" +
+			"\t- To report which commits would be squashed without squashing them, use the `--dry-run` flag \n",
+		Example: "\t- {{alias}} \n" +
+			"\t- {{alias}} raw --dry-run \n",
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			req := &pfs.EnforceRetentionPolicyRequest{DryRun: dryRun}
+			if len(args) == 1 {
+				req.Repo = cmdutil.ParseRepo(project, args[0])
+			}
+			client, err := c.PfsAPIClient.EnforceRetentionPolicy(c.Ctx(), req)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				encoder := cmdutil.Encoder(output, os.Stdout)
+				return grpcutil.ForEach[*pfs.RetentionAction](client, func(action *pfs.RetentionAction) error {
+					return errors.EnsureStack(encoder.EncodeProto(action))
+				})
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.RetentionActionHeader)
+			if err := grpcutil.ForEach[*pfs.RetentionAction](client, func(action *pfs.RetentionAction) error {
+				pretty.PrintRetentionAction(writer, action)
+				return nil
+			}); err != nil {
+				return err
+			}
+			return writer.Flush()
+		}),
+	}
+	enforceRetention.Flags().BoolVar(&dryRun, "dry-run", false, "Report which commits would be squashed without squashing them.")
+	enforceRetention.Flags().AddFlagSet(outputFlags)
+	enforceRetention.Flags().StringVar(&project, "project", project, "Specify the project (by name) containing the repo.")
+	shell.RegisterCompletionFunc(enforceRetention, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAliases(enforceRetention, "enforce retention", retentions))
+
+	// VALIDATION COMMANDS
+
+	validationDocs := &cobra.Command{
+		Short: "Docs for commit validations.",
+		Long: "A commit validation is a contract that the files of every commit to a repo must satisfy. \n" +
+			"It's checked when a commit finishes, and a commit which violates it fails with the violation as its error, \n" +
+			"so it isn't processed by downstream pipelines and doesn't fire branch triggers. \n" +
+			"\n" +
+			"A validation can also name a validator pipeline which takes the repo as input.  A commit then waits for the \n" +
+			"validator's job in its commit set, and fails if the job fails.  Branches which trigger on the repo's branches \n" +
+			"aren't moved to the commit until the job succeeds, so pipelines which should only process validated commits \n" +
+			"take such a branch as input.",
+	}
+	commands = append(commands, cmdutil.CreateDocsAliases(validationDocs, "validation", " validation", validations))
+
+	var contractPath, validator string
+	createValidation := &cobra.Command{
+		Use:   "{{alias}} <repo> [-f <contract>] [--validator <pipeline>]",
+		Short: "Create or replace the commit validation of a repo.",
+		Long: "This command sets the contract that commits to a repo must satisfy, from a JSON or YAML file. \n" +
+			"The contract can bound the number and size of the files in a commit, and constrain the files matching globs: \n" +
+			"\n" +
+			"\t- `required` requires at least one file to match the glob \n" +
+			"\t- `minFiles`, `maxFiles`, `minFileBytes` and `maxFileBytes` bound the matching files \n" +
+			"\t- `jsonSchema` is a JSON Schema that every record of the matching files must satisfy \n" +
+			"\t- `table` is the columns that matching CSV, JSON or Parquet files must have \n" +
+			"\n" +
+			"The commits can also be checked by a validator pipeline, which must take the repo as input. \n",
+		Example: "\t- {{alias}} raw -f contract.yaml \n" +
+			"\t- {{alias}} raw --project foo -f contract.json \n" +
+			"\t- {{alias}} raw -f contract.yaml --validator check-raw \n",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			repo := cmdutil.ParseRepo(project, args[0])
+			if contractPath == "" && validator == "" {
+				return errors.New("a contract (--file/-f) or a validator (--validator) must be given")
+			}
+			req := &pfs.SetCommitValidationRequest{Repo: repo}
+			if contractPath != "" {
+				data, err := os.ReadFile(contractPath)
+				if err != nil {
+					return errors.EnsureStack(err)
+				}
+				req.Contract = &pfs.ContentContract{}
+				if err := serde.Decode(data, req.Contract); err != nil {
+					return errors.Wrapf(err, "parse contract %s", contractPath)
+				}
+			}
+			if validator != "" {
+				req.Validator = cmdutil.ParseRepo(project, validator)
+			}
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			_, err = c.PfsAPIClient.SetCommitValidation(c.Ctx(), req)
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	createValidation.Flags().StringVarP(&contractPath, "file", "f", "", "Specify the JSON or YAML file containing the contract.")
+	createValidation.Flags().StringVar(&validator, "validator", "", "Specify the validator pipeline, in the repo's project, which must accept each commit.")
+	createValidation.Flags().StringVar(&project, "project", project, "Specify the project (by name) containing the repo.")
+	shell.RegisterCompletionFunc(createValidation, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAliases(createValidation, "create validation", validations))
+
+	listValidation := &cobra.Command{
+		Use:   "{{alias}} [<repo>]",
+		Short: "Return the commit validations.",
+		Long:  "This command returns all commit validations, or the commit validation of a repo.",
+		Example: "\t- {{alias}} \n" +
+			"\t- {{alias}} raw --raw -o yaml \n",
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			req := &pfs.ListCommitValidationRequest{}
+			if len(args) == 1 {
+				req.Repo = cmdutil.ParseRepo(project, args[0])
+			}
+			client, err := c.PfsAPIClient.ListCommitValidation(c.Ctx(), req)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			infos, err := grpcutil.Collect[*pfs.CommitValidationInfo](client, 1000)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				encoder := cmdutil.Encoder(output, os.Stdout)
+				for _, info := range infos {
+					if err := encoder.EncodeProto(info); err != nil {
+						return errors.EnsureStack(err)
+					}
+				}
+				return nil
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.CommitValidationHeader)
+			for _, info := range infos {
+				pretty.PrintCommitValidationInfo(writer, info)
+			}
+			return writer.Flush()
+		}),
+	}
+	listValidation.Flags().AddFlagSet(outputFlags)
+	listValidation.Flags().StringVar(&project, "project", project, "Specify the project (by name) containing the repo.")
+	shell.RegisterCompletionFunc(listValidation, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAliases(listValidation, "list validation", validations))
+
+	deleteValidation := &cobra.Command{
+		Use:     "{{alias}} <repo>",
+		Short:   "Delete the commit validation of a repo.",
+		Long:    "This command deletes the commit validation of a repo.  Commits which have already failed it stay failed.",
+		Example: "\t- {{alias}} raw \n",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			_, err = c.PfsAPIClient.SetCommitValidation(
+				c.Ctx(),
+				&pfs.SetCommitValidationRequest{
+					Repo: cmdutil.ParseRepo(project, args[0]),
+				})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	deleteValidation.Flags().StringVar(&project, "project", project, "Specify the project (by name) containing the repo.")
+	shell.RegisterCompletionFunc(deleteValidation, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAliases(deleteValidation, "delete validation", validations))
+
+	// PLACEMENT COMMANDS
+
+	placementDocs := &cobra.Command{
+		Short: "Docs for storage placements.",
+		Long: "A storage placement puts the chunks of a repo's commits on storage tiers, the object storage backends configured with STORAGE_TIERS. \n" +
+			"A repo can be placed on one tier, and the commits older than a threshold on another, cold tier. \n" +
+			"Chunks are moved between tiers in the background, and chunks shared with commits placed on other tiers stay on the default tier.",
+	}
+	commands = append(commands, cmdutil.CreateDocsAliases(placementDocs, "placement", " placement", placements))
+
+	var tier, coldTier string
+	var coldAfter time.Duration
+	createPlacement := &cobra.Command{
+		Use:   "{{alias}} <repo>",
+		Short: "Create or replace the storage placement of a repo.",
+		Long:  "This command sets the storage tiers that the chunks of a repo's commits are placed on.",
+		Example: "\t- {{alias}} raw --tier fast \n" +
+			"\t- {{alias}} raw --cold-tier archive --cold-after 720h \n",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			if tier == "" && coldTier == "" {
+				return errors.New("at least one of --tier and --cold-tier must be set")
+			}
+			req := &pfs.SetStoragePlacementRequest{
+				Repo:     cmdutil.ParseRepo(project, args[0]),
+				Tier:     tier,
+				ColdTier: coldTier,
+			}
+			if coldTier != "" {
+				req.ColdAfter = durationpb.New(coldAfter)
+			}
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			_, err = c.PfsAPIClient.SetStoragePlacement(c.Ctx(), req)
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	createPlacement.Flags().StringVar(&tier, "tier", "", "Specify the tier that the repo's chunks are placed on, the default tier if unset.")
+	createPlacement.Flags().StringVar(&coldTier, "cold-tier", "", "Specify the tier that the chunks of old commits are placed on.")
+	createPlacement.Flags().DurationVar(&coldAfter, "cold-after", 0, "Specify how long after they're started commits are placed on the cold tier.")
+	createPlacement.Flags().StringVar(&project, "project", project, "Specify the project (by name) containing the repo.")
+	shell.RegisterCompletionFunc(createPlacement, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAliases(createPlacement, "create placement", placements))
+
+	listPlacement := &cobra.Command{
+		Use:   "{{alias}} [<repo>]",
+		Short: "Return the storage placements.",
+		Long:  "This command returns all storage placements, or the storage placement of a repo.",
+		Example: "\t- {{alias}} \n" +
+			"\t- {{alias}} raw --raw -o yaml \n",
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			req := &pfs.ListStoragePlacementRequest{}
+			if len(args) == 1 {
+				req.Repo = cmdutil.ParseRepo(project, args[0])
+			}
+			client, err := c.PfsAPIClient.ListStoragePlacement(c.Ctx(), req)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			infos, err := grpcutil.Collect[*pfs.StoragePlacementInfo](client, 1000)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				encoder := cmdutil.Encoder(output, os.Stdout)
+				for _, info := range infos {
+					if err := encoder.EncodeProto(info); err != nil {
+						return errors.EnsureStack(err)
+					}
+				}
+				return nil
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.StoragePlacementHeader)
+			for _, info := range infos {
+				pretty.PrintStoragePlacementInfo(writer, info)
+			}
+			return writer.Flush()
+		}),
+	}
+	listPlacement.Flags().AddFlagSet(outputFlags)
+	listPlacement.Flags().StringVar(&project, "project", project, "Specify the project (by name) containing the repo.")
+	shell.RegisterCompletionFunc(listPlacement, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAliases(listPlacement, "list placement", placements))
+
+	deletePlacement := &cobra.Command{
+		Use:     "{{alias}} <repo>",
+		Short:   "Delete the storage placement of a repo.",
+		Long:    "This command deletes the storage placement of a repo.  Its chunks are moved back to the default tier in the background.",
+		Example: "\t- {{alias}} raw \n",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			_, err = c.PfsAPIClient.SetStoragePlacement(
+				c.Ctx(),
+				&pfs.SetStoragePlacementRequest{
+					Repo: cmdutil.ParseRepo(project, args[0]),
+				})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	deletePlacement.Flags().StringVar(&project, "project", project, "Specify the project (by name) containing the repo.")
+	shell.RegisterCompletionFunc(deletePlacement, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAliases(deletePlacement, "delete placement", placements))
+
 	// FILE COMMANDS
 
 	fileDocs := &cobra.Command{
@@ -1450,6 +2192,7 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 	var enableProgress bool
 	var fullPath bool
 	var untar bool
+	var resume bool
 	putFile := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>[:<path/to/file>]",
 		Short: "Put a file into the filesystem.",
@@ -1467,6 +2210,7 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 			"\t- To compress files before uploading, use the `-c` flag \n" +
 			"\t- To define the maximum number of files that can be uploaded in parallel, use the `-p` flag \n" +
 			"\t- To append to an existing file, use the `-a` flag \n" +
+			"\t- To upload a large file in parts that can be resumed if interrupted, use the `--resume` flag \n" +
 			"\n" +
 			"Other: \n" +
 			"\t- To enable progress bars, use the `-P` flag \n",
@@ -1478,10 +2222,12 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 			"\t- {{alias}} repo@branch -f http://host/example.png \n" +
 			"\t- {{alias}} repo@branch:/dir -f http://host/example.png \n" +
 			"\t- {{alias}} repo@branch -r -f s3://my_bucket \n" +
+			"\t- {{alias}} repo@branch -r -f sftp://user@host/dir?secret=my-secret \n" +
 			"\t- {{alias}} repo@branch -i file \n" +
 			"\t- {{alias}} repo@branch -i http://host/path \n" +
 			"\t- {{alias}} repo@branch -f -untar dir.tar \n" +
-			"\t- {{alias}} repo@branch -f -c image.png \n",
+			"\t- {{alias}} repo@branch -f -c image.png \n" +
+			"\t- {{alias}} repo@branch --resume -f large.bin \n",
 
 		Run: cmdutil.RunFixedArgs(1, func(args []string) (retErr error) {
 			if !enableProgress {
@@ -1554,6 +2300,23 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 				sources = filePaths
 			}
 
+			if resume {
+				if len(sources) != 1 || sources[0] == "-" || recursive || untar {
+					return errors.Errorf("--resume requires a single local file and cannot be used with stdin, -r or --untar")
+				}
+				if url, err := url.Parse(sources[0]); err == nil && url.Scheme != "" {
+					return errors.Errorf("--resume requires a local file, not a URL")
+				}
+				target := file.Path
+				if target == "" {
+					target = sources[0]
+					if !fullPath {
+						target = filepath.Base(sources[0])
+					}
+				}
+				return putFileResumable(c, file.Commit.NewFile(joinPaths("", target)), sources[0], appendFile)
+			}
+
 			return c.WithModifyFileClient(file.Commit, func(mf client.ModifyFile) error {
 				for _, source := range sources {
 					source := source
@@ -1600,6 +2363,7 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 	putFile.Flags().BoolVar(&enableProgress, "progress", isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd()), "Print progress bars.")
 	putFile.Flags().BoolVar(&fullPath, "full-path", false, "Specify entire path provided to -f should be the target filename in PFS; by default only the base of the path is used.")
 	putFile.Flags().BoolVar(&untar, "untar", false, "Specify file(s) with the extension .tar should be untarred and put as a separate file for each file within the tar stream(s); gzipped (.tar.gz or .tgz) tar file(s) are handled as well")
+	putFile.Flags().BoolVar(&resume, "resume", false, "Upload a single local file in parts through an upload session; if the upload is interrupted, running the same command again uploads only the missing parts.")
 	putFile.Flags().StringVar(&project, "project", project, "Specify the project (by name) where the repo for uploading this file is located.")
 	shell.RegisterCompletionFunc(putFile,
 		func(flag, text string, maxCompletions int64) ([]prompt.Suggest, shell.CacheFunc) {
@@ -1777,6 +2541,59 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 	shell.RegisterCompletionFunc(getFile, shell.FileCompletion)
 	commands = append(commands, cmdutil.CreateAliases(getFile, "get file", files))
 
+	var deleteExtra bool
+	syncCmd := &cobra.Command{
+		Use:   "{{alias}} (<local-dir> <repo>@<branch>[:<path>] | <repo>@<branch-or-commit>[:<path>] <local-dir>)",
+		Short: "Synchronize a local directory with a directory in PFS.",
+		Long: "This command synchronizes a local directory with a directory in PFS, transferring only the files that are new or have changed. " +
+			"If the first argument is an existing local directory, its files are uploaded to PFS in a single commit; otherwise the files in PFS are downloaded to the local directory. " +
+			"Files are compared by size and by the hash that PFS stores for them, so unchanged files are never transferred. \n" +
+			"\n" +
+			"\t- To delete files from the destination that are not in the source, use the --delete flag \n" +
+			"\t- To specify the project where the repo is located, use the --project flag \n",
+		Example: "\t- {{alias}} ./data repo@master \n" +
+			"\t- {{alias}} ./data repo@master:/data --delete \n" +
+			"\t- {{alias}} repo@master:/data ./data \n" +
+			"\t- {{alias}} repo@0001a0100b1c10d01111e001fg00h00i:/data ./data --delete \n",
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			if !enableProgress {
+				progress.Disable()
+			}
+			upload := false
+			localDir, pfsPath := args[1], args[0]
+			if fi, err := os.Stat(args[0]); err == nil && fi.IsDir() {
+				upload = true
+				localDir, pfsPath = args[0], args[1]
+			}
+			file, err := cmdutil.ParseFile(project, pfsPath)
+			if err != nil {
+				return err
+			}
+			c, err := newClient(mainCtx, pachctlCfg)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			var plan *syncPlan
+			if upload {
+				plan, err = syncUp(c, localDir, file, deleteExtra)
+			} else {
+				plan, err = syncDown(c, file, localDir, deleteExtra)
+			}
+			if err != nil {
+				return err
+			}
+			progress.Wait()
+			fmt.Printf("%d files transferred, %d files deleted\n", len(plan.transfer), len(plan.remove))
+			return nil
+		}),
+	}
+	syncCmd.Flags().BoolVar(&deleteExtra, "delete", false, "Delete files from the destination that do not exist in the source.")
+	syncCmd.Flags().BoolVar(&enableProgress, "progress", isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd()), "Print progress bars.")
+	syncCmd.Flags().StringVar(&project, "project", project, "Specify the project (by name) where the repo is located.")
+	shell.RegisterCompletionFunc(syncCmd, shell.FileCompletion)
+	commands = append(commands, cmdutil.CreateAlias(syncCmd, "sync"))
+
 	inspectFile := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>:<path/in/pfs>",
 		Short: "Return info about a file.",
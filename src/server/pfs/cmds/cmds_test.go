@@ -293,6 +293,49 @@ func TestGetFileError(t *testing.T) {
 	checkError("master", "/dir/*", "Try again with the -r flag")
 }
 
+func TestSync(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	ctx := pctx.TestContext(t)
+	env := realenv.NewRealEnv(ctx, t, dockertestenv.NewTestDBConfig(t))
+	mockInspectCluster(env)
+	c := env.PachClient
+	require.NoError(t, tu.PachctlBashCmd(t, c, `
+		pachctl create repo {{.repo}}
+		src=$(mktemp -d)
+		mkdir -p "${src}/dir"
+		echo foo >"${src}/foo"
+		echo bar >"${src}/dir/bar"
+		echo baz >"${src}/dir/baz"
+		pachctl sync "${src}" {{.repo}}@master:/data --progress=false \
+		  | match "3 files transferred, 0 files deleted"
+
+		# Unchanged files are not uploaded again.
+		pachctl sync "${src}" {{.repo}}@master:/data --progress=false \
+		  | match "0 files transferred, 0 files deleted"
+
+		echo changed >"${src}/foo"
+		rm "${src}/dir/baz"
+		pachctl sync "${src}" {{.repo}}@master:/data --delete --progress=false \
+		  | match "1 files transferred, 1 files deleted"
+		pachctl get file {{.repo}}@master:/data/foo | match changed
+		pachctl list file {{.repo}}@master:/data/dir | match -v baz
+
+		dst=$(mktemp -d)
+		echo stale >"${dst}/stale"
+		pachctl sync {{.repo}}@master:/data "${dst}" --delete --progress=false \
+		  | match "2 files transferred, 1 files deleted"
+		match changed <"${dst}/foo"
+		match bar <"${dst}/dir/bar"
+		test ! -e "${dst}/stale"
+		pachctl sync {{.repo}}@master:/data "${dst}" --progress=false \
+		  | match "0 files transferred, 0 files deleted"
+		`,
+		"repo", tu.UniqueString("TestSync-repo"),
+	).Run())
+}
+
 // TestSynonyms walks through the command tree for each resource and verb combination defined in PPS.
 // A template is filled in that calls the help flag and the output is compared. It seems like 'match'
 // is unable to compare the outputs correctly, but we can use diff here which returns an exit code of 0
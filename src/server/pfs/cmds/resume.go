@@ -0,0 +1,172 @@
+package cmds
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/progress"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// resumePartSize is the size of the parts a resumable upload is split into.
+// A part is the unit of retry, so an interrupted upload loses at most one
+// part's worth of progress.
+const resumePartSize = 64 * 1024 * 1024
+
+// resumeStateDir holds the state of in-progress resumable uploads, so that a
+// later 'put file --resume' of the same file picks up where the last one left
+// off.
+var resumeStateDir = filepath.Join(os.Getenv("HOME"), ".pachyderm", "uploads")
+
+// resumeState is the persisted state of a resumable upload.  The size and
+// modification time of the source are recorded so that an upload of a file
+// that has since changed starts over rather than mixing old and new content.
+type resumeState struct {
+	SessionID string    `json:"session_id"`
+	Size      int64     `json:"size"`
+	ModTime   time.Time `json:"mod_time"`
+}
+
+// resumeStatePath returns the path of the state file for uploading source to
+// dst.
+func resumeStatePath(source string, dst *pfs.File, appendFile bool) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s\x00%t", source, dst.String(), appendFile)
+	return filepath.Join(resumeStateDir, hex.EncodeToString(h.Sum(nil))+".json")
+}
+
+func readResumeState(p string) (*resumeState, error) {
+	data, err := os.ReadFile(p)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	state := &resumeState{}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, errors.Wrapf(err, "parse upload state %s", p)
+	}
+	return state, nil
+}
+
+func writeResumeState(p string, state *resumeState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(os.WriteFile(p, data, 0600))
+}
+
+// resumeSession returns the upload session recorded in the state file at p,
+// or creates a new one if there is none, it has expired, or the source has
+// changed since it was created.
+func resumeSession(c *client.APIClient, p string, dst *pfs.File, appendFile bool, fi os.FileInfo) (*pfs.UploadSessionInfo, error) {
+	state, err := readResumeState(p)
+	if err != nil {
+		return nil, err
+	}
+	if state != nil {
+		if state.Size == fi.Size() && state.ModTime.Equal(fi.ModTime()) {
+			info, err := c.InspectUploadSession(state.SessionID)
+			if err == nil {
+				return info, nil
+			}
+			if !errutil.IsNotFoundError(err) {
+				return nil, err
+			}
+		} else if err := c.DeleteUploadSession(state.SessionID); err != nil && !errutil.IsNotFoundError(err) {
+			return nil, err
+		}
+	}
+	info, err := c.CreateUploadSession(dst, appendFile, 0)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeResumeState(p, &resumeState{
+		SessionID: info.Id,
+		Size:      fi.Size(),
+		ModTime:   fi.ModTime(),
+	}); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// putFileResumable uploads the local file source to dst through an upload
+// session.  Each part is retried independently, and if the command is
+// interrupted, running it again only uploads the parts that are missing.
+func putFileResumable(c *client.APIClient, dst *pfs.File, source string, appendFile bool) error {
+	source, err := filepath.Abs(source)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	f, err := os.Open(source)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	if !fi.Mode().IsRegular() {
+		return errors.Errorf("--resume requires a regular file, %s is not one", source)
+	}
+	statePath := resumeStatePath(source, dst, appendFile)
+	info, err := resumeSession(c, statePath, dst, appendFile, fi)
+	if err != nil {
+		return err
+	}
+	uploaded := make(map[int64]int64)
+	for _, part := range info.Parts {
+		uploaded[part.Number] = part.SizeBytes
+	}
+	size := fi.Size()
+	bar := "uploading " + filepath.Base(source)
+	var parts []int64
+	var done int64
+	for number, offset := int64(1), int64(0); offset < size || number == 1; number, offset = number+1, offset+resumePartSize {
+		partSize := size - offset
+		if partSize > resumePartSize {
+			partSize = resumePartSize
+		}
+		parts = append(parts, number)
+		if n, ok := uploaded[number]; !ok || n != partSize {
+			if err := backoff.RetryUntilCancel(c.Ctx(), func() error {
+				_, err := c.UploadPart(info.Id, number, io.NewSectionReader(f, offset, partSize))
+				return err
+			}, backoff.New60sBackOff(), func(err error, d time.Duration) error {
+				if errutil.IsNotFoundError(err) {
+					// The session has expired; the next run will start over.
+					return err
+				}
+				fmt.Fprintf(os.Stderr, "retrying part %d in %v: %v\n", number, d, err)
+				return nil
+			}); err != nil {
+				return errors.Wrapf(err, "upload part %d", number)
+			}
+		}
+		done += partSize
+		if size > 0 {
+			progress.WriteProgress(bar, done, size)
+		}
+	}
+	if err := c.CommitUploadSession(info.Id, parts); err != nil {
+		return err
+	}
+	return errors.EnsureStack(os.Remove(statePath))
+}
@@ -0,0 +1,250 @@
+package cmds
+
+import (
+	"bytes"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/progress"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// syncPlan is the set of changes needed to make a destination match a source.
+// Paths are relative to the root of the synchronized directories and use
+// forward slashes.
+type syncPlan struct {
+	transfer []string
+	remove   []string
+}
+
+// remoteFiles returns the files under root in commit, keyed by their path
+// relative to root.  A root that doesn't exist yet has no files.
+func remoteFiles(c *client.APIClient, commit *pfs.Commit, root string) (map[string]*pfs.FileInfo, error) {
+	prefix := strings.TrimSuffix(path.Join("/", root), "/") + "/"
+	files := make(map[string]*pfs.FileInfo)
+	if err := c.WalkFile(commit, root, func(fi *pfs.FileInfo) error {
+		if fi.FileType != pfs.FileType_FILE {
+			return nil
+		}
+		files[strings.TrimPrefix(fi.File.Path, prefix)] = fi
+		return nil
+	}); err != nil && !errutil.IsNotFoundError(err) {
+		return nil, err
+	}
+	return files, nil
+}
+
+// localFiles returns the sizes of the regular files under root, keyed by their
+// path relative to root.  A root that doesn't exist yet has no files.
+func localFiles(root string) (map[string]int64, error) {
+	files := make(map[string]int64)
+	if err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = info.Size()
+		return nil
+	}); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, errors.EnsureStack(err)
+	}
+	return files, nil
+}
+
+// sameContent reports whether the local file at p has the content described by
+// fi.  Sizes are compared first so that only files that might be unchanged are
+// hashed.
+func sameContent(p string, size int64, fi *pfs.FileInfo) (bool, error) {
+	if size != fi.SizeBytes {
+		return false, nil
+	}
+	f, err := os.Open(p)
+	if err != nil {
+		return false, errors.EnsureStack(err)
+	}
+	defer f.Close()
+	hash, err := fileset.ComputeHash(f)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(hash, fi.Hash), nil
+}
+
+// planSync compares the files under localDir with the remote files.  If
+// upload is set, the remote files are the destination, otherwise the local
+// files are.  Files only present in the destination are removed if del is set.
+func planSync(localDir string, local map[string]int64, remote map[string]*pfs.FileInfo, upload, del bool) (*syncPlan, error) {
+	plan := &syncPlan{}
+	var compared, total int64
+	if upload {
+		total = int64(len(local))
+	} else {
+		total = int64(len(remote))
+	}
+	check := func(rel string, size int64, fi *pfs.FileInfo) error {
+		compared++
+		progress.WriteProgress("comparing", compared, total)
+		if fi != nil {
+			same, err := sameContent(filepath.Join(localDir, filepath.FromSlash(rel)), size, fi)
+			if err != nil || same {
+				return err
+			}
+		}
+		plan.transfer = append(plan.transfer, rel)
+		return nil
+	}
+	if upload {
+		for rel, size := range local {
+			if err := check(rel, size, remote[rel]); err != nil {
+				return nil, err
+			}
+		}
+		for rel := range remote {
+			if _, ok := local[rel]; !ok && del {
+				plan.remove = append(plan.remove, rel)
+			}
+		}
+	} else {
+		for rel, fi := range remote {
+			size, ok := local[rel]
+			if !ok {
+				fi = nil
+			}
+			if err := check(rel, size, fi); err != nil {
+				return nil, err
+			}
+		}
+		for rel := range local {
+			if _, ok := remote[rel]; !ok && del {
+				plan.remove = append(plan.remove, rel)
+			}
+		}
+	}
+	if total > 0 {
+		progress.WriteProgress("comparing", total, total)
+	}
+	sort.Strings(plan.transfer)
+	sort.Strings(plan.remove)
+	return plan, nil
+}
+
+// syncUp makes the files under file match the files under localDir.  All of
+// the changes are made in a single commit.
+func syncUp(c *client.APIClient, localDir string, file *pfs.File, del bool) (*syncPlan, error) {
+	local, err := localFiles(localDir)
+	if err != nil {
+		return nil, err
+	}
+	remote, err := remoteFiles(c, file.Commit, file.Path)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := planSync(localDir, local, remote, true, del)
+	if err != nil {
+		return nil, err
+	}
+	if len(plan.transfer) == 0 && len(plan.remove) == 0 {
+		return plan, nil
+	}
+	if err := c.WithModifyFileClient(file.Commit, func(mf client.ModifyFile) error {
+		for i, rel := range plan.transfer {
+			if err := syncPutFile(mf, path.Join("/", file.Path, rel), filepath.Join(localDir, filepath.FromSlash(rel))); err != nil {
+				return err
+			}
+			progress.WriteProgress("uploading", int64(i+1), int64(len(plan.transfer)))
+		}
+		for i, rel := range plan.remove {
+			if err := mf.DeleteFile(path.Join("/", file.Path, rel)); err != nil {
+				return errors.EnsureStack(err)
+			}
+			progress.WriteProgress("deleting", int64(i+1), int64(len(plan.remove)))
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+func syncPutFile(mf client.ModifyFile, dst, src string) (retErr error) {
+	f, err := os.Open(src)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := f.Close(); retErr == nil {
+			retErr = errors.EnsureStack(err)
+		}
+	}()
+	return errors.EnsureStack(mf.PutFile(dst, f))
+}
+
+// syncDown makes the files under localDir match the files under file.
+func syncDown(c *client.APIClient, file *pfs.File, localDir string, del bool) (*syncPlan, error) {
+	// Pin the commit so that every file is read from the same one, even if
+	// the branch moves during the download.
+	repo := file.Commit.AccessRepo()
+	ci, err := c.InspectCommit(repo.Project.GetName(), repo.Name, file.Commit.Branch.GetName(), file.Commit.Id)
+	if err != nil {
+		return nil, err
+	}
+	remote, err := remoteFiles(c, ci.Commit, file.Path)
+	if err != nil {
+		return nil, err
+	}
+	local, err := localFiles(localDir)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := planSync(localDir, local, remote, false, del)
+	if err != nil {
+		return nil, err
+	}
+	for i, rel := range plan.transfer {
+		if err := syncGetFile(c, ci.Commit, remote[rel].File.Path, filepath.Join(localDir, filepath.FromSlash(rel))); err != nil {
+			return nil, err
+		}
+		progress.WriteProgress("downloading", int64(i+1), int64(len(plan.transfer)))
+	}
+	for i, rel := range plan.remove {
+		if err := os.Remove(filepath.Join(localDir, filepath.FromSlash(rel))); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		progress.WriteProgress("deleting", int64(i+1), int64(len(plan.remove)))
+	}
+	return plan, nil
+}
+
+func syncGetFile(c *client.APIClient, commit *pfs.Commit, src, dst string) (retErr error) {
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return errors.EnsureStack(err)
+	}
+	f, err := os.Create(dst)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := f.Close(); retErr == nil {
+			retErr = errors.EnsureStack(err)
+		}
+	}()
+	return c.GetFile(commit, src, f)
+}
@@ -51,6 +51,45 @@ type ErrProjectExists struct {
 	Project *pfs.Project
 }
 
+// ErrReplicationNotFound represents a replication-not-found error.
+type ErrReplicationNotFound struct {
+	Replication *pfs.Replication
+}
+
+// ErrReplicationExists represents a replication-exists error.
+type ErrReplicationExists struct {
+	Replication *pfs.Replication
+}
+
+// ErrRetentionPolicyNotFound represents a retention-policy-not-found error.
+// Branch.Name is empty for repo-wide policies.
+type ErrRetentionPolicyNotFound struct {
+	Branch *pfs.Branch
+}
+
+// ErrUploadSessionNotFound represents an upload-session-not-found error.
+type ErrUploadSessionNotFound struct {
+	ID string
+}
+
+// ErrProjectQuotaExceeded represents an error finishing a commit in a project
+// which has exceeded its hard storage quota.
+type ErrProjectQuotaExceeded struct {
+	Project   *pfs.Project
+	UsedBytes int64
+	MaxBytes  int64
+}
+
+// ErrCommitValidationNotFound represents a commit-validation-not-found error.
+type ErrCommitValidationNotFound struct {
+	Repo *pfs.Repo
+}
+
+// ErrStoragePlacementNotFound represents a storage-placement-not-found error.
+type ErrStoragePlacementNotFound struct {
+	Repo *pfs.Repo
+}
+
 // ErrCommitNotFound represents a commit-not-found error.
 type ErrCommitNotFound struct {
 	Commit *pfs.Commit
@@ -220,6 +259,65 @@ func (e ErrProjectExists) GRPCStatus() *status.Status {
 	return status.New(codes.AlreadyExists, e.Error())
 }
 
+func (e ErrReplicationNotFound) Error() string {
+	return fmt.Sprintf("replication %q not found", e.Replication.Name)
+}
+
+func (e ErrReplicationNotFound) GRPCStatus() *status.Status {
+	return status.New(codes.NotFound, e.Error())
+}
+
+func (e ErrReplicationExists) Error() string {
+	return fmt.Sprintf("replication %s already exists", e.Replication.Name)
+}
+
+func (e ErrReplicationExists) GRPCStatus() *status.Status {
+	return status.New(codes.AlreadyExists, e.Error())
+}
+
+func (e ErrRetentionPolicyNotFound) Error() string {
+	if e.Branch.Name == "" {
+		return fmt.Sprintf("retention policy for repo %s not found", e.Branch.Repo)
+	}
+	return fmt.Sprintf("retention policy for branch %s not found", e.Branch)
+}
+
+func (e ErrRetentionPolicyNotFound) GRPCStatus() *status.Status {
+	return status.New(codes.NotFound, e.Error())
+}
+
+func (e ErrUploadSessionNotFound) Error() string {
+	return fmt.Sprintf("upload session %s not found", e.ID)
+}
+
+func (e ErrUploadSessionNotFound) GRPCStatus() *status.Status {
+	return status.New(codes.NotFound, e.Error())
+}
+
+func (e ErrProjectQuotaExceeded) Error() string {
+	return fmt.Sprintf("project %s has exceeded its storage quota (%d of %d bytes used)", e.Project, e.UsedBytes, e.MaxBytes)
+}
+
+func (e ErrProjectQuotaExceeded) GRPCStatus() *status.Status {
+	return status.New(codes.ResourceExhausted, e.Error())
+}
+
+func (e ErrCommitValidationNotFound) Error() string {
+	return fmt.Sprintf("commit validation for repo %s not found", e.Repo)
+}
+
+func (e ErrCommitValidationNotFound) GRPCStatus() *status.Status {
+	return status.New(codes.NotFound, e.Error())
+}
+
+func (e ErrStoragePlacementNotFound) Error() string {
+	return fmt.Sprintf("storage placement for repo %s not found", e.Repo)
+}
+
+func (e ErrStoragePlacementNotFound) GRPCStatus() *status.Status {
+	return status.New(codes.NotFound, e.Error())
+}
+
 func (e ErrCommitNotFound) Error() string {
 	return fmt.Sprintf("commit %v not found", e.Commit)
 }
@@ -333,6 +431,7 @@ var (
 	dropWithChildrenRe        = regexp.MustCompile("cannot drop a commit that has children")
 	invalidBranchStructureRe  = regexp.MustCompile("multiple branches from the same repo, .+, cannot participate in a DAG")
 	squashWithSubvenanceRe    = regexp.MustCompile("commit set .+ cannot be dropped because it has subvenant commit sets: .+")
+	projectQuotaExceededRe    = regexp.MustCompile("project [^ ]+ has exceeded its storage quota")
 )
 
 // IsCommitNotFoundErr returns true if 'err' has an error message that matches
@@ -503,6 +602,15 @@ func IsSquashWithSuvenanceErr(err error) bool {
 	return squashWithSubvenanceRe.MatchString(err.Error())
 }
 
+// IsProjectQuotaExceededErr returns true if 'err' has an error message that
+// matches ErrProjectQuotaExceeded
+func IsProjectQuotaExceededErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return projectQuotaExceededRe.MatchString(grpcutil.ScrubGRPC(err).Error())
+}
+
 func ValidateSQLDatabaseEgress(sql *pfs.SQLDatabaseEgress) error {
 	if sql == nil {
 		return nil
@@ -9,6 +9,7 @@ import (
 
 	units "github.com/docker/go-units"
 	"github.com/fatih/color"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
@@ -16,6 +17,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/pretty"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tabwriter"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
 )
 
 const (
@@ -33,6 +35,16 @@ const (
 	ProjectHeader = "ACTIVE\tPROJECT\tCREATED\tDESCRIPTION\n"
 	// ProjectAuthHeader is the header for the projects with auth info attached.
 	ProjectAuthHeader = "ACTIVE\tPROJECT\tACCESS_LEVEL\tCREATED\tDESCRIPTION\n"
+	// ReplicationHeader is the header for replications.
+	ReplicationHeader = "NAME\tTARGET\tBRANCHES\tCREATED\tLAG\t\n"
+	// RetentionPolicyHeader is the header for retention policies.
+	RetentionPolicyHeader = "REPO\tBRANCH\tKEEP LAST\tKEEP FOR\tKEEP DAILY AFTER\t\n"
+	// RetentionActionHeader is the header for the result of enforcing retention policies.
+	RetentionActionHeader = "COMMIT\tSQUASHED\tREASON\t\n"
+	// CommitValidationHeader is the header for commit validations.
+	CommitValidationHeader = "REPO\tMIN FILES\tMAX FILES\tMAX SIZE\tPATHS\tVALIDATOR\t\n"
+	// StoragePlacementHeader is the header for storage placements.
+	StoragePlacementHeader = "REPO\tTIER\tCOLD TIER\tCOLD AFTER\t\n"
 	// FileHeader is the header for files.  It pads SIZE to allow up to four
 	// characters (e.g. “1023”) followed by a unit such as “KiB.”
 	FileHeader = "SIZE   \tNAME\n"
@@ -89,7 +101,9 @@ func PrintDetailedRepoInfo(repoInfo *PrintableRepoInfo) error {
 Description: {{.Description}}{{end}}{{if .FullTimestamps}}
 Created: {{prettyTime .Created}}{{else}}
 Created: {{prettyAgo .Created}}{{end}}{{if .Details}}
-Size of HEAD on master: {{prettySize .Details.SizeBytes}}{{end}}{{if .AuthInfo}}
+Size of HEAD on master: {{prettySize .Details.SizeBytes}}{{if .Details.Usage}}
+Exclusive storage: {{prettySize .Details.Usage.ExclusiveBytes}}
+Shared storage: {{prettySize .Details.Usage.SharedBytes}}{{end}}{{end}}{{if .AuthInfo}}
 Roles: {{ .AuthInfo.Roles | commafy }}
 Permissions: {{ .AuthInfo.Permissions | commafy }}{{end}}
 `)
@@ -119,6 +133,13 @@ func printTrigger(trigger *pfs.Trigger) string {
 	} else {
 		cond = strings.Join(conds, " or ")
 	}
+	if trigger.RequireApproval {
+		if cond == "" {
+			cond = "Approval"
+		} else {
+			cond = fmt.Sprintf("Approval and (%s)", cond)
+		}
+	}
 	return fmt.Sprintf("%s on %s", trigger.Branch, cond)
 }
 
@@ -182,8 +203,23 @@ Trigger: {{printTrigger .Trigger}} {{end}}
 	return errors.EnsureStack(template.Execute(os.Stdout, branchInfo))
 }
 
+// PrintableProjectInfo is a wrapper around ProjectInfo containing the compute
+// resources used by the project's pipelines, if they were requested.
+type PrintableProjectInfo struct {
+	*pfs.ProjectInfo
+	ComputeUsage *pps.GetProjectComputeUsageResponse
+}
+
+// NewPrintableProjectInfo constructs a PrintableProjectInfo from just a
+// ProjectInfo.
+func NewPrintableProjectInfo(pi *pfs.ProjectInfo) *PrintableProjectInfo {
+	return &PrintableProjectInfo{
+		ProjectInfo: pi,
+	}
+}
+
 // PrintDetailedProjectInfo pretty-prints detailed project info.
-func PrintDetailedProjectInfo(projectInfo *pfs.ProjectInfo) error {
+func PrintDetailedProjectInfo(projectInfo *PrintableProjectInfo) error {
 	template, err := template.New("ProjectInfo").Funcs(funcMap).Parse(
 		`Name: {{ .Project.Name }}
 {{- if .Description }}
@@ -196,6 +232,37 @@ Created at: {{ prettyTime .CreatedAt }}
 Roles: {{.AuthInfo.Roles | commafy}}
 Permissions: {{.AuthInfo.Permissions | commafy}}
 {{- end -}}
+{{- if .Quota }}
+{{- if .Quota.MaxBytes }}
+Quota: {{ prettySize .Quota.MaxBytes }} ({{ .Quota.Enforcement }})
+{{- end -}}
+{{- if .Quota.MaxWorkers }}
+Max workers: {{ .Quota.MaxWorkers }}
+{{- end -}}
+{{- if .Quota.MaxCpu }}
+Max CPU: {{ .Quota.MaxCpu }}
+{{- end -}}
+{{- if .Quota.MaxMemory }}
+Max memory: {{ .Quota.MaxMemory }}
+{{- end -}}
+{{- if .Quota.PriorityClassName }}
+Priority class: {{ .Quota.PriorityClassName }}
+{{- end -}}
+{{- end -}}
+{{- if .Details }}
+{{- if .Details.Usage }}
+Exclusive storage: {{ prettySize .Details.Usage.ExclusiveBytes }}
+Shared storage: {{ prettySize .Details.Usage.SharedBytes }}
+Usage computed: {{ prettyAgo .Details.Usage.ComputedAt }}
+{{- else }}
+Usage: not yet computed
+{{- end -}}
+{{- end -}}
+{{- if .ComputeUsage }}
+Workers: {{ .ComputeUsage.Workers }}
+CPU requested: {{ .ComputeUsage.Cpu }}
+Memory requested: {{ .ComputeUsage.Memory }}
+{{- end -}}
 `)
 	if err != nil {
 		return errors.EnsureStack(err)
@@ -203,6 +270,151 @@ Permissions: {{.AuthInfo.Permissions | commafy}}
 	return errors.EnsureStack(template.Execute(os.Stdout, projectInfo))
 }
 
+// PrintReplicationInfo pretty-prints replication info.
+func PrintReplicationInfo(w io.Writer, replicationInfo *pfs.ReplicationInfo) {
+	fmt.Fprintf(w, "%s\t", replicationInfo.Replication.Name)
+	fmt.Fprintf(w, "%s\t", replicationInfo.Target.GetPachdAddress())
+	fmt.Fprintf(w, "%d\t", len(replicationInfo.Branches))
+	if c := replicationInfo.CreatedAt; c == nil {
+		fmt.Fprintf(w, "-\t")
+	} else {
+		fmt.Fprintf(w, "%s\t", pretty.Ago(c))
+	}
+	// LAG is the largest lag of any replicated branch.
+	var lag *durationpb.Duration
+	for _, s := range replicationInfo.Status {
+		if s.Lag != nil && (lag == nil || s.Lag.AsDuration() > lag.AsDuration()) {
+			lag = s.Lag
+		}
+	}
+	if lag == nil {
+		fmt.Fprintf(w, "-\t")
+	} else {
+		fmt.Fprintf(w, "%s\t", pretty.Duration(lag))
+	}
+	fmt.Fprintln(w)
+}
+
+// PrintDetailedReplicationInfo pretty-prints detailed replication info.
+func PrintDetailedReplicationInfo(replicationInfo *pfs.ReplicationInfo) error {
+	template, err := template.New("ReplicationInfo").Funcs(funcMap).Parse(
+		`Name: {{ .Replication.Name }}
+Target: {{ .Target.PachdAddress }}
+{{- if .CreatedAt }}
+Created at: {{ prettyTime .CreatedAt }}
+{{- end }}
+Branches:
+{{- range .Branches }}
+  {{ . }}
+{{- end }}
+{{- if .Status }}
+Status:
+{{- range .Status }}
+  {{ .Branch }}:
+{{- if .LastReplicatedCommit }}
+    Last replicated commit: {{ .LastReplicatedCommit.Id }}
+    Last replicated at: {{ prettyTime .LastReplicatedAt }}
+    Lag: {{ prettyDuration .Lag }}
+{{- end }}
+    Bytes transferred: {{ prettySize .BytesTransferred }}
+{{- if .Error }}
+    Error: {{ .Error }}
+{{- end }}
+{{- end }}
+{{- end }}
+`)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(template.Execute(os.Stdout, replicationInfo))
+}
+
+// PrintRetentionPolicyInfo pretty-prints a retention policy.
+func PrintRetentionPolicyInfo(w io.Writer, info *pfs.RetentionPolicyInfo) {
+	fmt.Fprintf(w, "%s\t", info.Repo)
+	if info.Branch == "" {
+		fmt.Fprintf(w, "*\t")
+	} else {
+		fmt.Fprintf(w, "%s\t", info.Branch)
+	}
+	if info.Policy.KeepLast == 0 {
+		fmt.Fprintf(w, "-\t")
+	} else {
+		fmt.Fprintf(w, "%d\t", info.Policy.KeepLast)
+	}
+	for _, d := range []*durationpb.Duration{info.Policy.KeepFor, info.Policy.KeepDailyAfter} {
+		if d == nil {
+			fmt.Fprintf(w, "-\t")
+		} else {
+			fmt.Fprintf(w, "%s\t", pretty.Duration(d))
+		}
+	}
+	fmt.Fprintln(w)
+}
+
+// PrintRetentionAction pretty-prints the result of enforcing a retention policy on a commit.
+func PrintRetentionAction(w io.Writer, action *pfs.RetentionAction) {
+	fmt.Fprintf(w, "%s\t", action.Commit)
+	fmt.Fprintf(w, "%t\t", action.Squashed)
+	if action.SkippedReason == "" {
+		fmt.Fprintf(w, "-\t")
+	} else {
+		fmt.Fprintf(w, "%s\t", action.SkippedReason)
+	}
+	fmt.Fprintln(w)
+}
+
+// PrintCommitValidationInfo pretty-prints a commit validation.
+func PrintCommitValidationInfo(w io.Writer, info *pfs.CommitValidationInfo) {
+	c := info.Contract
+	fmt.Fprintf(w, "%s\t", info.Repo)
+	for _, n := range []int64{c.GetMinFiles(), c.GetMaxFiles()} {
+		if n == 0 {
+			fmt.Fprintf(w, "-\t")
+		} else {
+			fmt.Fprintf(w, "%d\t", n)
+		}
+	}
+	if c.GetMaxTotalBytes() == 0 {
+		fmt.Fprintf(w, "-\t")
+	} else {
+		fmt.Fprintf(w, "%s\t", units.BytesSize(float64(c.GetMaxTotalBytes())))
+	}
+	var globs []string
+	for _, p := range c.GetPaths() {
+		globs = append(globs, p.Glob)
+	}
+	if len(globs) == 0 {
+		fmt.Fprintf(w, "-\t")
+	} else {
+		fmt.Fprintf(w, "%s\t", strings.Join(globs, ", "))
+	}
+	if info.Validator == nil {
+		fmt.Fprintf(w, "-\t")
+	} else {
+		fmt.Fprintf(w, "%s\t", info.Validator)
+	}
+	fmt.Fprintln(w)
+}
+
+// PrintStoragePlacementInfo pretty-prints a storage placement.
+func PrintStoragePlacementInfo(w io.Writer, info *pfs.StoragePlacementInfo) {
+	fmt.Fprintf(w, "%s\t", info.Repo)
+	for _, tier := range []string{info.Tier, info.ColdTier} {
+		if tier == "" {
+			fmt.Fprintf(w, "default\t")
+		} else {
+			fmt.Fprintf(w, "%s\t", tier)
+		}
+	}
+	if info.ColdTier == "" {
+		fmt.Fprintf(w, "-\t")
+	} else {
+		fmt.Fprintf(w, "%s\t", info.ColdAfter.AsDuration())
+	}
+	fmt.Fprintln(w)
+}
+
 // PrintCommitInfo pretty-prints commit info.
 func PrintCommitInfo(w io.Writer, commitInfo *pfs.CommitInfo, fullTimestamps bool) {
 	fmt.Fprintf(w, "%s\t", commitInfo.Commit.Branch.Repo.Project)
@@ -347,8 +559,14 @@ Parent: {{.ParentCommit.Id}}{{end}}{{if .FullTimestamps}}
 Started: {{prettyTime .Started}}{{else}}
 Started: {{prettyAgo .Started}}{{end}}{{if .Finished}}{{if .FullTimestamps}}
 Finished: {{prettyTime .Finished}}{{else}}
-Finished: {{prettyAgo .Finished}}{{end}}{{end}}{{if .Details}}
-Size: {{prettySize .Details.SizeBytes}}{{end}}
+Finished: {{prettyAgo .Finished}}{{end}}{{end}}{{if .Approval}}
+Approved by: {{.Approval.Principal}}{{if .FullTimestamps}} ({{prettyTime .Approval.Approved}}){{else}} ({{prettyAgo .Approval.Approved}}){{end}}{{if .Approval.Comment}}
+Approval comment: {{.Approval.Comment}}{{end}}{{end}}{{if .Checkpoint}}
+Checkpoint: {{.Checkpoint}}{{end}}{{if .Details}}
+Size: {{prettySize .Details.SizeBytes}}{{if .Details.Usage}}
+Exclusive storage: {{prettySize .Details.Usage.ExclusiveBytes}}
+Shared storage: {{prettySize .Details.Usage.SharedBytes}}{{end}}{{if .Details.Validation}}
+Validation: {{if .Details.Validation.Pending}}pending{{else if .Details.Validation.Passed}}passed{{else}}failed: {{.Details.Validation.Reason}}{{end}}{{end}}{{end}}
 `)
 	if err != nil {
 		return errors.EnsureStack(err)
@@ -394,12 +612,13 @@ func fileType(fileType pfs.FileType) string {
 }
 
 var funcMap = template.FuncMap{
-	"prettyAgo":    pretty.Ago,
-	"prettySize":   pretty.Size,
-	"prettyTime":   pretty.Timestamp,
-	"fileType":     fileType,
-	"printTrigger": printTrigger,
-	"commafy":      pretty.Commafy,
+	"prettyAgo":      pretty.Ago,
+	"prettySize":     pretty.Size,
+	"prettyTime":     pretty.Timestamp,
+	"prettyDuration": pretty.Duration,
+	"fileType":       fileType,
+	"printTrigger":   printTrigger,
+	"commafy":        pretty.Commafy,
 }
 
 // CompactPrintCommit renders 'c' as a compact string, e.g.
@@ -6,16 +6,12 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"path"
-	"regexp"
-	"strconv"
+	"sort"
 	"strings"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
-	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
-	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	pfsServer "github.com/pachyderm/pachyderm/v2/src/server/pfs"
 	"go.uber.org/zap"
@@ -23,136 +19,94 @@ import (
 	"github.com/pachyderm/s2"
 )
 
-var multipartChunkPathMatcher = regexp.MustCompile(`([^/]+)/([^/]+)/(.+)/([^/]+)/(\d+)`)
-var multipartKeepPathMatcher = regexp.MustCompile(`([^/]+)/([^/]+)/(.+)/([^/]+)/\.keep`)
-
-func multipartChunkArgs(path string) (repo string, branch string, key string, uploadID string, partNumber int, err error) {
-	match := multipartChunkPathMatcher.FindStringSubmatch(path)
-
-	if len(match) == 0 {
-		err = errors.New("invalid file path found in multipath bucket")
-		return
-	}
-
-	repo = match[1]
-	branch = match[2]
-	key = match[3]
-	uploadID = match[4]
-	partNumber, err = strconv.Atoi(match[5])
-	if err != nil {
-		err = errors.Wrapf(err, "invalid file path found in multipath bucket")
-		return
-	}
-	return
-}
-
-func multipartKeepArgs(path string) (repo string, branch string, key string, uploadID string, err error) {
-	match := multipartKeepPathMatcher.FindStringSubmatch(path)
-
-	if len(match) == 0 {
-		err = errors.New("invalid file path found in multipath bucket")
-		return
-	}
-
-	repo = match[1]
-	branch = match[2]
-	key = match[3]
-	uploadID = match[4]
-	return
+// Multipart uploads are backed by PFS upload sessions: an upload ID is an
+// upload session ID, each part is an upload session part, and completing the
+// upload commits the listed parts to the object's file.
+
+// sessionMatches returns whether an upload session was created for key in
+// bucket.
+func sessionMatches(info *pfs.UploadSessionInfo, bucket *Bucket, key string) bool {
+	commit := info.File.Commit
+	return commit.Repo.String() == bucket.Commit.Repo.String() &&
+		commit.GetBranch().GetName() == bucket.Commit.GetBranch().GetName() &&
+		commit.Id == bucket.Commit.Id &&
+		strings.TrimPrefix(info.File.Path, "/") == strings.TrimPrefix(key, "/")
 }
 
-func parentDirPath(bucket *Bucket, key string, uploadID string) string {
-	commitID := bucket.Commit.Id
-	if commitID == "" {
-		commitID = "latest"
+// uploadSession returns the upload session for uploadID, or a NoSuchUpload
+// error if there is no such session for key in bucket.
+func (c *controller) uploadSession(pc *client.APIClient, r *http.Request, bucket *Bucket, key, uploadID string) (*pfs.UploadSessionInfo, error) {
+	if bucket.Commit == nil {
+		return nil, s2.NoSuchBucketError(r)
 	}
-	return path.Join(bucket.Commit.Repo.Name, bucket.Commit.Repo.Type, bucket.Commit.Branch.Name, commitID, key, uploadID)
-}
-
-func chunkPath(bucket *Bucket, key string, uploadID string, partNumber int) string {
-	return path.Join(parentDirPath(bucket, key, uploadID), strconv.Itoa(partNumber))
-}
-
-func keepPath(bucket *Bucket, key string, uploadID string) string {
-	return path.Join(parentDirPath(bucket, key, uploadID), ".keep")
-}
-
-func (c *controller) ensureRepo(pc *client.APIClient) error {
-	_, err := pc.InspectBranch(c.project, c.repo, "master")
+	info, err := pc.InspectUploadSession(uploadID)
 	if err != nil {
-		err = pc.UpdateRepo(c.project, c.repo)
-		if err != nil {
-			return err
-		}
-
-		err = pc.CreateBranch(c.project, c.repo, "master", "", "", nil)
-		if err != nil {
-			return err
+		if errutil.IsNotFoundError(err) {
+			return nil, s2.NoSuchUploadError(r)
 		}
+		return nil, err
 	}
-
-	return nil
+	if !sessionMatches(info, bucket, key) {
+		return nil, s2.NoSuchUploadError(r)
+	}
+	return info, nil
 }
 
 func (c *controller) ListMultipart(r *http.Request, bucketName, keyMarker, uploadIDMarker string, maxUploads int) (*s2.ListMultipartResult, error) {
 	defer log.Span(r.Context(), "ListMulitpart", zap.String("bucketName", bucketName), zap.String("keyMarker", keyMarker), zap.String("uploadIDMarker", uploadIDMarker), zap.Int("maxUploads", maxUploads))()
 
 	pc := c.requestClient(r)
-	if err := c.ensureRepo(pc); err != nil {
-		return nil, err
-	}
-
 	bucket, err := c.driver.bucket(pc, r, bucketName)
 	if err != nil {
 		return nil, err
 	}
-
-	result := s2.ListMultipartResult{
-		Uploads: []*s2.Upload{},
+	if bucket.Commit == nil {
+		return nil, s2.NoSuchBucketError(r)
 	}
 
-	globPattern := keepPath(bucket, "*", "*")
-	err = pc.GlobFile(client.NewCommit(c.project, c.repo, "master", ""), globPattern, func(fileInfo *pfs.FileInfo) error {
-		_, _, key, uploadID, err := multipartKeepArgs(fileInfo.File.Path)
-		if err != nil {
-			return nil
-		}
-
-		if key <= keyMarker || uploadID <= uploadIDMarker {
-			return nil
+	infos, err := pc.ListUploadSession(bucket.Commit.Repo)
+	if err != nil {
+		return nil, err
+	}
+	var uploads []*s2.Upload
+	for _, info := range infos {
+		key := strings.TrimPrefix(info.File.Path, "/")
+		if !sessionMatches(info, bucket, key) {
+			continue
 		}
-
-		if len(result.Uploads) >= maxUploads {
-			if maxUploads > 0 {
-				result.IsTruncated = true
-			}
-			return errutil.ErrBreak
+		if key < keyMarker || (key == keyMarker && (uploadIDMarker == "" || info.Id <= uploadIDMarker)) {
+			continue
 		}
-
-		timestamp := fileInfo.Committed.AsTime()
-
-		result.Uploads = append(result.Uploads, &s2.Upload{
+		uploads = append(uploads, &s2.Upload{
 			Key:          key,
-			UploadID:     uploadID,
+			UploadID:     info.Id,
 			Initiator:    defaultUser,
 			StorageClass: globalStorageClass,
-			Initiated:    timestamp,
+			Initiated:    info.Created.AsTime(),
 		})
-
-		return nil
+	}
+	sort.Slice(uploads, func(i, j int) bool {
+		if uploads[i].Key != uploads[j].Key {
+			return uploads[i].Key < uploads[j].Key
+		}
+		return uploads[i].UploadID < uploads[j].UploadID
 	})
 
-	return &result, err
+	result := s2.ListMultipartResult{
+		Uploads: []*s2.Upload{},
+	}
+	if len(uploads) > maxUploads {
+		uploads = uploads[:maxUploads]
+		result.IsTruncated = maxUploads > 0
+	}
+	result.Uploads = append(result.Uploads, uploads...)
+	return &result, nil
 }
 
 func (c *controller) InitMultipart(r *http.Request, bucketName, key string) (string, error) {
 	defer log.Span(r.Context(), "InitMultipart", zap.String("bucketName", bucketName), zap.String("key", key))()
 
 	pc := c.requestClient(r)
-	if err := c.ensureRepo(pc); err != nil {
-		return "", err
-	}
-
 	bucket, err := c.driver.bucket(pc, r, bucketName)
 	if err != nil {
 		return "", err
@@ -165,38 +119,32 @@ func (c *controller) InitMultipart(r *http.Request, bucketName, key string) (str
 		return "", s2.NotImplementedError(r)
 	}
 
-	uploadID := uuid.NewWithoutDashes()
-
-	if err := pc.PutFile(client.NewCommit(pfs.DefaultProjectName, c.repo, "master", ""), keepPath(bucket, key, uploadID), strings.NewReader("")); err != nil {
-		return "", err
+	// The object is overwritten on completion, for "last write wins"
+	// behavior.
+	info, err := pc.CreateUploadSession(bucket.Commit.NewFile(key), false, 0)
+	if err != nil {
+		return "", maybeNotFoundError(r, err)
 	}
-
-	return uploadID, nil
+	return info.Id, nil
 }
 
 func (c *controller) AbortMultipart(r *http.Request, bucketName, key, uploadID string) (retErr error) {
 	defer log.SpanL(r.Context(), "AbortMultipart", log.InfoLevel, zap.String("bucketName", bucketName), zap.String("key", key), zap.String("uploadID", uploadID))(log.Errorp(&retErr))
 
 	pc := c.requestClient(r)
-	if err := c.ensureRepo(pc); err != nil {
-		return err
-	}
-
 	bucket, err := c.driver.bucket(pc, r, bucketName)
 	if err != nil {
 		return err
 	}
-
-	_, err = pc.InspectFile(client.NewCommit(pfs.DefaultProjectName, c.repo, "master", ""), keepPath(bucket, key, uploadID))
-	if err != nil {
-		return s2.NoSuchUploadError(r)
+	if _, err := c.uploadSession(pc, r, bucket, key, uploadID); err != nil {
+		return err
 	}
-
-	err = pc.DeleteFile(client.NewCommit(pfs.DefaultProjectName, c.repo, "master", ""), parentDirPath(bucket, key, uploadID))
-	if err != nil {
+	if err := pc.DeleteUploadSession(uploadID); err != nil {
+		if errutil.IsNotFoundError(err) {
+			return s2.NoSuchUploadError(r)
+		}
 		return s2.InternalError(r, err)
 	}
-
 	return nil
 }
 
@@ -205,10 +153,6 @@ func (c *controller) CompleteMultipart(r *http.Request, bucketName, key, uploadI
 	defer end(zap.Any("result", &res), log.ErrorpL(&retErr, log.InfoLevel))
 
 	pc := c.requestClient(r)
-	if err := c.ensureRepo(pc); err != nil {
-		return nil, err
-	}
-
 	bucket, err := c.driver.bucket(pc, r, bucketName)
 	if err != nil {
 		return nil, err
@@ -221,61 +165,47 @@ func (c *controller) CompleteMultipart(r *http.Request, bucketName, key, uploadI
 		return nil, s2.NotImplementedError(r)
 	}
 
-	_, err = pc.InspectFile(client.NewCommit(pfs.DefaultProjectName, c.repo, "master", ""), keepPath(bucket, key, uploadID))
+	info, err := c.uploadSession(pc, r, bucket, key, uploadID)
 	if err != nil {
-		if pfsServer.IsFileNotFoundErr(err) {
-			return nil, s2.NoSuchUploadError(r)
-		}
 		return nil, err
 	}
+	uploaded := make(map[int64]*pfs.UploadPartInfo)
+	for _, part := range info.Parts {
+		uploaded[part.Number] = part
+	}
 
-	// S3 "supports" concurrent complete calls on the same upload ID.
-	// Write to a random file ID in our directory to avoid conflict
-	tmpPath := uuid.NewWithoutDashes()
-
+	var numbers []int64
 	for i, part := range parts {
-		srcPath := chunkPath(bucket, key, uploadID, part.PartNumber)
-
-		fileInfo, err := pc.InspectFile(client.NewCommit(pfs.DefaultProjectName, c.repo, "master", ""), srcPath)
-		if err != nil {
-			if pfsServer.IsFileNotFoundErr(err) {
-				return nil, s2.InvalidPartError(r)
-			}
-			return nil, err
+		partInfo, ok := uploaded[int64(part.PartNumber)]
+		if !ok {
+			return nil, s2.InvalidPartError(r)
 		}
 
 		// Only verify the ETag when it's of the same length as PFS file
 		// hashes. This is because s3 clients will generally use md5 for
 		// ETags, and would otherwise fail.
-		expectedETag := fmt.Sprintf("%x", fileInfo.Hash)
+		expectedETag := fmt.Sprintf("%x", partInfo.Hash)
 		if len(part.ETag) == len(expectedETag) && part.ETag != expectedETag {
 			return nil, s2.InvalidPartError(r)
 		}
 
-		if i < len(parts)-1 && fileInfo.SizeBytes < 5*1024*1024 {
+		if i < len(parts)-1 && partInfo.SizeBytes < 5*1024*1024 {
 			// each part, except for the last, is expected to be at least 5mb
 			// in s3
 			return nil, s2.EntityTooSmallError(r)
 		}
-
-		if err := pc.CopyFile(
-			client.NewCommit(pfs.DefaultProjectName, c.repo, "master", ""), tmpPath,
-			client.NewCommit(pfs.DefaultProjectName, c.repo, "master", ""), srcPath,
-			client.WithAppendCopyFile()); err != nil {
-			return nil, err
-		}
+		numbers = append(numbers, partInfo.Number)
 	}
 
-	// overwrite file, for "last write wins" behavior
-	if err := pc.CopyFile(bucket.Commit, key, client.NewCommit(pfs.DefaultProjectName, c.repo, "master", ""), tmpPath); err != nil {
+	if err := pc.CommitUploadSession(uploadID, numbers); err != nil {
 		if errutil.IsWriteToOutputBranchError(err) {
 			return nil, writeToOutputBranchError(r)
 		}
-		return nil, err
-	}
-
-	err = pc.DeleteFile(client.NewCommit(pfs.DefaultProjectName, c.repo, "master", ""), parentDirPath(bucket, key, uploadID))
-	if err != nil {
+		if errutil.IsNotFoundError(err) {
+			// S3 "supports" concurrent complete calls on the same upload ID,
+			// but only one of them can commit the session.
+			return nil, s2.NoSuchUploadError(r)
+		}
 		return nil, err
 	}
 
@@ -297,11 +227,11 @@ func (c *controller) ListMultipartChunks(r *http.Request, bucketName, key, uploa
 	defer log.Span(r.Context(), "ListMultipartChunks", zap.String("bucketName", bucketName), zap.String("key", key), zap.String("uploadID", uploadID), zap.Int("partNumberMarker", partNumberMarker), zap.Int("maxParts", maxParts))()
 
 	pc := c.requestClient(r)
-	if err := c.ensureRepo(pc); err != nil {
+	bucket, err := c.driver.bucket(pc, r, bucketName)
+	if err != nil {
 		return nil, err
 	}
-
-	bucket, err := c.driver.bucket(pc, r, bucketName)
+	info, err := c.uploadSession(pc, r, bucket, key, uploadID)
 	if err != nil {
 		return nil, err
 	}
@@ -312,66 +242,43 @@ func (c *controller) ListMultipartChunks(r *http.Request, bucketName, key, uploa
 		StorageClass: globalStorageClass,
 		Parts:        []*s2.Part{},
 	}
-
-	globPattern := path.Join(parentDirPath(bucket, key, uploadID), "*")
-	err = pc.GlobFile(client.NewCommit(pfs.DefaultProjectName, c.repo, "master", ""), globPattern, func(fileInfo *pfs.FileInfo) error {
-		_, _, _, _, partNumber, err := multipartChunkArgs(fileInfo.File.Path)
-		if err != nil {
-			return nil
-		}
-
-		if partNumber <= partNumberMarker {
-			return nil
+	// Parts are kept sorted by number.
+	for _, part := range info.Parts {
+		if part.Number <= int64(partNumberMarker) {
+			continue
 		}
-
 		if len(result.Parts) >= maxParts {
 			if maxParts > 0 {
 				result.IsTruncated = true
 			}
-			return errutil.ErrBreak
+			break
 		}
-
 		result.Parts = append(result.Parts, &s2.Part{
-			PartNumber: partNumber,
-			ETag:       fmt.Sprintf("%x", fileInfo.Hash),
+			PartNumber: int(part.Number),
+			ETag:       fmt.Sprintf("%x", part.Hash),
 		})
-
-		return nil
-	})
-
-	return &result, err
+	}
+	return &result, nil
 }
 
 func (c *controller) UploadMultipartChunk(r *http.Request, bucketName, key, uploadID string, partNumber int, reader io.Reader) (string, error) {
 	defer log.Span(r.Context(), "UploadMultipartChunk", zap.String("bucketName", bucketName), zap.String("key", key), zap.String("uploadID", uploadID), zap.Int("partNumber", partNumber))()
 
 	pc := c.requestClient(r)
-	if err := c.ensureRepo(pc); err != nil {
-		return "", err
-	}
-
 	bucket, err := c.driver.bucket(pc, r, bucketName)
 	if err != nil {
 		return "", err
 	}
-
-	_, err = pc.InspectFile(client.NewCommit(pfs.DefaultProjectName, c.repo, "master", ""), keepPath(bucket, key, uploadID))
-	if err != nil {
-		if pfsServer.IsFileNotFoundErr(err) {
-			return "", s2.NoSuchUploadError(r)
-		}
+	if _, err := c.uploadSession(pc, r, bucket, key, uploadID); err != nil {
 		return "", err
 	}
 
-	path := chunkPath(bucket, key, uploadID, partNumber)
-	if err := pc.PutFile(client.NewCommit(pfs.DefaultProjectName, c.repo, "master", ""), path, reader); err != nil {
-		return "", err
-	}
-
-	fileInfo, err := pc.InspectFile(client.NewCommit(pfs.DefaultProjectName, c.repo, "master", ""), path)
+	part, err := pc.UploadPart(uploadID, int64(partNumber), reader)
 	if err != nil {
+		if errutil.IsNotFoundError(err) {
+			return "", s2.NoSuchUploadError(r)
+		}
 		return "", err
 	}
-
-	return fmt.Sprintf("%x", fileInfo.Hash), nil
+	return fmt.Sprintf("%x", part.Hash), nil
 }
@@ -23,7 +23,6 @@ import (
 type ClientFactory = func(ctx context.Context) *client.APIClient
 
 const (
-	multipartRepo        = "_s3gateway_multipart_"
 	maxAllowedParts      = 10000
 	maxRequestBodyLength = math.MaxUint32 // Unlimited
 	requestTimeout       = 5 * time.Minute
@@ -40,11 +39,6 @@ const (
 var defaultUser = s2.User{ID: "00000000000000000000000000000000", DisplayName: "pachyderm"}
 
 type controller struct {
-	// Project of the PFS repo holding the multipart content
-	project string
-	// Name of the PFS repo holding multipart content
-	repo string
-
 	// the maximum number of allowed parts that can be associated with any
 	// given file
 	maxAllowedParts int
@@ -91,7 +85,6 @@ func (c *controller) requestClient(r *http.Request) *client.APIClient {
 func Router(ctx context.Context, driver Driver, clientFactory ClientFactory) *mux.Router {
 	logger := log.NewLogrus(ctx).WithField("source", "s3gateway")
 	c := &controller{
-		repo:            multipartRepo,
 		maxAllowedParts: maxAllowedParts,
 		driver:          driver,
 		clientFactory:   clientFactory,
@@ -159,6 +159,11 @@ func (a *apiServer) InspectRepo(ctx context.Context, request *pfs.InspectRepoReq
 		repoInfo.Details = &pfs.RepoInfo_Details{}
 	}
 	repoInfo.Details.SizeBytes = size
+	usage, err := a.driver.getStorageUsage(ctx, pfsdb.RepoKey(repoInfo.Repo))
+	if err != nil {
+		return nil, err
+	}
+	repoInfo.Details.Usage = usage
 	return repoInfo, nil
 }
 
@@ -269,7 +274,7 @@ func (a *apiServer) StartCommit(ctx context.Context, request *pfs.StartCommitReq
 // inside an existing postgres transaction.  This is not an RPC.
 func (a *apiServer) FinishCommitInTransaction(ctx context.Context, txnCtx *txncontext.TransactionContext, request *pfs.FinishCommitRequest) error {
 	return metrics.ReportRequest(func() error {
-		return a.driver.finishCommit(ctx, txnCtx, request.Commit, request.Description, request.Error, request.Force)
+		return a.driver.finishCommit(ctx, txnCtx, request.Commit, request.Description, request.Checkpoint, request.Error, request.Force)
 	})
 }
 
@@ -292,7 +297,18 @@ func (a *apiServer) InspectCommitInTransaction(ctx context.Context, txnCtx *txnc
 
 // InspectCommit implements the protobuf pfs.InspectCommit RPC
 func (a *apiServer) InspectCommit(ctx context.Context, request *pfs.InspectCommitRequest) (response *pfs.CommitInfo, retErr error) {
-	return a.driver.inspectCommit(ctx, request.Commit, request.Wait)
+	commitInfo, err := a.driver.inspectCommit(ctx, request.Commit, request.Wait)
+	if err != nil {
+		return nil, err
+	}
+	if commitInfo.Details != nil {
+		usage, err := a.driver.getStorageUsage(ctx, pfsdb.CommitKey(commitInfo.Commit))
+		if err != nil {
+			return nil, err
+		}
+		commitInfo.Details.Usage = usage
+	}
+	return commitInfo, nil
 }
 
 // ListCommit implements the protobuf pfs.ListCommit RPC
@@ -369,6 +385,14 @@ func (a *apiServer) ClearCommit(ctx context.Context, request *pfs.ClearCommitReq
 	return &emptypb.Empty{}, a.driver.clearCommit(ctx, request.Commit)
 }
 
+// ApproveCommit implements the protobuf pfs.ApproveCommit RPC
+func (a *apiServer) ApproveCommit(ctx context.Context, request *pfs.ApproveCommitRequest) (*emptypb.Empty, error) {
+	if err := a.driver.approveCommit(ctx, request.Commit, request.Principal, request.Comment); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
 // FindCommits searches for commits that reference a supplied file being modified in a branch.
 func (a *apiServer) FindCommits(request *pfs.FindCommitsRequest, srv pfs.API_FindCommitsServer) error {
 	var cancel context.CancelFunc
@@ -451,7 +475,7 @@ func (a *apiServer) CreateProject(ctx context.Context, request *pfs.CreateProjec
 
 // InspectProject implements the protobuf pfs.InspectProject RPC
 func (a *apiServer) InspectProject(ctx context.Context, request *pfs.InspectProjectRequest) (*pfs.ProjectInfo, error) {
-	return a.driver.inspectProject(ctx, request.Project)
+	return a.driver.inspectProject(ctx, request.Project, request.Details)
 }
 
 // ListProject implements the protobuf pfs.ListProject RPC
@@ -516,7 +540,7 @@ func (a *apiServer) modifyFile(ctx context.Context, uw *fileset.UnorderedWriter,
 			case *pfs.AddFile_Raw:
 				n, err = putFileRaw(ctx, uw, p, t, src.Raw)
 			case *pfs.AddFile_Url:
-				n, err = putFileURL(ctx, a.env.TaskService, uw, p, t, src.Url)
+				n, err = putFileURL(ctx, a.env.TaskService, kubeSecrets(a.env.GetKubeClient, a.env.Namespace), uw, p, t, src.Url)
 			default:
 				// need to write empty data to path
 				n, err = putFileRaw(ctx, uw, p, t, &wrapperspb.BytesValue{})
@@ -906,3 +930,164 @@ func (a *apiServer) Egress(ctx context.Context, req *pfs.EgressRequest) (*pfs.Eg
 	}
 	return nil, errors.Errorf("egress failed")
 }
+
+// CreateReplication implements the protobuf pfs.CreateReplication RPC
+func (a *apiServer) CreateReplication(ctx context.Context, request *pfs.CreateReplicationRequest) (*emptypb.Empty, error) {
+	if err := a.driver.createReplication(ctx, request); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// InspectReplication implements the protobuf pfs.InspectReplication RPC
+func (a *apiServer) InspectReplication(ctx context.Context, request *pfs.InspectReplicationRequest) (*pfs.ReplicationInfo, error) {
+	return a.driver.inspectReplication(ctx, request.Replication)
+}
+
+// ListReplication implements the protobuf pfs.ListReplication RPC
+func (a *apiServer) ListReplication(request *pfs.ListReplicationRequest, srv pfs.API_ListReplicationServer) error {
+	return a.driver.listReplication(srv.Context(), srv.Send)
+}
+
+// DeleteReplication implements the protobuf pfs.DeleteReplication RPC
+func (a *apiServer) DeleteReplication(ctx context.Context, request *pfs.DeleteReplicationRequest) (*emptypb.Empty, error) {
+	if err := a.driver.deleteReplication(ctx, request.Replication); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// MissingChunks implements the protobuf pfs.MissingChunks RPC
+func (a *apiServer) MissingChunks(ctx context.Context, request *pfs.MissingChunksRequest) (*pfs.MissingChunksResponse, error) {
+	missing, err := a.driver.missingChunks(ctx, request.ChunkIds)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.MissingChunksResponse{ChunkIds: missing}, nil
+}
+
+// ReplicateFileSet implements the protobuf pfs.ReplicateFileSet RPC
+func (a *apiServer) ReplicateFileSet(server pfs.API_ReplicateFileSetServer) error {
+	fsID, err := a.driver.replicateFileSet(server.Context(), server.Recv)
+	if err != nil {
+		return err
+	}
+	return errors.EnsureStack(server.SendAndClose(&pfs.CreateFileSetResponse{
+		FileSetId: fsID.HexString(),
+	}))
+}
+
+// SetRetentionPolicy implements the protobuf pfs.SetRetentionPolicy RPC
+func (a *apiServer) SetRetentionPolicy(ctx context.Context, request *pfs.SetRetentionPolicyRequest) (*emptypb.Empty, error) {
+	if err := a.driver.setRetentionPolicy(ctx, request); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListRetentionPolicy implements the protobuf pfs.ListRetentionPolicy RPC
+func (a *apiServer) ListRetentionPolicy(request *pfs.ListRetentionPolicyRequest, srv pfs.API_ListRetentionPolicyServer) error {
+	return a.driver.listRetentionPolicy(srv.Context(), request.Repo, srv.Send)
+}
+
+// EnforceRetentionPolicy implements the protobuf pfs.EnforceRetentionPolicy RPC
+func (a *apiServer) EnforceRetentionPolicy(request *pfs.EnforceRetentionPolicyRequest, srv pfs.API_EnforceRetentionPolicyServer) error {
+	return a.driver.enforceRetentionPolicy(srv.Context(), request.Repo, request.DryRun, srv.Send)
+}
+
+// CreateUploadSession implements the protobuf pfs.CreateUploadSession RPC
+func (a *apiServer) CreateUploadSession(ctx context.Context, request *pfs.CreateUploadSessionRequest) (*pfs.UploadSessionInfo, error) {
+	return a.driver.createUploadSession(ctx, request)
+}
+
+// InspectUploadSession implements the protobuf pfs.InspectUploadSession RPC
+func (a *apiServer) InspectUploadSession(ctx context.Context, request *pfs.InspectUploadSessionRequest) (*pfs.UploadSessionInfo, error) {
+	return a.driver.inspectUploadSession(ctx, request.Id)
+}
+
+// ListUploadSession implements the protobuf pfs.ListUploadSession RPC
+func (a *apiServer) ListUploadSession(request *pfs.ListUploadSessionRequest, srv pfs.API_ListUploadSessionServer) error {
+	return a.driver.listUploadSession(srv.Context(), request.Repo, srv.Send)
+}
+
+// UploadPart implements the protobuf pfs.UploadPart RPC
+func (a *apiServer) UploadPart(server pfs.API_UploadPartServer) error {
+	return metrics.ReportRequestWithThroughput(func() (int64, error) {
+		first, err := server.Recv()
+		if err != nil {
+			return 0, errors.EnsureStack(err)
+		}
+		r := &uploadPartReader{server: server, buf: first.Data}
+		part, err := a.driver.uploadPart(server.Context(), first.SessionId, first.Number, r)
+		if err != nil {
+			return r.n, err
+		}
+		return r.n, errors.EnsureStack(server.SendAndClose(part))
+	})
+}
+
+// uploadPartReader reads the data of an UploadPart stream.
+type uploadPartReader struct {
+	server pfs.API_UploadPartServer
+	buf    []byte
+	n      int64
+}
+
+func (r *uploadPartReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		msg, err := r.server.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return 0, io.EOF
+			}
+			return 0, errors.EnsureStack(err)
+		}
+		r.buf = msg.Data
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	r.n += int64(n)
+	return n, nil
+}
+
+// CommitUploadSession implements the protobuf pfs.CommitUploadSession RPC
+func (a *apiServer) CommitUploadSession(ctx context.Context, request *pfs.CommitUploadSessionRequest) (*emptypb.Empty, error) {
+	if err := a.driver.commitUploadSession(ctx, request.Id, request.Parts); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteUploadSession implements the protobuf pfs.DeleteUploadSession RPC
+func (a *apiServer) DeleteUploadSession(ctx context.Context, request *pfs.DeleteUploadSessionRequest) (*emptypb.Empty, error) {
+	if err := a.driver.deleteUploadSession(ctx, request.Id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// SetCommitValidation implements the protobuf pfs.SetCommitValidation RPC
+func (a *apiServer) SetCommitValidation(ctx context.Context, request *pfs.SetCommitValidationRequest) (*emptypb.Empty, error) {
+	if err := a.driver.setCommitValidation(ctx, request); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListCommitValidation implements the protobuf pfs.ListCommitValidation RPC
+func (a *apiServer) ListCommitValidation(request *pfs.ListCommitValidationRequest, srv pfs.API_ListCommitValidationServer) error {
+	return a.driver.listCommitValidation(srv.Context(), request.Repo, srv.Send)
+}
+
+// SetStoragePlacement implements the protobuf pfs.SetStoragePlacement RPC
+func (a *apiServer) SetStoragePlacement(ctx context.Context, request *pfs.SetStoragePlacementRequest) (*emptypb.Empty, error) {
+	if err := a.driver.setStoragePlacement(ctx, request); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListStoragePlacement implements the protobuf pfs.ListStoragePlacement RPC
+func (a *apiServer) ListStoragePlacement(request *pfs.ListStoragePlacementRequest, srv pfs.API_ListStoragePlacementServer) error {
+	return a.driver.listStoragePlacement(srv.Context(), request.Repo, srv.Send)
+}
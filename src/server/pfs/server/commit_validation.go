@@ -0,0 +1,204 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/contentcontract"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+func (d *driver) setCommitValidation(ctx context.Context, req *pfs.SetCommitValidationRequest) error {
+	// Only repo owners may change a repo's validation, since writers could
+	// otherwise remove it to get a commit through.
+	if err := d.env.Auth.CheckRepoIsAuthorized(ctx, req.Repo, auth.Permission_REPO_MODIFY_BINDINGS); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return dbutil.WithTx(ctx, d.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		if _, err := pfsdb.GetRepoByName(ctx, tx, req.Repo.Project.Name, req.Repo.Name, req.Repo.Type); err != nil {
+			if pfsdb.IsErrRepoNotFound(err) {
+				return pfsserver.ErrRepoNotFound{Repo: req.Repo}
+			}
+			return errors.EnsureStack(err)
+		}
+		validations := d.commitValidations.ReadWrite(tx)
+		if req.Contract == nil && req.Validator == nil {
+			return errors.EnsureStack(validations.Delete(req.Repo))
+		}
+		if req.Validator != nil {
+			if err := d.checkValidator(ctx, tx, req.Repo, req.Validator); err != nil {
+				return err
+			}
+		}
+		return errors.EnsureStack(validations.Put(req.Repo, &pfs.CommitValidationInfo{
+			Repo:      req.Repo,
+			Contract:  req.Contract,
+			Validator: req.Validator,
+		}))
+	})
+}
+
+// checkValidator returns an error unless validator is the output repo of a
+// pipeline which takes repo as input.
+func (d *driver) checkValidator(ctx context.Context, tx *pachsql.Tx, repo, validator *pfs.Repo) error {
+	if _, err := pfsdb.GetRepoByName(ctx, tx, validator.Project.Name, validator.Name, validator.Type); err != nil {
+		if pfsdb.IsErrRepoNotFound(err) {
+			return pfsserver.ErrRepoNotFound{Repo: validator}
+		}
+		return errors.EnsureStack(err)
+	}
+	branchInfo := &pfs.BranchInfo{}
+	var isDownstream bool
+	if err := d.branches.ReadWrite(tx).GetByIndex(pfsdb.BranchesRepoIndex, pfsdb.RepoKey(validator), branchInfo, col.DefaultOptions(), func(string) error {
+		for _, b := range branchInfo.DirectProvenance {
+			if pfsdb.RepoKey(b.Repo) == pfsdb.RepoKey(repo) {
+				isDownstream = true
+			}
+		}
+		return nil
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if !isDownstream {
+		return errors.Errorf("validator %v does not take %v as input", validator, repo)
+	}
+	return nil
+}
+
+func (d *driver) listCommitValidation(ctx context.Context, repo *pfs.Repo, cb func(*pfs.CommitValidationInfo) error) error {
+	info := &pfs.CommitValidationInfo{}
+	if repo != nil {
+		if err := d.commitValidations.ReadOnly(ctx).Get(repo, info); err != nil {
+			if col.IsErrNotFound(err) {
+				return nil
+			}
+			return errors.EnsureStack(err)
+		}
+		return cb(info)
+	}
+	return errors.EnsureStack(d.commitValidations.ReadOnly(ctx).List(info, col.DefaultOptions(), func(string) error {
+		return cb(proto.Clone(info).(*pfs.CommitValidationInfo))
+	}))
+}
+
+// checkCommitValidation checks the files of a commit, in the total file set
+// id, against the validation of its repo.  It returns nil if the repo has no
+// validation, and a pending result if the files satisfy the contract but the
+// repo has a validator.
+func (d *driver) checkCommitValidation(ctx context.Context, commit *pfs.Commit, id fileset.ID) (*pfs.CommitValidationResult, error) {
+	info := &pfs.CommitValidationInfo{}
+	if err := d.commitValidations.ReadOnly(ctx).Get(commit.Repo, info); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	if info.Contract != nil {
+		fs, err := d.storage.Filesets.Open(ctx, []fileset.ID{id})
+		if err != nil {
+			return nil, err
+		}
+		files := &commitFiles{ctx: ctx, src: NewSource(&pfs.CommitInfo{Commit: commit}, fs)}
+		if err := contentcontract.Check(ctx, info.Contract, files); err != nil {
+			var violation contentcontract.ErrViolated
+			if errors.As(err, &violation) {
+				return &pfs.CommitValidationResult{Reason: violation.Reason}, nil
+			}
+			return nil, err
+		}
+	}
+	if info.Validator != nil {
+		return &pfs.CommitValidationResult{Pending: true}, nil
+	}
+	return &pfs.CommitValidationResult{Passed: true}, nil
+}
+
+// resolveCommitValidations is called when a commit is finished.  If its repo
+// is the validator of other repos, the commits to them in the same commit set
+// which are waiting for it pass their validation if it succeeded, and fail
+// otherwise.  The triggers of the ones which pass are then fired.
+func (d *driver) resolveCommitValidations(ctx context.Context, txnCtx *txncontext.TransactionContext, outputInfo *pfs.CommitInfo) error {
+	if outputInfo.Commit.Repo.Type != pfs.UserRepoType {
+		return nil
+	}
+	var repos []*pfs.Repo
+	info := &pfs.CommitValidationInfo{}
+	if err := d.commitValidations.ReadWrite(txnCtx.SqlTx).List(info, col.DefaultOptions(), func(string) error {
+		if info.Validator != nil && pfsdb.RepoKey(info.Validator) == pfsdb.RepoKey(outputInfo.Commit.Repo) {
+			repos = append(repos, info.Repo)
+		}
+		return nil
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	for _, repo := range repos {
+		commitInfo := &pfs.CommitInfo{}
+		if err := d.commits.ReadWrite(txnCtx.SqlTx).Get(repo.NewCommit("", outputInfo.Commit.Id), commitInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				continue
+			}
+			return errors.EnsureStack(err)
+		}
+		if !commitInfo.Details.GetValidation().GetPending() {
+			continue
+		}
+		if err := d.commits.ReadWrite(txnCtx.SqlTx).Update(commitInfo.Commit, commitInfo, func() error {
+			v := commitInfo.Details.Validation
+			v.Pending = false
+			if outputInfo.Error == "" {
+				v.Passed = true
+				return nil
+			}
+			v.Reason = fmt.Sprintf("validator %v failed: %s", outputInfo.Commit.Repo, outputInfo.Error)
+			commitInfo.Error = fmt.Sprintf("commit validation failed: %s", v.Reason)
+			return nil
+		}); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if commitInfo.Error == "" {
+			if err := d.triggerHead(ctx, txnCtx, commitInfo); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// commitFiles are the files of a commit which is being finished.  They're read
+// from its file set, since the commit can't be opened until it's finished.
+type commitFiles struct {
+	ctx context.Context
+	src Source
+	// current is the file being walked.
+	current     fileset.File
+	currentPath string
+}
+
+func (f *commitFiles) Walk(cb func(contentcontract.File) error) error {
+	return errors.EnsureStack(f.src.Iterate(f.ctx, func(fi *pfs.FileInfo, file fileset.File) error {
+		if fi.FileType != pfs.FileType_FILE {
+			return nil
+		}
+		f.current, f.currentPath = file, fi.File.Path
+		return cb(contentcontract.File{Path: fi.File.Path, SizeBytes: fi.SizeBytes})
+	}))
+}
+
+func (f *commitFiles) Get(path string, w io.Writer) error {
+	if f.current == nil || path != f.currentPath {
+		return errors.Errorf("%s is not the file being walked", path)
+	}
+	return errors.EnsureStack(f.current.Content(f.ctx, w))
+}
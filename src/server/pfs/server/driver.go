@@ -16,6 +16,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
@@ -78,8 +79,17 @@ type driver struct {
 	prefix     string
 
 	// collections
-	commits  col.PostgresCollection
-	branches col.PostgresCollection
+	commits      col.PostgresCollection
+	branches     col.PostgresCollection
+	replications col.PostgresCollection
+	// retentionPolicies is a collection of retention policies, keyed by branch.
+	retentionPolicies col.PostgresCollection
+	// uploadSessions is a collection of upload sessions, keyed by ID.
+	uploadSessions col.PostgresCollection
+	// commitValidations is a collection of commit validations, keyed by repo.
+	commitValidations col.PostgresCollection
+	// storagePlacements is a collection of storage placements, keyed by repo.
+	storagePlacements col.PostgresCollection
 
 	storage     *storage.Server
 	commitStore commitStore
@@ -99,17 +109,27 @@ func newDriver(env Env) (*driver, error) {
 	}
 	commits := pfsdb.Commits(env.DB, env.Listener)
 	branches := pfsdb.Branches(env.DB, env.Listener)
+	replications := pfsdb.Replications(env.DB, env.Listener)
+	retentionPolicies := pfsdb.RetentionPolicies(env.DB, env.Listener)
+	uploadSessions := pfsdb.UploadSessions(env.DB, env.Listener)
+	commitValidations := pfsdb.CommitValidations(env.DB, env.Listener)
+	storagePlacements := pfsdb.StoragePlacements(env.DB, env.Listener)
 
 	// Setup driver struct.
 	d := &driver{
-		env:        env,
-		etcdClient: env.EtcdClient,
-		txnEnv:     env.TxnEnv,
-		prefix:     env.EtcdPrefix,
-		commits:    commits,
-		branches:   branches,
-	}
-	storageSrv, err := storage.New(storage.Env{DB: env.DB, ObjectStore: env.ObjectClient}, env.StorageConfig)
+		env:               env,
+		etcdClient:        env.EtcdClient,
+		txnEnv:            env.TxnEnv,
+		prefix:            env.EtcdPrefix,
+		commits:           commits,
+		branches:          branches,
+		replications:      replications,
+		retentionPolicies: retentionPolicies,
+		uploadSessions:    uploadSessions,
+		commitValidations: commitValidations,
+		storagePlacements: storagePlacements,
+	}
+	storageSrv, err := storage.New(storage.Env{DB: env.DB, ObjectStore: env.ObjectClient, PeerCache: env.PeerCache}, env.StorageConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -468,6 +488,15 @@ func (d *driver) deleteRepoInfo(ctx context.Context, txnCtx *txncontext.Transact
 	if err := d.commits.ReadWrite(txnCtx.SqlTx).DeleteByIndex(pfsdb.CommitsRepoIndex, pfsdb.RepoKey(ri.Repo)); err != nil {
 		return errors.EnsureStack(err)
 	}
+	if err := d.retentionPolicies.ReadWrite(txnCtx.SqlTx).DeleteByIndex(pfsdb.RetentionPoliciesRepoIndex, pfsdb.RepoKey(ri.Repo)); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if err := d.commitValidations.ReadWrite(txnCtx.SqlTx).Delete(ri.Repo); err != nil && !col.IsErrNotFound(err) {
+		return errors.EnsureStack(err)
+	}
+	if err := d.storagePlacements.ReadWrite(txnCtx.SqlTx).Delete(ri.Repo); err != nil && !col.IsErrNotFound(err) {
+		return errors.EnsureStack(err)
+	}
 	if err := pfsdb.DeleteRepo(ctx, txnCtx.SqlTx, ri.Repo.Project.Name, ri.Repo.Name, ri.Repo.Type); err != nil && !pfsdb.IsErrRepoNotFound(err) {
 		return errors.Wrapf(err, "repos.Delete")
 	}
@@ -532,9 +561,31 @@ func (d *driver) createProjectInTransaction(ctx context.Context, txnCtx *txncont
 	if err := req.Project.ValidateName(); err != nil {
 		return errors.Wrapf(err, "invalid project name")
 	}
+	if req.Quota != nil {
+		for _, q := range []string{req.Quota.MaxCpu, req.Quota.MaxMemory} {
+			if q == "" {
+				continue
+			}
+			if _, err := resource.ParseQuantity(q); err != nil {
+				return errors.Wrapf(err, "invalid quota %q", q)
+			}
+		}
+		if err := d.env.Auth.CheckClusterIsAuthorizedInTransaction(txnCtx, auth.Permission_CLUSTER_SET_PROJECT_QUOTAS); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
 	if req.Update {
+		quota := req.Quota
+		if quota == nil {
+			pi, err := coredb.GetProjectByName(ctx, txnCtx.SqlTx, pfsdb.ProjectKey(req.Project))
+			if err != nil && !errors.Is(err, coredb.ErrProjectNotFound{}) {
+				return errors.Wrapf(err, "failed to update project %s", req.Project.Name)
+			} else if err == nil {
+				quota = pi.Quota
+			}
+		}
 		return errors.Wrapf(coredb.UpsertProject(ctx, txnCtx.SqlTx,
-			&pfs.ProjectInfo{Project: req.Project, Description: req.Description}),
+			&pfs.ProjectInfo{Project: req.Project, Description: req.Description, Quota: quota}),
 			"failed to update project %s", req.Project.Name)
 	}
 	// If auth is active, make caller the owner of this new project.
@@ -554,6 +605,7 @@ func (d *driver) createProjectInTransaction(ctx context.Context, txnCtx *txncont
 		Project:     req.Project,
 		Description: req.Description,
 		CreatedAt:   timestamppb.Now(),
+		Quota:       req.Quota,
 	}); err != nil {
 		if errors.As(err, &col.ErrExists{}) {
 			return pfsserver.ErrProjectExists{
@@ -565,7 +617,7 @@ func (d *driver) createProjectInTransaction(ctx context.Context, txnCtx *txncont
 	return nil
 }
 
-func (d *driver) inspectProject(ctx context.Context, project *pfs.Project) (*pfs.ProjectInfo, error) {
+func (d *driver) inspectProject(ctx context.Context, project *pfs.Project, details bool) (*pfs.ProjectInfo, error) {
 	var pi *pfs.ProjectInfo
 	if err := dbutil.WithTx(ctx, d.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
 		var err error
@@ -573,6 +625,13 @@ func (d *driver) inspectProject(ctx context.Context, project *pfs.Project) (*pfs
 		if err != nil {
 			return err
 		}
+		if details {
+			usage, err := pfsdb.GetStorageUsage(ctx, tx, pfsdb.ProjectKey(project))
+			if err != nil {
+				return err
+			}
+			pi.Details = &pfs.ProjectInfo_Details{Usage: usage}
+		}
 		return nil
 	}); err != nil {
 		return nil, errors.Wrapf(err, "error getting project %q", project)
@@ -842,7 +901,7 @@ func (d *driver) startCommit(
 		return nil, errors.Errorf("branch must be specified")
 	}
 	// Check that caller is authorized
-	if err := d.env.Auth.CheckRepoIsAuthorizedInTransaction(txnCtx, branch.Repo, auth.Permission_REPO_WRITE); err != nil {
+	if err := d.env.Auth.CheckBranchIsAuthorizedInTransaction(txnCtx, branch, auth.Permission_REPO_WRITE); err != nil {
 		return nil, errors.EnsureStack(err)
 	}
 	// New commit and commitInfo
@@ -895,7 +954,7 @@ func (d *driver) startCommit(
 	return newCommitInfo.Commit, nil
 }
 
-func (d *driver) finishCommit(ctx context.Context, txnCtx *txncontext.TransactionContext, commit *pfs.Commit, description, commitError string, force bool) error {
+func (d *driver) finishCommit(ctx context.Context, txnCtx *txncontext.TransactionContext, commit *pfs.Commit, description, checkpoint, commitError string, force bool) error {
 	commitInfo, err := d.resolveCommit(ctx, txnCtx.SqlTx, commit)
 	if err != nil {
 		return err
@@ -913,9 +972,16 @@ func (d *driver) finishCommit(ctx context.Context, txnCtx *txncontext.Transactio
 		}
 		// otherwise, this either isn't a pipeline at all, or is a spout or service for which we should allow finishing
 	}
+	if commitError == "" {
+		commitError, err = d.checkProjectQuota(ctx, txnCtx.SqlTx, commitInfo.Commit.Repo)
+		if err != nil {
+			return err
+		}
+	}
 	if description != "" {
 		commitInfo.Description = description
 	}
+	commitInfo.Checkpoint = checkpoint
 	commitInfo.Finishing = txnCtx.Timestamp
 	commitInfo.Error = commitError
 	return errors.EnsureStack(d.commits.ReadWrite(txnCtx.SqlTx).Put(commitInfo.Commit, commitInfo))
@@ -1723,7 +1789,7 @@ func (d *driver) createBranch(ctx context.Context, txnCtx *txncontext.Transactio
 		return errors.New("a branch cannot have both provenance and a trigger")
 	}
 	var err error
-	if err := d.env.Auth.CheckRepoIsAuthorizedInTransaction(txnCtx, branch.Repo, auth.Permission_REPO_CREATE_BRANCH); err != nil {
+	if err := d.env.Auth.CheckBranchIsAuthorizedInTransaction(txnCtx, branch, auth.Permission_REPO_CREATE_BRANCH); err != nil {
 		return errors.EnsureStack(err)
 	}
 	// Validate request
@@ -1925,7 +1991,7 @@ func (d *driver) deleteBranch(ctx context.Context, txnCtx *txncontext.Transactio
 		return errors.New("branch repo cannot be nil")
 	}
 	branch.Repo.EnsureProject()
-	if err := d.env.Auth.CheckRepoIsAuthorizedInTransaction(txnCtx, branch.Repo, auth.Permission_REPO_DELETE_BRANCH); err != nil {
+	if err := d.env.Auth.CheckBranchIsAuthorizedInTransaction(txnCtx, branch, auth.Permission_REPO_DELETE_BRANCH); err != nil {
 		return errors.EnsureStack(err)
 	}
 	// make sure repo exists.
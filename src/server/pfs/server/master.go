@@ -78,6 +78,9 @@ func (m *Master) Run(ctx context.Context) error {
 				gc := m.driver.storage.Filesets.NewGC(trackerPeriod)
 				return gc.RunForever(pctx.Child(ctx, "storage-gc"))
 			})
+			eg.Go(func() error {
+				return m.collectUploadSessions(pctx.Child(ctx, "upload-sessions"), trackerPeriod)
+			})
 		}
 		chunkPeriod := time.Second * time.Duration(m.env.StorageConfig.StorageChunkGCPeriod)
 		if chunkPeriod <= 0 {
@@ -102,6 +105,33 @@ func (m *Master) Run(ctx context.Context) error {
 		eg.Go(func() error {
 			return m.watchRepos(ctx)
 		})
+		eg.Go(func() error {
+			return m.watchReplications(ctx)
+		})
+		retentionPeriod := time.Second * time.Duration(m.env.StorageConfig.StorageRetentionPeriod)
+		if retentionPeriod <= 0 {
+			log.Info(ctx, "Skipping Retention Policy Enforcement")
+		} else {
+			eg.Go(func() error {
+				return m.enforceRetentionPolicies(pctx.Child(ctx, "retention"), retentionPeriod)
+			})
+		}
+		usagePeriod := time.Second * time.Duration(m.env.StorageConfig.StorageUsagePeriod)
+		if usagePeriod <= 0 {
+			log.Info(ctx, "Skipping Storage Usage Accounting")
+		} else {
+			eg.Go(func() error {
+				return m.accountStorageUsage(pctx.Child(ctx, "usage"), usagePeriod)
+			})
+		}
+		tierPeriod := time.Second * time.Duration(m.env.StorageConfig.StorageTierMigrationPeriod)
+		if len(m.driver.storage.Chunks.Tiers()) == 0 || tierPeriod <= 0 {
+			log.Info(ctx, "Skipping Storage Tier Migration")
+		} else {
+			eg.Go(func() error {
+				return m.migrateStorageTiers(pctx.Child(ctx, "tiers"), tierPeriod)
+			})
+		}
 		return errors.EnsureStack(eg.Wait())
 	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
 		log.Error(ctx, "error in pfs master; restarting", zap.Error(err), zap.Duration("retryAfter", d))
@@ -400,6 +430,20 @@ func (d *driver) finishRepoCommits(ctx context.Context, repoKey string) error {
 					}); err != nil {
 						return err
 					}
+					// Check the commit against its repo's validation, unless it's
+					// already invalid.
+					if validationError == "" {
+						if err := log.LogStep(ctx, "checkCommitValidation", func(ctx context.Context) error {
+							var err error
+							details.Validation, err = d.checkCommitValidation(ctx, commit, *totalId)
+							return err
+						}); err != nil {
+							return err
+						}
+						if v := details.Validation; v != nil && !v.Passed && !v.Pending {
+							validationError = fmt.Sprintf("commit validation failed: %s", v.Reason)
+						}
+					}
 					details.ValidatingTime = durationpb.New(time.Since(start))
 					// Finish the commit.
 					return d.finalizeCommit(ctx, commit, validationError, details, totalId)
@@ -465,6 +509,9 @@ func (d *driver) finalizeCommit(ctx context.Context, commit *pfs.Commit, validat
 			if commitInfo.Commit.Repo.Type == pfs.UserRepoType {
 				txnCtx.FinishJob(commitInfo)
 			}
+			if err := d.resolveCommitValidations(ctx, txnCtx, commitInfo); err != nil {
+				return err
+			}
 			if commitInfo.Error == "" {
 				return d.triggerCommit(ctx, txnCtx, commitInfo)
 			}
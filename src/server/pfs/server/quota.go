@@ -0,0 +1,48 @@
+package server
+
+import (
+	"context"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/coredb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+// checkProjectQuota checks whether the project of repo has exceeded its
+// storage quota.  If it has a hard quota an ErrProjectQuotaExceeded is
+// returned, and if it has a soft quota the error message that commits in repo
+// should be finished with is returned.  The usage of a project is the one
+// cached by the last storage usage accounting pass, so a project can exceed its
+// quota by the data written since then.
+func (d *driver) checkProjectQuota(ctx context.Context, tx *pachsql.Tx, repo *pfs.Repo) (string, error) {
+	if repo.Type != pfs.UserRepoType {
+		return "", nil
+	}
+	pi, err := coredb.GetProjectByName(ctx, tx, pfsdb.ProjectKey(repo.Project))
+	if err != nil {
+		return "", errors.Wrapf(err, "error getting project %q", repo.Project)
+	}
+	if pi.Quota.GetMaxBytes() == 0 {
+		return "", nil
+	}
+	usage, err := pfsdb.GetStorageUsage(ctx, tx, pfsdb.ProjectKey(repo.Project))
+	if err != nil {
+		return "", err
+	}
+	used := usage.GetExclusiveBytes() + usage.GetSharedBytes()
+	if used <= pi.Quota.MaxBytes {
+		return "", nil
+	}
+	quotaErr := pfsserver.ErrProjectQuotaExceeded{
+		Project:   repo.Project,
+		UsedBytes: used,
+		MaxBytes:  pi.Quota.MaxBytes,
+	}
+	if pi.Quota.Enforcement == pfs.ProjectQuota_HARD {
+		return "", quotaErr
+	}
+	return quotaErr.Error(), nil
+}
@@ -0,0 +1,625 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"path"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/watch"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+// missingChunksBatchSize is the maximum number of chunk IDs sent in a single MissingChunks request.
+const missingChunksBatchSize = 1000
+
+var (
+	replicationLagMetric = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_replication",
+		Name:      "lag_seconds",
+		Help:      "Time between the last replicated commit finishing on the source and it finishing on the target, by replication and branch.",
+	}, []string{"replication", "branch"})
+
+	replicationCommitsMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_replication",
+		Name:      "commits_total",
+		Help:      "Number of commits replicated, by replication.",
+	}, []string{"replication"})
+
+	replicationBytesMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_replication",
+		Name:      "transferred_bytes_total",
+		Help:      "Number of chunk bytes sent to replication targets, by replication.",
+	}, []string{"replication"})
+)
+
+func (d *driver) createReplication(ctx context.Context, req *pfs.CreateReplicationRequest) error {
+	return dbutil.WithTx(ctx, d.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		replications := d.replications.ReadWrite(tx)
+		info := &pfs.ReplicationInfo{}
+		if err := replications.Get(req.Replication, info); err != nil {
+			if !col.IsErrNotFound(err) {
+				return errors.EnsureStack(err)
+			}
+			info = &pfs.ReplicationInfo{
+				Replication: req.Replication,
+				CreatedAt:   timestamppb.Now(),
+			}
+		} else if !req.Update {
+			return pfsserver.ErrReplicationExists{Replication: req.Replication}
+		}
+		info.Target = req.Target
+		info.Branches = req.Branches
+		// Keep the status of branches that are still being replicated, so
+		// that they pick up where they left off.
+		var status []*pfs.BranchReplicationStatus
+		for _, s := range info.Status {
+			for _, b := range req.Branches {
+				if proto.Equal(s.Branch, b) {
+					status = append(status, s)
+					break
+				}
+			}
+		}
+		info.Status = status
+		return errors.EnsureStack(replications.Put(req.Replication, info))
+	})
+}
+
+func (d *driver) inspectReplication(ctx context.Context, replication *pfs.Replication) (*pfs.ReplicationInfo, error) {
+	info := &pfs.ReplicationInfo{}
+	if err := d.replications.ReadOnly(ctx).Get(replication, info); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return info, nil
+}
+
+func (d *driver) listReplication(ctx context.Context, cb func(*pfs.ReplicationInfo) error) error {
+	info := &pfs.ReplicationInfo{}
+	return errors.EnsureStack(d.replications.ReadOnly(ctx).List(info, col.DefaultOptions(), func(string) error {
+		return cb(proto.Clone(info).(*pfs.ReplicationInfo))
+	}))
+}
+
+func (d *driver) deleteReplication(ctx context.Context, replication *pfs.Replication) error {
+	return dbutil.WithTx(ctx, d.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		return errors.EnsureStack(d.replications.ReadWrite(tx).Delete(replication))
+	})
+}
+
+func (d *driver) updateReplicationStatus(ctx context.Context, replication *pfs.Replication, branch *pfs.Branch, f func(*pfs.BranchReplicationStatus)) error {
+	return dbutil.WithTx(ctx, d.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		info := &pfs.ReplicationInfo{}
+		return errors.EnsureStack(d.replications.ReadWrite(tx).Update(replication, info, func() error {
+			for _, s := range info.Status {
+				if proto.Equal(s.Branch, branch) {
+					f(s)
+					return nil
+				}
+			}
+			s := &pfs.BranchReplicationStatus{Branch: branch}
+			f(s)
+			info.Status = append(info.Status, s)
+			return nil
+		}))
+	})
+}
+
+func (d *driver) missingChunks(ctx context.Context, ids [][]byte) ([][]byte, error) {
+	var chunkIDs []chunk.ID
+	for _, id := range ids {
+		chunkIDs = append(chunkIDs, id)
+	}
+	missing, err := d.storage.Chunks.Missing(ctx, chunkIDs)
+	if err != nil {
+		return nil, err
+	}
+	var result [][]byte
+	for _, id := range missing {
+		result = append(result, id)
+	}
+	return result, nil
+}
+
+// replicateFileSet creates a file set from the chunks and primitive file sets
+// received from a replication source.
+func (d *driver) replicateFileSet(ctx context.Context, recv func() (*pfs.ReplicateFileSetRequest, error)) (*fileset.ID, error) {
+	var result *fileset.ID
+	if err := d.storage.Filesets.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *fileset.Renewer) (retErr error) {
+		chunkRenewer := d.storage.Chunks.NewRenewer(ctx, "replicate-file-set", defaultTTL)
+		defer func() {
+			if err := chunkRenewer.Close(); retErr == nil {
+				retErr = err
+			}
+		}()
+		var ids []fileset.ID
+		for {
+			req, err := recv()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				return errors.EnsureStack(err)
+			}
+			switch x := req.Value.(type) {
+			case *pfs.ReplicateFileSetRequest_Chunk_:
+				var pointsTo []chunk.ID
+				for _, id := range x.Chunk.PointsTo {
+					pointsTo = append(pointsTo, id)
+				}
+				id, err := d.storage.Chunks.CreateStored(ctx, chunkRenewer, pointsTo, x.Chunk.Data)
+				if err != nil {
+					return err
+				}
+				if !bytes.Equal(id, x.Chunk.Id) {
+					return errors.Errorf("chunk %v does not match its content (%v)", chunk.ID(x.Chunk.Id), id)
+				}
+			case *pfs.ReplicateFileSetRequest_Primitive:
+				prim := &fileset.Primitive{}
+				if err := proto.Unmarshal(x.Primitive, prim); err != nil {
+					return errors.EnsureStack(err)
+				}
+				id, err := d.storage.Filesets.CreatePrimitive(ctx, prim, defaultTTL)
+				if err != nil {
+					return err
+				}
+				if err := renewer.Add(ctx, *id); err != nil {
+					return err
+				}
+				ids = append(ids, *id)
+			default:
+				return errors.Errorf("unrecognized replicate file set request type: %T", req.Value)
+			}
+		}
+		var err error
+		result, err = d.storage.Filesets.Compose(ctx, ids, defaultTTL)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// sendFileSet copies the file set with the given ID to the target, only sending the chunks that the
+// target does not have.  It returns the ID of the file set on the target and the number of chunk bytes sent.
+func (d *driver) sendFileSet(ctx context.Context, target *client.APIClient, id fileset.ID) (string, int64, error) {
+	prims, err := d.storage.Filesets.FlattenPrimitives(ctx, []fileset.ID{id})
+	if err != nil {
+		return "", 0, err
+	}
+	ctx, cancel := pctx.WithCancel(ctx)
+	defer cancel()
+	stream, err := target.PfsAPIClient.ReplicateFileSet(ctx)
+	if err != nil {
+		return "", 0, errors.EnsureStack(err)
+	}
+	var sent int64
+	seen := make(map[string]struct{})
+	for _, prim := range prims {
+		ids, err := d.chunkClosure(ctx, prim.PointsTo(), seen)
+		if err != nil {
+			return "", 0, err
+		}
+		for len(ids) > 0 {
+			batch := ids
+			if len(batch) > missingChunksBatchSize {
+				batch = batch[:missingChunksBatchSize]
+			}
+			ids = ids[len(batch):]
+			req := &pfs.MissingChunksRequest{}
+			for _, id := range batch {
+				req.ChunkIds = append(req.ChunkIds, id)
+			}
+			resp, err := target.PfsAPIClient.MissingChunks(ctx, req)
+			if err != nil {
+				return "", 0, errors.EnsureStack(err)
+			}
+			for _, id := range resp.ChunkIds {
+				n, err := d.sendChunk(ctx, stream, id)
+				if err != nil {
+					return "", 0, err
+				}
+				sent += n
+			}
+		}
+		data, err := proto.Marshal(prim)
+		if err != nil {
+			return "", 0, errors.EnsureStack(err)
+		}
+		if err := stream.Send(&pfs.ReplicateFileSetRequest{
+			Value: &pfs.ReplicateFileSetRequest_Primitive{Primitive: data},
+		}); err != nil {
+			return "", 0, errors.EnsureStack(err)
+		}
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return "", 0, errors.EnsureStack(err)
+	}
+	return resp.FileSetId, sent, nil
+}
+
+func (d *driver) sendChunk(ctx context.Context, stream pfs.API_ReplicateFileSetClient, id chunk.ID) (int64, error) {
+	pointsTo, err := d.storage.Chunks.PointsTo(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	msg := &pfs.ReplicateFileSetRequest_Chunk{Id: id}
+	for _, id := range pointsTo {
+		msg.PointsTo = append(msg.PointsTo, id)
+	}
+	var n int64
+	if err := d.storage.Chunks.GetStored(ctx, id, func(data []byte) error {
+		msg.Data = data
+		n = int64(len(data))
+		return errors.EnsureStack(stream.Send(&pfs.ReplicateFileSetRequest{
+			Value: &pfs.ReplicateFileSetRequest_Chunk_{Chunk: msg},
+		}))
+	}); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// chunkClosure returns the chunks reachable from roots which are not in seen, ordered such that
+// each chunk comes after every chunk it points to.
+func (d *driver) chunkClosure(ctx context.Context, roots []chunk.ID, seen map[string]struct{}) ([]chunk.ID, error) {
+	var ids []chunk.ID
+	var walk func(id chunk.ID) error
+	walk = func(id chunk.ID) error {
+		if _, ok := seen[string(id)]; ok {
+			return nil
+		}
+		seen[string(id)] = struct{}{}
+		pointsTo, err := d.storage.Chunks.PointsTo(ctx, id)
+		if err != nil {
+			return err
+		}
+		for _, child := range pointsTo {
+			if err := walk(child); err != nil {
+				return err
+			}
+		}
+		ids = append(ids, id)
+		return nil
+	}
+	for _, id := range roots {
+		if err := walk(id); err != nil {
+			return nil, err
+		}
+	}
+	return ids, nil
+}
+
+func (d *driver) newReplicationClient(ctx context.Context, target *pfs.ReplicationTarget) (*client.APIClient, error) {
+	c, err := client.NewFromURI(ctx, target.PachdAddress)
+	if err != nil {
+		return nil, errors.Wrapf(err, "connect to replication target %q", target.PachdAddress)
+	}
+	if secret := target.AuthTokenSecret; secret != nil {
+		if d.env.GetKubeClient == nil {
+			c.Close()
+			return nil, errors.Errorf("cannot read secret %q without kubernetes", secret.Name)
+		}
+		s, err := d.env.GetKubeClient().CoreV1().Secrets(d.env.Namespace).Get(ctx, secret.Name, metav1.GetOptions{})
+		if err != nil {
+			c.Close()
+			return nil, errors.Wrapf(err, "get secret %q", secret.Name)
+		}
+		token, ok := s.Data[secret.Key]
+		if !ok {
+			c.Close()
+			return nil, errors.Errorf("secret %q has no key %q", secret.Name, secret.Key)
+		}
+		c.SetAuthToken(string(token))
+	}
+	return c, nil
+}
+
+// watchReplications runs every replication, restarting a replication
+// whenever its target or branches change.
+func (m *Master) watchReplications(ctx context.Context) error {
+	lock := dlock.NewDLock(m.driver.etcdClient, path.Join(m.driver.prefix, masterLockPath, "replication"))
+	ctx, err := lock.Lock(ctx)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := lock.Unlock(ctx); err != nil {
+			log.Error(ctx, "error unlocking in pfs master (replication)", zap.Error(err))
+		}
+	}()
+	type running struct {
+		info   *pfs.ReplicationInfo
+		cancel context.CancelFunc
+	}
+	replications := make(map[string]*running)
+	stop := func(name string) {
+		r, ok := replications[name]
+		if !ok {
+			return
+		}
+		r.cancel()
+		for _, b := range r.info.Branches {
+			replicationLagMetric.DeleteLabelValues(name, b.String())
+		}
+		delete(replications, name)
+	}
+	defer func() {
+		for name := range replications {
+			stop(name)
+		}
+	}()
+	return errors.EnsureStack(m.driver.replications.ReadOnly(ctx).WatchF(func(ev *watch.Event) error {
+		if ev.Type == watch.EventDelete {
+			stop(string(ev.Key))
+			return nil
+		}
+		var key string
+		info := &pfs.ReplicationInfo{}
+		if err := ev.Unmarshal(&key, info); err != nil {
+			return errors.Wrap(err, "unmarshal")
+		}
+		if r, ok := replications[key]; ok {
+			// Status updates are written to the same row, so only restart
+			// when the spec changes.
+			if proto.Equal(r.info.Target, info.Target) && branchesEqual(r.info.Branches, info.Branches) {
+				return nil
+			}
+			stop(key)
+		}
+		ctx, cancel := pctx.WithCancel(pctx.Child(ctx, "replication", pctx.WithFields(zap.String("replication", key))))
+		replications[key] = &running{info: info, cancel: cancel}
+		go func() {
+			backoff.RetryUntilCancel(ctx, func() error { //nolint:errcheck
+				return m.runReplication(ctx, info)
+			}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+				log.Error(ctx, "error running replication; retrying", zap.Error(err), zap.Duration("retryAfter", d))
+				return nil
+			})
+		}()
+		return nil
+	}))
+}
+
+func branchesEqual(a, b []*pfs.Branch) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Master) runReplication(ctx context.Context, info *pfs.ReplicationInfo) error {
+	target, err := m.driver.newReplicationClient(ctx, info.Target)
+	if err != nil {
+		return err
+	}
+	defer target.Close()
+	eg, ctx := errgroup.WithContext(ctx)
+	for _, branch := range info.Branches {
+		branch := branch
+		eg.Go(func() error {
+			return backoff.RetryUntilCancel(ctx, func() error {
+				return m.replicateBranch(ctx, info.Replication, target, branch)
+			}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+				log.Error(ctx, "error replicating branch; retrying", zap.Stringer("branch", branch), zap.Error(err), zap.Duration("retryAfter", d))
+				if err := m.driver.updateReplicationStatus(ctx, info.Replication, branch, func(s *pfs.BranchReplicationStatus) {
+					s.Error = err.Error()
+				}); err != nil {
+					log.Error(ctx, "error updating replication status", zap.Error(err))
+				}
+				return nil
+			})
+		})
+	}
+	return errors.EnsureStack(eg.Wait())
+}
+
+func (m *Master) replicateBranch(ctx context.Context, replication *pfs.Replication, target *client.APIClient, branch *pfs.Branch) error {
+	// Start after the last commit that made it to the target.
+	var from *pfs.Commit
+	var after time.Time
+	info, err := m.driver.inspectReplication(ctx, replication)
+	if err != nil {
+		return err
+	}
+	for _, s := range info.Status {
+		if !proto.Equal(s.Branch, branch) || s.LastReplicatedCommit == nil {
+			continue
+		}
+		ci, err := m.driver.inspectCommit(ctx, s.LastReplicatedCommit, pfs.CommitState_STARTED)
+		if err != nil {
+			if !pfsserver.IsCommitNotFoundErr(err) {
+				return err
+			}
+			// The commit has been squashed, so the subscription below starts
+			// from the beginning of the branch and relies on the time that
+			// the commit finished on the source.
+			after = s.LastReplicatedAt.AsTime().Add(-s.Lag.AsDuration())
+		} else {
+			from = ci.Commit
+			after = ci.Finished.AsTime()
+		}
+	}
+	if err := ensureReplicationRepo(target.WithCtx(ctx), branch.Repo); err != nil {
+		return err
+	}
+	return m.driver.subscribeCommit(ctx, branch.Repo, branch.Name, from, pfs.CommitState_FINISHED, false, pfs.OriginKind_ORIGIN_KIND_UNKNOWN, func(ci *pfs.CommitInfo) error {
+		if !after.IsZero() && !ci.Finished.AsTime().After(after) {
+			return nil
+		}
+		return m.replicateCommit(ctx, replication, target, branch, ci)
+	})
+}
+
+func ensureReplicationRepo(target *client.APIClient, repo *pfs.Repo) error {
+	if _, err := target.PfsAPIClient.CreateProject(target.Ctx(), &pfs.CreateProjectRequest{Project: repo.Project}); err != nil && !errutil.IsAlreadyExistError(err) {
+		return errors.Wrapf(err, "create project %q on replication target", repo.Project.GetName())
+	}
+	if _, err := target.PfsAPIClient.CreateRepo(target.Ctx(), &pfs.CreateRepoRequest{Repo: repo}); err != nil && !errutil.IsAlreadyExistError(err) {
+		return errors.Wrapf(err, "create repo %q on replication target", repo)
+	}
+	return nil
+}
+
+func (m *Master) replicateCommit(ctx context.Context, replication *pfs.Replication, target *client.APIClient, branch *pfs.Branch, ci *pfs.CommitInfo) error {
+	targetCommit, err := m.resumeReplicatedCommit(ctx, replication, target.WithCtx(ctx), branch, ci)
+	if err != nil {
+		return errors.Wrapf(err, "replicate commit %v", ci.Commit)
+	}
+	if targetCommit != nil {
+		// An earlier attempt finished the target commit but failed before
+		// recording it.
+		return m.driver.updateReplicationStatus(ctx, replication, branch, func(s *pfs.BranchReplicationStatus) {
+			s.LastReplicatedCommit = ci.Commit
+			s.TargetCommit = targetCommit
+			s.PendingCommit = nil
+			s.PendingTargetCommit = nil
+			s.LastReplicatedAt = timestamppb.Now()
+			s.Error = ""
+		})
+	}
+	var sent int64
+	if err := m.driver.storage.Filesets.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *fileset.Renewer) (retErr error) {
+		diff, err := m.driver.getCompactedDiffFileSet(ctx, ci.Commit)
+		if err != nil {
+			return err
+		}
+		if err := renewer.Add(ctx, *diff); err != nil {
+			return err
+		}
+		targetCtx := target.WithCtx(ctx)
+		var id string
+		id, sent, err = m.driver.sendFileSet(targetCtx.Ctx(), targetCtx, *diff)
+		if err != nil {
+			return err
+		}
+		targetCommit, err = targetCtx.PfsAPIClient.StartCommit(targetCtx.Ctx(), &pfs.StartCommitRequest{
+			Branch:      branch,
+			Description: ci.Description,
+		})
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		defer func() {
+			if retErr != nil {
+				errors.JoinInto(&retErr, abandonTargetCommit(targetCtx, targetCommit))
+			}
+		}()
+		if err := m.driver.updateReplicationStatus(ctx, replication, branch, func(s *pfs.BranchReplicationStatus) {
+			s.PendingCommit = ci.Commit
+			s.PendingTargetCommit = targetCommit
+		}); err != nil {
+			return err
+		}
+		if _, err := targetCtx.PfsAPIClient.AddFileSet(targetCtx.Ctx(), &pfs.AddFileSetRequest{
+			Commit:    targetCommit,
+			FileSetId: id,
+		}); err != nil {
+			return errors.EnsureStack(err)
+		}
+		_, err = targetCtx.PfsAPIClient.FinishCommit(targetCtx.Ctx(), &pfs.FinishCommitRequest{
+			Commit:      targetCommit,
+			Description: ci.Description,
+			Error:       ci.Error,
+		})
+		return errors.EnsureStack(err)
+	}); err != nil {
+		return errors.Wrapf(err, "replicate commit %v", ci.Commit)
+	}
+	now := time.Now()
+	lag := now.Sub(ci.Finished.AsTime())
+	replicationLagMetric.WithLabelValues(replication.Name, branch.String()).Set(lag.Seconds())
+	replicationCommitsMetric.WithLabelValues(replication.Name).Inc()
+	replicationBytesMetric.WithLabelValues(replication.Name).Add(float64(sent))
+	log.Debug(ctx, "replicated commit", zap.Stringer("commit", ci.Commit), zap.Stringer("targetCommit", targetCommit), zap.Int64("bytesSent", sent), zap.Duration("lag", lag))
+	return m.driver.updateReplicationStatus(ctx, replication, branch, func(s *pfs.BranchReplicationStatus) {
+		s.LastReplicatedCommit = ci.Commit
+		s.TargetCommit = targetCommit
+		s.LastReplicatedAt = timestamppb.New(now)
+		s.Lag = durationpb.New(lag)
+		s.BytesTransferred += sent
+		s.PendingCommit = nil
+		s.PendingTargetCommit = nil
+		s.Error = ""
+	})
+}
+
+// resumeReplicatedCommit looks for a target commit left behind by an earlier
+// attempt to replicate ci. It returns that commit if the attempt finished it,
+// otherwise it finishes any open target commit with an error, which discards
+// its data, and returns nil so that a new target commit is started.
+func (m *Master) resumeReplicatedCommit(ctx context.Context, replication *pfs.Replication, target *client.APIClient, branch *pfs.Branch, ci *pfs.CommitInfo) (*pfs.Commit, error) {
+	info, err := m.driver.inspectReplication(ctx, replication)
+	if err != nil {
+		return nil, err
+	}
+	var pending *pfs.Commit
+	for _, s := range info.Status {
+		if proto.Equal(s.Branch, branch) && proto.Equal(s.PendingCommit, ci.Commit) {
+			pending = s.PendingTargetCommit
+		}
+	}
+	if pending == nil {
+		return nil, nil
+	}
+	tci, err := target.PfsAPIClient.InspectCommit(target.Ctx(), &pfs.InspectCommitRequest{Commit: pending})
+	if err != nil {
+		if pfsserver.IsCommitNotFoundErr(err) {
+			return nil, nil
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	if tci.Finished == nil {
+		return nil, abandonTargetCommit(target, pending)
+	}
+	if tci.Error != ci.Error {
+		// The commit was abandoned by an earlier attempt.
+		return nil, nil
+	}
+	return pending, nil
+}
+
+// abandonTargetCommit finishes a partially replicated target commit with an
+// error so that it does not block the target branch and its data is not used
+// as the base of later commits.
+func abandonTargetCommit(target *client.APIClient, commit *pfs.Commit) error {
+	if _, err := target.PfsAPIClient.FinishCommit(target.Ctx(), &pfs.FinishCommitRequest{
+		Commit: commit,
+		Error:  "replication attempt abandoned",
+	}); err != nil && !pfsserver.IsCommitFinishedErr(err) {
+		return errors.Wrapf(err, "abandon target commit %v", commit)
+	}
+	return nil
+}
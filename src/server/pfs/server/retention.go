@@ -0,0 +1,331 @@
+package server
+
+import (
+	"context"
+	"path"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+// errRetentionDryRun is returned from the transaction that squashes a commit
+// set during a dry run, so that the squash is rolled back.
+var errRetentionDryRun = errors.New("retention dry run")
+
+func (d *driver) setRetentionPolicy(ctx context.Context, req *pfs.SetRetentionPolicyRequest) error {
+	key := &pfs.Branch{Repo: req.Repo, Name: req.Branch}
+	if err := d.env.Auth.CheckRepoIsAuthorized(ctx, req.Repo, auth.Permission_REPO_DELETE_COMMIT); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return dbutil.WithTx(ctx, d.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		if _, err := pfsdb.GetRepoByName(ctx, tx, req.Repo.Project.Name, req.Repo.Name, req.Repo.Type); err != nil {
+			if pfsdb.IsErrRepoNotFound(err) {
+				return pfsserver.ErrRepoNotFound{Repo: req.Repo}
+			}
+			return errors.EnsureStack(err)
+		}
+		policies := d.retentionPolicies.ReadWrite(tx)
+		if req.Policy == nil {
+			return errors.EnsureStack(policies.Delete(key))
+		}
+		return errors.EnsureStack(policies.Put(key, &pfs.RetentionPolicyInfo{
+			Repo:   req.Repo,
+			Branch: req.Branch,
+			Policy: req.Policy,
+		}))
+	})
+}
+
+// listRetentionPolicy calls cb with the retention policies of repo, or of every
+// repo which the caller can read if repo is nil.
+func (d *driver) listRetentionPolicy(ctx context.Context, repo *pfs.Repo, cb func(*pfs.RetentionPolicyInfo) error) error {
+	if repo != nil {
+		if err := d.env.Auth.CheckRepoIsAuthorized(ctx, repo, auth.Permission_REPO_READ); err != nil {
+			return errors.EnsureStack(err)
+		}
+		return d.listRetentionPolicies(ctx, repo, cb)
+	}
+	return d.listRetentionPolicies(ctx, nil, func(info *pfs.RetentionPolicyInfo) error {
+		if err := d.env.Auth.CheckRepoIsAuthorized(ctx, info.Repo, auth.Permission_REPO_READ); err != nil {
+			if errors.As(err, &auth.ErrNotAuthorized{}) {
+				return nil
+			}
+			return errors.EnsureStack(err)
+		}
+		return cb(info)
+	})
+}
+
+// listRetentionPolicies calls cb with the retention policies of repo, or of
+// every repo if repo is nil, without checking the caller's permissions.
+func (d *driver) listRetentionPolicies(ctx context.Context, repo *pfs.Repo, cb func(*pfs.RetentionPolicyInfo) error) error {
+	info := &pfs.RetentionPolicyInfo{}
+	send := func(string) error {
+		return cb(proto.Clone(info).(*pfs.RetentionPolicyInfo))
+	}
+	if repo != nil {
+		return errors.EnsureStack(d.retentionPolicies.ReadOnly(ctx).GetByIndex(pfsdb.RetentionPoliciesRepoIndex, pfsdb.RepoKey(repo), info, col.DefaultOptions(), send))
+	}
+	return errors.EnsureStack(d.retentionPolicies.ReadOnly(ctx).List(info, col.DefaultOptions(), send))
+}
+
+// repoRetention holds the retention policies of a repo.
+type repoRetention struct {
+	repo     *pfs.Repo
+	policy   *pfs.RetentionPolicy
+	branches map[string]*pfs.RetentionPolicy
+}
+
+// enforceRetentionPolicy squashes the commit sets containing commits which are
+// not kept by the retention policies of their branches.  If repo is set, only
+// commits in repo are considered for squashing, but the policies of every repo
+// are taken into account, since squashing a commit set squashes the commits in
+// every repo.  If repo is nil, only commits in the repos in which the caller can
+// delete commits are considered, and a commit set is only squashed if the
+// caller can delete commits in every repo that it touches.
+func (d *driver) enforceRetentionPolicy(ctx context.Context, repo *pfs.Repo, dryRun bool, cb func(*pfs.RetentionAction) error) error {
+	if repo != nil {
+		if err := d.env.Auth.CheckRepoIsAuthorized(ctx, repo, auth.Permission_REPO_DELETE_COMMIT); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	retentions := make(map[string]*repoRetention)
+	if err := d.listRetentionPolicies(ctx, nil, func(info *pfs.RetentionPolicyInfo) error {
+		key := pfsdb.RepoKey(info.Repo)
+		r, ok := retentions[key]
+		if !ok {
+			r = &repoRetention{repo: info.Repo, branches: make(map[string]*pfs.RetentionPolicy)}
+			retentions[key] = r
+		}
+		if info.Branch == "" {
+			r.policy = info.Policy
+		} else {
+			r.branches[info.Branch] = info.Policy
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	now := time.Now()
+	kept := make(map[string]struct{})
+	var candidates []*pfs.CommitInfo
+	for key, r := range retentions {
+		repoKept, repoCandidates, err := d.evaluateRetention(ctx, r, now)
+		if err != nil {
+			if pfsserver.IsRepoNotFoundErr(err) {
+				continue
+			}
+			return err
+		}
+		for k := range repoKept {
+			kept[k] = struct{}{}
+		}
+		if repo != nil && key != pfsdb.RepoKey(repo) {
+			continue
+		}
+		if repo == nil && len(repoCandidates) > 0 {
+			if err := d.env.Auth.CheckRepoIsAuthorized(ctx, r.repo, auth.Permission_REPO_DELETE_COMMIT); err != nil {
+				if errors.As(err, &auth.ErrNotAuthorized{}) {
+					continue
+				}
+				return errors.EnsureStack(err)
+			}
+		}
+		candidates = append(candidates, repoCandidates...)
+	}
+	// A commit can be a candidate on one branch and kept by another.
+	var commitSets []string
+	setCandidates := make(map[string][]*pfs.CommitInfo)
+	for _, ci := range candidates {
+		if _, ok := kept[pfsdb.CommitKey(ci.Commit)]; ok {
+			continue
+		}
+		if _, ok := setCandidates[ci.Commit.Id]; !ok {
+			commitSets = append(commitSets, ci.Commit.Id)
+		}
+		setCandidates[ci.Commit.Id] = append(setCandidates[ci.Commit.Id], ci)
+	}
+	// Squash the oldest commit sets first.
+	sort.SliceStable(commitSets, func(i, j int) bool {
+		return setCandidates[commitSets[i]][0].Started.AsTime().Before(setCandidates[commitSets[j]][0].Started.AsTime())
+	})
+	for _, id := range commitSets {
+		commitSet := &pfs.CommitSet{Id: id}
+		var reason string
+		if err := d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+			if err := d.checkRetentionSquash(ctx, txnCtx, commitSet, kept); err != nil {
+				return err
+			}
+			if err := d.squashCommitSet(ctx, txnCtx, commitSet); err != nil {
+				return err
+			}
+			if dryRun {
+				return errRetentionDryRun
+			}
+			return nil
+		}); err != nil && !errors.Is(err, errRetentionDryRun) {
+			reason = err.Error()
+		}
+		for _, ci := range setCandidates[id] {
+			if err := cb(&pfs.RetentionAction{
+				Commit:        ci.Commit,
+				Squashed:      reason == "",
+				SkippedReason: reason,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// evaluateRetention walks the history of each branch in a repo, returning the
+// keys of the commits kept by a retention policy and the commits which are not.
+// Branches without a policy of their own use the repo policy, and if the repo
+// has no policy then all of their commits are kept.
+func (d *driver) evaluateRetention(ctx context.Context, r *repoRetention, now time.Time) (map[string]struct{}, []*pfs.CommitInfo, error) {
+	var branchInfos []*pfs.BranchInfo
+	branchInfo := &pfs.BranchInfo{}
+	if err := d.branches.ReadOnly(ctx).GetByIndex(pfsdb.BranchesRepoIndex, pfsdb.RepoKey(r.repo), branchInfo, col.DefaultOptions(), func(string) error {
+		branchInfos = append(branchInfos, proto.Clone(branchInfo).(*pfs.BranchInfo))
+		return nil
+	}); err != nil {
+		return nil, nil, errors.EnsureStack(err)
+	}
+	if len(branchInfos) == 0 {
+		if err := dbutil.WithTx(ctx, d.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+			_, err := pfsdb.GetRepoByName(ctx, tx, r.repo.Project.Name, r.repo.Name, r.repo.Type)
+			return err
+		}, dbutil.WithReadOnly()); err != nil {
+			if pfsdb.IsErrRepoNotFound(err) {
+				return nil, nil, pfsserver.ErrRepoNotFound{Repo: r.repo}
+			}
+			return nil, nil, errors.EnsureStack(err)
+		}
+	}
+	kept := make(map[string]struct{})
+	candidates := make(map[string]*pfs.CommitInfo)
+	for _, bi := range branchInfos {
+		policy, ok := r.branches[bi.Branch.Name]
+		if !ok {
+			policy = r.policy
+		}
+		days := make(map[time.Time]struct{})
+		commit := bi.Head
+		for i := 0; commit != nil; i++ {
+			ci := &pfs.CommitInfo{}
+			if err := d.commits.ReadOnly(ctx).Get(commit, ci); err != nil {
+				return nil, nil, errors.EnsureStack(err)
+			}
+			key := pfsdb.CommitKey(ci.Commit)
+			if i == 0 || retainCommit(policy, ci, i, now, days) {
+				kept[key] = struct{}{}
+			} else {
+				candidates[key] = ci
+			}
+			commit = ci.ParentCommit
+		}
+	}
+	var result []*pfs.CommitInfo
+	for key, ci := range candidates {
+		if _, ok := kept[key]; !ok {
+			result = append(result, ci)
+		}
+	}
+	return kept, result, nil
+}
+
+// retainCommit returns true if policy keeps ci, which is the i-th most recent
+// commit on its branch.  Commits must be passed in order from newest to oldest,
+// days records the days which already have a commit kept that is older than
+// keep_daily_after.
+func retainCommit(policy *pfs.RetentionPolicy, ci *pfs.CommitInfo, i int, now time.Time, days map[time.Time]struct{}) bool {
+	if policy == nil {
+		return true
+	}
+	age := now.Sub(ci.Started.AsTime())
+	keep := i < int(policy.KeepLast) || (policy.KeepFor != nil && age < policy.KeepFor.AsDuration())
+	if policy.KeepDailyAfter != nil {
+		if age < policy.KeepDailyAfter.AsDuration() {
+			return true
+		}
+		day := ci.Started.AsTime().UTC().Truncate(24 * time.Hour)
+		if _, ok := days[day]; !ok {
+			keep = true
+		}
+		if keep {
+			days[day] = struct{}{}
+		}
+	}
+	return keep
+}
+
+// checkRetentionSquash returns an error if squashing commitSet would remove a
+// commit which is kept by a retention policy, a commit which is not yet
+// finished, such as the output commit of a running job, or a commit in a repo
+// in which the caller can't delete commits.
+func (d *driver) checkRetentionSquash(ctx context.Context, txnCtx *txncontext.TransactionContext, commitSet *pfs.CommitSet, kept map[string]struct{}) error {
+	cis, err := d.inspectCommitSetImmediateTx(ctx, txnCtx, commitSet, false)
+	if err != nil {
+		return err
+	}
+	for _, ci := range cis {
+		if err := d.env.Auth.CheckRepoIsAuthorizedInTransaction(txnCtx, ci.Commit.Repo, auth.Permission_REPO_DELETE_COMMIT); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if _, ok := kept[pfsdb.CommitKey(ci.Commit)]; ok {
+			return errors.Errorf("commit %s is kept by the retention policy of its repo", ci.Commit)
+		}
+		if ci.Finished == nil {
+			return errors.Errorf("commit %s is not finished", ci.Commit)
+		}
+	}
+	return nil
+}
+
+// enforceRetentionPolicies periodically enforces all retention policies.
+func (m *Master) enforceRetentionPolicies(ctx context.Context, period time.Duration) error {
+	lock := dlock.NewDLock(m.driver.etcdClient, path.Join(m.driver.prefix, masterLockPath, "retention"))
+	ctx, err := lock.Lock(ctx)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := lock.Unlock(ctx); err != nil {
+			log.Error(ctx, "error unlocking in pfs master (retention)", zap.Error(err))
+		}
+	}()
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		if err := m.driver.enforceRetentionPolicy(ctx, nil, false, func(action *pfs.RetentionAction) error {
+			if action.Squashed {
+				log.Info(ctx, "squashed commit set for retention policy", zap.Stringer("commit", action.Commit))
+			} else {
+				log.Debug(ctx, "could not squash commit set for retention policy", zap.Stringer("commit", action.Commit), zap.String("reason", action.SkippedReason))
+			}
+			return nil
+		}); err != nil {
+			log.Error(ctx, "error enforcing retention policies", zap.Error(err))
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return errors.EnsureStack(context.Cause(ctx))
+		}
+	}
+}
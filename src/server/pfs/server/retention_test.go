@@ -0,0 +1,42 @@
+//go:build unit_test
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+func TestRetainCommit(t *testing.T) {
+	now := time.Date(2023, 6, 30, 12, 0, 0, 0, time.UTC)
+	// commits are ordered newest to oldest, every 6 hours
+	var cis []*pfs.CommitInfo
+	for i := 0; i < 40; i++ {
+		cis = append(cis, &pfs.CommitInfo{Started: timestamppb.New(now.Add(-time.Duration(i) * 6 * time.Hour))})
+	}
+	retained := func(policy *pfs.RetentionPolicy) []int {
+		days := make(map[time.Time]struct{})
+		var result []int
+		for i, ci := range cis {
+			if retainCommit(policy, ci, i, now, days) {
+				result = append(result, i)
+			}
+		}
+		return result
+	}
+	require.Len(t, retained(nil), len(cis))
+	require.Equal(t, []int{0, 1, 2}, retained(&pfs.RetentionPolicy{KeepLast: 3}))
+	require.Equal(t, []int{0, 1, 2, 3}, retained(&pfs.RetentionPolicy{KeepFor: durationpb.New(24 * time.Hour)}))
+	// commits younger than a day are all kept, after that only the first
+	// commit seen (the most recent) on each day is kept.
+	require.Equal(t, []int{0, 1, 2, 3, 4, 7, 11, 15, 19, 23, 27, 31, 35, 39},
+		retained(&pfs.RetentionPolicy{KeepDailyAfter: durationpb.New(24 * time.Hour)}))
+	require.Equal(t, []int{0, 1, 2, 3, 4, 5, 7, 11, 15, 19, 23, 27, 31, 35, 39},
+		retained(&pfs.RetentionPolicy{KeepLast: 6, KeepDailyAfter: durationpb.New(24 * time.Hour)}))
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachconfig"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/peercache"
 	"github.com/pachyderm/pachyderm/v2/src/internal/task"
 	txnenv "github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
@@ -15,6 +16,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
 	etcd "go.etcd.io/etcd/client/v3"
+	"k8s.io/client-go/kubernetes"
 )
 
 type APIServer = *validatedAPIServer
@@ -30,8 +32,10 @@ type PFSAuth interface {
 	WhoAmI(ctx context.Context, req *auth.WhoAmIRequest) (*auth.WhoAmIResponse, error)
 	GetPermissions(ctx context.Context, req *auth.GetPermissionsRequest) (*auth.GetPermissionsResponse, error)
 
+	CheckClusterIsAuthorizedInTransaction(txnCtx *txncontext.TransactionContext, p ...auth.Permission) error
 	CheckProjectIsAuthorizedInTransaction(txnCtx *txncontext.TransactionContext, project *pfs.Project, p ...auth.Permission) error
 	CheckRepoIsAuthorizedInTransaction(txnCtx *txncontext.TransactionContext, repo *pfs.Repo, p ...auth.Permission) error
+	CheckBranchIsAuthorizedInTransaction(txnCtx *txncontext.TransactionContext, branch *pfs.Branch, p ...auth.Permission) error
 	CreateRoleBindingInTransaction(txnCtx *txncontext.TransactionContext, principal string, roleSlice []string, resource *auth.Resource) error
 	DeleteRoleBindingInTransaction(transactionContext *txncontext.TransactionContext, resource *auth.Resource) error
 	GetPermissionsInTransaction(txnCtx *txncontext.TransactionContext, req *auth.GetPermissionsRequest) (*auth.GetPermissionsResponse, error)
@@ -50,7 +54,15 @@ type Env struct {
 	Auth                 PFSAuth
 	GetPipelineInspector func() PipelineInspector
 
+	// GetKubeClient and Namespace are used to read the secrets referenced by
+	// replications.  GetKubeClient may be nil if pachd is not running in
+	// Kubernetes.
+	GetKubeClient func() kubernetes.Interface
+	Namespace     string
+
 	StorageConfig pachconfig.StorageConfiguration
+	// PeerCache, if set, is the process's member of the cluster chunk cache.
+	PeerCache *peercache.Cache
 }
 
 // NewAPIServer creates an APIServer.
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	neturl "net/url"
 	"os"
 	"strconv"
 	"strings"
@@ -18,13 +19,44 @@ import (
 	_ "gocloud.dev/blob/gcsblob"
 	_ "gocloud.dev/blob/memblob"
 	_ "gocloud.dev/blob/s3blob"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/remotefs"
 )
 
+// secretGetter returns the data of the named secret.
+type secretGetter func(ctx context.Context, name string) (map[string][]byte, error)
+
+// kubeSecrets returns a secretGetter which reads secrets from namespace.  Only
+// secrets created with pachctl create secret, which are labeled
+// secret-source=pachyderm-user, are returned, so that a url can't name one of
+// pachd's own secrets.  It returns nil if getKubeClient is nil.
+func kubeSecrets(getKubeClient func() kubernetes.Interface, namespace string) secretGetter {
+	if getKubeClient == nil {
+		return nil
+	}
+	return func(ctx context.Context, name string) (map[string][]byte, error) {
+		s, err := getKubeClient().CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, errors.Wrapf(err, "get secret %q", name)
+		}
+		if s.Labels["secret-source"] != "pachyderm-user" {
+			return nil, errors.Errorf("secret %q was not created with pachctl create secret", name)
+		}
+		return s.Data, nil
+	}
+}
+
 // openBucket handles connection to s3-compatible buckets, gcp buckets, and azure containers using the go-cdk library.
-func openBucket(ctx context.Context, url *obj.ObjectStoreURL) (*blob.Bucket, error) {
+// Remote file systems are opened as buckets by the remotefs package, with the credentials in the secret named by the
+// url's secret parameter, which are read with getSecret.
+func openBucket(ctx context.Context, url *obj.ObjectStoreURL, getSecret secretGetter) (*blob.Bucket, error) {
+	if remotefs.IsScheme(url.Scheme) {
+		return openRemoteFS(ctx, url, getSecret)
+	}
 	switch url.Scheme {
 	case "s3": // these environment variables should be ignored if not using s3.
 		if os.Getenv("CUSTOM_ENDPOINT") != "" {
@@ -53,6 +85,30 @@ func openBucket(ctx context.Context, url *obj.ObjectStoreURL) (*blob.Bucket, err
 	}
 }
 
+// openRemoteFS opens the remote file system at url as a bucket.
+func openRemoteFS(ctx context.Context, url *obj.ObjectStoreURL, getSecret secretGetter) (*blob.Bucket, error) {
+	params, err := neturl.ParseQuery(url.Params)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse parameters of %s", url)
+	}
+	var creds remotefs.Credentials
+	if name := params.Get("secret"); name != "" {
+		if getSecret == nil {
+			return nil, errors.Errorf("cannot read secret %q without kubernetes", name)
+		}
+		data, err := getSecret(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		creds = remotefs.CredentialsFromSecret(data)
+	}
+	bucket, err := remotefs.OpenBucket(ctx, url.Scheme, url.Bucket, creds)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error opening %s://%s", url.Scheme, url.Bucket)
+	}
+	return bucket, nil
+}
+
 // handleMinio opens a minio bucket using pachyderm minio environment variables if defined.
 func handleMinio(ctx context.Context, url *obj.ObjectStoreURL) (*blob.Bucket, error) {
 	endpoint := ""
@@ -6,16 +6,26 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path"
 	"testing"
 	"time"
 
 	"gocloud.dev/blob"
+	"golang.org/x/net/webdav"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
 
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj/integrationtests"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
 	"github.com/pachyderm/pachyderm/v2/src/internal/randutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/remotefs"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 )
 
@@ -81,10 +91,95 @@ func TestAmazonECS(t *testing.T) {
 	writeReadDelete(t, url)
 }
 
+func TestRemoteFS(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	h := &webdav.Handler{
+		FileSystem: webdav.Dir(t.TempDir()),
+		LockSystem: webdav.NewMemLS(),
+	}
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "vendor" || pass != "hunter2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		h.ServeHTTP(w, r)
+	}))
+	defer s.Close()
+	getSecret := func(_ context.Context, name string) (map[string][]byte, error) {
+		if name != "vendor-webdav" {
+			return nil, errors.Errorf("secret %q not found", name)
+		}
+		return map[string][]byte{
+			remotefs.UsernameKey: []byte("vendor"),
+			remotefs.PasswordKey: []byte("hunter2"),
+		}, nil
+	}
+	url, err := obj.ParseURL("webdav://" + s.Listener.Addr().String() + "/?secret=vendor-webdav")
+	require.NoError(t, err, "should be able to parse url")
+	bucket, err := openBucket(ctx, url, getSecret)
+	require.NoError(t, err, "should be able to open bucket")
+	defer func() {
+		require.NoError(t, bucket.Close())
+	}()
+	for _, name := range []string{"dir/a", "dir/b", "other"} {
+		writeToObjStorage(ctx, t, bucket, name, name)
+	}
+	var sharded []string
+	require.NoError(t, shardObjects(ctx, "webdav://"+s.Listener.Addr().String()+"/dir?secret=vendor-webdav", getSecret,
+		func(paths []string, _, _ int64) error {
+			sharded = append(sharded, paths...)
+			return nil
+		}))
+	require.Equal(t, []string{"dir/a", "dir/b"}, sharded)
+
+	// Credentials must come from a secret.
+	_, err = obj.ParseURL("webdav://vendor:hunter2@" + s.Listener.Addr().String() + "/")
+	require.YesError(t, err)
+	_, err = openBucket(ctx, url, nil)
+	require.YesError(t, err)
+	url, err = obj.ParseURL("webdav://" + s.Listener.Addr().String() + "/")
+	require.NoError(t, err)
+	bucket2, err := openBucket(ctx, url, getSecret)
+	require.NoError(t, err)
+	defer bucket2.Close()
+	_, err = bucket2.ReadAll(ctx, "other")
+	require.YesError(t, err)
+}
+
+func TestKubeSecrets(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	kubeClient := fake.NewSimpleClientset(
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "vendor-webdav",
+				Namespace: "default",
+				Labels:    map[string]string{"secret-source": "pachyderm-user"},
+			},
+			Data: map[string][]byte{remotefs.UsernameKey: []byte("vendor")},
+		},
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pachyderm-storage-secret",
+				Namespace: "default",
+			},
+			Data: map[string][]byte{remotefs.UsernameKey: []byte("pachd")},
+		},
+	)
+	getSecret := kubeSecrets(func() kubernetes.Interface { return kubeClient }, "default")
+	data, err := getSecret(ctx, "vendor-webdav")
+	require.NoError(t, err)
+	require.Equal(t, "vendor", string(data[remotefs.UsernameKey]))
+	_, err = getSecret(ctx, "pachyderm-storage-secret")
+	require.YesError(t, err)
+	_, err = getSecret(ctx, "missing")
+	require.YesError(t, err)
+	require.Nil(t, kubeSecrets(nil, "default"))
+}
+
 func writeReadDelete(t *testing.T, url *obj.ObjectStoreURL) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 	defer cancel()
-	bucket, err := openBucket(ctx, url)
+	bucket, err := openBucket(ctx, url, nil)
 	defer func() {
 		require.NoError(t, bucket.Close())
 	}()
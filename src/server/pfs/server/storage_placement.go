@@ -0,0 +1,206 @@
+package server
+
+import (
+	"context"
+	"path"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+func (d *driver) setStoragePlacement(ctx context.Context, req *pfs.SetStoragePlacementRequest) error {
+	// Only repo owners may place a repo's data, since its tier determines
+	// what storing it costs.
+	if err := d.env.Auth.CheckRepoIsAuthorized(ctx, req.Repo, auth.Permission_REPO_MODIFY_BINDINGS); err != nil {
+		return errors.EnsureStack(err)
+	}
+	for _, tier := range []string{req.Tier, req.ColdTier} {
+		if !d.storage.Chunks.HasTier(tier) {
+			return errors.Errorf("storage tier %q is not configured, the configured tiers are %v", tier, d.storage.Chunks.Tiers())
+		}
+	}
+	return dbutil.WithTx(ctx, d.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		if _, err := pfsdb.GetRepoByName(ctx, tx, req.Repo.Project.Name, req.Repo.Name, req.Repo.Type); err != nil {
+			if pfsdb.IsErrRepoNotFound(err) {
+				return pfsserver.ErrRepoNotFound{Repo: req.Repo}
+			}
+			return errors.EnsureStack(err)
+		}
+		placements := d.storagePlacements.ReadWrite(tx)
+		if req.Tier == "" && req.ColdTier == "" {
+			if err := placements.Delete(req.Repo); err != nil && !col.IsErrNotFound(err) {
+				return errors.EnsureStack(err)
+			}
+			return nil
+		}
+		return errors.EnsureStack(placements.Put(req.Repo, &pfs.StoragePlacementInfo{
+			Repo:      req.Repo,
+			Tier:      req.Tier,
+			ColdTier:  req.ColdTier,
+			ColdAfter: req.ColdAfter,
+		}))
+	})
+}
+
+func (d *driver) listStoragePlacement(ctx context.Context, repo *pfs.Repo, cb func(*pfs.StoragePlacementInfo) error) error {
+	info := &pfs.StoragePlacementInfo{}
+	if repo != nil {
+		if err := d.storagePlacements.ReadOnly(ctx).Get(repo, info); err != nil {
+			if col.IsErrNotFound(err) {
+				return nil
+			}
+			return errors.EnsureStack(err)
+		}
+		return cb(info)
+	}
+	return errors.EnsureStack(d.storagePlacements.ReadOnly(ctx).List(info, col.DefaultOptions(), func(string) error {
+		return cb(proto.Clone(info).(*pfs.StoragePlacementInfo))
+	}))
+}
+
+// placementTier returns the tier that the chunks of a commit started at
+// started are placed on by p, which may be nil.
+func placementTier(p *pfs.StoragePlacementInfo, started, now time.Time) string {
+	if p == nil {
+		return ""
+	}
+	if p.ColdTier != "" && now.Sub(started) >= p.ColdAfter.AsDuration() {
+		return p.ColdTier
+	}
+	return p.Tier
+}
+
+// chunkTiers returns the tier of each chunk in g, by tracker id, given the
+// tier of each commit, by commit key.  A chunk shared by commits placed on
+// different tiers, or referenced by no commit, is placed on the default tier,
+// so that chunks are only moved off it when every commit using them agrees.
+func chunkTiers(g *track.Graph, commitTiers map[string]string) (map[string]string, error) {
+	roots := make(map[string][]int64)
+	for id, strID := range g.IDs {
+		key, _, ok := parseCommitTrackerID(strID)
+		if !ok {
+			continue
+		}
+		tier, ok := commitTiers[key]
+		if !ok {
+			continue
+		}
+		roots[tier] = append(roots[tier], id)
+	}
+	tiers := make(map[int64]string)
+	for tier, ids := range roots {
+		if err := g.Walk(ids, func(id int64) error {
+			if !strings.HasPrefix(g.IDs[id], chunk.TrackerPrefix) {
+				return nil
+			}
+			if t, ok := tiers[id]; !ok {
+				tiers[id] = tier
+			} else if t != tier {
+				tiers[id] = ""
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	res := make(map[string]string)
+	for id, tier := range tiers {
+		if tier != "" {
+			res[g.IDs[id]] = tier
+		}
+	}
+	return res, nil
+}
+
+// moveChunksToTiers moves every chunk which isn't on the tier that the storage
+// placements put it on, and returns the number of chunks moved.
+func (d *driver) moveChunksToTiers(ctx context.Context) (int, error) {
+	placements := make(map[string]*pfs.StoragePlacementInfo)
+	if err := d.listStoragePlacement(ctx, nil, func(info *pfs.StoragePlacementInfo) error {
+		placements[pfsdb.RepoKey(info.Repo)] = info
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	now := time.Now()
+	commitTiers := make(map[string]string)
+	if err := pfsdb.ListCommitStartTimes(ctx, d.env.DB, func(key string, started time.Time) error {
+		commit := pfsdb.ParseCommit(key)
+		commitTiers[key] = placementTier(placements[pfsdb.RepoKey(commit.Repo)], started, now)
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	g, err := track.LoadGraph(ctx, d.env.DB)
+	if err != nil {
+		return 0, err
+	}
+	want, err := chunkTiers(g, commitTiers)
+	if err != nil {
+		return 0, err
+	}
+	moves := make(map[string]string)
+	if err := d.storage.Chunks.ListTiers(ctx, func(id chunk.ID, tier string) error {
+		if w := want[id.TrackerID()]; w != tier {
+			moves[string(id)] = w
+		}
+		return nil
+	}); err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	var moved int
+	for id, tier := range moves {
+		// A chunk that can't be moved, for example because its tier is no
+		// longer configured, shouldn't keep the others from being moved.
+		if err := d.storage.Chunks.MoveChunk(ctx, chunk.ID(id), tier); err != nil {
+			log.Error(ctx, "error moving chunk to storage tier", zap.Stringer("chunkID", chunk.ID(id)), zap.String("tier", tier), zap.Error(err))
+			continue
+		}
+		moved++
+	}
+	return moved, nil
+}
+
+// migrateStorageTiers periodically moves chunks between storage tiers
+// according to the storage placements of the repos referencing them.
+func (m *Master) migrateStorageTiers(ctx context.Context, period time.Duration) error {
+	lock := dlock.NewDLock(m.driver.etcdClient, path.Join(m.driver.prefix, masterLockPath, "tiers"))
+	ctx, err := lock.Lock(ctx)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := lock.Unlock(ctx); err != nil {
+			log.Error(ctx, "error unlocking in pfs master (tiers)", zap.Error(err))
+		}
+	}()
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		if moved, err := m.driver.moveChunksToTiers(ctx); err != nil {
+			log.Error(ctx, "error moving chunks between storage tiers", zap.Error(err))
+		} else if moved > 0 {
+			log.Info(ctx, "moved chunks between storage tiers", zap.Int("chunks", moved))
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return errors.EnsureStack(context.Cause(ctx))
+		}
+	}
+}
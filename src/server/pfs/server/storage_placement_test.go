@@ -0,0 +1,68 @@
+//go:build unit_test
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+func TestPlacementTier(t *testing.T) {
+	now := time.Now()
+	p := &pfs.StoragePlacementInfo{Tier: "fast", ColdTier: "cold", ColdAfter: durationpb.New(24 * time.Hour)}
+	require.Equal(t, "", placementTier(nil, now, now))
+	require.Equal(t, "fast", placementTier(p, now.Add(-time.Hour), now))
+	require.Equal(t, "cold", placementTier(p, now.Add(-48*time.Hour), now))
+	require.Equal(t, "", placementTier(&pfs.StoragePlacementInfo{ColdTier: "cold", ColdAfter: durationpb.New(24 * time.Hour)}, now, now))
+}
+
+func TestChunkTiers(t *testing.T) {
+	g := &track.Graph{
+		IDs: map[int64]string{
+			1:  "chunk/01",
+			2:  "chunk/02",
+			3:  "chunk/03",
+			4:  "chunk/04",
+			5:  "fileset/05",
+			6:  "fileset/06",
+			7:  "fileset/07",
+			8:  "fileset/08",
+			10: "commit/p/a.user@1/diff/05",
+			11: "commit/p/a.user@2/diff/06",
+			12: "commit/p/b.user@3/diff/07",
+			13: "fileset/temp",
+		},
+		Downstream: map[int64][]int64{
+			5:  {1, 2},
+			6:  {2},
+			7:  {3},
+			8:  {4},
+			10: {5},
+			11: {6},
+			12: {7},
+			13: {8},
+		},
+	}
+	tiers, err := chunkTiers(g, map[string]string{
+		"p/a.user@1": "cold",
+		"p/a.user@2": "cold",
+		"p/b.user@3": "",
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"chunk/01": "cold", "chunk/02": "cold"}, tiers)
+
+	// A chunk shared with a commit on another tier stays on the default tier.
+	tiers, err = chunkTiers(g, map[string]string{
+		"p/a.user@1": "cold",
+		"p/a.user@2": "fast",
+		"p/b.user@3": "fast",
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"chunk/01": "cold", "chunk/03": "fast"}, tiers)
+}
@@ -1290,6 +1290,344 @@ func TestDeleteProjectWithRepos(t *testing.T) {
 	}
 }
 
+func TestReplication(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	env := realenv.NewRealEnv(ctx, t, dockertestenv.NewTestDBConfig(t))
+	c := env.PachClient
+
+	require.NoError(t, c.CreateRepo(pfs.DefaultProjectName, "images"))
+	req := &pfs.CreateReplicationRequest{
+		Replication: &pfs.Replication{Name: "dr"},
+		Target:      &pfs.ReplicationTarget{PachdAddress: "grpc://dr.example.com:30650"},
+		Branches:    []*pfs.Branch{client.NewBranch(pfs.DefaultProjectName, "images", "master")},
+	}
+	_, err := c.PfsAPIClient.CreateReplication(ctx, req)
+	require.NoError(t, err)
+	// creating the same replication again fails unless it is an update.
+	_, err = c.PfsAPIClient.CreateReplication(ctx, req)
+	require.YesError(t, err)
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	req.Branches = append(req.Branches, client.NewBranch(pfs.DefaultProjectName, "images", "staging"))
+	req.Update = true
+	_, err = c.PfsAPIClient.CreateReplication(ctx, req)
+	require.NoError(t, err)
+
+	ri, err := c.PfsAPIClient.InspectReplication(ctx, &pfs.InspectReplicationRequest{Replication: &pfs.Replication{Name: "dr"}})
+	require.NoError(t, err)
+	require.Equal(t, "grpc://dr.example.com:30650", ri.Target.PachdAddress)
+	require.Len(t, ri.Branches, 2)
+	require.NotNil(t, ri.CreatedAt)
+
+	listClient, err := c.PfsAPIClient.ListReplication(ctx, &pfs.ListReplicationRequest{})
+	require.NoError(t, err)
+	ris, err := grpcutil.Collect[*pfs.ReplicationInfo](listClient, 1000)
+	require.NoError(t, err)
+	require.Len(t, ris, 1)
+
+	_, err = c.PfsAPIClient.DeleteReplication(ctx, &pfs.DeleteReplicationRequest{Replication: &pfs.Replication{Name: "dr"}})
+	require.NoError(t, err)
+	_, err = c.PfsAPIClient.InspectReplication(ctx, &pfs.InspectReplicationRequest{Replication: &pfs.Replication{Name: "dr"}})
+	require.YesError(t, err)
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestReplicateFileSet(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	env := realenv.NewRealEnv(ctx, t, dockertestenv.NewTestDBConfig(t))
+	c := env.PachClient
+
+	// chunks that have never been written are reported as missing.
+	resp, err := c.PfsAPIClient.MissingChunks(ctx, &pfs.MissingChunksRequest{ChunkIds: [][]byte{[]byte("not a chunk")}})
+	require.NoError(t, err)
+	require.Len(t, resp.ChunkIds, 1)
+	// an empty file set can be replicated.
+	stream, err := c.PfsAPIClient.ReplicateFileSet(ctx)
+	require.NoError(t, err)
+	fsResp, err := stream.CloseAndRecv()
+	require.NoError(t, err)
+	require.NotEqual(t, "", fsResp.FileSetId)
+}
+
+func TestRetentionPolicy(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	env := realenv.NewRealEnv(ctx, t, dockertestenv.NewTestDBConfig(t))
+	c := env.PachClient
+
+	repo := client.NewRepo(pfs.DefaultProjectName, "raw")
+	require.NoError(t, c.CreateRepo(pfs.DefaultProjectName, "raw"))
+	for i := 0; i < 5; i++ {
+		commit, err := c.StartCommit(pfs.DefaultProjectName, "raw", "master")
+		require.NoError(t, err)
+		require.NoError(t, c.PutFile(commit, fmt.Sprintf("file%d", i), strings.NewReader("data")))
+		require.NoError(t, c.FinishCommit(pfs.DefaultProjectName, "raw", "master", commit.Id))
+	}
+	// an empty policy would squash everything but the head.
+	_, err := c.PfsAPIClient.SetRetentionPolicy(ctx, &pfs.SetRetentionPolicyRequest{Repo: repo, Policy: &pfs.RetentionPolicy{}})
+	require.YesError(t, err)
+	_, err = c.PfsAPIClient.SetRetentionPolicy(ctx, &pfs.SetRetentionPolicyRequest{Repo: repo, Policy: &pfs.RetentionPolicy{KeepLast: 2}})
+	require.NoError(t, err)
+	listClient, err := c.PfsAPIClient.ListRetentionPolicy(ctx, &pfs.ListRetentionPolicyRequest{Repo: repo})
+	require.NoError(t, err)
+	infos, err := grpcutil.Collect[*pfs.RetentionPolicyInfo](listClient, 1000)
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+	require.Equal(t, uint32(2), infos[0].Policy.KeepLast)
+
+	enforce := func(dryRun bool) []*pfs.RetentionAction {
+		enforceClient, err := c.PfsAPIClient.EnforceRetentionPolicy(ctx, &pfs.EnforceRetentionPolicyRequest{Repo: repo, DryRun: dryRun})
+		require.NoError(t, err)
+		actions, err := grpcutil.Collect[*pfs.RetentionAction](enforceClient, 1000)
+		require.NoError(t, err)
+		return actions
+	}
+	actions := enforce(true)
+	require.Len(t, actions, 3)
+	for _, action := range actions {
+		require.True(t, action.Squashed, action.SkippedReason)
+	}
+	cis, err := c.ListCommitByRepo(repo)
+	require.NoError(t, err)
+	require.Len(t, cis, 5)
+
+	require.Len(t, enforce(false), 3)
+	cis, err = c.ListCommitByRepo(repo)
+	require.NoError(t, err)
+	require.Len(t, cis, 2)
+	// the data from the squashed commits is still in the head.
+	fis, err := c.ListFileAll(client.NewCommit(pfs.DefaultProjectName, "raw", "master", ""), "/")
+	require.NoError(t, err)
+	require.Len(t, fis, 5)
+
+	// removing the policy keeps every commit.
+	_, err = c.PfsAPIClient.SetRetentionPolicy(ctx, &pfs.SetRetentionPolicyRequest{Repo: repo})
+	require.NoError(t, err)
+	require.Len(t, enforce(false), 0)
+}
+
+func TestUploadSession(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	env := realenv.NewRealEnv(ctx, t, dockertestenv.NewTestDBConfig(t))
+	c := env.PachClient
+
+	require.NoError(t, c.CreateRepo(pfs.DefaultProjectName, "raw"))
+	master := client.NewCommit(pfs.DefaultProjectName, "raw", "master", "")
+	require.NoError(t, c.PutFile(master, "file", strings.NewReader("old")))
+
+	info, err := c.CreateUploadSession(master.NewFile("file"), false, 0)
+	require.NoError(t, err)
+	// parts can be uploaded out of order, and retrying a part replaces it.
+	_, err = c.UploadPart(info.Id, 2, strings.NewReader("bar"))
+	require.NoError(t, err)
+	_, err = c.UploadPart(info.Id, 1, strings.NewReader("fooo"))
+	require.NoError(t, err)
+	part, err := c.UploadPart(info.Id, 1, strings.NewReader("foo"))
+	require.NoError(t, err)
+	require.Equal(t, int64(3), part.SizeBytes)
+	info, err = c.InspectUploadSession(info.Id)
+	require.NoError(t, err)
+	require.Len(t, info.Parts, 2)
+	require.Equal(t, int64(1), info.Parts[0].Number)
+	infos, err := c.ListUploadSession(master.Repo)
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+
+	// committing an unknown part fails without touching the file.
+	require.YesError(t, c.CommitUploadSession(info.Id, []int64{1, 3}))
+	require.NoError(t, c.CommitUploadSession(info.Id, []int64{1, 2}))
+	buf := &bytes.Buffer{}
+	require.NoError(t, c.GetFile(master, "file", buf))
+	require.Equal(t, "foobar", buf.String())
+	_, err = c.InspectUploadSession(info.Id)
+	require.YesError(t, err)
+	require.True(t, errutil.IsNotFoundError(err))
+
+	// appending sessions keep the existing content.
+	info, err = c.CreateUploadSession(master.NewFile("file"), true, 0)
+	require.NoError(t, err)
+	_, err = c.UploadPart(info.Id, 1, strings.NewReader("baz"))
+	require.NoError(t, err)
+	require.NoError(t, c.CommitUploadSession(info.Id, []int64{1}))
+	buf.Reset()
+	require.NoError(t, c.GetFile(master, "file", buf))
+	require.Equal(t, "foobarbaz", buf.String())
+
+	// deleted sessions can't be committed.
+	info, err = c.CreateUploadSession(master.NewFile("other"), false, 0)
+	require.NoError(t, err)
+	require.NoError(t, c.DeleteUploadSession(info.Id))
+	require.YesError(t, c.CommitUploadSession(info.Id, nil))
+}
+
+func TestProjectQuota(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	env := realenv.NewRealEnv(ctx, t, dockertestenv.NewTestDBConfig(t))
+	c := env.PachClient
+	db := env.ServiceEnv.GetDBClient()
+
+	project := &pfs.Project{Name: "quota"}
+	_, err := c.PfsAPIClient.CreateProject(ctx, &pfs.CreateProjectRequest{
+		Project: project,
+		Quota:   &pfs.ProjectQuota{MaxBytes: 100, Enforcement: pfs.ProjectQuota_HARD},
+	})
+	require.NoError(t, err)
+	// updating the description leaves the quota unchanged.
+	_, err = c.PfsAPIClient.CreateProject(ctx, &pfs.CreateProjectRequest{Project: project, Description: "quota", Update: true})
+	require.NoError(t, err)
+	pi, err := c.PfsAPIClient.InspectProject(ctx, &pfs.InspectProjectRequest{Project: project, Details: true})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), pi.Quota.GetMaxBytes())
+	require.Nil(t, pi.Details.Usage)
+
+	require.NoError(t, c.CreateRepo(project.Name, "data"))
+	setUsage := func(used int64) {
+		require.NoError(t, dbutil.WithTx(ctx, db, func(ctx context.Context, tx *pachsql.Tx) error {
+			return pfsdb.ReplaceStorageUsage(ctx, tx, map[string]*pfs.StorageUsage{
+				pfsdb.ProjectKey(project): {ExclusiveBytes: used},
+			}, time.Now())
+		}))
+	}
+	commit, err := c.StartCommit(project.Name, "data", "master")
+	require.NoError(t, err)
+	setUsage(50)
+	require.NoError(t, c.FinishCommit(project.Name, "data", "master", commit.Id))
+
+	commit, err = c.StartCommit(project.Name, "data", "master")
+	require.NoError(t, err)
+	setUsage(200)
+	err = c.FinishCommit(project.Name, "data", "master", commit.Id)
+	require.YesError(t, err)
+	require.True(t, pfsserver.IsProjectQuotaExceededErr(err))
+	pi, err = c.PfsAPIClient.InspectProject(ctx, &pfs.InspectProjectRequest{Project: project, Details: true})
+	require.NoError(t, err)
+	require.Equal(t, int64(200), pi.Details.Usage.ExclusiveBytes)
+
+	// soft quotas finish the commit with an error.
+	_, err = c.PfsAPIClient.CreateProject(ctx, &pfs.CreateProjectRequest{
+		Project: project,
+		Update:  true,
+		Quota:   &pfs.ProjectQuota{MaxBytes: 100, Enforcement: pfs.ProjectQuota_SOFT},
+	})
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(project.Name, "data", "master", commit.Id))
+	ci, err := c.WaitCommit(project.Name, "data", "master", commit.Id)
+	require.NoError(t, err)
+	require.True(t, strings.Contains(ci.Error, "exceeded its storage quota"))
+}
+
+func TestCommitValidation(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	env := realenv.NewRealEnv(ctx, t, dockertestenv.NewTestDBConfig(t))
+	c := env.PachClient
+
+	repo := client.NewRepo(pfs.DefaultProjectName, "raw")
+	require.NoError(t, c.CreateRepo(pfs.DefaultProjectName, "raw"))
+	// an invalid contract is rejected.
+	_, err := c.PfsAPIClient.SetCommitValidation(ctx, &pfs.SetCommitValidationRequest{Repo: repo, Contract: &pfs.ContentContract{MinFiles: 2, MaxFiles: 1}})
+	require.YesError(t, err)
+	contract := &pfs.ContentContract{
+		Paths: []*pfs.PathContract{{Glob: "/*.csv", Required: true}},
+	}
+	_, err = c.PfsAPIClient.SetCommitValidation(ctx, &pfs.SetCommitValidationRequest{Repo: repo, Contract: contract})
+	require.NoError(t, err)
+	listClient, err := c.PfsAPIClient.ListCommitValidation(ctx, &pfs.ListCommitValidationRequest{Repo: repo})
+	require.NoError(t, err)
+	infos, err := grpcutil.Collect[*pfs.CommitValidationInfo](listClient, 1000)
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+	require.Equal(t, "/*.csv", infos[0].Contract.Paths[0].Glob)
+
+	put := func(path string) *pfs.CommitInfo {
+		commit, err := c.StartCommit(pfs.DefaultProjectName, "raw", "master")
+		require.NoError(t, err)
+		require.NoError(t, c.PutFile(commit, path, strings.NewReader("data")))
+		require.NoError(t, c.FinishCommit(pfs.DefaultProjectName, "raw", "master", commit.Id))
+		ci, err := c.WaitCommit(pfs.DefaultProjectName, "raw", "", commit.Id)
+		require.NoError(t, err)
+		return ci
+	}
+	ci := put("a.csv")
+	require.Equal(t, "", ci.Error)
+	require.True(t, ci.Details.Validation.Passed)
+
+	// the second commit violates the contract once a.csv is deleted.
+	commit, err := c.StartCommit(pfs.DefaultProjectName, "raw", "master")
+	require.NoError(t, err)
+	require.NoError(t, c.DeleteFile(commit, "a.csv"))
+	require.NoError(t, c.PutFile(commit, "b.txt", strings.NewReader("data")))
+	require.NoError(t, c.FinishCommit(pfs.DefaultProjectName, "raw", "master", commit.Id))
+	ci, err = c.WaitCommit(pfs.DefaultProjectName, "raw", "", commit.Id)
+	require.NoError(t, err)
+	require.False(t, ci.Details.Validation.Passed)
+	require.Equal(t, `no file matches required glob "/*.csv"`, ci.Details.Validation.Reason)
+	require.Matches(t, "commit validation failed", ci.Error)
+
+	// without the validation, commits aren't checked.
+	_, err = c.PfsAPIClient.SetCommitValidation(ctx, &pfs.SetCommitValidationRequest{Repo: repo})
+	require.NoError(t, err)
+	ci = put("c.txt")
+	require.Equal(t, "", ci.Error)
+	require.Nil(t, ci.Details.Validation)
+}
+
+func TestCommitValidator(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	env := realenv.NewRealEnv(ctx, t, dockertestenv.NewTestDBConfig(t))
+	c := env.PachClient
+
+	repo := client.NewRepo(pfs.DefaultProjectName, "raw")
+	validator := client.NewRepo(pfs.DefaultProjectName, "check")
+	require.NoError(t, c.CreateRepo(pfs.DefaultProjectName, "raw"))
+	require.NoError(t, c.CreateRepo(pfs.DefaultProjectName, "check"))
+	// the validator must take the repo as input.
+	_, err := c.PfsAPIClient.SetCommitValidation(ctx, &pfs.SetCommitValidationRequest{Repo: repo, Validator: validator})
+	require.YesError(t, err)
+	require.NoError(t, c.CreateBranch(pfs.DefaultProjectName, "check", "master", "", "", []*pfs.Branch{repo.NewBranch("master")}))
+	_, err = c.PfsAPIClient.SetCommitValidation(ctx, &pfs.SetCommitValidationRequest{Repo: repo, Validator: validator})
+	require.NoError(t, err)
+	require.NoError(t, c.CreateBranchTrigger(pfs.DefaultProjectName, "raw", "validated", "", "", &pfs.Trigger{Branch: "master"}))
+
+	// put commits a file to raw, and finishes the validator's commit with
+	// validatorErr.
+	put := func(path, validatorErr string) *pfs.CommitInfo {
+		commit, err := c.StartCommit(pfs.DefaultProjectName, "raw", "master")
+		require.NoError(t, err)
+		require.NoError(t, c.PutFile(commit, path, strings.NewReader("data")))
+		require.NoError(t, c.FinishCommit(pfs.DefaultProjectName, "raw", "master", commit.Id))
+		ci, err := c.WaitCommit(pfs.DefaultProjectName, "raw", "", commit.Id)
+		require.NoError(t, err)
+		require.True(t, ci.Details.Validation.Pending)
+		bi, err := c.InspectBranch(pfs.DefaultProjectName, "raw", "validated")
+		require.NoError(t, err)
+		require.NotEqual(t, commit.Id, bi.Head.Id)
+
+		_, err = c.PfsAPIClient.FinishCommit(ctx, &pfs.FinishCommitRequest{
+			Commit: validator.NewCommit("master", commit.Id),
+			Error:  validatorErr,
+		})
+		require.NoError(t, err)
+		_, err = c.WaitCommit(pfs.DefaultProjectName, "check", "", commit.Id)
+		require.NoError(t, err)
+		ci, err = c.InspectCommit(pfs.DefaultProjectName, "raw", "", commit.Id)
+		require.NoError(t, err)
+		require.False(t, ci.Details.Validation.Pending)
+		return ci
+	}
+	ci := put("a", "")
+	require.Equal(t, "", ci.Error)
+	require.True(t, ci.Details.Validation.Passed)
+	bi, err := c.InspectBranch(pfs.DefaultProjectName, "raw", "validated")
+	require.NoError(t, err)
+	require.Equal(t, ci.Commit.Id, bi.Head.Id)
+
+	ci = put("b", "bad data")
+	require.False(t, ci.Details.Validation.Passed)
+	require.Matches(t, "bad data", ci.Details.Validation.Reason)
+	require.Matches(t, "commit validation failed", ci.Error)
+	bi, err = c.InspectBranch(pfs.DefaultProjectName, "raw", "validated")
+	require.NoError(t, err)
+	require.NotEqual(t, ci.Commit.Id, bi.Head.Id)
+}
+
 func TestDeleteRepo2(t *testing.T) {
 	ctx := pctx.TestContext(t)
 	env := realenv.NewRealEnv(ctx, t, dockertestenv.NewTestDBConfig(t))
@@ -1523,6 +1861,44 @@ func TestInspectCommitWait(t *testing.T) {
 	require.NoError(t, eg.Wait())
 }
 
+func TestFinishCommitCheckpoint(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	env := realenv.NewRealEnv(ctx, t, dockertestenv.NewTestDBConfig(t))
+
+	repo := "test"
+	require.NoError(t, env.PachClient.CreateRepo(pfs.DefaultProjectName, repo))
+	commit1, err := env.PachClient.StartCommit(pfs.DefaultProjectName, repo, "master")
+	require.NoError(t, err)
+	require.NoError(t, env.PachClient.PutFile(commit1, "1", strings.NewReader("1")))
+	_, err = env.PachClient.PfsAPIClient.FinishCommit(ctx, &pfs.FinishCommitRequest{Commit: commit1, Checkpoint: "offset-1"})
+	require.NoError(t, err)
+	commitInfo, err := env.PachClient.WaitCommit(pfs.DefaultProjectName, repo, "", commit1.Id)
+	require.NoError(t, err)
+	require.Equal(t, "offset-1", commitInfo.Checkpoint)
+
+	// The data of a commit finished with an error is discarded along with its
+	// checkpoint, which is what exactly once spouts rely on.
+	commit2, err := env.PachClient.StartCommit(pfs.DefaultProjectName, repo, "master")
+	require.NoError(t, err)
+	require.NoError(t, env.PachClient.PutFile(commit2, "2", strings.NewReader("2")))
+	_, err = env.PachClient.PfsAPIClient.FinishCommit(ctx, &pfs.FinishCommitRequest{Commit: commit2, Error: "abandoned"})
+	require.NoError(t, err)
+	commit3, err := env.PachClient.StartCommit(pfs.DefaultProjectName, repo, "master")
+	require.NoError(t, err)
+	require.NoError(t, env.PachClient.PutFile(commit3, "3", strings.NewReader("3")))
+	require.NoError(t, finishCommit(env.PachClient, repo, "", commit3.Id))
+	commitInfo, err = env.PachClient.WaitCommit(pfs.DefaultProjectName, repo, "", commit3.Id)
+	require.NoError(t, err)
+	require.Equal(t, "", commitInfo.Checkpoint)
+	files, err := env.PachClient.ListFileAll(commit3, "")
+	require.NoError(t, err)
+	var paths []string
+	for _, fi := range files {
+		paths = append(paths, fi.File.Path)
+	}
+	require.ElementsEqual(t, []string{"/1", "/3"}, paths)
+}
+
 func TestDropCommitSet(t *testing.T) {
 	ctx := pctx.TestContext(t)
 	env := realenv.NewRealEnv(ctx, t, dockertestenv.NewTestDBConfig(t))
@@ -7072,6 +7448,53 @@ func TestTrigger(t *testing.T) {
 		require.Equal(t, aBranch.Head.Id, bBranch.Head.Id)
 		require.NotEqual(t, aBranch.Head.Id, cBranch.Head.Id)
 	})
+
+	t.Run("Approval", func(t *testing.T) {
+		t.Parallel()
+		repo := tu.UniqueString("approval")
+		require.NoError(t, c.CreateRepo(pfs.DefaultProjectName, repo))
+		require.NoError(t, c.CreateBranch(pfs.DefaultProjectName, repo, "candidate", "", "", nil))
+		require.NoError(t, c.CreateBranchTrigger(pfs.DefaultProjectName, repo, "production", "", "", &pfs.Trigger{
+			Branch:          "candidate",
+			RequireApproval: true,
+		}))
+		candidateHead := client.NewCommit(pfs.DefaultProjectName, repo, "candidate", "")
+		require.NoError(t, c.PutFile(candidateHead, "model", strings.NewReader("v1")))
+		candidate, err := c.WaitCommit(pfs.DefaultProjectName, repo, "candidate", "")
+		require.NoError(t, err)
+		production, err := c.InspectBranch(pfs.DefaultProjectName, repo, "production")
+		require.NoError(t, err)
+		require.NotEqual(t, candidate.Commit.Id, production.Head.Id)
+
+		// without auth, the approver must be named.
+		_, err = c.PfsAPIClient.ApproveCommit(ctx, &pfs.ApproveCommitRequest{Commit: candidate.Commit})
+		require.YesError(t, err)
+		_, err = c.PfsAPIClient.ApproveCommit(ctx, &pfs.ApproveCommitRequest{
+			Commit:    candidate.Commit,
+			Principal: "user:reviewer",
+			Comment:   "looks good",
+		})
+		require.NoError(t, err)
+		production, err = c.InspectBranch(pfs.DefaultProjectName, repo, "production")
+		require.NoError(t, err)
+		require.Equal(t, candidate.Commit.Id, production.Head.Id)
+		ci, err := c.InspectCommit(pfs.DefaultProjectName, repo, "", candidate.Commit.Id)
+		require.NoError(t, err)
+		require.Equal(t, "user:reviewer", ci.Approval.Principal)
+		require.Equal(t, "looks good", ci.Approval.Comment)
+		// a commit can only be approved once.
+		_, err = c.PfsAPIClient.ApproveCommit(ctx, &pfs.ApproveCommitRequest{Commit: candidate.Commit, Principal: "user:reviewer"})
+		require.YesError(t, err)
+
+		// the next candidate waits for its own approval.
+		require.NoError(t, c.PutFile(candidateHead, "model", strings.NewReader("v2")))
+		next, err := c.WaitCommit(pfs.DefaultProjectName, repo, "candidate", "")
+		require.NoError(t, err)
+		production, err = c.InspectBranch(pfs.DefaultProjectName, repo, "production")
+		require.NoError(t, err)
+		require.Equal(t, candidate.Commit.Id, production.Head.Id)
+		require.NotEqual(t, candidate.Commit.Id, next.Commit.Id)
+	})
 }
 
 // TriggerValidation tests branch trigger validation
@@ -7118,6 +7541,12 @@ func TestTriggerValidation(t *testing.T) {
 		Branch:   "master",
 		CronSpec: "this is not a cron spec",
 	}))
+	// Cron triggers can't require approval
+	require.YesError(t, c.CreateBranchTrigger(pfs.DefaultProjectName, "repo", "trigger", "", "", &pfs.Trigger{
+		Branch:          "master",
+		CronSpec:        "@every 1h",
+		RequireApproval: true,
+	}))
 	// Can't use a trigger and provenance together
 	require.NoError(t, c.CreateRepo(pfs.DefaultProjectName, "in"))
 	_, err := c.PfsAPIClient.CreateBranch(c.Ctx(),
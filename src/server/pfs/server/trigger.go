@@ -5,12 +5,15 @@ import (
 	"time"
 
 	units "github.com/docker/go-units"
+	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/cronutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
@@ -92,6 +95,18 @@ func (d *driver) triggerCommit(ctx context.Context, txnCtx *txncontext.Transacti
 // isTriggered checks to see if a branch should be updated from oldHead to
 // newHead based on a trigger.
 func (d *driver) isTriggered(ctx context.Context, txnCtx *txncontext.TransactionContext, t *pfs.Trigger, oldHead, newHead *pfs.CommitInfo) (bool, error) {
+	// Commits waiting for their validator are triggered on once it passes.
+	if newHead.Details.GetValidation().GetPending() {
+		return false, nil
+	}
+	if t.RequireApproval {
+		if newHead.Approval == nil {
+			return false, nil
+		}
+		if t.RateLimitSpec == "" && t.Size == "" && t.Commits == 0 {
+			return true, nil
+		}
+	}
 	result := t.All
 	merge := func(cond bool) {
 		if t.All {
@@ -177,6 +192,9 @@ func (d *driver) validateTrigger(ctx context.Context, txnCtx *txncontext.Transac
 	if _, err := cronutil.ParseCronExpression(trigger.CronSpec); trigger.CronSpec != "" && err != nil {
 		return errors.Wrapf(err, "invalid trigger cron spec")
 	}
+	if trigger.RequireApproval && trigger.CronSpec != "" {
+		return errors.Errorf("cron triggers cannot require approval")
+	}
 
 	biMaps := make(map[string]*pfs.BranchInfo)
 	if err := d.listBranchInTransaction(ctx, txnCtx, branch.Repo, false, func(bi *pfs.BranchInfo) error {
@@ -199,3 +217,75 @@ func (d *driver) validateTrigger(ctx context.Context, txnCtx *txncontext.Transac
 	}
 	return nil
 }
+
+// approveCommit records the approval of a commit by principal.  If the commit
+// is finished and is the head of its branch, the triggers waiting for its
+// approval are fired, otherwise they're fired when it finishes.  Approving a
+// commit which is no longer the head of its branch doesn't move the branches
+// which trigger on it back to it.
+func (d *driver) approveCommit(ctx context.Context, commit *pfs.Commit, principal, comment string) error {
+	whoAmI, err := d.env.Auth.WhoAmI(ctx, &auth.WhoAmIRequest{})
+	switch {
+	case auth.IsErrNotActivated(err):
+		if principal == "" {
+			return errors.New("the approving principal must be set when auth is not activated")
+		}
+	case err != nil:
+		return errors.EnsureStack(err)
+	case principal != "" && principal != whoAmI.Username:
+		return errors.Errorf("cannot approve a commit as %s while authenticated as %s", principal, whoAmI.Username)
+	default:
+		principal = whoAmI.Username
+	}
+	var approved *pfs.Commit
+	if err := d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		commitInfo, err := d.resolveCommit(ctx, txnCtx.SqlTx, commit)
+		if err != nil {
+			return err
+		}
+		if commitInfo.Error != "" {
+			return errors.Errorf("commit %v failed and cannot be approved: %s", commitInfo.Commit, commitInfo.Error)
+		}
+		if a := commitInfo.Approval; a != nil {
+			return errors.Errorf("commit %v was already approved by %s", commitInfo.Commit, a.Principal)
+		}
+		if err := d.commits.ReadWrite(txnCtx.SqlTx).Update(commitInfo.Commit, commitInfo, func() error {
+			commitInfo.Approval = &pfs.CommitApproval{
+				Principal: principal,
+				Comment:   comment,
+				Approved:  txnCtx.Timestamp,
+			}
+			return nil
+		}); err != nil {
+			return errors.EnsureStack(err)
+		}
+		approved = commitInfo.Commit
+		return d.triggerHead(ctx, txnCtx, commitInfo)
+	}); err != nil {
+		return err
+	}
+	// Approvals are audited like the commands run by users, once they're
+	// committed.
+	log.Info(ctx, "audit log", zap.String("service", "pfs_v2.API"), zap.String("method", "ApproveCommit"), zap.String("user", principal), log.Proto("commit", approved), zap.String("comment", comment))
+	return nil
+}
+
+// triggerHead fires the triggers on a finished commit which has become ready
+// to be triggered on, such as by being approved, if it is still the head of its
+// branch.
+func (d *driver) triggerHead(ctx context.Context, txnCtx *txncontext.TransactionContext, commitInfo *pfs.CommitInfo) error {
+	if commitInfo.Finished == nil || commitInfo.Commit.Branch == nil {
+		return nil
+	}
+	branchInfo := &pfs.BranchInfo{}
+	if err := d.branches.ReadWrite(txnCtx.SqlTx).Get(commitInfo.Commit.Branch, branchInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil
+		}
+		return errors.EnsureStack(err)
+	}
+	if branchInfo.Head.Id != commitInfo.Commit.Id {
+		return nil
+	}
+	return d.triggerCommit(ctx, txnCtx, commitInfo)
+}
@@ -0,0 +1,316 @@
+package server
+
+import (
+	"context"
+	"io"
+	"path"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+const (
+	defaultUploadSessionTTL = 24 * time.Hour
+	minUploadSessionTTL     = time.Minute
+)
+
+func (d *driver) createUploadSession(ctx context.Context, req *pfs.CreateUploadSessionRequest) (*pfs.UploadSessionInfo, error) {
+	repo := req.File.Commit.Repo
+	if err := d.env.Auth.CheckRepoIsAuthorized(ctx, repo, auth.Permission_REPO_WRITE); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	ttl := defaultUploadSessionTTL
+	if req.Ttl != nil {
+		ttl = req.Ttl.AsDuration()
+	}
+	if ttl < minUploadSessionTTL {
+		return nil, errors.Errorf("upload session ttl (%v) must be at least %v", ttl, minUploadSessionTTL)
+	}
+	now := time.Now()
+	info := &pfs.UploadSessionInfo{
+		Id:      uuid.NewWithoutDashes(),
+		File:    req.File,
+		Append:  req.Append,
+		Created: timestamppb.New(now),
+		Expires: timestamppb.New(now.Add(ttl)),
+		Ttl:     durationpb.New(ttl),
+	}
+	if err := dbutil.WithTx(ctx, d.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		if _, err := pfsdb.GetRepoByName(ctx, tx, repo.Project.Name, repo.Name, repo.Type); err != nil {
+			if pfsdb.IsErrRepoNotFound(err) {
+				return pfsserver.ErrRepoNotFound{Repo: repo}
+			}
+			return errors.EnsureStack(err)
+		}
+		return errors.EnsureStack(d.uploadSessions.ReadWrite(tx).Create(info.Id, info))
+	}); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// inspectUploadSession returns the unexpired upload session with the given ID,
+// if the caller may write to the session's repo.
+func (d *driver) inspectUploadSession(ctx context.Context, id string) (*pfs.UploadSessionInfo, error) {
+	info := &pfs.UploadSessionInfo{}
+	if err := d.uploadSessions.ReadOnly(ctx).Get(id, info); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, pfsserver.ErrUploadSessionNotFound{ID: id}
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	if info.Expires.AsTime().Before(time.Now()) {
+		return nil, pfsserver.ErrUploadSessionNotFound{ID: id}
+	}
+	if err := d.env.Auth.CheckRepoIsAuthorized(ctx, info.File.Commit.Repo, auth.Permission_REPO_WRITE); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return info, nil
+}
+
+// listUploadSession returns the unexpired upload sessions for repos that the
+// caller may write to.
+func (d *driver) listUploadSession(ctx context.Context, repo *pfs.Repo, cb func(*pfs.UploadSessionInfo) error) error {
+	now := time.Now()
+	authorized := make(map[string]bool)
+	info := &pfs.UploadSessionInfo{}
+	send := func(string) error {
+		if info.Expires.AsTime().Before(now) {
+			return nil
+		}
+		key := pfsdb.RepoKey(info.File.Commit.Repo)
+		ok, seen := authorized[key]
+		if !seen {
+			if err := d.env.Auth.CheckRepoIsAuthorized(ctx, info.File.Commit.Repo, auth.Permission_REPO_WRITE); err != nil {
+				if !auth.IsErrNotAuthorized(err) {
+					return errors.EnsureStack(err)
+				}
+			} else {
+				ok = true
+			}
+			authorized[key] = ok
+		}
+		if !ok {
+			return nil
+		}
+		return cb(proto.Clone(info).(*pfs.UploadSessionInfo))
+	}
+	if repo != nil {
+		return errors.EnsureStack(d.uploadSessions.ReadOnly(ctx).GetByIndex(pfsdb.UploadSessionsRepoIndex, pfsdb.RepoKey(repo), info, col.DefaultOptions(), send))
+	}
+	return errors.EnsureStack(d.uploadSessions.ReadOnly(ctx).List(info, col.DefaultOptions(), send))
+}
+
+// uploadPart writes the content of r to a temporary file set and records it as
+// part number of the upload session.  The file sets of a session's parts are
+// kept until the session expires, and the session's expiration is extended
+// once less than half of its ttl remains.
+func (d *driver) uploadPart(ctx context.Context, id string, number int64, r io.Reader) (*pfs.UploadPartInfo, error) {
+	info, err := d.inspectUploadSession(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	fsid, err := d.createFileSet(ctx, func(uw *fileset.UnorderedWriter) error {
+		return uw.Put(ctx, info.File.Path, "", true, r)
+	})
+	if err != nil {
+		return nil, err
+	}
+	part := &pfs.UploadPartInfo{
+		Number:    number,
+		FileSetId: fsid.HexString(),
+	}
+	fs, err := d.storage.Filesets.Open(ctx, []fileset.ID{*fsid})
+	if err != nil {
+		return nil, err
+	}
+	if err := fs.Iterate(ctx, func(f fileset.File) error {
+		part.SizeBytes = index.SizeBytes(f.Index())
+		hash, err := f.Hash(ctx)
+		part.Hash = hash
+		return errors.EnsureStack(err)
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	var renew bool
+	if err := dbutil.WithTx(ctx, d.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		renew = false
+		return errors.EnsureStack(d.uploadSessions.ReadWrite(tx).Update(id, info, func() error {
+			now := time.Now()
+			if info.Expires.AsTime().Before(now) {
+				return pfsserver.ErrUploadSessionNotFound{ID: id}
+			}
+			i := sort.Search(len(info.Parts), func(i int) bool { return info.Parts[i].Number >= number })
+			if i < len(info.Parts) && info.Parts[i].Number == number {
+				info.Parts[i] = part
+			} else {
+				info.Parts = append(info.Parts[:i], append([]*pfs.UploadPartInfo{part}, info.Parts[i:]...)...)
+			}
+			if ttl := info.Ttl.AsDuration(); info.Expires.AsTime().Sub(now) < ttl/2 {
+				info.Expires = timestamppb.New(now.Add(ttl))
+				renew = true
+			}
+			return nil
+		}))
+	}); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, pfsserver.ErrUploadSessionNotFound{ID: id}
+		}
+		return nil, err
+	}
+	parts := []*pfs.UploadPartInfo{part}
+	if renew {
+		parts = info.Parts
+	}
+	for _, p := range parts {
+		if err := d.renewUploadPart(ctx, p, time.Until(info.Expires.AsTime())); err != nil {
+			return nil, err
+		}
+	}
+	return part, nil
+}
+
+func (d *driver) renewUploadPart(ctx context.Context, part *pfs.UploadPartInfo, ttl time.Duration) error {
+	fsid, err := fileset.ParseID(part.FileSetId)
+	if err != nil {
+		return err
+	}
+	_, err = d.storage.Filesets.SetTTL(ctx, *fsid, ttl)
+	return errors.EnsureStack(err)
+}
+
+// commitUploadSession writes the given parts of an upload session, in order,
+// to the session's file in a single commit, and then deletes the session.
+func (d *driver) commitUploadSession(ctx context.Context, id string, numbers []int64) error {
+	info, err := d.inspectUploadSession(ctx, id)
+	if err != nil {
+		return err
+	}
+	parts := info.Parts
+	if len(numbers) > 0 {
+		byNumber := make(map[int64]*pfs.UploadPartInfo)
+		for _, p := range info.Parts {
+			byNumber[p.Number] = p
+		}
+		parts = nil
+		for _, n := range numbers {
+			p, ok := byNumber[n]
+			if !ok {
+				return errors.Errorf("part %d of upload session %s has not been uploaded", n, id)
+			}
+			delete(byNumber, n)
+			parts = append(parts, p)
+		}
+	}
+	var fsids []fileset.ID
+	for _, p := range parts {
+		fsid, err := fileset.ParseID(p.FileSetId)
+		if err != nil {
+			return err
+		}
+		fsids = append(fsids, *fsid)
+	}
+	if err := d.modifyFile(ctx, info.File.Commit, func(uw *fileset.UnorderedWriter) error {
+		if !info.Append {
+			if err := uw.Delete(ctx, info.File.Path, ""); err != nil {
+				return err
+			}
+		}
+		for _, fsid := range fsids {
+			if err := uw.AddFileSet(ctx, fsid); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	return dbutil.WithTx(ctx, d.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		return errors.EnsureStack(d.uploadSessions.ReadWrite(tx).Delete(id))
+	})
+}
+
+func (d *driver) deleteUploadSession(ctx context.Context, id string) error {
+	if _, err := d.inspectUploadSession(ctx, id); err != nil {
+		return err
+	}
+	return dbutil.WithTx(ctx, d.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		return errors.EnsureStack(d.uploadSessions.ReadWrite(tx).Delete(id))
+	})
+}
+
+// deleteExpiredUploadSessions deletes the sessions which expired before now.
+// The file sets of their parts are garbage collected by storage GC.
+func (d *driver) deleteExpiredUploadSessions(ctx context.Context, now time.Time) (int, error) {
+	var expired []string
+	info := &pfs.UploadSessionInfo{}
+	if err := d.uploadSessions.ReadOnly(ctx).List(info, col.DefaultOptions(), func(key string) error {
+		if info.Expires.AsTime().Before(now) {
+			expired = append(expired, key)
+		}
+		return nil
+	}); err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	if len(expired) == 0 {
+		return 0, nil
+	}
+	if err := dbutil.WithTx(ctx, d.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		sessions := d.uploadSessions.ReadWrite(tx)
+		for _, key := range expired {
+			if err := sessions.Delete(key); err != nil && !col.IsErrNotFound(err) {
+				return errors.EnsureStack(err)
+			}
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return len(expired), nil
+}
+
+// collectUploadSessions periodically deletes expired upload sessions.
+func (m *Master) collectUploadSessions(ctx context.Context, period time.Duration) error {
+	lock := dlock.NewDLock(m.driver.etcdClient, path.Join(m.driver.prefix, masterLockPath, "upload-sessions"))
+	ctx, err := lock.Lock(ctx)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := lock.Unlock(ctx); err != nil {
+			log.Error(ctx, "error unlocking in pfs master (upload sessions)", zap.Error(err))
+		}
+	}()
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		if n, err := m.driver.deleteExpiredUploadSessions(ctx, time.Now()); err != nil {
+			log.Error(ctx, "error deleting expired upload sessions", zap.Error(err))
+		} else if n > 0 {
+			log.Info(ctx, "deleted expired upload sessions", zap.Int("count", n))
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return errors.EnsureStack(context.Cause(ctx))
+		}
+	}
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/promutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
@@ -33,7 +34,7 @@ var (
 	defaultSizeThreshold       = int64(units.GB)
 )
 
-func putFileURL(ctx context.Context, taskService task.Service, uw *fileset.UnorderedWriter, dstPath, tag string, src *pfs.AddFile_URLSource) (n int64, retErr error) {
+func putFileURL(ctx context.Context, taskService task.Service, getSecret secretGetter, uw *fileset.UnorderedWriter, dstPath, tag string, src *pfs.AddFile_URLSource) (n int64, retErr error) {
 	url, err := url.Parse(src.URL)
 	if err != nil {
 		return 0, errors.EnsureStack(err)
@@ -57,35 +58,55 @@ func putFileURL(ctx context.Context, taskService task.Service, uw *fileset.Unord
 		return 0, uw.Put(ctx, dstPath, tag, true, resp.Body)
 	default:
 		if src.Recursive {
-			return 0, putFileURLRecursive(ctx, taskService, uw, dstPath, tag, src)
+			return 0, putFileURLRecursive(ctx, taskService, getSecret, uw, dstPath, tag, src)
 		}
 		url, err := obj.ParseURL(src.URL)
 		if err != nil {
 			return 0, errors.EnsureStack(err)
 		}
-		bucket, err := openBucket(ctx, url)
+		bucket, err := openBucket(ctx, url, getSecret)
 		if err != nil {
 			return 0, err
 		}
 		defer errors.Close(&retErr, bucket, "close bucket")
-		r, err := bucket.NewReader(ctx, url.Object, nil)
+		c := obj.NewFromBucket(bucket, *url)
+		info, err := obj.Stat(ctx, c, url.Object)
 		if err != nil {
-			return 0, errors.EnsureStack(err)
+			return 0, err
 		}
-		defer errors.Close(&retErr, r, "close reader for bucket %v", url.Bucket)
-		return 0, uw.Put(ctx, dstPath, tag, true, r)
+		// Objects larger than a shard are read in parallel, in ranges, by
+		// tasks which are retried independently.
+		if info.Size > defaultSizeThreshold {
+			return 0, putFileURLTasks(ctx, taskService, uw, dstPath, tag, src, func(cb shardCallback) error {
+				return shardObject(url.Object, info.Size, cb)
+			})
+		}
+		return 0, miscutil.WithPipe(func(w io.Writer) error {
+			return errors.EnsureStack(c.Get(ctx, url.Object, w))
+		}, func(r io.Reader) error {
+			return errors.EnsureStack(uw.Put(ctx, dstPath, tag, true, r))
+		})
 	}
 }
 
 type shardCallback func(paths []string, startOffset, endOffset int64) error
 
-func putFileURLRecursive(ctx context.Context, taskService task.Service, uw *fileset.UnorderedWriter, dst, tag string, src *pfs.AddFile_URLSource) error {
+func putFileURLRecursive(ctx context.Context, taskService task.Service, getSecret secretGetter, uw *fileset.UnorderedWriter, dst, tag string, src *pfs.AddFile_URLSource) error {
+	return putFileURLTasks(ctx, taskService, uw, dst, tag, src, func(cb shardCallback) error {
+		return shardObjects(ctx, src.URL, getSecret, cb)
+	})
+}
+
+// putFileURLTasks creates a task for each of the shards of the objects at
+// src.URL created by shard, and adds the file sets the tasks create to uw in
+// order.
+func putFileURLTasks(ctx context.Context, taskService task.Service, uw *fileset.UnorderedWriter, dst, tag string, src *pfs.AddFile_URLSource, shard func(shardCallback) error) error {
 	inputChan := make(chan *anypb.Any)
 	eg, ctx := errgroup.WithContext(ctx)
 	doer := taskService.NewDoer(URLTaskNamespace, uuid.NewWithoutDashes(), nil)
 	// Create tasks.
 	eg.Go(func() error {
-		if err := shardObjects(ctx, src.URL, func(paths []string, startOffset, endOffset int64) error {
+		if err := shard(func(paths []string, startOffset, endOffset int64) error {
 			input, err := serializePutFileURLTask(&PutFileURLTask{
 				Dst:         dst,
 				Datum:       tag,
@@ -136,18 +157,22 @@ func putFileURLRecursive(ctx context.Context, taskService task.Service, uw *file
 }
 
 // shardObjects iterates through a list of objects and creates tasks by sharding small files
-// into a single shard. Files larger than a shard size will be given their own dedicated shard.
-func shardObjects(ctx context.Context, URL string, cb shardCallback) (retErr error) {
+// into a single shard. Files larger than a shard size are split into shards of byte ranges.
+func shardObjects(ctx context.Context, URL string, getSecret secretGetter, cb shardCallback) (retErr error) {
 	url, err := obj.ParseURL(URL)
 	if err != nil {
 		return errors.EnsureStack(err)
 	}
-	bucket, err := openBucket(ctx, url)
+	bucket, err := openBucket(ctx, url, getSecret)
 	if err != nil {
 		return err
 	}
 	defer errors.Close(&retErr, bucket, "close bucket")
-	list := bucket.List(&blob.ListOptions{Prefix: url.Object})
+	return shardBucket(ctx, bucket, url.Object, cb)
+}
+
+func shardBucket(ctx context.Context, bucket *blob.Bucket, prefix string, cb shardCallback) error {
+	list := bucket.List(&blob.ListOptions{Prefix: prefix})
 	var paths []string
 	var size int64
 	for {
@@ -158,6 +183,12 @@ func shardObjects(ctx context.Context, URL string, cb shardCallback) (retErr err
 			}
 			return errors.EnsureStack(err)
 		}
+		if listObj.Size > defaultSizeThreshold {
+			if err := shardObject(listObj.Key, listObj.Size, cb); err != nil {
+				return err
+			}
+			continue
+		}
 		paths = append(paths, listObj.Key)
 		size += listObj.Size
 		if len(paths) >= defaultNumObjectsThreshold || size >= defaultSizeThreshold {
@@ -174,6 +205,21 @@ func shardObjects(ctx context.Context, URL string, cb shardCallback) (retErr err
 	return nil
 }
 
+// shardObject splits the object at path, which is size bytes long, into
+// shards of byte ranges no larger than a shard size.
+func shardObject(path string, size int64, cb shardCallback) error {
+	for offset := int64(0); offset < size; offset += defaultSizeThreshold {
+		endOffset := offset + defaultSizeThreshold
+		if endOffset >= size {
+			endOffset = -1
+		}
+		if err := cb([]string{path}, offset, endOffset); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (d *driver) getFileURL(ctx context.Context, taskService task.Service, URL string, file *pfs.File, basePathRange *pfs.PathRange) (int64, error) {
 	if basePathRange == nil {
 		basePathRange = &pfs.PathRange{}
@@ -5,15 +5,20 @@ package server
 import (
 	"bytes"
 	"context"
+	"io"
 	"os"
 	"path"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"gocloud.dev/blob"
+	"gocloud.dev/blob/memblob"
 
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj/integrationtests"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
 	"github.com/pachyderm/pachyderm/v2/src/internal/randutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 )
@@ -33,7 +38,7 @@ func TestSharding(t *testing.T) {
 	require.NoError(t, err, "should be able to read dir")
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*300000)
 	defer cancel()
-	bucket, err := openBucket(ctx, url)
+	bucket, err := openBucket(ctx, url, nil)
 	require.NoError(t, err, "should be able to open bucket")
 	defer func() {
 		require.NoError(t, bucket.Close())
@@ -51,7 +56,7 @@ func TestSharding(t *testing.T) {
 	}()
 	var tasks []*PutFileURLTask
 	defaultSizeThreshold, defaultNumObjectsThreshold = 3, 3
-	require.NoError(t, shardObjects(ctx, url.BucketString()+"/"+objStoreDir,
+	require.NoError(t, shardObjects(ctx, url.BucketString()+"/"+objStoreDir, nil,
 		func(paths []string, startOffset, endOffset int64) error {
 			task := &PutFileURLTask{
 				Paths:       paths,
@@ -73,29 +78,119 @@ func TestSharding(t *testing.T) {
 }
 
 func processTasks(ctx context.Context, t *testing.T, tasks []*PutFileURLTask, bucket *blob.Bucket) map[string]string {
+	c := obj.NewFromBucket(bucket, obj.ObjectStoreURL{Scheme: "mem"})
 	verifiedFiles := make(map[string]string)
 	for _, task := range tasks {
-		startOffset := task.StartOffset
-		length := int64(-1) // -1 means to read until end of file.
-		for i, filePath := range task.Paths {
-			func() {
-				if i != 0 {
-					startOffset = 0
-				}
-				if i == len(task.Paths)-1 && task.EndOffset != int64(-1) {
-					length = task.EndOffset - startOffset
-				}
-				r, err := bucket.NewRangeReader(ctx, filePath, startOffset, length, nil)
-				require.NoError(t, err, "should be able to create range reader")
-				defer func() {
-					require.NoError(t, r.Close(), "should be able to close file")
-				}()
-				buf := &bytes.Buffer{}
-				_, err = r.WriteTo(buf)
-				require.NoError(t, err, "should be able to write to buffer")
-				verifiedFiles[path.Base(filePath)] += buf.String()
-			}()
-		}
+		require.NoError(t, readPutFileURLShard(ctx, c, "", task, func(filePath string, r io.Reader) error {
+			data, err := io.ReadAll(r)
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			verifiedFiles[path.Base(filePath)] += string(data)
+			return nil
+		}), "should be able to read shard")
 	}
 	return verifiedFiles
 }
+
+func TestShardLargeObjects(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	bucket := memblob.OpenBucket(nil)
+	defer func() {
+		require.NoError(t, bucket.Close())
+	}()
+	files := map[string]string{
+		"dir/a": "01",
+		"dir/b": "0123456789",
+		"dir/c": "0",
+	}
+	for file, data := range files {
+		writeToObjStorage(ctx, t, bucket, file, data)
+	}
+	defer func(size int64, num int) {
+		defaultSizeThreshold, defaultNumObjectsThreshold = size, num
+	}(defaultSizeThreshold, defaultNumObjectsThreshold)
+	defaultSizeThreshold, defaultNumObjectsThreshold = 4, 10
+	var tasks []*PutFileURLTask
+	require.NoError(t, shardBucket(ctx, bucket, "dir", func(paths []string, startOffset, endOffset int64) error {
+		tasks = append(tasks, &PutFileURLTask{
+			Paths:       paths,
+			StartOffset: startOffset,
+			EndOffset:   endOffset,
+		})
+		return nil
+	}))
+	// The large object is read in ranges, by separate tasks.
+	var ranges [][2]int64
+	for _, task := range tasks {
+		if len(task.Paths) == 1 && task.Paths[0] == "dir/b" {
+			ranges = append(ranges, [2]int64{task.StartOffset, task.EndOffset})
+		}
+	}
+	require.Equal(t, [][2]int64{{0, 4}, {4, 8}, {8, -1}}, ranges)
+	processedFiles := processTasks(ctx, t, tasks, bucket)
+	require.Equal(t, map[string]string{"a": "01", "b": "0123456789", "c": "0"}, processedFiles)
+}
+
+// multipartCounter is a client which writes objects in parts, and counts the
+// parts written.
+type multipartCounter struct {
+	obj.Client
+	parts atomic.Int64
+}
+
+func (c *multipartCounter) NewMultipartUpload(ctx context.Context, name string) (obj.MultipartUpload, error) {
+	u, err := obj.NewMultipartUpload(ctx, c.Client, name)
+	if err != nil {
+		return nil, err
+	}
+	return &countedUpload{MultipartUpload: u, c: c}, nil
+}
+
+type countedUpload struct {
+	obj.MultipartUpload
+	c *multipartCounter
+}
+
+func (u *countedUpload) PutPart(ctx context.Context, n int, data []byte) error {
+	u.c.parts.Add(1)
+	return u.MultipartUpload.PutPart(ctx, n, data)
+}
+
+func TestPutEgressObject(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	bucket := memblob.OpenBucket(nil)
+	defer func() {
+		require.NoError(t, bucket.Close())
+	}()
+	c := &multipartCounter{Client: obj.NewFromBucket(bucket, obj.ObjectStoreURL{Scheme: "mem"})}
+	defer func(size int64) {
+		egressPartSize = size
+	}(egressPartSize)
+	egressPartSize = 4
+	for _, tc := range []struct {
+		data  string
+		parts int64
+	}{
+		{"012", 0},
+		{"0123", 0},
+		{"0123456789", 3},
+		{"01234567", 2},
+	} {
+		c.parts.Store(0)
+		name := randutil.UniqueString("dir/egress-")
+		require.NoError(t, putEgressObject(ctx, c, name, int64(len(tc.data)), func(w io.Writer) error {
+			// Write the content in pieces which don't line up with the parts.
+			for data := []byte(tc.data); len(data) > 0; data = data[min(3, len(data)):] {
+				if _, err := w.Write(data[:min(3, len(data))]); err != nil {
+					return errors.EnsureStack(err)
+				}
+			}
+			return nil
+		}))
+		require.Equal(t, tc.parts, c.parts.Load(), "parts of %q", tc.data)
+		buf := &bytes.Buffer{}
+		require.NoError(t, c.Get(ctx, name, buf))
+		require.Equal(t, tc.data, buf.String())
+	}
+}
@@ -0,0 +1,196 @@
+package server
+
+import (
+	"context"
+	"path"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// computeStorageUsage computes the physical storage used by every project,
+// repo and commit from the references between tracked objects and the sizes
+// of the chunks that they reference.
+func (d *driver) computeStorageUsage(ctx context.Context) (map[string]*pfs.StorageUsage, error) {
+	g, err := track.LoadGraph(ctx, d.env.DB)
+	if err != nil {
+		return nil, err
+	}
+	sizes := make(map[string]int64)
+	if err := d.storage.Chunks.ListSizes(ctx, func(id chunk.ID, size int64) error {
+		sizes[id.TrackerID()] = size
+		return nil
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return storageUsage(g, sizes)
+}
+
+// storageUsage computes the storage usage of the commits, repos and projects
+// referencing the chunks in g.  A commit's usage is that of its diff file sets,
+// while a repo's or project's usage includes the total file sets of its commits
+// as well.  Chunks referenced by only one commit, repo or project are counted as
+// exclusive to it, and chunks referenced by more than one as shared.
+func storageUsage(g *track.Graph, chunkSizes map[string]int64) (map[string]*pfs.StorageUsage, error) {
+	chunks := make(map[int64]int64)
+	projects := make(map[string][]int64)
+	repos := make(map[string][]int64)
+	commits := make(map[string][]int64)
+	for id, strID := range g.IDs {
+		if size, ok := chunkSizes[strID]; ok {
+			chunks[id] = size
+			continue
+		}
+		key, diff, ok := parseCommitTrackerID(strID)
+		if !ok {
+			continue
+		}
+		commit := pfsdb.ParseCommit(key)
+		projects[pfsdb.ProjectKey(commit.Repo.Project)] = append(projects[pfsdb.ProjectKey(commit.Repo.Project)], id)
+		repos[pfsdb.RepoKey(commit.Repo)] = append(repos[pfsdb.RepoKey(commit.Repo)], id)
+		if diff {
+			commits[key] = append(commits[key], id)
+		}
+	}
+	usage := make(map[string]*pfs.StorageUsage)
+	for _, owners := range []map[string][]int64{projects, repos, commits} {
+		if err := addStorageUsage(g, chunks, owners, usage); err != nil {
+			return nil, err
+		}
+	}
+	return usage, nil
+}
+
+// addStorageUsage adds the usage of each owner to usage, where the roots of an
+// owner are the tracked objects that it references.  Exclusive bytes come from
+// labeling every object with its owner in one traversal of g, while shared
+// bytes need a walk per owner, but only of the objects that lead to a shared
+// chunk.
+func addStorageUsage(g *track.Graph, chunks map[int64]int64, owners map[string][]int64, usage map[string]*pfs.StorageUsage) error {
+	labels := g.Owners(owners)
+	for key := range owners {
+		usage[key] = &pfs.StorageUsage{}
+	}
+	isShared := func(id int64) bool {
+		_, isChunk := chunks[id]
+		owner, ok := labels[id]
+		return isChunk && ok && owner == ""
+	}
+	for id, size := range chunks {
+		if owner, ok := labels[id]; ok && owner != "" {
+			usage[owner].ExclusiveBytes += size
+		}
+	}
+	// leadsToShared records whether a shared chunk is reachable from each
+	// object, so the walks below skip the parts of the graph without one.
+	leadsToShared := make(map[int64]bool)
+	var visit func(id int64) bool
+	visit = func(id int64) bool {
+		if ok, visited := leadsToShared[id]; visited {
+			return ok
+		}
+		leadsToShared[id] = false
+		ok := isShared(id)
+		for _, child := range g.Downstream[id] {
+			if visit(child) {
+				ok = true
+			}
+		}
+		leadsToShared[id] = ok
+		return ok
+	}
+	for key, roots := range owners {
+		visited := make(map[int64]struct{})
+		stack := append([]int64{}, roots...)
+		for len(stack) > 0 {
+			id := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if _, ok := visited[id]; ok || !visit(id) {
+				continue
+			}
+			visited[id] = struct{}{}
+			if isShared(id) {
+				usage[key].SharedBytes += chunks[id]
+			}
+			stack = append(stack, g.Downstream[id]...)
+		}
+	}
+	return nil
+}
+
+// parseCommitTrackerID returns the commit key of a commit tracker id, and
+// whether it references a diff or total file set.
+func parseCommitTrackerID(id string) (string, bool, bool) {
+	if !strings.HasPrefix(id, commitTrackerPrefix) {
+		return "", false, false
+	}
+	id = strings.TrimPrefix(id, commitTrackerPrefix)
+	if i := strings.LastIndex(id, "/diff/"); i >= 0 {
+		return id[:i], true, true
+	}
+	if i := strings.LastIndex(id, "/total/"); i >= 0 {
+		return id[:i], false, true
+	}
+	return "", false, false
+}
+
+// getStorageUsage returns the cached storage usage for key, which is a project,
+// repo or commit key, or nil if it has not been computed yet.
+func (d *driver) getStorageUsage(ctx context.Context, key string) (*pfs.StorageUsage, error) {
+	var usage *pfs.StorageUsage
+	if err := dbutil.WithTx(ctx, d.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		var err error
+		usage, err = pfsdb.GetStorageUsage(ctx, tx, key)
+		return err
+	}, dbutil.WithReadOnly()); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return usage, nil
+}
+
+// accountStorageUsage periodically computes and caches the storage usage of
+// every project, repo and commit.
+func (m *Master) accountStorageUsage(ctx context.Context, period time.Duration) error {
+	lock := dlock.NewDLock(m.driver.etcdClient, path.Join(m.driver.prefix, masterLockPath, "usage"))
+	ctx, err := lock.Lock(ctx)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := lock.Unlock(ctx); err != nil {
+			log.Error(ctx, "error unlocking in pfs master (usage)", zap.Error(err))
+		}
+	}()
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		computedAt := time.Now()
+		usage, err := m.driver.computeStorageUsage(ctx)
+		if err == nil {
+			err = dbutil.WithTx(ctx, m.driver.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+				return pfsdb.ReplaceStorageUsage(ctx, tx, usage, computedAt)
+			})
+		}
+		if err != nil {
+			log.Error(ctx, "error computing storage usage", zap.Error(err))
+		} else {
+			log.Info(ctx, "computed storage usage", zap.Int("entries", len(usage)))
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return errors.EnsureStack(context.Cause(ctx))
+		}
+	}
+}
@@ -0,0 +1,60 @@
+//go:build unit_test
+
+package server
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
+)
+
+func TestStorageUsage(t *testing.T) {
+	g := &track.Graph{
+		IDs: map[int64]string{
+			1:  "chunk/01",
+			2:  "chunk/02",
+			3:  "chunk/03",
+			4:  "fileset/04",
+			5:  "fileset/05",
+			6:  "fileset/06",
+			7:  "fileset/07",
+			10: "commit/p/a.user@1/diff/04",
+			11: "commit/p/a.user@2/diff/05",
+			12: "commit/p/a.user@2/total/07",
+			13: "commit/q/b.user@3/diff/06",
+		},
+		Downstream: map[int64][]int64{
+			4:  {1, 2},
+			5:  {2, 3},
+			6:  {3},
+			7:  {4, 5},
+			10: {4},
+			11: {5},
+			12: {7},
+			13: {6},
+		},
+	}
+	sizes := map[string]int64{
+		"chunk/01": 100,
+		"chunk/02": 200,
+		"chunk/03": 400,
+	}
+	usage, err := storageUsage(g, sizes)
+	require.NoError(t, err)
+	for key, expected := range map[string][2]int64{
+		"p":          {300, 400},
+		"q":          {0, 400},
+		"p/a.user":   {300, 400},
+		"q/b.user":   {0, 400},
+		"p/a.user@1": {100, 200},
+		"p/a.user@2": {0, 600},
+		"q/b.user@3": {0, 400},
+	} {
+		u, ok := usage[key]
+		require.True(t, ok, "missing usage for %s", key)
+		require.Equal(t, expected[0], u.ExclusiveBytes, "exclusive bytes for %s", key)
+		require.Equal(t, expected[1], u.SharedBytes, "shared bytes for %s", key)
+	}
+	require.Len(t, usage, 7)
+}
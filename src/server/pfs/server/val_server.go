@@ -2,10 +2,13 @@ package server
 
 import (
 	"context"
+	"strings"
 
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
+	"github.com/pachyderm/pachyderm/v2/src/internal/contentcontract"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
@@ -43,9 +46,19 @@ func (a *validatedAPIServer) FinishCommitInTransaction(ctx context.Context, txnC
 	if err := checkCommit(userCommit); err != nil {
 		return errors.Wrap(err, "check new file commit")
 	}
-	if err := a.auth.CheckRepoIsAuthorizedInTransaction(txnCtx, userCommit.Repo, auth.Permission_REPO_WRITE); err != nil {
+	// Check the branch the commit is on, since scoped tokens may be
+	// restricted to some of the repo's branches.
+	commitInfo, resolveErr := a.driver.resolveCommit(ctx, txnCtx.SqlTx, userCommit)
+	if resolveErr == nil && commitInfo.Commit.Branch != nil {
+		if err := a.auth.CheckBranchIsAuthorizedInTransaction(txnCtx, commitInfo.Commit.Branch, auth.Permission_REPO_WRITE); err != nil {
+			return errors.EnsureStack(err)
+		}
+	} else if err := a.auth.CheckRepoIsAuthorizedInTransaction(txnCtx, userCommit.Repo, auth.Permission_REPO_WRITE); err != nil {
 		return errors.EnsureStack(err)
 	}
+	if resolveErr != nil {
+		return resolveErr
+	}
 	return a.apiServer.FinishCommitInTransaction(ctx, txnCtx, request)
 }
 
@@ -115,6 +128,16 @@ func (a *validatedAPIServer) ClearCommit(ctx context.Context, req *pfs.ClearComm
 	return a.apiServer.ClearCommit(ctx, req)
 }
 
+func (a *validatedAPIServer) ApproveCommit(ctx context.Context, req *pfs.ApproveCommitRequest) (*emptypb.Empty, error) {
+	if err := checkCommit(req.Commit); err != nil {
+		return nil, err
+	}
+	if err := a.auth.CheckRepoIsAuthorized(ctx, req.Commit.Repo, auth.Permission_REPO_APPROVE_COMMIT); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return a.apiServer.ApproveCommit(ctx, req)
+}
+
 func (a *validatedAPIServer) InspectCommit(ctx context.Context, req *pfs.InspectCommitRequest) (response *pfs.CommitInfo, retErr error) {
 	if err := checkCommit(req.Commit); err != nil {
 		return nil, err
@@ -236,6 +259,130 @@ func (a *validatedAPIServer) FindCommits(request *pfs.FindCommitsRequest, srv pf
 	return a.apiServer.FindCommits(request, srv)
 }
 
+func (a *validatedAPIServer) CreateReplication(ctx context.Context, request *pfs.CreateReplicationRequest) (*emptypb.Empty, error) {
+	if err := ancestry.ValidateName(request.Replication.GetName()); err != nil {
+		return nil, errors.Wrap(err, "invalid replication name")
+	}
+	if request.Target.GetPachdAddress() == "" {
+		return nil, errors.New("replication target must have a pachd address")
+	}
+	if secret := request.Target.AuthTokenSecret; secret != nil && (secret.Name == "" || secret.Key == "") {
+		return nil, errors.New("replication target auth token secret must have a name and a key")
+	}
+	if len(request.Branches) == 0 {
+		return nil, errors.New("replication must have at least one branch")
+	}
+	seen := make(map[string]bool)
+	for _, b := range request.Branches {
+		if b.GetRepo() == nil || b.Name == "" {
+			return nil, errors.New("replicated branches must have a repo and a name")
+		}
+		b.Repo.EnsureProject()
+		if seen[b.String()] {
+			return nil, errors.Errorf("branch %v is replicated more than once", b)
+		}
+		seen[b.String()] = true
+	}
+	return a.apiServer.CreateReplication(ctx, request)
+}
+
+func (a *validatedAPIServer) SetRetentionPolicy(ctx context.Context, request *pfs.SetRetentionPolicyRequest) (*emptypb.Empty, error) {
+	if request.Repo == nil {
+		return nil, errors.New("repo cannot be nil")
+	}
+	request.Repo.EnsureProject()
+	if request.Repo.Type == "" {
+		request.Repo.Type = pfs.UserRepoType
+	}
+	if p := request.Policy; p != nil && p.KeepLast == 0 && p.KeepFor == nil && p.KeepDailyAfter == nil {
+		return nil, errors.New("retention policy must set at least one of keep_last, keep_for or keep_daily_after")
+	}
+	return a.apiServer.SetRetentionPolicy(ctx, request)
+}
+
+func (a *validatedAPIServer) EnforceRetentionPolicy(request *pfs.EnforceRetentionPolicyRequest, srv pfs.API_EnforceRetentionPolicyServer) error {
+	if request.Repo != nil {
+		request.Repo.EnsureProject()
+		if request.Repo.Type == "" {
+			request.Repo.Type = pfs.UserRepoType
+		}
+	}
+	return a.apiServer.EnforceRetentionPolicy(request, srv)
+}
+
+func (a *validatedAPIServer) SetCommitValidation(ctx context.Context, request *pfs.SetCommitValidationRequest) (*emptypb.Empty, error) {
+	if request.Repo == nil {
+		return nil, errors.New("repo cannot be nil")
+	}
+	request.Repo.EnsureProject()
+	if request.Repo.Type == "" {
+		request.Repo.Type = pfs.UserRepoType
+	}
+	if request.Contract != nil {
+		if err := contentcontract.Validate(request.Contract); err != nil {
+			return nil, err
+		}
+	}
+	return a.apiServer.SetCommitValidation(ctx, request)
+}
+
+func (a *validatedAPIServer) ListCommitValidation(request *pfs.ListCommitValidationRequest, srv pfs.API_ListCommitValidationServer) error {
+	if request.Repo != nil {
+		request.Repo.EnsureProject()
+		if request.Repo.Type == "" {
+			request.Repo.Type = pfs.UserRepoType
+		}
+	}
+	return a.apiServer.ListCommitValidation(request, srv)
+}
+
+func (a *validatedAPIServer) SetStoragePlacement(ctx context.Context, request *pfs.SetStoragePlacementRequest) (*emptypb.Empty, error) {
+	if request.Repo == nil {
+		return nil, errors.New("repo cannot be nil")
+	}
+	request.Repo.EnsureProject()
+	if request.Repo.Type == "" {
+		request.Repo.Type = pfs.UserRepoType
+	}
+	if request.ColdTier != "" && request.ColdAfter.AsDuration() <= 0 {
+		return nil, errors.New("a cold tier must have a positive cold_after duration")
+	}
+	if request.ColdTier == "" && request.ColdAfter != nil {
+		return nil, errors.New("cold_after cannot be set without a cold tier")
+	}
+	return a.apiServer.SetStoragePlacement(ctx, request)
+}
+
+func (a *validatedAPIServer) ListStoragePlacement(request *pfs.ListStoragePlacementRequest, srv pfs.API_ListStoragePlacementServer) error {
+	if request.Repo != nil {
+		request.Repo.EnsureProject()
+		if request.Repo.Type == "" {
+			request.Repo.Type = pfs.UserRepoType
+		}
+	}
+	return a.apiServer.ListStoragePlacement(request, srv)
+}
+
+func (a *validatedAPIServer) CreateUploadSession(ctx context.Context, request *pfs.CreateUploadSessionRequest) (*pfs.UploadSessionInfo, error) {
+	if err := validateFile(request.File); err != nil {
+		return nil, err
+	}
+	if request.File.Path == "" || strings.HasSuffix(request.File.Path, "/") {
+		return nil, errors.Errorf("upload session must be for a file, not a directory")
+	}
+	return a.apiServer.CreateUploadSession(ctx, request)
+}
+
+func (a *validatedAPIServer) ListUploadSession(request *pfs.ListUploadSessionRequest, srv pfs.API_ListUploadSessionServer) error {
+	if request.Repo != nil {
+		request.Repo.EnsureProject()
+		if request.Repo.Type == "" {
+			request.Repo.Type = pfs.UserRepoType
+		}
+	}
+	return a.apiServer.ListUploadSession(request, srv)
+}
+
 func validateFile(file *pfs.File) error {
 	if file == nil {
 		return errors.New("file cannot be nil")
@@ -10,14 +10,22 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachconfig"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/peercache"
 	"github.com/pachyderm/pachyderm/v2/src/internal/task"
 	"golang.org/x/sync/errgroup"
+	"k8s.io/client-go/kubernetes"
 )
 
 type WorkerEnv struct {
 	DB          *sqlx.DB
 	ObjClient   obj.Client
 	TaskService task.Service
+	// GetKubeClient and Namespace are used to read the secrets referenced by
+	// URLs.  GetKubeClient may be nil if pachd is not running in Kubernetes.
+	GetKubeClient func() kubernetes.Interface
+	Namespace     string
+	// PeerCache, if set, is the process's member of the cluster chunk cache.
+	PeerCache *peercache.Cache
 }
 
 type WorkerConfig struct {
@@ -35,6 +43,7 @@ func NewWorker(env WorkerEnv, config WorkerConfig) (*Worker, error) {
 	ss, err := storage.New(storage.Env{
 		ObjectStore: env.ObjClient,
 		DB:          env.DB,
+		PeerCache:   env.PeerCache,
 	}, config.Storage)
 	if err != nil {
 		return nil, err
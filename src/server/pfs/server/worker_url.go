@@ -2,18 +2,22 @@ package server
 
 import (
 	"context"
+	"io"
+	"path"
 	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/docker/go-units"
 	"go.uber.org/zap"
-	"gocloud.dev/blob"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/middleware/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
@@ -23,6 +27,12 @@ const (
 	URLTaskNamespace = "url"
 )
 
+var (
+	// these are overridden when testing.
+	egressPartSize        = int64(64 * units.MiB)
+	egressPartConcurrency = 8
+)
+
 func (w *Worker) URLWorker(ctx context.Context) error {
 	ctx = auth.AsInternalUser(ctx, "pfs-url-worker")
 	taskSource := w.env.TaskService.NewSource(URLTaskNamespace)
@@ -57,37 +67,19 @@ func (w *Worker) processPutFileURLTask(ctx context.Context, task *PutFileURLTask
 	if err != nil {
 		return nil, errors.EnsureStack(err)
 	}
-	bucket, err := openBucket(ctx, url)
+	bucket, err := openBucket(ctx, url, kubeSecrets(w.env.GetKubeClient, w.env.Namespace))
 	if err != nil {
 		return nil, err
 	}
 	defer errors.Close(&retErr, bucket, "close bucket")
-	prefix := strings.TrimPrefix(url.Object, "/")
+	c := obj.NewFromBucket(bucket, *url)
 	result := &PutFileURLTaskResult{}
 	if err := log.LogStep(ctx, "putFileURLTask", func(ctx context.Context) error {
 		return w.storage.Filesets.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *fileset.Renewer) error {
 			id, err := withUnorderedWriter(ctx, w.storage, renewer, func(uw *fileset.UnorderedWriter) error {
-				startOffset := task.StartOffset
-				length := int64(-1) // -1 means to read until end of file.
-				for i, path := range task.Paths {
-					if i != 0 {
-						startOffset = 0
-					}
-					if i == len(task.Paths)-1 && task.EndOffset != int64(-1) {
-						length = task.EndOffset - startOffset
-					}
-					if err := func() error {
-						r, err := bucket.NewRangeReader(ctx, path, startOffset, length, nil)
-						if err != nil {
-							return errors.EnsureStack(err)
-						}
-						defer errors.Close(&retErr, r, "close reader for bucket %s", url.Bucket)
-						return uw.Put(ctx, filepath.Join(task.Dst, strings.TrimPrefix(path, prefix)), task.Datum, true, r)
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
+				return readPutFileURLShard(ctx, c, url.Object, task, func(path string, r io.Reader) error {
+					return errors.EnsureStack(uw.Put(ctx, path, task.Datum, true, r))
+				})
 			})
 			if err != nil {
 				return err
@@ -101,19 +93,43 @@ func (w *Worker) processPutFileURLTask(ctx context.Context, task *PutFileURLTask
 	return serializePutFileURLTaskResult(result)
 }
 
+// readPutFileURLShard calls cb with the path in PFS and the content of each of
+// the objects, or the range of an object, in the shard of task.  The objects'
+// keys start with prefix.
+func readPutFileURLShard(ctx context.Context, c obj.Client, prefix string, task *PutFileURLTask, cb func(path string, r io.Reader) error) error {
+	prefix = strings.TrimPrefix(prefix, "/")
+	startOffset := task.StartOffset
+	length := int64(-1) // -1 means to read until end of file.
+	for i, path := range task.Paths {
+		if i != 0 {
+			startOffset = 0
+		}
+		if i == len(task.Paths)-1 && task.EndOffset != int64(-1) {
+			length = task.EndOffset - startOffset
+		}
+		if err := miscutil.WithPipe(func(w io.Writer) error {
+			return obj.GetRange(ctx, c, path, startOffset, length, w)
+		}, func(r io.Reader) error {
+			return cb(filepath.Join(task.Dst, strings.TrimPrefix(path, prefix)), r)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (w *Worker) processGetFileURLTask(ctx context.Context, task *GetFileURLTask) (_ *anypb.Any, retErr error) {
 	url, err := obj.ParseURL(task.URL)
 	if err != nil {
 		return nil, errors.EnsureStack(err)
 	}
-	bucket, err := openBucket(ctx, url)
+	bucket, err := openBucket(ctx, url, kubeSecrets(w.env.GetKubeClient, w.env.Namespace))
 	if err != nil {
 		return nil, err
 	}
-	if url.Object != "" {
-		bucket = blob.PrefixedBucket(bucket, strings.Trim(url.Object, "/")+"/")
-	}
 	defer errors.Close(&retErr, bucket, "close bucket")
+	c := obj.NewFromBucket(bucket, *url)
+	prefix := strings.Trim(url.Object, "/")
 
 	if err := log.LogStep(ctx, "getFileURLTask", func(ctx context.Context) error {
 		fsid, err := fileset.ParseID(task.Fileset)
@@ -129,14 +145,10 @@ func (w *Worker) processGetFileURLTask(ctx context.Context, task *GetFileURLTask
 			Upper: task.PathRange.Upper,
 		}
 		return fs.Iterate(ctx, func(f fileset.File) error {
-			w, err := bucket.NewWriter(ctx, strings.TrimLeft(f.Index().Path, "/"), nil)
-			if err != nil {
-				return errors.EnsureStack(err)
-			}
-			if err := f.Content(ctx, w); err != nil {
-				return err
-			}
-			return errors.EnsureStack(w.Close())
+			name := path.Join(prefix, strings.TrimLeft(f.Index().Path, "/"))
+			return putEgressObject(ctx, c, name, index.SizeBytes(f.Index()), func(w io.Writer) error {
+				return errors.EnsureStack(f.Content(ctx, w))
+			})
 		}, index.WithRange(&pathRange))
 	}); err != nil {
 		return nil, err
@@ -144,6 +156,87 @@ func (w *Worker) processGetFileURLTask(ctx context.Context, task *GetFileURLTask
 	return serializeGetFileURLTaskResult(&GetFileURLTaskResult{})
 }
 
+// putEgressObject writes a file which is size bytes long, and whose content is
+// written by content, to the object name.  If c can write objects in parts,
+// files larger than a part are written in parts, which are uploaded in parallel
+// and retried independently.
+func putEgressObject(ctx context.Context, c obj.Client, name string, size int64, content func(w io.Writer) error) (retErr error) {
+	if _, ok := c.(obj.MultipartPutter); !ok || size <= egressPartSize {
+		return miscutil.WithPipe(content, func(r io.Reader) error {
+			return errors.EnsureStack(c.Put(ctx, name, r))
+		})
+	}
+	partSize := egressPartSize
+	if size > partSize*obj.MaxParts {
+		partSize = (size + obj.MaxParts - 1) / obj.MaxParts
+	}
+	u, err := obj.NewMultipartUpload(ctx, c, name)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			errors.JoinInto(&retErr, u.Abort(ctx))
+		}
+	}()
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(egressPartConcurrency)
+	pw := &partWriter{
+		size: int(partSize),
+		put: func(n int, data []byte) {
+			eg.Go(func() error {
+				return backoff.RetryUntilCancel(egCtx, func() error {
+					return errors.EnsureStack(u.PutPart(egCtx, n, data))
+				}, backoff.New60sBackOff(), backoff.NotifyCtx(egCtx, "put part of "+name))
+			})
+		},
+	}
+	if err := content(pw); err != nil {
+		errors.JoinInto(&err, eg.Wait())
+		return err
+	}
+	pw.flush()
+	if err := eg.Wait(); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(u.Complete(ctx))
+}
+
+// partWriter splits the data written to it into parts of size bytes, and
+// passes each of them to put, along with its part number.
+type partWriter struct {
+	size int
+	put  func(n int, data []byte)
+	n    int
+	buf  []byte
+}
+
+func (pw *partWriter) Write(data []byte) (int, error) {
+	written := len(data)
+	for len(data) > 0 {
+		if pw.buf == nil {
+			pw.buf = make([]byte, 0, pw.size)
+		}
+		n := copy(pw.buf[len(pw.buf):pw.size], data)
+		pw.buf = pw.buf[:len(pw.buf)+n]
+		data = data[n:]
+		if len(pw.buf) == pw.size {
+			pw.flush()
+		}
+	}
+	return written, nil
+}
+
+// flush puts the buffered data as the next part, if there is any.
+func (pw *partWriter) flush() {
+	if len(pw.buf) == 0 {
+		return
+	}
+	pw.n++
+	pw.put(pw.n, pw.buf)
+	pw.buf = nil
+}
+
 func serializePutFileURLTask(task *PutFileURLTask) (*anypb.Any, error) {
 	return anypb.New(task)
 }
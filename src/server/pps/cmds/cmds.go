@@ -46,6 +46,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/server/cmd/pachctl/shell"
 	"github.com/pachyderm/pachyderm/v2/src/server/pps/pretty"
 	txncmds "github.com/pachyderm/pachyderm/v2/src/server/transaction/cmds"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/local"
 	workerserver "github.com/pachyderm/pachyderm/v2/src/server/worker/server"
 	workerapi "github.com/pachyderm/pachyderm/v2/src/worker"
 )
@@ -56,6 +57,8 @@ const (
 	jobs      = "jobs"
 	pipelines = "pipelines"
 	secrets   = "secrets"
+	// notifications is the plural of notification.
+	notifications = "notifications"
 )
 
 // Cmds returns a slice containing pps commands.
@@ -918,6 +921,100 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 	runCron.Flags().StringVar(&project, "project", project, "Specify the project (by name) containing the cron pipeline.")
 	commands = append(commands, cmdutil.CreateAlias(runCron, "run cron"))
 
+	var runLocal bool
+	var localDir string
+	var localDatum string
+	var localOutput string
+	runPipeline := &cobra.Command{
+		Use:   "{{alias}} --local <pipeline-spec>",
+		Short: "Run a pipeline's transform locally.",
+		Long: "This command runs the transform of a pipeline spec on the local machine instead of in Kubernetes, which makes debugging user code faster. \n" +
+			"\n" +
+			"Datums are computed from the spec's input against the real commits in the cluster, and each datum's inputs are downloaded into a local directory laid out like /pfs (--dir, a temporary directory by default). " +
+			"The transform's cmd is then run as a subprocess in the current directory with the environment variables a worker would set, which point at the local directory; code that hardcodes /pfs paths needs to be run with --dir /pfs. " +
+			"The transform's image is not used, so the command and its dependencies must be available locally. \n" +
+			"\n" +
+			"Use --datum to run a single datum (see 'pachctl list datum -f <spec>'), and --output to upload the output to a branch in an existing repo.",
+		Example: "\t- {{alias}} --local spec.json \n" +
+			"\t- {{alias}} --local spec.json --datum 3f2a... \n" +
+			"\t- {{alias}} --local spec.json --dir ./pfs --output scratch@debug \n",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) (retErr error) {
+			if !runLocal {
+				return errors.New("only local runs are supported; use --local, or create the pipeline to run it in the cluster")
+			}
+			r, err := fileIndicatorToReadCloser(args[0])
+			if err != nil {
+				return err
+			}
+			defer r.Close()
+			spec, err := ppsutil.NewSpecReader(r).Next()
+			if err != nil {
+				return err
+			}
+			var request pps.CreatePipelineRequest
+			if err := protojson.Unmarshal([]byte(spec), &request); err != nil {
+				return errors.Wrap(err, "could not unmarshal CreatePipelineRequest")
+			}
+			if request.Pipeline == nil {
+				return errors.New("must specify pipeline")
+			}
+			if request.Pipeline.Project.GetName() == "" {
+				request.Pipeline.Project = &pfs.Project{Name: project}
+			}
+			config := &local.Config{
+				Dir:     localDir,
+				DatumID: localDatum,
+				Stdout:  os.Stdout,
+				Stderr:  os.Stderr,
+			}
+			if localOutput != "" {
+				if config.Output, err = cmdutil.ParseBranch(project, localOutput); err != nil {
+					return err
+				}
+			}
+			if config.Dir == "" {
+				if config.Dir, err = os.MkdirTemp("", "pachctl-run-pipeline-"); err != nil {
+					return errors.EnsureStack(err)
+				}
+				defer func() {
+					if err := os.RemoveAll(config.Dir); err != nil && retErr == nil {
+						retErr = errors.EnsureStack(err)
+					}
+				}()
+			} else if config.Dir, err = filepath.Abs(config.Dir); err != nil {
+				return errors.EnsureStack(err)
+			}
+			client, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			var processed, failed int
+			if err := local.Run(client.Ctx(), client, &request, config, func(result *local.Result) error {
+				processed++
+				if result.Err != nil {
+					failed++
+					fmt.Fprintf(os.Stderr, "datum %s failed: %v\n", result.DatumID, result.Err)
+					return nil
+				}
+				fmt.Fprintf(os.Stderr, "datum %s succeeded\n", result.DatumID)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if failed > 0 {
+				return errors.Errorf("%d of %d datums failed", failed, processed)
+			}
+			return nil
+		}),
+	}
+	runPipeline.Flags().BoolVar(&runLocal, "local", false, "Run the transform on the local machine (required).")
+	runPipeline.Flags().StringVar(&localDir, "dir", "", "Specify the local directory that stands in for /pfs; defaults to a temporary directory that is removed afterwards.")
+	runPipeline.Flags().StringVar(&localDatum, "datum", "", "Only run the datum with this ID.")
+	runPipeline.Flags().StringVar(&localOutput, "output", "", "Upload the output to this branch (<repo>@<branch>), in a single commit.")
+	runPipeline.Flags().StringVar(&project, "project", project, "Specify the project (by name) of the pipeline and its inputs, if the spec doesn't set them.")
+	commands = append(commands, cmdutil.CreateAlias(runPipeline, "run pipeline"))
+
 	inspectPipeline := &cobra.Command{
 		Use:   "{{alias}} <pipeline>",
 		Short: "Return info about a pipeline.",
@@ -1461,6 +1558,197 @@ func Cmds(mainCtx context.Context, pachCtx *config.Context, pachctlCfg *pachctl.
 	}
 	commands = append(commands, cmdutil.CreateAliases(listSecret, "list secret", secrets))
 
+	notificationDocs := &cobra.Command{
+		Short: "Docs for notifications.",
+		Long: "Notifications deliver events to an HTTP endpoint: commits finishing on a branch, jobs finishing and pipelines changing state. \n \n" +
+			"Each event is POSTed to the endpoint as a JSON payload, signed with the notification's secret in the `" + pps.NotificationSignatureHeader + "` header. " +
+			"Deliveries are retried with backoff until the endpoint responds with a 2xx status, and are recorded in the notification's delivery log.",
+	}
+	commands = append(commands, cmdutil.CreateDocsAliases(notificationDocs, "notification", " notification$", notifications))
+
+	var notificationURL, notificationSecret string
+	var commitFinished, jobFinished, pipelineStateChanged, jobStates, pipelineStates []string
+	var updateNotification bool
+	createNotification := &cobra.Command{
+		Use:   "{{alias}} <name>",
+		Short: "Create a notification.",
+		Long: "This command creates a notification, which delivers events to an HTTP endpoint. \n \n" +
+			"\t- To be notified when commits finish on a branch, use `--commit-finished <repo>@<branch>`; omit the branch to match every branch of the repo \n" +
+			"\t- To be notified when a pipeline's jobs finish, use `--job-finished <pipeline>`, and `--job-state` to choose the states (default success and failure) \n" +
+			"\t- To be notified when a pipeline changes state, use `--pipeline-state-changed <pipeline>`, and `--pipeline-state` to choose the states (default crashing and failure) \n" +
+			"\t- Use `*` as the pipeline to match every pipeline of the project \n \n" +
+			"If `--secret` is not set, a random secret is generated and printed.",
+		Example: "\t- {{alias}} alerts --url https://example.com/hook --pipeline-state-changed '*' \n" +
+			"\t- {{alias}} scheduler --url https://example.com/hook --commit-finished images@master --job-finished edges --job-state success \n" +
+			"\t- {{alias}} alerts --url https://example.com/hook --job-finished '*' --job-state failure --project foo --update \n",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) (retErr error) {
+			var events []*pps.NotificationEvent
+			for _, arg := range commitFinished {
+				branch := &pfs.Branch{Repo: cmdutil.ParseRepo(project, arg)}
+				if strings.Contains(arg, "@") {
+					var err error
+					if branch, err = cmdutil.ParseBranch(project, arg); err != nil {
+						return err
+					}
+				}
+				events = append(events, &pps.NotificationEvent{Event: &pps.NotificationEvent_CommitFinished_{
+					CommitFinished: &pps.NotificationEvent_CommitFinished{Branch: branch},
+				}})
+			}
+			var js []pps.JobState
+			for _, s := range jobStates {
+				state, err := pps.JobStateFromName(s)
+				if err != nil {
+					return err
+				}
+				js = append(js, state)
+			}
+			for _, arg := range jobFinished {
+				events = append(events, &pps.NotificationEvent{Event: &pps.NotificationEvent_JobFinished_{
+					JobFinished: &pps.NotificationEvent_JobFinished{Pipeline: notificationPipeline(project, arg), States: js},
+				}})
+			}
+			var ps []pps.PipelineState
+			for _, s := range pipelineStates {
+				state, err := pps.PipelineStateFromName(s)
+				if err != nil {
+					return err
+				}
+				ps = append(ps, state)
+			}
+			for _, arg := range pipelineStateChanged {
+				events = append(events, &pps.NotificationEvent{Event: &pps.NotificationEvent_PipelineStateChanged_{
+					PipelineStateChanged: &pps.NotificationEvent_PipelineStateChanged{Pipeline: notificationPipeline(project, arg), States: ps},
+				}})
+			}
+			client, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			resp, err := client.PpsAPIClient.CreateNotification(
+				client.Ctx(),
+				&pps.CreateNotificationRequest{
+					Notification: &pps.Notification{Name: args[0]},
+					Url:          notificationURL,
+					Events:       events,
+					Secret:       notificationSecret,
+					Update:       updateNotification,
+				})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if notificationSecret == "" {
+				fmt.Printf("Payloads are signed with secret %s\n", resp.Secret)
+			}
+			return nil
+		}),
+	}
+	createNotification.Flags().StringVar(&notificationURL, "url", "", "Specify the HTTP or HTTPS endpoint which events are POSTed to.")
+	createNotification.Flags().StringVar(&notificationSecret, "secret", "", "Specify the secret which payloads are signed with; a random one is generated if unset.")
+	createNotification.Flags().StringArrayVar(&commitFinished, "commit-finished", nil, "Notify when commits finish on a branch, in the form <repo>@<branch>; can be repeated.")
+	createNotification.Flags().StringArrayVar(&jobFinished, "job-finished", nil, "Notify when a pipeline's jobs finish, or every pipeline's if '*'; can be repeated.")
+	createNotification.Flags().StringArrayVar(&jobStates, "job-state", nil, "Specify the job states to notify (success, failure, killed, unrunnable); can be repeated.")
+	createNotification.Flags().StringArrayVar(&pipelineStateChanged, "pipeline-state-changed", nil, "Notify when a pipeline changes state, or any pipeline if '*'; can be repeated.")
+	createNotification.Flags().StringArrayVar(&pipelineStates, "pipeline-state", nil, "Specify the pipeline states to notify (e.g. crashing, failure, standby); can be repeated.")
+	createNotification.Flags().BoolVar(&updateNotification, "update", false, "Replace an existing notification of the same name.")
+	createNotification.Flags().StringVar(&project, "project", project, "Specify the project containing the repos and pipelines.")
+	commands = append(commands, cmdutil.CreateAliases(createNotification, "create notification", notifications))
+
+	listNotification := &cobra.Command{
+		Short:   "List notifications.",
+		Long:    "This command lists the notifications whose events you can read.",
+		Example: "\t- {{alias}} \n",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
+			if !raw && output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			client, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			lnClient, err := client.PpsAPIClient.ListNotification(client.Ctx(), &pps.ListNotificationRequest{})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			infos, err := grpcutil.Collect[*pps.NotificationInfo](lnClient, 1000)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				e := cmdutil.Encoder(output, os.Stdout)
+				for _, info := range infos {
+					if err := e.EncodeProto(info); err != nil {
+						return errors.EnsureStack(err)
+					}
+				}
+				return nil
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.NotificationHeader)
+			for _, info := range infos {
+				pretty.PrintNotificationInfo(writer, info)
+			}
+			return writer.Flush()
+		}),
+	}
+	listNotification.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAliases(listNotification, "list notification", notifications))
+
+	inspectNotification := &cobra.Command{
+		Use:     "{{alias}} <name>",
+		Short:   "Return the delivery log of a notification.",
+		Long:    "This command returns the delivery log of a notification, most recent first.",
+		Example: "\t- {{alias}} alerts \n",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) (retErr error) {
+			client, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			lndClient, err := client.PpsAPIClient.ListNotificationDelivery(
+				client.Ctx(),
+				&pps.ListNotificationDeliveryRequest{
+					Notification: &pps.Notification{Name: args[0]},
+				})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.NotificationDeliveryHeader)
+			if err := grpcutil.ForEach[*pps.NotificationDeliveryInfo](lndClient, func(info *pps.NotificationDeliveryInfo) error {
+				pretty.PrintNotificationDeliveryInfo(writer, info)
+				return nil
+			}); err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			return writer.Flush()
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAliases(inspectNotification, "inspect notification", notifications))
+
+	deleteNotification := &cobra.Command{
+		Use:     "{{alias}} <name>",
+		Short:   "Delete a notification.",
+		Long:    "This command deletes a notification, along with its delivery log and pending deliveries.",
+		Example: "\t- {{alias}} alerts \n",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) (retErr error) {
+			client, err := pachctlCfg.NewOnUserMachine(mainCtx, false)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			if _, err := client.PpsAPIClient.DeleteNotification(
+				client.Ctx(),
+				&pps.DeleteNotificationRequest{
+					Notification: &pps.Notification{Name: args[0]},
+				}); err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			return nil
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAliases(deleteNotification, "delete notification", notifications))
+
 	var dagSpecFile string
 	var seed int64
 	var parallelism int64
@@ -2132,3 +2420,12 @@ func listJobFilterF(ctx context.Context, client pps.API_ListJobClient, f func(*p
 		}
 	}
 }
+
+// notificationPipeline parses the pipeline of a notification event, which is
+// every pipeline of project if it's "*".
+func notificationPipeline(project, arg string) *pps.Pipeline {
+	if arg == "*" {
+		return &pps.Pipeline{Project: &pfs.Project{Name: project}}
+	}
+	return &pps.Pipeline{Project: &pfs.Project{Name: project}, Name: arg}
+}
@@ -44,6 +44,30 @@ func (e ErrPipelineAlreadyExists) GRPCStatus() *status.Status {
 	return status.New(codes.AlreadyExists, e.Error())
 }
 
+type ErrNotificationNotFound struct {
+	Notification *pps.Notification
+}
+
+func (e ErrNotificationNotFound) Error() string {
+	return fmt.Sprintf("notification %q not found", e.Notification.GetName())
+}
+
+func (e ErrNotificationNotFound) GRPCStatus() *status.Status {
+	return status.New(codes.NotFound, e.Error())
+}
+
+type ErrNotificationAlreadyExists struct {
+	Notification *pps.Notification
+}
+
+func (e ErrNotificationAlreadyExists) Error() string {
+	return fmt.Sprintf("notification %q already exists", e.Notification.GetName())
+}
+
+func (e ErrNotificationAlreadyExists) GRPCStatus() *status.Status {
+	return status.New(codes.AlreadyExists, e.Error())
+}
+
 var (
 	jobFinishedRe      = regexp.MustCompile("job [^ ]+ has already finished")
 	pipelineNotFoundRe = regexp.MustCompile("pipeline [^ ]+ not found")
@@ -37,6 +37,10 @@ const (
 	jobReasonLen = 25
 	// KubeEventsHeader is the header for kubernetes events
 	KubeEventsHeader = "LAST SEEN\tTYPE\tREASON\tOBJECT\tMESSAGE\t\n"
+	// NotificationHeader is the header for notifications
+	NotificationHeader = "NAME\tURL\tEVENTS\tCREATED\t\n"
+	// NotificationDeliveryHeader is the header for notification deliveries
+	NotificationDeliveryHeader = "ID\tEVENT\tSTATE\tATTEMPTS\tSTATUS\tCREATED\tERROR\t\n"
 )
 
 func safeTrim(s string, l int) string {
@@ -459,6 +463,67 @@ func PrintSecretInfo(w io.Writer, secretInfo *ppsclient.SecretInfo) {
 	fmt.Fprintf(w, "%s\t%s\t%s\t\n", secretInfo.Secret.Name, secretInfo.Type, pretty.Ago(secretInfo.CreationTimestamp))
 }
 
+// PrintNotificationInfo pretty-prints notification info.
+func PrintNotificationInfo(w io.Writer, info *ppsclient.NotificationInfo) {
+	var events []string
+	for _, e := range info.Events {
+		events = append(events, NotificationEvent(e))
+	}
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t\n", info.Notification.Name, info.Url, strings.Join(events, "; "), pretty.Ago(info.CreatedAt))
+}
+
+// NotificationEvent returns a notification event as a pretty printed string.
+func NotificationEvent(e *ppsclient.NotificationEvent) string {
+	notificationPipeline := func(p *ppsclient.Pipeline) string {
+		if p.Name == "" {
+			return p.Project.GetName() + "/*"
+		}
+		return p.String()
+	}
+	switch e := e.Event.(type) {
+	case *ppsclient.NotificationEvent_CommitFinished_:
+		branch := e.CommitFinished.Branch
+		if branch.Name == "" {
+			return fmt.Sprintf("commit finished on %s", branch.Repo)
+		}
+		return fmt.Sprintf("commit finished on %s", branch)
+	case *ppsclient.NotificationEvent_JobFinished_:
+		var states []string
+		for _, s := range e.JobFinished.States {
+			states = append(states, JobState(s))
+		}
+		return fmt.Sprintf("job of %s %s", notificationPipeline(e.JobFinished.Pipeline), strings.Join(states, "/"))
+	case *ppsclient.NotificationEvent_PipelineStateChanged_:
+		var states []string
+		for _, s := range e.PipelineStateChanged.States {
+			states = append(states, pipelineState(s))
+		}
+		return fmt.Sprintf("pipeline %s %s", notificationPipeline(e.PipelineStateChanged.Pipeline), strings.Join(states, "/"))
+	}
+	return "-"
+}
+
+// PrintNotificationDeliveryInfo pretty-prints notification delivery info.
+func PrintNotificationDeliveryInfo(w io.Writer, info *ppsclient.NotificationDeliveryInfo) {
+	statusCode := "-"
+	if info.StatusCode != 0 {
+		statusCode = fmt.Sprint(info.StatusCode)
+	}
+	fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\t%s\t\n", info.Id, info.EventId, notificationDeliveryState(info.State), info.Attempts, statusCode, pretty.Ago(info.CreatedAt), info.Error)
+}
+
+func notificationDeliveryState(state ppsclient.NotificationDeliveryState) string {
+	switch state {
+	case ppsclient.NotificationDeliveryState_NOTIFICATION_DELIVERY_PENDING:
+		return color.New(color.FgYellow).SprintFunc()("pending")
+	case ppsclient.NotificationDeliveryState_NOTIFICATION_DELIVERY_DELIVERED:
+		return color.New(color.FgGreen).SprintFunc()("delivered")
+	case ppsclient.NotificationDeliveryState_NOTIFICATION_DELIVERY_FAILED:
+		return color.New(color.FgRed).SprintFunc()("failed")
+	}
+	return "-"
+}
+
 // PrintFileHeader prints the header for a pfs file.
 func PrintFileHeader(w io.Writer) {
 	fmt.Fprintf(w, "  REPO\tCOMMIT\tPATH\t\n")
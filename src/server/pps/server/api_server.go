@@ -14,7 +14,7 @@ import (
 	"unicode"
 
 	"github.com/itchyny/gojq"
-	opentracing "github.com/opentracing/opentracing-go"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
@@ -47,6 +47,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/contentcontract"
 	"github.com/pachyderm/pachyderm/v2/src/internal/cronutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
@@ -67,6 +68,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing/extended"
 	txnenv "github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transforms"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	"github.com/pachyderm/pachyderm/v2/src/internal/watch"
 )
@@ -120,10 +122,14 @@ type apiServer struct {
 	port                  uint16
 	peerPort              uint16
 	gcPercent             int
+	// notificationAddresses are the addresses notifications may be
+	// delivered to.
+	notificationAddresses *notificationAddresses
 	// collections
 	pipelines       col.PostgresCollection
 	jobs            col.PostgresCollection
 	clusterDefaults col.PostgresCollection
+	notifications   col.PostgresCollection
 }
 
 func merge(from, to map[string]bool) {
@@ -234,6 +240,16 @@ func (a *apiServer) validateInput(pipeline *pps.Pipeline, input *pps.Input) erro
 					"Pachyderm's S3 gateway rather than the file system")
 			case input.Pfs.Commit != "":
 				return errors.Errorf("input cannot come from a commit; use a branch with head pointing to the commit")
+			case input.Pfs.PreviousOutput != nil:
+				return errors.Errorf("input cannot specify 'previous_output', it is set by pachyderm")
+			case input.Pfs.Incremental && input.Pfs.Window != nil:
+				return errors.Errorf("input cannot specify both 'incremental' and 'window'")
+			case (input.Pfs.Incremental || input.Pfs.Window != nil) && (input.Pfs.S3 || input.Pfs.JoinOn != "" || input.Pfs.GroupBy != ""):
+				return errors.Errorf("incremental and windowed inputs cannot specify 's3', 'join_on' or 'group_by'")
+			case input.Pfs.Window != nil && (input.Pfs.Window.Commits > 0) == (input.Pfs.Window.Duration != nil):
+				return errors.Errorf("input window must specify exactly one of 'commits' and 'duration'")
+			case input.Pfs.Window != nil && (input.Pfs.Window.Commits < 0 || input.Pfs.Window.Duration.AsDuration() < 0):
+				return errors.Errorf("input window cannot be negative")
 			}
 		}
 		if input.Cross != nil {
@@ -299,6 +315,51 @@ func validateTransform(transform *pps.Transform) error {
 	if transform == nil {
 		return errors.Errorf("pipeline must specify a transform")
 	}
+	if transform.Builtin != nil {
+		// As with starlark, the image is ignored.
+		if transform.Starlark != nil {
+			return errors.Errorf("pipeline transform cannot specify both builtin and starlark")
+		}
+		if len(transform.Cmd) > 0 || len(transform.Stdin) > 0 {
+			return errors.Errorf("pipeline transform cannot specify both builtin and cmd")
+		}
+		if transform.Builtin.Name == "" {
+			return errors.Errorf("pipeline transform must name a builtin transform")
+		}
+		if transform.DatumBatching {
+			return errors.Errorf("builtin transforms do not support datum batching")
+		}
+		if len(transform.ErrCmd) > 0 {
+			return errors.Errorf("builtin transforms do not support err_cmd")
+		}
+		if _, err := transforms.ParseBuiltinSpec(transform.Builtin); err != nil {
+			return err
+		}
+		return nil
+	}
+	if transform.Starlark != nil {
+		// The image isn't checked, since cluster defaults may set one; it is
+		// ignored.
+		if len(transform.Cmd) > 0 || len(transform.Stdin) > 0 {
+			return errors.Errorf("pipeline transform cannot specify both starlark and cmd")
+		}
+		if transform.Starlark.Script == "" {
+			return errors.Errorf("pipeline transform must contain a starlark script")
+		}
+		if transform.DatumBatching {
+			return errors.Errorf("starlark transforms do not support datum batching")
+		}
+		if len(transform.ErrCmd) > 0 {
+			return errors.Errorf("starlark transforms do not support err_cmd")
+		}
+		if transform.Starlark.MemoryLimitBytes < 0 {
+			return errors.Errorf("starlark memory_limit_bytes cannot be negative")
+		}
+		if transform.Starlark.Timeout != nil && transform.Starlark.Timeout.AsDuration() < 0 {
+			return errors.Errorf("starlark timeout cannot be negative")
+		}
+		return nil
+	}
 	if transform.Image == "" {
 		return errors.Errorf("pipeline transform must contain an image")
 	}
@@ -1953,6 +2014,20 @@ func (a *apiServer) validatePipeline(pipelineInfo *pps.PipelineInfo) error {
 			return errors.Errorf("spout pipelines (without a service) must not have an input")
 		}
 	}
+	if pipelineInfo.Details.Transform.Starlark != nil && (pipelineInfo.Details.Service != nil || pipelineInfo.Details.Spout != nil) {
+		return errors.Errorf("starlark transforms are not supported by services or spouts")
+	}
+	if pipelineInfo.Details.Transform.Builtin != nil && (pipelineInfo.Details.Service != nil || pipelineInfo.Details.Spout != nil) {
+		return errors.Errorf("builtin transforms are not supported by services or spouts")
+	}
+	if pipelineInfo.Details.OutputContract != nil {
+		if pipelineInfo.Details.Service != nil || pipelineInfo.Details.Spout != nil {
+			return errors.Errorf("output contracts are not supported by services or spouts")
+		}
+		if err := contentcontract.Validate(pipelineInfo.Details.OutputContract); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -2237,7 +2312,7 @@ func (a *apiServer) createPipeline(ctx context.Context, req *pps.CreatePipelineV
 	}
 
 	// Annotate current span with pipeline & persist any extended trace to etcd
-	span := opentracing.SpanFromContext(ctx)
+	span := trace.SpanFromContext(ctx)
 	tracing.TagAnySpan(span, "project", effectiveSpec.Pipeline.Project.GetName(), "pipeline", effectiveSpec.Pipeline.Name)
 	defer func() {
 		tracing.TagAnySpan(span, "err", err)
@@ -2355,6 +2430,7 @@ func (a *apiServer) initializePipelineInfo(txn *pps.CreatePipelineTransaction, o
 			Autoscaling:             request.Autoscaling,
 			Tolerations:             request.Tolerations,
 			Determined:              request.Determined,
+			OutputContract:          request.OutputContract,
 		},
 		UserSpecJson:      txn.UserJson,
 		EffectiveSpecJson: txn.EffectiveJson,
@@ -3351,6 +3427,7 @@ func (a *apiServer) propagateJobs(ctx context.Context, txnCtx *txncontext.Transa
 			Stats:           &pps.ProcessStats{},
 			AuthToken:       token,
 			Created:         timestamppb.Now(),
+			TraceContext:    tracing.Inject(ctx),
 		}
 		if err := ppsutil.UpdateJobState(pipelines, jobs, jobPtr, pps.JobState_JOB_CREATED, ""); err != nil {
 			return err
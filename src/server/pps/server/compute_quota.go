@@ -0,0 +1,155 @@
+package server
+
+import (
+	"context"
+	"math"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+// computeUsage is the compute resources requested by the workers of a set of
+// pipelines.
+type computeUsage struct {
+	workers int64
+	cpu     resource.Quantity
+	memory  resource.Quantity
+}
+
+func (u *computeUsage) pb() *pps.GetProjectComputeUsageResponse {
+	return &pps.GetProjectComputeUsageResponse{
+		Workers: u.workers,
+		Cpu:     u.cpu.String(),
+		Memory:  u.memory.String(),
+	}
+}
+
+// projectComputeUsage returns the compute resources requested by the workers of
+// the RCs in rcs that belong to project, excluding the RC named exclude.
+func projectComputeUsage(rcs []v1.ReplicationController, project, exclude string) *computeUsage {
+	usage := &computeUsage{}
+	for i := range rcs {
+		rc := &rcs[i]
+		if rc.Labels[pipelineProjectLabel] != project || rc.Name == exclude {
+			continue
+		}
+		if rc.Spec.Replicas == nil || *rc.Spec.Replicas <= 0 {
+			continue
+		}
+		replicas := int64(*rc.Spec.Replicas)
+		cpu, memory := workerRequests(rc)
+		usage.workers += replicas
+		usage.cpu.Add(*resource.NewMilliQuantity(cpu.MilliValue()*replicas, resource.DecimalSI))
+		usage.memory.Add(*resource.NewQuantity(memory.Value()*replicas, resource.BinarySI))
+	}
+	return usage
+}
+
+// workerRequests returns the cpu and memory requested by a single worker of rc,
+// summed across its containers.  As in Kubernetes, a container's limit is used
+// as its request if it doesn't set one.
+func workerRequests(rc *v1.ReplicationController) (cpu, memory resource.Quantity) {
+	if rc.Spec.Template == nil {
+		return cpu, memory
+	}
+	for _, c := range rc.Spec.Template.Spec.Containers {
+		for name, total := range map[v1.ResourceName]*resource.Quantity{v1.ResourceCPU: &cpu, v1.ResourceMemory: &memory} {
+			if q, ok := c.Resources.Requests[name]; ok {
+				total.Add(q)
+			} else if q, ok := c.Resources.Limits[name]; ok {
+				total.Add(q)
+			}
+		}
+	}
+	return cpu, memory
+}
+
+// capScale returns the largest scale, no greater than target, at which the
+// workers of rc fit within quota alongside the project's other workers.
+func capScale(quota *pfs.ProjectQuota, usage *computeUsage, rc *v1.ReplicationController, target int32) (int32, error) {
+	allowed := int64(target)
+	if quota.GetMaxWorkers() > 0 {
+		allowed = min(allowed, quota.GetMaxWorkers()-usage.workers)
+	}
+	cpu, memory := workerRequests(rc)
+	if quota.GetMaxCpu() != "" && !cpu.IsZero() {
+		maxCPU, err := resource.ParseQuantity(quota.GetMaxCpu())
+		if err != nil {
+			return 0, errors.Wrapf(err, "invalid cpu quota %q", quota.GetMaxCpu())
+		}
+		allowed = min(allowed, (maxCPU.MilliValue()-usage.cpu.MilliValue())/cpu.MilliValue())
+	}
+	if quota.GetMaxMemory() != "" && !memory.IsZero() {
+		maxMemory, err := resource.ParseQuantity(quota.GetMaxMemory())
+		if err != nil {
+			return 0, errors.Wrapf(err, "invalid memory quota %q", quota.GetMaxMemory())
+		}
+		allowed = min(allowed, (maxMemory.Value()-usage.memory.Value())/memory.Value())
+	}
+	switch {
+	case allowed < 0:
+		return 0, nil
+	case allowed > math.MaxInt32:
+		return math.MaxInt32, nil
+	}
+	return int32(allowed), nil
+}
+
+// hasComputeLimits returns whether quota limits the compute resources of a
+// project's pipelines.
+func hasComputeLimits(quota *pfs.ProjectQuota) bool {
+	return quota.GetMaxWorkers() > 0 || quota.GetMaxCpu() != "" || quota.GetMaxMemory() != ""
+}
+
+// computeQuota returns the compute quota of pi's project, along with the
+// resources requested by the workers of the project's other pipelines, or nil
+// usage if the quota doesn't limit them.  If the quota limits them, the
+// returned function must be called once rc has been scaled, as scale ups in the
+// project are serialized so that two pipelines can't each fit in the quota but
+// exceed it together.  Scale ups in different projects don't wait for each
+// other.
+//
+// Only the scale of each pipeline's RC is capped.  Each pipeline's workers
+// only process the tasks of that pipeline, so the task service's group
+// scheduler has no tasks from other projects to share them with.
+func (pc *pipelineController) computeQuota(ctx context.Context, pi *pps.PipelineInfo, rc *v1.ReplicationController) (*pfs.ProjectQuota, *computeUsage, func(), error) {
+	pachClient := pc.env.GetPachClient(ctx)
+	pachClient.SetAuthToken(pi.AuthToken)
+	projectInfo, err := pachClient.PfsAPIClient.InspectProject(pachClient.Ctx(), &pfs.InspectProjectRequest{Project: pi.Pipeline.Project})
+	if err != nil {
+		return nil, nil, func() {}, errors.Wrap(err, "inspect project")
+	}
+	quota := projectInfo.Quota
+	if !hasComputeLimits(quota) {
+		return quota, nil, func() {}, nil
+	}
+	mu := pc.pcMgr.quotaLock(pi.Pipeline.Project.GetName())
+	mu.Lock()
+	rcs, err := pc.iDriver.ListProjectReplicationControllers(ctx, pi.Pipeline.Project.GetName())
+	if err != nil {
+		mu.Unlock()
+		return quota, nil, func() {}, err
+	}
+	return quota, projectComputeUsage(rcs.Items, pi.Pipeline.Project.GetName(), rc.Name), mu.Unlock, nil
+}
+
+func (a *apiServer) GetProjectComputeUsage(ctx context.Context, req *pps.GetProjectComputeUsageRequest) (*pps.GetProjectComputeUsageResponse, error) {
+	if _, err := a.env.PFSServer.InspectProject(ctx, &pfs.InspectProjectRequest{Project: req.Project}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	rcs, err := a.env.KubeClient.CoreV1().ReplicationControllers(a.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: metav1.FormatLabelSelector(metav1.SetAsLabelSelector(map[string]string{
+			"suite":              suite,
+			pipelineProjectLabel: req.Project.GetName(),
+		})),
+	})
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return projectComputeUsage(rcs.Items, req.Project.GetName(), "").pb(), nil
+}
@@ -0,0 +1,94 @@
+package server
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+func testRC(name, project string, replicas int32, cpu, memory string) v1.ReplicationController {
+	return v1.ReplicationController{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{pipelineProjectLabel: project},
+		},
+		Spec: v1.ReplicationControllerSpec{
+			Replicas: &replicas,
+			Template: &v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{
+						Resources: v1.ResourceRequirements{
+							Requests: v1.ResourceList{
+								v1.ResourceCPU:    resource.MustParse(cpu),
+								v1.ResourceMemory: resource.MustParse(memory),
+							},
+						},
+					}},
+				},
+			},
+		},
+	}
+}
+
+func TestProjectComputeUsage(t *testing.T) {
+	rcs := []v1.ReplicationController{
+		testRC("a", "p", 2, "500m", "1Gi"),
+		testRC("b", "p", 3, "1", "2Gi"),
+		testRC("c", "q", 4, "1", "2Gi"),
+	}
+	usage := projectComputeUsage(rcs, "p", "")
+	require.Equal(t, int64(5), usage.workers)
+	require.Equal(t, int64(4000), usage.cpu.MilliValue())
+	require.Equal(t, int64(8<<30), usage.memory.Value())
+
+	usage = projectComputeUsage(rcs, "p", "b")
+	require.Equal(t, int64(2), usage.workers)
+	require.Equal(t, int64(1000), usage.cpu.MilliValue())
+	require.Equal(t, int64(2<<30), usage.memory.Value())
+}
+
+func TestCapScale(t *testing.T) {
+	rcs := []v1.ReplicationController{
+		testRC("a", "p", 2, "1", "1Gi"),
+		testRC("b", "p", 1, "1", "1Gi"),
+	}
+	rc := &rcs[1]
+	usage := projectComputeUsage(rcs, "p", rc.Name)
+	for _, tc := range []struct {
+		quota    *pfs.ProjectQuota
+		target   int32
+		expected int32
+	}{
+		{quota: nil, target: 10, expected: 10},
+		{quota: &pfs.ProjectQuota{MaxWorkers: 5}, target: 10, expected: 3},
+		{quota: &pfs.ProjectQuota{MaxWorkers: 5}, target: 2, expected: 2},
+		{quota: &pfs.ProjectQuota{MaxWorkers: 1}, target: 2, expected: 0},
+		{quota: &pfs.ProjectQuota{MaxCpu: "6"}, target: 10, expected: 4},
+		{quota: &pfs.ProjectQuota{MaxMemory: "5Gi"}, target: 10, expected: 3},
+		{quota: &pfs.ProjectQuota{MaxWorkers: 4, MaxCpu: "6", MaxMemory: "5Gi"}, target: 10, expected: 2},
+	} {
+		capped, err := capScale(tc.quota, usage, rc, tc.target)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, capped, "quota %v", tc.quota)
+	}
+	_, err := capScale(&pfs.ProjectQuota{MaxCpu: "lots"}, usage, rc, 1)
+	require.YesError(t, err)
+}
+
+func TestQuotaLock(t *testing.T) {
+	m := newPcManager()
+	a := m.quotaLock("a")
+	require.True(t, a == m.quotaLock("a"))
+	b := m.quotaLock("b")
+	require.False(t, a == b)
+	// Holding one project's lock doesn't block scale ups in another project.
+	a.Lock()
+	defer a.Unlock()
+	require.True(t, b.TryLock())
+	b.Unlock()
+}
@@ -28,6 +28,8 @@ type InfraDriver interface {
 	// of the 'old' rc passed to update() as mutable.
 	UpdateReplicationController(ctx context.Context, old *v1.ReplicationController, update func(rc *v1.ReplicationController) bool) error
 	ListReplicationControllers(ctx context.Context) (*v1.ReplicationControllerList, error)
+	// ListProjectReplicationControllers lists the RCs of the pipelines in project.
+	ListProjectReplicationControllers(ctx context.Context, project string) (*v1.ReplicationControllerList, error)
 	WatchPipelinePods(ctx context.Context) (<-chan watch.Event, func(), error)
 }
 
@@ -108,6 +110,18 @@ func (d *mockInfraDriver) ListReplicationControllers(ctx context.Context) (*v1.R
 	}, nil
 }
 
+func (d *mockInfraDriver) ListProjectReplicationControllers(ctx context.Context, project string) (*v1.ReplicationControllerList, error) {
+	items := make([]v1.ReplicationController, 0)
+	for _, rc := range d.rcs {
+		if rc.ObjectMeta.Labels[pipelineProjectLabel] == project {
+			items = append(items, rc)
+		}
+	}
+	return &v1.ReplicationControllerList{
+		Items: items,
+	}, nil
+}
+
 // TODO(acohen4): complete
 func (d *mockInfraDriver) WatchPipelinePods(ctx context.Context) (<-chan watch.Event, func(), error) {
 	ch := make(chan watch.Event)
@@ -18,6 +18,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachconfig"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
 )
 
 type kubeDriver struct {
@@ -28,11 +29,14 @@ type kubeDriver struct {
 	limiter    limit.ConcurrencyLimiter
 	config     pachconfig.Configuration
 	etcdPrefix string
+	// pfsServer is used to read the compute quotas of pipelines' projects.
+	pfsServer pfsserver.APIServer
 }
 
-func newKubeDriver(kubeClient kubernetes.Interface, config pachconfig.Configuration) InfraDriver {
+func newKubeDriver(kubeClient kubernetes.Interface, config pachconfig.Configuration, pfsServer pfsserver.APIServer) InfraDriver {
 	return &kubeDriver{
 		kubeClient: kubeClient,
+		pfsServer:  pfsServer,
 		namespace:  config.Namespace,
 		limiter:    limit.New(config.PPSMaxConcurrentK8sRequests),
 		config:     config,
@@ -174,6 +178,19 @@ func (kd *kubeDriver) ListReplicationControllers(ctx context.Context) (*v1.Repli
 	return rc, errors.Wrap(err, "failed to list rcs")
 }
 
+// Used to compute the compute resources requested by a project's pipelines.
+func (kd *kubeDriver) ListProjectReplicationControllers(ctx context.Context, project string) (*v1.ReplicationControllerList, error) {
+	rc, err := kd.kubeClient.CoreV1().ReplicationControllers(kd.namespace).List(
+		ctx,
+		metav1.ListOptions{
+			LabelSelector: metav1.FormatLabelSelector(metav1.SetAsLabelSelector(map[string]string{
+				"suite":              suite,
+				pipelineProjectLabel: project,
+			})),
+		})
+	return rc, errors.Wrap(err, "failed to list project rcs")
+}
+
 // Used to discover crashing pods which signals the controller to transition
 // a pipeline to CRASHING
 func (kd *kubeDriver) WatchPipelinePods(ctx context.Context) (<-chan watch.Event, func(), error) {
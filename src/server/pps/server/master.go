@@ -150,9 +150,11 @@ func (a *apiServer) master(ctx context.Context) {
 		}
 		defer masterLock.Unlock(ctx) //nolint:errcheck
 		log.Info(ctx, "PPS master: launching master process")
-		kd := newKubeDriver(a.env.KubeClient, a.env.Config)
+		kd := newKubeDriver(a.env.KubeClient, a.env.Config, a.env.PFSServer)
 		sd := newPipelineStateDriver(a.env.DB, a.pipelines, a.txnEnv, a.env.PFSServer)
 		m := newMaster(ctx, a.env, a.etcdPrefix, kd, sd)
+		cancelNotifier := startMonitorThread(pctx.Child(ctx, "notifier"), newNotifier(a.env, a.notificationAddresses).run)
+		defer cancelNotifier()
 		m.run()
 		return errors.Wrapf(context.Cause(ctx), "ppsMaster.Run() exited unexpectedly")
 	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
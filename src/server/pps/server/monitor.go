@@ -8,7 +8,6 @@ import (
 	"path"
 	"time"
 
-	opentracing "github.com/opentracing/opentracing-go"
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/cronutil"
@@ -21,6 +20,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 	workerserver "github.com/pachyderm/pachyderm/v2/src/server/worker/server"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
@@ -121,7 +121,7 @@ func (pc *pipelineController) monitorPipeline(ctx context.Context, pipelineInfo
 			return backoff.RetryNotify(func() error {
 				var (
 					oldCtx    = ctx
-					childSpan opentracing.Span
+					childSpan trace.Span
 					ctx       context.Context
 				)
 				defer func() {
@@ -0,0 +1,244 @@
+package server
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/metrics"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/random"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	ppsServer "github.com/pachyderm/pachyderm/v2/src/server/pps"
+)
+
+var (
+	defaultNotificationJobStates      = []pps.JobState{pps.JobState_JOB_SUCCESS, pps.JobState_JOB_FAILURE}
+	defaultNotificationPipelineStates = []pps.PipelineState{pps.PipelineState_PIPELINE_CRASHING, pps.PipelineState_PIPELINE_FAILURE}
+)
+
+// CreateNotification implements the protobuf pps.CreateNotification RPC
+func (a *apiServer) CreateNotification(ctx context.Context, request *pps.CreateNotificationRequest) (response *pps.CreateNotificationResponse, retErr error) {
+	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "CreateNotification")
+	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
+
+	info := &pps.NotificationInfo{
+		Notification: request.Notification,
+		Url:          request.Url,
+		Events:       request.Events,
+		Secret:       request.Secret,
+		CreatedAt:    timestamppb.Now(),
+	}
+	if err := validateNotification(info, a.notificationAddresses); err != nil {
+		return nil, err
+	}
+	if err := a.checkNotificationIsAuthorized(ctx, info); err != nil {
+		return nil, err
+	}
+	if info.Secret == "" {
+		info.Secret = random.String(32)
+	}
+	if err := dbutil.WithTx(ctx, a.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		notifications := a.notifications.ReadWrite(tx)
+		if request.Update {
+			// Deliveries already queued go to the new endpoint; they were
+			// queued for events the notification still matched at the time.
+			return errors.EnsureStack(notifications.Put(info.Notification.Name, info))
+		}
+		if err := notifications.Create(info.Notification.Name, info); err != nil {
+			if col.IsErrExists(err) {
+				return ppsServer.ErrNotificationAlreadyExists{Notification: info.Notification}
+			}
+			return errors.EnsureStack(err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &pps.CreateNotificationResponse{Secret: info.Secret}, nil
+}
+
+// ListNotification implements the protobuf pps.ListNotification RPC.  Only the
+// notifications whose events the caller may read are returned, and their
+// secrets are omitted.
+func (a *apiServer) ListNotification(request *pps.ListNotificationRequest, srv pps.API_ListNotificationServer) (retErr error) {
+	ctx := srv.Context()
+	info := &pps.NotificationInfo{}
+	return errors.EnsureStack(a.notifications.ReadOnly(ctx).List(info, col.DefaultOptions(), func(string) error {
+		if err := a.checkNotificationIsAuthorized(ctx, info); err != nil {
+			if errors.As(err, &auth.ErrNotAuthorized{}) {
+				return nil
+			}
+			return err
+		}
+		info := proto.Clone(info).(*pps.NotificationInfo)
+		info.Secret = ""
+		return errors.EnsureStack(srv.Send(info))
+	}))
+}
+
+// DeleteNotification implements the protobuf pps.DeleteNotification RPC
+func (a *apiServer) DeleteNotification(ctx context.Context, request *pps.DeleteNotificationRequest) (response *emptypb.Empty, retErr error) {
+	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "DeleteNotification")
+	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
+
+	info, err := a.inspectNotification(ctx, request.Notification)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.checkNotificationIsAuthorized(ctx, info); err != nil {
+		return nil, err
+	}
+	if err := dbutil.WithTx(ctx, a.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		if err := a.notifications.ReadWrite(tx).Delete(info.Notification.Name); err != nil {
+			return errors.EnsureStack(err)
+		}
+		return ppsdb.DeleteNotificationDeliveries(ctx, tx, info.Notification.Name)
+	}); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListNotificationDelivery implements the protobuf pps.ListNotificationDelivery
+// RPC
+func (a *apiServer) ListNotificationDelivery(request *pps.ListNotificationDeliveryRequest, srv pps.API_ListNotificationDeliveryServer) (retErr error) {
+	ctx := srv.Context()
+	info, err := a.inspectNotification(ctx, request.Notification)
+	if err != nil {
+		return err
+	}
+	if err := a.checkNotificationIsAuthorized(ctx, info); err != nil {
+		return err
+	}
+	var ds []*ppsdb.NotificationDelivery
+	if err := dbutil.WithTx(ctx, a.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		var err error
+		ds, err = ppsdb.ListNotificationDeliveries(ctx, tx, info.Notification.Name)
+		return err
+	}, dbutil.WithReadOnly()); err != nil {
+		return err
+	}
+	for _, d := range ds {
+		if err := srv.Send(d.PB()); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	return nil
+}
+
+func (a *apiServer) inspectNotification(ctx context.Context, notification *pps.Notification) (*pps.NotificationInfo, error) {
+	if notification.GetName() == "" {
+		return nil, errors.New("notification must have a name")
+	}
+	info := &pps.NotificationInfo{}
+	if err := a.notifications.ReadOnly(ctx).Get(notification.Name, info); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, ppsServer.ErrNotificationNotFound{Notification: notification}
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	return info, nil
+}
+
+// validateNotification validates info, filling in the defaults of its events.
+func validateNotification(info *pps.NotificationInfo, addrs *notificationAddresses) error {
+	if info.Notification == nil {
+		return errors.New("notification must have a name")
+	}
+	if err := ancestry.ValidateName(info.Notification.Name); err != nil {
+		return errors.Wrap(err, "invalid notification name")
+	}
+	u, err := url.Parse(info.Url)
+	if err != nil {
+		return errors.Wrapf(err, "invalid notification url %q", info.Url)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.Errorf("notification url %q must be an http or https url", info.Url)
+	}
+	if err := addrs.checkHost(u.Hostname()); err != nil {
+		return errors.Wrapf(err, "invalid notification url %q", info.Url)
+	}
+	if len(info.Events) == 0 {
+		return errors.New("notification must have at least one event")
+	}
+	for _, e := range info.Events {
+		switch e := e.GetEvent().(type) {
+		case *pps.NotificationEvent_CommitFinished_:
+			branch := e.CommitFinished.GetBranch()
+			if branch.GetRepo().GetName() == "" {
+				return errors.New("commit finished events must have a repo")
+			}
+			if branch.Repo.Project.GetName() == "" {
+				branch.Repo.Project = &pfs.Project{Name: pfs.DefaultProjectName}
+			}
+			if branch.Repo.Type == "" {
+				branch.Repo.Type = pfs.UserRepoType
+			}
+		case *pps.NotificationEvent_JobFinished_:
+			if e.JobFinished.Pipeline == nil {
+				e.JobFinished.Pipeline = &pps.Pipeline{}
+			}
+			ensurePipelineProject(e.JobFinished.Pipeline)
+			if len(e.JobFinished.States) == 0 {
+				e.JobFinished.States = defaultNotificationJobStates
+			}
+			for _, s := range e.JobFinished.States {
+				if !pps.IsTerminal(s) {
+					return errors.Errorf("job finished events can't be for %v, which is not a terminal job state", s)
+				}
+			}
+		case *pps.NotificationEvent_PipelineStateChanged_:
+			if e.PipelineStateChanged.Pipeline == nil {
+				e.PipelineStateChanged.Pipeline = &pps.Pipeline{}
+			}
+			ensurePipelineProject(e.PipelineStateChanged.Pipeline)
+			if len(e.PipelineStateChanged.States) == 0 {
+				e.PipelineStateChanged.States = defaultNotificationPipelineStates
+			}
+		default:
+			return errors.New("notification events must be one of commit finished, job finished or pipeline state changed")
+		}
+	}
+	return nil
+}
+
+// checkNotificationIsAuthorized checks that the caller may read everything
+// that info's events are about, since its payloads include them.
+func (a *apiServer) checkNotificationIsAuthorized(ctx context.Context, info *pps.NotificationInfo) error {
+	for _, e := range info.Events {
+		var err error
+		switch e := e.GetEvent().(type) {
+		case *pps.NotificationEvent_CommitFinished_:
+			err = a.env.AuthServer.CheckRepoIsAuthorized(ctx, e.CommitFinished.Branch.Repo, auth.Permission_REPO_READ)
+		case *pps.NotificationEvent_JobFinished_:
+			err = a.checkNotificationPipelineIsAuthorized(ctx, e.JobFinished.Pipeline)
+		case *pps.NotificationEvent_PipelineStateChanged_:
+			err = a.checkNotificationPipelineIsAuthorized(ctx, e.PipelineStateChanged.Pipeline)
+		}
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	return nil
+}
+
+// checkNotificationPipelineIsAuthorized checks that the caller may read
+// pipeline, or every pipeline of its project if it doesn't have a name.
+func (a *apiServer) checkNotificationPipelineIsAuthorized(ctx context.Context, pipeline *pps.Pipeline) error {
+	if pipeline.Name == "" {
+		return errors.EnsureStack(a.env.AuthServer.CheckProjectIsAuthorized(ctx, pipeline.Project, auth.Permission_PROJECT_LIST_REPO))
+	}
+	return errors.EnsureStack(a.env.AuthServer.CheckRepoIsAuthorized(ctx, &pfs.Repo{Type: pfs.UserRepoType, Project: pipeline.Project, Name: pipeline.Name}, auth.Permission_REPO_READ))
+}
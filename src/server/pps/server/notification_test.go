@@ -0,0 +1,187 @@
+package server
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+func TestValidateNotification(t *testing.T) {
+	info := &pps.NotificationInfo{
+		Notification: &pps.Notification{Name: "alerts"},
+		Url:          "https://example.com/hook",
+		Events: []*pps.NotificationEvent{
+			{Event: &pps.NotificationEvent_CommitFinished_{CommitFinished: &pps.NotificationEvent_CommitFinished{
+				Branch: &pfs.Branch{Repo: &pfs.Repo{Name: "images"}, Name: "master"},
+			}}},
+			{Event: &pps.NotificationEvent_PipelineStateChanged_{PipelineStateChanged: &pps.NotificationEvent_PipelineStateChanged{}}},
+		},
+	}
+	require.NoError(t, validateNotification(info, nil))
+	repo := info.Events[0].GetCommitFinished().Branch.Repo
+	require.Equal(t, pfs.DefaultProjectName, repo.Project.GetName())
+	require.Equal(t, pfs.UserRepoType, repo.Type)
+	changed := info.Events[1].GetPipelineStateChanged()
+	require.Equal(t, pfs.DefaultProjectName, changed.Pipeline.Project.GetName())
+	require.Equal(t, defaultNotificationPipelineStates, changed.States)
+
+	for name, info := range map[string]*pps.NotificationInfo{
+		"no events": {Notification: &pps.Notification{Name: "n"}, Url: "https://example.com"},
+		"bad url": {Notification: &pps.Notification{Name: "n"}, Url: "example.com", Events: []*pps.NotificationEvent{
+			{Event: &pps.NotificationEvent_JobFinished_{JobFinished: &pps.NotificationEvent_JobFinished{}}},
+		}},
+		"running job": {Notification: &pps.Notification{Name: "n"}, Url: "https://example.com", Events: []*pps.NotificationEvent{
+			{Event: &pps.NotificationEvent_JobFinished_{JobFinished: &pps.NotificationEvent_JobFinished{States: []pps.JobState{pps.JobState_JOB_RUNNING}}}},
+		}},
+		"no repo": {Notification: &pps.Notification{Name: "n"}, Url: "https://example.com", Events: []*pps.NotificationEvent{
+			{Event: &pps.NotificationEvent_CommitFinished_{CommitFinished: &pps.NotificationEvent_CommitFinished{}}},
+		}},
+		"metadata url": {Notification: &pps.Notification{Name: "n"}, Url: "http://169.254.169.254/latest", Events: []*pps.NotificationEvent{
+			{Event: &pps.NotificationEvent_JobFinished_{JobFinished: &pps.NotificationEvent_JobFinished{}}},
+		}},
+		"localhost url": {Notification: &pps.Notification{Name: "n"}, Url: "http://localhost:1650", Events: []*pps.NotificationEvent{
+			{Event: &pps.NotificationEvent_JobFinished_{JobFinished: &pps.NotificationEvent_JobFinished{}}},
+		}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.YesError(t, validateNotification(info, nil))
+		})
+	}
+}
+
+func TestNotificationMatches(t *testing.T) {
+	repo := &pfs.Repo{Project: &pfs.Project{Name: "p"}, Name: "images", Type: pfs.UserRepoType}
+	commitInfo := &pfs.CommitInfo{Commit: &pfs.Commit{Repo: repo, Branch: &pfs.Branch{Repo: repo, Name: "master"}, Id: "a"}}
+	require.True(t, commitFinishedMatches(&pps.NotificationEvent_CommitFinished{Branch: &pfs.Branch{Repo: repo, Name: "master"}}, commitInfo))
+	require.True(t, commitFinishedMatches(&pps.NotificationEvent_CommitFinished{Branch: &pfs.Branch{Repo: repo}}, commitInfo))
+	require.False(t, commitFinishedMatches(&pps.NotificationEvent_CommitFinished{Branch: &pfs.Branch{Repo: repo, Name: "dev"}}, commitInfo))
+	require.False(t, commitFinishedMatches(nil, commitInfo))
+
+	pipeline := &pps.Pipeline{Project: &pfs.Project{Name: "p"}, Name: "edges"}
+	jobInfo := &pps.JobInfo{Job: &pps.Job{Pipeline: pipeline, Id: "a"}, State: pps.JobState_JOB_FAILURE}
+	require.True(t, jobFinishedMatches(&pps.NotificationEvent_JobFinished{Pipeline: pipeline, States: defaultNotificationJobStates}, jobInfo))
+	require.True(t, jobFinishedMatches(&pps.NotificationEvent_JobFinished{Pipeline: &pps.Pipeline{Project: pipeline.Project}, States: defaultNotificationJobStates}, jobInfo))
+	require.False(t, jobFinishedMatches(&pps.NotificationEvent_JobFinished{Pipeline: pipeline, States: []pps.JobState{pps.JobState_JOB_SUCCESS}}, jobInfo))
+	require.False(t, jobFinishedMatches(&pps.NotificationEvent_JobFinished{Pipeline: &pps.Pipeline{Project: &pfs.Project{Name: "q"}}, States: defaultNotificationJobStates}, jobInfo))
+
+	pipelineInfo := &pps.PipelineInfo{Pipeline: pipeline, State: pps.PipelineState_PIPELINE_CRASHING}
+	require.True(t, pipelineStateChangedMatches(&pps.NotificationEvent_PipelineStateChanged{Pipeline: pipeline, States: defaultNotificationPipelineStates}, pipelineInfo))
+	pipelineInfo.State = pps.PipelineState_PIPELINE_RUNNING
+	require.False(t, pipelineStateChangedMatches(&pps.NotificationEvent_PipelineStateChanged{Pipeline: pipeline, States: defaultNotificationPipelineStates}, pipelineInfo))
+}
+
+func TestNotifierAttempt(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	const secret = "secret"
+	status := http.StatusInternalServerError
+	var received []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		if !pps.VerifyNotificationPayload(secret, body, r.Header.Get(pps.NotificationSignatureHeader)) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		require.Equal(t, "delivery", r.Header.Get(pps.NotificationDeliveryHeader))
+		received = body
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+	n := &notifier{client: srv.Client()}
+	info := &pps.NotificationInfo{Notification: &pps.Notification{Name: "alerts"}, Url: srv.URL, Secret: secret}
+	pending := int32(pps.NotificationDeliveryState_NOTIFICATION_DELIVERY_PENDING)
+	d := &ppsdb.NotificationDelivery{ID: "delivery", Notification: "alerts", Payload: []byte(`{"deliveryId":"delivery"}`), State: pending}
+
+	// A failed attempt is retried later.
+	n.attempt(ctx, info, d)
+	require.Equal(t, pending, d.State)
+	require.Equal(t, int32(1), d.Attempts)
+	require.Equal(t, int32(http.StatusInternalServerError), d.StatusCode)
+	require.NotEqual(t, "", d.Error)
+	require.True(t, d.NextAttemptAt.After(time.Now()))
+
+	// A delivery signed with the wrong secret is rejected by the endpoint.
+	n.attempt(ctx, &pps.NotificationInfo{Notification: info.Notification, Url: srv.URL, Secret: "wrong"}, d)
+	require.Equal(t, int32(http.StatusUnauthorized), d.StatusCode)
+
+	status = http.StatusNoContent
+	n.attempt(ctx, info, d)
+	require.Equal(t, int32(pps.NotificationDeliveryState_NOTIFICATION_DELIVERY_DELIVERED), d.State)
+	require.Equal(t, "", d.Error)
+	require.True(t, d.DeliveredAt.Valid)
+	require.Equal(t, string(d.Payload), string(received))
+
+	// A delivery fails once it runs out of attempts.
+	status = http.StatusBadGateway
+	d = &ppsdb.NotificationDelivery{ID: "delivery", Notification: "alerts", State: pending, Attempts: notificationMaxAttempts - 1}
+	n.attempt(ctx, info, d)
+	require.Equal(t, int32(pps.NotificationDeliveryState_NOTIFICATION_DELIVERY_FAILED), d.State)
+}
+
+func TestNotificationAddresses(t *testing.T) {
+	var defaults *notificationAddresses
+	for _, addr := range []string{"127.0.0.1", "10.1.2.3", "169.254.169.254", "192.168.0.1", "::1", "fe80::1%eth0", "::ffff:10.0.0.1"} {
+		require.YesError(t, defaults.check(netip.MustParseAddr(addr)), addr)
+	}
+	require.NoError(t, defaults.check(netip.MustParseAddr("93.184.216.34")))
+	require.NoError(t, defaults.check(netip.MustParseAddr("2606:2800:220:1::1")))
+
+	addrs, err := newNotificationAddresses("10.1.0.0/16", "")
+	require.NoError(t, err)
+	require.NoError(t, addrs.check(netip.MustParseAddr("10.1.2.3")))
+	require.YesError(t, addrs.check(netip.MustParseAddr("10.2.0.1")))
+	require.YesError(t, addrs.checkHost("localhost"))
+	require.NoError(t, addrs.checkHost("example.com"))
+
+	addrs, err = newNotificationAddresses("", "93.184.216.0/24")
+	require.NoError(t, err)
+	require.YesError(t, addrs.check(netip.MustParseAddr("93.184.216.34")))
+	require.NoError(t, addrs.check(netip.MustParseAddr("127.0.0.1")))
+
+	_, err = newNotificationAddresses("10.0.0.0", "")
+	require.YesError(t, err)
+}
+
+func TestNotificationClient(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	var redirected bool
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		redirected = true
+	}))
+	defer target.Close()
+	srv := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusTemporaryRedirect))
+	defer srv.Close()
+	info := &pps.NotificationInfo{Notification: &pps.Notification{Name: "alerts"}, Url: srv.URL, Secret: "secret"}
+	d := &ppsdb.NotificationDelivery{ID: "delivery", Notification: "alerts", Payload: []byte(`{}`)}
+
+	// Deliveries to the loopback address are refused by default.
+	n := &notifier{client: newNotificationClient(nil)}
+	n.attempt(ctx, info, d)
+	require.Equal(t, int32(0), d.StatusCode)
+	require.True(t, strings.Contains(d.Error, "may not be delivered"), d.Error)
+
+	// Redirects aren't followed.
+	addrs, err := newNotificationAddresses("127.0.0.0/8", "")
+	require.NoError(t, err)
+	n = &notifier{client: newNotificationClient(addrs)}
+	n.attempt(ctx, info, d)
+	require.Equal(t, int32(http.StatusTemporaryRedirect), d.StatusCode)
+	require.NotEqual(t, "", d.Error)
+	require.False(t, redirected)
+}
+
+func TestNotificationRetryDelay(t *testing.T) {
+	require.Equal(t, notificationRetryInterval, notificationRetryDelay(1))
+	require.Equal(t, 4*notificationRetryInterval, notificationRetryDelay(3))
+	require.Equal(t, notificationMaxRetryInterval, notificationRetryDelay(notificationMaxAttempts))
+}
@@ -0,0 +1,523 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/internal/watch"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+const (
+	// notificationMaxAttempts is the number of times a delivery is attempted
+	// before it fails.
+	notificationMaxAttempts = 10
+	// notificationRetryInterval is the wait before retrying a delivery, which
+	// doubles after each attempt up to notificationMaxRetryInterval.
+	notificationRetryInterval    = 5 * time.Second
+	notificationMaxRetryInterval = 30 * time.Minute
+	notificationTimeout          = 30 * time.Second
+	notificationPollInterval     = 10 * time.Second
+	notificationBatchSize        = 100
+	// notificationRetention is how long the delivery log is kept.  Events older
+	// than it are not delivered, since they may have been delivered already
+	// and since pruned from the log.
+	notificationRetention = 7 * 24 * time.Hour
+)
+
+// notifier delivers the events that notifications subscribe to.  It runs in
+// the PPS master, watching the commits, jobs and pipelines collections and
+// queueing a delivery in the delivery log for each notification an event
+// matches, then POSTing the queued deliveries.
+type notifier struct {
+	db            *pachsql.DB
+	notifications col.PostgresCollection
+	commits       col.PostgresCollection
+	jobs          col.PostgresCollection
+	pipelines     col.PostgresCollection
+	client        *http.Client
+	// queued wakes the delivery loop when a delivery is queued.
+	queued chan struct{}
+
+	mu sync.Mutex
+	// infos are the current notifications, keyed by name.
+	infos map[string]*pps.NotificationInfo
+}
+
+func newNotifier(env Env, addrs *notificationAddresses) *notifier {
+	return &notifier{
+		db:            env.DB,
+		notifications: ppsdb.Notifications(env.DB, env.Listener),
+		commits:       pfsdb.Commits(env.DB, env.Listener),
+		jobs:          ppsdb.Jobs(env.DB, env.Listener),
+		pipelines:     ppsdb.Pipelines(env.DB, env.Listener),
+		client:        newNotificationClient(addrs),
+		queued:        make(chan struct{}, 1),
+	}
+}
+
+// newNotificationClient returns the client that deliveries are POSTed with.
+// Any user may create a notification, so the client only connects to the
+// addresses that addrs allows, checking them once they're resolved so that a
+// hostname can't resolve to a refused address, and doesn't follow redirects,
+// which fail the attempt instead.  It doesn't use a proxy, since the proxy's
+// address would be checked rather than the endpoint's.
+func newNotificationClient(addrs *notificationAddresses) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: notificationTimeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			return addrs.check(addrPort.Addr())
+		},
+	}
+	return &http.Client{
+		Timeout: notificationTimeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			ForceAttemptHTTP2:   true,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+			TLSHandshakeTimeout: 10 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// defaultNotificationDeniedCIDRs are the address ranges that notifications may
+// not be delivered to by default: the unspecified, loopback, link-local (where
+// cloud metadata services live), private and shared ranges, which hold the
+// cluster's own services.
+var defaultNotificationDeniedCIDRs = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("10.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("127.0.0.0/8"),
+	netip.MustParsePrefix("169.254.0.0/16"),
+	netip.MustParsePrefix("172.16.0.0/12"),
+	netip.MustParsePrefix("192.168.0.0/16"),
+	netip.MustParsePrefix("::/128"),
+	netip.MustParsePrefix("::1/128"),
+	netip.MustParsePrefix("fc00::/7"),
+	netip.MustParsePrefix("fe80::/10"),
+}
+
+// notificationAddresses are the addresses that notifications may be delivered
+// to.  An address in a denied range is refused unless it's also in an allowed
+// one.  A nil *notificationAddresses denies the default ranges.
+type notificationAddresses struct {
+	allowed, denied []netip.Prefix
+}
+
+// newNotificationAddresses parses the comma-separated allowed and denied
+// ranges, denying the default ranges if denied is empty.
+func newNotificationAddresses(allowed, denied string) (*notificationAddresses, error) {
+	a := &notificationAddresses{}
+	var err error
+	if a.allowed, err = parseCIDRs(allowed); err != nil {
+		return nil, errors.Wrap(err, "parse allowed notification CIDRs")
+	}
+	if a.denied, err = parseCIDRs(denied); err != nil {
+		return nil, errors.Wrap(err, "parse denied notification CIDRs")
+	}
+	if len(a.denied) == 0 {
+		a.denied = defaultNotificationDeniedCIDRs
+	}
+	return a, nil
+}
+
+func parseCIDRs(s string) ([]netip.Prefix, error) {
+	var prefixes []netip.Prefix
+	for _, cidr := range strings.Split(s, ",") {
+		if cidr = strings.TrimSpace(cidr); cidr == "" {
+			continue
+		}
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return prefixes, nil
+}
+
+// check returns an error if notifications may not be delivered to addr.
+func (a *notificationAddresses) check(addr netip.Addr) error {
+	allowed, denied := []netip.Prefix(nil), defaultNotificationDeniedCIDRs
+	if a != nil {
+		allowed, denied = a.allowed, a.denied
+	}
+	addr = addr.Unmap().WithZone("")
+	for _, prefix := range allowed {
+		if prefix.Contains(addr) {
+			return nil
+		}
+	}
+	for _, prefix := range denied {
+		if prefix.Contains(addr) {
+			return errors.Errorf("notifications may not be delivered to %v", addr)
+		}
+	}
+	return nil
+}
+
+// checkHost returns an error if host is an address, or localhost, that
+// notifications may not be delivered to.  Other hostnames are checked once
+// they're resolved, when deliveries are attempted.
+func (a *notificationAddresses) checkHost(host string) error {
+	if strings.EqualFold(host, "localhost") {
+		host = "127.0.0.1"
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return nil
+	}
+	return a.check(addr)
+}
+
+func (n *notifier) run(ctx context.Context) {
+	backoff.RetryUntilCancel(ctx, func() error { //nolint:errcheck
+		return n.notify(ctx)
+	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+		log.Error(ctx, "error delivering notifications; retrying", zap.Error(err), zap.Duration("retryIn", d))
+		return nil
+	})
+}
+
+func (n *notifier) notify(ctx context.Context) error {
+	// Load the notifications before watching for events, so that none are
+	// missed.
+	infos := make(map[string]*pps.NotificationInfo)
+	info := &pps.NotificationInfo{}
+	if err := n.notifications.ReadOnly(ctx).List(info, col.DefaultOptions(), func(string) error {
+		infos[info.Notification.Name] = proto.Clone(info).(*pps.NotificationInfo)
+		return nil
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	n.mu.Lock()
+	n.infos = infos
+	n.mu.Unlock()
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.Go(func() error { return n.watchNotifications(ctx) })
+	eg.Go(func() error { return n.watchCommits(ctx) })
+	eg.Go(func() error { return n.watchJobs(ctx) })
+	eg.Go(func() error { return n.watchPipelines(ctx) })
+	eg.Go(func() error { return n.deliver(ctx) })
+	return errors.EnsureStack(eg.Wait())
+}
+
+func (n *notifier) watchNotifications(ctx context.Context) error {
+	return errors.EnsureStack(n.notifications.ReadOnly(ctx).WatchF(func(ev *watch.Event) error {
+		var name string
+		info := &pps.NotificationInfo{}
+		if err := ev.Unmarshal(&name, info); err != nil {
+			return errors.Wrap(err, "unmarshal")
+		}
+		n.mu.Lock()
+		defer n.mu.Unlock()
+		if ev.Type == watch.EventDelete {
+			delete(n.infos, name)
+		} else {
+			n.infos[name] = info
+		}
+		return nil
+	}))
+}
+
+func (n *notifier) watchCommits(ctx context.Context) error {
+	return errors.EnsureStack(n.commits.ReadOnly(ctx).WatchF(func(ev *watch.Event) error {
+		var key string
+		commitInfo := &pfs.CommitInfo{}
+		if err := ev.Unmarshal(&key, commitInfo); err != nil {
+			return errors.Wrap(err, "unmarshal")
+		}
+		if commitInfo.Finished == nil {
+			return nil
+		}
+		return n.enqueue(ctx, "commit_finished:"+commitInfo.Commit.Key(), commitInfo.Finished, func(e *pps.NotificationEvent) bool {
+			return commitFinishedMatches(e.GetCommitFinished(), commitInfo)
+		}, &pps.NotificationPayload{Event: &pps.NotificationPayload_CommitFinished{CommitFinished: commitInfo}})
+	}, watch.IgnoreDelete))
+}
+
+func (n *notifier) watchJobs(ctx context.Context) error {
+	return errors.EnsureStack(n.jobs.ReadOnly(ctx).WatchF(func(ev *watch.Event) error {
+		var key string
+		jobInfo := &pps.JobInfo{}
+		if err := ev.Unmarshal(&key, jobInfo); err != nil {
+			return errors.Wrap(err, "unmarshal")
+		}
+		if !pps.IsTerminal(jobInfo.State) || jobInfo.Finished == nil {
+			return nil
+		}
+		return n.enqueue(ctx, "job_finished:"+ppsdb.JobKey(jobInfo.Job), jobInfo.Finished, func(e *pps.NotificationEvent) bool {
+			return jobFinishedMatches(e.GetJobFinished(), jobInfo)
+		}, &pps.NotificationPayload{Event: &pps.NotificationPayload_JobFinished{JobFinished: jobInfo}})
+	}, watch.IgnoreDelete))
+}
+
+// watchPipelines notifies pipelines' changes of state.  Pipelines don't record
+// when their state changed, so changes are found by comparing each pipeline's
+// state to the last one seen.  The first state seen of each pipeline is not
+// notified, since it may be from before the notifier started.
+func (n *notifier) watchPipelines(ctx context.Context) error {
+	type seen struct {
+		version uint64
+		state   pps.PipelineState
+	}
+	states := make(map[string]seen)
+	return errors.EnsureStack(n.pipelines.ReadOnly(ctx).WatchF(func(ev *watch.Event) error {
+		var key string
+		pipelineInfo := &pps.PipelineInfo{}
+		if err := ev.Unmarshal(&key, pipelineInfo); err != nil {
+			return errors.Wrap(err, "unmarshal")
+		}
+		name := pipelineInfo.Pipeline.String()
+		last, ok := states[name]
+		if ok && pipelineInfo.Version < last.version {
+			return nil
+		}
+		states[name] = seen{version: pipelineInfo.Version, state: pipelineInfo.State}
+		if !ok || pipelineInfo.State == last.state {
+			return nil
+		}
+		now := timestamppb.Now()
+		eventID := fmt.Sprintf("pipeline_state_changed:%s:%s:%s", ppsdb.VersionKey(pipelineInfo.Pipeline, pipelineInfo.Version), pipelineInfo.State, now.AsTime().Format(time.RFC3339Nano))
+		return n.enqueue(ctx, eventID, now, func(e *pps.NotificationEvent) bool {
+			return pipelineStateChangedMatches(e.GetPipelineStateChanged(), pipelineInfo)
+		}, &pps.NotificationPayload{Event: &pps.NotificationPayload_PipelineStateChanged{PipelineStateChanged: pipelineInfo}})
+	}, watch.IgnoreDelete))
+}
+
+// enqueue queues a delivery of payload to each notification with an event
+// matching match, unless the event, which happened at eventTime, predates the
+// notification.
+func (n *notifier) enqueue(ctx context.Context, eventID string, eventTime *timestamppb.Timestamp, match func(*pps.NotificationEvent) bool, payload *pps.NotificationPayload) error {
+	if time.Since(eventTime.AsTime()) > notificationRetention {
+		return nil
+	}
+	var matched []*pps.NotificationInfo
+	n.mu.Lock()
+	for _, info := range n.infos {
+		if eventTime.AsTime().Before(info.CreatedAt.AsTime()) {
+			continue
+		}
+		for _, e := range info.Events {
+			if match(e) {
+				matched = append(matched, info)
+				break
+			}
+		}
+	}
+	n.mu.Unlock()
+	for _, info := range matched {
+		d := &ppsdb.NotificationDelivery{
+			ID:           uuid.NewWithoutDashes(),
+			Notification: info.Notification.Name,
+			EventID:      eventID,
+		}
+		payload.DeliveryId = d.ID
+		payload.Notification = info.Notification
+		payload.Time = timestamppb.Now()
+		var err error
+		if d.Payload, err = protojson.Marshal(payload); err != nil {
+			return errors.Wrap(err, "marshal notification payload")
+		}
+		var queued bool
+		if err := dbutil.WithTx(ctx, n.db, func(ctx context.Context, tx *pachsql.Tx) error {
+			queued, err = ppsdb.CreateNotificationDelivery(ctx, tx, d)
+			return err
+		}); err != nil {
+			return err
+		}
+		if queued {
+			log.Info(ctx, "queued notification delivery", zap.String("notification", d.Notification), zap.String("event", eventID), zap.String("delivery", d.ID))
+			select {
+			case n.queued <- struct{}{}:
+			default:
+			}
+		}
+	}
+	return nil
+}
+
+// deliver attempts the due deliveries whenever one is queued, or every
+// notificationPollInterval for retries, and prunes the delivery log.
+func (n *notifier) deliver(ctx context.Context) error {
+	ticker := time.NewTicker(notificationPollInterval)
+	defer ticker.Stop()
+	var pruned time.Time
+	for {
+		if err := n.deliverDue(ctx); err != nil {
+			return err
+		}
+		if time.Since(pruned) > time.Hour {
+			if err := dbutil.WithTx(ctx, n.db, func(ctx context.Context, tx *pachsql.Tx) error {
+				return ppsdb.PruneNotificationDeliveries(ctx, tx, time.Now().Add(-notificationRetention))
+			}); err != nil {
+				return err
+			}
+			pruned = time.Now()
+		}
+		select {
+		case <-n.queued:
+		case <-ticker.C:
+		case <-ctx.Done():
+			return errors.EnsureStack(context.Cause(ctx))
+		}
+	}
+}
+
+func (n *notifier) deliverDue(ctx context.Context) error {
+	for {
+		var ds []*ppsdb.NotificationDelivery
+		if err := dbutil.WithTx(ctx, n.db, func(ctx context.Context, tx *pachsql.Tx) error {
+			var err error
+			ds, err = ppsdb.ListDueNotificationDeliveries(ctx, tx, time.Now(), notificationBatchSize)
+			return err
+		}); err != nil {
+			return err
+		}
+		for _, d := range ds {
+			n.mu.Lock()
+			info := n.infos[d.Notification]
+			n.mu.Unlock()
+			if info == nil {
+				// The notification was deleted, along with its deliveries.
+				continue
+			}
+			n.attempt(ctx, info, d)
+			if err := dbutil.WithTx(ctx, n.db, func(ctx context.Context, tx *pachsql.Tx) error {
+				return ppsdb.UpdateNotificationDelivery(ctx, tx, d)
+			}); err != nil {
+				return err
+			}
+		}
+		if len(ds) < notificationBatchSize {
+			return nil
+		}
+	}
+}
+
+// attempt POSTs d to info's endpoint, and records the result in d.
+func (n *notifier) attempt(ctx context.Context, info *pps.NotificationInfo, d *ppsdb.NotificationDelivery) {
+	d.Attempts++
+	statusCode, err := n.post(ctx, info, d)
+	d.StatusCode = int32(statusCode)
+	switch {
+	case err == nil:
+		d.State = int32(pps.NotificationDeliveryState_NOTIFICATION_DELIVERY_DELIVERED)
+		d.Error = ""
+		d.DeliveredAt = sql.NullTime{Time: time.Now(), Valid: true}
+	case d.Attempts >= notificationMaxAttempts:
+		d.State = int32(pps.NotificationDeliveryState_NOTIFICATION_DELIVERY_FAILED)
+		d.Error = err.Error()
+		log.Info(ctx, "notification delivery failed", zap.String("notification", d.Notification), zap.String("delivery", d.ID), zap.Error(err))
+	default:
+		d.Error = err.Error()
+		d.NextAttemptAt = time.Now().Add(notificationRetryDelay(d.Attempts))
+	}
+}
+
+func (n *notifier) post(ctx context.Context, info *pps.NotificationInfo, d *ppsdb.NotificationDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, info.Url, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(pps.NotificationDeliveryHeader, d.ID)
+	req.Header.Set(pps.NotificationSignatureHeader, pps.SignNotificationPayload(info.Secret, d.Payload))
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<20)) //nolint:errcheck
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, errors.Errorf("endpoint responded %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// notificationRetryDelay returns the wait before retrying a delivery which
+// has been attempted attempts times.
+func notificationRetryDelay(attempts int32) time.Duration {
+	delay := notificationRetryInterval
+	for i := int32(1); i < attempts; i++ {
+		delay *= 2
+		if delay >= notificationMaxRetryInterval {
+			return notificationMaxRetryInterval
+		}
+	}
+	return delay
+}
+
+func commitFinishedMatches(e *pps.NotificationEvent_CommitFinished, commitInfo *pfs.CommitInfo) bool {
+	if e == nil {
+		return false
+	}
+	branch := commitInfo.Commit.GetBranch()
+	return proto.Equal(e.Branch.Repo, commitInfo.Commit.Repo) && (e.Branch.Name == "" || e.Branch.Name == branch.GetName())
+}
+
+func jobFinishedMatches(e *pps.NotificationEvent_JobFinished, jobInfo *pps.JobInfo) bool {
+	if e == nil || !notificationPipelineMatches(e.Pipeline, jobInfo.Job.Pipeline) {
+		return false
+	}
+	for _, s := range e.States {
+		if s == jobInfo.State {
+			return true
+		}
+	}
+	return false
+}
+
+func pipelineStateChangedMatches(e *pps.NotificationEvent_PipelineStateChanged, pipelineInfo *pps.PipelineInfo) bool {
+	if e == nil || !notificationPipelineMatches(e.Pipeline, pipelineInfo.Pipeline) {
+		return false
+	}
+	for _, s := range e.States {
+		if s == pipelineInfo.State {
+			return true
+		}
+	}
+	return false
+}
+
+// notificationPipelineMatches returns true if a notification's pipeline, which
+// matches every pipeline of its project if it doesn't have a name, matches
+// pipeline.
+func notificationPipelineMatches(p, pipeline *pps.Pipeline) bool {
+	return p.Project.GetName() == pipeline.Project.GetName() && (p.Name == "" || p.Name == pipeline.Name)
+}
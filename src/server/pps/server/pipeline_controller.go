@@ -22,9 +22,9 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/driver"
 	"github.com/pachyderm/pachyderm/v2/src/task"
 	"github.com/pachyderm/pachyderm/v2/src/version"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
-	opentracing "github.com/opentracing/opentracing-go"
 	v1 "k8s.io/api/core/v1"
 )
 
@@ -44,14 +44,31 @@ func max(is ...int) int {
 type pcManager struct {
 	sync.Mutex
 	pcs map[pipelineKey]*pipelineController
+	// quotaLocks serialize the scale ups of the pipelines in each project
+	// with a compute quota, indexed by project name.
+	quotaLocks map[string]*sync.Mutex
 }
 
 func newPcManager() *pcManager {
 	return &pcManager{
-		pcs: make(map[pipelineKey]*pipelineController),
+		pcs:        make(map[pipelineKey]*pipelineController),
+		quotaLocks: make(map[string]*sync.Mutex),
 	}
 }
 
+// quotaLock returns the lock which serializes the scale ups of the pipelines
+// in project.
+func (m *pcManager) quotaLock(project string) *sync.Mutex {
+	m.Lock()
+	defer m.Unlock()
+	mu, ok := m.quotaLocks[project]
+	if !ok {
+		mu = &sync.Mutex{}
+		m.quotaLocks[project] = mu
+	}
+	return mu
+}
+
 type sideEffectName int32
 
 const (
@@ -603,6 +620,13 @@ func (pc *pipelineController) scaleUpPipeline(ctx context.Context, pi *pps.Pipel
 	if pi.Details.ParallelismSpec != nil && pi.Details.ParallelismSpec.Constant > 0 {
 		maxScale = int32(pi.Details.ParallelismSpec.Constant)
 	}
+	quota, usage, done, quotaErr := pc.computeQuota(ctx, pi, oldRC)
+	defer done()
+	if quotaErr != nil {
+		// Don't exceed a quota that can't be read, but don't scale down
+		// running workers either.
+		log.Error(ctx, "could not read project compute quota; not scaling up pipeline", zap.Error(quotaErr))
+	}
 	// update pipeline RC
 	return errors.EnsureStack(pc.iDriver.UpdateReplicationController(ctx, oldRC, func(rc *v1.ReplicationController) bool {
 		var curScale int32
@@ -641,6 +665,27 @@ func (pc *pipelineController) scaleUpPipeline(ctx context.Context, pi *pps.Pipel
 				return maxScale
 			}
 		}()
+		if quotaErr != nil {
+			targetScale = min(targetScale, curScale)
+		} else if usage != nil {
+			// Cap the scale at what fits in the project's compute quota.  Running
+			// workers aren't preempted, so the quota never scales a pipeline below its
+			// current scale; a pipeline that doesn't fit waits for capacity.
+			capped, err := capScale(quota, usage, rc, targetScale)
+			if err != nil {
+				log.Error(ctx, "could not apply project compute quota; not scaling up pipeline", zap.Error(err))
+				targetScale = min(targetScale, curScale)
+			} else if capped < targetScale {
+				log.Debug(ctx, "pipeline scale capped by project compute quota", zap.Int32("targetScale", targetScale), zap.Int32("cappedScale", capped))
+				switch {
+				case curScale >= targetScale:
+				case curScale > capped:
+					targetScale = curScale
+				default:
+					targetScale = capped
+				}
+			}
+		}
 		if targetScale < maxScale {
 			// schedule another step in scaleUpInterval, to check the tasks again
 			go func() {
@@ -727,7 +772,7 @@ func (pc *pipelineController) deletePipelineResources() (retErr error) {
 func (pc *pipelineController) getRC(ctx context.Context, pi *pps.PipelineInfo) (rc *v1.ReplicationController, restart bool, retErr error) {
 	span, _ := tracing.AddSpanToAnyExisting(ctx,
 		"/pps.Master/GetRC", "project", pc.pipeline.GetProject().GetName(), "pipeline", pc.pipeline.GetName())
-	defer func(span opentracing.Span) {
+	defer func(span trace.Span) {
 		tracing.TagAnySpan(span, "err", fmt.Sprintf("%v", retErr))
 		tracing.FinishAnySpan(span)
 	}(span)
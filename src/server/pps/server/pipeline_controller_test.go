@@ -31,6 +31,9 @@ func ppsMasterHandles(t *testing.T) (*mockStateDriver, *mockInfraDriver, *testpa
 	sDriver := newMockStateDriver()
 	iDriver := newMockInfraDriver()
 	mockEnv := testpachd.NewMockEnv(ctx, t)
+	mockEnv.MockPachd.PFS.InspectProject.Use(func(_ context.Context, req *pfs.InspectProjectRequest) (*pfs.ProjectInfo, error) {
+		return &pfs.ProjectInfo{Project: req.Project}, nil
+	})
 	env := Env{
 		BackgroundContext: context.Background(),
 		EtcdClient:        mockEnv.EtcdClient,
@@ -312,6 +315,53 @@ func TestAutoscalingManyTasks(t *testing.T) {
 	require.ElementsEqual(t, []int32{0, 1, 100, 0}, infraDriver.scaleHistory[toKey(pi.Pipeline)])
 }
 
+func TestAutoscalingComputeQuota(t *testing.T) {
+	stateDriver, infraDriver, mockPachd := ppsMasterHandles(t)
+	pipelineName := tu.UniqueString(t.Name())
+	pipeline := client.NewPipeline(pfs.DefaultProjectName, pipelineName)
+	mockPachd.PFS.InspectProject.Use(func(_ context.Context, req *pfs.InspectProjectRequest) (*pfs.ProjectInfo, error) {
+		return &pfs.ProjectInfo{Project: req.Project, Quota: &pfs.ProjectQuota{MaxWorkers: 10}}, nil
+	})
+	done := mockJobRunning(mockPachd, 100, 1)
+	defer close(done)
+	mockPachd.PFS.InspectCommit.Use(func(context.Context, *pfs.InspectCommitRequest) (*pfs.CommitInfo, error) {
+		// wait for pipeline replica scale to update before closing the commit
+		require.NoError(t, backoff.Retry(func() error {
+			if len(infraDriver.scaleHistory[toKey(pipeline)]) > 2 {
+				return nil
+			}
+			return errors.New("waiting for scaleHistory to update")
+		}, backoff.NewTestingBackOff()))
+		return &pfs.CommitInfo{}, nil
+	})
+	pi := &pps.PipelineInfo{
+		Pipeline: pipeline,
+		State:    pps.PipelineState_PIPELINE_STARTING,
+		Details: &pps.PipelineInfo_Details{
+			Autoscaling: true,
+			ParallelismSpec: &pps.ParallelismSpec{
+				Constant: 300,
+			},
+		},
+		Version: 1,
+	}
+	stateDriver.upsertPipeline(pi)
+	validate(t, stateDriver, infraDriver, []pipelineTest{
+		{
+			key: toKey(pipeline),
+			expectedStates: []pps.PipelineState{
+				pps.PipelineState_PIPELINE_STARTING,
+				pps.PipelineState_PIPELINE_STANDBY,
+				pps.PipelineState_PIPELINE_RUNNING,
+				pps.PipelineState_PIPELINE_STANDBY,
+			},
+		},
+	})
+	// the pipeline's 100 tasks would scale it to 100 workers, but the
+	// project's quota only allows 10.
+	require.ElementsEqual(t, []int32{0, 1, 10, 0}, infraDriver.scaleHistory[toKey(pi.Pipeline)])
+}
+
 func TestAutoscalingNoCommits(t *testing.T) {
 	stateDriver, infraDriver, mockPachd := ppsMasterHandles(t)
 	pipelineName := tu.UniqueString(t.Name())
@@ -68,6 +68,10 @@ func NewAPIServer(env Env) (ppsiface.APIServer, error) {
 // loop in the background.
 func NewAPIServerNoMaster(env Env) (ppsiface.APIServer, error) {
 	config := env.Config
+	notificationAddresses, err := newNotificationAddresses(config.NotificationAllowedCIDRs, config.NotificationDeniedCIDRs)
+	if err != nil {
+		return nil, err
+	}
 	apiServer := &apiServer{
 		env:                   env,
 		txnEnv:                env.TxnEnv,
@@ -85,10 +89,12 @@ func NewAPIServerNoMaster(env Env) (ppsiface.APIServer, error) {
 		pipelines:             ppsdb.Pipelines(env.DB, env.Listener),
 		jobs:                  ppsdb.Jobs(env.DB, env.Listener),
 		clusterDefaults:       ppsdb.ClusterDefaults(env.DB, env.Listener),
+		notifications:         ppsdb.Notifications(env.DB, env.Listener),
 		workerGrpcPort:        config.PPSWorkerPort,
 		port:                  config.Port,
 		peerPort:              config.PeerPort,
 		gcPercent:             config.GCPercent,
+		notificationAddresses: notificationAddresses,
 	}
 	return apiServer, nil
 }
@@ -112,6 +118,7 @@ func NewSidecarAPIServer(
 		pipelines:       ppsdb.Pipelines(env.DB, env.Listener),
 		jobs:            ppsdb.Jobs(env.DB, env.Listener),
 		clusterDefaults: ppsdb.ClusterDefaults(env.DB, env.Listener),
+		notifications:   ppsdb.Notifications(env.DB, env.Listener),
 		workerGrpcPort:  workerGrpcPort,
 		peerPort:        peerPort,
 	}
@@ -25,6 +25,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 	workerstats "github.com/pachyderm/pachyderm/v2/src/server/worker/stats"
 	"github.com/pachyderm/pachyderm/v2/src/version"
@@ -59,6 +60,10 @@ const (
 	StorageMaxOpenFileSetsEnvVar               = "STORAGE_FILESETS_MAX_OPEN"
 	StorageDiskCacheSizeEnvVar                 = "STORAGE_DISK_CACHE_SIZE"
 	StorageMemoryCacheSizeEnvVar               = "STORAGE_MEMORY_CACHE_SIZE"
+	StorageTiersEnvVar                         = "STORAGE_TIERS"
+	StoragePeerCachePortEnvVar                 = "STORAGE_PEER_CACHE_PORT"
+	StoragePeerCacheHostEnvVar                 = "STORAGE_PEER_CACHE_HOST"
+	StoragePeerCacheSecretEnvVar               = "STORAGE_PEER_CACHE_SECRET"
 	SidecarMemoryRequestEnvVar                 = "K8S_MEMORY_REQUEST"
 	SidecarMemoryLimitEnvVar                   = "K8S_MEMORY_LIMIT"
 )
@@ -82,7 +87,9 @@ type workerOptions struct {
 	volumes                 []v1.Volume           // Volumes that we expose to the user container
 	volumeMounts            []v1.VolumeMount      // Paths where we mount each volume in 'volumes'
 	postgresSecret          *v1.SecretKeySelector // the reference to the postgres password
+	peerCacheSecret         *v1.SecretKeySelector // the reference to the peer cache secret, if pachd has one
 	schedulingSpec          *pps.SchedulingSpec   // the SchedulingSpec for the pipeline
+	priorityClassName       string                // the priority class of the project's compute quota, which overrides the SchedulingSpec's
 	podSpec                 string
 	podPatch                string
 
@@ -190,6 +197,7 @@ func (kd *kubeDriver) workerPodSpec(ctx context.Context, options *workerOptions,
 		},
 	}
 	commonEnv = append(commonEnv, log.WorkerLogConfig.AsKubernetesEnvironment()...)
+	commonEnv = append(commonEnv, tracing.KubernetesEnvironment()...)
 
 	// Set up sidecar env vars
 	sidecarEnv := []v1.EnvVar{{
@@ -211,7 +219,7 @@ func (kd *kubeDriver) workerPodSpec(ctx context.Context, options *workerOptions,
 		Value: strconv.FormatInt(int64(kd.config.GCPercent), 10),
 	}}
 
-	sidecarEnv = append(sidecarEnv, kd.getStorageEnvVars(pipelineInfo)...)
+	sidecarEnv = append(sidecarEnv, kd.getStorageEnvVars(options, pipelineInfo)...)
 	sidecarEnv = append(sidecarEnv, commonEnv...)
 	sidecarEnv = append(sidecarEnv, kd.getEgressSecretEnvVars(pipelineInfo)...)
 
@@ -491,6 +499,9 @@ func (kd *kubeDriver) workerPodSpec(ctx context.Context, options *workerOptions,
 		podSpec.NodeSelector = options.schedulingSpec.NodeSelector
 		podSpec.PriorityClassName = options.schedulingSpec.PriorityClassName
 	}
+	if options.priorityClassName != "" {
+		podSpec.PriorityClassName = options.priorityClassName
+	}
 
 	if options.resourceRequests != nil {
 		for k, v := range *options.resourceRequests {
@@ -575,7 +586,7 @@ func (kd *kubeDriver) workerPodSpec(ctx context.Context, options *workerOptions,
 	return podSpec, nil
 }
 
-func (kd *kubeDriver) getStorageEnvVars(pipelineInfo *pps.PipelineInfo) []v1.EnvVar {
+func (kd *kubeDriver) getStorageEnvVars(options *workerOptions, pipelineInfo *pps.PipelineInfo) []v1.EnvVar {
 	vars := []v1.EnvVar{
 		{Name: UploadConcurrencyLimitEnvVar, Value: strconv.Itoa(kd.config.StorageUploadConcurrencyLimit)},
 	}
@@ -627,6 +638,33 @@ func (kd *kubeDriver) getStorageEnvVars(pipelineInfo *pps.PipelineInfo) []v1.Env
 			Value: strconv.FormatInt(int64(kd.config.StorageMemoryCacheSize), 10),
 		})
 	}
+	if kd.config.StorageTiers != "" {
+		vars = append(vars, v1.EnvVar{
+			Name:  StorageTiersEnvVar,
+			Value: kd.config.StorageTiers,
+		})
+	}
+	if kd.config.StoragePeerCachePort != 0 && options.peerCacheSecret != nil {
+		// Sidecars join the same peer cache as pachd, at their pod's IP, with
+		// pachd's secret.
+		vars = append(vars, v1.EnvVar{
+			Name:  StoragePeerCachePortEnvVar,
+			Value: strconv.Itoa(int(kd.config.StoragePeerCachePort)),
+		}, v1.EnvVar{
+			Name: StoragePeerCacheHostEnvVar,
+			ValueFrom: &v1.EnvVarSource{
+				FieldRef: &v1.ObjectFieldSelector{
+					APIVersion: "v1",
+					FieldPath:  "status.podIP",
+				},
+			},
+		}, v1.EnvVar{
+			Name: StoragePeerCacheSecretEnvVar,
+			ValueFrom: &v1.EnvVarSource{
+				SecretKeyRef: options.peerCacheSecret,
+			},
+		})
+	}
 	return vars
 }
 
@@ -742,7 +780,12 @@ func (kd *kubeDriver) getWorkerOptions(ctx context.Context, pipelineInfo *pps.Pi
 	transform := pipelineInfo.Details.Transform
 	labels := pipelineLabels(projectName, pipelineName, pipelineVersion)
 	userImage := transform.Image
-	if userImage == "" {
+	if transform.Starlark != nil || transform.Builtin != nil {
+		// Starlark and builtin transforms run inside the worker binary, so
+		// there's no user image to pull; the worker image is already on the
+		// node.
+		userImage = kd.config.WorkerImage
+	} else if userImage == "" {
 		userImage = DefaultUserImage
 	}
 
@@ -866,17 +909,24 @@ func (kd *kubeDriver) getWorkerOptions(ctx context.Context, pipelineInfo *pps.Pi
 		s3GatewayPort = int32(kd.config.S3GatewayPort)
 	}
 
-	// Get the reference to the postgres secret used by the current pod
+	// Get the references to the postgres and peer cache secrets used by the
+	// current pod
 	podName := kd.config.PachdPodName
 	selfPodInfo, err := kd.kubeClient.CoreV1().Pods(kd.namespace).Get(ctx, podName, metav1.GetOptions{})
 	if err != nil {
 		return nil, errors.EnsureStack(err)
 	}
-	var postgresSecretRef *v1.SecretKeySelector
+	var postgresSecretRef, peerCacheSecretRef *v1.SecretKeySelector
 	for _, container := range selfPodInfo.Spec.Containers {
 		for _, envVar := range container.Env {
-			if envVar.Name == "POSTGRES_PASSWORD" && envVar.ValueFrom != nil && envVar.ValueFrom.SecretKeyRef != nil {
+			if envVar.ValueFrom == nil || envVar.ValueFrom.SecretKeyRef == nil {
+				continue
+			}
+			switch envVar.Name {
+			case "POSTGRES_PASSWORD":
 				postgresSecretRef = envVar.ValueFrom.SecretKeyRef
+			case StoragePeerCacheSecretEnvVar:
+				peerCacheSecretRef = envVar.ValueFrom.SecretKeyRef
 			}
 		}
 	}
@@ -899,6 +949,13 @@ func (kd *kubeDriver) getWorkerOptions(ctx context.Context, pipelineInfo *pps.Pi
 		return nil, tolErr
 	}
 
+	// The workers run at the priority class of the project's compute quota, if
+	// it has one.
+	projectInfo, err := kd.pfsServer.InspectProject(ctx, &pfs.InspectProjectRequest{Project: pipelineInfo.Pipeline.Project})
+	if err != nil {
+		return nil, errors.Wrap(err, "inspect project")
+	}
+
 	// Generate options for new RC
 	return &workerOptions{
 		rcName:                  ppsutil.PipelineRcName(pipelineInfo),
@@ -916,9 +973,11 @@ func (kd *kubeDriver) getWorkerOptions(ctx context.Context, pipelineInfo *pps.Pi
 		volumes:                 volumes,
 		volumeMounts:            volumeMounts,
 		postgresSecret:          postgresSecretRef,
+		peerCacheSecret:         peerCacheSecretRef,
 		imagePullSecrets:        imagePullSecrets,
 		service:                 service,
 		schedulingSpec:          pipelineInfo.Details.SchedulingSpec,
+		priorityClassName:       projectInfo.Quota.GetPriorityClassName(),
 		podSpec:                 pipelineInfo.Details.PodSpec,
 		podPatch:                pipelineInfo.Details.PodPatch,
 		tolerations:             tolerations,
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"regexp"
 	"testing"
 
@@ -10,6 +11,8 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -73,9 +76,25 @@ func mergeDefaultOptions(project, name string, add *workerOptions) *workerOption
 		base.resourceRequests = r
 	}
 	base.tolerations = append(base.tolerations, add.tolerations...)
+	if s := add.schedulingSpec; s != nil {
+		base.schedulingSpec = s
+	}
+	if p := add.priorityClassName; p != "" {
+		base.priorityClassName = p
+	}
 	return base
 }
 
+// fakeProjectServer returns the quotas of projects from InspectProject.
+type fakeProjectServer struct {
+	pfsserver.APIServer
+	quotas map[string]*pfs.ProjectQuota
+}
+
+func (s *fakeProjectServer) InspectProject(_ context.Context, req *pfs.InspectProjectRequest) (*pfs.ProjectInfo, error) {
+	return &pfs.ProjectInfo{Project: req.Project, Quota: s.quotas[req.Project.GetName()]}, nil
+}
+
 func TestGetWorkerOptions(t *testing.T) {
 	testData := []struct {
 		name        string
@@ -194,6 +213,26 @@ func TestGetWorkerOptions(t *testing.T) {
 				},
 			}),
 		},
+		{
+			name: "project priority class",
+			pipeline: &pps.PipelineInfo{
+				Pipeline: &pps.Pipeline{
+					Project: &pfs.Project{
+						Name: "quota",
+					},
+					Name: "priority",
+				},
+				Details: &pps.PipelineInfo_Details{
+					Transform:      &pps.Transform{},
+					SchedulingSpec: &pps.SchedulingSpec{PriorityClassName: "low"},
+				},
+				SpecCommit: &pfs.Commit{},
+			},
+			wantOptions: mergeDefaultOptions("quota", "priority", &workerOptions{
+				schedulingSpec:    &pps.SchedulingSpec{PriorityClassName: "low"},
+				priorityClassName: "high",
+			}),
+		},
 		{
 			name: "invalid toleration",
 			pipeline: &pps.PipelineInfo{
@@ -242,6 +281,11 @@ func TestGetWorkerOptions(t *testing.T) {
 			WorkerSpecificConfiguration:     &pachconfig.WorkerSpecificConfiguration{},
 			EnterpriseSpecificConfiguration: &pachconfig.EnterpriseSpecificConfiguration{},
 		},
+		pfsServer: &fakeProjectServer{
+			quotas: map[string]*pfs.ProjectQuota{
+				"quota": {PriorityClassName: "high"},
+			},
+		},
 		kubeClient: fake.NewSimpleClientset(&v1.Pod{
 			// Minimal pachd pod for some introspection that happens.
 			ObjectMeta: metav1.ObjectMeta{
@@ -288,7 +332,7 @@ func TestGetWorkerOptions(t *testing.T) {
 				}
 				return
 			}
-			if diff := cmp.Diff(got, test.wantOptions, cmp.AllowUnexported(workerOptions{})); diff != "" {
+			if diff := cmp.Diff(got, test.wantOptions, cmp.AllowUnexported(workerOptions{}), protocmp.Transform()); diff != "" {
 				t.Errorf("options (+got -want):\n%s", diff)
 			}
 		})
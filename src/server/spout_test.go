@@ -184,6 +184,59 @@ func TestSpoutPachctl(t *testing.T) {
 		require.Equal(t, pps.PipelineState_PIPELINE_RUNNING, pipelineInfo.State)
 	})
 
+	t.Run("SpoutExactlyOnce", func(t *testing.T) {
+		c, _ := minikubetestenv.AcquireCluster(t)
+
+		// The spout writes one message per commit, and crashes once after
+		// writing the third message, before finishing its commit.
+		pipeline := tu.UniqueString("pipelinespoutexactlyonce")
+		_, err := c.PpsAPIClient.CreatePipeline(
+			c.Ctx(),
+			&pps.CreatePipelineRequest{
+				Pipeline: client.NewPipeline(pfs.DefaultProjectName, pipeline),
+				Transform: &pps.Transform{
+					Cmd: []string{"/bin/sh"},
+					Stdin: []string{
+						"n=${PACH_SPOUT_CHECKPOINT:-0}",
+						"while [ $n -lt 5 ]",
+						"do",
+						"n=$((n+1))",
+						"pachctl start commit $PPS_PIPELINE_NAME@master",
+						"echo $n > message",
+						"pachctl put file $PPS_PIPELINE_NAME@master:/messages -a -f message",
+						"if [ $n -eq 3 ] && [ ! -f /tmp/crashed ]; then touch /tmp/crashed; exit 1; fi",
+						"pachctl finish commit $PPS_PIPELINE_NAME@master --checkpoint $n",
+						"done",
+						"sleep infinity"},
+				},
+				Spout: &pps.Spout{ExactlyOnce: true},
+			})
+		require.NoError(t, err)
+
+		var last *pfs.CommitInfo
+		require.NoError(t, c.SubscribeCommit(client.NewRepo(pfs.DefaultProjectName, pipeline), "master", "", pfs.CommitState_FINISHED, func(ci *pfs.CommitInfo) error {
+			if ci.Checkpoint == "5" {
+				last = ci
+				return errutil.ErrBreak
+			}
+			return nil
+		}))
+		// The third message was written again after the restart, but only
+		// the rewrite was committed.
+		var buf bytes.Buffer
+		require.NoError(t, c.GetFile(last.Commit, "messages", &buf))
+		require.Equal(t, "1\n2\n3\n4\n5\n", buf.String())
+		commitInfos, err := c.ListCommit(client.NewRepo(pfs.DefaultProjectName, pipeline), last.Commit, nil, 0)
+		require.NoError(t, err)
+		var abandoned int
+		for _, ci := range commitInfos {
+			if ci.Error != "" {
+				abandoned++
+			}
+		}
+		require.Equal(t, 1, abandoned)
+	})
+
 	testSpout(t, true)
 }
 
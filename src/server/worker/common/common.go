@@ -6,6 +6,7 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"path"
+	"strings"
 	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
@@ -33,6 +34,14 @@ func MetaFilePath(id string) string {
 	return path.Join(MetaPrefix, id, MetaFileName)
 }
 
+// InputDirName returns the name of the directory under /pfs that an input is
+// presented in.  The inputs of a windowed PFS input are named
+// <name>/<commit ID>, and all share the PFS input's directory.
+func InputDirName(input *Input) string {
+	name, _, _ := strings.Cut(input.Name, "/")
+	return name
+}
+
 // DatumID computes the ID of a datum.
 func DatumID(inputs []*Input) string {
 	hash := pfs.NewHash()
@@ -38,6 +38,9 @@ func Create(ctx context.Context, c pfs.APIClient, taskDoer task.Doer, input *pps
 }
 
 func createPFS(ctx context.Context, c pfs.APIClient, taskDoer task.Doer, input *pps.PFSInput) (string, error) {
+	if isHistoryInput(input) {
+		return createPFSHistory(ctx, taskDoer, input)
+	}
 	authToken := getAuthToken(ctx)
 	var outputFileSetID string
 	if err := client.WithRenewer(ctx, c, func(ctx context.Context, renewer *renew.StringSet) error {
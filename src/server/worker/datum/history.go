@@ -0,0 +1,267 @@
+package datum
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/task"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
+)
+
+// PreviousOutputSuffix is appended to the name of an incremental input to get
+// the name of the input that presents the previous output.
+const PreviousOutputSuffix = "_previous_output"
+
+// isHistoryInput returns whether the datums of a PFS input depend on more than
+// the input commit, in which case they are computed by a single PFS task
+// rather than one per shard of the input commit.
+func isHistoryInput(input *pps.PFSInput) bool {
+	return input.Window != nil || (input.Incremental && input.PreviousOutput != nil)
+}
+
+func createPFSHistory(ctx context.Context, taskDoer task.Doer, input *pps.PFSInput) (string, error) {
+	taskInput, err := serializePFSTask(&PFSTask{
+		Input:     input,
+		BaseIndex: createBaseIndex(0),
+		AuthToken: getAuthToken(ctx),
+	})
+	if err != nil {
+		return "", err
+	}
+	output, err := task.DoOne(ctx, taskDoer, taskInput)
+	if err != nil {
+		return "", err
+	}
+	result, err := deserializePFSTaskResult(output)
+	if err != nil {
+		return "", err
+	}
+	return result.FileSetId, nil
+}
+
+func newPFSInput(input *pps.PFSInput, name string, fi *pfs.FileInfo) *common.Input {
+	return &common.Input{
+		FileInfo:   fi,
+		Name:       name,
+		Lazy:       input.Lazy,
+		Branch:     input.Branch,
+		EmptyFiles: input.EmptyFiles,
+	}
+}
+
+// globFiles returns the files in commit that match the input's glob.
+func globFiles(ctx context.Context, c pfs.APIClient, commit *pfs.Commit, pattern string) ([]*pfs.FileInfo, error) {
+	globClient, err := c.GlobFile(ctx, &pfs.GlobFileRequest{
+		Commit:  commit,
+		Pattern: pattern,
+	})
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	var fis []*pfs.FileInfo
+	if err := grpcutil.ForEach[*pfs.FileInfo](globClient, func(fi *pfs.FileInfo) error {
+		fis = append(fis, proto.Clone(fi).(*pfs.FileInfo))
+		return nil
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return fis, nil
+}
+
+// processPFSWindowTask creates a datum for each path matched by the input's
+// glob in any commit of the window.  The datum has an input for each commit
+// the path exists in, named <name>/<commit ID>.
+func processPFSWindowTask(ctx context.Context, c pfs.APIClient, task *PFSTask) (*anypb.Any, error) {
+	input := task.Input
+	cis, err := windowCommits(ctx, c, input)
+	if err != nil {
+		return nil, err
+	}
+	matches := make(map[string][]*common.Input)
+	for _, ci := range cis {
+		fis, err := globFiles(ctx, c, ci.Commit, input.Glob)
+		if err != nil {
+			return nil, err
+		}
+		for _, fi := range fis {
+			p := strings.TrimRight(fi.File.Path, "/")
+			matches[p] = append(matches[p], newPFSInput(input, path.Join(input.Name, ci.Commit.Id), fi))
+		}
+	}
+	var paths []string
+	for p := range matches {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	fileSetID, err := WithCreateFileSet(ctx, c, "pachyderm-datums-pfs-window", func(s *Set) error {
+		index := task.BaseIndex
+		for _, p := range paths {
+			if err := s.UploadMeta(&Meta{Inputs: matches[p], Index: index}); err != nil {
+				return err
+			}
+			index++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return serializePFSTaskResult(&PFSTaskResult{FileSetId: fileSetID})
+}
+
+// windowCommits returns the commits in the input's window, newest first.
+func windowCommits(ctx context.Context, c pfs.APIClient, input *pps.PFSInput) ([]*pfs.CommitInfo, error) {
+	ci, err := c.InspectCommit(ctx, &pfs.InspectCommitRequest{
+		Commit: client.NewCommit(input.Project, input.Repo, input.Branch, input.Commit),
+		Wait:   pfs.CommitState_FINISHED,
+	})
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	var start time.Time
+	if input.Window.Duration != nil {
+		start = ci.Started.AsTime().Add(-input.Window.Duration.AsDuration())
+	}
+	var cis []*pfs.CommitInfo
+	for {
+		cis = append(cis, ci)
+		if (input.Window.Commits > 0 && int64(len(cis)) >= input.Window.Commits) || ci.ParentCommit == nil {
+			return cis, nil
+		}
+		ci, err = c.InspectCommit(ctx, &pfs.InspectCommitRequest{
+			Commit: ci.ParentCommit,
+			Wait:   pfs.CommitState_FINISHED,
+		})
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		if input.Window.Duration != nil && ci.Started.AsTime().Before(start) {
+			return cis, nil
+		}
+	}
+}
+
+// processPFSIncrementalTask creates a datum for each path matched by the
+// input's glob that contains files which were added or changed since the
+// input commit processed by the previous job.  The datum has an input for each
+// of those files, and an input for the previous output.
+func processPFSIncrementalTask(ctx context.Context, c pfs.APIClient, task *PFSTask) (*anypb.Any, error) {
+	input := task.Input
+	commit := client.NewCommit(input.Project, input.Repo, input.Branch, input.Commit)
+	baseInfo, err := c.InspectCommit(ctx, &pfs.InspectCommitRequest{
+		Commit: client.NewCommit(input.Project, input.Repo, input.Branch, input.PreviousOutput.Id),
+	})
+	if err != nil {
+		if !pfsserver.IsCommitNotFoundErr(err) {
+			return nil, errors.EnsureStack(err)
+		}
+		// The input wasn't part of the previous job, so all of it is new.
+		task = proto.Clone(task).(*PFSTask)
+		task.Input.Incremental = false
+		task.Input.PreviousOutput = nil
+		return processPFSTask(ctx, c, task)
+	}
+	changed, err := changedFiles(ctx, c, commit, baseInfo.Commit)
+	if err != nil {
+		return nil, err
+	}
+	previousOutput, err := c.InspectFile(ctx, &pfs.InspectFileRequest{File: input.PreviousOutput.NewFile("/")})
+	if err != nil {
+		if !errutil.IsNotFoundError(err) {
+			return nil, errors.EnsureStack(err)
+		}
+		// The previous job didn't output anything.
+		previousOutput = &pfs.FileInfo{
+			File:     input.PreviousOutput.NewFile("/"),
+			FileType: pfs.FileType_DIR,
+		}
+	}
+	previousOutputInput := newPFSInput(input, input.Name+PreviousOutputSuffix, previousOutput)
+	previousOutputInput.Lazy = false
+	previousOutputInput.EmptyFiles = false
+	var matches []*pfs.FileInfo
+	if len(changed) > 0 {
+		if matches, err = globFiles(ctx, c, commit, input.Glob); err != nil {
+			return nil, err
+		}
+	}
+	fileSetID, err := WithCreateFileSet(ctx, c, "pachyderm-datums-pfs-incremental", func(s *Set) error {
+		index := task.BaseIndex
+		for _, match := range matches {
+			var inputs []*common.Input
+			for _, fi := range filesUnder(changed, match) {
+				inputs = append(inputs, newPFSInput(input, input.Name, fi))
+			}
+			if len(inputs) == 0 {
+				continue
+			}
+			inputs = append(inputs, previousOutputInput)
+			if err := s.UploadMeta(&Meta{Inputs: inputs, Index: index}); err != nil {
+				return err
+			}
+			index++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return serializePFSTaskResult(&PFSTaskResult{FileSetId: fileSetID})
+}
+
+// changedFiles returns the files in commit that were added or changed since
+// base, sorted by path.
+func changedFiles(ctx context.Context, c pfs.APIClient, commit, base *pfs.Commit) ([]*pfs.FileInfo, error) {
+	diffClient, err := c.DiffFile(ctx, &pfs.DiffFileRequest{
+		NewFile: commit.NewFile("/"),
+		OldFile: base.NewFile("/"),
+	})
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	var changed []*pfs.FileInfo
+	if err := grpcutil.ForEach[*pfs.DiffFileResponse](diffClient, func(resp *pfs.DiffFileResponse) error {
+		if resp.NewFile == nil || resp.NewFile.FileType != pfs.FileType_FILE {
+			return nil
+		}
+		changed = append(changed, proto.Clone(resp.NewFile).(*pfs.FileInfo))
+		return nil
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	sort.Slice(changed, func(i, j int) bool { return changed[i].File.Path < changed[j].File.Path })
+	return changed, nil
+}
+
+// filesUnder returns the files in sorted that are match, or are under it if
+// it is a directory.
+func filesUnder(sorted []*pfs.FileInfo, match *pfs.FileInfo) []*pfs.FileInfo {
+	prefix := strings.TrimRight(match.File.Path, "/")
+	if match.FileType == pfs.FileType_FILE {
+		i := sort.Search(len(sorted), func(i int) bool { return sorted[i].File.Path >= prefix })
+		if i < len(sorted) && sorted[i].File.Path == prefix {
+			return sorted[i : i+1]
+		}
+		return nil
+	}
+	prefix += "/"
+	i := sort.Search(len(sorted), func(i int) bool { return sorted[i].File.Path >= prefix })
+	j := i
+	for j < len(sorted) && strings.HasPrefix(sorted[j].File.Path, prefix) {
+		j++
+	}
+	return sorted[i:j]
+}
@@ -0,0 +1,37 @@
+package datum
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+func TestFilesUnder(t *testing.T) {
+	var sorted []*pfs.FileInfo
+	for _, p := range []string{"/a", "/b/c", "/b/d", "/bc", "/e/f/g"} {
+		sorted = append(sorted, &pfs.FileInfo{File: &pfs.File{Path: p}, FileType: pfs.FileType_FILE})
+	}
+	paths := func(fis []*pfs.FileInfo) []string {
+		var result []string
+		for _, fi := range fis {
+			result = append(result, fi.File.Path)
+		}
+		return result
+	}
+	for _, tc := range []struct {
+		match    string
+		fileType pfs.FileType
+		expected []string
+	}{
+		{"/a", pfs.FileType_FILE, []string{"/a"}},
+		{"/b", pfs.FileType_FILE, nil},
+		{"/b/", pfs.FileType_DIR, []string{"/b/c", "/b/d"}},
+		{"/e/", pfs.FileType_DIR, []string{"/e/f/g"}},
+		{"/z/", pfs.FileType_DIR, nil},
+		{"/", pfs.FileType_DIR, []string{"/a", "/b/c", "/b/d", "/bc", "/e/f/g"}},
+	} {
+		match := &pfs.FileInfo{File: &pfs.File{Path: tc.match}, FileType: tc.fileType}
+		require.ElementsEqual(t, tc.expected, paths(filesUnder(sorted, match)), "match %v", tc.match)
+	}
+}
@@ -113,6 +113,12 @@ func ProcessTask(ctx context.Context, c pfs.APIClient, input *anypb.Any) (*anypb
 }
 
 func processPFSTask(ctx context.Context, c client.PfsAPIClient, task *PFSTask) (*anypb.Any, error) {
+	if task.Input.Window != nil {
+		return processPFSWindowTask(ctx, c, task)
+	}
+	if task.Input.Incremental && task.Input.PreviousOutput != nil {
+		return processPFSIncrementalTask(ctx, c, task)
+	}
 	fileSetID, err := WithCreateFileSet(ctx, c, "pachyderm-datums-pfs", func(s *Set) error {
 		commit := client.NewCommit(task.Input.Project, task.Input.Repo, task.Input.Branch, task.Input.Commit)
 		client, err := c.GlobFile(
@@ -28,10 +28,13 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/proc"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/task"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transforms"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/logs"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/transformstar"
 )
 
 // TODO(2.0 optional):
@@ -84,8 +87,9 @@ type Driver interface {
 	WithActiveData([]*common.Input, string, func() error) error
 
 	// UserCodeEnv returns the set of environment variables to construct when
-	// launching the configured user process.
-	UserCodeEnv(string, *pfs.Commit, []*common.Input, string) []string
+	// launching the configured user process.  If the context carries a trace,
+	// the user process is passed its W3C trace context.
+	UserCodeEnv(context.Context, string, *pfs.Commit, []*common.Input, string) []string
 
 	RunUserCode(context.Context, logs.TaggedLogger, []string) error
 
@@ -345,6 +349,12 @@ func (d *driver) RunUserCode(
 			logger.Logf("finished running user code after %v", time.Since(start))
 		}
 	}(time.Now())
+	if d.pipelineInfo.Details.Transform.Starlark != nil {
+		return d.runStarlark(ctx, logger, environ)
+	}
+	if d.pipelineInfo.Details.Transform.Builtin != nil {
+		return errors.EnsureStack(transforms.RunBuiltin(ctx, d.pipelineInfo.Details.Transform.Builtin, d.InputDir()))
+	}
 	if len(d.pipelineInfo.Details.Transform.Cmd) == 0 {
 		return errors.New("invalid pipeline transform, no command specified")
 	}
@@ -413,6 +423,18 @@ func (d *driver) RunUserCode(
 	return nil
 }
 
+// runStarlark runs the transform's Starlark script in the worker process,
+// against the active datum.
+func (d *driver) runStarlark(ctx context.Context, logger logs.TaggedLogger, environ []string) error {
+	stdout := logger.WithUserCode().Writer("stdout")
+	defer stdout.Close()
+	return errors.EnsureStack(transformstar.Run(ctx, d.pipelineInfo.Details.Transform.Starlark, &transformstar.Env{
+		InputDir: d.InputDir(),
+		Environ:  environ,
+		Stdout:   stdout,
+	}))
+}
+
 func (d *driver) RunUserErrorHandlingCode(
 	ctx context.Context,
 	logger logs.TaggedLogger,
@@ -530,16 +552,17 @@ func (d *driver) unlinkData(inputs []*common.Input) error {
 	return nil
 }
 
-func (d *driver) UserCodeEnv(
-	jobID string,
-	outputCommit *pfs.Commit,
-	inputs []*common.Input,
-	pachToken string,
-) []string {
-	result := os.Environ()
-
+// DatumEnv returns the environment variables that describe a datum's inputs to
+// user code, given the directory the inputs are downloaded to.
+func DatumEnv(inputDir string, inputs []*common.Input) []string {
+	var result []string
 	for _, input := range inputs {
-		result = append(result, fmt.Sprintf("%s=%s", input.Name, filepath.Join(d.InputDir(), input.Name, input.FileInfo.File.Path)))
+		if name := common.InputDirName(input); name != input.Name {
+			// A windowed input exposes a directory per commit.
+			result = append(result, fmt.Sprintf("%s=%s", name, filepath.Join(inputDir, name)))
+			continue
+		}
+		result = append(result, fmt.Sprintf("%s=%s", input.Name, filepath.Join(inputDir, input.Name, input.FileInfo.File.Path)))
 		result = append(result, fmt.Sprintf("%s_COMMIT=%s", input.Name, input.FileInfo.File.Commit.Id))
 		if input.JoinOn != "" {
 			result = append(result, fmt.Sprintf("PACH_DATUM_%s_JOIN_ON=%s", input.Name, input.JoinOn))
@@ -548,7 +571,18 @@ func (d *driver) UserCodeEnv(
 			result = append(result, fmt.Sprintf("PACH_DATUM_%s_GROUP_BY=%s", input.Name, input.GroupBy))
 		}
 	}
-	result = append(result, fmt.Sprintf("%s=%s", client.DatumIDEnv, common.DatumID(inputs)))
+	return append(result, fmt.Sprintf("%s=%s", client.DatumIDEnv, common.DatumID(inputs)))
+}
+
+func (d *driver) UserCodeEnv(
+	ctx context.Context,
+	jobID string,
+	outputCommit *pfs.Commit,
+	inputs []*common.Input,
+	pachToken string,
+) []string {
+	result := os.Environ()
+	result = append(result, DatumEnv(d.InputDir(), inputs)...)
 
 	if jobID != "" {
 		result = append(result, fmt.Sprintf("%s=%s", client.JobIDEnv, jobID))
@@ -596,6 +630,7 @@ func (d *driver) UserCodeEnv(
 	if outputCommit != nil {
 		result = append(result, fmt.Sprintf("%s=%s", client.OutputCommitIDEnv, outputCommit.Id))
 	}
+	result = append(result, tracing.Environ(ctx)...)
 	return result
 }
 
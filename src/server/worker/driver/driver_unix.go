@@ -167,10 +167,10 @@ func (d *driver) linkData(inputs []*common.Input, dir string) error {
 		if input.Name == "" {
 			return errors.New("input does not have a name")
 		}
-		if _, ok := seen[input.Name]; !ok {
-			seen[input.Name] = true
-			src := filepath.Join(dir, input.Name)
-			dst := filepath.Join(d.InputDir(), input.Name)
+		if name := common.InputDirName(input); !seen[name] {
+			seen[name] = true
+			src := filepath.Join(dir, name)
+			dst := filepath.Join(d.InputDir(), name)
 			if err := os.Symlink(src, dst); err != nil {
 				return errors.EnsureStack(err)
 			}
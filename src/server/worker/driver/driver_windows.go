@@ -64,10 +64,10 @@ func (d *driver) moveData(inputs []*common.Input, dir string) error {
 	// sometimes for group inputs, this part may get run multiple times for the same file
 	seen := make(map[string]bool)
 	for _, input := range inputs {
-		if _, ok := seen[input.Name]; !ok {
-			seen[input.Name] = true
-			src := filepath.Join(dir, input.Name)
-			dst := filepath.Join(d.InputDir(), input.Name)
+		if name := common.InputDirName(input); !seen[name] {
+			seen[name] = true
+			src := filepath.Join(dir, name)
+			dst := filepath.Join(d.InputDir(), name)
 			if err := os.Rename(src, dst); err != nil {
 				return errors.EnsureStack(err)
 			}
@@ -0,0 +1,310 @@
+// Package local runs a pipeline's transform on the local machine, outside of
+// Kubernetes.  Datums are computed from the pipeline's input against real
+// commits, downloaded into a local directory laid out like /pfs, and handed
+// to the transform's command as a subprocess with the environment a worker
+// would set.  It exists to make debugging user code fast; it does not
+// replicate the worker's container, image, secrets or resource limits.
+package local
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfssync"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
+	"github.com/pachyderm/pachyderm/v2/src/internal/task"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transforms"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/datum"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/driver"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/transformstar"
+)
+
+// Config configures a local run.
+type Config struct {
+	// Dir is the directory that stands in for /pfs.  Each datum's inputs are
+	// downloaded to Dir/<input name> and its output is written to Dir/out.
+	Dir string
+	// DatumID, if set, restricts the run to the datum with that ID.
+	DatumID string
+	// Output, if set, is the branch that the output of the run is uploaded
+	// to, in a single commit.
+	Output *pfs.Branch
+	// Stdout and Stderr receive the output of the transform.
+	Stdout, Stderr io.Writer
+}
+
+// Result is the outcome of running the transform on a single datum.
+type Result struct {
+	DatumID string
+	// Err is the error the transform failed with, if any.
+	Err error
+}
+
+// Run runs the transform of the pipeline described by req on each datum of
+// its input, calling cb with the result of each datum.  A datum that fails
+// does not stop the run; errors returned by Run are errors setting up or
+// tearing down the run, or errors returned by cb.
+func Run(ctx context.Context, c *client.APIClient, req *pps.CreatePipelineRequest, config *Config, cb func(*Result) error) (retErr error) {
+	if req.Transform == nil || (len(req.Transform.Cmd) == 0 && req.Transform.Starlark == nil && req.Transform.Builtin == nil) {
+		return errors.New("invalid pipeline transform, no command specified")
+	}
+	if req.Input == nil {
+		return errors.New("pipeline has no input")
+	}
+	input := proto.Clone(req.Input).(*pps.Input)
+	setInputDefaults(req.Pipeline, input)
+	var outputCommit *pfs.Commit
+	if config.Output != nil {
+		var err error
+		outputCommit, err = startOutputCommit(c, config.Output)
+		if err != nil {
+			return err
+		}
+		// Always finish the output commit so that it doesn't block the
+		// output branch, but with an error if the run failed so that its
+		// partial output isn't used.
+		defer func() {
+			errors.JoinInto(&retErr, finishOutputCommit(c, outputCommit, retErr))
+		}()
+	}
+	doer := task.NewLocalDoer(func(ctx context.Context, input *anypb.Any) (*anypb.Any, error) {
+		if !datum.IsTask(input) {
+			return nil, errors.Errorf("unrecognized any type (%v) in local run", input.TypeUrl)
+		}
+		return datum.ProcessTask(ctx, c.PfsAPIClient, input)
+	})
+	var found bool
+	if err := client.WithRenewer(ctx, c.PfsAPIClient, func(ctx context.Context, renewer *renew.StringSet) error {
+		c := c.WithCtx(ctx)
+		// This is datum.NewIterator, except that the datum file set is kept
+		// alive for as long as the transform takes to run.
+		fileSetID, err := datum.Create(ctx, c.PfsAPIClient, doer, input)
+		if err != nil {
+			return err
+		}
+		if err := renewer.Add(ctx, fileSetID); err != nil {
+			return err
+		}
+		cacheClient := pfssync.NewCacheClient(c, renewer)
+		di := datum.NewFileSetIterator(ctx, c.PfsAPIClient, fileSetID, nil)
+		return errors.EnsureStack(di.Iterate(func(meta *datum.Meta) error {
+			id := common.DatumID(meta.Inputs)
+			if config.DatumID != "" && id != config.DatumID {
+				return nil
+			}
+			found = true
+			err := runDatum(ctx, cacheClient, req.Transform, meta.Inputs, outputCommit, config)
+			if err == nil && outputCommit != nil {
+				err = uploadOutput(c, outputCommit, filepath.Join(config.Dir, common.OutputPrefix))
+			}
+			return cb(&Result{DatumID: id, Err: err})
+		}))
+	}); err != nil {
+		return err
+	}
+	if config.DatumID != "" && !found {
+		return errors.Errorf("datum %s not found", config.DatumID)
+	}
+	return nil
+}
+
+// setInputDefaults fills in the defaults pachd sets on a pipeline's input
+// when the pipeline is created.
+func setInputDefaults(pipeline *pps.Pipeline, input *pps.Input) {
+	pps.SortInput(input)
+	pps.VisitInput(input, func(input *pps.Input) error { //nolint:errcheck
+		if input.Pfs != nil {
+			if input.Pfs.Project == "" {
+				input.Pfs.Project = pipeline.GetProject().GetName()
+			}
+			if input.Pfs.Branch == "" {
+				input.Pfs.Branch = "master"
+			}
+			if input.Pfs.Name == "" {
+				input.Pfs.Name = input.Pfs.Repo
+			}
+			if input.Pfs.RepoType == "" {
+				input.Pfs.RepoType = pfs.UserRepoType
+			}
+		}
+		if input.Cron != nil {
+			if input.Cron.Project == "" {
+				input.Cron.Project = pipeline.GetProject().GetName()
+			}
+			if input.Cron.Repo == "" {
+				input.Cron.Repo = fmt.Sprintf("%s_%s", pipeline.GetName(), input.Cron.Name)
+			}
+		}
+		return nil
+	})
+}
+
+// startOutputCommit starts a commit on branch that contains only the output
+// of this run.
+func startOutputCommit(c *client.APIClient, branch *pfs.Branch) (*pfs.Commit, error) {
+	commit, err := c.StartCommit(branch.Repo.Project.GetName(), branch.Repo.Name, branch.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.DeleteFile(commit, "/"); err != nil {
+		return nil, err
+	}
+	return commit, nil
+}
+
+// finishOutputCommit finishes the output commit of a run, with runErr as its
+// error if the run failed.
+func finishOutputCommit(c *client.APIClient, commit *pfs.Commit, runErr error) error {
+	req := &pfs.FinishCommitRequest{Commit: commit}
+	if runErr != nil {
+		req.Error = fmt.Sprintf("local run failed: %v", runErr)
+	}
+	_, err := c.PfsAPIClient.FinishCommit(c.Ctx(), req)
+	return errors.Wrapf(err, "finish output commit %v", commit)
+}
+
+// runDatum downloads the inputs of a datum into config.Dir and runs the
+// transform on them.
+func runDatum(ctx context.Context, cacheClient *pfssync.CacheClient, transform *pps.Transform, inputs []*common.Input, outputCommit *pfs.Commit, config *Config) error {
+	// Only remove what a previous datum put in the directory, in case the
+	// user pointed it at a directory that has other things in it.
+	dirs := []string{common.OutputPrefix}
+	for _, input := range inputs {
+		dirs = append(dirs, input.Name)
+	}
+	for _, dir := range dirs {
+		if err := os.RemoveAll(filepath.Join(config.Dir, dir)); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	if err := os.MkdirAll(filepath.Join(config.Dir, common.OutputPrefix), 0777); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return pfssync.WithDownloader(cacheClient, func(downloader pfssync.Downloader) error {
+		for _, input := range inputs {
+			inputPath := filepath.Join(config.Dir, input.Name)
+			if input.S3 {
+				if err := os.MkdirAll(inputPath, 0700); err != nil {
+					return errors.EnsureStack(err)
+				}
+				continue
+			}
+			// Lazy inputs are downloaded eagerly; named pipes only add
+			// confusion when debugging.
+			var opts []pfssync.DownloadOption
+			if input.EmptyFiles {
+				opts = append(opts, pfssync.WithEmpty())
+			}
+			if err := downloader.Download(inputPath, input.FileInfo.File, opts...); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		env := UserCodeEnv(config.Dir, transform, inputs, outputCommit)
+		if transform.Starlark != nil {
+			return errors.EnsureStack(transformstar.Run(ctx, transform.Starlark, &transformstar.Env{
+				InputDir: config.Dir,
+				Environ:  env,
+				Stdout:   config.Stdout,
+			}))
+		}
+		if transform.Builtin != nil {
+			return errors.EnsureStack(transforms.RunBuiltin(ctx, transform.Builtin, config.Dir))
+		}
+		return RunTransform(ctx, transform, env, config.Stdout, config.Stderr)
+	})
+}
+
+// UserCodeEnv returns the environment that the transform is run with: the
+// environment of the current process, the transform's env, and the variables
+// a worker sets for the datum.
+func UserCodeEnv(inputDir string, transform *pps.Transform, inputs []*common.Input, outputCommit *pfs.Commit) []string {
+	result := os.Environ()
+	var keys []string
+	for k := range transform.Env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		result = append(result, fmt.Sprintf("%s=%s", k, transform.Env[k]))
+	}
+	result = append(result, driver.DatumEnv(inputDir, inputs)...)
+	if outputCommit != nil {
+		result = append(result, fmt.Sprintf("%s=%s", client.OutputCommitIDEnv, outputCommit.Id))
+	}
+	return result
+}
+
+// RunTransform runs the transform's command as a subprocess of the current
+// process in the current working directory, feeding it the transform's stdin.
+// As in a worker, exiting with one of the transform's accepted return codes is
+// not an error.
+func RunTransform(ctx context.Context, transform *pps.Transform, env []string, stdout, stderr io.Writer) error {
+	if len(transform.Cmd) == 0 {
+		return errors.New("invalid pipeline transform, no command specified")
+	}
+	cmd := exec.CommandContext(ctx, transform.Cmd[0], transform.Cmd[1:]...)
+	if transform.Stdin != nil {
+		cmd.Stdin = strings.NewReader(strings.Join(transform.Stdin, "\n") + "\n")
+	}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	cmd.Env = env
+	err := cmd.Run()
+	// As in the worker, broken pipe errors are ignored, since they occur when
+	// the transform doesn't read all of its stdin.
+	if err != nil && !strings.Contains(err.Error(), "broken pipe") {
+		exiterr := &exec.ExitError{}
+		if errors.As(err, &exiterr) {
+			if status, ok := exiterr.Sys().(syscall.WaitStatus); ok {
+				for _, returnCode := range transform.AcceptReturnCode {
+					if int(returnCode) == status.ExitStatus() {
+						return nil
+					}
+				}
+			}
+		}
+		return errors.EnsureStack(err)
+	}
+	return nil
+}
+
+// uploadOutput appends the files in dir to commit.  Appending merges the
+// output of datums the same way a job does.
+func uploadOutput(c *client.APIClient, commit *pfs.Commit, dir string) error {
+	return c.WithModifyFileClient(commit, func(mf client.ModifyFile) error {
+		return errors.EnsureStack(filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.Type().IsRegular() {
+				return nil
+			}
+			rel, err := filepath.Rel(dir, p)
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			f, err := os.Open(p)
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			defer f.Close()
+			return mf.PutFile(filepath.ToSlash(rel), f, client.WithAppendPutFile())
+		}))
+	})
+}
@@ -0,0 +1,84 @@
+//go:build unix
+
+package local
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
+)
+
+func TestRunTransform(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	dir := t.TempDir()
+	transform := &pps.Transform{
+		Cmd:   []string{"sh"},
+		Stdin: []string{"cat $images > $out/copy", "echo $FOO $PACH_DATUM_ID"},
+		Env:   map[string]string{"FOO": "bar", "out": filepath.Join(dir, "out")},
+	}
+	inputs := []*common.Input{{
+		Name:     "images",
+		FileInfo: &pfs.FileInfo{File: client.NewFile(pfs.DefaultProjectName, "images", "master", "abc", "/1.png")},
+	}}
+	require.NoError(t, writeFile(filepath.Join(dir, "images", "1.png"), "data"))
+	require.NoError(t, writeFile(filepath.Join(dir, "out", ".keep"), ""))
+	stdout := &bytes.Buffer{}
+	env := UserCodeEnv(dir, transform, inputs, nil)
+	require.NoError(t, RunTransform(ctx, transform, env, stdout, &bytes.Buffer{}))
+	require.Equal(t, "bar "+common.DatumID(inputs)+"\n", stdout.String())
+	data, err := os.ReadFile(filepath.Join(dir, "out", "copy"))
+	require.NoError(t, err)
+	require.Equal(t, "data", string(data))
+	require.True(t, slices.Contains(env, "images_COMMIT=abc"))
+	require.True(t, slices.Contains(env, "images="+filepath.Join(dir, "images", "1.png")))
+}
+
+func TestRunTransformReturnCode(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	transform := &pps.Transform{
+		Cmd:   []string{"sh"},
+		Stdin: []string{"echo oops >&2", "exit 3"},
+	}
+	stderr := &bytes.Buffer{}
+	require.YesError(t, RunTransform(ctx, transform, nil, &bytes.Buffer{}, stderr))
+	require.Equal(t, "oops\n", stderr.String())
+	transform.AcceptReturnCode = []int64{3}
+	require.NoError(t, RunTransform(ctx, transform, nil, &bytes.Buffer{}, &bytes.Buffer{}))
+	require.YesError(t, RunTransform(ctx, &pps.Transform{}, nil, &bytes.Buffer{}, &bytes.Buffer{}))
+}
+
+func TestSetInputDefaults(t *testing.T) {
+	input := client.NewCrossInput(
+		client.NewPFSInput("", "b", "/*"),
+		&pps.Input{Pfs: &pps.PFSInput{Repo: "a", Branch: "dev", Glob: "/*", Name: "in"}},
+	)
+	setInputDefaults(&pps.Pipeline{Project: &pfs.Project{Name: "proj"}, Name: "p"}, input)
+	for _, in := range input.Cross {
+		require.Equal(t, "proj", in.Pfs.Project)
+		require.Equal(t, pfs.UserRepoType, in.Pfs.RepoType)
+	}
+	byRepo := make(map[string]*pps.PFSInput)
+	for _, in := range input.Cross {
+		byRepo[in.Pfs.Repo] = in.Pfs
+	}
+	require.Equal(t, "master", byRepo["b"].Branch)
+	require.Equal(t, "b", byRepo["b"].Name)
+	require.Equal(t, "dev", byRepo["a"].Branch)
+	require.Equal(t, "in", byRepo["a"].Name)
+}
+
+func writeFile(p, data string) error {
+	if err := os.MkdirAll(filepath.Dir(p), 0777); err != nil {
+		return err
+	}
+	return os.WriteFile(p, []byte(data), 0666)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfssync"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/datum"
@@ -66,7 +67,7 @@ func Run(driver driver.Driver, logger logs.TaggedLogger) error {
 			return datum.WithSet(cacheClient, storageRoot, func(s *datum.Set) error {
 				inputs := meta.Inputs
 				logger = logger.WithData(inputs)
-				env := driver.UserCodeEnv(logger.JobID(), jobInfo.OutputCommit, inputs, jobInfo.GetAuthToken())
+				env := driver.UserCodeEnv(tracing.Extract(ctx, jobInfo.TraceContext), logger.JobID(), jobInfo.OutputCommit, inputs, jobInfo.GetAuthToken())
 				return s.WithDatum(meta, func(d *datum.Datum) error {
 					err := driver.WithActiveData(inputs, d.PFSStorageRoot(), func() error {
 						return d.Run(ctx, func(runCtx context.Context) error {
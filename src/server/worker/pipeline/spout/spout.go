@@ -1,13 +1,74 @@
 package spout
 
 import (
+	"fmt"
+	"os"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/driver"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/logs"
 )
 
+// abandonedCommitError is the error that commits left open by a previous run
+// of an exactly once spout are finished with.
+const abandonedCommitError = "the spout restarted before the commit was finished"
+
 // Run will run a spout pipeline until the driver is canceled.
 func Run(driver driver.Driver, logger logs.TaggedLogger) error {
 	logger = logger.WithJob("spout")
-	return errors.EnsureStack(driver.RunUserCode(driver.PachClient().Ctx(), logger, nil))
+	var env []string
+	if driver.PipelineInfo().Details.Spout.ExactlyOnce {
+		checkpoint, err := recoverCheckpoint(driver.PachClient(), driver.PipelineInfo(), logger)
+		if err != nil {
+			return err
+		}
+		env = append(os.Environ(), fmt.Sprintf("%s=%s", client.SpoutCheckpointEnv, checkpoint))
+	}
+	return errors.EnsureStack(driver.RunUserCode(driver.PachClient().Ctx(), logger, env))
+}
+
+// recoverCheckpoint prepares the spout's output branch for the user code to
+// resume writing to it, and returns the checkpoint to resume from.  A commit
+// left open by a previous run may hold data past its checkpoint, so it is
+// finished with an error, which discards its data.  The checkpoint is that of
+// the latest commit which finished without an error and has one.
+func recoverCheckpoint(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo, logger logs.TaggedLogger) (string, error) {
+	project, pipeline := pipelineInfo.Pipeline.Project.GetName(), pipelineInfo.Pipeline.Name
+	head, err := pachClient.InspectCommit(project, pipeline, pipelineInfo.Details.OutputBranch, "")
+	if err != nil {
+		return "", errors.Wrap(err, "inspect the head of the spout's output branch")
+	}
+	if head.Finishing == nil {
+		logger.Logf("discarding commit %v, which was left open by a previous run", head.Commit.Id)
+		if _, err := pachClient.PfsAPIClient.FinishCommit(pachClient.Ctx(), &pfs.FinishCommitRequest{
+			Commit: head.Commit,
+			Error:  abandonedCommitError,
+		}); err != nil {
+			return "", errors.Wrapf(grpcutil.ScrubGRPC(err), "finish commit %v", head.Commit.Id)
+		}
+	}
+	var checkpoint string
+	if err := pachClient.ListCommitF(head.Commit.Repo, head.Commit, nil, 0, false, func(ci *pfs.CommitInfo) error {
+		// A finishing commit may still fail, e.g. its validation.
+		if ci.Finished == nil {
+			var err error
+			if ci, err = pachClient.WaitCommit(project, pipeline, "", ci.Commit.Id); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		if ci.Error == "" && ci.Checkpoint != "" {
+			checkpoint = ci.Checkpoint
+			logger.Logf("resuming from the checkpoint of commit %v", ci.Commit.Id)
+			return errutil.ErrBreak
+		}
+		return nil
+	}); err != nil {
+		return "", errors.Wrap(err, "find the latest checkpoint of the spout's output")
+	}
+	return checkpoint, nil
 }
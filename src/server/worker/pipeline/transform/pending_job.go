@@ -17,6 +17,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/datum"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/driver"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/logs"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
@@ -163,7 +164,7 @@ func (pj *pendingJob) createParallelDatums(ctx context.Context, taskDoer task.Do
 		var fileSetID string
 		var err error
 		if err := pj.logger.LogStep("creating full job datum file set", func() error {
-			fileSetID, err = createDatums(pachClient, taskDoer, pj.ji.Job)
+			fileSetID, err = createDatums(pachClient, taskDoer, pj.ji.Job, pj.previousOutput())
 			if err != nil {
 				return err
 			}
@@ -172,10 +173,14 @@ func (pj *pendingJob) createParallelDatums(ctx context.Context, taskDoer task.Do
 			return errors.EnsureStack(err)
 		}
 		var baseFileSetID string
-		if pj.baseMetaCommit != nil {
+		// The datums of a job with an incremental input only cover what
+		// changed since the base job, so they aren't compared with the base
+		// job's datums: all of them are new, and the base job's output is
+		// carried forward as is.
+		if pj.baseMetaCommit != nil && !pj.hasIncrementalInput() {
 			// Upload the datums from the base job into the datum file set format.
 			if err := pj.logger.LogStep("creating full base job datum file set", func() error {
-				baseFileSetID, err = createDatums(pachClient, taskDoer, client.NewJob(pj.ji.Job.Pipeline.Project.GetName(), pj.ji.Job.Pipeline.Name, pj.baseMetaCommit.Id))
+				baseFileSetID, err = createDatums(pachClient, taskDoer, client.NewJob(pj.ji.Job.Pipeline.Project.GetName(), pj.ji.Job.Pipeline.Name, pj.baseMetaCommit.Id), nil)
 				if err != nil {
 					return err
 				}
@@ -193,7 +198,32 @@ func (pj *pendingJob) createParallelDatums(ctx context.Context, taskDoer task.Do
 	return outputFileSetID, nil
 }
 
-func createDatums(pachClient *client.APIClient, taskDoer task.Doer, job *pps.Job) (string, error) {
+// hasIncrementalInput returns whether any of the job's PFS inputs are
+// incremental.
+func (pj *pendingJob) hasIncrementalInput() bool {
+	var incremental bool
+	pps.VisitInput(pj.ji.Details.Input, func(input *pps.Input) error { //nolint:errcheck
+		if input.Pfs != nil && input.Pfs.Incremental {
+			incremental = true
+		}
+		return nil
+	})
+	return incremental
+}
+
+// previousOutput returns the output commit of the base job, which is presented
+// to user code by incremental inputs, or nil if there is no base job.
+func (pj *pendingJob) previousOutput() *pfs.Commit {
+	if pj.baseMetaCommit == nil {
+		return nil
+	}
+	return client.NewCommit(pj.ji.OutputCommit.Repo.Project.GetName(), pj.ji.OutputCommit.Repo.Name, pj.ji.OutputCommit.GetBranch().GetName(), pj.baseMetaCommit.Id)
+}
+
+// createDatums creates the datum file set for job.  If previousOutput is set,
+// it is the commit presented by the job's incremental inputs as the previous
+// output, and only the changed datums of those inputs are created.
+func createDatums(pachClient *client.APIClient, taskDoer task.Doer, job *pps.Job, previousOutput *pfs.Commit) (string, error) {
 	jobInfo, err := pachClient.InspectJob(job.Pipeline.Project.GetName(), job.Pipeline.Name, job.Id, true)
 	if err != nil {
 		return "", err
@@ -218,7 +248,17 @@ func createDatums(pachClient *client.APIClient, taskDoer task.Doer, job *pps.Job
 		}
 		return resp.FileSetId, nil
 	}
-	return datum.Create(pachClient.Ctx(), pachClient.PfsAPIClient, taskDoer, jobInfo.Details.Input)
+	input := jobInfo.Details.Input
+	if previousOutput != nil {
+		input = proto.Clone(input).(*pps.Input)
+		pps.VisitInput(input, func(input *pps.Input) error { //nolint:errcheck
+			if input.Pfs != nil && input.Pfs.Incremental {
+				input.Pfs.PreviousOutput = previousOutput
+			}
+			return nil
+		})
+	}
+	return datum.Create(pachClient.Ctx(), pachClient.PfsAPIClient, taskDoer, input)
 }
 
 func (pj *pendingJob) createJobDatumFileSetParallel(ctx context.Context, taskDoer task.Doer, renewer *renew.StringSet, fileSetID, baseFileSetID string) (string, error) {
@@ -300,12 +340,17 @@ func (pj *pendingJob) createSerialDatums(ctx context.Context, taskDoer task.Doer
 	if ci.Error != "" {
 		return "", pfsserver.ErrCommitError{Commit: ci.Commit}
 	}
+	// All of the datums of a job with an incremental input were processed in
+	// the parallel step, and none of the base job's datums are deleted.
+	if pj.hasIncrementalInput() {
+		return datum.CreateEmptyFileSet(pachClient.Ctx(), pachClient.PfsAPIClient)
+	}
 	var outputFileSetID string
 	if err := pachClient.WithRenewer(func(ctx context.Context, renewer *renew.StringSet) error {
 		// Upload the datums from the current job into the datum file set format.
 		var fileSetID string
 		if err := pj.logger.LogStep("creating full job datum file set", func() error {
-			fileSetID, err = createDatums(pachClient, taskDoer, pj.ji.Job)
+			fileSetID, err = createDatums(pachClient, taskDoer, pj.ji.Job, pj.previousOutput())
 			if err != nil {
 				return err
 			}
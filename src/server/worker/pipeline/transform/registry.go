@@ -3,6 +3,7 @@ package transform
 import (
 	"context"
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/contentcontract"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/limit"
@@ -314,6 +316,17 @@ func (reg *registry) processJobRunning(pj *pendingJob) error {
 		}
 		return err
 	}
+	if contract := pj.driver.PipelineInfo().Details.OutputContract; contract != nil {
+		if err := pj.logger.LogStep("checking output contract", func() error {
+			return contentcontract.Check(pachClient.Ctx(), contract, &commitOutput{c: pachClient, commit: pj.commitInfo.Commit})
+		}); err != nil {
+			var violation contentcontract.ErrViolated
+			if errors.As(err, &violation) {
+				return reg.failJob(pj, violation.Error())
+			}
+			return errors.EnsureStack(err)
+		}
+	}
 	if pj.ji.Details.Egress != nil {
 		pj.ji.State = pps.JobState_JOB_EGRESSING
 		return pj.writeJobInfo()
@@ -503,6 +516,25 @@ func (reg *registry) processJobEgressing(pj *pendingJob) error {
 	return reg.succeedJob(pj)
 }
 
+// commitOutput is the output of a job, in its output commit.
+type commitOutput struct {
+	c      *client.APIClient
+	commit *pfs.Commit
+}
+
+func (o *commitOutput) Walk(cb func(contentcontract.File) error) error {
+	return errors.EnsureStack(o.c.WalkFile(o.commit, "/", func(fi *pfs.FileInfo) error {
+		if fi.FileType != pfs.FileType_FILE {
+			return nil
+		}
+		return cb(contentcontract.File{Path: fi.File.Path, SizeBytes: fi.SizeBytes})
+	}))
+}
+
+func (o *commitOutput) Get(path string, w io.Writer) error {
+	return errors.EnsureStack(o.c.GetFile(o.commit, path, w))
+}
+
 func failedInputs(pachClient *client.APIClient, jobInfo *pps.JobInfo) ([]string, error) {
 	var failed []string
 	waitCommit := func(name string, commit *pfs.Commit) error {
@@ -25,6 +25,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfssync"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 )
 
@@ -378,6 +379,7 @@ func forEachDatum(ctx context.Context, driver driver.Driver, baseLogger logs.Tag
 	if err != nil {
 		return errors.Wrapf(err, "load datum set's job info for job %q", task.Job.String())
 	}
+	ctx = tracing.Extract(ctx, jobInfo.TraceContext)
 	// TODO: Can this just be refactored into the datum package such that we don't need to specify a storage root for the sets?
 	// The sets would just create a temporary directory under /tmp.
 	storageRoot := filepath.Join(driver.InputDir(), client.PPSScratchSpace, uuid.NewWithoutDashes())
@@ -393,8 +395,10 @@ func forEachDatum(ctx context.Context, driver driver.Driver, baseLogger logs.Tag
 			meta.ImageId = userImageID
 			inputs := meta.Inputs
 			logger := baseLogger.WithData(inputs)
+			span, ctx := tracing.AddSpanToAnyExisting(ctx, "/worker/ProcessDatum", "job", logger.JobID(), "datum", common.DatumID(inputs))
+			defer tracing.FinishAnySpan(span)
 
-			env := driver.UserCodeEnv(logger.JobID(), task.OutputCommit, inputs, jobInfo.GetAuthToken())
+			env := driver.UserCodeEnv(ctx, logger.JobID(), task.OutputCommit, inputs, jobInfo.GetAuthToken())
 			opts := []datum.Option{datum.WithEnv(env)}
 			if driver.PipelineInfo().Details.DatumTimeout != nil {
 				timeout := driver.PipelineInfo().Details.DatumTimeout.AsDuration()
@@ -0,0 +1,344 @@
+// Package transformstar runs Starlark pipeline transforms inside the worker, so that small glue
+// steps don't need a user image.  Scripts see the datum through the predefined "pfs" module, which
+// can read anything under the input directory and write only under its "out" directory, and "env",
+// the environment that a user process would have been started with.
+package transformstar
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/metrics"
+	"sort"
+	"strings"
+	"time"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+	"go.starlark.net/syntax"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	ourstar "github.com/pachyderm/pachyderm/v2/src/internal/starlark"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+const (
+	// DefaultMaxSteps is the default limit on Starlark execution steps per datum.
+	DefaultMaxSteps = 1_000_000_000
+	// DefaultMemoryLimit is the default limit on memory allocated per datum.
+	DefaultMemoryLimit = 256 * 1024 * 1024
+	// DefaultTimeout is the default limit on the running time per datum.
+	DefaultTimeout = 10 * time.Minute
+
+	outputDir = "out"
+	// memoryCheckInterval is how often the heap is sampled to enforce the memory limit.
+	memoryCheckInterval = 50 * time.Millisecond
+)
+
+func init() {
+	// This registration only matters for "starpach run -p transform", which runs a transform
+	// against a local directory laid out like /pfs.
+	ourstar.RegisterPersonality("transform", (&Env{InputDir: ".", Environ: os.Environ()}).Options())
+}
+
+// Limits are the resource limits a script runs under.
+type Limits struct {
+	MaxSteps    uint64
+	MemoryLimit int64
+	Timeout     time.Duration
+}
+
+// LimitsFor returns the limits for the provided transform, with defaults applied.
+func LimitsFor(t *pps.StarlarkTransform) Limits {
+	l := Limits{
+		MaxSteps:    t.GetMaxSteps(),
+		MemoryLimit: t.GetMemoryLimitBytes(),
+		Timeout:     t.GetTimeout().AsDuration(),
+	}
+	if l.MaxSteps == 0 {
+		l.MaxSteps = DefaultMaxSteps
+	}
+	if l.MemoryLimit <= 0 {
+		l.MemoryLimit = DefaultMemoryLimit
+	}
+	if t.GetTimeout() == nil || l.Timeout <= 0 {
+		l.Timeout = DefaultTimeout
+	}
+	return l
+}
+
+// Env is the environment of a single run of a script.
+type Env struct {
+	// InputDir is the directory that holds the datum's inputs, and its output in InputDir/out.
+	InputDir string
+	// Environ is the environment, in os.Environ form, that the script sees as "env".
+	Environ []string
+	// Stdout receives the output of print().  If nil, print() output is logged.
+	Stdout io.Writer
+}
+
+// Options returns the starlark options for this environment.
+func (e *Env) Options() ourstar.Options {
+	env := starlark.NewDict(len(e.Environ))
+	for _, kv := range e.Environ {
+		k, v, _ := strings.Cut(kv, "=")
+		env.SetKey(starlark.String(k), starlark.String(v)) //nolint:errcheck
+	}
+	env.Freeze()
+	return ourstar.Options{
+		Predefined: starlark.StringDict{
+			"pfs": e.module(),
+			"env": env,
+		},
+	}
+}
+
+// Run runs a transform's script on the datum in env.
+func Run(rctx context.Context, t *pps.StarlarkTransform, env *Env) (retErr error) {
+	limits := LimitsFor(t)
+	ctx, cancel := context.WithTimeout(rctx, limits.Timeout)
+	defer cancel()
+	defer func() {
+		if err := recover(); err != nil {
+			errors.JoinInto(&retErr, errors.Errorf("starlark evaluation panicked: %v", err))
+		}
+	}()
+	_, err := ourstar.Run(ctx, "transform.star", env.Options(), func(fileOpts *syntax.FileOptions, thread *starlark.Thread, _, _ string, globals starlark.StringDict) (starlark.StringDict, error) {
+		thread.Load = func(_ *starlark.Thread, module string) (starlark.StringDict, error) {
+			return nil, errors.Errorf("cannot load %q: load is not available in transforms", module)
+		}
+		if env.Stdout != nil {
+			thread.Print = func(_ *starlark.Thread, msg string) {
+				fmt.Fprintln(env.Stdout, msg)
+			}
+		}
+		thread.SetMaxExecutionSteps(limits.MaxSteps)
+		stop := watchMemory(thread, limits.MemoryLimit)
+		defer stop()
+		result, err := starlark.ExecFileOptions(fileOpts, thread, "transform.star", t.Script, globals)
+		if err != nil {
+			return result, errors.Wrap(err, "exec starlark transform")
+		}
+		return result, nil
+	})
+	return err
+}
+
+// watchMemory cancels thread if the heap grows by more than limit bytes while it runs.  The heap
+// is shared by the whole worker, so this is approximate, but the worker only runs one datum at a
+// time and does little else while it does.  Before cancelling, a collection is forced so that
+// garbage the script has already dropped isn't counted against it.
+func watchMemory(thread *starlark.Thread, limit int64) (stop func()) {
+	sample := []metrics.Sample{{Name: "/memory/classes/heap/objects:bytes"}}
+	heap := func() int64 {
+		metrics.Read(sample)
+		return int64(sample[0].Value.Uint64())
+	}
+	base := heap()
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(memoryCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+			if heap()-base <= limit {
+				continue
+			}
+			runtime.GC()
+			if used := heap() - base; used > limit {
+				thread.Cancel(fmt.Sprintf("memory limit of %d bytes exceeded (%d bytes in use)", limit, used))
+				return
+			}
+		}
+	}()
+	return func() { close(done) }
+}
+
+// resolve returns the path on disk of p, which is relative to the input directory or an absolute
+// path in it, or an error if it is outside of the input directory.
+func (e *Env) resolve(p string) (string, error) {
+	rel := filepath.Clean(p)
+	if filepath.IsAbs(p) {
+		var err error
+		if rel, err = filepath.Rel(e.InputDir, rel); err != nil {
+			return "", errors.Errorf("path %q is outside of the input directory", p)
+		}
+	}
+	if rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", errors.Errorf("path %q is outside of the input directory", p)
+	}
+	return filepath.Join(e.InputDir, rel), nil
+}
+
+// resolveOutput is like resolve, but the path must be in the output directory.
+func (e *Env) resolveOutput(p string) (string, error) {
+	full, err := e.resolve(p)
+	if err != nil {
+		return "", err
+	}
+	out := filepath.Join(e.InputDir, outputDir)
+	if !strings.HasPrefix(full, out+string(filepath.Separator)) {
+		return "", errors.Errorf("path %q is not in the output directory %q", p, outputDir)
+	}
+	return full, nil
+}
+
+func (e *Env) module() *starlarkstruct.Module {
+	return &starlarkstruct.Module{
+		Name: "pfs",
+		Members: starlark.StringDict{
+			// read(path, binary=False) returns the content of a file as a string, or as bytes
+			// if binary is set.
+			"read": starlark.NewBuiltin("pfs.read", func(t *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				var p string
+				var binary bool
+				if err := starlark.UnpackArgs(fn.Name(), args, kwargs, "path", &p, "binary?", &binary); err != nil {
+					return nil, errors.Wrap(err, "unpack args")
+				}
+				full, err := e.resolve(p)
+				if err != nil {
+					return nil, err
+				}
+				data, err := os.ReadFile(full)
+				if err != nil {
+					return nil, errors.Wrapf(err, "read %v", p)
+				}
+				if binary {
+					return starlark.Bytes(data), nil
+				}
+				return starlark.String(data), nil
+			}),
+			// write(path, data, append=False) writes a string or bytes to a file in the
+			// output directory, creating its parent directories.
+			"write": starlark.NewBuiltin("pfs.write", func(t *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				var p string
+				var data starlark.Value
+				var appendFile bool
+				if err := starlark.UnpackArgs(fn.Name(), args, kwargs, "path", &p, "data", &data, "append?", &appendFile); err != nil {
+					return nil, errors.Wrap(err, "unpack args")
+				}
+				var content string
+				switch x := data.(type) {
+				case starlark.String:
+					content = string(x)
+				case starlark.Bytes:
+					content = string(x)
+				default:
+					return nil, errors.Errorf("%v: data must be a string or bytes, not %v", fn.Name(), data.Type())
+				}
+				full, err := e.resolveOutput(p)
+				if err != nil {
+					return nil, err
+				}
+				if err := os.MkdirAll(filepath.Dir(full), 0777); err != nil {
+					return nil, errors.Wrapf(err, "create parent of %v", p)
+				}
+				flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+				if appendFile {
+					flags = os.O_WRONLY | os.O_CREATE | os.O_APPEND
+				}
+				f, err := os.OpenFile(full, flags, 0666)
+				if err != nil {
+					return nil, errors.Wrapf(err, "open %v", p)
+				}
+				if _, err := io.WriteString(f, content); err != nil {
+					f.Close()
+					return nil, errors.Wrapf(err, "write %v", p)
+				}
+				if err := f.Close(); err != nil {
+					return nil, errors.Wrapf(err, "close %v", p)
+				}
+				return starlark.None, nil
+			}),
+			// list(path) returns the sorted paths of the entries of a directory.
+			"list": starlark.NewBuiltin("pfs.list", func(t *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				var p string
+				if err := starlark.UnpackArgs(fn.Name(), args, kwargs, "path", &p); err != nil {
+					return nil, errors.Wrap(err, "unpack args")
+				}
+				full, err := e.resolve(p)
+				if err != nil {
+					return nil, err
+				}
+				entries, err := os.ReadDir(full)
+				if err != nil {
+					return nil, errors.Wrapf(err, "list %v", p)
+				}
+				var result []starlark.Value
+				for _, entry := range entries {
+					result = append(result, starlark.String(filepath.ToSlash(filepath.Join(filepath.Clean(p), entry.Name()))))
+				}
+				return starlark.NewList(result), nil
+			}),
+			// glob(pattern) returns the sorted paths matching a pattern.
+			"glob": starlark.NewBuiltin("pfs.glob", func(t *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				var pattern string
+				if err := starlark.UnpackArgs(fn.Name(), args, kwargs, "pattern", &pattern); err != nil {
+					return nil, errors.Wrap(err, "unpack args")
+				}
+				full, err := e.resolve(pattern)
+				if err != nil {
+					return nil, err
+				}
+				matches, err := filepath.Glob(full)
+				if err != nil {
+					return nil, errors.Wrapf(err, "glob %v", pattern)
+				}
+				sort.Strings(matches)
+				var result []starlark.Value
+				for _, m := range matches {
+					rel, err := filepath.Rel(e.InputDir, m)
+					if err != nil {
+						return nil, errors.Wrapf(err, "relative path of %v", m)
+					}
+					result = append(result, starlark.String(filepath.ToSlash(rel)))
+				}
+				return starlark.NewList(result), nil
+			}),
+			// exists(path) returns whether a file or directory exists.
+			"exists": starlark.NewBuiltin("pfs.exists", func(t *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				var p string
+				if err := starlark.UnpackArgs(fn.Name(), args, kwargs, "path", &p); err != nil {
+					return nil, errors.Wrap(err, "unpack args")
+				}
+				full, err := e.resolve(p)
+				if err != nil {
+					return nil, err
+				}
+				if _, err := os.Stat(full); err != nil {
+					if errors.Is(err, os.ErrNotExist) {
+						return starlark.False, nil
+					}
+					return nil, errors.Wrapf(err, "stat %v", p)
+				}
+				return starlark.True, nil
+			}),
+			// isdir(path) returns whether path is a directory.
+			"isdir": starlark.NewBuiltin("pfs.isdir", func(t *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				var p string
+				if err := starlark.UnpackArgs(fn.Name(), args, kwargs, "path", &p); err != nil {
+					return nil, errors.Wrap(err, "unpack args")
+				}
+				full, err := e.resolve(p)
+				if err != nil {
+					return nil, err
+				}
+				fi, err := os.Stat(full)
+				if err != nil {
+					if errors.Is(err, os.ErrNotExist) {
+						return starlark.False, nil
+					}
+					return nil, errors.Wrapf(err, "stat %v", p)
+				}
+				return starlark.Bool(fi.IsDir()), nil
+			}),
+		},
+	}
+}
@@ -0,0 +1,90 @@
+package transformstar
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+func newEnv(t *testing.T) *Env {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "in", "sub"), 0777))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "out"), 0777))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "in", "a.json"), []byte(`{"name": "a", "n": 1}`), 0666))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "in", "sub", "b.json"), []byte(`{"name": "b", "n": 2}`), 0666))
+	return &Env{
+		InputDir: dir,
+		Environ:  []string{"PACH_DATUM_ID=abc"},
+		Stdout:   new(bytes.Buffer),
+	}
+}
+
+func TestRun(t *testing.T) {
+	env := newEnv(t)
+	script := `
+for p in pfs.glob("in/*.json") + pfs.glob("in/*/*.json"):
+    obj = json.decode(pfs.read(p))
+    pfs.write("out/" + obj["name"] + ".txt", str(obj["n"] * 10))
+    pfs.write("out/all.txt", obj["name"] + "\n", append=True)
+pfs.write("out/datum", env["PACH_DATUM_ID"])
+pfs.write("out/listing", ",".join(pfs.list("in")))
+print("exists", pfs.exists("in/a.json"), pfs.exists("in/nope"), pfs.isdir("in/sub"))
+`
+	require.NoError(t, Run(pctx.TestContext(t), &pps.StarlarkTransform{Script: script}, env))
+	for file, want := range map[string]string{
+		"a.txt":   "10",
+		"b.txt":   "20",
+		"all.txt": "a\nb\n",
+		"datum":   "abc",
+		"listing": "in/a.json,in/sub",
+	} {
+		got, err := os.ReadFile(filepath.Join(env.InputDir, "out", file))
+		require.NoError(t, err)
+		require.Equal(t, want, string(got), "file %v", file)
+	}
+	require.Equal(t, "exists True False True\n", env.Stdout.(*bytes.Buffer).String())
+}
+
+func TestRunSandbox(t *testing.T) {
+	testData := []struct {
+		name   string
+		script string
+		limits *pps.StarlarkTransform
+	}{
+		{name: "read outside input dir", script: `pfs.read("../secret")`},
+		{name: "read absolute path", script: `pfs.read("/etc/passwd")`},
+		{name: "write outside output dir", script: `pfs.write("in/a.json", "x")`},
+		{name: "write output dir itself", script: `pfs.write("out", "x")`},
+		{name: "load", script: `load("other.star", "x")`},
+		{name: "step limit", script: "x = 0\nfor i in range(1000000):\n    x += i", limits: &pps.StarlarkTransform{MaxSteps: 1000}},
+		{name: "timeout", script: "x = 0\nfor i in range(1000000000):\n    x += i", limits: &pps.StarlarkTransform{Timeout: durationpb.New(100 * time.Millisecond)}},
+		{name: "memory limit", script: "x = []\nfor i in range(100000000):\n    x.append(str(i))", limits: &pps.StarlarkTransform{MemoryLimitBytes: 16 * 1024 * 1024}},
+	}
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			transform := &pps.StarlarkTransform{}
+			if test.limits != nil {
+				transform = test.limits
+			}
+			transform.Script = test.script
+			require.YesError(t, Run(pctx.TestContext(t), transform, newEnv(t)))
+		})
+	}
+}
+
+func TestLimitsFor(t *testing.T) {
+	require.Equal(t, Limits{MaxSteps: DefaultMaxSteps, MemoryLimit: DefaultMemoryLimit, Timeout: DefaultTimeout}, LimitsFor(nil))
+	require.Equal(t, Limits{MaxSteps: 5, MemoryLimit: 6, Timeout: time.Second}, LimitsFor(&pps.StarlarkTransform{
+		MaxSteps:         5,
+		MemoryLimitBytes: 6,
+		Timeout:          durationpb.New(time.Second),
+	}))
+}
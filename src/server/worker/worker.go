@@ -70,7 +70,7 @@ func NewWorker(
 		return nil, err
 	}
 
-	if pipelineInfo.Details.Transform.Image != "" && pipelineInfo.Details.Transform.Cmd == nil {
+	if pipelineInfo.Details.Transform.Image != "" && pipelineInfo.Details.Transform.Cmd == nil && pipelineInfo.Details.Transform.Starlark == nil && pipelineInfo.Details.Transform.Builtin == nil {
 		if err := ppsutil.FailPipeline(
 			env.Context(),
 			env.GetDBClient(),
@@ -56,6 +56,9 @@ export enum Permission {
   SECRET_DELETE = "SECRET_DELETE",
   SECRET_INSPECT = "SECRET_INSPECT",
   CLUSTER_DELETE_ALL = "CLUSTER_DELETE_ALL",
+  CLUSTER_MANAGE_REPLICATIONS = "CLUSTER_MANAGE_REPLICATIONS",
+  CLUSTER_RECEIVE_REPLICATION = "CLUSTER_RECEIVE_REPLICATION",
+  CLUSTER_SET_PROJECT_QUOTAS = "CLUSTER_SET_PROJECT_QUOTAS",
   REPO_READ = "REPO_READ",
   REPO_WRITE = "REPO_WRITE",
   REPO_MODIFY_BINDINGS = "REPO_MODIFY_BINDINGS",
@@ -71,6 +74,7 @@ export enum Permission {
   REPO_ADD_PIPELINE_READER = "REPO_ADD_PIPELINE_READER",
   REPO_REMOVE_PIPELINE_READER = "REPO_REMOVE_PIPELINE_READER",
   REPO_ADD_PIPELINE_WRITER = "REPO_ADD_PIPELINE_WRITER",
+  REPO_APPROVE_COMMIT = "REPO_APPROVE_COMMIT",
   PIPELINE_LIST_JOB = "PIPELINE_LIST_JOB",
   CLUSTER_SET_DEFAULTS = "CLUSTER_SET_DEFAULTS",
   PROJECT_CREATE = "PROJECT_CREATE",
@@ -119,6 +123,28 @@ export type OIDCConfig = {
   requireEmailVerified?: boolean
   localhostIssuer?: boolean
   userAccessibleIssuerHost?: string
+  workloadIdentityProviders?: WorkloadIdentityProvider[]
+  groupSync?: OIDCGroupSync
+}
+
+export type OIDCGroupSync = {
+  claim?: string
+  prefix?: string
+  allowedGroups?: string[]
+  disabled?: boolean
+}
+
+export type WorkloadIdentityProvider = {
+  issuer?: string
+  audiences?: string[]
+  jwksUrl?: string
+  kubernetesApi?: boolean
+  rules?: WorkloadIdentityRule[]
+}
+
+export type WorkloadIdentityRule = {
+  claims?: {[key: string]: string}
+  principal?: string
 }
 
 export type GetConfigurationRequest = {
@@ -139,11 +165,21 @@ export type TokenInfo = {
   subject?: string
   expiration?: GoogleProtobufTimestamp.Timestamp
   hashedToken?: string
+  scopes?: TokenScope[]
+  createdAt?: GoogleProtobufTimestamp.Timestamp
+  id?: string
+}
+
+export type TokenScope = {
+  resource?: Resource
+  permissions?: Permission[]
+  branches?: string[]
 }
 
 export type AuthenticateRequest = {
   oidcState?: string
   idToken?: string
+  workloadToken?: string
 }
 
 export type AuthenticateResponse = {
@@ -156,6 +192,7 @@ export type WhoAmIRequest = {
 export type WhoAmIResponse = {
   username?: string
   expiration?: GoogleProtobufTimestamp.Timestamp
+  scopes?: TokenScope[]
 }
 
 export type GetRolesForPermissionRequest = {
@@ -168,6 +205,8 @@ export type GetRolesForPermissionResponse = {
 
 export type Roles = {
   roles?: {[key: string]: boolean}
+  expiration?: GoogleProtobufTimestamp.Timestamp
+  justification?: string
 }
 
 export type RoleBinding = {
@@ -224,6 +263,8 @@ export type ModifyRoleBindingRequest = {
   resource?: Resource
   principal?: string
   roles?: string[]
+  expiration?: GoogleProtobufTimestamp.Timestamp
+  justification?: string
 }
 
 export type ModifyRoleBindingResponse = {
@@ -254,14 +295,34 @@ export type GetOIDCLoginResponse = {
 export type GetRobotTokenRequest = {
   robot?: string
   ttl?: string
+  scopes?: TokenScope[]
 }
 
 export type GetRobotTokenResponse = {
   token?: string
 }
 
+export type GetScopedTokenRequest = {
+  scopes?: TokenScope[]
+  ttl?: string
+}
+
+export type GetScopedTokenResponse = {
+  token?: string
+  id?: string
+}
+
+export type ListAuthTokensRequest = {
+  principal?: string
+}
+
+export type ListAuthTokensResponse = {
+  tokens?: TokenInfo[]
+}
+
 export type RevokeAuthTokenRequest = {
   token?: string
+  id?: string
 }
 
 export type RevokeAuthTokenResponse = {
@@ -375,6 +436,12 @@ export class API {
   static GetRobotToken(req: GetRobotTokenRequest, initReq?: fm.InitReq): Promise<GetRobotTokenResponse> {
     return fm.fetchReq<GetRobotTokenRequest, GetRobotTokenResponse>(`/auth_v2.API/GetRobotToken`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
+  static GetScopedToken(req: GetScopedTokenRequest, initReq?: fm.InitReq): Promise<GetScopedTokenResponse> {
+    return fm.fetchReq<GetScopedTokenRequest, GetScopedTokenResponse>(`/auth_v2.API/GetScopedToken`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static ListAuthTokens(req: ListAuthTokensRequest, initReq?: fm.InitReq): Promise<ListAuthTokensResponse> {
+    return fm.fetchReq<ListAuthTokensRequest, ListAuthTokensResponse>(`/auth_v2.API/ListAuthTokens`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
   static RevokeAuthToken(req: RevokeAuthTokenRequest, initReq?: fm.InitReq): Promise<RevokeAuthTokenResponse> {
     return fm.fetchReq<RevokeAuthTokenRequest, RevokeAuthTokenResponse>(`/auth_v2.API/RevokeAuthToken`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
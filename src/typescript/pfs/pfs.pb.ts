@@ -9,6 +9,7 @@ import * as fm from "../fetch.pb"
 import * as GoogleProtobufAny from "../google/protobuf/any.pb"
 import * as GoogleProtobufDuration from "../google/protobuf/duration.pb"
 import * as GoogleProtobufEmpty from "../google/protobuf/empty.pb"
+import * as GoogleProtobufStruct from "../google/protobuf/struct.pb"
 import * as GoogleProtobufTimestamp from "../google/protobuf/timestamp.pb"
 import * as GoogleProtobufWrappers from "../google/protobuf/wrappers.pb"
 import * as TaskapiTask from "../task/task.pb"
@@ -51,6 +52,11 @@ export enum Delimiter {
   CSV = "CSV",
 }
 
+export enum ProjectQuotaEnforcement {
+  SOFT = "SOFT",
+  HARD = "HARD",
+}
+
 export enum SQLDatabaseEgressFileFormatType {
   UNKNOWN = "UNKNOWN",
   CSV = "CSV",
@@ -77,6 +83,7 @@ export type File = {
 
 export type RepoInfoDetails = {
   sizeBytes?: string
+  usage?: StorageUsage
 }
 
 export type RepoInfo = {
@@ -89,6 +96,12 @@ export type RepoInfo = {
   details?: RepoInfoDetails
 }
 
+export type StorageUsage = {
+  exclusiveBytes?: string
+  sharedBytes?: string
+  computedAt?: GoogleProtobufTimestamp.Timestamp
+}
+
 export type AuthInfo = {
   permissions?: Auth_v2Auth.Permission[]
   roles?: string[]
@@ -110,6 +123,7 @@ export type Trigger = {
   size?: string
   commits?: string
   cronSpec?: string
+  requireApproval?: boolean
 }
 
 export type CommitOrigin = {
@@ -126,6 +140,8 @@ export type CommitInfoDetails = {
   sizeBytes?: string
   compactingTime?: GoogleProtobufDuration.Duration
   validatingTime?: GoogleProtobufDuration.Duration
+  usage?: StorageUsage
+  validation?: CommitValidationResult
 }
 
 export type CommitInfo = {
@@ -140,9 +156,17 @@ export type CommitInfo = {
   directProvenance?: Commit[]
   error?: string
   sizeBytesUpperBound?: string
+  approval?: CommitApproval
+  checkpoint?: string
   details?: CommitInfoDetails
 }
 
+export type CommitApproval = {
+  principal?: string
+  comment?: string
+  approved?: GoogleProtobufTimestamp.Timestamp
+}
+
 export type CommitSet = {
   id?: string
 }
@@ -164,11 +188,26 @@ export type Project = {
   name?: string
 }
 
+export type ProjectInfoDetails = {
+  usage?: StorageUsage
+}
+
 export type ProjectInfo = {
   project?: Project
   description?: string
   authInfo?: AuthInfo
   createdAt?: GoogleProtobufTimestamp.Timestamp
+  details?: ProjectInfoDetails
+  quota?: ProjectQuota
+}
+
+export type ProjectQuota = {
+  maxBytes?: string
+  enforcement?: ProjectQuotaEnforcement
+  maxWorkers?: string
+  maxCpu?: string
+  maxMemory?: string
+  priorityClassName?: string
 }
 
 export type CreateRepoRequest = {
@@ -216,6 +255,13 @@ export type FinishCommitRequest = {
   description?: string
   error?: string
   force?: boolean
+  checkpoint?: string
+}
+
+export type ApproveCommitRequest = {
+  commit?: Commit
+  comment?: string
+  principal?: string
 }
 
 export type InspectCommitRequest = {
@@ -304,10 +350,12 @@ export type CreateProjectRequest = {
   project?: Project
   description?: string
   update?: boolean
+  quota?: ProjectQuota
 }
 
 export type InspectProjectRequest = {
   project?: Project
+  details?: boolean
 }
 
 export type ListProjectRequest = {
@@ -522,6 +570,229 @@ type BaseEgressResponse = {
 export type EgressResponse = BaseEgressResponse
   & OneOf<{ objectStorage: EgressResponseObjectStorageResult; sqlDatabase: EgressResponseSQLDatabaseResult }>
 
+export type Replication = {
+  name?: string
+}
+
+export type ReplicationTargetSecret = {
+  name?: string
+  key?: string
+}
+
+export type ReplicationTarget = {
+  pachdAddress?: string
+  authTokenSecret?: ReplicationTargetSecret
+}
+
+export type BranchReplicationStatus = {
+  branch?: Branch
+  lastReplicatedCommit?: Commit
+  targetCommit?: Commit
+  lastReplicatedAt?: GoogleProtobufTimestamp.Timestamp
+  lag?: GoogleProtobufDuration.Duration
+  bytesTransferred?: string
+  error?: string
+  pendingCommit?: Commit
+  pendingTargetCommit?: Commit
+}
+
+export type ReplicationInfo = {
+  replication?: Replication
+  target?: ReplicationTarget
+  branches?: Branch[]
+  createdAt?: GoogleProtobufTimestamp.Timestamp
+  status?: BranchReplicationStatus[]
+}
+
+export type CreateReplicationRequest = {
+  replication?: Replication
+  target?: ReplicationTarget
+  branches?: Branch[]
+  update?: boolean
+}
+
+export type InspectReplicationRequest = {
+  replication?: Replication
+}
+
+export type ListReplicationRequest = {
+}
+
+export type DeleteReplicationRequest = {
+  replication?: Replication
+}
+
+export type MissingChunksRequest = {
+  chunkIds?: Uint8Array[]
+}
+
+export type MissingChunksResponse = {
+  chunkIds?: Uint8Array[]
+}
+
+export type ReplicateFileSetRequestChunk = {
+  id?: Uint8Array
+  pointsTo?: Uint8Array[]
+  data?: Uint8Array
+}
+
+
+type BaseReplicateFileSetRequest = {
+}
+
+export type ReplicateFileSetRequest = BaseReplicateFileSetRequest
+  & OneOf<{ chunk: ReplicateFileSetRequestChunk; primitive: Uint8Array }>
+
+export type RetentionPolicy = {
+  keepLast?: number
+  keepFor?: GoogleProtobufDuration.Duration
+  keepDailyAfter?: GoogleProtobufDuration.Duration
+}
+
+export type RetentionPolicyInfo = {
+  repo?: Repo
+  branch?: string
+  policy?: RetentionPolicy
+}
+
+export type SetRetentionPolicyRequest = {
+  repo?: Repo
+  branch?: string
+  policy?: RetentionPolicy
+}
+
+export type ListRetentionPolicyRequest = {
+  repo?: Repo
+}
+
+export type EnforceRetentionPolicyRequest = {
+  repo?: Repo
+  dryRun?: boolean
+}
+
+export type RetentionAction = {
+  commit?: Commit
+  squashed?: boolean
+  skippedReason?: string
+}
+
+export type ContentContract = {
+  paths?: PathContract[]
+  minFiles?: string
+  maxFiles?: string
+  maxTotalBytes?: string
+}
+
+export type PathContract = {
+  glob?: string
+  required?: boolean
+  minFiles?: string
+  maxFiles?: string
+  minFileBytes?: string
+  maxFileBytes?: string
+  jsonSchema?: GoogleProtobufStruct.Struct
+  table?: TableSchema
+}
+
+export type TableSchema = {
+  format?: string
+  header?: boolean
+  columns?: TableColumn[]
+  allowExtraColumns?: boolean
+}
+
+export type TableColumn = {
+  name?: string
+  type?: string
+  nullable?: boolean
+}
+
+export type CommitValidationInfo = {
+  repo?: Repo
+  contract?: ContentContract
+  validator?: Repo
+}
+
+export type SetCommitValidationRequest = {
+  repo?: Repo
+  contract?: ContentContract
+  validator?: Repo
+}
+
+export type ListCommitValidationRequest = {
+  repo?: Repo
+}
+
+export type CommitValidationResult = {
+  passed?: boolean
+  reason?: string
+  pending?: boolean
+}
+
+export type StoragePlacementInfo = {
+  repo?: Repo
+  tier?: string
+  coldTier?: string
+  coldAfter?: GoogleProtobufDuration.Duration
+}
+
+export type SetStoragePlacementRequest = {
+  repo?: Repo
+  tier?: string
+  coldTier?: string
+  coldAfter?: GoogleProtobufDuration.Duration
+}
+
+export type ListStoragePlacementRequest = {
+  repo?: Repo
+}
+
+export type UploadPartInfo = {
+  number?: string
+  sizeBytes?: string
+  hash?: Uint8Array
+  fileSetId?: string
+}
+
+export type UploadSessionInfo = {
+  id?: string
+  file?: File
+  append?: boolean
+  created?: GoogleProtobufTimestamp.Timestamp
+  expires?: GoogleProtobufTimestamp.Timestamp
+  ttl?: GoogleProtobufDuration.Duration
+  parts?: UploadPartInfo[]
+}
+
+export type CreateUploadSessionRequest = {
+  file?: File
+  append?: boolean
+  ttl?: GoogleProtobufDuration.Duration
+}
+
+export type InspectUploadSessionRequest = {
+  id?: string
+}
+
+export type ListUploadSessionRequest = {
+  repo?: Repo
+}
+
+export type UploadPartRequest = {
+  sessionId?: string
+  number?: string
+  data?: Uint8Array
+}
+
+export type CommitUploadSessionRequest = {
+  id?: string
+  parts?: string[]
+}
+
+export type DeleteUploadSessionRequest = {
+  id?: string
+}
+
 export class API {
   static CreateRepo(req: CreateRepoRequest, initReq?: fm.InitReq): Promise<GoogleProtobufEmpty.Empty> {
     return fm.fetchReq<CreateRepoRequest, GoogleProtobufEmpty.Empty>(`/pfs_v2.API/CreateRepo`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
@@ -547,6 +818,9 @@ export class API {
   static ClearCommit(req: ClearCommitRequest, initReq?: fm.InitReq): Promise<GoogleProtobufEmpty.Empty> {
     return fm.fetchReq<ClearCommitRequest, GoogleProtobufEmpty.Empty>(`/pfs_v2.API/ClearCommit`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
+  static ApproveCommit(req: ApproveCommitRequest, initReq?: fm.InitReq): Promise<GoogleProtobufEmpty.Empty> {
+    return fm.fetchReq<ApproveCommitRequest, GoogleProtobufEmpty.Empty>(`/pfs_v2.API/ApproveCommit`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
   static InspectCommit(req: InspectCommitRequest, initReq?: fm.InitReq): Promise<CommitInfo> {
     return fm.fetchReq<InspectCommitRequest, CommitInfo>(`/pfs_v2.API/InspectCommit`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
@@ -658,4 +932,55 @@ export class API {
   static DeleteProject(req: DeleteProjectRequest, initReq?: fm.InitReq): Promise<GoogleProtobufEmpty.Empty> {
     return fm.fetchReq<DeleteProjectRequest, GoogleProtobufEmpty.Empty>(`/pfs_v2.API/DeleteProject`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
+  static CreateReplication(req: CreateReplicationRequest, initReq?: fm.InitReq): Promise<GoogleProtobufEmpty.Empty> {
+    return fm.fetchReq<CreateReplicationRequest, GoogleProtobufEmpty.Empty>(`/pfs_v2.API/CreateReplication`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static InspectReplication(req: InspectReplicationRequest, initReq?: fm.InitReq): Promise<ReplicationInfo> {
+    return fm.fetchReq<InspectReplicationRequest, ReplicationInfo>(`/pfs_v2.API/InspectReplication`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static ListReplication(req: ListReplicationRequest, entityNotifier?: fm.NotifyStreamEntityArrival<ReplicationInfo>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<ListReplicationRequest, ReplicationInfo>(`/pfs_v2.API/ListReplication`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static DeleteReplication(req: DeleteReplicationRequest, initReq?: fm.InitReq): Promise<GoogleProtobufEmpty.Empty> {
+    return fm.fetchReq<DeleteReplicationRequest, GoogleProtobufEmpty.Empty>(`/pfs_v2.API/DeleteReplication`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static MissingChunks(req: MissingChunksRequest, initReq?: fm.InitReq): Promise<MissingChunksResponse> {
+    return fm.fetchReq<MissingChunksRequest, MissingChunksResponse>(`/pfs_v2.API/MissingChunks`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static SetRetentionPolicy(req: SetRetentionPolicyRequest, initReq?: fm.InitReq): Promise<GoogleProtobufEmpty.Empty> {
+    return fm.fetchReq<SetRetentionPolicyRequest, GoogleProtobufEmpty.Empty>(`/pfs_v2.API/SetRetentionPolicy`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static ListRetentionPolicy(req: ListRetentionPolicyRequest, entityNotifier?: fm.NotifyStreamEntityArrival<RetentionPolicyInfo>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<ListRetentionPolicyRequest, RetentionPolicyInfo>(`/pfs_v2.API/ListRetentionPolicy`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static EnforceRetentionPolicy(req: EnforceRetentionPolicyRequest, entityNotifier?: fm.NotifyStreamEntityArrival<RetentionAction>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<EnforceRetentionPolicyRequest, RetentionAction>(`/pfs_v2.API/EnforceRetentionPolicy`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static SetCommitValidation(req: SetCommitValidationRequest, initReq?: fm.InitReq): Promise<GoogleProtobufEmpty.Empty> {
+    return fm.fetchReq<SetCommitValidationRequest, GoogleProtobufEmpty.Empty>(`/pfs_v2.API/SetCommitValidation`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static ListCommitValidation(req: ListCommitValidationRequest, entityNotifier?: fm.NotifyStreamEntityArrival<CommitValidationInfo>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<ListCommitValidationRequest, CommitValidationInfo>(`/pfs_v2.API/ListCommitValidation`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static SetStoragePlacement(req: SetStoragePlacementRequest, initReq?: fm.InitReq): Promise<GoogleProtobufEmpty.Empty> {
+    return fm.fetchReq<SetStoragePlacementRequest, GoogleProtobufEmpty.Empty>(`/pfs_v2.API/SetStoragePlacement`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static ListStoragePlacement(req: ListStoragePlacementRequest, entityNotifier?: fm.NotifyStreamEntityArrival<StoragePlacementInfo>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<ListStoragePlacementRequest, StoragePlacementInfo>(`/pfs_v2.API/ListStoragePlacement`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static CreateUploadSession(req: CreateUploadSessionRequest, initReq?: fm.InitReq): Promise<UploadSessionInfo> {
+    return fm.fetchReq<CreateUploadSessionRequest, UploadSessionInfo>(`/pfs_v2.API/CreateUploadSession`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static InspectUploadSession(req: InspectUploadSessionRequest, initReq?: fm.InitReq): Promise<UploadSessionInfo> {
+    return fm.fetchReq<InspectUploadSessionRequest, UploadSessionInfo>(`/pfs_v2.API/InspectUploadSession`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static ListUploadSession(req: ListUploadSessionRequest, entityNotifier?: fm.NotifyStreamEntityArrival<UploadSessionInfo>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<ListUploadSessionRequest, UploadSessionInfo>(`/pfs_v2.API/ListUploadSession`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static CommitUploadSession(req: CommitUploadSessionRequest, initReq?: fm.InitReq): Promise<GoogleProtobufEmpty.Empty> {
+    return fm.fetchReq<CommitUploadSessionRequest, GoogleProtobufEmpty.Empty>(`/pfs_v2.API/CommitUploadSession`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static DeleteUploadSession(req: DeleteUploadSessionRequest, initReq?: fm.InitReq): Promise<GoogleProtobufEmpty.Empty> {
+    return fm.fetchReq<DeleteUploadSessionRequest, GoogleProtobufEmpty.Empty>(`/pfs_v2.API/DeleteUploadSession`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
 }
\ No newline at end of file
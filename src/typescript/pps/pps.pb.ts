@@ -7,6 +7,7 @@
 import * as fm from "../fetch.pb"
 import * as GoogleProtobufDuration from "../google/protobuf/duration.pb"
 import * as GoogleProtobufEmpty from "../google/protobuf/empty.pb"
+import * as GoogleProtobufStruct from "../google/protobuf/struct.pb"
 import * as GoogleProtobufTimestamp from "../google/protobuf/timestamp.pb"
 import * as GoogleProtobufWrappers from "../google/protobuf/wrappers.pb"
 import * as Pfs_v2Pfs from "../pfs/pfs.pb"
@@ -74,6 +75,13 @@ export enum TaintEffect {
   NO_EXECUTE = "NO_EXECUTE",
 }
 
+export enum NotificationDeliveryState {
+  NOTIFICATION_DELIVERY_STATE_UNKNOWN = "NOTIFICATION_DELIVERY_STATE_UNKNOWN",
+  NOTIFICATION_DELIVERY_PENDING = "NOTIFICATION_DELIVERY_PENDING",
+  NOTIFICATION_DELIVERY_DELIVERED = "NOTIFICATION_DELIVERY_DELIVERED",
+  NOTIFICATION_DELIVERY_FAILED = "NOTIFICATION_DELIVERY_FAILED",
+}
+
 export enum PipelineInfoPipelineType {
   PIPELINT_TYPE_UNKNOWN = "PIPELINT_TYPE_UNKNOWN",
   PIPELINE_TYPE_TRANSFORM = "PIPELINE_TYPE_TRANSFORM",
@@ -104,6 +112,20 @@ export type Transform = {
   dockerfile?: string
   memoryVolume?: boolean
   datumBatching?: boolean
+  starlark?: StarlarkTransform
+  builtin?: BuiltinTransform
+}
+
+export type StarlarkTransform = {
+  script?: string
+  maxSteps?: string
+  memoryLimitBytes?: string
+  timeout?: GoogleProtobufDuration.Duration
+}
+
+export type BuiltinTransform = {
+  name?: string
+  args?: GoogleProtobufStruct.Struct
 }
 
 export type TFJob = {
@@ -141,6 +163,7 @@ export type Service = {
 
 export type Spout = {
   service?: Service
+  exactlyOnce?: boolean
 }
 
 export type PFSInput = {
@@ -158,6 +181,14 @@ export type PFSInput = {
   emptyFiles?: boolean
   s3?: boolean
   trigger?: Pfs_v2Pfs.Trigger
+  incremental?: boolean
+  window?: PFSWindow
+  previousOutput?: Pfs_v2Pfs.Commit
+}
+
+export type PFSWindow = {
+  commits?: string
+  duration?: GoogleProtobufDuration.Duration
 }
 
 export type CronInput = {
@@ -301,6 +332,7 @@ export type JobInfo = {
   finished?: GoogleProtobufTimestamp.Timestamp
   details?: JobInfoDetails
   authToken?: string
+  traceContext?: {[key: string]: string}
 }
 
 export type Worker = {
@@ -356,6 +388,7 @@ export type PipelineInfoDetails = {
   tolerations?: Toleration[]
   sidecarResourceRequests?: ResourceSpec
   determined?: Determined
+  outputContract?: Pfs_v2Pfs.ContentContract
 }
 
 export type PipelineInfo = {
@@ -538,6 +571,7 @@ export type CreatePipelineRequest = {
   sidecarResourceRequests?: ResourceSpec
   dryRun?: boolean
   determined?: Determined
+  outputContract?: Pfs_v2Pfs.ContentContract
 }
 
 export type CreatePipelineV2Request = {
@@ -720,6 +754,94 @@ export type SetProjectDefaultsResponse = {
   affectedPipelines?: Pipeline[]
 }
 
+export type GetProjectComputeUsageRequest = {
+  project?: Pfs_v2Pfs.Project
+}
+
+export type GetProjectComputeUsageResponse = {
+  workers?: string
+  cpu?: string
+  memory?: string
+}
+
+export type Notification = {
+  name?: string
+}
+
+export type NotificationEventCommitFinished = {
+  branch?: Pfs_v2Pfs.Branch
+}
+
+export type NotificationEventJobFinished = {
+  pipeline?: Pipeline
+  states?: JobState[]
+}
+
+export type NotificationEventPipelineStateChanged = {
+  pipeline?: Pipeline
+  states?: PipelineState[]
+}
+
+
+type BaseNotificationEvent = {
+}
+
+export type NotificationEvent = BaseNotificationEvent
+  & OneOf<{ commitFinished: NotificationEventCommitFinished; jobFinished: NotificationEventJobFinished; pipelineStateChanged: NotificationEventPipelineStateChanged }>
+
+export type NotificationInfo = {
+  notification?: Notification
+  url?: string
+  events?: NotificationEvent[]
+  secret?: string
+  createdAt?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type CreateNotificationRequest = {
+  notification?: Notification
+  url?: string
+  events?: NotificationEvent[]
+  secret?: string
+  update?: boolean
+}
+
+export type CreateNotificationResponse = {
+  secret?: string
+}
+
+export type ListNotificationRequest = {
+}
+
+export type DeleteNotificationRequest = {
+  notification?: Notification
+}
+
+export type NotificationDeliveryInfo = {
+  id?: string
+  notification?: Notification
+  eventId?: string
+  state?: NotificationDeliveryState
+  attempts?: number
+  statusCode?: number
+  error?: string
+  createdAt?: GoogleProtobufTimestamp.Timestamp
+  deliveredAt?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type ListNotificationDeliveryRequest = {
+  notification?: Notification
+}
+
+
+type BaseNotificationPayload = {
+  deliveryId?: string
+  notification?: Notification
+  time?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type NotificationPayload = BaseNotificationPayload
+  & OneOf<{ commitFinished: Pfs_v2Pfs.CommitInfo; jobFinished: JobInfo; pipelineStateChanged: PipelineInfo }>
+
 export class API {
   static InspectJob(req: InspectJobRequest, initReq?: fm.InitReq): Promise<JobInfo> {
     return fm.fetchReq<InspectJobRequest, JobInfo>(`/pps_v2.API/InspectJob`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
@@ -832,4 +954,19 @@ export class API {
   static SetClusterDefaults(req: SetClusterDefaultsRequest, initReq?: fm.InitReq): Promise<SetClusterDefaultsResponse> {
     return fm.fetchReq<SetClusterDefaultsRequest, SetClusterDefaultsResponse>(`/pps_v2.API/SetClusterDefaults`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
+  static GetProjectComputeUsage(req: GetProjectComputeUsageRequest, initReq?: fm.InitReq): Promise<GetProjectComputeUsageResponse> {
+    return fm.fetchReq<GetProjectComputeUsageRequest, GetProjectComputeUsageResponse>(`/pps_v2.API/GetProjectComputeUsage`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static CreateNotification(req: CreateNotificationRequest, initReq?: fm.InitReq): Promise<CreateNotificationResponse> {
+    return fm.fetchReq<CreateNotificationRequest, CreateNotificationResponse>(`/pps_v2.API/CreateNotification`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static ListNotification(req: ListNotificationRequest, entityNotifier?: fm.NotifyStreamEntityArrival<NotificationInfo>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<ListNotificationRequest, NotificationInfo>(`/pps_v2.API/ListNotification`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static DeleteNotification(req: DeleteNotificationRequest, initReq?: fm.InitReq): Promise<GoogleProtobufEmpty.Empty> {
+    return fm.fetchReq<DeleteNotificationRequest, GoogleProtobufEmpty.Empty>(`/pps_v2.API/DeleteNotification`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static ListNotificationDelivery(req: ListNotificationDeliveryRequest, entityNotifier?: fm.NotifyStreamEntityArrival<NotificationDeliveryInfo>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<ListNotificationDeliveryRequest, NotificationDeliveryInfo>(`/pps_v2.API/ListNotificationDelivery`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
 }
\ No newline at end of file